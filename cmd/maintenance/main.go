@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/omkar273/nashikdarshan/internal/config"
+	"github.com/omkar273/nashikdarshan/internal/logger"
+	"github.com/omkar273/nashikdarshan/internal/postgres"
+)
+
+// maintainedTable is the table whose spatial and text indexes the maintenance commands look after
+const maintainedTable = "places"
+
+// indexNameRegex extracts the index name from a CREATE INDEX statement
+var indexNameRegex = regexp.MustCompile(`INDEX IF NOT EXISTS (\w+)`)
+
+// operation is one maintenance subcommand
+type operation struct {
+	name        string
+	description string
+	run         func(ctx context.Context, db *sql.DB, opts options) error
+}
+
+// options are the flags shared by the operations
+type options struct {
+	concurrently bool
+}
+
+var operations = []operation{
+	{"analyze", "Refresh the planner statistics of every table", analyze},
+	{"reindex", "Rebuild the indexes of the places table, including the spatial and text ones", reindex},
+	{"refresh-views", "Refresh the materialized views read by the API", refreshViews},
+	{"check-indexes", "Verify PostGIS and that the spatial and text indexes exist and are valid", checkIndexes},
+}
+
+func main() {
+	concurrently := flag.Bool("concurrently", false, "Run REINDEX and view refreshes without blocking reads and writes (slower)")
+	timeout := flag.Duration("timeout", time.Hour, "Give up on all operations after this long")
+	flag.Usage = usage
+	flag.Parse()
+
+	names := flag.Args()
+	if len(names) == 0 {
+		usage()
+		os.Exit(2)
+	}
+	if len(names) == 1 && names[0] == "all" {
+		names = nil
+		for _, op := range operations {
+			names = append(names, op.name)
+		}
+	}
+
+	selected := make([]operation, 0, len(names))
+	for _, name := range names {
+		op, ok := findOperation(name)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "unknown command %q\n\n", name)
+			usage()
+			os.Exit(2)
+		}
+		selected = append(selected, op)
+	}
+
+	// Load configuration
+	cfg, err := config.NewConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	// Initialize logger
+	logger, err := logger.NewLogger(cfg)
+	if err != nil {
+		log.Fatalf("Failed to create logger: %v", err)
+	}
+
+	logger.Infow("Connecting to database", "host", cfg.Postgres.Host)
+
+	db, err := sql.Open("postgres", buildDSN(cfg.Postgres))
+	if err != nil {
+		logger.Fatalw("Failed to open database connection", "error", err)
+	}
+	//nolint:errcheck
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	if err := postgres.PingWithRetry(ctx, db, cfg.Postgres, logger); err != nil {
+		logger.Fatalw("Failed to connect to database", "error", err)
+	}
+
+	opts := options{concurrently: *concurrently}
+	failed := false
+	for _, op := range selected {
+		start := time.Now()
+		err := op.run(ctx, db, opts)
+		elapsed := time.Since(start).Round(time.Millisecond)
+		if err != nil {
+			failed = true
+			logger.Errorw("Maintenance operation failed", "operation", op.name, "duration", elapsed.String(), "error", err)
+			fmt.Printf("%-14s FAILED after %s: %v\n", op.name, elapsed, err)
+			continue
+		}
+		logger.Infow("Maintenance operation completed", "operation", op.name, "duration", elapsed.String())
+		fmt.Printf("%-14s ok in %s\n", op.name, elapsed)
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: go run cmd/maintenance/main.go [flags] <command>...\n\nCommands:\n")
+	for _, op := range operations {
+		fmt.Fprintf(os.Stderr, "  %-14s %s\n", op.name, op.description)
+	}
+	fmt.Fprintf(os.Stderr, "  %-14s %s\n\nFlags:\n", "all", "Run every command above, in order")
+	flag.PrintDefaults()
+}
+
+func findOperation(name string) (operation, bool) {
+	for _, op := range operations {
+		if op.name == name {
+			return op, true
+		}
+	}
+	return operation{}, false
+}
+
+func analyze(ctx context.Context, db *sql.DB, _ options) error {
+	_, err := db.ExecContext(ctx, "ANALYZE")
+	return err
+}
+
+// reindex rebuilds every index of the places table. CONCURRENTLY builds the new indexes
+// alongside the old ones instead of locking the table; a failed concurrent rebuild leaves an
+// invalid index behind, which check-indexes reports.
+func reindex(ctx context.Context, db *sql.DB, opts options) error {
+	stmt := "REINDEX TABLE "
+	if opts.concurrently {
+		stmt += "CONCURRENTLY "
+	}
+	_, err := db.ExecContext(ctx, stmt+pq.QuoteIdentifier(maintainedTable))
+	return err
+}
+
+func refreshViews(ctx context.Context, db *sql.DB, opts options) error {
+	stmt := "REFRESH MATERIALIZED VIEW "
+	if opts.concurrently {
+		stmt += "CONCURRENTLY "
+	}
+	_, err := db.ExecContext(ctx, stmt+pq.QuoteIdentifier(postgres.PlaceFeedListingsView))
+	return err
+}
+
+// checkIndexes reports the PostGIS version and fails when an index the migrator creates is
+// missing, or invalid or not ready, e.g. after an interrupted concurrent rebuild
+func checkIndexes(ctx context.Context, db *sql.DB, _ options) error {
+	var version string
+	if err := db.QueryRowContext(ctx, "SELECT postgis_lib_version()").Scan(&version); err != nil {
+		return fmt.Errorf("postgis is not available: %w", err)
+	}
+	fmt.Printf("postgis %s\n", version)
+
+	expected := make([]string, 0, len(postgres.Indexes))
+	for _, stmt := range postgres.Indexes {
+		if m := indexNameRegex.FindStringSubmatch(stmt); m != nil {
+			expected = append(expected, m[1])
+		}
+	}
+
+	rows, err := db.QueryContext(ctx, `
+SELECT c.relname, i.indisvalid, i.indisready
+FROM pg_index i
+JOIN pg_class c ON c.oid = i.indexrelid
+WHERE c.relname = ANY($1)`, pq.Array(expected))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	found := make(map[string]bool, len(expected))
+	unhealthy := 0
+	for rows.Next() {
+		var name string
+		var valid, ready bool
+		if err := rows.Scan(&name, &valid, &ready); err != nil {
+			return err
+		}
+		found[name] = true
+		if !valid || !ready {
+			unhealthy++
+			fmt.Printf("  %-30s INVALID (valid=%t ready=%t), run reindex\n", name, valid, ready)
+			continue
+		}
+		fmt.Printf("  %-30s ok\n", name)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, name := range expected {
+		if !found[name] {
+			unhealthy++
+			fmt.Printf("  %-30s MISSING, run the migrator\n", name)
+		}
+	}
+	if unhealthy > 0 {
+		return fmt.Errorf("%d of %d indexes are missing or invalid", unhealthy, len(expected))
+	}
+	return nil
+}
+
+// buildDSN builds a DSN from the postgres configuration
+func buildDSN(cfg config.PostgresConfig) string {
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+		cfg.Host,
+		cfg.Port,
+		cfg.User,
+		cfg.Password,
+		cfg.DBName,
+		cfg.SSLMode,
+	)
+}
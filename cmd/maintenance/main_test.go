@@ -0,0 +1,52 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/omkar273/nashikdarshan/internal/postgres"
+)
+
+// TestIndexNames guards check-indexes against statements it cannot name, which it would
+// otherwise skip without ever reporting the index missing
+func TestIndexNames(t *testing.T) {
+	seen := make(map[string]bool, len(postgres.Indexes))
+	for _, stmt := range postgres.Indexes {
+		m := indexNameRegex.FindStringSubmatch(stmt)
+		if m == nil {
+			t.Errorf("no index name in %q", stmt)
+			continue
+		}
+		if seen[m[1]] {
+			t.Errorf("index %s is created twice", m[1])
+		}
+		seen[m[1]] = true
+	}
+}
+
+func TestFindOperation(t *testing.T) {
+	tests := []struct {
+		name   string
+		wantOK bool
+	}{
+		{name: "analyze", wantOK: true},
+		{name: "reindex", wantOK: true},
+		{name: "refresh-views", wantOK: true},
+		{name: "check-indexes", wantOK: true},
+		// all is expanded by main, not an operation itself
+		{name: "all"},
+		{name: "vacuum"},
+		{name: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op, ok := findOperation(tt.name)
+			if ok != tt.wantOK {
+				t.Fatalf("findOperation(%q) ok = %v, want %v", tt.name, ok, tt.wantOK)
+			}
+			if ok && (op.name != tt.name || op.run == nil) {
+				t.Errorf("findOperation(%q) = %+v", tt.name, op)
+			}
+		})
+	}
+}
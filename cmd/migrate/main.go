@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"flag"
 	"fmt"
 	"log"
@@ -9,12 +10,21 @@ import (
 	"strings"
 	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	_ "github.com/lib/pq"
 	"github.com/omkar273/nashikdarshan/ent"
+	"github.com/omkar273/nashikdarshan/ent/place"
+	_ "github.com/omkar273/nashikdarshan/ent/runtime" // registers schema defaults and hooks
 	"github.com/omkar273/nashikdarshan/internal/config"
 	"github.com/omkar273/nashikdarshan/internal/logger"
+	"github.com/omkar273/nashikdarshan/internal/postgres"
+	"github.com/omkar273/nashikdarshan/internal/types"
 )
 
+// requiredExtensions are the postgres extensions the application relies on
+var requiredExtensions = []string{"postgis", "pg_trgm"}
+
 func main() {
 	// Parse command line flags
 	dryRun := flag.Bool("dry-run", false, "Print migration SQL without executing it")
@@ -48,14 +58,21 @@ func main() {
 
 	logger.Infow("Connecting to database", "host", cfg.Postgres.Host)
 
+	db, err := sql.Open("postgres", migrationDSN)
+	if err != nil {
+		logger.Fatalw("Failed to open database connection", "error", err)
+	}
+
+	// Wait for the database to come up before migrating
+	if err := postgres.PingWithRetry(context.Background(), db, cfg.Postgres, logger); err != nil {
+		logger.Fatalw("Failed to connect to database", "error", err)
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
 	// Create Ent client with migration DSN
-	client, err := ent.Open("postgres", migrationDSN)
-	if err != nil {
-		logger.Fatalw("Failed to create ent client", "error", err)
-	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
 	//nolint:errcheck
 	defer client.Close()
 
@@ -71,17 +88,93 @@ func main() {
 			logger.Fatalw("Failed to generate migration SQL", "error", err)
 		}
 	} else {
+		// Extensions used by raw queries (e.g. duplicate place detection). Missing
+		// privileges should not block the schema migration itself.
+		for _, ext := range requiredExtensions {
+			if _, err := client.ExecContext(ctx, fmt.Sprintf("CREATE EXTENSION IF NOT EXISTS %s", ext)); err != nil {
+				logger.Warnw("Failed to create postgres extension", "extension", ext, "error", err)
+			}
+		}
+
+		// Title sort collations. Servers without ICU keep sorting titles by byte order.
+		for _, stmt := range postgres.Collations {
+			if _, err := client.ExecContext(ctx, stmt); err != nil {
+				logger.Warnw("Failed to create collation", "statement", stmt, "error", err)
+			}
+		}
+
 		// Run the actual migration
 		err = client.Schema.Create(ctx)
 		if err != nil {
 			logger.Fatalw("Failed to create schema resources", "error", err)
 		}
+
+		// Places created before the geohash column existed are not matched by the prefix filter
+		backfilled, err := backfillPlaceGeohashes(ctx, client)
+		if err != nil {
+			logger.Fatalw("Failed to backfill place geohashes", "error", err)
+		}
+		if backfilled > 0 {
+			logger.Infow("Backfilled place geohashes", "count", backfilled)
+		}
+
+		for _, stmt := range postgres.Indexes {
+			if _, err := client.ExecContext(ctx, stmt); err != nil {
+				logger.Fatalw("Failed to create index", "error", err)
+			}
+		}
+
+		categoryNameIndex := postgres.DropCategoryNameUniqueIndex
+		if cfg.Categories.UniqueNames {
+			categoryNameIndex = postgres.CreateCategoryNameUniqueIndex
+		}
+		if _, err := client.ExecContext(ctx, categoryNameIndex); err != nil {
+			logger.Fatalw("Failed to apply category name uniqueness index", "error", err,
+				"unique_names", cfg.Categories.UniqueNames)
+		}
+
+		// Materialized views read by the API; queries fall back to live data when they are missing
+		for _, stmt := range postgres.MaterializedViews {
+			if _, err := client.ExecContext(ctx, stmt); err != nil {
+				logger.Fatalw("Failed to create materialized view", "error", err)
+			}
+		}
 		logger.Info("Migration completed successfully")
 	}
 
 	fmt.Println("Migration process completed")
 }
 
+// geohashBackfillBatchSize is the number of places updated per backfill query
+const geohashBackfillBatchSize = 500
+
+// backfillPlaceGeohashes stores the geohash of every place that does not have one yet
+func backfillPlaceGeohashes(ctx context.Context, client *ent.Client) (int, error) {
+	total := 0
+	for {
+		places, err := client.Place.Query().
+			Where(place.Or(place.GeohashIsNil(), place.GeohashEQ(""))).
+			Select(place.FieldID, place.FieldLatitude, place.FieldLongitude).
+			Limit(geohashBackfillBatchSize).
+			All(ctx)
+		if err != nil {
+			return total, err
+		}
+		for _, p := range places {
+			location := types.Location{Latitude: p.Latitude, Longitude: p.Longitude}
+			if err := client.Place.UpdateOneID(p.ID).
+				SetGeohash(location.Geohash(types.MaxGeohashPrecision)).
+				Exec(ctx); err != nil {
+				return total, fmt.Errorf("place %s: %w", p.ID, err)
+			}
+		}
+		total += len(places)
+		if len(places) < geohashBackfillBatchSize {
+			return total, nil
+		}
+	}
+}
+
 // buildMigrationDSN builds a DSN for migrations using direct connection
 func buildMigrationDSN(cfg config.PostgresConfig) string {
 	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
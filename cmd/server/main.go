@@ -9,6 +9,7 @@ import (
 	"github.com/omkar273/nashikdarshan/internal/api"
 	v1 "github.com/omkar273/nashikdarshan/internal/api/v1"
 	"github.com/omkar273/nashikdarshan/internal/auth"
+	"github.com/omkar273/nashikdarshan/internal/cache"
 	"github.com/omkar273/nashikdarshan/internal/config"
 	"github.com/omkar273/nashikdarshan/internal/logger"
 	"github.com/omkar273/nashikdarshan/internal/postgres"
@@ -16,6 +17,8 @@ import (
 	"github.com/omkar273/nashikdarshan/internal/security"
 	"github.com/omkar273/nashikdarshan/internal/service"
 	"github.com/omkar273/nashikdarshan/internal/validator"
+	"github.com/omkar273/nashikdarshan/internal/vision"
+	"github.com/omkar273/nashikdarshan/internal/weather"
 
 	"go.uber.org/fx"
 )
@@ -66,6 +69,7 @@ func main() {
 			auth.NewSupabaseProvider,
 
 			// repositories
+			repository.NewCacheStore,
 			repository.NewUserRepository,
 			repository.NewCategoryRepository,
 			repository.NewPlaceRepository,
@@ -73,12 +77,16 @@ func main() {
 			repository.NewHotelRepository,
 			repository.NewEventRepository,
 			repository.NewItineraryRepository,
+			repository.NewAPIKeyRepository,
+			repository.NewOutboxRepository,
 		),
 	) // services
 	opts = append(opts, fx.Provide(
 
 		// external services
 		service.NewRoutingClient,
+		weather.NewProvider,
+		vision.NewCaptioner,
 
 		// all services
 		security.NewEncryptionService,
@@ -91,6 +99,10 @@ func main() {
 		service.NewHotelService,
 		service.NewEventService,
 		service.NewItineraryService,
+		service.NewAdminService,
+		service.NewPlaceImportService,
+		service.NewAPIKeyService,
+		service.NewOutboxDispatcher,
 	)) // factory layer
 	opts = append(opts, fx.Provide(
 		// handlers
@@ -116,12 +128,19 @@ func startServer(
 	cfg *config.Configuration,
 	r *gin.Engine,
 	log *logger.Logger,
+	placeService service.PlaceService,
+	outboxDispatcher service.OutboxDispatcher,
 ) {
 	// start api server
 	startAPIServer(lc, r, cfg, log)
+
+	// start background jobs
+	startFeedListingsRefresher(lc, cfg, log, placeService)
+	startPublishScheduler(lc, cfg, log, placeService)
+	startOutboxDispatcher(lc, cfg, log, outboxDispatcher)
 }
 
-func provideHandlers(logger *logger.Logger, authService service.AuthService, userService service.UserService, categoryService service.CategoryService, placeService service.PlaceService, reviewService service.ReviewService, hotelService service.HotelService, eventService service.EventService, itineraryService service.ItineraryService) *api.Handlers {
+func provideHandlers(logger *logger.Logger, authService service.AuthService, userService service.UserService, categoryService service.CategoryService, placeService service.PlaceService, reviewService service.ReviewService, hotelService service.HotelService, eventService service.EventService, itineraryService service.ItineraryService, adminService service.AdminService, placeImportService service.PlaceImportService, apiKeyService service.APIKeyService, cacheStore *cache.Store, cfg *config.Configuration) *api.Handlers {
 	return &api.Handlers{
 		Health:    v1.NewHealthHandler(logger),
 		Auth:      v1.NewAuthHandler(authService),
@@ -132,11 +151,13 @@ func provideHandlers(logger *logger.Logger, authService service.AuthService, use
 		Hotel:     v1.NewHotelHandler(hotelService),
 		Event:     v1.NewEventHandler(eventService),
 		Itinerary: v1.NewItineraryHandler(itineraryService),
+		Admin:     v1.NewAdminHandler(adminService, placeImportService, cacheStore, cfg),
+		APIKey:    v1.NewAPIKeyHandler(apiKeyService),
 	}
 }
 
-func provideRouter(handlers *api.Handlers, cfg *config.Configuration, logger *logger.Logger) *gin.Engine {
-	return api.NewRouter(handlers, cfg, logger)
+func provideRouter(handlers *api.Handlers, cfg *config.Configuration, logger *logger.Logger, apiKeyService service.APIKeyService, userService service.UserService) *gin.Engine {
+	return api.NewRouter(handlers, cfg, logger, apiKeyService, userService)
 }
 
 func startAPIServer(
@@ -164,3 +185,122 @@ func startAPIServer(
 		},
 	})
 }
+
+// startFeedListingsRefresher periodically refreshes the precomputed feed listings
+func startFeedListingsRefresher(
+	lc fx.Lifecycle,
+	cfg *config.Configuration,
+	log *logger.Logger,
+	placeService service.PlaceService,
+) {
+	interval := cfg.Places.GetFeedListingsRefreshInterval()
+	if interval == 0 {
+		log.Info("Feed listings refresh job is disabled")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				ticker := time.NewTicker(interval)
+				defer ticker.Stop()
+
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+						if err := placeService.RefreshFeedListings(ctx); err != nil {
+							log.Errorw("failed to refresh feed listings", "error", err)
+						}
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+}
+
+// startPublishScheduler periodically applies the due publish_at/unpublish_at schedules of places
+func startPublishScheduler(
+	lc fx.Lifecycle,
+	cfg *config.Configuration,
+	log *logger.Logger,
+	placeService service.PlaceService,
+) {
+	interval := cfg.Places.GetPublishScheduleInterval()
+	if interval == 0 {
+		log.Info("Place publish scheduler is disabled")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				ticker := time.NewTicker(interval)
+				defer ticker.Stop()
+
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+						if err := placeService.ApplyPublishSchedules(ctx); err != nil {
+							log.Errorw("failed to apply place publish schedules", "error", err)
+						}
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+}
+
+// startOutboxDispatcher periodically delivers the change events recorded in the outbox
+func startOutboxDispatcher(
+	lc fx.Lifecycle,
+	cfg *config.Configuration,
+	log *logger.Logger,
+	dispatcher service.OutboxDispatcher,
+) {
+	if !cfg.Outbox.Enabled {
+		log.Info("Outbox dispatcher is disabled")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				ticker := time.NewTicker(cfg.Outbox.GetPollInterval())
+				defer ticker.Stop()
+
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+						if _, err := dispatcher.DispatchDue(ctx); err != nil {
+							log.Errorw("failed to dispatch outbox events", "error", err)
+						}
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+}
@@ -299,7 +299,7 @@ func (_q *CategoryQuery) WithPlaces(opts ...func(*PlaceQuery)) *CategoryQuery {
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Status types.Status `json:"status,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
@@ -322,7 +322,7 @@ func (_q *CategoryQuery) GroupBy(field string, fields ...string) *CategoryGroupB
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Status types.Status `json:"status,omitempty"`
 //	}
 //
 //	client.Category.Query().
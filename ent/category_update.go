@@ -14,6 +14,7 @@ import (
 	"github.com/omkar273/nashikdarshan/ent/category"
 	"github.com/omkar273/nashikdarshan/ent/place"
 	"github.com/omkar273/nashikdarshan/ent/predicate"
+	"github.com/omkar273/nashikdarshan/internal/types"
 )
 
 // CategoryUpdate is the builder for updating Category entities.
@@ -30,13 +31,13 @@ func (_u *CategoryUpdate) Where(ps ...predicate.Category) *CategoryUpdate {
 }
 
 // SetStatus sets the "status" field.
-func (_u *CategoryUpdate) SetStatus(v string) *CategoryUpdate {
+func (_u *CategoryUpdate) SetStatus(v types.Status) *CategoryUpdate {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *CategoryUpdate) SetNillableStatus(v *string) *CategoryUpdate {
+func (_u *CategoryUpdate) SetNillableStatus(v *types.Status) *CategoryUpdate {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -69,6 +70,46 @@ func (_u *CategoryUpdate) ClearUpdatedBy() *CategoryUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CategoryUpdate) SetDeletedAt(v time.Time) *CategoryUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableDeletedAt(v *time.Time) *CategoryUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CategoryUpdate) ClearDeletedAt() *CategoryUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *CategoryUpdate) SetDeletedBy(v string) *CategoryUpdate {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableDeletedBy(v *string) *CategoryUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *CategoryUpdate) ClearDeletedBy() *CategoryUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *CategoryUpdate) SetMetadata(v map[string]string) *CategoryUpdate {
 	_u.mutation.SetMetadata(v)
@@ -172,7 +213,9 @@ func (_u *CategoryUpdate) RemovePlaces(v ...*Place) *CategoryUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CategoryUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -199,15 +242,24 @@ func (_u *CategoryUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *CategoryUpdate) defaults() {
+func (_u *CategoryUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if category.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized category.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := category.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *CategoryUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := category.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Category.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Name(); ok {
 		if err := category.NameValidator(v); err != nil {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Category.name": %w`, err)}
@@ -248,6 +300,18 @@ func (_u *CategoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.UpdatedByCleared() {
 		_spec.ClearField(category.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(category.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(category.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(category.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(category.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(category.FieldMetadata, field.TypeJSON, value)
 	}
@@ -332,13 +396,13 @@ type CategoryUpdateOne struct {
 }
 
 // SetStatus sets the "status" field.
-func (_u *CategoryUpdateOne) SetStatus(v string) *CategoryUpdateOne {
+func (_u *CategoryUpdateOne) SetStatus(v types.Status) *CategoryUpdateOne {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *CategoryUpdateOne) SetNillableStatus(v *string) *CategoryUpdateOne {
+func (_u *CategoryUpdateOne) SetNillableStatus(v *types.Status) *CategoryUpdateOne {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -371,6 +435,46 @@ func (_u *CategoryUpdateOne) ClearUpdatedBy() *CategoryUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CategoryUpdateOne) SetDeletedAt(v time.Time) *CategoryUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableDeletedAt(v *time.Time) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CategoryUpdateOne) ClearDeletedAt() *CategoryUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *CategoryUpdateOne) SetDeletedBy(v string) *CategoryUpdateOne {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableDeletedBy(v *string) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *CategoryUpdateOne) ClearDeletedBy() *CategoryUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *CategoryUpdateOne) SetMetadata(v map[string]string) *CategoryUpdateOne {
 	_u.mutation.SetMetadata(v)
@@ -487,7 +591,9 @@ func (_u *CategoryUpdateOne) Select(field string, fields ...string) *CategoryUpd
 
 // Save executes the query and returns the updated Category entity.
 func (_u *CategoryUpdateOne) Save(ctx context.Context) (*Category, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -514,15 +620,24 @@ func (_u *CategoryUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *CategoryUpdateOne) defaults() {
+func (_u *CategoryUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if category.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized category.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := category.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *CategoryUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := category.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Category.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Name(); ok {
 		if err := category.NameValidator(v); err != nil {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Category.name": %w`, err)}
@@ -580,6 +695,18 @@ func (_u *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 	if _u.mutation.UpdatedByCleared() {
 		_spec.ClearField(category.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(category.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(category.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(category.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(category.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(category.FieldMetadata, field.TypeJSON, value)
 	}
@@ -15,16 +15,22 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/omkar273/nashikdarshan/ent/apikey"
 	"github.com/omkar273/nashikdarshan/ent/category"
 	"github.com/omkar273/nashikdarshan/ent/event"
 	"github.com/omkar273/nashikdarshan/ent/eventoccurrence"
 	"github.com/omkar273/nashikdarshan/ent/hotel"
 	"github.com/omkar273/nashikdarshan/ent/itinerary"
+	"github.com/omkar273/nashikdarshan/ent/outboxevent"
 	"github.com/omkar273/nashikdarshan/ent/place"
 	"github.com/omkar273/nashikdarshan/ent/placeimage"
+	"github.com/omkar273/nashikdarshan/ent/placerevision"
+	"github.com/omkar273/nashikdarshan/ent/placeslug"
 	"github.com/omkar273/nashikdarshan/ent/review"
 	"github.com/omkar273/nashikdarshan/ent/user"
 	"github.com/omkar273/nashikdarshan/ent/visit"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -32,6 +38,8 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
 	// Event is the client for interacting with the Event builders.
@@ -42,10 +50,16 @@ type Client struct {
 	Hotel *HotelClient
 	// Itinerary is the client for interacting with the Itinerary builders.
 	Itinerary *ItineraryClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
 	// Place is the client for interacting with the Place builders.
 	Place *PlaceClient
 	// PlaceImage is the client for interacting with the PlaceImage builders.
 	PlaceImage *PlaceImageClient
+	// PlaceRevision is the client for interacting with the PlaceRevision builders.
+	PlaceRevision *PlaceRevisionClient
+	// PlaceSlug is the client for interacting with the PlaceSlug builders.
+	PlaceSlug *PlaceSlugClient
 	// Review is the client for interacting with the Review builders.
 	Review *ReviewClient
 	// User is the client for interacting with the User builders.
@@ -63,13 +77,17 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APIKey = NewAPIKeyClient(c.config)
 	c.Category = NewCategoryClient(c.config)
 	c.Event = NewEventClient(c.config)
 	c.EventOccurrence = NewEventOccurrenceClient(c.config)
 	c.Hotel = NewHotelClient(c.config)
 	c.Itinerary = NewItineraryClient(c.config)
+	c.OutboxEvent = NewOutboxEventClient(c.config)
 	c.Place = NewPlaceClient(c.config)
 	c.PlaceImage = NewPlaceImageClient(c.config)
+	c.PlaceRevision = NewPlaceRevisionClient(c.config)
+	c.PlaceSlug = NewPlaceSlugClient(c.config)
 	c.Review = NewReviewClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.Visit = NewVisitClient(c.config)
@@ -165,13 +183,17 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:             ctx,
 		config:          cfg,
+		APIKey:          NewAPIKeyClient(cfg),
 		Category:        NewCategoryClient(cfg),
 		Event:           NewEventClient(cfg),
 		EventOccurrence: NewEventOccurrenceClient(cfg),
 		Hotel:           NewHotelClient(cfg),
 		Itinerary:       NewItineraryClient(cfg),
+		OutboxEvent:     NewOutboxEventClient(cfg),
 		Place:           NewPlaceClient(cfg),
 		PlaceImage:      NewPlaceImageClient(cfg),
+		PlaceRevision:   NewPlaceRevisionClient(cfg),
+		PlaceSlug:       NewPlaceSlugClient(cfg),
 		Review:          NewReviewClient(cfg),
 		User:            NewUserClient(cfg),
 		Visit:           NewVisitClient(cfg),
@@ -194,13 +216,17 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:             ctx,
 		config:          cfg,
+		APIKey:          NewAPIKeyClient(cfg),
 		Category:        NewCategoryClient(cfg),
 		Event:           NewEventClient(cfg),
 		EventOccurrence: NewEventOccurrenceClient(cfg),
 		Hotel:           NewHotelClient(cfg),
 		Itinerary:       NewItineraryClient(cfg),
+		OutboxEvent:     NewOutboxEventClient(cfg),
 		Place:           NewPlaceClient(cfg),
 		PlaceImage:      NewPlaceImageClient(cfg),
+		PlaceRevision:   NewPlaceRevisionClient(cfg),
+		PlaceSlug:       NewPlaceSlugClient(cfg),
 		Review:          NewReviewClient(cfg),
 		User:            NewUserClient(cfg),
 		Visit:           NewVisitClient(cfg),
@@ -210,7 +236,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Category.
+//		APIKey.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -233,8 +259,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Category, c.Event, c.EventOccurrence, c.Hotel, c.Itinerary, c.Place,
-		c.PlaceImage, c.Review, c.User, c.Visit,
+		c.APIKey, c.Category, c.Event, c.EventOccurrence, c.Hotel, c.Itinerary,
+		c.OutboxEvent, c.Place, c.PlaceImage, c.PlaceRevision, c.PlaceSlug, c.Review,
+		c.User, c.Visit,
 	} {
 		n.Use(hooks...)
 	}
@@ -244,8 +271,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Category, c.Event, c.EventOccurrence, c.Hotel, c.Itinerary, c.Place,
-		c.PlaceImage, c.Review, c.User, c.Visit,
+		c.APIKey, c.Category, c.Event, c.EventOccurrence, c.Hotel, c.Itinerary,
+		c.OutboxEvent, c.Place, c.PlaceImage, c.PlaceRevision, c.PlaceSlug, c.Review,
+		c.User, c.Visit,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -254,6 +282,8 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APIKeyMutation:
+		return c.APIKey.mutate(ctx, m)
 	case *CategoryMutation:
 		return c.Category.mutate(ctx, m)
 	case *EventMutation:
@@ -264,10 +294,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Hotel.mutate(ctx, m)
 	case *ItineraryMutation:
 		return c.Itinerary.mutate(ctx, m)
+	case *OutboxEventMutation:
+		return c.OutboxEvent.mutate(ctx, m)
 	case *PlaceMutation:
 		return c.Place.mutate(ctx, m)
 	case *PlaceImageMutation:
 		return c.PlaceImage.mutate(ctx, m)
+	case *PlaceRevisionMutation:
+		return c.PlaceRevision.mutate(ctx, m)
+	case *PlaceSlugMutation:
+		return c.PlaceSlug.mutate(ctx, m)
 	case *ReviewMutation:
 		return c.Review.mutate(ctx, m)
 	case *UserMutation:
@@ -279,6 +315,140 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// APIKeyClient is a client for the APIKey schema.
+type APIKeyClient struct {
+	config
+}
+
+// NewAPIKeyClient returns a client for the APIKey from the given config.
+func NewAPIKeyClient(c config) *APIKeyClient {
+	return &APIKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apikey.Hooks(f(g(h())))`.
+func (c *APIKeyClient) Use(hooks ...Hook) {
+	c.hooks.APIKey = append(c.hooks.APIKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apikey.Intercept(f(g(h())))`.
+func (c *APIKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIKey = append(c.inters.APIKey, interceptors...)
+}
+
+// Create returns a builder for creating a APIKey entity.
+func (c *APIKeyClient) Create() *APIKeyCreate {
+	mutation := newAPIKeyMutation(c.config, OpCreate)
+	return &APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of APIKey entities.
+func (c *APIKeyClient) CreateBulk(builders ...*APIKeyCreate) *APIKeyCreateBulk {
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *APIKeyClient) MapCreateBulk(slice any, setFunc func(*APIKeyCreate, int)) *APIKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &APIKeyCreateBulk{err: fmt.Errorf("calling to APIKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*APIKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for APIKey.
+func (c *APIKeyClient) Update() *APIKeyUpdate {
+	mutation := newAPIKeyMutation(c.config, OpUpdate)
+	return &APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *APIKeyClient) UpdateOne(_m *APIKey) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKey(_m))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *APIKeyClient) UpdateOneID(id string) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKeyID(id))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for APIKey.
+func (c *APIKeyClient) Delete() *APIKeyDelete {
+	mutation := newAPIKeyMutation(c.config, OpDelete)
+	return &APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *APIKeyClient) DeleteOne(_m *APIKey) *APIKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *APIKeyClient) DeleteOneID(id string) *APIKeyDeleteOne {
+	builder := c.Delete().Where(apikey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &APIKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for APIKey.
+func (c *APIKeyClient) Query() *APIKeyQuery {
+	return &APIKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAPIKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a APIKey entity by its id.
+func (c *APIKeyClient) Get(ctx context.Context, id string) (*APIKey, error) {
+	return c.Query().Where(apikey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *APIKeyClient) GetX(ctx context.Context, id string) *APIKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *APIKeyClient) Hooks() []Hook {
+	hooks := c.hooks.APIKey
+	return append(hooks[:len(hooks):len(hooks)], apikey.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *APIKeyClient) Interceptors() []Interceptor {
+	return c.inters.APIKey
+}
+
+func (c *APIKeyClient) mutate(ctx context.Context, m *APIKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown APIKey mutation op: %q", m.Op())
+	}
+}
+
 // CategoryClient is a client for the Category schema.
 type CategoryClient struct {
 	config
@@ -405,7 +575,8 @@ func (c *CategoryClient) QueryPlaces(_m *Category) *PlaceQuery {
 
 // Hooks returns the client hooks.
 func (c *CategoryClient) Hooks() []Hook {
-	return c.hooks.Category
+	hooks := c.hooks.Category
+	return append(hooks[:len(hooks):len(hooks)], category.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -554,7 +725,8 @@ func (c *EventClient) QueryOccurrences(_m *Event) *EventOccurrenceQuery {
 
 // Hooks returns the client hooks.
 func (c *EventClient) Hooks() []Hook {
-	return c.hooks.Event
+	hooks := c.hooks.Event
+	return append(hooks[:len(hooks):len(hooks)], event.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -703,7 +875,8 @@ func (c *EventOccurrenceClient) QueryEvent(_m *EventOccurrence) *EventQuery {
 
 // Hooks returns the client hooks.
 func (c *EventOccurrenceClient) Hooks() []Hook {
-	return c.hooks.EventOccurrence
+	hooks := c.hooks.EventOccurrence
+	return append(hooks[:len(hooks):len(hooks)], eventoccurrence.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -836,7 +1009,8 @@ func (c *HotelClient) GetX(ctx context.Context, id string) *Hotel {
 
 // Hooks returns the client hooks.
 func (c *HotelClient) Hooks() []Hook {
-	return c.hooks.Hotel
+	hooks := c.hooks.Hotel
+	return append(hooks[:len(hooks):len(hooks)], hotel.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -1001,7 +1175,8 @@ func (c *ItineraryClient) QueryVisits(_m *Itinerary) *VisitQuery {
 
 // Hooks returns the client hooks.
 func (c *ItineraryClient) Hooks() []Hook {
-	return c.hooks.Itinerary
+	hooks := c.hooks.Itinerary
+	return append(hooks[:len(hooks):len(hooks)], itinerary.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -1024,6 +1199,139 @@ func (c *ItineraryClient) mutate(ctx context.Context, m *ItineraryMutation) (Val
 	}
 }
 
+// OutboxEventClient is a client for the OutboxEvent schema.
+type OutboxEventClient struct {
+	config
+}
+
+// NewOutboxEventClient returns a client for the OutboxEvent from the given config.
+func NewOutboxEventClient(c config) *OutboxEventClient {
+	return &OutboxEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outboxevent.Hooks(f(g(h())))`.
+func (c *OutboxEventClient) Use(hooks ...Hook) {
+	c.hooks.OutboxEvent = append(c.hooks.OutboxEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outboxevent.Intercept(f(g(h())))`.
+func (c *OutboxEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutboxEvent = append(c.inters.OutboxEvent, interceptors...)
+}
+
+// Create returns a builder for creating a OutboxEvent entity.
+func (c *OutboxEventClient) Create() *OutboxEventCreate {
+	mutation := newOutboxEventMutation(c.config, OpCreate)
+	return &OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutboxEvent entities.
+func (c *OutboxEventClient) CreateBulk(builders ...*OutboxEventCreate) *OutboxEventCreateBulk {
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutboxEventClient) MapCreateBulk(slice any, setFunc func(*OutboxEventCreate, int)) *OutboxEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutboxEventCreateBulk{err: fmt.Errorf("calling to OutboxEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutboxEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutboxEvent.
+func (c *OutboxEventClient) Update() *OutboxEventUpdate {
+	mutation := newOutboxEventMutation(c.config, OpUpdate)
+	return &OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxEventClient) UpdateOne(_m *OutboxEvent) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEvent(_m))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxEventClient) UpdateOneID(id string) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEventID(id))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutboxEvent.
+func (c *OutboxEventClient) Delete() *OutboxEventDelete {
+	mutation := newOutboxEventMutation(c.config, OpDelete)
+	return &OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxEventClient) DeleteOne(_m *OutboxEvent) *OutboxEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxEventClient) DeleteOneID(id string) *OutboxEventDeleteOne {
+	builder := c.Delete().Where(outboxevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxEventDeleteOne{builder}
+}
+
+// Query returns a query builder for OutboxEvent.
+func (c *OutboxEventClient) Query() *OutboxEventQuery {
+	return &OutboxEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutboxEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OutboxEvent entity by its id.
+func (c *OutboxEventClient) Get(ctx context.Context, id string) (*OutboxEvent, error) {
+	return c.Query().Where(outboxevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxEventClient) GetX(ctx context.Context, id string) *OutboxEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxEventClient) Hooks() []Hook {
+	return c.hooks.OutboxEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxEventClient) Interceptors() []Interceptor {
+	return c.inters.OutboxEvent
+}
+
+func (c *OutboxEventClient) mutate(ctx context.Context, m *OutboxEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OutboxEvent mutation op: %q", m.Op())
+	}
+}
+
 // PlaceClient is a client for the Place schema.
 type PlaceClient struct {
 	config
@@ -1180,9 +1488,42 @@ func (c *PlaceClient) QueryVisits(_m *Place) *VisitQuery {
 	return query
 }
 
+// QueryRevisions queries the revisions edge of a Place.
+func (c *PlaceClient) QueryRevisions(_m *Place) *PlaceRevisionQuery {
+	query := (&PlaceRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(place.Table, place.FieldID, id),
+			sqlgraph.To(placerevision.Table, placerevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, place.RevisionsTable, place.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySlugHistory queries the slug_history edge of a Place.
+func (c *PlaceClient) QuerySlugHistory(_m *Place) *PlaceSlugQuery {
+	query := (&PlaceSlugClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(place.Table, place.FieldID, id),
+			sqlgraph.To(placeslug.Table, placeslug.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, place.SlugHistoryTable, place.SlugHistoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *PlaceClient) Hooks() []Hook {
-	return c.hooks.Place
+	hooks := c.hooks.Place
+	return append(hooks[:len(hooks):len(hooks)], place.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -1331,7 +1672,8 @@ func (c *PlaceImageClient) QueryPlace(_m *PlaceImage) *PlaceQuery {
 
 // Hooks returns the client hooks.
 func (c *PlaceImageClient) Hooks() []Hook {
-	return c.hooks.PlaceImage
+	hooks := c.hooks.PlaceImage
+	return append(hooks[:len(hooks):len(hooks)], placeimage.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -1354,6 +1696,306 @@ func (c *PlaceImageClient) mutate(ctx context.Context, m *PlaceImageMutation) (V
 	}
 }
 
+// PlaceRevisionClient is a client for the PlaceRevision schema.
+type PlaceRevisionClient struct {
+	config
+}
+
+// NewPlaceRevisionClient returns a client for the PlaceRevision from the given config.
+func NewPlaceRevisionClient(c config) *PlaceRevisionClient {
+	return &PlaceRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `placerevision.Hooks(f(g(h())))`.
+func (c *PlaceRevisionClient) Use(hooks ...Hook) {
+	c.hooks.PlaceRevision = append(c.hooks.PlaceRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `placerevision.Intercept(f(g(h())))`.
+func (c *PlaceRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PlaceRevision = append(c.inters.PlaceRevision, interceptors...)
+}
+
+// Create returns a builder for creating a PlaceRevision entity.
+func (c *PlaceRevisionClient) Create() *PlaceRevisionCreate {
+	mutation := newPlaceRevisionMutation(c.config, OpCreate)
+	return &PlaceRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PlaceRevision entities.
+func (c *PlaceRevisionClient) CreateBulk(builders ...*PlaceRevisionCreate) *PlaceRevisionCreateBulk {
+	return &PlaceRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlaceRevisionClient) MapCreateBulk(slice any, setFunc func(*PlaceRevisionCreate, int)) *PlaceRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlaceRevisionCreateBulk{err: fmt.Errorf("calling to PlaceRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlaceRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlaceRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PlaceRevision.
+func (c *PlaceRevisionClient) Update() *PlaceRevisionUpdate {
+	mutation := newPlaceRevisionMutation(c.config, OpUpdate)
+	return &PlaceRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlaceRevisionClient) UpdateOne(_m *PlaceRevision) *PlaceRevisionUpdateOne {
+	mutation := newPlaceRevisionMutation(c.config, OpUpdateOne, withPlaceRevision(_m))
+	return &PlaceRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlaceRevisionClient) UpdateOneID(id string) *PlaceRevisionUpdateOne {
+	mutation := newPlaceRevisionMutation(c.config, OpUpdateOne, withPlaceRevisionID(id))
+	return &PlaceRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PlaceRevision.
+func (c *PlaceRevisionClient) Delete() *PlaceRevisionDelete {
+	mutation := newPlaceRevisionMutation(c.config, OpDelete)
+	return &PlaceRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlaceRevisionClient) DeleteOne(_m *PlaceRevision) *PlaceRevisionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlaceRevisionClient) DeleteOneID(id string) *PlaceRevisionDeleteOne {
+	builder := c.Delete().Where(placerevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlaceRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for PlaceRevision.
+func (c *PlaceRevisionClient) Query() *PlaceRevisionQuery {
+	return &PlaceRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlaceRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PlaceRevision entity by its id.
+func (c *PlaceRevisionClient) Get(ctx context.Context, id string) (*PlaceRevision, error) {
+	return c.Query().Where(placerevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlaceRevisionClient) GetX(ctx context.Context, id string) *PlaceRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlace queries the place edge of a PlaceRevision.
+func (c *PlaceRevisionClient) QueryPlace(_m *PlaceRevision) *PlaceQuery {
+	query := (&PlaceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(placerevision.Table, placerevision.FieldID, id),
+			sqlgraph.To(place.Table, place.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, placerevision.PlaceTable, placerevision.PlaceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlaceRevisionClient) Hooks() []Hook {
+	hooks := c.hooks.PlaceRevision
+	return append(hooks[:len(hooks):len(hooks)], placerevision.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlaceRevisionClient) Interceptors() []Interceptor {
+	return c.inters.PlaceRevision
+}
+
+func (c *PlaceRevisionClient) mutate(ctx context.Context, m *PlaceRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlaceRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlaceRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlaceRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlaceRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PlaceRevision mutation op: %q", m.Op())
+	}
+}
+
+// PlaceSlugClient is a client for the PlaceSlug schema.
+type PlaceSlugClient struct {
+	config
+}
+
+// NewPlaceSlugClient returns a client for the PlaceSlug from the given config.
+func NewPlaceSlugClient(c config) *PlaceSlugClient {
+	return &PlaceSlugClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `placeslug.Hooks(f(g(h())))`.
+func (c *PlaceSlugClient) Use(hooks ...Hook) {
+	c.hooks.PlaceSlug = append(c.hooks.PlaceSlug, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `placeslug.Intercept(f(g(h())))`.
+func (c *PlaceSlugClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PlaceSlug = append(c.inters.PlaceSlug, interceptors...)
+}
+
+// Create returns a builder for creating a PlaceSlug entity.
+func (c *PlaceSlugClient) Create() *PlaceSlugCreate {
+	mutation := newPlaceSlugMutation(c.config, OpCreate)
+	return &PlaceSlugCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PlaceSlug entities.
+func (c *PlaceSlugClient) CreateBulk(builders ...*PlaceSlugCreate) *PlaceSlugCreateBulk {
+	return &PlaceSlugCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlaceSlugClient) MapCreateBulk(slice any, setFunc func(*PlaceSlugCreate, int)) *PlaceSlugCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlaceSlugCreateBulk{err: fmt.Errorf("calling to PlaceSlugClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlaceSlugCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlaceSlugCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PlaceSlug.
+func (c *PlaceSlugClient) Update() *PlaceSlugUpdate {
+	mutation := newPlaceSlugMutation(c.config, OpUpdate)
+	return &PlaceSlugUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlaceSlugClient) UpdateOne(_m *PlaceSlug) *PlaceSlugUpdateOne {
+	mutation := newPlaceSlugMutation(c.config, OpUpdateOne, withPlaceSlug(_m))
+	return &PlaceSlugUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlaceSlugClient) UpdateOneID(id string) *PlaceSlugUpdateOne {
+	mutation := newPlaceSlugMutation(c.config, OpUpdateOne, withPlaceSlugID(id))
+	return &PlaceSlugUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PlaceSlug.
+func (c *PlaceSlugClient) Delete() *PlaceSlugDelete {
+	mutation := newPlaceSlugMutation(c.config, OpDelete)
+	return &PlaceSlugDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlaceSlugClient) DeleteOne(_m *PlaceSlug) *PlaceSlugDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlaceSlugClient) DeleteOneID(id string) *PlaceSlugDeleteOne {
+	builder := c.Delete().Where(placeslug.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlaceSlugDeleteOne{builder}
+}
+
+// Query returns a query builder for PlaceSlug.
+func (c *PlaceSlugClient) Query() *PlaceSlugQuery {
+	return &PlaceSlugQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlaceSlug},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PlaceSlug entity by its id.
+func (c *PlaceSlugClient) Get(ctx context.Context, id string) (*PlaceSlug, error) {
+	return c.Query().Where(placeslug.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlaceSlugClient) GetX(ctx context.Context, id string) *PlaceSlug {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlace queries the place edge of a PlaceSlug.
+func (c *PlaceSlugClient) QueryPlace(_m *PlaceSlug) *PlaceQuery {
+	query := (&PlaceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(placeslug.Table, placeslug.FieldID, id),
+			sqlgraph.To(place.Table, place.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, placeslug.PlaceTable, placeslug.PlaceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlaceSlugClient) Hooks() []Hook {
+	hooks := c.hooks.PlaceSlug
+	return append(hooks[:len(hooks):len(hooks)], placeslug.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlaceSlugClient) Interceptors() []Interceptor {
+	return c.inters.PlaceSlug
+}
+
+func (c *PlaceSlugClient) mutate(ctx context.Context, m *PlaceSlugMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlaceSlugCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlaceSlugUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlaceSlugUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlaceSlugDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PlaceSlug mutation op: %q", m.Op())
+	}
+}
+
 // ReviewClient is a client for the Review schema.
 type ReviewClient struct {
 	config
@@ -1464,7 +2106,8 @@ func (c *ReviewClient) GetX(ctx context.Context, id string) *Review {
 
 // Hooks returns the client hooks.
 func (c *ReviewClient) Hooks() []Hook {
-	return c.hooks.Review
+	hooks := c.hooks.Review
+	return append(hooks[:len(hooks):len(hooks)], review.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -1613,7 +2256,8 @@ func (c *UserClient) QueryItineraries(_m *User) *ItineraryQuery {
 
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+	hooks := c.hooks.User
+	return append(hooks[:len(hooks):len(hooks)], user.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -1778,7 +2422,8 @@ func (c *VisitClient) QueryPlace(_m *Visit) *PlaceQuery {
 
 // Hooks returns the client hooks.
 func (c *VisitClient) Hooks() []Hook {
-	return c.hooks.Visit
+	hooks := c.hooks.Visit
+	return append(hooks[:len(hooks):len(hooks)], visit.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -1804,11 +2449,35 @@ func (c *VisitClient) mutate(ctx context.Context, m *VisitMutation) (Value, erro
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Category, Event, EventOccurrence, Hotel, Itinerary, Place, PlaceImage, Review,
-		User, Visit []ent.Hook
+		APIKey, Category, Event, EventOccurrence, Hotel, Itinerary, OutboxEvent, Place,
+		PlaceImage, PlaceRevision, PlaceSlug, Review, User, Visit []ent.Hook
 	}
 	inters struct {
-		Category, Event, EventOccurrence, Hotel, Itinerary, Place, PlaceImage, Review,
-		User, Visit []ent.Interceptor
+		APIKey, Category, Event, EventOccurrence, Hotel, Itinerary, OutboxEvent, Place,
+		PlaceImage, PlaceRevision, PlaceSlug, Review, User, Visit []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
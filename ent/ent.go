@@ -12,13 +12,17 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/omkar273/nashikdarshan/ent/apikey"
 	"github.com/omkar273/nashikdarshan/ent/category"
 	"github.com/omkar273/nashikdarshan/ent/event"
 	"github.com/omkar273/nashikdarshan/ent/eventoccurrence"
 	"github.com/omkar273/nashikdarshan/ent/hotel"
 	"github.com/omkar273/nashikdarshan/ent/itinerary"
+	"github.com/omkar273/nashikdarshan/ent/outboxevent"
 	"github.com/omkar273/nashikdarshan/ent/place"
 	"github.com/omkar273/nashikdarshan/ent/placeimage"
+	"github.com/omkar273/nashikdarshan/ent/placerevision"
+	"github.com/omkar273/nashikdarshan/ent/placeslug"
 	"github.com/omkar273/nashikdarshan/ent/review"
 	"github.com/omkar273/nashikdarshan/ent/user"
 	"github.com/omkar273/nashikdarshan/ent/visit"
@@ -82,13 +86,17 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			apikey.Table:          apikey.ValidColumn,
 			category.Table:        category.ValidColumn,
 			event.Table:           event.ValidColumn,
 			eventoccurrence.Table: eventoccurrence.ValidColumn,
 			hotel.Table:           hotel.ValidColumn,
 			itinerary.Table:       itinerary.ValidColumn,
+			outboxevent.Table:     outboxevent.ValidColumn,
 			place.Table:           place.ValidColumn,
 			placeimage.Table:      placeimage.ValidColumn,
+			placerevision.Table:   placerevision.ValidColumn,
+			placeslug.Table:       placeslug.ValidColumn,
 			review.Table:          review.ValidColumn,
 			user.Table:            user.ValidColumn,
 			visit.Table:           visit.ValidColumn,
@@ -8,10 +8,13 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/omkar273/nashikdarshan/ent/event"
 	"github.com/omkar273/nashikdarshan/ent/eventoccurrence"
+	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -20,16 +23,17 @@ type EventCreate struct {
 	config
 	mutation *EventMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetStatus sets the "status" field.
-func (_c *EventCreate) SetStatus(v string) *EventCreate {
+func (_c *EventCreate) SetStatus(v types.Status) *EventCreate {
 	_c.mutation.SetStatus(v)
 	return _c
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_c *EventCreate) SetNillableStatus(v *string) *EventCreate {
+func (_c *EventCreate) SetNillableStatus(v *types.Status) *EventCreate {
 	if v != nil {
 		_c.SetStatus(*v)
 	}
@@ -92,6 +96,34 @@ func (_c *EventCreate) SetNillableUpdatedBy(v *string) *EventCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *EventCreate) SetDeletedAt(v time.Time) *EventCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *EventCreate) SetNillableDeletedAt(v *time.Time) *EventCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *EventCreate) SetDeletedBy(v string) *EventCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *EventCreate) SetNillableDeletedBy(v *string) *EventCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
 // SetMetadata sets the "metadata" field.
 func (_c *EventCreate) SetMetadata(v map[string]string) *EventCreate {
 	_c.mutation.SetMetadata(v)
@@ -286,7 +318,9 @@ func (_c *EventCreate) Mutation() *EventMutation {
 
 // Save creates the Event in the database.
 func (_c *EventCreate) Save(ctx context.Context) (*Event, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -313,16 +347,22 @@ func (_c *EventCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *EventCreate) defaults() {
+func (_c *EventCreate) defaults() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		v := event.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if event.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized event.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := event.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if event.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized event.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := event.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
@@ -338,6 +378,7 @@ func (_c *EventCreate) defaults() {
 		v := event.DefaultInterestedCount
 		_c.mutation.SetInterestedCount(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -345,6 +386,11 @@ func (_c *EventCreate) check() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Event.status"`)}
 	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := event.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Event.status": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Event.created_at"`)}
 	}
@@ -440,6 +486,7 @@ func (_c *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 		_node = &Event{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(event.Table, sqlgraph.NewFieldSpec(event.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -464,6 +511,14 @@ func (_c *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 		_spec.SetField(event.FieldUpdatedBy, field.TypeString, value)
 		_node.UpdatedBy = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(event.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(event.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
 	if value, ok := _c.mutation.Metadata(); ok {
 		_spec.SetField(event.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -551,11 +606,910 @@ func (_c *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Event.Create().
+//		SetStatus(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EventUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EventCreate) OnConflict(opts ...sql.ConflictOption) *EventUpsertOne {
+	_c.conflict = opts
+	return &EventUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Event.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EventCreate) OnConflictColumns(columns ...string) *EventUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EventUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EventUpsertOne is the builder for "upsert"-ing
+	//  one Event node.
+	EventUpsertOne struct {
+		create *EventCreate
+	}
+
+	// EventUpsert is the "OnConflict" setter.
+	EventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *EventUpsert) SetStatus(v types.Status) *EventUpsert {
+	u.Set(event.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EventUpsert) UpdateStatus() *EventUpsert {
+	u.SetExcluded(event.FieldStatus)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EventUpsert) SetUpdatedAt(v time.Time) *EventUpsert {
+	u.Set(event.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EventUpsert) UpdateUpdatedAt() *EventUpsert {
+	u.SetExcluded(event.FieldUpdatedAt)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *EventUpsert) SetUpdatedBy(v string) *EventUpsert {
+	u.Set(event.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *EventUpsert) UpdateUpdatedBy() *EventUpsert {
+	u.SetExcluded(event.FieldUpdatedBy)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *EventUpsert) ClearUpdatedBy() *EventUpsert {
+	u.SetNull(event.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *EventUpsert) SetDeletedAt(v time.Time) *EventUpsert {
+	u.Set(event.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *EventUpsert) UpdateDeletedAt() *EventUpsert {
+	u.SetExcluded(event.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *EventUpsert) ClearDeletedAt() *EventUpsert {
+	u.SetNull(event.FieldDeletedAt)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *EventUpsert) SetDeletedBy(v string) *EventUpsert {
+	u.Set(event.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *EventUpsert) UpdateDeletedBy() *EventUpsert {
+	u.SetExcluded(event.FieldDeletedBy)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *EventUpsert) ClearDeletedBy() *EventUpsert {
+	u.SetNull(event.FieldDeletedBy)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *EventUpsert) SetMetadata(v map[string]string) *EventUpsert {
+	u.Set(event.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *EventUpsert) UpdateMetadata() *EventUpsert {
+	u.SetExcluded(event.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *EventUpsert) ClearMetadata() *EventUpsert {
+	u.SetNull(event.FieldMetadata)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *EventUpsert) SetType(v string) *EventUpsert {
+	u.Set(event.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *EventUpsert) UpdateType() *EventUpsert {
+	u.SetExcluded(event.FieldType)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *EventUpsert) SetTitle(v string) *EventUpsert {
+	u.Set(event.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *EventUpsert) UpdateTitle() *EventUpsert {
+	u.SetExcluded(event.FieldTitle)
+	return u
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (u *EventUpsert) SetSubtitle(v string) *EventUpsert {
+	u.Set(event.FieldSubtitle, v)
+	return u
+}
+
+// UpdateSubtitle sets the "subtitle" field to the value that was provided on create.
+func (u *EventUpsert) UpdateSubtitle() *EventUpsert {
+	u.SetExcluded(event.FieldSubtitle)
+	return u
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (u *EventUpsert) ClearSubtitle() *EventUpsert {
+	u.SetNull(event.FieldSubtitle)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *EventUpsert) SetDescription(v string) *EventUpsert {
+	u.Set(event.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *EventUpsert) UpdateDescription() *EventUpsert {
+	u.SetExcluded(event.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *EventUpsert) ClearDescription() *EventUpsert {
+	u.SetNull(event.FieldDescription)
+	return u
+}
+
+// SetPlaceID sets the "place_id" field.
+func (u *EventUpsert) SetPlaceID(v string) *EventUpsert {
+	u.Set(event.FieldPlaceID, v)
+	return u
+}
+
+// UpdatePlaceID sets the "place_id" field to the value that was provided on create.
+func (u *EventUpsert) UpdatePlaceID() *EventUpsert {
+	u.SetExcluded(event.FieldPlaceID)
+	return u
+}
+
+// ClearPlaceID clears the value of the "place_id" field.
+func (u *EventUpsert) ClearPlaceID() *EventUpsert {
+	u.SetNull(event.FieldPlaceID)
+	return u
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *EventUpsert) SetStartDate(v time.Time) *EventUpsert {
+	u.Set(event.FieldStartDate, v)
+	return u
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *EventUpsert) UpdateStartDate() *EventUpsert {
+	u.SetExcluded(event.FieldStartDate)
+	return u
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *EventUpsert) SetEndDate(v time.Time) *EventUpsert {
+	u.Set(event.FieldEndDate, v)
+	return u
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *EventUpsert) UpdateEndDate() *EventUpsert {
+	u.SetExcluded(event.FieldEndDate)
+	return u
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (u *EventUpsert) ClearEndDate() *EventUpsert {
+	u.SetNull(event.FieldEndDate)
+	return u
+}
+
+// SetCoverImageURL sets the "cover_image_url" field.
+func (u *EventUpsert) SetCoverImageURL(v string) *EventUpsert {
+	u.Set(event.FieldCoverImageURL, v)
+	return u
+}
+
+// UpdateCoverImageURL sets the "cover_image_url" field to the value that was provided on create.
+func (u *EventUpsert) UpdateCoverImageURL() *EventUpsert {
+	u.SetExcluded(event.FieldCoverImageURL)
+	return u
+}
+
+// ClearCoverImageURL clears the value of the "cover_image_url" field.
+func (u *EventUpsert) ClearCoverImageURL() *EventUpsert {
+	u.SetNull(event.FieldCoverImageURL)
+	return u
+}
+
+// SetImages sets the "images" field.
+func (u *EventUpsert) SetImages(v []string) *EventUpsert {
+	u.Set(event.FieldImages, v)
+	return u
+}
+
+// UpdateImages sets the "images" field to the value that was provided on create.
+func (u *EventUpsert) UpdateImages() *EventUpsert {
+	u.SetExcluded(event.FieldImages)
+	return u
+}
+
+// ClearImages clears the value of the "images" field.
+func (u *EventUpsert) ClearImages() *EventUpsert {
+	u.SetNull(event.FieldImages)
+	return u
+}
+
+// SetTags sets the "tags" field.
+func (u *EventUpsert) SetTags(v []string) *EventUpsert {
+	u.Set(event.FieldTags, v)
+	return u
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *EventUpsert) UpdateTags() *EventUpsert {
+	u.SetExcluded(event.FieldTags)
+	return u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *EventUpsert) ClearTags() *EventUpsert {
+	u.SetNull(event.FieldTags)
+	return u
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *EventUpsert) SetLatitude(v *decimal.Decimal) *EventUpsert {
+	u.Set(event.FieldLatitude, v)
+	return u
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *EventUpsert) UpdateLatitude() *EventUpsert {
+	u.SetExcluded(event.FieldLatitude)
+	return u
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (u *EventUpsert) ClearLatitude() *EventUpsert {
+	u.SetNull(event.FieldLatitude)
+	return u
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *EventUpsert) SetLongitude(v *decimal.Decimal) *EventUpsert {
+	u.Set(event.FieldLongitude, v)
+	return u
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *EventUpsert) UpdateLongitude() *EventUpsert {
+	u.SetExcluded(event.FieldLongitude)
+	return u
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (u *EventUpsert) ClearLongitude() *EventUpsert {
+	u.SetNull(event.FieldLongitude)
+	return u
+}
+
+// SetLocationName sets the "location_name" field.
+func (u *EventUpsert) SetLocationName(v string) *EventUpsert {
+	u.Set(event.FieldLocationName, v)
+	return u
+}
+
+// UpdateLocationName sets the "location_name" field to the value that was provided on create.
+func (u *EventUpsert) UpdateLocationName() *EventUpsert {
+	u.SetExcluded(event.FieldLocationName)
+	return u
+}
+
+// ClearLocationName clears the value of the "location_name" field.
+func (u *EventUpsert) ClearLocationName() *EventUpsert {
+	u.SetNull(event.FieldLocationName)
+	return u
+}
+
+// SetViewCount sets the "view_count" field.
+func (u *EventUpsert) SetViewCount(v int) *EventUpsert {
+	u.Set(event.FieldViewCount, v)
+	return u
+}
+
+// UpdateViewCount sets the "view_count" field to the value that was provided on create.
+func (u *EventUpsert) UpdateViewCount() *EventUpsert {
+	u.SetExcluded(event.FieldViewCount)
+	return u
+}
+
+// AddViewCount adds v to the "view_count" field.
+func (u *EventUpsert) AddViewCount(v int) *EventUpsert {
+	u.Add(event.FieldViewCount, v)
+	return u
+}
+
+// SetInterestedCount sets the "interested_count" field.
+func (u *EventUpsert) SetInterestedCount(v int) *EventUpsert {
+	u.Set(event.FieldInterestedCount, v)
+	return u
+}
+
+// UpdateInterestedCount sets the "interested_count" field to the value that was provided on create.
+func (u *EventUpsert) UpdateInterestedCount() *EventUpsert {
+	u.SetExcluded(event.FieldInterestedCount)
+	return u
+}
+
+// AddInterestedCount adds v to the "interested_count" field.
+func (u *EventUpsert) AddInterestedCount(v int) *EventUpsert {
+	u.Add(event.FieldInterestedCount, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Event.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(event.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EventUpsertOne) UpdateNewValues() *EventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(event.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(event.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(event.FieldCreatedBy)
+		}
+		if _, exists := u.create.mutation.Slug(); exists {
+			s.SetIgnore(event.FieldSlug)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Event.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EventUpsertOne) Ignore() *EventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EventUpsertOne) DoNothing() *EventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EventCreate.OnConflict
+// documentation for more info.
+func (u *EventUpsertOne) Update(set func(*EventUpsert)) *EventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *EventUpsertOne) SetStatus(v types.Status) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateStatus() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EventUpsertOne) SetUpdatedAt(v time.Time) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateUpdatedAt() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *EventUpsertOne) SetUpdatedBy(v string) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateUpdatedBy() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *EventUpsertOne) ClearUpdatedBy() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *EventUpsertOne) SetDeletedAt(v time.Time) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateDeletedAt() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *EventUpsertOne) ClearDeletedAt() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *EventUpsertOne) SetDeletedBy(v string) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateDeletedBy() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *EventUpsertOne) ClearDeletedBy() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *EventUpsertOne) SetMetadata(v map[string]string) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateMetadata() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *EventUpsertOne) ClearMetadata() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *EventUpsertOne) SetType(v string) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateType() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *EventUpsertOne) SetTitle(v string) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateTitle() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (u *EventUpsertOne) SetSubtitle(v string) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetSubtitle(v)
+	})
+}
+
+// UpdateSubtitle sets the "subtitle" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateSubtitle() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateSubtitle()
+	})
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (u *EventUpsertOne) ClearSubtitle() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearSubtitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *EventUpsertOne) SetDescription(v string) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateDescription() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *EventUpsertOne) ClearDescription() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetPlaceID sets the "place_id" field.
+func (u *EventUpsertOne) SetPlaceID(v string) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetPlaceID(v)
+	})
+}
+
+// UpdatePlaceID sets the "place_id" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdatePlaceID() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdatePlaceID()
+	})
+}
+
+// ClearPlaceID clears the value of the "place_id" field.
+func (u *EventUpsertOne) ClearPlaceID() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearPlaceID()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *EventUpsertOne) SetStartDate(v time.Time) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateStartDate() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *EventUpsertOne) SetEndDate(v time.Time) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateEndDate() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (u *EventUpsertOne) ClearEndDate() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearEndDate()
+	})
+}
+
+// SetCoverImageURL sets the "cover_image_url" field.
+func (u *EventUpsertOne) SetCoverImageURL(v string) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetCoverImageURL(v)
+	})
+}
+
+// UpdateCoverImageURL sets the "cover_image_url" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateCoverImageURL() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateCoverImageURL()
+	})
+}
+
+// ClearCoverImageURL clears the value of the "cover_image_url" field.
+func (u *EventUpsertOne) ClearCoverImageURL() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearCoverImageURL()
+	})
+}
+
+// SetImages sets the "images" field.
+func (u *EventUpsertOne) SetImages(v []string) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetImages(v)
+	})
+}
+
+// UpdateImages sets the "images" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateImages() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateImages()
+	})
+}
+
+// ClearImages clears the value of the "images" field.
+func (u *EventUpsertOne) ClearImages() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearImages()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *EventUpsertOne) SetTags(v []string) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateTags() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *EventUpsertOne) ClearTags() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearTags()
+	})
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *EventUpsertOne) SetLatitude(v *decimal.Decimal) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetLatitude(v)
+	})
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateLatitude() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateLatitude()
+	})
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (u *EventUpsertOne) ClearLatitude() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearLatitude()
+	})
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *EventUpsertOne) SetLongitude(v *decimal.Decimal) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetLongitude(v)
+	})
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateLongitude() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateLongitude()
+	})
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (u *EventUpsertOne) ClearLongitude() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearLongitude()
+	})
+}
+
+// SetLocationName sets the "location_name" field.
+func (u *EventUpsertOne) SetLocationName(v string) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetLocationName(v)
+	})
+}
+
+// UpdateLocationName sets the "location_name" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateLocationName() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateLocationName()
+	})
+}
+
+// ClearLocationName clears the value of the "location_name" field.
+func (u *EventUpsertOne) ClearLocationName() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearLocationName()
+	})
+}
+
+// SetViewCount sets the "view_count" field.
+func (u *EventUpsertOne) SetViewCount(v int) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetViewCount(v)
+	})
+}
+
+// AddViewCount adds v to the "view_count" field.
+func (u *EventUpsertOne) AddViewCount(v int) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.AddViewCount(v)
+	})
+}
+
+// UpdateViewCount sets the "view_count" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateViewCount() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateViewCount()
+	})
+}
+
+// SetInterestedCount sets the "interested_count" field.
+func (u *EventUpsertOne) SetInterestedCount(v int) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetInterestedCount(v)
+	})
+}
+
+// AddInterestedCount adds v to the "interested_count" field.
+func (u *EventUpsertOne) AddInterestedCount(v int) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.AddInterestedCount(v)
+	})
+}
+
+// UpdateInterestedCount sets the "interested_count" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateInterestedCount() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateInterestedCount()
+	})
+}
+
+// Exec executes the query.
+func (u *EventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EventUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EventUpsertOne.ID is not supported by MySQL driver. Use EventUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EventUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // EventCreateBulk is the builder for creating many Event entities in bulk.
 type EventCreateBulk struct {
 	config
 	err      error
 	builders []*EventCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Event entities in the database.
@@ -585,6 +1539,7 @@ func (_c *EventCreateBulk) Save(ctx context.Context) ([]*Event, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -634,3 +1589,532 @@ func (_c *EventCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Event.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EventUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EventCreateBulk) OnConflict(opts ...sql.ConflictOption) *EventUpsertBulk {
+	_c.conflict = opts
+	return &EventUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Event.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EventCreateBulk) OnConflictColumns(columns ...string) *EventUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EventUpsertBulk{
+		create: _c,
+	}
+}
+
+// EventUpsertBulk is the builder for "upsert"-ing
+// a bulk of Event nodes.
+type EventUpsertBulk struct {
+	create *EventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Event.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(event.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EventUpsertBulk) UpdateNewValues() *EventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(event.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(event.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(event.FieldCreatedBy)
+			}
+			if _, exists := b.mutation.Slug(); exists {
+				s.SetIgnore(event.FieldSlug)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Event.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EventUpsertBulk) Ignore() *EventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EventUpsertBulk) DoNothing() *EventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EventCreateBulk.OnConflict
+// documentation for more info.
+func (u *EventUpsertBulk) Update(set func(*EventUpsert)) *EventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *EventUpsertBulk) SetStatus(v types.Status) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateStatus() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EventUpsertBulk) SetUpdatedAt(v time.Time) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateUpdatedAt() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *EventUpsertBulk) SetUpdatedBy(v string) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateUpdatedBy() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *EventUpsertBulk) ClearUpdatedBy() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *EventUpsertBulk) SetDeletedAt(v time.Time) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateDeletedAt() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *EventUpsertBulk) ClearDeletedAt() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *EventUpsertBulk) SetDeletedBy(v string) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateDeletedBy() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *EventUpsertBulk) ClearDeletedBy() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *EventUpsertBulk) SetMetadata(v map[string]string) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateMetadata() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *EventUpsertBulk) ClearMetadata() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *EventUpsertBulk) SetType(v string) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateType() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *EventUpsertBulk) SetTitle(v string) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateTitle() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (u *EventUpsertBulk) SetSubtitle(v string) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetSubtitle(v)
+	})
+}
+
+// UpdateSubtitle sets the "subtitle" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateSubtitle() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateSubtitle()
+	})
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (u *EventUpsertBulk) ClearSubtitle() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearSubtitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *EventUpsertBulk) SetDescription(v string) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateDescription() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *EventUpsertBulk) ClearDescription() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetPlaceID sets the "place_id" field.
+func (u *EventUpsertBulk) SetPlaceID(v string) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetPlaceID(v)
+	})
+}
+
+// UpdatePlaceID sets the "place_id" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdatePlaceID() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdatePlaceID()
+	})
+}
+
+// ClearPlaceID clears the value of the "place_id" field.
+func (u *EventUpsertBulk) ClearPlaceID() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearPlaceID()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *EventUpsertBulk) SetStartDate(v time.Time) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateStartDate() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *EventUpsertBulk) SetEndDate(v time.Time) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateEndDate() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (u *EventUpsertBulk) ClearEndDate() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearEndDate()
+	})
+}
+
+// SetCoverImageURL sets the "cover_image_url" field.
+func (u *EventUpsertBulk) SetCoverImageURL(v string) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetCoverImageURL(v)
+	})
+}
+
+// UpdateCoverImageURL sets the "cover_image_url" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateCoverImageURL() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateCoverImageURL()
+	})
+}
+
+// ClearCoverImageURL clears the value of the "cover_image_url" field.
+func (u *EventUpsertBulk) ClearCoverImageURL() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearCoverImageURL()
+	})
+}
+
+// SetImages sets the "images" field.
+func (u *EventUpsertBulk) SetImages(v []string) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetImages(v)
+	})
+}
+
+// UpdateImages sets the "images" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateImages() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateImages()
+	})
+}
+
+// ClearImages clears the value of the "images" field.
+func (u *EventUpsertBulk) ClearImages() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearImages()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *EventUpsertBulk) SetTags(v []string) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateTags() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *EventUpsertBulk) ClearTags() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearTags()
+	})
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *EventUpsertBulk) SetLatitude(v *decimal.Decimal) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetLatitude(v)
+	})
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateLatitude() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateLatitude()
+	})
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (u *EventUpsertBulk) ClearLatitude() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearLatitude()
+	})
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *EventUpsertBulk) SetLongitude(v *decimal.Decimal) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetLongitude(v)
+	})
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateLongitude() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateLongitude()
+	})
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (u *EventUpsertBulk) ClearLongitude() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearLongitude()
+	})
+}
+
+// SetLocationName sets the "location_name" field.
+func (u *EventUpsertBulk) SetLocationName(v string) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetLocationName(v)
+	})
+}
+
+// UpdateLocationName sets the "location_name" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateLocationName() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateLocationName()
+	})
+}
+
+// ClearLocationName clears the value of the "location_name" field.
+func (u *EventUpsertBulk) ClearLocationName() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearLocationName()
+	})
+}
+
+// SetViewCount sets the "view_count" field.
+func (u *EventUpsertBulk) SetViewCount(v int) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetViewCount(v)
+	})
+}
+
+// AddViewCount adds v to the "view_count" field.
+func (u *EventUpsertBulk) AddViewCount(v int) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.AddViewCount(v)
+	})
+}
+
+// UpdateViewCount sets the "view_count" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateViewCount() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateViewCount()
+	})
+}
+
+// SetInterestedCount sets the "interested_count" field.
+func (u *EventUpsertBulk) SetInterestedCount(v int) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetInterestedCount(v)
+	})
+}
+
+// AddInterestedCount adds v to the "interested_count" field.
+func (u *EventUpsertBulk) AddInterestedCount(v int) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.AddInterestedCount(v)
+	})
+}
+
+// UpdateInterestedCount sets the "interested_count" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateInterestedCount() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateInterestedCount()
+	})
+}
+
+// Exec executes the query.
+func (u *EventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
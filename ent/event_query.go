@@ -299,7 +299,7 @@ func (_q *EventQuery) WithOccurrences(opts ...func(*EventOccurrenceQuery)) *Even
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Status types.Status `json:"status,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
@@ -322,7 +322,7 @@ func (_q *EventQuery) GroupBy(field string, fields ...string) *EventGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Status types.Status `json:"status,omitempty"`
 //	}
 //
 //	client.Event.Query().
@@ -15,6 +15,7 @@ import (
 	"github.com/omkar273/nashikdarshan/ent/event"
 	"github.com/omkar273/nashikdarshan/ent/eventoccurrence"
 	"github.com/omkar273/nashikdarshan/ent/predicate"
+	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -32,13 +33,13 @@ func (_u *EventUpdate) Where(ps ...predicate.Event) *EventUpdate {
 }
 
 // SetStatus sets the "status" field.
-func (_u *EventUpdate) SetStatus(v string) *EventUpdate {
+func (_u *EventUpdate) SetStatus(v types.Status) *EventUpdate {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *EventUpdate) SetNillableStatus(v *string) *EventUpdate {
+func (_u *EventUpdate) SetNillableStatus(v *types.Status) *EventUpdate {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -71,6 +72,46 @@ func (_u *EventUpdate) ClearUpdatedBy() *EventUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *EventUpdate) SetDeletedAt(v time.Time) *EventUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableDeletedAt(v *time.Time) *EventUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *EventUpdate) ClearDeletedAt() *EventUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *EventUpdate) SetDeletedBy(v string) *EventUpdate {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableDeletedBy(v *string) *EventUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *EventUpdate) ClearDeletedBy() *EventUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *EventUpdate) SetMetadata(v map[string]string) *EventUpdate {
 	_u.mutation.SetMetadata(v)
@@ -390,7 +431,9 @@ func (_u *EventUpdate) RemoveOccurrences(v ...*EventOccurrence) *EventUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *EventUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -417,15 +460,24 @@ func (_u *EventUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *EventUpdate) defaults() {
+func (_u *EventUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if event.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized event.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := event.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *EventUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := event.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Event.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.GetType(); ok {
 		if err := event.TypeValidator(v); err != nil {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Event.type": %w`, err)}
@@ -486,6 +538,18 @@ func (_u *EventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.UpdatedByCleared() {
 		_spec.ClearField(event.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(event.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(event.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(event.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(event.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(event.FieldMetadata, field.TypeJSON, value)
 	}
@@ -649,13 +713,13 @@ type EventUpdateOne struct {
 }
 
 // SetStatus sets the "status" field.
-func (_u *EventUpdateOne) SetStatus(v string) *EventUpdateOne {
+func (_u *EventUpdateOne) SetStatus(v types.Status) *EventUpdateOne {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *EventUpdateOne) SetNillableStatus(v *string) *EventUpdateOne {
+func (_u *EventUpdateOne) SetNillableStatus(v *types.Status) *EventUpdateOne {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -688,6 +752,46 @@ func (_u *EventUpdateOne) ClearUpdatedBy() *EventUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *EventUpdateOne) SetDeletedAt(v time.Time) *EventUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableDeletedAt(v *time.Time) *EventUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *EventUpdateOne) ClearDeletedAt() *EventUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *EventUpdateOne) SetDeletedBy(v string) *EventUpdateOne {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableDeletedBy(v *string) *EventUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *EventUpdateOne) ClearDeletedBy() *EventUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *EventUpdateOne) SetMetadata(v map[string]string) *EventUpdateOne {
 	_u.mutation.SetMetadata(v)
@@ -1020,7 +1124,9 @@ func (_u *EventUpdateOne) Select(field string, fields ...string) *EventUpdateOne
 
 // Save executes the query and returns the updated Event entity.
 func (_u *EventUpdateOne) Save(ctx context.Context) (*Event, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -1047,15 +1153,24 @@ func (_u *EventUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *EventUpdateOne) defaults() {
+func (_u *EventUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if event.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized event.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := event.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *EventUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := event.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Event.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.GetType(); ok {
 		if err := event.TypeValidator(v); err != nil {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Event.type": %w`, err)}
@@ -1133,6 +1248,18 @@ func (_u *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error)
 	if _u.mutation.UpdatedByCleared() {
 		_spec.ClearField(event.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(event.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(event.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(event.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(event.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(event.FieldMetadata, field.TypeJSON, value)
 	}
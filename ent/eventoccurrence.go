@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/omkar273/nashikdarshan/ent/event"
 	"github.com/omkar273/nashikdarshan/ent/eventoccurrence"
+	"github.com/omkar273/nashikdarshan/internal/types"
 )
 
 // EventOccurrence is the model entity for the EventOccurrence schema.
@@ -21,7 +22,7 @@ type EventOccurrence struct {
 	// Unique occurrence identifier with prefix occ_
 	ID string `json:"id,omitempty"`
 	// Status holds the value of the "status" field.
-	Status string `json:"status,omitempty"`
+	Status types.Status `json:"status,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -30,6 +31,10 @@ type EventOccurrence struct {
 	CreatedBy string `json:"created_by,omitempty"`
 	// UpdatedBy holds the value of the "updated_by" field.
 	UpdatedBy string `json:"updated_by,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// DeletedBy holds the value of the "deleted_by" field.
+	DeletedBy string `json:"deleted_by,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]string `json:"metadata,omitempty"`
 	// FK to parent event
@@ -85,10 +90,12 @@ func (*EventOccurrence) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case eventoccurrence.FieldDurationMinutes, eventoccurrence.FieldDayOfWeek, eventoccurrence.FieldDayOfMonth, eventoccurrence.FieldMonthOfYear:
 			values[i] = new(sql.NullInt64)
-		case eventoccurrence.FieldID, eventoccurrence.FieldStatus, eventoccurrence.FieldCreatedBy, eventoccurrence.FieldUpdatedBy, eventoccurrence.FieldEventID, eventoccurrence.FieldRecurrenceType:
+		case eventoccurrence.FieldID, eventoccurrence.FieldCreatedBy, eventoccurrence.FieldUpdatedBy, eventoccurrence.FieldDeletedBy, eventoccurrence.FieldEventID, eventoccurrence.FieldRecurrenceType:
 			values[i] = new(sql.NullString)
-		case eventoccurrence.FieldCreatedAt, eventoccurrence.FieldUpdatedAt, eventoccurrence.FieldStartTime, eventoccurrence.FieldEndTime:
+		case eventoccurrence.FieldCreatedAt, eventoccurrence.FieldUpdatedAt, eventoccurrence.FieldDeletedAt, eventoccurrence.FieldStartTime, eventoccurrence.FieldEndTime:
 			values[i] = new(sql.NullTime)
+		case eventoccurrence.FieldStatus:
+			values[i] = new(types.Status)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -111,10 +118,10 @@ func (_m *EventOccurrence) assignValues(columns []string, values []any) error {
 				_m.ID = value.String
 			}
 		case eventoccurrence.FieldStatus:
-			if value, ok := values[i].(*sql.NullString); !ok {
+			if value, ok := values[i].(*types.Status); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
-			} else if value.Valid {
-				_m.Status = value.String
+			} else if value != nil {
+				_m.Status = *value
 			}
 		case eventoccurrence.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -140,6 +147,19 @@ func (_m *EventOccurrence) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedBy = value.String
 			}
+		case eventoccurrence.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case eventoccurrence.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = value.String
+			}
 		case eventoccurrence.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -252,7 +272,7 @@ func (_m *EventOccurrence) String() string {
 	builder.WriteString("EventOccurrence(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("status=")
-	builder.WriteString(_m.Status)
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
@@ -266,6 +286,14 @@ func (_m *EventOccurrence) String() string {
 	builder.WriteString("updated_by=")
 	builder.WriteString(_m.UpdatedBy)
 	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(_m.DeletedBy)
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
 	builder.WriteString(", ")
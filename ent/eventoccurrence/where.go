@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/omkar273/nashikdarshan/ent/predicate"
+	"github.com/omkar273/nashikdarshan/internal/types"
 )
 
 // ID filters vertices based on their ID field.
@@ -66,7 +67,7 @@ func IDContainsFold(id string) predicate.EventOccurrence {
 }
 
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
-func Status(v string) predicate.EventOccurrence {
+func Status(v types.Status) predicate.EventOccurrence {
 	return predicate.EventOccurrence(sql.FieldEQ(FieldStatus, v))
 }
 
@@ -90,6 +91,16 @@ func UpdatedBy(v string) predicate.EventOccurrence {
 	return predicate.EventOccurrence(sql.FieldEQ(FieldUpdatedBy, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v string) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldEQ(FieldDeletedBy, v))
+}
+
 // EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
 func EventID(v string) predicate.EventOccurrence {
 	return predicate.EventOccurrence(sql.FieldEQ(FieldEventID, v))
@@ -131,68 +142,73 @@ func MonthOfYear(v int) predicate.EventOccurrence {
 }
 
 // StatusEQ applies the EQ predicate on the "status" field.
-func StatusEQ(v string) predicate.EventOccurrence {
+func StatusEQ(v types.Status) predicate.EventOccurrence {
 	return predicate.EventOccurrence(sql.FieldEQ(FieldStatus, v))
 }
 
 // StatusNEQ applies the NEQ predicate on the "status" field.
-func StatusNEQ(v string) predicate.EventOccurrence {
+func StatusNEQ(v types.Status) predicate.EventOccurrence {
 	return predicate.EventOccurrence(sql.FieldNEQ(FieldStatus, v))
 }
 
 // StatusIn applies the In predicate on the "status" field.
-func StatusIn(vs ...string) predicate.EventOccurrence {
+func StatusIn(vs ...types.Status) predicate.EventOccurrence {
 	return predicate.EventOccurrence(sql.FieldIn(FieldStatus, vs...))
 }
 
 // StatusNotIn applies the NotIn predicate on the "status" field.
-func StatusNotIn(vs ...string) predicate.EventOccurrence {
+func StatusNotIn(vs ...types.Status) predicate.EventOccurrence {
 	return predicate.EventOccurrence(sql.FieldNotIn(FieldStatus, vs...))
 }
 
 // StatusGT applies the GT predicate on the "status" field.
-func StatusGT(v string) predicate.EventOccurrence {
+func StatusGT(v types.Status) predicate.EventOccurrence {
 	return predicate.EventOccurrence(sql.FieldGT(FieldStatus, v))
 }
 
 // StatusGTE applies the GTE predicate on the "status" field.
-func StatusGTE(v string) predicate.EventOccurrence {
+func StatusGTE(v types.Status) predicate.EventOccurrence {
 	return predicate.EventOccurrence(sql.FieldGTE(FieldStatus, v))
 }
 
 // StatusLT applies the LT predicate on the "status" field.
-func StatusLT(v string) predicate.EventOccurrence {
+func StatusLT(v types.Status) predicate.EventOccurrence {
 	return predicate.EventOccurrence(sql.FieldLT(FieldStatus, v))
 }
 
 // StatusLTE applies the LTE predicate on the "status" field.
-func StatusLTE(v string) predicate.EventOccurrence {
+func StatusLTE(v types.Status) predicate.EventOccurrence {
 	return predicate.EventOccurrence(sql.FieldLTE(FieldStatus, v))
 }
 
 // StatusContains applies the Contains predicate on the "status" field.
-func StatusContains(v string) predicate.EventOccurrence {
-	return predicate.EventOccurrence(sql.FieldContains(FieldStatus, v))
+func StatusContains(v types.Status) predicate.EventOccurrence {
+	vc := string(v)
+	return predicate.EventOccurrence(sql.FieldContains(FieldStatus, vc))
 }
 
 // StatusHasPrefix applies the HasPrefix predicate on the "status" field.
-func StatusHasPrefix(v string) predicate.EventOccurrence {
-	return predicate.EventOccurrence(sql.FieldHasPrefix(FieldStatus, v))
+func StatusHasPrefix(v types.Status) predicate.EventOccurrence {
+	vc := string(v)
+	return predicate.EventOccurrence(sql.FieldHasPrefix(FieldStatus, vc))
 }
 
 // StatusHasSuffix applies the HasSuffix predicate on the "status" field.
-func StatusHasSuffix(v string) predicate.EventOccurrence {
-	return predicate.EventOccurrence(sql.FieldHasSuffix(FieldStatus, v))
+func StatusHasSuffix(v types.Status) predicate.EventOccurrence {
+	vc := string(v)
+	return predicate.EventOccurrence(sql.FieldHasSuffix(FieldStatus, vc))
 }
 
 // StatusEqualFold applies the EqualFold predicate on the "status" field.
-func StatusEqualFold(v string) predicate.EventOccurrence {
-	return predicate.EventOccurrence(sql.FieldEqualFold(FieldStatus, v))
+func StatusEqualFold(v types.Status) predicate.EventOccurrence {
+	vc := string(v)
+	return predicate.EventOccurrence(sql.FieldEqualFold(FieldStatus, vc))
 }
 
 // StatusContainsFold applies the ContainsFold predicate on the "status" field.
-func StatusContainsFold(v string) predicate.EventOccurrence {
-	return predicate.EventOccurrence(sql.FieldContainsFold(FieldStatus, v))
+func StatusContainsFold(v types.Status) predicate.EventOccurrence {
+	vc := string(v)
+	return predicate.EventOccurrence(sql.FieldContainsFold(FieldStatus, vc))
 }
 
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
@@ -425,6 +441,131 @@ func UpdatedByContainsFold(v string) predicate.EventOccurrence {
 	return predicate.EventOccurrence(sql.FieldContainsFold(FieldUpdatedBy, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v string) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v string) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...string) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...string) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v string) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v string) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v string) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v string) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByContains applies the Contains predicate on the "deleted_by" field.
+func DeletedByContains(v string) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldContains(FieldDeletedBy, v))
+}
+
+// DeletedByHasPrefix applies the HasPrefix predicate on the "deleted_by" field.
+func DeletedByHasPrefix(v string) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldHasPrefix(FieldDeletedBy, v))
+}
+
+// DeletedByHasSuffix applies the HasSuffix predicate on the "deleted_by" field.
+func DeletedByHasSuffix(v string) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldHasSuffix(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// DeletedByEqualFold applies the EqualFold predicate on the "deleted_by" field.
+func DeletedByEqualFold(v string) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldEqualFold(FieldDeletedBy, v))
+}
+
+// DeletedByContainsFold applies the ContainsFold predicate on the "deleted_by" field.
+func DeletedByContainsFold(v string) predicate.EventOccurrence {
+	return predicate.EventOccurrence(sql.FieldContainsFold(FieldDeletedBy, v))
+}
+
 // MetadataIsNil applies the IsNil predicate on the "metadata" field.
 func MetadataIsNil() predicate.EventOccurrence {
 	return predicate.EventOccurrence(sql.FieldIsNull(FieldMetadata))
@@ -8,10 +8,13 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/omkar273/nashikdarshan/ent/event"
 	"github.com/omkar273/nashikdarshan/ent/eventoccurrence"
+	"github.com/omkar273/nashikdarshan/internal/types"
 )
 
 // EventOccurrenceCreate is the builder for creating a EventOccurrence entity.
@@ -19,16 +22,17 @@ type EventOccurrenceCreate struct {
 	config
 	mutation *EventOccurrenceMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetStatus sets the "status" field.
-func (_c *EventOccurrenceCreate) SetStatus(v string) *EventOccurrenceCreate {
+func (_c *EventOccurrenceCreate) SetStatus(v types.Status) *EventOccurrenceCreate {
 	_c.mutation.SetStatus(v)
 	return _c
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_c *EventOccurrenceCreate) SetNillableStatus(v *string) *EventOccurrenceCreate {
+func (_c *EventOccurrenceCreate) SetNillableStatus(v *types.Status) *EventOccurrenceCreate {
 	if v != nil {
 		_c.SetStatus(*v)
 	}
@@ -91,6 +95,34 @@ func (_c *EventOccurrenceCreate) SetNillableUpdatedBy(v *string) *EventOccurrenc
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *EventOccurrenceCreate) SetDeletedAt(v time.Time) *EventOccurrenceCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *EventOccurrenceCreate) SetNillableDeletedAt(v *time.Time) *EventOccurrenceCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *EventOccurrenceCreate) SetDeletedBy(v string) *EventOccurrenceCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *EventOccurrenceCreate) SetNillableDeletedBy(v *string) *EventOccurrenceCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
 // SetMetadata sets the "metadata" field.
 func (_c *EventOccurrenceCreate) SetMetadata(v map[string]string) *EventOccurrenceCreate {
 	_c.mutation.SetMetadata(v)
@@ -217,7 +249,9 @@ func (_c *EventOccurrenceCreate) Mutation() *EventOccurrenceMutation {
 
 // Save creates the EventOccurrence in the database.
 func (_c *EventOccurrenceCreate) Save(ctx context.Context) (*EventOccurrence, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -244,16 +278,22 @@ func (_c *EventOccurrenceCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *EventOccurrenceCreate) defaults() {
+func (_c *EventOccurrenceCreate) defaults() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		v := eventoccurrence.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if eventoccurrence.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized eventoccurrence.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := eventoccurrence.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if eventoccurrence.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized eventoccurrence.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := eventoccurrence.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
@@ -261,6 +301,7 @@ func (_c *EventOccurrenceCreate) defaults() {
 		v := eventoccurrence.DefaultMetadata
 		_c.mutation.SetMetadata(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -268,6 +309,11 @@ func (_c *EventOccurrenceCreate) check() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "EventOccurrence.status"`)}
 	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := eventoccurrence.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EventOccurrence.status": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EventOccurrence.created_at"`)}
 	}
@@ -344,6 +390,7 @@ func (_c *EventOccurrenceCreate) createSpec() (*EventOccurrence, *sqlgraph.Creat
 		_node = &EventOccurrence{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(eventoccurrence.Table, sqlgraph.NewFieldSpec(eventoccurrence.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -368,6 +415,14 @@ func (_c *EventOccurrenceCreate) createSpec() (*EventOccurrence, *sqlgraph.Creat
 		_spec.SetField(eventoccurrence.FieldUpdatedBy, field.TypeString, value)
 		_node.UpdatedBy = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(eventoccurrence.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(eventoccurrence.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
 	if value, ok := _c.mutation.Metadata(); ok {
 		_spec.SetField(eventoccurrence.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -424,11 +479,738 @@ func (_c *EventOccurrenceCreate) createSpec() (*EventOccurrence, *sqlgraph.Creat
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EventOccurrence.Create().
+//		SetStatus(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EventOccurrenceUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EventOccurrenceCreate) OnConflict(opts ...sql.ConflictOption) *EventOccurrenceUpsertOne {
+	_c.conflict = opts
+	return &EventOccurrenceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EventOccurrence.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EventOccurrenceCreate) OnConflictColumns(columns ...string) *EventOccurrenceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EventOccurrenceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EventOccurrenceUpsertOne is the builder for "upsert"-ing
+	//  one EventOccurrence node.
+	EventOccurrenceUpsertOne struct {
+		create *EventOccurrenceCreate
+	}
+
+	// EventOccurrenceUpsert is the "OnConflict" setter.
+	EventOccurrenceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *EventOccurrenceUpsert) SetStatus(v types.Status) *EventOccurrenceUpsert {
+	u.Set(eventoccurrence.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EventOccurrenceUpsert) UpdateStatus() *EventOccurrenceUpsert {
+	u.SetExcluded(eventoccurrence.FieldStatus)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EventOccurrenceUpsert) SetUpdatedAt(v time.Time) *EventOccurrenceUpsert {
+	u.Set(eventoccurrence.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EventOccurrenceUpsert) UpdateUpdatedAt() *EventOccurrenceUpsert {
+	u.SetExcluded(eventoccurrence.FieldUpdatedAt)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *EventOccurrenceUpsert) SetUpdatedBy(v string) *EventOccurrenceUpsert {
+	u.Set(eventoccurrence.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *EventOccurrenceUpsert) UpdateUpdatedBy() *EventOccurrenceUpsert {
+	u.SetExcluded(eventoccurrence.FieldUpdatedBy)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *EventOccurrenceUpsert) ClearUpdatedBy() *EventOccurrenceUpsert {
+	u.SetNull(eventoccurrence.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *EventOccurrenceUpsert) SetDeletedAt(v time.Time) *EventOccurrenceUpsert {
+	u.Set(eventoccurrence.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *EventOccurrenceUpsert) UpdateDeletedAt() *EventOccurrenceUpsert {
+	u.SetExcluded(eventoccurrence.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *EventOccurrenceUpsert) ClearDeletedAt() *EventOccurrenceUpsert {
+	u.SetNull(eventoccurrence.FieldDeletedAt)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *EventOccurrenceUpsert) SetDeletedBy(v string) *EventOccurrenceUpsert {
+	u.Set(eventoccurrence.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *EventOccurrenceUpsert) UpdateDeletedBy() *EventOccurrenceUpsert {
+	u.SetExcluded(eventoccurrence.FieldDeletedBy)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *EventOccurrenceUpsert) ClearDeletedBy() *EventOccurrenceUpsert {
+	u.SetNull(eventoccurrence.FieldDeletedBy)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *EventOccurrenceUpsert) SetMetadata(v map[string]string) *EventOccurrenceUpsert {
+	u.Set(eventoccurrence.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *EventOccurrenceUpsert) UpdateMetadata() *EventOccurrenceUpsert {
+	u.SetExcluded(eventoccurrence.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *EventOccurrenceUpsert) ClearMetadata() *EventOccurrenceUpsert {
+	u.SetNull(eventoccurrence.FieldMetadata)
+	return u
+}
+
+// SetEventID sets the "event_id" field.
+func (u *EventOccurrenceUpsert) SetEventID(v string) *EventOccurrenceUpsert {
+	u.Set(eventoccurrence.FieldEventID, v)
+	return u
+}
+
+// UpdateEventID sets the "event_id" field to the value that was provided on create.
+func (u *EventOccurrenceUpsert) UpdateEventID() *EventOccurrenceUpsert {
+	u.SetExcluded(eventoccurrence.FieldEventID)
+	return u
+}
+
+// SetRecurrenceType sets the "recurrence_type" field.
+func (u *EventOccurrenceUpsert) SetRecurrenceType(v string) *EventOccurrenceUpsert {
+	u.Set(eventoccurrence.FieldRecurrenceType, v)
+	return u
+}
+
+// UpdateRecurrenceType sets the "recurrence_type" field to the value that was provided on create.
+func (u *EventOccurrenceUpsert) UpdateRecurrenceType() *EventOccurrenceUpsert {
+	u.SetExcluded(eventoccurrence.FieldRecurrenceType)
+	return u
+}
+
+// SetStartTime sets the "start_time" field.
+func (u *EventOccurrenceUpsert) SetStartTime(v time.Time) *EventOccurrenceUpsert {
+	u.Set(eventoccurrence.FieldStartTime, v)
+	return u
+}
+
+// UpdateStartTime sets the "start_time" field to the value that was provided on create.
+func (u *EventOccurrenceUpsert) UpdateStartTime() *EventOccurrenceUpsert {
+	u.SetExcluded(eventoccurrence.FieldStartTime)
+	return u
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (u *EventOccurrenceUpsert) ClearStartTime() *EventOccurrenceUpsert {
+	u.SetNull(eventoccurrence.FieldStartTime)
+	return u
+}
+
+// SetEndTime sets the "end_time" field.
+func (u *EventOccurrenceUpsert) SetEndTime(v time.Time) *EventOccurrenceUpsert {
+	u.Set(eventoccurrence.FieldEndTime, v)
+	return u
+}
+
+// UpdateEndTime sets the "end_time" field to the value that was provided on create.
+func (u *EventOccurrenceUpsert) UpdateEndTime() *EventOccurrenceUpsert {
+	u.SetExcluded(eventoccurrence.FieldEndTime)
+	return u
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (u *EventOccurrenceUpsert) ClearEndTime() *EventOccurrenceUpsert {
+	u.SetNull(eventoccurrence.FieldEndTime)
+	return u
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (u *EventOccurrenceUpsert) SetDurationMinutes(v int) *EventOccurrenceUpsert {
+	u.Set(eventoccurrence.FieldDurationMinutes, v)
+	return u
+}
+
+// UpdateDurationMinutes sets the "duration_minutes" field to the value that was provided on create.
+func (u *EventOccurrenceUpsert) UpdateDurationMinutes() *EventOccurrenceUpsert {
+	u.SetExcluded(eventoccurrence.FieldDurationMinutes)
+	return u
+}
+
+// AddDurationMinutes adds v to the "duration_minutes" field.
+func (u *EventOccurrenceUpsert) AddDurationMinutes(v int) *EventOccurrenceUpsert {
+	u.Add(eventoccurrence.FieldDurationMinutes, v)
+	return u
+}
+
+// ClearDurationMinutes clears the value of the "duration_minutes" field.
+func (u *EventOccurrenceUpsert) ClearDurationMinutes() *EventOccurrenceUpsert {
+	u.SetNull(eventoccurrence.FieldDurationMinutes)
+	return u
+}
+
+// SetDayOfWeek sets the "day_of_week" field.
+func (u *EventOccurrenceUpsert) SetDayOfWeek(v int) *EventOccurrenceUpsert {
+	u.Set(eventoccurrence.FieldDayOfWeek, v)
+	return u
+}
+
+// UpdateDayOfWeek sets the "day_of_week" field to the value that was provided on create.
+func (u *EventOccurrenceUpsert) UpdateDayOfWeek() *EventOccurrenceUpsert {
+	u.SetExcluded(eventoccurrence.FieldDayOfWeek)
+	return u
+}
+
+// AddDayOfWeek adds v to the "day_of_week" field.
+func (u *EventOccurrenceUpsert) AddDayOfWeek(v int) *EventOccurrenceUpsert {
+	u.Add(eventoccurrence.FieldDayOfWeek, v)
+	return u
+}
+
+// ClearDayOfWeek clears the value of the "day_of_week" field.
+func (u *EventOccurrenceUpsert) ClearDayOfWeek() *EventOccurrenceUpsert {
+	u.SetNull(eventoccurrence.FieldDayOfWeek)
+	return u
+}
+
+// SetDayOfMonth sets the "day_of_month" field.
+func (u *EventOccurrenceUpsert) SetDayOfMonth(v int) *EventOccurrenceUpsert {
+	u.Set(eventoccurrence.FieldDayOfMonth, v)
+	return u
+}
+
+// UpdateDayOfMonth sets the "day_of_month" field to the value that was provided on create.
+func (u *EventOccurrenceUpsert) UpdateDayOfMonth() *EventOccurrenceUpsert {
+	u.SetExcluded(eventoccurrence.FieldDayOfMonth)
+	return u
+}
+
+// AddDayOfMonth adds v to the "day_of_month" field.
+func (u *EventOccurrenceUpsert) AddDayOfMonth(v int) *EventOccurrenceUpsert {
+	u.Add(eventoccurrence.FieldDayOfMonth, v)
+	return u
+}
+
+// ClearDayOfMonth clears the value of the "day_of_month" field.
+func (u *EventOccurrenceUpsert) ClearDayOfMonth() *EventOccurrenceUpsert {
+	u.SetNull(eventoccurrence.FieldDayOfMonth)
+	return u
+}
+
+// SetMonthOfYear sets the "month_of_year" field.
+func (u *EventOccurrenceUpsert) SetMonthOfYear(v int) *EventOccurrenceUpsert {
+	u.Set(eventoccurrence.FieldMonthOfYear, v)
+	return u
+}
+
+// UpdateMonthOfYear sets the "month_of_year" field to the value that was provided on create.
+func (u *EventOccurrenceUpsert) UpdateMonthOfYear() *EventOccurrenceUpsert {
+	u.SetExcluded(eventoccurrence.FieldMonthOfYear)
+	return u
+}
+
+// AddMonthOfYear adds v to the "month_of_year" field.
+func (u *EventOccurrenceUpsert) AddMonthOfYear(v int) *EventOccurrenceUpsert {
+	u.Add(eventoccurrence.FieldMonthOfYear, v)
+	return u
+}
+
+// ClearMonthOfYear clears the value of the "month_of_year" field.
+func (u *EventOccurrenceUpsert) ClearMonthOfYear() *EventOccurrenceUpsert {
+	u.SetNull(eventoccurrence.FieldMonthOfYear)
+	return u
+}
+
+// SetExceptionDates sets the "exception_dates" field.
+func (u *EventOccurrenceUpsert) SetExceptionDates(v []string) *EventOccurrenceUpsert {
+	u.Set(eventoccurrence.FieldExceptionDates, v)
+	return u
+}
+
+// UpdateExceptionDates sets the "exception_dates" field to the value that was provided on create.
+func (u *EventOccurrenceUpsert) UpdateExceptionDates() *EventOccurrenceUpsert {
+	u.SetExcluded(eventoccurrence.FieldExceptionDates)
+	return u
+}
+
+// ClearExceptionDates clears the value of the "exception_dates" field.
+func (u *EventOccurrenceUpsert) ClearExceptionDates() *EventOccurrenceUpsert {
+	u.SetNull(eventoccurrence.FieldExceptionDates)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EventOccurrence.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(eventoccurrence.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EventOccurrenceUpsertOne) UpdateNewValues() *EventOccurrenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(eventoccurrence.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(eventoccurrence.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(eventoccurrence.FieldCreatedBy)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EventOccurrence.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EventOccurrenceUpsertOne) Ignore() *EventOccurrenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EventOccurrenceUpsertOne) DoNothing() *EventOccurrenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EventOccurrenceCreate.OnConflict
+// documentation for more info.
+func (u *EventOccurrenceUpsertOne) Update(set func(*EventOccurrenceUpsert)) *EventOccurrenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EventOccurrenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *EventOccurrenceUpsertOne) SetStatus(v types.Status) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertOne) UpdateStatus() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EventOccurrenceUpsertOne) SetUpdatedAt(v time.Time) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertOne) UpdateUpdatedAt() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *EventOccurrenceUpsertOne) SetUpdatedBy(v string) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertOne) UpdateUpdatedBy() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *EventOccurrenceUpsertOne) ClearUpdatedBy() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *EventOccurrenceUpsertOne) SetDeletedAt(v time.Time) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertOne) UpdateDeletedAt() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *EventOccurrenceUpsertOne) ClearDeletedAt() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *EventOccurrenceUpsertOne) SetDeletedBy(v string) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertOne) UpdateDeletedBy() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *EventOccurrenceUpsertOne) ClearDeletedBy() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *EventOccurrenceUpsertOne) SetMetadata(v map[string]string) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertOne) UpdateMetadata() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *EventOccurrenceUpsertOne) ClearMetadata() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetEventID sets the "event_id" field.
+func (u *EventOccurrenceUpsertOne) SetEventID(v string) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetEventID(v)
+	})
+}
+
+// UpdateEventID sets the "event_id" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertOne) UpdateEventID() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateEventID()
+	})
+}
+
+// SetRecurrenceType sets the "recurrence_type" field.
+func (u *EventOccurrenceUpsertOne) SetRecurrenceType(v string) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetRecurrenceType(v)
+	})
+}
+
+// UpdateRecurrenceType sets the "recurrence_type" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertOne) UpdateRecurrenceType() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateRecurrenceType()
+	})
+}
+
+// SetStartTime sets the "start_time" field.
+func (u *EventOccurrenceUpsertOne) SetStartTime(v time.Time) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "start_time" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertOne) UpdateStartTime() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (u *EventOccurrenceUpsertOne) ClearStartTime() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearStartTime()
+	})
+}
+
+// SetEndTime sets the "end_time" field.
+func (u *EventOccurrenceUpsertOne) SetEndTime(v time.Time) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "end_time" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertOne) UpdateEndTime() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (u *EventOccurrenceUpsertOne) ClearEndTime() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearEndTime()
+	})
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (u *EventOccurrenceUpsertOne) SetDurationMinutes(v int) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetDurationMinutes(v)
+	})
+}
+
+// AddDurationMinutes adds v to the "duration_minutes" field.
+func (u *EventOccurrenceUpsertOne) AddDurationMinutes(v int) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.AddDurationMinutes(v)
+	})
+}
+
+// UpdateDurationMinutes sets the "duration_minutes" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertOne) UpdateDurationMinutes() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateDurationMinutes()
+	})
+}
+
+// ClearDurationMinutes clears the value of the "duration_minutes" field.
+func (u *EventOccurrenceUpsertOne) ClearDurationMinutes() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearDurationMinutes()
+	})
+}
+
+// SetDayOfWeek sets the "day_of_week" field.
+func (u *EventOccurrenceUpsertOne) SetDayOfWeek(v int) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetDayOfWeek(v)
+	})
+}
+
+// AddDayOfWeek adds v to the "day_of_week" field.
+func (u *EventOccurrenceUpsertOne) AddDayOfWeek(v int) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.AddDayOfWeek(v)
+	})
+}
+
+// UpdateDayOfWeek sets the "day_of_week" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertOne) UpdateDayOfWeek() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateDayOfWeek()
+	})
+}
+
+// ClearDayOfWeek clears the value of the "day_of_week" field.
+func (u *EventOccurrenceUpsertOne) ClearDayOfWeek() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearDayOfWeek()
+	})
+}
+
+// SetDayOfMonth sets the "day_of_month" field.
+func (u *EventOccurrenceUpsertOne) SetDayOfMonth(v int) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetDayOfMonth(v)
+	})
+}
+
+// AddDayOfMonth adds v to the "day_of_month" field.
+func (u *EventOccurrenceUpsertOne) AddDayOfMonth(v int) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.AddDayOfMonth(v)
+	})
+}
+
+// UpdateDayOfMonth sets the "day_of_month" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertOne) UpdateDayOfMonth() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateDayOfMonth()
+	})
+}
+
+// ClearDayOfMonth clears the value of the "day_of_month" field.
+func (u *EventOccurrenceUpsertOne) ClearDayOfMonth() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearDayOfMonth()
+	})
+}
+
+// SetMonthOfYear sets the "month_of_year" field.
+func (u *EventOccurrenceUpsertOne) SetMonthOfYear(v int) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetMonthOfYear(v)
+	})
+}
+
+// AddMonthOfYear adds v to the "month_of_year" field.
+func (u *EventOccurrenceUpsertOne) AddMonthOfYear(v int) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.AddMonthOfYear(v)
+	})
+}
+
+// UpdateMonthOfYear sets the "month_of_year" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertOne) UpdateMonthOfYear() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateMonthOfYear()
+	})
+}
+
+// ClearMonthOfYear clears the value of the "month_of_year" field.
+func (u *EventOccurrenceUpsertOne) ClearMonthOfYear() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearMonthOfYear()
+	})
+}
+
+// SetExceptionDates sets the "exception_dates" field.
+func (u *EventOccurrenceUpsertOne) SetExceptionDates(v []string) *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetExceptionDates(v)
+	})
+}
+
+// UpdateExceptionDates sets the "exception_dates" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertOne) UpdateExceptionDates() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateExceptionDates()
+	})
+}
+
+// ClearExceptionDates clears the value of the "exception_dates" field.
+func (u *EventOccurrenceUpsertOne) ClearExceptionDates() *EventOccurrenceUpsertOne {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearExceptionDates()
+	})
+}
+
+// Exec executes the query.
+func (u *EventOccurrenceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EventOccurrenceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EventOccurrenceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EventOccurrenceUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EventOccurrenceUpsertOne.ID is not supported by MySQL driver. Use EventOccurrenceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EventOccurrenceUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // EventOccurrenceCreateBulk is the builder for creating many EventOccurrence entities in bulk.
 type EventOccurrenceCreateBulk struct {
 	config
 	err      error
 	builders []*EventOccurrenceCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the EventOccurrence entities in the database.
@@ -458,6 +1240,7 @@ func (_c *EventOccurrenceCreateBulk) Save(ctx context.Context) ([]*EventOccurren
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -507,3 +1290,438 @@ func (_c *EventOccurrenceCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EventOccurrence.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EventOccurrenceUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EventOccurrenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *EventOccurrenceUpsertBulk {
+	_c.conflict = opts
+	return &EventOccurrenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EventOccurrence.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EventOccurrenceCreateBulk) OnConflictColumns(columns ...string) *EventOccurrenceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EventOccurrenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// EventOccurrenceUpsertBulk is the builder for "upsert"-ing
+// a bulk of EventOccurrence nodes.
+type EventOccurrenceUpsertBulk struct {
+	create *EventOccurrenceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EventOccurrence.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(eventoccurrence.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EventOccurrenceUpsertBulk) UpdateNewValues() *EventOccurrenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(eventoccurrence.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(eventoccurrence.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(eventoccurrence.FieldCreatedBy)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EventOccurrence.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EventOccurrenceUpsertBulk) Ignore() *EventOccurrenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EventOccurrenceUpsertBulk) DoNothing() *EventOccurrenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EventOccurrenceCreateBulk.OnConflict
+// documentation for more info.
+func (u *EventOccurrenceUpsertBulk) Update(set func(*EventOccurrenceUpsert)) *EventOccurrenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EventOccurrenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *EventOccurrenceUpsertBulk) SetStatus(v types.Status) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertBulk) UpdateStatus() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EventOccurrenceUpsertBulk) SetUpdatedAt(v time.Time) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertBulk) UpdateUpdatedAt() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *EventOccurrenceUpsertBulk) SetUpdatedBy(v string) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertBulk) UpdateUpdatedBy() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *EventOccurrenceUpsertBulk) ClearUpdatedBy() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *EventOccurrenceUpsertBulk) SetDeletedAt(v time.Time) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertBulk) UpdateDeletedAt() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *EventOccurrenceUpsertBulk) ClearDeletedAt() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *EventOccurrenceUpsertBulk) SetDeletedBy(v string) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertBulk) UpdateDeletedBy() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *EventOccurrenceUpsertBulk) ClearDeletedBy() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *EventOccurrenceUpsertBulk) SetMetadata(v map[string]string) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertBulk) UpdateMetadata() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *EventOccurrenceUpsertBulk) ClearMetadata() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetEventID sets the "event_id" field.
+func (u *EventOccurrenceUpsertBulk) SetEventID(v string) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetEventID(v)
+	})
+}
+
+// UpdateEventID sets the "event_id" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertBulk) UpdateEventID() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateEventID()
+	})
+}
+
+// SetRecurrenceType sets the "recurrence_type" field.
+func (u *EventOccurrenceUpsertBulk) SetRecurrenceType(v string) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetRecurrenceType(v)
+	})
+}
+
+// UpdateRecurrenceType sets the "recurrence_type" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertBulk) UpdateRecurrenceType() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateRecurrenceType()
+	})
+}
+
+// SetStartTime sets the "start_time" field.
+func (u *EventOccurrenceUpsertBulk) SetStartTime(v time.Time) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "start_time" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertBulk) UpdateStartTime() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (u *EventOccurrenceUpsertBulk) ClearStartTime() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearStartTime()
+	})
+}
+
+// SetEndTime sets the "end_time" field.
+func (u *EventOccurrenceUpsertBulk) SetEndTime(v time.Time) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "end_time" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertBulk) UpdateEndTime() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (u *EventOccurrenceUpsertBulk) ClearEndTime() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearEndTime()
+	})
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (u *EventOccurrenceUpsertBulk) SetDurationMinutes(v int) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetDurationMinutes(v)
+	})
+}
+
+// AddDurationMinutes adds v to the "duration_minutes" field.
+func (u *EventOccurrenceUpsertBulk) AddDurationMinutes(v int) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.AddDurationMinutes(v)
+	})
+}
+
+// UpdateDurationMinutes sets the "duration_minutes" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertBulk) UpdateDurationMinutes() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateDurationMinutes()
+	})
+}
+
+// ClearDurationMinutes clears the value of the "duration_minutes" field.
+func (u *EventOccurrenceUpsertBulk) ClearDurationMinutes() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearDurationMinutes()
+	})
+}
+
+// SetDayOfWeek sets the "day_of_week" field.
+func (u *EventOccurrenceUpsertBulk) SetDayOfWeek(v int) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetDayOfWeek(v)
+	})
+}
+
+// AddDayOfWeek adds v to the "day_of_week" field.
+func (u *EventOccurrenceUpsertBulk) AddDayOfWeek(v int) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.AddDayOfWeek(v)
+	})
+}
+
+// UpdateDayOfWeek sets the "day_of_week" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertBulk) UpdateDayOfWeek() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateDayOfWeek()
+	})
+}
+
+// ClearDayOfWeek clears the value of the "day_of_week" field.
+func (u *EventOccurrenceUpsertBulk) ClearDayOfWeek() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearDayOfWeek()
+	})
+}
+
+// SetDayOfMonth sets the "day_of_month" field.
+func (u *EventOccurrenceUpsertBulk) SetDayOfMonth(v int) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetDayOfMonth(v)
+	})
+}
+
+// AddDayOfMonth adds v to the "day_of_month" field.
+func (u *EventOccurrenceUpsertBulk) AddDayOfMonth(v int) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.AddDayOfMonth(v)
+	})
+}
+
+// UpdateDayOfMonth sets the "day_of_month" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertBulk) UpdateDayOfMonth() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateDayOfMonth()
+	})
+}
+
+// ClearDayOfMonth clears the value of the "day_of_month" field.
+func (u *EventOccurrenceUpsertBulk) ClearDayOfMonth() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearDayOfMonth()
+	})
+}
+
+// SetMonthOfYear sets the "month_of_year" field.
+func (u *EventOccurrenceUpsertBulk) SetMonthOfYear(v int) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetMonthOfYear(v)
+	})
+}
+
+// AddMonthOfYear adds v to the "month_of_year" field.
+func (u *EventOccurrenceUpsertBulk) AddMonthOfYear(v int) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.AddMonthOfYear(v)
+	})
+}
+
+// UpdateMonthOfYear sets the "month_of_year" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertBulk) UpdateMonthOfYear() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateMonthOfYear()
+	})
+}
+
+// ClearMonthOfYear clears the value of the "month_of_year" field.
+func (u *EventOccurrenceUpsertBulk) ClearMonthOfYear() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearMonthOfYear()
+	})
+}
+
+// SetExceptionDates sets the "exception_dates" field.
+func (u *EventOccurrenceUpsertBulk) SetExceptionDates(v []string) *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.SetExceptionDates(v)
+	})
+}
+
+// UpdateExceptionDates sets the "exception_dates" field to the value that was provided on create.
+func (u *EventOccurrenceUpsertBulk) UpdateExceptionDates() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.UpdateExceptionDates()
+	})
+}
+
+// ClearExceptionDates clears the value of the "exception_dates" field.
+func (u *EventOccurrenceUpsertBulk) ClearExceptionDates() *EventOccurrenceUpsertBulk {
+	return u.Update(func(s *EventOccurrenceUpsert) {
+		s.ClearExceptionDates()
+	})
+}
+
+// Exec executes the query.
+func (u *EventOccurrenceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EventOccurrenceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EventOccurrenceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EventOccurrenceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -298,7 +298,7 @@ func (_q *EventOccurrenceQuery) WithEvent(opts ...func(*EventQuery)) *EventOccur
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Status types.Status `json:"status,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
@@ -321,7 +321,7 @@ func (_q *EventOccurrenceQuery) GroupBy(field string, fields ...string) *EventOc
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Status types.Status `json:"status,omitempty"`
 //	}
 //
 //	client.EventOccurrence.Query().
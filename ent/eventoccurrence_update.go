@@ -15,6 +15,7 @@ import (
 	"github.com/omkar273/nashikdarshan/ent/event"
 	"github.com/omkar273/nashikdarshan/ent/eventoccurrence"
 	"github.com/omkar273/nashikdarshan/ent/predicate"
+	"github.com/omkar273/nashikdarshan/internal/types"
 )
 
 // EventOccurrenceUpdate is the builder for updating EventOccurrence entities.
@@ -31,13 +32,13 @@ func (_u *EventOccurrenceUpdate) Where(ps ...predicate.EventOccurrence) *EventOc
 }
 
 // SetStatus sets the "status" field.
-func (_u *EventOccurrenceUpdate) SetStatus(v string) *EventOccurrenceUpdate {
+func (_u *EventOccurrenceUpdate) SetStatus(v types.Status) *EventOccurrenceUpdate {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *EventOccurrenceUpdate) SetNillableStatus(v *string) *EventOccurrenceUpdate {
+func (_u *EventOccurrenceUpdate) SetNillableStatus(v *types.Status) *EventOccurrenceUpdate {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -70,6 +71,46 @@ func (_u *EventOccurrenceUpdate) ClearUpdatedBy() *EventOccurrenceUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *EventOccurrenceUpdate) SetDeletedAt(v time.Time) *EventOccurrenceUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *EventOccurrenceUpdate) SetNillableDeletedAt(v *time.Time) *EventOccurrenceUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *EventOccurrenceUpdate) ClearDeletedAt() *EventOccurrenceUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *EventOccurrenceUpdate) SetDeletedBy(v string) *EventOccurrenceUpdate {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *EventOccurrenceUpdate) SetNillableDeletedBy(v *string) *EventOccurrenceUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *EventOccurrenceUpdate) ClearDeletedBy() *EventOccurrenceUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *EventOccurrenceUpdate) SetMetadata(v map[string]string) *EventOccurrenceUpdate {
 	_u.mutation.SetMetadata(v)
@@ -294,7 +335,9 @@ func (_u *EventOccurrenceUpdate) ClearEvent() *EventOccurrenceUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *EventOccurrenceUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -321,15 +364,24 @@ func (_u *EventOccurrenceUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *EventOccurrenceUpdate) defaults() {
+func (_u *EventOccurrenceUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if eventoccurrence.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized eventoccurrence.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := eventoccurrence.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *EventOccurrenceUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := eventoccurrence.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EventOccurrence.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.EventID(); ok {
 		if err := eventoccurrence.EventIDValidator(v); err != nil {
 			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "EventOccurrence.event_id": %w`, err)}
@@ -388,6 +440,18 @@ func (_u *EventOccurrenceUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if _u.mutation.UpdatedByCleared() {
 		_spec.ClearField(eventoccurrence.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(eventoccurrence.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(eventoccurrence.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(eventoccurrence.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(eventoccurrence.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(eventoccurrence.FieldMetadata, field.TypeJSON, value)
 	}
@@ -506,13 +570,13 @@ type EventOccurrenceUpdateOne struct {
 }
 
 // SetStatus sets the "status" field.
-func (_u *EventOccurrenceUpdateOne) SetStatus(v string) *EventOccurrenceUpdateOne {
+func (_u *EventOccurrenceUpdateOne) SetStatus(v types.Status) *EventOccurrenceUpdateOne {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *EventOccurrenceUpdateOne) SetNillableStatus(v *string) *EventOccurrenceUpdateOne {
+func (_u *EventOccurrenceUpdateOne) SetNillableStatus(v *types.Status) *EventOccurrenceUpdateOne {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -545,6 +609,46 @@ func (_u *EventOccurrenceUpdateOne) ClearUpdatedBy() *EventOccurrenceUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *EventOccurrenceUpdateOne) SetDeletedAt(v time.Time) *EventOccurrenceUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *EventOccurrenceUpdateOne) SetNillableDeletedAt(v *time.Time) *EventOccurrenceUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *EventOccurrenceUpdateOne) ClearDeletedAt() *EventOccurrenceUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *EventOccurrenceUpdateOne) SetDeletedBy(v string) *EventOccurrenceUpdateOne {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *EventOccurrenceUpdateOne) SetNillableDeletedBy(v *string) *EventOccurrenceUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *EventOccurrenceUpdateOne) ClearDeletedBy() *EventOccurrenceUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *EventOccurrenceUpdateOne) SetMetadata(v map[string]string) *EventOccurrenceUpdateOne {
 	_u.mutation.SetMetadata(v)
@@ -782,7 +886,9 @@ func (_u *EventOccurrenceUpdateOne) Select(field string, fields ...string) *Even
 
 // Save executes the query and returns the updated EventOccurrence entity.
 func (_u *EventOccurrenceUpdateOne) Save(ctx context.Context) (*EventOccurrence, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -809,15 +915,24 @@ func (_u *EventOccurrenceUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *EventOccurrenceUpdateOne) defaults() {
+func (_u *EventOccurrenceUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if eventoccurrence.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized eventoccurrence.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := eventoccurrence.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *EventOccurrenceUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := eventoccurrence.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EventOccurrence.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.EventID(); ok {
 		if err := eventoccurrence.EventIDValidator(v); err != nil {
 			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "EventOccurrence.event_id": %w`, err)}
@@ -893,6 +1008,18 @@ func (_u *EventOccurrenceUpdateOne) sqlSave(ctx context.Context) (_node *EventOc
 	if _u.mutation.UpdatedByCleared() {
 		_spec.ClearField(eventoccurrence.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(eventoccurrence.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(eventoccurrence.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(eventoccurrence.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(eventoccurrence.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(eventoccurrence.FieldMetadata, field.TypeJSON, value)
 	}
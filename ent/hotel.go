@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/omkar273/nashikdarshan/ent/hotel"
+	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -20,7 +21,7 @@ type Hotel struct {
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
 	// Status holds the value of the "status" field.
-	Status string `json:"status,omitempty"`
+	Status types.Status `json:"status,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -29,6 +30,10 @@ type Hotel struct {
 	CreatedBy string `json:"created_by,omitempty"`
 	// UpdatedBy holds the value of the "updated_by" field.
 	UpdatedBy string `json:"updated_by,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// DeletedBy holds the value of the "deleted_by" field.
+	DeletedBy string `json:"deleted_by,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]string `json:"metadata,omitempty"`
 	// Slug holds the value of the "slug" field.
@@ -91,10 +96,12 @@ func (*Hotel) scanValues(columns []string) ([]any, error) {
 			values[i] = new(decimal.Decimal)
 		case hotel.FieldStarRating, hotel.FieldRoomCount, hotel.FieldViewCount, hotel.FieldRatingCount:
 			values[i] = new(sql.NullInt64)
-		case hotel.FieldID, hotel.FieldStatus, hotel.FieldCreatedBy, hotel.FieldUpdatedBy, hotel.FieldSlug, hotel.FieldName, hotel.FieldDescription, hotel.FieldPhone, hotel.FieldEmail, hotel.FieldWebsite, hotel.FieldPrimaryImageURL, hotel.FieldThumbnailURL, hotel.FieldCurrency:
+		case hotel.FieldID, hotel.FieldCreatedBy, hotel.FieldUpdatedBy, hotel.FieldDeletedBy, hotel.FieldSlug, hotel.FieldName, hotel.FieldDescription, hotel.FieldPhone, hotel.FieldEmail, hotel.FieldWebsite, hotel.FieldPrimaryImageURL, hotel.FieldThumbnailURL, hotel.FieldCurrency:
 			values[i] = new(sql.NullString)
-		case hotel.FieldCreatedAt, hotel.FieldUpdatedAt, hotel.FieldCheckInTime, hotel.FieldCheckOutTime, hotel.FieldLastViewedAt:
+		case hotel.FieldCreatedAt, hotel.FieldUpdatedAt, hotel.FieldDeletedAt, hotel.FieldCheckInTime, hotel.FieldCheckOutTime, hotel.FieldLastViewedAt:
 			values[i] = new(sql.NullTime)
+		case hotel.FieldStatus:
+			values[i] = new(types.Status)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -117,10 +124,10 @@ func (_m *Hotel) assignValues(columns []string, values []any) error {
 				_m.ID = value.String
 			}
 		case hotel.FieldStatus:
-			if value, ok := values[i].(*sql.NullString); !ok {
+			if value, ok := values[i].(*types.Status); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
-			} else if value.Valid {
-				_m.Status = value.String
+			} else if value != nil {
+				_m.Status = *value
 			}
 		case hotel.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -146,6 +153,19 @@ func (_m *Hotel) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedBy = value.String
 			}
+		case hotel.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case hotel.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = value.String
+			}
 		case hotel.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -331,7 +351,7 @@ func (_m *Hotel) String() string {
 	builder.WriteString("Hotel(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("status=")
-	builder.WriteString(_m.Status)
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
@@ -345,6 +365,14 @@ func (_m *Hotel) String() string {
 	builder.WriteString("updated_by=")
 	builder.WriteString(_m.UpdatedBy)
 	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(_m.DeletedBy)
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
 	builder.WriteString(", ")
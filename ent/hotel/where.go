@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/omkar273/nashikdarshan/ent/predicate"
+	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -66,7 +67,7 @@ func IDContainsFold(id string) predicate.Hotel {
 }
 
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
-func Status(v string) predicate.Hotel {
+func Status(v types.Status) predicate.Hotel {
 	return predicate.Hotel(sql.FieldEQ(FieldStatus, v))
 }
 
@@ -90,6 +91,16 @@ func UpdatedBy(v string) predicate.Hotel {
 	return predicate.Hotel(sql.FieldEQ(FieldUpdatedBy, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Hotel {
+	return predicate.Hotel(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v string) predicate.Hotel {
+	return predicate.Hotel(sql.FieldEQ(FieldDeletedBy, v))
+}
+
 // Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
 func Slug(v string) predicate.Hotel {
 	return predicate.Hotel(sql.FieldEQ(FieldSlug, v))
@@ -201,68 +212,73 @@ func PopularityScore(v decimal.Decimal) predicate.Hotel {
 }
 
 // StatusEQ applies the EQ predicate on the "status" field.
-func StatusEQ(v string) predicate.Hotel {
+func StatusEQ(v types.Status) predicate.Hotel {
 	return predicate.Hotel(sql.FieldEQ(FieldStatus, v))
 }
 
 // StatusNEQ applies the NEQ predicate on the "status" field.
-func StatusNEQ(v string) predicate.Hotel {
+func StatusNEQ(v types.Status) predicate.Hotel {
 	return predicate.Hotel(sql.FieldNEQ(FieldStatus, v))
 }
 
 // StatusIn applies the In predicate on the "status" field.
-func StatusIn(vs ...string) predicate.Hotel {
+func StatusIn(vs ...types.Status) predicate.Hotel {
 	return predicate.Hotel(sql.FieldIn(FieldStatus, vs...))
 }
 
 // StatusNotIn applies the NotIn predicate on the "status" field.
-func StatusNotIn(vs ...string) predicate.Hotel {
+func StatusNotIn(vs ...types.Status) predicate.Hotel {
 	return predicate.Hotel(sql.FieldNotIn(FieldStatus, vs...))
 }
 
 // StatusGT applies the GT predicate on the "status" field.
-func StatusGT(v string) predicate.Hotel {
+func StatusGT(v types.Status) predicate.Hotel {
 	return predicate.Hotel(sql.FieldGT(FieldStatus, v))
 }
 
 // StatusGTE applies the GTE predicate on the "status" field.
-func StatusGTE(v string) predicate.Hotel {
+func StatusGTE(v types.Status) predicate.Hotel {
 	return predicate.Hotel(sql.FieldGTE(FieldStatus, v))
 }
 
 // StatusLT applies the LT predicate on the "status" field.
-func StatusLT(v string) predicate.Hotel {
+func StatusLT(v types.Status) predicate.Hotel {
 	return predicate.Hotel(sql.FieldLT(FieldStatus, v))
 }
 
 // StatusLTE applies the LTE predicate on the "status" field.
-func StatusLTE(v string) predicate.Hotel {
+func StatusLTE(v types.Status) predicate.Hotel {
 	return predicate.Hotel(sql.FieldLTE(FieldStatus, v))
 }
 
 // StatusContains applies the Contains predicate on the "status" field.
-func StatusContains(v string) predicate.Hotel {
-	return predicate.Hotel(sql.FieldContains(FieldStatus, v))
+func StatusContains(v types.Status) predicate.Hotel {
+	vc := string(v)
+	return predicate.Hotel(sql.FieldContains(FieldStatus, vc))
 }
 
 // StatusHasPrefix applies the HasPrefix predicate on the "status" field.
-func StatusHasPrefix(v string) predicate.Hotel {
-	return predicate.Hotel(sql.FieldHasPrefix(FieldStatus, v))
+func StatusHasPrefix(v types.Status) predicate.Hotel {
+	vc := string(v)
+	return predicate.Hotel(sql.FieldHasPrefix(FieldStatus, vc))
 }
 
 // StatusHasSuffix applies the HasSuffix predicate on the "status" field.
-func StatusHasSuffix(v string) predicate.Hotel {
-	return predicate.Hotel(sql.FieldHasSuffix(FieldStatus, v))
+func StatusHasSuffix(v types.Status) predicate.Hotel {
+	vc := string(v)
+	return predicate.Hotel(sql.FieldHasSuffix(FieldStatus, vc))
 }
 
 // StatusEqualFold applies the EqualFold predicate on the "status" field.
-func StatusEqualFold(v string) predicate.Hotel {
-	return predicate.Hotel(sql.FieldEqualFold(FieldStatus, v))
+func StatusEqualFold(v types.Status) predicate.Hotel {
+	vc := string(v)
+	return predicate.Hotel(sql.FieldEqualFold(FieldStatus, vc))
 }
 
 // StatusContainsFold applies the ContainsFold predicate on the "status" field.
-func StatusContainsFold(v string) predicate.Hotel {
-	return predicate.Hotel(sql.FieldContainsFold(FieldStatus, v))
+func StatusContainsFold(v types.Status) predicate.Hotel {
+	vc := string(v)
+	return predicate.Hotel(sql.FieldContainsFold(FieldStatus, vc))
 }
 
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
@@ -495,6 +511,131 @@ func UpdatedByContainsFold(v string) predicate.Hotel {
 	return predicate.Hotel(sql.FieldContainsFold(FieldUpdatedBy, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Hotel {
+	return predicate.Hotel(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Hotel {
+	return predicate.Hotel(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Hotel {
+	return predicate.Hotel(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Hotel {
+	return predicate.Hotel(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Hotel {
+	return predicate.Hotel(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Hotel {
+	return predicate.Hotel(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Hotel {
+	return predicate.Hotel(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Hotel {
+	return predicate.Hotel(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Hotel {
+	return predicate.Hotel(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Hotel {
+	return predicate.Hotel(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v string) predicate.Hotel {
+	return predicate.Hotel(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v string) predicate.Hotel {
+	return predicate.Hotel(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...string) predicate.Hotel {
+	return predicate.Hotel(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...string) predicate.Hotel {
+	return predicate.Hotel(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v string) predicate.Hotel {
+	return predicate.Hotel(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v string) predicate.Hotel {
+	return predicate.Hotel(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v string) predicate.Hotel {
+	return predicate.Hotel(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v string) predicate.Hotel {
+	return predicate.Hotel(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByContains applies the Contains predicate on the "deleted_by" field.
+func DeletedByContains(v string) predicate.Hotel {
+	return predicate.Hotel(sql.FieldContains(FieldDeletedBy, v))
+}
+
+// DeletedByHasPrefix applies the HasPrefix predicate on the "deleted_by" field.
+func DeletedByHasPrefix(v string) predicate.Hotel {
+	return predicate.Hotel(sql.FieldHasPrefix(FieldDeletedBy, v))
+}
+
+// DeletedByHasSuffix applies the HasSuffix predicate on the "deleted_by" field.
+func DeletedByHasSuffix(v string) predicate.Hotel {
+	return predicate.Hotel(sql.FieldHasSuffix(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.Hotel {
+	return predicate.Hotel(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.Hotel {
+	return predicate.Hotel(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// DeletedByEqualFold applies the EqualFold predicate on the "deleted_by" field.
+func DeletedByEqualFold(v string) predicate.Hotel {
+	return predicate.Hotel(sql.FieldEqualFold(FieldDeletedBy, v))
+}
+
+// DeletedByContainsFold applies the ContainsFold predicate on the "deleted_by" field.
+func DeletedByContainsFold(v string) predicate.Hotel {
+	return predicate.Hotel(sql.FieldContainsFold(FieldDeletedBy, v))
+}
+
 // MetadataIsNil applies the IsNil predicate on the "metadata" field.
 func MetadataIsNil() predicate.Hotel {
 	return predicate.Hotel(sql.FieldIsNull(FieldMetadata))
@@ -8,9 +8,12 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/omkar273/nashikdarshan/ent/hotel"
+	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -19,16 +22,17 @@ type HotelCreate struct {
 	config
 	mutation *HotelMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetStatus sets the "status" field.
-func (_c *HotelCreate) SetStatus(v string) *HotelCreate {
+func (_c *HotelCreate) SetStatus(v types.Status) *HotelCreate {
 	_c.mutation.SetStatus(v)
 	return _c
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_c *HotelCreate) SetNillableStatus(v *string) *HotelCreate {
+func (_c *HotelCreate) SetNillableStatus(v *types.Status) *HotelCreate {
 	if v != nil {
 		_c.SetStatus(*v)
 	}
@@ -91,6 +95,34 @@ func (_c *HotelCreate) SetNillableUpdatedBy(v *string) *HotelCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *HotelCreate) SetDeletedAt(v time.Time) *HotelCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *HotelCreate) SetNillableDeletedAt(v *time.Time) *HotelCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *HotelCreate) SetDeletedBy(v string) *HotelCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *HotelCreate) SetNillableDeletedBy(v *string) *HotelCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
 // SetMetadata sets the "metadata" field.
 func (_c *HotelCreate) SetMetadata(v map[string]string) *HotelCreate {
 	_c.mutation.SetMetadata(v)
@@ -416,7 +448,9 @@ func (_c *HotelCreate) Mutation() *HotelMutation {
 
 // Save creates the Hotel in the database.
 func (_c *HotelCreate) Save(ctx context.Context) (*Hotel, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -443,16 +477,22 @@ func (_c *HotelCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *HotelCreate) defaults() {
+func (_c *HotelCreate) defaults() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		v := hotel.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if hotel.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized hotel.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := hotel.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if hotel.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized hotel.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := hotel.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
@@ -505,9 +545,13 @@ func (_c *HotelCreate) defaults() {
 		_c.mutation.SetPopularityScore(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if hotel.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized hotel.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := hotel.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -515,6 +559,11 @@ func (_c *HotelCreate) check() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Hotel.status"`)}
 	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := hotel.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Hotel.status": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Hotel.created_at"`)}
 	}
@@ -612,6 +661,7 @@ func (_c *HotelCreate) createSpec() (*Hotel, *sqlgraph.CreateSpec) {
 		_node = &Hotel{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(hotel.Table, sqlgraph.NewFieldSpec(hotel.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -636,6 +686,14 @@ func (_c *HotelCreate) createSpec() (*Hotel, *sqlgraph.CreateSpec) {
 		_spec.SetField(hotel.FieldUpdatedBy, field.TypeString, value)
 		_node.UpdatedBy = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(hotel.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(hotel.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
 	if value, ok := _c.mutation.Metadata(); ok {
 		_spec.SetField(hotel.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -735,86 +793,1895 @@ func (_c *HotelCreate) createSpec() (*Hotel, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
-// HotelCreateBulk is the builder for creating many Hotel entities in bulk.
-type HotelCreateBulk struct {
-	config
-	err      error
-	builders []*HotelCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Hotel.Create().
+//		SetStatus(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HotelUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *HotelCreate) OnConflict(opts ...sql.ConflictOption) *HotelUpsertOne {
+	_c.conflict = opts
+	return &HotelUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Hotel.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *HotelCreate) OnConflictColumns(columns ...string) *HotelUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &HotelUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// HotelUpsertOne is the builder for "upsert"-ing
+	//  one Hotel node.
+	HotelUpsertOne struct {
+		create *HotelCreate
+	}
+
+	// HotelUpsert is the "OnConflict" setter.
+	HotelUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *HotelUpsert) SetStatus(v types.Status) *HotelUpsert {
+	u.Set(hotel.FieldStatus, v)
+	return u
 }
 
-// Save creates the Hotel entities in the database.
-func (_c *HotelCreateBulk) Save(ctx context.Context) ([]*Hotel, error) {
-	if _c.err != nil {
-		return nil, _c.err
-	}
-	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
-	nodes := make([]*Hotel, len(_c.builders))
-	mutators := make([]Mutator, len(_c.builders))
-	for i := range _c.builders {
-		func(i int, root context.Context) {
-			builder := _c.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*HotelMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
-	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
-			return nil, err
-		}
-	}
-	return nodes, nil
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateStatus() *HotelUpsert {
+	u.SetExcluded(hotel.FieldStatus)
+	return u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_c *HotelCreateBulk) SaveX(ctx context.Context) []*Hotel {
-	v, err := _c.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+// SetUpdatedAt sets the "updated_at" field.
+func (u *HotelUpsert) SetUpdatedAt(v time.Time) *HotelUpsert {
+	u.Set(hotel.FieldUpdatedAt, v)
+	return u
 }
 
-// Exec executes the query.
-func (_c *HotelCreateBulk) Exec(ctx context.Context) error {
-	_, err := _c.Save(ctx)
-	return err
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateUpdatedAt() *HotelUpsert {
+	u.SetExcluded(hotel.FieldUpdatedAt)
+	return u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_c *HotelCreateBulk) ExecX(ctx context.Context) {
-	if err := _c.Exec(ctx); err != nil {
+// SetUpdatedBy sets the "updated_by" field.
+func (u *HotelUpsert) SetUpdatedBy(v string) *HotelUpsert {
+	u.Set(hotel.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateUpdatedBy() *HotelUpsert {
+	u.SetExcluded(hotel.FieldUpdatedBy)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *HotelUpsert) ClearUpdatedBy() *HotelUpsert {
+	u.SetNull(hotel.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *HotelUpsert) SetDeletedAt(v time.Time) *HotelUpsert {
+	u.Set(hotel.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateDeletedAt() *HotelUpsert {
+	u.SetExcluded(hotel.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *HotelUpsert) ClearDeletedAt() *HotelUpsert {
+	u.SetNull(hotel.FieldDeletedAt)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *HotelUpsert) SetDeletedBy(v string) *HotelUpsert {
+	u.Set(hotel.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateDeletedBy() *HotelUpsert {
+	u.SetExcluded(hotel.FieldDeletedBy)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *HotelUpsert) ClearDeletedBy() *HotelUpsert {
+	u.SetNull(hotel.FieldDeletedBy)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *HotelUpsert) SetMetadata(v map[string]string) *HotelUpsert {
+	u.Set(hotel.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateMetadata() *HotelUpsert {
+	u.SetExcluded(hotel.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *HotelUpsert) ClearMetadata() *HotelUpsert {
+	u.SetNull(hotel.FieldMetadata)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *HotelUpsert) SetName(v string) *HotelUpsert {
+	u.Set(hotel.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateName() *HotelUpsert {
+	u.SetExcluded(hotel.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *HotelUpsert) SetDescription(v string) *HotelUpsert {
+	u.Set(hotel.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateDescription() *HotelUpsert {
+	u.SetExcluded(hotel.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *HotelUpsert) ClearDescription() *HotelUpsert {
+	u.SetNull(hotel.FieldDescription)
+	return u
+}
+
+// SetStarRating sets the "star_rating" field.
+func (u *HotelUpsert) SetStarRating(v int) *HotelUpsert {
+	u.Set(hotel.FieldStarRating, v)
+	return u
+}
+
+// UpdateStarRating sets the "star_rating" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateStarRating() *HotelUpsert {
+	u.SetExcluded(hotel.FieldStarRating)
+	return u
+}
+
+// AddStarRating adds v to the "star_rating" field.
+func (u *HotelUpsert) AddStarRating(v int) *HotelUpsert {
+	u.Add(hotel.FieldStarRating, v)
+	return u
+}
+
+// SetRoomCount sets the "room_count" field.
+func (u *HotelUpsert) SetRoomCount(v int) *HotelUpsert {
+	u.Set(hotel.FieldRoomCount, v)
+	return u
+}
+
+// UpdateRoomCount sets the "room_count" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateRoomCount() *HotelUpsert {
+	u.SetExcluded(hotel.FieldRoomCount)
+	return u
+}
+
+// AddRoomCount adds v to the "room_count" field.
+func (u *HotelUpsert) AddRoomCount(v int) *HotelUpsert {
+	u.Add(hotel.FieldRoomCount, v)
+	return u
+}
+
+// SetCheckInTime sets the "check_in_time" field.
+func (u *HotelUpsert) SetCheckInTime(v time.Time) *HotelUpsert {
+	u.Set(hotel.FieldCheckInTime, v)
+	return u
+}
+
+// UpdateCheckInTime sets the "check_in_time" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateCheckInTime() *HotelUpsert {
+	u.SetExcluded(hotel.FieldCheckInTime)
+	return u
+}
+
+// ClearCheckInTime clears the value of the "check_in_time" field.
+func (u *HotelUpsert) ClearCheckInTime() *HotelUpsert {
+	u.SetNull(hotel.FieldCheckInTime)
+	return u
+}
+
+// SetCheckOutTime sets the "check_out_time" field.
+func (u *HotelUpsert) SetCheckOutTime(v time.Time) *HotelUpsert {
+	u.Set(hotel.FieldCheckOutTime, v)
+	return u
+}
+
+// UpdateCheckOutTime sets the "check_out_time" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateCheckOutTime() *HotelUpsert {
+	u.SetExcluded(hotel.FieldCheckOutTime)
+	return u
+}
+
+// ClearCheckOutTime clears the value of the "check_out_time" field.
+func (u *HotelUpsert) ClearCheckOutTime() *HotelUpsert {
+	u.SetNull(hotel.FieldCheckOutTime)
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *HotelUpsert) SetAddress(v map[string]string) *HotelUpsert {
+	u.Set(hotel.FieldAddress, v)
+	return u
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateAddress() *HotelUpsert {
+	u.SetExcluded(hotel.FieldAddress)
+	return u
+}
+
+// ClearAddress clears the value of the "address" field.
+func (u *HotelUpsert) ClearAddress() *HotelUpsert {
+	u.SetNull(hotel.FieldAddress)
+	return u
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *HotelUpsert) SetLatitude(v decimal.Decimal) *HotelUpsert {
+	u.Set(hotel.FieldLatitude, v)
+	return u
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateLatitude() *HotelUpsert {
+	u.SetExcluded(hotel.FieldLatitude)
+	return u
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *HotelUpsert) SetLongitude(v decimal.Decimal) *HotelUpsert {
+	u.Set(hotel.FieldLongitude, v)
+	return u
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateLongitude() *HotelUpsert {
+	u.SetExcluded(hotel.FieldLongitude)
+	return u
+}
+
+// SetPhone sets the "phone" field.
+func (u *HotelUpsert) SetPhone(v string) *HotelUpsert {
+	u.Set(hotel.FieldPhone, v)
+	return u
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *HotelUpsert) UpdatePhone() *HotelUpsert {
+	u.SetExcluded(hotel.FieldPhone)
+	return u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *HotelUpsert) ClearPhone() *HotelUpsert {
+	u.SetNull(hotel.FieldPhone)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *HotelUpsert) SetEmail(v string) *HotelUpsert {
+	u.Set(hotel.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateEmail() *HotelUpsert {
+	u.SetExcluded(hotel.FieldEmail)
+	return u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *HotelUpsert) ClearEmail() *HotelUpsert {
+	u.SetNull(hotel.FieldEmail)
+	return u
+}
+
+// SetWebsite sets the "website" field.
+func (u *HotelUpsert) SetWebsite(v string) *HotelUpsert {
+	u.Set(hotel.FieldWebsite, v)
+	return u
+}
+
+// UpdateWebsite sets the "website" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateWebsite() *HotelUpsert {
+	u.SetExcluded(hotel.FieldWebsite)
+	return u
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (u *HotelUpsert) ClearWebsite() *HotelUpsert {
+	u.SetNull(hotel.FieldWebsite)
+	return u
+}
+
+// SetPrimaryImageURL sets the "primary_image_url" field.
+func (u *HotelUpsert) SetPrimaryImageURL(v string) *HotelUpsert {
+	u.Set(hotel.FieldPrimaryImageURL, v)
+	return u
+}
+
+// UpdatePrimaryImageURL sets the "primary_image_url" field to the value that was provided on create.
+func (u *HotelUpsert) UpdatePrimaryImageURL() *HotelUpsert {
+	u.SetExcluded(hotel.FieldPrimaryImageURL)
+	return u
+}
+
+// ClearPrimaryImageURL clears the value of the "primary_image_url" field.
+func (u *HotelUpsert) ClearPrimaryImageURL() *HotelUpsert {
+	u.SetNull(hotel.FieldPrimaryImageURL)
+	return u
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (u *HotelUpsert) SetThumbnailURL(v string) *HotelUpsert {
+	u.Set(hotel.FieldThumbnailURL, v)
+	return u
+}
+
+// UpdateThumbnailURL sets the "thumbnail_url" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateThumbnailURL() *HotelUpsert {
+	u.SetExcluded(hotel.FieldThumbnailURL)
+	return u
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (u *HotelUpsert) ClearThumbnailURL() *HotelUpsert {
+	u.SetNull(hotel.FieldThumbnailURL)
+	return u
+}
+
+// SetPriceMin sets the "price_min" field.
+func (u *HotelUpsert) SetPriceMin(v decimal.Decimal) *HotelUpsert {
+	u.Set(hotel.FieldPriceMin, v)
+	return u
+}
+
+// UpdatePriceMin sets the "price_min" field to the value that was provided on create.
+func (u *HotelUpsert) UpdatePriceMin() *HotelUpsert {
+	u.SetExcluded(hotel.FieldPriceMin)
+	return u
+}
+
+// ClearPriceMin clears the value of the "price_min" field.
+func (u *HotelUpsert) ClearPriceMin() *HotelUpsert {
+	u.SetNull(hotel.FieldPriceMin)
+	return u
+}
+
+// SetPriceMax sets the "price_max" field.
+func (u *HotelUpsert) SetPriceMax(v decimal.Decimal) *HotelUpsert {
+	u.Set(hotel.FieldPriceMax, v)
+	return u
+}
+
+// UpdatePriceMax sets the "price_max" field to the value that was provided on create.
+func (u *HotelUpsert) UpdatePriceMax() *HotelUpsert {
+	u.SetExcluded(hotel.FieldPriceMax)
+	return u
+}
+
+// ClearPriceMax clears the value of the "price_max" field.
+func (u *HotelUpsert) ClearPriceMax() *HotelUpsert {
+	u.SetNull(hotel.FieldPriceMax)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *HotelUpsert) SetCurrency(v string) *HotelUpsert {
+	u.Set(hotel.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateCurrency() *HotelUpsert {
+	u.SetExcluded(hotel.FieldCurrency)
+	return u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *HotelUpsert) ClearCurrency() *HotelUpsert {
+	u.SetNull(hotel.FieldCurrency)
+	return u
+}
+
+// SetViewCount sets the "view_count" field.
+func (u *HotelUpsert) SetViewCount(v int) *HotelUpsert {
+	u.Set(hotel.FieldViewCount, v)
+	return u
+}
+
+// UpdateViewCount sets the "view_count" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateViewCount() *HotelUpsert {
+	u.SetExcluded(hotel.FieldViewCount)
+	return u
+}
+
+// AddViewCount adds v to the "view_count" field.
+func (u *HotelUpsert) AddViewCount(v int) *HotelUpsert {
+	u.Add(hotel.FieldViewCount, v)
+	return u
+}
+
+// SetRatingAvg sets the "rating_avg" field.
+func (u *HotelUpsert) SetRatingAvg(v decimal.Decimal) *HotelUpsert {
+	u.Set(hotel.FieldRatingAvg, v)
+	return u
+}
+
+// UpdateRatingAvg sets the "rating_avg" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateRatingAvg() *HotelUpsert {
+	u.SetExcluded(hotel.FieldRatingAvg)
+	return u
+}
+
+// SetRatingCount sets the "rating_count" field.
+func (u *HotelUpsert) SetRatingCount(v int) *HotelUpsert {
+	u.Set(hotel.FieldRatingCount, v)
+	return u
+}
+
+// UpdateRatingCount sets the "rating_count" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateRatingCount() *HotelUpsert {
+	u.SetExcluded(hotel.FieldRatingCount)
+	return u
+}
+
+// AddRatingCount adds v to the "rating_count" field.
+func (u *HotelUpsert) AddRatingCount(v int) *HotelUpsert {
+	u.Add(hotel.FieldRatingCount, v)
+	return u
+}
+
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (u *HotelUpsert) SetLastViewedAt(v time.Time) *HotelUpsert {
+	u.Set(hotel.FieldLastViewedAt, v)
+	return u
+}
+
+// UpdateLastViewedAt sets the "last_viewed_at" field to the value that was provided on create.
+func (u *HotelUpsert) UpdateLastViewedAt() *HotelUpsert {
+	u.SetExcluded(hotel.FieldLastViewedAt)
+	return u
+}
+
+// ClearLastViewedAt clears the value of the "last_viewed_at" field.
+func (u *HotelUpsert) ClearLastViewedAt() *HotelUpsert {
+	u.SetNull(hotel.FieldLastViewedAt)
+	return u
+}
+
+// SetPopularityScore sets the "popularity_score" field.
+func (u *HotelUpsert) SetPopularityScore(v decimal.Decimal) *HotelUpsert {
+	u.Set(hotel.FieldPopularityScore, v)
+	return u
+}
+
+// UpdatePopularityScore sets the "popularity_score" field to the value that was provided on create.
+func (u *HotelUpsert) UpdatePopularityScore() *HotelUpsert {
+	u.SetExcluded(hotel.FieldPopularityScore)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Hotel.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(hotel.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HotelUpsertOne) UpdateNewValues() *HotelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(hotel.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(hotel.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(hotel.FieldCreatedBy)
+		}
+		if _, exists := u.create.mutation.Slug(); exists {
+			s.SetIgnore(hotel.FieldSlug)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Hotel.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *HotelUpsertOne) Ignore() *HotelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HotelUpsertOne) DoNothing() *HotelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HotelCreate.OnConflict
+// documentation for more info.
+func (u *HotelUpsertOne) Update(set func(*HotelUpsert)) *HotelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HotelUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *HotelUpsertOne) SetStatus(v types.Status) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateStatus() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *HotelUpsertOne) SetUpdatedAt(v time.Time) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateUpdatedAt() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *HotelUpsertOne) SetUpdatedBy(v string) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateUpdatedBy() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *HotelUpsertOne) ClearUpdatedBy() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *HotelUpsertOne) SetDeletedAt(v time.Time) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateDeletedAt() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *HotelUpsertOne) ClearDeletedAt() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *HotelUpsertOne) SetDeletedBy(v string) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateDeletedBy() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *HotelUpsertOne) ClearDeletedBy() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *HotelUpsertOne) SetMetadata(v map[string]string) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateMetadata() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *HotelUpsertOne) ClearMetadata() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *HotelUpsertOne) SetName(v string) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateName() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *HotelUpsertOne) SetDescription(v string) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateDescription() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *HotelUpsertOne) ClearDescription() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStarRating sets the "star_rating" field.
+func (u *HotelUpsertOne) SetStarRating(v int) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetStarRating(v)
+	})
+}
+
+// AddStarRating adds v to the "star_rating" field.
+func (u *HotelUpsertOne) AddStarRating(v int) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.AddStarRating(v)
+	})
+}
+
+// UpdateStarRating sets the "star_rating" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateStarRating() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateStarRating()
+	})
+}
+
+// SetRoomCount sets the "room_count" field.
+func (u *HotelUpsertOne) SetRoomCount(v int) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetRoomCount(v)
+	})
+}
+
+// AddRoomCount adds v to the "room_count" field.
+func (u *HotelUpsertOne) AddRoomCount(v int) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.AddRoomCount(v)
+	})
+}
+
+// UpdateRoomCount sets the "room_count" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateRoomCount() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateRoomCount()
+	})
+}
+
+// SetCheckInTime sets the "check_in_time" field.
+func (u *HotelUpsertOne) SetCheckInTime(v time.Time) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetCheckInTime(v)
+	})
+}
+
+// UpdateCheckInTime sets the "check_in_time" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateCheckInTime() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateCheckInTime()
+	})
+}
+
+// ClearCheckInTime clears the value of the "check_in_time" field.
+func (u *HotelUpsertOne) ClearCheckInTime() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearCheckInTime()
+	})
+}
+
+// SetCheckOutTime sets the "check_out_time" field.
+func (u *HotelUpsertOne) SetCheckOutTime(v time.Time) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetCheckOutTime(v)
+	})
+}
+
+// UpdateCheckOutTime sets the "check_out_time" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateCheckOutTime() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateCheckOutTime()
+	})
+}
+
+// ClearCheckOutTime clears the value of the "check_out_time" field.
+func (u *HotelUpsertOne) ClearCheckOutTime() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearCheckOutTime()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *HotelUpsertOne) SetAddress(v map[string]string) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateAddress() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// ClearAddress clears the value of the "address" field.
+func (u *HotelUpsertOne) ClearAddress() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearAddress()
+	})
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *HotelUpsertOne) SetLatitude(v decimal.Decimal) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetLatitude(v)
+	})
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateLatitude() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateLatitude()
+	})
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *HotelUpsertOne) SetLongitude(v decimal.Decimal) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetLongitude(v)
+	})
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateLongitude() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateLongitude()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *HotelUpsertOne) SetPhone(v string) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdatePhone() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *HotelUpsertOne) ClearPhone() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearPhone()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *HotelUpsertOne) SetEmail(v string) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateEmail() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *HotelUpsertOne) ClearEmail() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetWebsite sets the "website" field.
+func (u *HotelUpsertOne) SetWebsite(v string) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetWebsite(v)
+	})
+}
+
+// UpdateWebsite sets the "website" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateWebsite() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateWebsite()
+	})
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (u *HotelUpsertOne) ClearWebsite() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearWebsite()
+	})
+}
+
+// SetPrimaryImageURL sets the "primary_image_url" field.
+func (u *HotelUpsertOne) SetPrimaryImageURL(v string) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetPrimaryImageURL(v)
+	})
+}
+
+// UpdatePrimaryImageURL sets the "primary_image_url" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdatePrimaryImageURL() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdatePrimaryImageURL()
+	})
+}
+
+// ClearPrimaryImageURL clears the value of the "primary_image_url" field.
+func (u *HotelUpsertOne) ClearPrimaryImageURL() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearPrimaryImageURL()
+	})
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (u *HotelUpsertOne) SetThumbnailURL(v string) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetThumbnailURL(v)
+	})
+}
+
+// UpdateThumbnailURL sets the "thumbnail_url" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateThumbnailURL() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateThumbnailURL()
+	})
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (u *HotelUpsertOne) ClearThumbnailURL() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearThumbnailURL()
+	})
+}
+
+// SetPriceMin sets the "price_min" field.
+func (u *HotelUpsertOne) SetPriceMin(v decimal.Decimal) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetPriceMin(v)
+	})
+}
+
+// UpdatePriceMin sets the "price_min" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdatePriceMin() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdatePriceMin()
+	})
+}
+
+// ClearPriceMin clears the value of the "price_min" field.
+func (u *HotelUpsertOne) ClearPriceMin() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearPriceMin()
+	})
+}
+
+// SetPriceMax sets the "price_max" field.
+func (u *HotelUpsertOne) SetPriceMax(v decimal.Decimal) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetPriceMax(v)
+	})
+}
+
+// UpdatePriceMax sets the "price_max" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdatePriceMax() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdatePriceMax()
+	})
+}
+
+// ClearPriceMax clears the value of the "price_max" field.
+func (u *HotelUpsertOne) ClearPriceMax() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearPriceMax()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *HotelUpsertOne) SetCurrency(v string) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateCurrency() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *HotelUpsertOne) ClearCurrency() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearCurrency()
+	})
+}
+
+// SetViewCount sets the "view_count" field.
+func (u *HotelUpsertOne) SetViewCount(v int) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetViewCount(v)
+	})
+}
+
+// AddViewCount adds v to the "view_count" field.
+func (u *HotelUpsertOne) AddViewCount(v int) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.AddViewCount(v)
+	})
+}
+
+// UpdateViewCount sets the "view_count" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateViewCount() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateViewCount()
+	})
+}
+
+// SetRatingAvg sets the "rating_avg" field.
+func (u *HotelUpsertOne) SetRatingAvg(v decimal.Decimal) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetRatingAvg(v)
+	})
+}
+
+// UpdateRatingAvg sets the "rating_avg" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateRatingAvg() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateRatingAvg()
+	})
+}
+
+// SetRatingCount sets the "rating_count" field.
+func (u *HotelUpsertOne) SetRatingCount(v int) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetRatingCount(v)
+	})
+}
+
+// AddRatingCount adds v to the "rating_count" field.
+func (u *HotelUpsertOne) AddRatingCount(v int) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.AddRatingCount(v)
+	})
+}
+
+// UpdateRatingCount sets the "rating_count" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateRatingCount() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateRatingCount()
+	})
+}
+
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (u *HotelUpsertOne) SetLastViewedAt(v time.Time) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetLastViewedAt(v)
+	})
+}
+
+// UpdateLastViewedAt sets the "last_viewed_at" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdateLastViewedAt() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateLastViewedAt()
+	})
+}
+
+// ClearLastViewedAt clears the value of the "last_viewed_at" field.
+func (u *HotelUpsertOne) ClearLastViewedAt() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearLastViewedAt()
+	})
+}
+
+// SetPopularityScore sets the "popularity_score" field.
+func (u *HotelUpsertOne) SetPopularityScore(v decimal.Decimal) *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetPopularityScore(v)
+	})
+}
+
+// UpdatePopularityScore sets the "popularity_score" field to the value that was provided on create.
+func (u *HotelUpsertOne) UpdatePopularityScore() *HotelUpsertOne {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdatePopularityScore()
+	})
+}
+
+// Exec executes the query.
+func (u *HotelUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HotelCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HotelUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *HotelUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: HotelUpsertOne.ID is not supported by MySQL driver. Use HotelUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *HotelUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// HotelCreateBulk is the builder for creating many Hotel entities in bulk.
+type HotelCreateBulk struct {
+	config
+	err      error
+	builders []*HotelCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Hotel entities in the database.
+func (_c *HotelCreateBulk) Save(ctx context.Context) ([]*Hotel, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Hotel, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HotelMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *HotelCreateBulk) SaveX(ctx context.Context) []*Hotel {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HotelCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HotelCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Hotel.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HotelUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *HotelCreateBulk) OnConflict(opts ...sql.ConflictOption) *HotelUpsertBulk {
+	_c.conflict = opts
+	return &HotelUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Hotel.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *HotelCreateBulk) OnConflictColumns(columns ...string) *HotelUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &HotelUpsertBulk{
+		create: _c,
+	}
+}
+
+// HotelUpsertBulk is the builder for "upsert"-ing
+// a bulk of Hotel nodes.
+type HotelUpsertBulk struct {
+	create *HotelCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Hotel.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(hotel.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HotelUpsertBulk) UpdateNewValues() *HotelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(hotel.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(hotel.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(hotel.FieldCreatedBy)
+			}
+			if _, exists := b.mutation.Slug(); exists {
+				s.SetIgnore(hotel.FieldSlug)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Hotel.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *HotelUpsertBulk) Ignore() *HotelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HotelUpsertBulk) DoNothing() *HotelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HotelCreateBulk.OnConflict
+// documentation for more info.
+func (u *HotelUpsertBulk) Update(set func(*HotelUpsert)) *HotelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HotelUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *HotelUpsertBulk) SetStatus(v types.Status) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateStatus() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *HotelUpsertBulk) SetUpdatedAt(v time.Time) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateUpdatedAt() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *HotelUpsertBulk) SetUpdatedBy(v string) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateUpdatedBy() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *HotelUpsertBulk) ClearUpdatedBy() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *HotelUpsertBulk) SetDeletedAt(v time.Time) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateDeletedAt() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *HotelUpsertBulk) ClearDeletedAt() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *HotelUpsertBulk) SetDeletedBy(v string) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateDeletedBy() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *HotelUpsertBulk) ClearDeletedBy() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *HotelUpsertBulk) SetMetadata(v map[string]string) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateMetadata() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *HotelUpsertBulk) ClearMetadata() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *HotelUpsertBulk) SetName(v string) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateName() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *HotelUpsertBulk) SetDescription(v string) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateDescription() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *HotelUpsertBulk) ClearDescription() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStarRating sets the "star_rating" field.
+func (u *HotelUpsertBulk) SetStarRating(v int) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetStarRating(v)
+	})
+}
+
+// AddStarRating adds v to the "star_rating" field.
+func (u *HotelUpsertBulk) AddStarRating(v int) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.AddStarRating(v)
+	})
+}
+
+// UpdateStarRating sets the "star_rating" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateStarRating() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateStarRating()
+	})
+}
+
+// SetRoomCount sets the "room_count" field.
+func (u *HotelUpsertBulk) SetRoomCount(v int) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetRoomCount(v)
+	})
+}
+
+// AddRoomCount adds v to the "room_count" field.
+func (u *HotelUpsertBulk) AddRoomCount(v int) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.AddRoomCount(v)
+	})
+}
+
+// UpdateRoomCount sets the "room_count" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateRoomCount() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateRoomCount()
+	})
+}
+
+// SetCheckInTime sets the "check_in_time" field.
+func (u *HotelUpsertBulk) SetCheckInTime(v time.Time) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetCheckInTime(v)
+	})
+}
+
+// UpdateCheckInTime sets the "check_in_time" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateCheckInTime() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateCheckInTime()
+	})
+}
+
+// ClearCheckInTime clears the value of the "check_in_time" field.
+func (u *HotelUpsertBulk) ClearCheckInTime() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearCheckInTime()
+	})
+}
+
+// SetCheckOutTime sets the "check_out_time" field.
+func (u *HotelUpsertBulk) SetCheckOutTime(v time.Time) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetCheckOutTime(v)
+	})
+}
+
+// UpdateCheckOutTime sets the "check_out_time" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateCheckOutTime() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateCheckOutTime()
+	})
+}
+
+// ClearCheckOutTime clears the value of the "check_out_time" field.
+func (u *HotelUpsertBulk) ClearCheckOutTime() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearCheckOutTime()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *HotelUpsertBulk) SetAddress(v map[string]string) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateAddress() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// ClearAddress clears the value of the "address" field.
+func (u *HotelUpsertBulk) ClearAddress() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearAddress()
+	})
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *HotelUpsertBulk) SetLatitude(v decimal.Decimal) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetLatitude(v)
+	})
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateLatitude() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateLatitude()
+	})
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *HotelUpsertBulk) SetLongitude(v decimal.Decimal) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetLongitude(v)
+	})
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateLongitude() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateLongitude()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *HotelUpsertBulk) SetPhone(v string) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdatePhone() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *HotelUpsertBulk) ClearPhone() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearPhone()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *HotelUpsertBulk) SetEmail(v string) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateEmail() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *HotelUpsertBulk) ClearEmail() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetWebsite sets the "website" field.
+func (u *HotelUpsertBulk) SetWebsite(v string) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetWebsite(v)
+	})
+}
+
+// UpdateWebsite sets the "website" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateWebsite() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateWebsite()
+	})
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (u *HotelUpsertBulk) ClearWebsite() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearWebsite()
+	})
+}
+
+// SetPrimaryImageURL sets the "primary_image_url" field.
+func (u *HotelUpsertBulk) SetPrimaryImageURL(v string) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetPrimaryImageURL(v)
+	})
+}
+
+// UpdatePrimaryImageURL sets the "primary_image_url" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdatePrimaryImageURL() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdatePrimaryImageURL()
+	})
+}
+
+// ClearPrimaryImageURL clears the value of the "primary_image_url" field.
+func (u *HotelUpsertBulk) ClearPrimaryImageURL() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearPrimaryImageURL()
+	})
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (u *HotelUpsertBulk) SetThumbnailURL(v string) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetThumbnailURL(v)
+	})
+}
+
+// UpdateThumbnailURL sets the "thumbnail_url" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateThumbnailURL() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateThumbnailURL()
+	})
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (u *HotelUpsertBulk) ClearThumbnailURL() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearThumbnailURL()
+	})
+}
+
+// SetPriceMin sets the "price_min" field.
+func (u *HotelUpsertBulk) SetPriceMin(v decimal.Decimal) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetPriceMin(v)
+	})
+}
+
+// UpdatePriceMin sets the "price_min" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdatePriceMin() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdatePriceMin()
+	})
+}
+
+// ClearPriceMin clears the value of the "price_min" field.
+func (u *HotelUpsertBulk) ClearPriceMin() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearPriceMin()
+	})
+}
+
+// SetPriceMax sets the "price_max" field.
+func (u *HotelUpsertBulk) SetPriceMax(v decimal.Decimal) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetPriceMax(v)
+	})
+}
+
+// UpdatePriceMax sets the "price_max" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdatePriceMax() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdatePriceMax()
+	})
+}
+
+// ClearPriceMax clears the value of the "price_max" field.
+func (u *HotelUpsertBulk) ClearPriceMax() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearPriceMax()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *HotelUpsertBulk) SetCurrency(v string) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateCurrency() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *HotelUpsertBulk) ClearCurrency() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearCurrency()
+	})
+}
+
+// SetViewCount sets the "view_count" field.
+func (u *HotelUpsertBulk) SetViewCount(v int) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetViewCount(v)
+	})
+}
+
+// AddViewCount adds v to the "view_count" field.
+func (u *HotelUpsertBulk) AddViewCount(v int) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.AddViewCount(v)
+	})
+}
+
+// UpdateViewCount sets the "view_count" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateViewCount() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateViewCount()
+	})
+}
+
+// SetRatingAvg sets the "rating_avg" field.
+func (u *HotelUpsertBulk) SetRatingAvg(v decimal.Decimal) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetRatingAvg(v)
+	})
+}
+
+// UpdateRatingAvg sets the "rating_avg" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateRatingAvg() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateRatingAvg()
+	})
+}
+
+// SetRatingCount sets the "rating_count" field.
+func (u *HotelUpsertBulk) SetRatingCount(v int) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetRatingCount(v)
+	})
+}
+
+// AddRatingCount adds v to the "rating_count" field.
+func (u *HotelUpsertBulk) AddRatingCount(v int) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.AddRatingCount(v)
+	})
+}
+
+// UpdateRatingCount sets the "rating_count" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateRatingCount() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateRatingCount()
+	})
+}
+
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (u *HotelUpsertBulk) SetLastViewedAt(v time.Time) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetLastViewedAt(v)
+	})
+}
+
+// UpdateLastViewedAt sets the "last_viewed_at" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdateLastViewedAt() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdateLastViewedAt()
+	})
+}
+
+// ClearLastViewedAt clears the value of the "last_viewed_at" field.
+func (u *HotelUpsertBulk) ClearLastViewedAt() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.ClearLastViewedAt()
+	})
+}
+
+// SetPopularityScore sets the "popularity_score" field.
+func (u *HotelUpsertBulk) SetPopularityScore(v decimal.Decimal) *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.SetPopularityScore(v)
+	})
+}
+
+// UpdatePopularityScore sets the "popularity_score" field to the value that was provided on create.
+func (u *HotelUpsertBulk) UpdatePopularityScore() *HotelUpsertBulk {
+	return u.Update(func(s *HotelUpsert) {
+		s.UpdatePopularityScore()
+	})
+}
+
+// Exec executes the query.
+func (u *HotelUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the HotelCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HotelCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HotelUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -262,7 +262,7 @@ func (_q *HotelQuery) Clone() *HotelQuery {
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Status types.Status `json:"status,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
@@ -285,7 +285,7 @@ func (_q *HotelQuery) GroupBy(field string, fields ...string) *HotelGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Status types.Status `json:"status,omitempty"`
 //	}
 //
 //	client.Hotel.Query().
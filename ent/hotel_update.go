@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/omkar273/nashikdarshan/ent/hotel"
 	"github.com/omkar273/nashikdarshan/ent/predicate"
+	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -30,13 +31,13 @@ func (_u *HotelUpdate) Where(ps ...predicate.Hotel) *HotelUpdate {
 }
 
 // SetStatus sets the "status" field.
-func (_u *HotelUpdate) SetStatus(v string) *HotelUpdate {
+func (_u *HotelUpdate) SetStatus(v types.Status) *HotelUpdate {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *HotelUpdate) SetNillableStatus(v *string) *HotelUpdate {
+func (_u *HotelUpdate) SetNillableStatus(v *types.Status) *HotelUpdate {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -69,6 +70,46 @@ func (_u *HotelUpdate) ClearUpdatedBy() *HotelUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *HotelUpdate) SetDeletedAt(v time.Time) *HotelUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *HotelUpdate) SetNillableDeletedAt(v *time.Time) *HotelUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *HotelUpdate) ClearDeletedAt() *HotelUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *HotelUpdate) SetDeletedBy(v string) *HotelUpdate {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *HotelUpdate) SetNillableDeletedBy(v *string) *HotelUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *HotelUpdate) ClearDeletedBy() *HotelUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *HotelUpdate) SetMetadata(v map[string]string) *HotelUpdate {
 	_u.mutation.SetMetadata(v)
@@ -494,7 +535,9 @@ func (_u *HotelUpdate) Mutation() *HotelMutation {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *HotelUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -521,15 +564,24 @@ func (_u *HotelUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *HotelUpdate) defaults() {
+func (_u *HotelUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if hotel.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized hotel.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := hotel.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *HotelUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := hotel.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Hotel.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Name(); ok {
 		if err := hotel.NameValidator(v); err != nil {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Hotel.name": %w`, err)}
@@ -585,6 +637,18 @@ func (_u *HotelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.UpdatedByCleared() {
 		_spec.ClearField(hotel.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(hotel.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(hotel.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(hotel.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(hotel.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(hotel.FieldMetadata, field.TypeJSON, value)
 	}
@@ -729,13 +793,13 @@ type HotelUpdateOne struct {
 }
 
 // SetStatus sets the "status" field.
-func (_u *HotelUpdateOne) SetStatus(v string) *HotelUpdateOne {
+func (_u *HotelUpdateOne) SetStatus(v types.Status) *HotelUpdateOne {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *HotelUpdateOne) SetNillableStatus(v *string) *HotelUpdateOne {
+func (_u *HotelUpdateOne) SetNillableStatus(v *types.Status) *HotelUpdateOne {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -768,6 +832,46 @@ func (_u *HotelUpdateOne) ClearUpdatedBy() *HotelUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *HotelUpdateOne) SetDeletedAt(v time.Time) *HotelUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *HotelUpdateOne) SetNillableDeletedAt(v *time.Time) *HotelUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *HotelUpdateOne) ClearDeletedAt() *HotelUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *HotelUpdateOne) SetDeletedBy(v string) *HotelUpdateOne {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *HotelUpdateOne) SetNillableDeletedBy(v *string) *HotelUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *HotelUpdateOne) ClearDeletedBy() *HotelUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *HotelUpdateOne) SetMetadata(v map[string]string) *HotelUpdateOne {
 	_u.mutation.SetMetadata(v)
@@ -1206,7 +1310,9 @@ func (_u *HotelUpdateOne) Select(field string, fields ...string) *HotelUpdateOne
 
 // Save executes the query and returns the updated Hotel entity.
 func (_u *HotelUpdateOne) Save(ctx context.Context) (*Hotel, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -1233,15 +1339,24 @@ func (_u *HotelUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *HotelUpdateOne) defaults() {
+func (_u *HotelUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if hotel.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized hotel.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := hotel.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *HotelUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := hotel.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Hotel.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Name(); ok {
 		if err := hotel.NameValidator(v); err != nil {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Hotel.name": %w`, err)}
@@ -1314,6 +1429,18 @@ func (_u *HotelUpdateOne) sqlSave(ctx context.Context) (_node *Hotel, err error)
 	if _u.mutation.UpdatedByCleared() {
 		_spec.ClearField(hotel.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(hotel.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(hotel.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(hotel.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(hotel.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(hotel.FieldMetadata, field.TypeJSON, value)
 	}
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/omkar273/nashikdarshan/ent/itinerary"
 	"github.com/omkar273/nashikdarshan/ent/user"
+	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -22,7 +23,7 @@ type Itinerary struct {
 	// Unique itinerary identifier with prefix itin_
 	ID string `json:"id,omitempty"`
 	// Status holds the value of the "status" field.
-	Status string `json:"status,omitempty"`
+	Status types.Status `json:"status,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -31,6 +32,10 @@ type Itinerary struct {
 	CreatedBy string `json:"created_by,omitempty"`
 	// UpdatedBy holds the value of the "updated_by" field.
 	UpdatedBy string `json:"updated_by,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// DeletedBy holds the value of the "deleted_by" field.
+	DeletedBy string `json:"deleted_by,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]string `json:"metadata,omitempty"`
 	// FK to user who owns this itinerary
@@ -107,10 +112,12 @@ func (*Itinerary) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullFloat64)
 		case itinerary.FieldTotalDurationMinutes, itinerary.FieldTotalVisitTimeMinutes:
 			values[i] = new(sql.NullInt64)
-		case itinerary.FieldID, itinerary.FieldStatus, itinerary.FieldCreatedBy, itinerary.FieldUpdatedBy, itinerary.FieldUserID, itinerary.FieldTitle, itinerary.FieldDescription, itinerary.FieldPreferredTransportMode:
+		case itinerary.FieldID, itinerary.FieldCreatedBy, itinerary.FieldUpdatedBy, itinerary.FieldDeletedBy, itinerary.FieldUserID, itinerary.FieldTitle, itinerary.FieldDescription, itinerary.FieldPreferredTransportMode:
 			values[i] = new(sql.NullString)
-		case itinerary.FieldCreatedAt, itinerary.FieldUpdatedAt, itinerary.FieldPlannedDate:
+		case itinerary.FieldCreatedAt, itinerary.FieldUpdatedAt, itinerary.FieldDeletedAt, itinerary.FieldPlannedDate:
 			values[i] = new(sql.NullTime)
+		case itinerary.FieldStatus:
+			values[i] = new(types.Status)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -133,10 +140,10 @@ func (_m *Itinerary) assignValues(columns []string, values []any) error {
 				_m.ID = value.String
 			}
 		case itinerary.FieldStatus:
-			if value, ok := values[i].(*sql.NullString); !ok {
+			if value, ok := values[i].(*types.Status); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
-			} else if value.Valid {
-				_m.Status = value.String
+			} else if value != nil {
+				_m.Status = *value
 			}
 		case itinerary.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -162,6 +169,19 @@ func (_m *Itinerary) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedBy = value.String
 			}
+		case itinerary.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case itinerary.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = value.String
+			}
 		case itinerary.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -287,7 +307,7 @@ func (_m *Itinerary) String() string {
 	builder.WriteString("Itinerary(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("status=")
-	builder.WriteString(_m.Status)
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
@@ -301,6 +321,14 @@ func (_m *Itinerary) String() string {
 	builder.WriteString("updated_by=")
 	builder.WriteString(_m.UpdatedBy)
 	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(_m.DeletedBy)
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
 	builder.WriteString(", ")
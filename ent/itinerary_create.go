@@ -8,11 +8,14 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/omkar273/nashikdarshan/ent/itinerary"
 	"github.com/omkar273/nashikdarshan/ent/user"
 	"github.com/omkar273/nashikdarshan/ent/visit"
+	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -21,16 +24,17 @@ type ItineraryCreate struct {
 	config
 	mutation *ItineraryMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetStatus sets the "status" field.
-func (_c *ItineraryCreate) SetStatus(v string) *ItineraryCreate {
+func (_c *ItineraryCreate) SetStatus(v types.Status) *ItineraryCreate {
 	_c.mutation.SetStatus(v)
 	return _c
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_c *ItineraryCreate) SetNillableStatus(v *string) *ItineraryCreate {
+func (_c *ItineraryCreate) SetNillableStatus(v *types.Status) *ItineraryCreate {
 	if v != nil {
 		_c.SetStatus(*v)
 	}
@@ -93,6 +97,34 @@ func (_c *ItineraryCreate) SetNillableUpdatedBy(v *string) *ItineraryCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ItineraryCreate) SetDeletedAt(v time.Time) *ItineraryCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ItineraryCreate) SetNillableDeletedAt(v *time.Time) *ItineraryCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *ItineraryCreate) SetDeletedBy(v string) *ItineraryCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *ItineraryCreate) SetNillableDeletedBy(v *string) *ItineraryCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
 // SetMetadata sets the "metadata" field.
 func (_c *ItineraryCreate) SetMetadata(v map[string]string) *ItineraryCreate {
 	_c.mutation.SetMetadata(v)
@@ -262,7 +294,9 @@ func (_c *ItineraryCreate) Mutation() *ItineraryMutation {
 
 // Save creates the Itinerary in the database.
 func (_c *ItineraryCreate) Save(ctx context.Context) (*Itinerary, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -289,16 +323,22 @@ func (_c *ItineraryCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *ItineraryCreate) defaults() {
+func (_c *ItineraryCreate) defaults() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		v := itinerary.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if itinerary.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized itinerary.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := itinerary.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if itinerary.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized itinerary.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := itinerary.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
@@ -307,6 +347,9 @@ func (_c *ItineraryCreate) defaults() {
 		_c.mutation.SetMetadata(v)
 	}
 	if _, ok := _c.mutation.PlannedDate(); !ok {
+		if itinerary.DefaultPlannedDate == nil {
+			return fmt.Errorf("ent: uninitialized itinerary.DefaultPlannedDate (forgotten import ent/runtime?)")
+		}
 		v := itinerary.DefaultPlannedDate()
 		_c.mutation.SetPlannedDate(v)
 	}
@@ -319,9 +362,13 @@ func (_c *ItineraryCreate) defaults() {
 		_c.mutation.SetIsOptimized(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if itinerary.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized itinerary.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := itinerary.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -329,6 +376,11 @@ func (_c *ItineraryCreate) check() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Itinerary.status"`)}
 	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := itinerary.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Itinerary.status": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Itinerary.created_at"`)}
 	}
@@ -405,6 +457,7 @@ func (_c *ItineraryCreate) createSpec() (*Itinerary, *sqlgraph.CreateSpec) {
 		_node = &Itinerary{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(itinerary.Table, sqlgraph.NewFieldSpec(itinerary.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -429,6 +482,14 @@ func (_c *ItineraryCreate) createSpec() (*Itinerary, *sqlgraph.CreateSpec) {
 		_spec.SetField(itinerary.FieldUpdatedBy, field.TypeString, value)
 		_node.UpdatedBy = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(itinerary.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(itinerary.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
 	if value, ok := _c.mutation.Metadata(); ok {
 		_spec.SetField(itinerary.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -509,11 +570,738 @@ func (_c *ItineraryCreate) createSpec() (*Itinerary, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Itinerary.Create().
+//		SetStatus(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ItineraryUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ItineraryCreate) OnConflict(opts ...sql.ConflictOption) *ItineraryUpsertOne {
+	_c.conflict = opts
+	return &ItineraryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Itinerary.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ItineraryCreate) OnConflictColumns(columns ...string) *ItineraryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ItineraryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ItineraryUpsertOne is the builder for "upsert"-ing
+	//  one Itinerary node.
+	ItineraryUpsertOne struct {
+		create *ItineraryCreate
+	}
+
+	// ItineraryUpsert is the "OnConflict" setter.
+	ItineraryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *ItineraryUpsert) SetStatus(v types.Status) *ItineraryUpsert {
+	u.Set(itinerary.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ItineraryUpsert) UpdateStatus() *ItineraryUpsert {
+	u.SetExcluded(itinerary.FieldStatus)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ItineraryUpsert) SetUpdatedAt(v time.Time) *ItineraryUpsert {
+	u.Set(itinerary.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ItineraryUpsert) UpdateUpdatedAt() *ItineraryUpsert {
+	u.SetExcluded(itinerary.FieldUpdatedAt)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *ItineraryUpsert) SetUpdatedBy(v string) *ItineraryUpsert {
+	u.Set(itinerary.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *ItineraryUpsert) UpdateUpdatedBy() *ItineraryUpsert {
+	u.SetExcluded(itinerary.FieldUpdatedBy)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *ItineraryUpsert) ClearUpdatedBy() *ItineraryUpsert {
+	u.SetNull(itinerary.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ItineraryUpsert) SetDeletedAt(v time.Time) *ItineraryUpsert {
+	u.Set(itinerary.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ItineraryUpsert) UpdateDeletedAt() *ItineraryUpsert {
+	u.SetExcluded(itinerary.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ItineraryUpsert) ClearDeletedAt() *ItineraryUpsert {
+	u.SetNull(itinerary.FieldDeletedAt)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *ItineraryUpsert) SetDeletedBy(v string) *ItineraryUpsert {
+	u.Set(itinerary.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *ItineraryUpsert) UpdateDeletedBy() *ItineraryUpsert {
+	u.SetExcluded(itinerary.FieldDeletedBy)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *ItineraryUpsert) ClearDeletedBy() *ItineraryUpsert {
+	u.SetNull(itinerary.FieldDeletedBy)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *ItineraryUpsert) SetMetadata(v map[string]string) *ItineraryUpsert {
+	u.Set(itinerary.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *ItineraryUpsert) UpdateMetadata() *ItineraryUpsert {
+	u.SetExcluded(itinerary.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *ItineraryUpsert) ClearMetadata() *ItineraryUpsert {
+	u.SetNull(itinerary.FieldMetadata)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ItineraryUpsert) SetUserID(v string) *ItineraryUpsert {
+	u.Set(itinerary.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ItineraryUpsert) UpdateUserID() *ItineraryUpsert {
+	u.SetExcluded(itinerary.FieldUserID)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *ItineraryUpsert) SetTitle(v string) *ItineraryUpsert {
+	u.Set(itinerary.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ItineraryUpsert) UpdateTitle() *ItineraryUpsert {
+	u.SetExcluded(itinerary.FieldTitle)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ItineraryUpsert) SetDescription(v string) *ItineraryUpsert {
+	u.Set(itinerary.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ItineraryUpsert) UpdateDescription() *ItineraryUpsert {
+	u.SetExcluded(itinerary.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ItineraryUpsert) ClearDescription() *ItineraryUpsert {
+	u.SetNull(itinerary.FieldDescription)
+	return u
+}
+
+// SetPlannedDate sets the "planned_date" field.
+func (u *ItineraryUpsert) SetPlannedDate(v time.Time) *ItineraryUpsert {
+	u.Set(itinerary.FieldPlannedDate, v)
+	return u
+}
+
+// UpdatePlannedDate sets the "planned_date" field to the value that was provided on create.
+func (u *ItineraryUpsert) UpdatePlannedDate() *ItineraryUpsert {
+	u.SetExcluded(itinerary.FieldPlannedDate)
+	return u
+}
+
+// SetStartLatitude sets the "start_latitude" field.
+func (u *ItineraryUpsert) SetStartLatitude(v decimal.Decimal) *ItineraryUpsert {
+	u.Set(itinerary.FieldStartLatitude, v)
+	return u
+}
+
+// UpdateStartLatitude sets the "start_latitude" field to the value that was provided on create.
+func (u *ItineraryUpsert) UpdateStartLatitude() *ItineraryUpsert {
+	u.SetExcluded(itinerary.FieldStartLatitude)
+	return u
+}
+
+// SetStartLongitude sets the "start_longitude" field.
+func (u *ItineraryUpsert) SetStartLongitude(v decimal.Decimal) *ItineraryUpsert {
+	u.Set(itinerary.FieldStartLongitude, v)
+	return u
+}
+
+// UpdateStartLongitude sets the "start_longitude" field to the value that was provided on create.
+func (u *ItineraryUpsert) UpdateStartLongitude() *ItineraryUpsert {
+	u.SetExcluded(itinerary.FieldStartLongitude)
+	return u
+}
+
+// SetPreferredTransportMode sets the "preferred_transport_mode" field.
+func (u *ItineraryUpsert) SetPreferredTransportMode(v string) *ItineraryUpsert {
+	u.Set(itinerary.FieldPreferredTransportMode, v)
+	return u
+}
+
+// UpdatePreferredTransportMode sets the "preferred_transport_mode" field to the value that was provided on create.
+func (u *ItineraryUpsert) UpdatePreferredTransportMode() *ItineraryUpsert {
+	u.SetExcluded(itinerary.FieldPreferredTransportMode)
+	return u
+}
+
+// SetTotalDistanceKm sets the "total_distance_km" field.
+func (u *ItineraryUpsert) SetTotalDistanceKm(v float64) *ItineraryUpsert {
+	u.Set(itinerary.FieldTotalDistanceKm, v)
+	return u
+}
+
+// UpdateTotalDistanceKm sets the "total_distance_km" field to the value that was provided on create.
+func (u *ItineraryUpsert) UpdateTotalDistanceKm() *ItineraryUpsert {
+	u.SetExcluded(itinerary.FieldTotalDistanceKm)
+	return u
+}
+
+// AddTotalDistanceKm adds v to the "total_distance_km" field.
+func (u *ItineraryUpsert) AddTotalDistanceKm(v float64) *ItineraryUpsert {
+	u.Add(itinerary.FieldTotalDistanceKm, v)
+	return u
+}
+
+// ClearTotalDistanceKm clears the value of the "total_distance_km" field.
+func (u *ItineraryUpsert) ClearTotalDistanceKm() *ItineraryUpsert {
+	u.SetNull(itinerary.FieldTotalDistanceKm)
+	return u
+}
+
+// SetTotalDurationMinutes sets the "total_duration_minutes" field.
+func (u *ItineraryUpsert) SetTotalDurationMinutes(v int) *ItineraryUpsert {
+	u.Set(itinerary.FieldTotalDurationMinutes, v)
+	return u
+}
+
+// UpdateTotalDurationMinutes sets the "total_duration_minutes" field to the value that was provided on create.
+func (u *ItineraryUpsert) UpdateTotalDurationMinutes() *ItineraryUpsert {
+	u.SetExcluded(itinerary.FieldTotalDurationMinutes)
+	return u
+}
+
+// AddTotalDurationMinutes adds v to the "total_duration_minutes" field.
+func (u *ItineraryUpsert) AddTotalDurationMinutes(v int) *ItineraryUpsert {
+	u.Add(itinerary.FieldTotalDurationMinutes, v)
+	return u
+}
+
+// ClearTotalDurationMinutes clears the value of the "total_duration_minutes" field.
+func (u *ItineraryUpsert) ClearTotalDurationMinutes() *ItineraryUpsert {
+	u.SetNull(itinerary.FieldTotalDurationMinutes)
+	return u
+}
+
+// SetTotalVisitTimeMinutes sets the "total_visit_time_minutes" field.
+func (u *ItineraryUpsert) SetTotalVisitTimeMinutes(v int) *ItineraryUpsert {
+	u.Set(itinerary.FieldTotalVisitTimeMinutes, v)
+	return u
+}
+
+// UpdateTotalVisitTimeMinutes sets the "total_visit_time_minutes" field to the value that was provided on create.
+func (u *ItineraryUpsert) UpdateTotalVisitTimeMinutes() *ItineraryUpsert {
+	u.SetExcluded(itinerary.FieldTotalVisitTimeMinutes)
+	return u
+}
+
+// AddTotalVisitTimeMinutes adds v to the "total_visit_time_minutes" field.
+func (u *ItineraryUpsert) AddTotalVisitTimeMinutes(v int) *ItineraryUpsert {
+	u.Add(itinerary.FieldTotalVisitTimeMinutes, v)
+	return u
+}
+
+// ClearTotalVisitTimeMinutes clears the value of the "total_visit_time_minutes" field.
+func (u *ItineraryUpsert) ClearTotalVisitTimeMinutes() *ItineraryUpsert {
+	u.SetNull(itinerary.FieldTotalVisitTimeMinutes)
+	return u
+}
+
+// SetIsOptimized sets the "is_optimized" field.
+func (u *ItineraryUpsert) SetIsOptimized(v bool) *ItineraryUpsert {
+	u.Set(itinerary.FieldIsOptimized, v)
+	return u
+}
+
+// UpdateIsOptimized sets the "is_optimized" field to the value that was provided on create.
+func (u *ItineraryUpsert) UpdateIsOptimized() *ItineraryUpsert {
+	u.SetExcluded(itinerary.FieldIsOptimized)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Itinerary.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(itinerary.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ItineraryUpsertOne) UpdateNewValues() *ItineraryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(itinerary.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(itinerary.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(itinerary.FieldCreatedBy)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Itinerary.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ItineraryUpsertOne) Ignore() *ItineraryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ItineraryUpsertOne) DoNothing() *ItineraryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ItineraryCreate.OnConflict
+// documentation for more info.
+func (u *ItineraryUpsertOne) Update(set func(*ItineraryUpsert)) *ItineraryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ItineraryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ItineraryUpsertOne) SetStatus(v types.Status) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ItineraryUpsertOne) UpdateStatus() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ItineraryUpsertOne) SetUpdatedAt(v time.Time) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ItineraryUpsertOne) UpdateUpdatedAt() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *ItineraryUpsertOne) SetUpdatedBy(v string) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *ItineraryUpsertOne) UpdateUpdatedBy() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *ItineraryUpsertOne) ClearUpdatedBy() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ItineraryUpsertOne) SetDeletedAt(v time.Time) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ItineraryUpsertOne) UpdateDeletedAt() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ItineraryUpsertOne) ClearDeletedAt() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *ItineraryUpsertOne) SetDeletedBy(v string) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *ItineraryUpsertOne) UpdateDeletedBy() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *ItineraryUpsertOne) ClearDeletedBy() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *ItineraryUpsertOne) SetMetadata(v map[string]string) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *ItineraryUpsertOne) UpdateMetadata() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *ItineraryUpsertOne) ClearMetadata() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ItineraryUpsertOne) SetUserID(v string) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ItineraryUpsertOne) UpdateUserID() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ItineraryUpsertOne) SetTitle(v string) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ItineraryUpsertOne) UpdateTitle() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ItineraryUpsertOne) SetDescription(v string) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ItineraryUpsertOne) UpdateDescription() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ItineraryUpsertOne) ClearDescription() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetPlannedDate sets the "planned_date" field.
+func (u *ItineraryUpsertOne) SetPlannedDate(v time.Time) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetPlannedDate(v)
+	})
+}
+
+// UpdatePlannedDate sets the "planned_date" field to the value that was provided on create.
+func (u *ItineraryUpsertOne) UpdatePlannedDate() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdatePlannedDate()
+	})
+}
+
+// SetStartLatitude sets the "start_latitude" field.
+func (u *ItineraryUpsertOne) SetStartLatitude(v decimal.Decimal) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetStartLatitude(v)
+	})
+}
+
+// UpdateStartLatitude sets the "start_latitude" field to the value that was provided on create.
+func (u *ItineraryUpsertOne) UpdateStartLatitude() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateStartLatitude()
+	})
+}
+
+// SetStartLongitude sets the "start_longitude" field.
+func (u *ItineraryUpsertOne) SetStartLongitude(v decimal.Decimal) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetStartLongitude(v)
+	})
+}
+
+// UpdateStartLongitude sets the "start_longitude" field to the value that was provided on create.
+func (u *ItineraryUpsertOne) UpdateStartLongitude() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateStartLongitude()
+	})
+}
+
+// SetPreferredTransportMode sets the "preferred_transport_mode" field.
+func (u *ItineraryUpsertOne) SetPreferredTransportMode(v string) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetPreferredTransportMode(v)
+	})
+}
+
+// UpdatePreferredTransportMode sets the "preferred_transport_mode" field to the value that was provided on create.
+func (u *ItineraryUpsertOne) UpdatePreferredTransportMode() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdatePreferredTransportMode()
+	})
+}
+
+// SetTotalDistanceKm sets the "total_distance_km" field.
+func (u *ItineraryUpsertOne) SetTotalDistanceKm(v float64) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetTotalDistanceKm(v)
+	})
+}
+
+// AddTotalDistanceKm adds v to the "total_distance_km" field.
+func (u *ItineraryUpsertOne) AddTotalDistanceKm(v float64) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.AddTotalDistanceKm(v)
+	})
+}
+
+// UpdateTotalDistanceKm sets the "total_distance_km" field to the value that was provided on create.
+func (u *ItineraryUpsertOne) UpdateTotalDistanceKm() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateTotalDistanceKm()
+	})
+}
+
+// ClearTotalDistanceKm clears the value of the "total_distance_km" field.
+func (u *ItineraryUpsertOne) ClearTotalDistanceKm() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.ClearTotalDistanceKm()
+	})
+}
+
+// SetTotalDurationMinutes sets the "total_duration_minutes" field.
+func (u *ItineraryUpsertOne) SetTotalDurationMinutes(v int) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetTotalDurationMinutes(v)
+	})
+}
+
+// AddTotalDurationMinutes adds v to the "total_duration_minutes" field.
+func (u *ItineraryUpsertOne) AddTotalDurationMinutes(v int) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.AddTotalDurationMinutes(v)
+	})
+}
+
+// UpdateTotalDurationMinutes sets the "total_duration_minutes" field to the value that was provided on create.
+func (u *ItineraryUpsertOne) UpdateTotalDurationMinutes() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateTotalDurationMinutes()
+	})
+}
+
+// ClearTotalDurationMinutes clears the value of the "total_duration_minutes" field.
+func (u *ItineraryUpsertOne) ClearTotalDurationMinutes() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.ClearTotalDurationMinutes()
+	})
+}
+
+// SetTotalVisitTimeMinutes sets the "total_visit_time_minutes" field.
+func (u *ItineraryUpsertOne) SetTotalVisitTimeMinutes(v int) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetTotalVisitTimeMinutes(v)
+	})
+}
+
+// AddTotalVisitTimeMinutes adds v to the "total_visit_time_minutes" field.
+func (u *ItineraryUpsertOne) AddTotalVisitTimeMinutes(v int) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.AddTotalVisitTimeMinutes(v)
+	})
+}
+
+// UpdateTotalVisitTimeMinutes sets the "total_visit_time_minutes" field to the value that was provided on create.
+func (u *ItineraryUpsertOne) UpdateTotalVisitTimeMinutes() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateTotalVisitTimeMinutes()
+	})
+}
+
+// ClearTotalVisitTimeMinutes clears the value of the "total_visit_time_minutes" field.
+func (u *ItineraryUpsertOne) ClearTotalVisitTimeMinutes() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.ClearTotalVisitTimeMinutes()
+	})
+}
+
+// SetIsOptimized sets the "is_optimized" field.
+func (u *ItineraryUpsertOne) SetIsOptimized(v bool) *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetIsOptimized(v)
+	})
+}
+
+// UpdateIsOptimized sets the "is_optimized" field to the value that was provided on create.
+func (u *ItineraryUpsertOne) UpdateIsOptimized() *ItineraryUpsertOne {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateIsOptimized()
+	})
+}
+
+// Exec executes the query.
+func (u *ItineraryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ItineraryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ItineraryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ItineraryUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ItineraryUpsertOne.ID is not supported by MySQL driver. Use ItineraryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ItineraryUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ItineraryCreateBulk is the builder for creating many Itinerary entities in bulk.
 type ItineraryCreateBulk struct {
 	config
 	err      error
 	builders []*ItineraryCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Itinerary entities in the database.
@@ -543,6 +1331,7 @@ func (_c *ItineraryCreateBulk) Save(ctx context.Context) ([]*Itinerary, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -592,3 +1381,438 @@ func (_c *ItineraryCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Itinerary.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ItineraryUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ItineraryCreateBulk) OnConflict(opts ...sql.ConflictOption) *ItineraryUpsertBulk {
+	_c.conflict = opts
+	return &ItineraryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Itinerary.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ItineraryCreateBulk) OnConflictColumns(columns ...string) *ItineraryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ItineraryUpsertBulk{
+		create: _c,
+	}
+}
+
+// ItineraryUpsertBulk is the builder for "upsert"-ing
+// a bulk of Itinerary nodes.
+type ItineraryUpsertBulk struct {
+	create *ItineraryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Itinerary.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(itinerary.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ItineraryUpsertBulk) UpdateNewValues() *ItineraryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(itinerary.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(itinerary.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(itinerary.FieldCreatedBy)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Itinerary.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ItineraryUpsertBulk) Ignore() *ItineraryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ItineraryUpsertBulk) DoNothing() *ItineraryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ItineraryCreateBulk.OnConflict
+// documentation for more info.
+func (u *ItineraryUpsertBulk) Update(set func(*ItineraryUpsert)) *ItineraryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ItineraryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ItineraryUpsertBulk) SetStatus(v types.Status) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ItineraryUpsertBulk) UpdateStatus() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ItineraryUpsertBulk) SetUpdatedAt(v time.Time) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ItineraryUpsertBulk) UpdateUpdatedAt() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *ItineraryUpsertBulk) SetUpdatedBy(v string) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *ItineraryUpsertBulk) UpdateUpdatedBy() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *ItineraryUpsertBulk) ClearUpdatedBy() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ItineraryUpsertBulk) SetDeletedAt(v time.Time) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ItineraryUpsertBulk) UpdateDeletedAt() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ItineraryUpsertBulk) ClearDeletedAt() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *ItineraryUpsertBulk) SetDeletedBy(v string) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *ItineraryUpsertBulk) UpdateDeletedBy() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *ItineraryUpsertBulk) ClearDeletedBy() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *ItineraryUpsertBulk) SetMetadata(v map[string]string) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *ItineraryUpsertBulk) UpdateMetadata() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *ItineraryUpsertBulk) ClearMetadata() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ItineraryUpsertBulk) SetUserID(v string) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ItineraryUpsertBulk) UpdateUserID() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ItineraryUpsertBulk) SetTitle(v string) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ItineraryUpsertBulk) UpdateTitle() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ItineraryUpsertBulk) SetDescription(v string) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ItineraryUpsertBulk) UpdateDescription() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ItineraryUpsertBulk) ClearDescription() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetPlannedDate sets the "planned_date" field.
+func (u *ItineraryUpsertBulk) SetPlannedDate(v time.Time) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetPlannedDate(v)
+	})
+}
+
+// UpdatePlannedDate sets the "planned_date" field to the value that was provided on create.
+func (u *ItineraryUpsertBulk) UpdatePlannedDate() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdatePlannedDate()
+	})
+}
+
+// SetStartLatitude sets the "start_latitude" field.
+func (u *ItineraryUpsertBulk) SetStartLatitude(v decimal.Decimal) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetStartLatitude(v)
+	})
+}
+
+// UpdateStartLatitude sets the "start_latitude" field to the value that was provided on create.
+func (u *ItineraryUpsertBulk) UpdateStartLatitude() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateStartLatitude()
+	})
+}
+
+// SetStartLongitude sets the "start_longitude" field.
+func (u *ItineraryUpsertBulk) SetStartLongitude(v decimal.Decimal) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetStartLongitude(v)
+	})
+}
+
+// UpdateStartLongitude sets the "start_longitude" field to the value that was provided on create.
+func (u *ItineraryUpsertBulk) UpdateStartLongitude() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateStartLongitude()
+	})
+}
+
+// SetPreferredTransportMode sets the "preferred_transport_mode" field.
+func (u *ItineraryUpsertBulk) SetPreferredTransportMode(v string) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetPreferredTransportMode(v)
+	})
+}
+
+// UpdatePreferredTransportMode sets the "preferred_transport_mode" field to the value that was provided on create.
+func (u *ItineraryUpsertBulk) UpdatePreferredTransportMode() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdatePreferredTransportMode()
+	})
+}
+
+// SetTotalDistanceKm sets the "total_distance_km" field.
+func (u *ItineraryUpsertBulk) SetTotalDistanceKm(v float64) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetTotalDistanceKm(v)
+	})
+}
+
+// AddTotalDistanceKm adds v to the "total_distance_km" field.
+func (u *ItineraryUpsertBulk) AddTotalDistanceKm(v float64) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.AddTotalDistanceKm(v)
+	})
+}
+
+// UpdateTotalDistanceKm sets the "total_distance_km" field to the value that was provided on create.
+func (u *ItineraryUpsertBulk) UpdateTotalDistanceKm() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateTotalDistanceKm()
+	})
+}
+
+// ClearTotalDistanceKm clears the value of the "total_distance_km" field.
+func (u *ItineraryUpsertBulk) ClearTotalDistanceKm() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.ClearTotalDistanceKm()
+	})
+}
+
+// SetTotalDurationMinutes sets the "total_duration_minutes" field.
+func (u *ItineraryUpsertBulk) SetTotalDurationMinutes(v int) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetTotalDurationMinutes(v)
+	})
+}
+
+// AddTotalDurationMinutes adds v to the "total_duration_minutes" field.
+func (u *ItineraryUpsertBulk) AddTotalDurationMinutes(v int) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.AddTotalDurationMinutes(v)
+	})
+}
+
+// UpdateTotalDurationMinutes sets the "total_duration_minutes" field to the value that was provided on create.
+func (u *ItineraryUpsertBulk) UpdateTotalDurationMinutes() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateTotalDurationMinutes()
+	})
+}
+
+// ClearTotalDurationMinutes clears the value of the "total_duration_minutes" field.
+func (u *ItineraryUpsertBulk) ClearTotalDurationMinutes() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.ClearTotalDurationMinutes()
+	})
+}
+
+// SetTotalVisitTimeMinutes sets the "total_visit_time_minutes" field.
+func (u *ItineraryUpsertBulk) SetTotalVisitTimeMinutes(v int) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetTotalVisitTimeMinutes(v)
+	})
+}
+
+// AddTotalVisitTimeMinutes adds v to the "total_visit_time_minutes" field.
+func (u *ItineraryUpsertBulk) AddTotalVisitTimeMinutes(v int) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.AddTotalVisitTimeMinutes(v)
+	})
+}
+
+// UpdateTotalVisitTimeMinutes sets the "total_visit_time_minutes" field to the value that was provided on create.
+func (u *ItineraryUpsertBulk) UpdateTotalVisitTimeMinutes() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateTotalVisitTimeMinutes()
+	})
+}
+
+// ClearTotalVisitTimeMinutes clears the value of the "total_visit_time_minutes" field.
+func (u *ItineraryUpsertBulk) ClearTotalVisitTimeMinutes() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.ClearTotalVisitTimeMinutes()
+	})
+}
+
+// SetIsOptimized sets the "is_optimized" field.
+func (u *ItineraryUpsertBulk) SetIsOptimized(v bool) *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.SetIsOptimized(v)
+	})
+}
+
+// UpdateIsOptimized sets the "is_optimized" field to the value that was provided on create.
+func (u *ItineraryUpsertBulk) UpdateIsOptimized() *ItineraryUpsertBulk {
+	return u.Update(func(s *ItineraryUpsert) {
+		s.UpdateIsOptimized()
+	})
+}
+
+// Exec executes the query.
+func (u *ItineraryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ItineraryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ItineraryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ItineraryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -335,7 +335,7 @@ func (_q *ItineraryQuery) WithVisits(opts ...func(*VisitQuery)) *ItineraryQuery
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Status types.Status `json:"status,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
@@ -358,7 +358,7 @@ func (_q *ItineraryQuery) GroupBy(field string, fields ...string) *ItineraryGrou
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Status types.Status `json:"status,omitempty"`
 //	}
 //
 //	client.Itinerary.Query().
@@ -15,6 +15,7 @@ import (
 	"github.com/omkar273/nashikdarshan/ent/predicate"
 	"github.com/omkar273/nashikdarshan/ent/user"
 	"github.com/omkar273/nashikdarshan/ent/visit"
+	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -32,13 +33,13 @@ func (_u *ItineraryUpdate) Where(ps ...predicate.Itinerary) *ItineraryUpdate {
 }
 
 // SetStatus sets the "status" field.
-func (_u *ItineraryUpdate) SetStatus(v string) *ItineraryUpdate {
+func (_u *ItineraryUpdate) SetStatus(v types.Status) *ItineraryUpdate {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *ItineraryUpdate) SetNillableStatus(v *string) *ItineraryUpdate {
+func (_u *ItineraryUpdate) SetNillableStatus(v *types.Status) *ItineraryUpdate {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -71,6 +72,46 @@ func (_u *ItineraryUpdate) ClearUpdatedBy() *ItineraryUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ItineraryUpdate) SetDeletedAt(v time.Time) *ItineraryUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ItineraryUpdate) SetNillableDeletedAt(v *time.Time) *ItineraryUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ItineraryUpdate) ClearDeletedAt() *ItineraryUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *ItineraryUpdate) SetDeletedBy(v string) *ItineraryUpdate {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *ItineraryUpdate) SetNillableDeletedBy(v *string) *ItineraryUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *ItineraryUpdate) ClearDeletedBy() *ItineraryUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *ItineraryUpdate) SetMetadata(v map[string]string) *ItineraryUpdate {
 	_u.mutation.SetMetadata(v)
@@ -336,7 +377,9 @@ func (_u *ItineraryUpdate) RemoveVisits(v ...*Visit) *ItineraryUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ItineraryUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -363,15 +406,24 @@ func (_u *ItineraryUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *ItineraryUpdate) defaults() {
+func (_u *ItineraryUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if itinerary.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized itinerary.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := itinerary.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *ItineraryUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := itinerary.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Itinerary.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.UserID(); ok {
 		if err := itinerary.UserIDValidator(v); err != nil {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Itinerary.user_id": %w`, err)}
@@ -415,6 +467,18 @@ func (_u *ItineraryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.UpdatedByCleared() {
 		_spec.ClearField(itinerary.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(itinerary.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(itinerary.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(itinerary.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(itinerary.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(itinerary.FieldMetadata, field.TypeJSON, value)
 	}
@@ -567,13 +631,13 @@ type ItineraryUpdateOne struct {
 }
 
 // SetStatus sets the "status" field.
-func (_u *ItineraryUpdateOne) SetStatus(v string) *ItineraryUpdateOne {
+func (_u *ItineraryUpdateOne) SetStatus(v types.Status) *ItineraryUpdateOne {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *ItineraryUpdateOne) SetNillableStatus(v *string) *ItineraryUpdateOne {
+func (_u *ItineraryUpdateOne) SetNillableStatus(v *types.Status) *ItineraryUpdateOne {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -606,6 +670,46 @@ func (_u *ItineraryUpdateOne) ClearUpdatedBy() *ItineraryUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ItineraryUpdateOne) SetDeletedAt(v time.Time) *ItineraryUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ItineraryUpdateOne) SetNillableDeletedAt(v *time.Time) *ItineraryUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ItineraryUpdateOne) ClearDeletedAt() *ItineraryUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *ItineraryUpdateOne) SetDeletedBy(v string) *ItineraryUpdateOne {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *ItineraryUpdateOne) SetNillableDeletedBy(v *string) *ItineraryUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *ItineraryUpdateOne) ClearDeletedBy() *ItineraryUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *ItineraryUpdateOne) SetMetadata(v map[string]string) *ItineraryUpdateOne {
 	_u.mutation.SetMetadata(v)
@@ -884,7 +988,9 @@ func (_u *ItineraryUpdateOne) Select(field string, fields ...string) *ItineraryU
 
 // Save executes the query and returns the updated Itinerary entity.
 func (_u *ItineraryUpdateOne) Save(ctx context.Context) (*Itinerary, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -911,15 +1017,24 @@ func (_u *ItineraryUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *ItineraryUpdateOne) defaults() {
+func (_u *ItineraryUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if itinerary.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized itinerary.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := itinerary.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *ItineraryUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := itinerary.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Itinerary.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.UserID(); ok {
 		if err := itinerary.UserIDValidator(v); err != nil {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Itinerary.user_id": %w`, err)}
@@ -980,6 +1095,18 @@ func (_u *ItineraryUpdateOne) sqlSave(ctx context.Context) (_node *Itinerary, er
 	if _u.mutation.UpdatedByCleared() {
 		_spec.ClearField(itinerary.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(itinerary.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(itinerary.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(itinerary.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(itinerary.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(itinerary.FieldMetadata, field.TypeJSON, value)
 	}
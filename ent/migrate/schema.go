@@ -9,6 +9,42 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "label", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "key_hash", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(64)"}},
+		{Name: "key_prefix", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(32)"}},
+		{Name: "scopes", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikey_key_hash",
+				Unique:  true,
+				Columns: []*schema.Column{APIKeysColumns[9]},
+			},
+			{
+				Name:    "apikey_status",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[1]},
+			},
+		},
+	}
 	// CategoriesColumns holds the columns for the "categories" table.
 	CategoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
@@ -17,6 +53,8 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_by", Type: field.TypeString, Nullable: true},
 		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 		{Name: "slug", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
@@ -31,7 +69,7 @@ var (
 			{
 				Name:    "category_slug",
 				Unique:  true,
-				Columns: []*schema.Column{CategoriesColumns[8]},
+				Columns: []*schema.Column{CategoriesColumns[10]},
 			},
 		},
 	}
@@ -43,6 +81,8 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_by", Type: field.TypeString, Nullable: true},
 		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "slug", Type: field.TypeString, Unique: true},
 		{Name: "type", Type: field.TypeString},
@@ -70,37 +110,37 @@ var (
 			{
 				Name:    "event_slug",
 				Unique:  true,
-				Columns: []*schema.Column{EventsColumns[7]},
+				Columns: []*schema.Column{EventsColumns[9]},
 			},
 			{
 				Name:    "event_place_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{EventsColumns[12], EventsColumns[1]},
+				Columns: []*schema.Column{EventsColumns[14], EventsColumns[1]},
 			},
 			{
 				Name:    "event_type_status",
 				Unique:  false,
-				Columns: []*schema.Column{EventsColumns[8], EventsColumns[1]},
+				Columns: []*schema.Column{EventsColumns[10], EventsColumns[1]},
 			},
 			{
 				Name:    "event_status_start_date",
 				Unique:  false,
-				Columns: []*schema.Column{EventsColumns[1], EventsColumns[13]},
+				Columns: []*schema.Column{EventsColumns[1], EventsColumns[15]},
 			},
 			{
 				Name:    "event_start_date_end_date",
 				Unique:  false,
-				Columns: []*schema.Column{EventsColumns[13], EventsColumns[14]},
+				Columns: []*schema.Column{EventsColumns[15], EventsColumns[16]},
 			},
 			{
 				Name:    "event_status_view_count",
 				Unique:  false,
-				Columns: []*schema.Column{EventsColumns[1], EventsColumns[21]},
+				Columns: []*schema.Column{EventsColumns[1], EventsColumns[23]},
 			},
 			{
 				Name:    "event_status_interested_count",
 				Unique:  false,
-				Columns: []*schema.Column{EventsColumns[1], EventsColumns[22]},
+				Columns: []*schema.Column{EventsColumns[1], EventsColumns[24]},
 			},
 		},
 	}
@@ -112,6 +152,8 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_by", Type: field.TypeString, Nullable: true},
 		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "recurrence_type", Type: field.TypeString},
 		{Name: "start_time", Type: field.TypeTime, Nullable: true},
@@ -131,7 +173,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "event_occurrences_events_occurrences",
-				Columns:    []*schema.Column{EventOccurrencesColumns[15]},
+				Columns:    []*schema.Column{EventOccurrencesColumns[17]},
 				RefColumns: []*schema.Column{EventsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -140,27 +182,27 @@ var (
 			{
 				Name:    "eventoccurrence_event_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{EventOccurrencesColumns[15], EventOccurrencesColumns[1]},
+				Columns: []*schema.Column{EventOccurrencesColumns[17], EventOccurrencesColumns[1]},
 			},
 			{
 				Name:    "eventoccurrence_recurrence_type_status",
 				Unique:  false,
-				Columns: []*schema.Column{EventOccurrencesColumns[7], EventOccurrencesColumns[1]},
+				Columns: []*schema.Column{EventOccurrencesColumns[9], EventOccurrencesColumns[1]},
 			},
 			{
 				Name:    "eventoccurrence_day_of_week_status",
 				Unique:  false,
-				Columns: []*schema.Column{EventOccurrencesColumns[11], EventOccurrencesColumns[1]},
+				Columns: []*schema.Column{EventOccurrencesColumns[13], EventOccurrencesColumns[1]},
 			},
 			{
 				Name:    "eventoccurrence_day_of_month_status",
 				Unique:  false,
-				Columns: []*schema.Column{EventOccurrencesColumns[12], EventOccurrencesColumns[1]},
+				Columns: []*schema.Column{EventOccurrencesColumns[14], EventOccurrencesColumns[1]},
 			},
 			{
 				Name:    "eventoccurrence_month_of_year_day_of_month_status",
 				Unique:  false,
-				Columns: []*schema.Column{EventOccurrencesColumns[13], EventOccurrencesColumns[12], EventOccurrencesColumns[1]},
+				Columns: []*schema.Column{EventOccurrencesColumns[15], EventOccurrencesColumns[14], EventOccurrencesColumns[1]},
 			},
 		},
 	}
@@ -172,6 +214,8 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_by", Type: field.TypeString, Nullable: true},
 		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "slug", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
@@ -206,47 +250,47 @@ var (
 			{
 				Name:    "hotel_slug_status",
 				Unique:  true,
-				Columns: []*schema.Column{HotelsColumns[7], HotelsColumns[1]},
+				Columns: []*schema.Column{HotelsColumns[9], HotelsColumns[1]},
 			},
 			{
 				Name:    "hotel_latitude_longitude",
 				Unique:  false,
-				Columns: []*schema.Column{HotelsColumns[15], HotelsColumns[16]},
+				Columns: []*schema.Column{HotelsColumns[17], HotelsColumns[18]},
 			},
 			{
 				Name:    "hotel_star_rating",
 				Unique:  false,
-				Columns: []*schema.Column{HotelsColumns[10]},
+				Columns: []*schema.Column{HotelsColumns[12]},
 			},
 			{
 				Name:    "hotel_price_min_price_max",
 				Unique:  false,
-				Columns: []*schema.Column{HotelsColumns[22], HotelsColumns[23]},
+				Columns: []*schema.Column{HotelsColumns[24], HotelsColumns[25]},
 			},
 			{
 				Name:    "hotel_popularity_score",
 				Unique:  false,
-				Columns: []*schema.Column{HotelsColumns[29]},
+				Columns: []*schema.Column{HotelsColumns[31]},
 			},
 			{
 				Name:    "hotel_view_count",
 				Unique:  false,
-				Columns: []*schema.Column{HotelsColumns[25]},
+				Columns: []*schema.Column{HotelsColumns[27]},
 			},
 			{
 				Name:    "hotel_rating_avg",
 				Unique:  false,
-				Columns: []*schema.Column{HotelsColumns[26]},
+				Columns: []*schema.Column{HotelsColumns[28]},
 			},
 			{
 				Name:    "hotel_last_viewed_at",
 				Unique:  false,
-				Columns: []*schema.Column{HotelsColumns[28]},
+				Columns: []*schema.Column{HotelsColumns[30]},
 			},
 			{
 				Name:    "hotel_last_viewed_at_popularity_score",
 				Unique:  false,
-				Columns: []*schema.Column{HotelsColumns[28], HotelsColumns[29]},
+				Columns: []*schema.Column{HotelsColumns[30], HotelsColumns[31]},
 			},
 		},
 	}
@@ -258,6 +302,8 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_by", Type: field.TypeString, Nullable: true},
 		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "title", Type: field.TypeString, Size: 255},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
@@ -279,7 +325,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "itineraries_users_itineraries",
-				Columns:    []*schema.Column{ItinerariesColumns[17]},
+				Columns:    []*schema.Column{ItinerariesColumns[19]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -288,12 +334,12 @@ var (
 			{
 				Name:    "itinerary_user_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{ItinerariesColumns[17], ItinerariesColumns[1]},
+				Columns: []*schema.Column{ItinerariesColumns[19], ItinerariesColumns[1]},
 			},
 			{
 				Name:    "itinerary_user_id_planned_date",
 				Unique:  false,
-				Columns: []*schema.Column{ItinerariesColumns[17], ItinerariesColumns[9]},
+				Columns: []*schema.Column{ItinerariesColumns[19], ItinerariesColumns[11]},
 			},
 			{
 				Name:    "itinerary_status",
@@ -302,6 +348,41 @@ var (
 			},
 		},
 	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "event_type", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(100)"}},
+		{Name: "aggregate_type", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "aggregate_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "payload", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "status", Type: field.TypeString, Default: "pending", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "attempts", Type: field.TypeInt, Default: 0, SchemaType: map[string]string{"postgres": "integer"}},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "available_at", Type: field.TypeTime},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_available_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'pending'",
+				},
+			},
+			{
+				Name:    "outboxevent_aggregate_type_aggregate_id",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[2], OutboxEventsColumns[3]},
+			},
+		},
+	}
 	// PlacesColumns holds the columns for the "places" table.
 	PlacesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
@@ -310,6 +391,8 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_by", Type: field.TypeString, Nullable: true},
 		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "slug", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "title", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
@@ -320,6 +403,7 @@ var (
 		{Name: "address", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "latitude", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "decimal(10,8)"}},
 		{Name: "longitude", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "decimal(11,8)"}},
+		{Name: "geohash", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(12)"}},
 		{Name: "primary_image_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "thumbnail_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "view_count", Type: field.TypeInt, Default: 0, SchemaType: map[string]string{"postgres": "integer"}},
@@ -329,12 +413,31 @@ var (
 		{Name: "popularity_score", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "decimal(10,4)"}},
 		{Name: "avg_visit_minutes", Type: field.TypeInt, Default: 60, SchemaType: map[string]string{"postgres": "integer"}},
 		{Name: "opening_hours", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "price_info", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "contact", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "accessibility", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "external_refs", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "geofence_radius_m", Type: field.TypeInt, Nullable: true, SchemaType: map[string]string{"postgres": "integer"}},
+		{Name: "publish_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp with time zone"}},
+		{Name: "unpublish_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp with time zone"}},
 	}
 	// PlacesTable holds the schema information for the "places" table.
 	PlacesTable = &schema.Table{
 		Name:       "places",
 		Columns:    PlacesColumns,
 		PrimaryKey: []*schema.Column{PlacesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "place_slug",
+				Unique:  true,
+				Columns: []*schema.Column{PlacesColumns[9]},
+			},
+			{
+				Name:    "place_updated_by_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{PlacesColumns[5], PlacesColumns[3]},
+			},
+		},
 	}
 	// PlaceImagesColumns holds the columns for the "place_images" table.
 	PlaceImagesColumns = []*schema.Column{
@@ -344,6 +447,8 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_by", Type: field.TypeString, Nullable: true},
 		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "url", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "alt", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
@@ -358,7 +463,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "place_images_places_images",
-				Columns:    []*schema.Column{PlaceImagesColumns[10]},
+				Columns:    []*schema.Column{PlaceImagesColumns[12]},
 				RefColumns: []*schema.Column{PlacesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -367,12 +472,87 @@ var (
 			{
 				Name:    "placeimage_place_id",
 				Unique:  false,
-				Columns: []*schema.Column{PlaceImagesColumns[10]},
+				Columns: []*schema.Column{PlaceImagesColumns[12]},
 			},
 			{
 				Name:    "placeimage_place_id_pos",
 				Unique:  false,
-				Columns: []*schema.Column{PlaceImagesColumns[10], PlaceImagesColumns[9]},
+				Columns: []*schema.Column{PlaceImagesColumns[12], PlaceImagesColumns[11]},
+			},
+		},
+	}
+	// PlaceRevisionsColumns holds the columns for the "place_revisions" table.
+	PlaceRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "revision", Type: field.TypeInt, SchemaType: map[string]string{"postgres": "integer"}},
+		{Name: "snapshot", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "reverted_from", Type: field.TypeInt, Nullable: true, SchemaType: map[string]string{"postgres": "integer"}},
+		{Name: "place_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+	}
+	// PlaceRevisionsTable holds the schema information for the "place_revisions" table.
+	PlaceRevisionsTable = &schema.Table{
+		Name:       "place_revisions",
+		Columns:    PlaceRevisionsColumns,
+		PrimaryKey: []*schema.Column{PlaceRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "place_revisions_places_revisions",
+				Columns:    []*schema.Column{PlaceRevisionsColumns[11]},
+				RefColumns: []*schema.Column{PlacesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "placerevision_place_id_revision",
+				Unique:  true,
+				Columns: []*schema.Column{PlaceRevisionsColumns[11], PlaceRevisionsColumns[8]},
+			},
+		},
+	}
+	// PlaceSlugsColumns holds the columns for the "place_slugs" table.
+	PlaceSlugsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "slug", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "place_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+	}
+	// PlaceSlugsTable holds the schema information for the "place_slugs" table.
+	PlaceSlugsTable = &schema.Table{
+		Name:       "place_slugs",
+		Columns:    PlaceSlugsColumns,
+		PrimaryKey: []*schema.Column{PlaceSlugsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "place_slugs_places_slug_history",
+				Columns:    []*schema.Column{PlaceSlugsColumns[9]},
+				RefColumns: []*schema.Column{PlacesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "placeslug_slug",
+				Unique:  true,
+				Columns: []*schema.Column{PlaceSlugsColumns[8]},
+			},
+			{
+				Name:    "placeslug_place_id",
+				Unique:  false,
+				Columns: []*schema.Column{PlaceSlugsColumns[9]},
 			},
 		},
 	}
@@ -384,6 +564,8 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_by", Type: field.TypeString, Nullable: true},
 		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "entity_type", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "entity_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
@@ -407,42 +589,42 @@ var (
 			{
 				Name:    "review_entity_type_entity_id",
 				Unique:  false,
-				Columns: []*schema.Column{ReviewsColumns[7], ReviewsColumns[8]},
+				Columns: []*schema.Column{ReviewsColumns[9], ReviewsColumns[10]},
 			},
 			{
 				Name:    "review_entity_type_entity_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{ReviewsColumns[7], ReviewsColumns[8], ReviewsColumns[1]},
+				Columns: []*schema.Column{ReviewsColumns[9], ReviewsColumns[10], ReviewsColumns[1]},
 			},
 			{
 				Name:    "review_user_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{ReviewsColumns[9], ReviewsColumns[1]},
+				Columns: []*schema.Column{ReviewsColumns[11], ReviewsColumns[1]},
 			},
 			{
 				Name:    "review_entity_type_entity_id_rating",
 				Unique:  false,
-				Columns: []*schema.Column{ReviewsColumns[7], ReviewsColumns[8], ReviewsColumns[10]},
+				Columns: []*schema.Column{ReviewsColumns[9], ReviewsColumns[10], ReviewsColumns[12]},
 			},
 			{
 				Name:    "review_entity_type_entity_id_is_featured_status",
 				Unique:  false,
-				Columns: []*schema.Column{ReviewsColumns[7], ReviewsColumns[8], ReviewsColumns[18], ReviewsColumns[1]},
+				Columns: []*schema.Column{ReviewsColumns[9], ReviewsColumns[10], ReviewsColumns[20], ReviewsColumns[1]},
 			},
 			{
 				Name:    "review_entity_type_entity_id_helpful_count",
 				Unique:  false,
-				Columns: []*schema.Column{ReviewsColumns[7], ReviewsColumns[8], ReviewsColumns[15]},
+				Columns: []*schema.Column{ReviewsColumns[9], ReviewsColumns[10], ReviewsColumns[17]},
 			},
 			{
 				Name:    "review_entity_type_entity_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{ReviewsColumns[7], ReviewsColumns[8], ReviewsColumns[2]},
+				Columns: []*schema.Column{ReviewsColumns[9], ReviewsColumns[10], ReviewsColumns[2]},
 			},
 			{
 				Name:    "review_entity_type_entity_id_rating_helpful_count_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{ReviewsColumns[7], ReviewsColumns[8], ReviewsColumns[10], ReviewsColumns[15], ReviewsColumns[2]},
+				Columns: []*schema.Column{ReviewsColumns[9], ReviewsColumns[10], ReviewsColumns[12], ReviewsColumns[17], ReviewsColumns[2]},
 			},
 		},
 	}
@@ -454,6 +636,8 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_by", Type: field.TypeString, Nullable: true},
 		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 		{Name: "email", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
@@ -469,7 +653,7 @@ var (
 			{
 				Name:    "user_email",
 				Unique:  true,
-				Columns: []*schema.Column{UsersColumns[8]},
+				Columns: []*schema.Column{UsersColumns[10]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "email IS NOT NULL AND email != ''",
 				},
@@ -477,7 +661,7 @@ var (
 			{
 				Name:    "user_phone",
 				Unique:  true,
-				Columns: []*schema.Column{UsersColumns[9]},
+				Columns: []*schema.Column{UsersColumns[11]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "phone IS NOT NULL AND phone != ''",
 				},
@@ -485,7 +669,7 @@ var (
 			{
 				Name:    "user_email_phone",
 				Unique:  true,
-				Columns: []*schema.Column{UsersColumns[8], UsersColumns[9]},
+				Columns: []*schema.Column{UsersColumns[10], UsersColumns[11]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "(email IS NOT NULL AND email != '') AND (phone IS NOT NULL AND phone != '')",
 				},
@@ -500,6 +684,8 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_by", Type: field.TypeString, Nullable: true},
 		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "sequence_order", Type: field.TypeInt},
 		{Name: "planned_duration_minutes", Type: field.TypeInt, Default: 60},
 		{Name: "distance_from_previous_km", Type: field.TypeFloat64, Nullable: true},
@@ -517,13 +703,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "visits_itineraries_visits",
-				Columns:    []*schema.Column{VisitsColumns[12]},
+				Columns:    []*schema.Column{VisitsColumns[14]},
 				RefColumns: []*schema.Column{ItinerariesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "visits_places_visits",
-				Columns:    []*schema.Column{VisitsColumns[13]},
+				Columns:    []*schema.Column{VisitsColumns[15]},
 				RefColumns: []*schema.Column{PlacesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -532,12 +718,12 @@ var (
 			{
 				Name:    "visit_itinerary_id_sequence_order",
 				Unique:  true,
-				Columns: []*schema.Column{VisitsColumns[12], VisitsColumns[6]},
+				Columns: []*schema.Column{VisitsColumns[14], VisitsColumns[8]},
 			},
 			{
 				Name:    "visit_place_id",
 				Unique:  false,
-				Columns: []*schema.Column{VisitsColumns[13]},
+				Columns: []*schema.Column{VisitsColumns[15]},
 			},
 		},
 	}
@@ -568,13 +754,17 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
 		CategoriesTable,
 		EventsTable,
 		EventOccurrencesTable,
 		HotelsTable,
 		ItinerariesTable,
+		OutboxEventsTable,
 		PlacesTable,
 		PlaceImagesTable,
+		PlaceRevisionsTable,
+		PlaceSlugsTable,
 		ReviewsTable,
 		UsersTable,
 		VisitsTable,
@@ -586,6 +776,8 @@ func init() {
 	EventOccurrencesTable.ForeignKeys[0].RefTable = EventsTable
 	ItinerariesTable.ForeignKeys[0].RefTable = UsersTable
 	PlaceImagesTable.ForeignKeys[0].RefTable = PlacesTable
+	PlaceRevisionsTable.ForeignKeys[0].RefTable = PlacesTable
+	PlaceSlugsTable.ForeignKeys[0].RefTable = PlacesTable
 	VisitsTable.ForeignKeys[0].RefTable = ItinerariesTable
 	VisitsTable.ForeignKeys[1].RefTable = PlacesTable
 	CategoryPlacesTable.ForeignKeys[0].RefTable = CategoriesTable
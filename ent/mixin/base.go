@@ -1,7 +1,7 @@
 package mixin
 
 import (
-	"time"
+	"context"
 
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
@@ -18,27 +18,106 @@ type BaseMixin struct {
 func (BaseMixin) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("status").
+			GoType(types.Status("")).
 			SchemaType(map[string]string{
 				"postgres": "varchar(20)",
 			}).
-			Default(string(types.StatusPublished)),
+			Default(string(types.StatusPublished)).
+			Validate(func(s string) error {
+				return types.Status(s).Validate()
+			}),
 		field.Time("created_at").
 			Immutable().
-			Default(time.Now),
+			Default(types.NowUTC),
 		field.Time("updated_at").
-			Default(time.Now).
-			UpdateDefault(time.Now),
+			Default(types.NowUTC).
+			UpdateDefault(types.NowUTC),
 		field.String("created_by").
 			Optional().
 			Immutable(),
 		field.String("updated_by").
 			Optional(),
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+		field.String("deleted_by").
+			Optional(),
 	}
 }
 
 // Hooks of the BaseMixin.
 func (BaseMixin) Hooks() []ent.Hook {
 	return []ent.Hook{
-		// Add hooks for updating updated_at and updated_by
+		auditActorHook,
+		deletionHook,
 	}
 }
+
+// deletionHook records when and by whom a row was soft-deleted whenever its status is set to
+// a soft-deleted one (archived by Delete, or deleted), and clears both when the status is set
+// to a live one, e.g. on restore. A row that already was soft-deleted keeps its original
+// deletion time.
+func deletionHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		value, ok := m.Field("status")
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+		status, _ := value.(types.Status)
+
+		switch {
+		case status.IsSoftDeleted():
+			if m.Op().Is(ent.OpUpdateOne) {
+				if old, err := m.OldField(ctx, "status"); err == nil {
+					if old, _ := old.(types.Status); old.IsSoftDeleted() {
+						break
+					}
+				}
+			}
+			if err := m.SetField("deleted_at", types.NowUTC()); err != nil {
+				return nil, err
+			}
+			if err := m.SetField("deleted_by", types.GetActor(ctx)); err != nil {
+				return nil, err
+			}
+		case !m.Op().Is(ent.OpCreate):
+			for _, name := range []string{"deleted_at", "deleted_by"} {
+				if err := m.ClearField(name); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		return next.Mutate(ctx, m)
+	})
+}
+
+// auditActorHook guarantees created_by/updated_by are never written blank by
+// falling back to the actor resolved from the context (user, API key or system).
+func auditActorHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		actor := types.GetActor(ctx)
+
+		fields := []string{"updated_by"}
+		if m.Op().Is(ent.OpCreate) {
+			fields = append(fields, "created_by")
+		}
+
+		for _, name := range fields {
+			value, ok := m.Field(name)
+			blank := ok && value == ""
+			// On create a missing value is also blank; updates keep the stored value
+			if !ok && m.Op().Is(ent.OpCreate) {
+				blank = true
+			}
+			if !blank {
+				continue
+			}
+			if err := m.SetField(name, actor); err != nil {
+				return nil, err
+			}
+		}
+
+		return next.Mutate(ctx, m)
+	})
+}
@@ -0,0 +1,204 @@
+package mixin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/omkar273/nashikdarshan/ent"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// offlineDriver fails every statement, so mutations can be built and hooked without a database
+type offlineDriver struct{}
+
+var errOffline = errors.New("offline driver")
+
+func (offlineDriver) Exec(context.Context, string, any, any) error  { return errOffline }
+func (offlineDriver) Query(context.Context, string, any, any) error { return errOffline }
+func (offlineDriver) Tx(context.Context) (dialect.Tx, error)        { return nil, errOffline }
+func (offlineDriver) Close() error                                  { return nil }
+func (offlineDriver) Dialect() string                               { return dialect.Postgres }
+
+func TestDeletionHook(t *testing.T) {
+	client := ent.NewClient(ent.Driver(offlineDriver{}))
+	ctx := context.WithValue(context.Background(), types.CtxUserID, "usr_admin")
+
+	tests := []struct {
+		name        string
+		mutation    func() ent.Mutation
+		wantDeleted bool
+		wantCleared bool
+	}{
+		{
+			name: "delete archives",
+			mutation: func() ent.Mutation {
+				return client.Place.UpdateOneID("plc_1").SetStatus(types.StatusArchived).Mutation()
+			},
+			wantDeleted: true,
+		},
+		{
+			name: "status deleted",
+			mutation: func() ent.Mutation {
+				return client.Place.UpdateOneID("plc_1").SetStatus(types.StatusDeleted).Mutation()
+			},
+			wantDeleted: true,
+		},
+		{
+			name: "bulk archive",
+			mutation: func() ent.Mutation {
+				return client.PlaceImage.Update().SetStatus(types.StatusArchived).Mutation()
+			},
+			wantDeleted: true,
+		},
+		{
+			name: "restore",
+			mutation: func() ent.Mutation {
+				return client.Place.UpdateOneID("plc_1").SetStatus(types.StatusPublished).Mutation()
+			},
+			wantCleared: true,
+		},
+		{
+			name: "create published",
+			mutation: func() ent.Mutation {
+				return client.Place.Create().SetStatus(types.StatusPublished).Mutation()
+			},
+		},
+		{
+			name: "update without status",
+			mutation: func() ent.Mutation {
+				return client.Place.UpdateOneID("plc_1").SetTitle("Kalaram Temple").Mutation()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := tt.mutation()
+			hooked := deletionHook(ent.MutateFunc(func(context.Context, ent.Mutation) (ent.Value, error) {
+				return nil, nil
+			}))
+			if _, err := hooked.Mutate(ctx, m); err != nil {
+				t.Fatalf("Mutate() error = %v", err)
+			}
+
+			_, hasDeletedAt := m.Field("deleted_at")
+			deletedBy, _ := m.Field("deleted_by")
+			if hasDeletedAt != tt.wantDeleted {
+				t.Errorf("deleted_at set = %v, want %v", hasDeletedAt, tt.wantDeleted)
+			}
+			if tt.wantDeleted && deletedBy != "usr_admin" {
+				t.Errorf("deleted_by = %v, want usr_admin", deletedBy)
+			}
+
+			for _, name := range []string{"deleted_at", "deleted_by"} {
+				if cleared := m.FieldCleared(name); cleared != tt.wantCleared {
+					t.Errorf("%s cleared = %v, want %v", name, cleared, tt.wantCleared)
+				}
+			}
+		})
+	}
+}
+
+func TestAuditActorHook(t *testing.T) {
+	client := ent.NewClient(ent.Driver(offlineDriver{}))
+	userCtx := context.WithValue(context.Background(), types.CtxUserID, "usr_admin")
+	// An API key carries no user id, so its label is recorded instead
+	keyCtx := context.WithValue(context.Background(), types.CtxAPIKeyID, "key_1")
+	keyCtx = context.WithValue(keyCtx, types.CtxAPIKeyLabel, "importer")
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		mutation      func() ent.Mutation
+		wantCreatedBy string
+		wantUpdatedBy string
+	}{
+		{
+			name: "create by user",
+			ctx:  userCtx,
+			mutation: func() ent.Mutation {
+				return client.Place.Create().SetTitle("Ramkund").Mutation()
+			},
+			wantCreatedBy: "usr_admin",
+			wantUpdatedBy: "usr_admin",
+		},
+		{
+			name: "create by API key",
+			ctx:  keyCtx,
+			mutation: func() ent.Mutation {
+				return client.Place.Create().SetTitle("Ramkund").Mutation()
+			},
+			wantCreatedBy: types.APIKeyActorPrefix + "importer",
+			wantUpdatedBy: types.APIKeyActorPrefix + "importer",
+		},
+		{
+			name: "create without principal",
+			ctx:  context.Background(),
+			mutation: func() ent.Mutation {
+				return client.Place.Create().SetTitle("Ramkund").Mutation()
+			},
+			wantCreatedBy: types.DefaultSystemActor,
+			wantUpdatedBy: types.DefaultSystemActor,
+		},
+		{
+			name: "create with blank actors",
+			ctx:  userCtx,
+			mutation: func() ent.Mutation {
+				return client.Place.Create().SetCreatedBy("").SetUpdatedBy("").Mutation()
+			},
+			wantCreatedBy: "usr_admin",
+			wantUpdatedBy: "usr_admin",
+		},
+		{
+			name: "create keeps explicit actors",
+			ctx:  userCtx,
+			mutation: func() ent.Mutation {
+				return client.Place.Create().SetCreatedBy("usr_editor").SetUpdatedBy("usr_editor").Mutation()
+			},
+			wantCreatedBy: "usr_editor",
+			wantUpdatedBy: "usr_editor",
+		},
+		{
+			name: "update with blank actor",
+			ctx:  keyCtx,
+			mutation: func() ent.Mutation {
+				return client.Place.UpdateOneID("plc_1").SetUpdatedBy("").Mutation()
+			},
+			wantUpdatedBy: types.APIKeyActorPrefix + "importer",
+		},
+		{
+			name: "update without actor keeps the stored one",
+			ctx:  userCtx,
+			mutation: func() ent.Mutation {
+				return client.Place.UpdateOneID("plc_1").SetTitle("Kalaram Temple").Mutation()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := tt.mutation()
+			hooked := auditActorHook(ent.MutateFunc(func(context.Context, ent.Mutation) (ent.Value, error) {
+				return nil, nil
+			}))
+			if _, err := hooked.Mutate(tt.ctx, m); err != nil {
+				t.Fatalf("Mutate() error = %v", err)
+			}
+
+			for name, want := range map[string]string{"created_by": tt.wantCreatedBy, "updated_by": tt.wantUpdatedBy} {
+				got, ok := m.Field(name)
+				if want == "" {
+					if ok {
+						t.Errorf("%s = %v, want unset", name, got)
+					}
+					continue
+				}
+				if got != want {
+					t.Errorf("%s = %v, want %s", name, got, want)
+				}
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sync"
@@ -11,17 +12,22 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/nashikdarshan/ent/apikey"
 	"github.com/omkar273/nashikdarshan/ent/category"
 	"github.com/omkar273/nashikdarshan/ent/event"
 	"github.com/omkar273/nashikdarshan/ent/eventoccurrence"
 	"github.com/omkar273/nashikdarshan/ent/hotel"
 	"github.com/omkar273/nashikdarshan/ent/itinerary"
+	"github.com/omkar273/nashikdarshan/ent/outboxevent"
 	"github.com/omkar273/nashikdarshan/ent/place"
 	"github.com/omkar273/nashikdarshan/ent/placeimage"
+	"github.com/omkar273/nashikdarshan/ent/placerevision"
+	"github.com/omkar273/nashikdarshan/ent/placeslug"
 	"github.com/omkar273/nashikdarshan/ent/predicate"
 	"github.com/omkar273/nashikdarshan/ent/review"
 	"github.com/omkar273/nashikdarshan/ent/user"
 	"github.com/omkar273/nashikdarshan/ent/visit"
+	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -34,53 +40,60 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeAPIKey          = "APIKey"
 	TypeCategory        = "Category"
 	TypeEvent           = "Event"
 	TypeEventOccurrence = "EventOccurrence"
 	TypeHotel           = "Hotel"
 	TypeItinerary       = "Itinerary"
+	TypeOutboxEvent     = "OutboxEvent"
 	TypePlace           = "Place"
 	TypePlaceImage      = "PlaceImage"
+	TypePlaceRevision   = "PlaceRevision"
+	TypePlaceSlug       = "PlaceSlug"
 	TypeReview          = "Review"
 	TypeUser            = "User"
 	TypeVisit           = "Visit"
 )
 
-// CategoryMutation represents an operation that mutates the Category nodes in the graph.
-type CategoryMutation struct {
+// APIKeyMutation represents an operation that mutates the APIKey nodes in the graph.
+type APIKeyMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *string
-	status        *string
+	status        *types.Status
 	created_at    *time.Time
 	updated_at    *time.Time
 	created_by    *string
 	updated_by    *string
-	metadata      *map[string]string
-	name          *string
-	slug          *string
-	description   *string
+	deleted_at    *time.Time
+	deleted_by    *string
+	label         *string
+	key_hash      *string
+	key_prefix    *string
+	scopes        *[]string
+	appendscopes  []string
+	last_used_at  *time.Time
+	expires_at    *time.Time
+	revoked_at    *time.Time
 	clearedFields map[string]struct{}
-	places        map[string]struct{}
-	removedplaces map[string]struct{}
-	clearedplaces bool
 	done          bool
-	oldValue      func(context.Context) (*Category, error)
-	predicates    []predicate.Category
+	oldValue      func(context.Context) (*APIKey, error)
+	predicates    []predicate.APIKey
 }
 
-var _ ent.Mutation = (*CategoryMutation)(nil)
+var _ ent.Mutation = (*APIKeyMutation)(nil)
 
-// categoryOption allows management of the mutation configuration using functional options.
-type categoryOption func(*CategoryMutation)
+// apikeyOption allows management of the mutation configuration using functional options.
+type apikeyOption func(*APIKeyMutation)
 
-// newCategoryMutation creates new mutation for the Category entity.
-func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutation {
-	m := &CategoryMutation{
+// newAPIKeyMutation creates new mutation for the APIKey entity.
+func newAPIKeyMutation(c config, op Op, opts ...apikeyOption) *APIKeyMutation {
+	m := &APIKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCategory,
+		typ:           TypeAPIKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -89,20 +102,20 @@ func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutat
 	return m
 }
 
-// withCategoryID sets the ID field of the mutation.
-func withCategoryID(id string) categoryOption {
-	return func(m *CategoryMutation) {
+// withAPIKeyID sets the ID field of the mutation.
+func withAPIKeyID(id string) apikeyOption {
+	return func(m *APIKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Category
+			value *APIKey
 		)
-		m.oldValue = func(ctx context.Context) (*Category, error) {
+		m.oldValue = func(ctx context.Context) (*APIKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Category.Get(ctx, id)
+					value, err = m.Client().APIKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -111,10 +124,10 @@ func withCategoryID(id string) categoryOption {
 	}
 }
 
-// withCategory sets the old Category of the mutation.
-func withCategory(node *Category) categoryOption {
-	return func(m *CategoryMutation) {
-		m.oldValue = func(context.Context) (*Category, error) {
+// withAPIKey sets the old APIKey of the mutation.
+func withAPIKey(node *APIKey) apikeyOption {
+	return func(m *APIKeyMutation) {
+		m.oldValue = func(context.Context) (*APIKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -123,7 +136,7 @@ func withCategory(node *Category) categoryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CategoryMutation) Client() *Client {
+func (m APIKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -131,7 +144,7 @@ func (m CategoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CategoryMutation) Tx() (*Tx, error) {
+func (m APIKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -141,14 +154,14 @@ func (m CategoryMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Category entities.
-func (m *CategoryMutation) SetID(id string) {
+// operation is only accepted on creation of APIKey entities.
+func (m *APIKeyMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CategoryMutation) ID() (id string, exists bool) {
+func (m *APIKeyMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -159,7 +172,7 @@ func (m *CategoryMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CategoryMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *APIKeyMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -168,19 +181,19 @@ func (m *CategoryMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Category.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().APIKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetStatus sets the "status" field.
-func (m *CategoryMutation) SetStatus(s string) {
-	m.status = &s
+func (m *APIKeyMutation) SetStatus(t types.Status) {
+	m.status = &t
 }
 
 // Status returns the value of the "status" field in the mutation.
-func (m *CategoryMutation) Status() (r string, exists bool) {
+func (m *APIKeyMutation) Status() (r types.Status, exists bool) {
 	v := m.status
 	if v == nil {
 		return
@@ -188,10 +201,10 @@ func (m *CategoryMutation) Status() (r string, exists bool) {
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldStatus(ctx context.Context) (v types.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
@@ -206,17 +219,17 @@ func (m *CategoryMutation) OldStatus(ctx context.Context) (v string, err error)
 }
 
 // ResetStatus resets all changes to the "status" field.
-func (m *CategoryMutation) ResetStatus() {
+func (m *APIKeyMutation) ResetStatus() {
 	m.status = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *CategoryMutation) SetCreatedAt(t time.Time) {
+func (m *APIKeyMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CategoryMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *APIKeyMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -224,10 +237,10 @@ func (m *CategoryMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APIKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -242,17 +255,17 @@ func (m *CategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CategoryMutation) ResetCreatedAt() {
+func (m *APIKeyMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *CategoryMutation) SetUpdatedAt(t time.Time) {
+func (m *APIKeyMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CategoryMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *APIKeyMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -260,10 +273,10 @@ func (m *CategoryMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APIKeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -278,17 +291,17 @@ func (m *CategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CategoryMutation) ResetUpdatedAt() {
+func (m *APIKeyMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetCreatedBy sets the "created_by" field.
-func (m *CategoryMutation) SetCreatedBy(s string) {
+func (m *APIKeyMutation) SetCreatedBy(s string) {
 	m.created_by = &s
 }
 
 // CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *CategoryMutation) CreatedBy() (r string, exists bool) {
+func (m *APIKeyMutation) CreatedBy() (r string, exists bool) {
 	v := m.created_by
 	if v == nil {
 		return
@@ -296,10 +309,10 @@ func (m *CategoryMutation) CreatedBy() (r string, exists bool) {
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
@@ -314,30 +327,30 @@ func (m *CategoryMutation) OldCreatedBy(ctx context.Context) (v string, err erro
 }
 
 // ClearCreatedBy clears the value of the "created_by" field.
-func (m *CategoryMutation) ClearCreatedBy() {
+func (m *APIKeyMutation) ClearCreatedBy() {
 	m.created_by = nil
-	m.clearedFields[category.FieldCreatedBy] = struct{}{}
+	m.clearedFields[apikey.FieldCreatedBy] = struct{}{}
 }
 
 // CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *CategoryMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[category.FieldCreatedBy]
+func (m *APIKeyMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldCreatedBy]
 	return ok
 }
 
 // ResetCreatedBy resets all changes to the "created_by" field.
-func (m *CategoryMutation) ResetCreatedBy() {
+func (m *APIKeyMutation) ResetCreatedBy() {
 	m.created_by = nil
-	delete(m.clearedFields, category.FieldCreatedBy)
+	delete(m.clearedFields, apikey.FieldCreatedBy)
 }
 
 // SetUpdatedBy sets the "updated_by" field.
-func (m *CategoryMutation) SetUpdatedBy(s string) {
+func (m *APIKeyMutation) SetUpdatedBy(s string) {
 	m.updated_by = &s
 }
 
 // UpdatedBy returns the value of the "updated_by" field in the mutation.
-func (m *CategoryMutation) UpdatedBy() (r string, exists bool) {
+func (m *APIKeyMutation) UpdatedBy() (r string, exists bool) {
 	v := m.updated_by
 	if v == nil {
 		return
@@ -345,10 +358,10 @@ func (m *CategoryMutation) UpdatedBy() (r string, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedBy returns the old "updated_by" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedBy returns the old "updated_by" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
 	}
@@ -363,308 +376,503 @@ func (m *CategoryMutation) OldUpdatedBy(ctx context.Context) (v string, err erro
 }
 
 // ClearUpdatedBy clears the value of the "updated_by" field.
-func (m *CategoryMutation) ClearUpdatedBy() {
+func (m *APIKeyMutation) ClearUpdatedBy() {
 	m.updated_by = nil
-	m.clearedFields[category.FieldUpdatedBy] = struct{}{}
+	m.clearedFields[apikey.FieldUpdatedBy] = struct{}{}
 }
 
 // UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
-func (m *CategoryMutation) UpdatedByCleared() bool {
-	_, ok := m.clearedFields[category.FieldUpdatedBy]
+func (m *APIKeyMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldUpdatedBy]
 	return ok
 }
 
 // ResetUpdatedBy resets all changes to the "updated_by" field.
-func (m *CategoryMutation) ResetUpdatedBy() {
+func (m *APIKeyMutation) ResetUpdatedBy() {
 	m.updated_by = nil
-	delete(m.clearedFields, category.FieldUpdatedBy)
+	delete(m.clearedFields, apikey.FieldUpdatedBy)
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *CategoryMutation) SetMetadata(value map[string]string) {
-	m.metadata = &value
+// SetDeletedAt sets the "deleted_at" field.
+func (m *APIKeyMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *CategoryMutation) Metadata() (r map[string]string, exists bool) {
-	v := m.metadata
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *APIKeyMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+func (m *APIKeyMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *CategoryMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[category.FieldMetadata] = struct{}{}
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *APIKeyMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[apikey.FieldDeletedAt] = struct{}{}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *CategoryMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[category.FieldMetadata]
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *APIKeyMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldDeletedAt]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *CategoryMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, category.FieldMetadata)
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *APIKeyMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, apikey.FieldDeletedAt)
 }
 
-// SetName sets the "name" field.
-func (m *CategoryMutation) SetName(s string) {
-	m.name = &s
+// SetDeletedBy sets the "deleted_by" field.
+func (m *APIKeyMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *CategoryMutation) Name() (r string, exists bool) {
-	v := m.name
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *APIKeyMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedBy returns the old "deleted_by" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.DeletedBy, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *CategoryMutation) ResetName() {
-	m.name = nil
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *APIKeyMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[apikey.FieldDeletedBy] = struct{}{}
 }
 
-// SetSlug sets the "slug" field.
-func (m *CategoryMutation) SetSlug(s string) {
-	m.slug = &s
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *APIKeyMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldDeletedBy]
+	return ok
 }
 
-// Slug returns the value of the "slug" field in the mutation.
-func (m *CategoryMutation) Slug() (r string, exists bool) {
-	v := m.slug
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *APIKeyMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, apikey.FieldDeletedBy)
+}
+
+// SetLabel sets the "label" field.
+func (m *APIKeyMutation) SetLabel(s string) {
+	m.label = &s
+}
+
+// Label returns the value of the "label" field in the mutation.
+func (m *APIKeyMutation) Label() (r string, exists bool) {
+	v := m.label
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlug returns the old "slug" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldLabel returns the old "label" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldSlug(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldLabel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlug requires an ID field in the mutation")
+		return v, errors.New("OldLabel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
 	}
-	return oldValue.Slug, nil
+	return oldValue.Label, nil
 }
 
-// ResetSlug resets all changes to the "slug" field.
-func (m *CategoryMutation) ResetSlug() {
-	m.slug = nil
+// ResetLabel resets all changes to the "label" field.
+func (m *APIKeyMutation) ResetLabel() {
+	m.label = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *CategoryMutation) SetDescription(s string) {
-	m.description = &s
+// SetKeyHash sets the "key_hash" field.
+func (m *APIKeyMutation) SetKeyHash(s string) {
+	m.key_hash = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *CategoryMutation) Description() (r string, exists bool) {
-	v := m.description
+// KeyHash returns the value of the "key_hash" field in the mutation.
+func (m *APIKeyMutation) KeyHash() (r string, exists bool) {
+	v := m.key_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyHash returns the old "key_hash" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldKeyHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldKeyHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyHash: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.KeyHash, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *CategoryMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[category.FieldDescription] = struct{}{}
+// ResetKeyHash resets all changes to the "key_hash" field.
+func (m *APIKeyMutation) ResetKeyHash() {
+	m.key_hash = nil
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *CategoryMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[category.FieldDescription]
-	return ok
+// SetKeyPrefix sets the "key_prefix" field.
+func (m *APIKeyMutation) SetKeyPrefix(s string) {
+	m.key_prefix = &s
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *CategoryMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, category.FieldDescription)
+// KeyPrefix returns the value of the "key_prefix" field in the mutation.
+func (m *APIKeyMutation) KeyPrefix() (r string, exists bool) {
+	v := m.key_prefix
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddPlaceIDs adds the "places" edge to the Place entity by ids.
-func (m *CategoryMutation) AddPlaceIDs(ids ...string) {
-	if m.places == nil {
-		m.places = make(map[string]struct{})
+// OldKeyPrefix returns the old "key_prefix" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldKeyPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyPrefix is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.places[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyPrefix: %w", err)
 	}
+	return oldValue.KeyPrefix, nil
 }
 
-// ClearPlaces clears the "places" edge to the Place entity.
-func (m *CategoryMutation) ClearPlaces() {
-	m.clearedplaces = true
+// ResetKeyPrefix resets all changes to the "key_prefix" field.
+func (m *APIKeyMutation) ResetKeyPrefix() {
+	m.key_prefix = nil
 }
 
-// PlacesCleared reports if the "places" edge to the Place entity was cleared.
-func (m *CategoryMutation) PlacesCleared() bool {
-	return m.clearedplaces
+// SetScopes sets the "scopes" field.
+func (m *APIKeyMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
 }
 
-// RemovePlaceIDs removes the "places" edge to the Place entity by IDs.
-func (m *CategoryMutation) RemovePlaceIDs(ids ...string) {
-	if m.removedplaces == nil {
-		m.removedplaces = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.places, ids[i])
-		m.removedplaces[ids[i]] = struct{}{}
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *APIKeyMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedPlaces returns the removed IDs of the "places" edge to the Place entity.
-func (m *CategoryMutation) RemovedPlacesIDs() (ids []string) {
-	for id := range m.removedplaces {
-		ids = append(ids, id)
+// OldScopes returns the old "scopes" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
 }
 
-// PlacesIDs returns the "places" edge IDs in the mutation.
-func (m *CategoryMutation) PlacesIDs() (ids []string) {
-	for id := range m.places {
-		ids = append(ids, id)
+// AppendScopes adds s to the "scopes" field.
+func (m *APIKeyMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *APIKeyMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
 	}
-	return
+	return m.appendscopes, true
 }
 
-// ResetPlaces resets all changes to the "places" edge.
-func (m *CategoryMutation) ResetPlaces() {
-	m.places = nil
-	m.clearedplaces = false
-	m.removedplaces = nil
+// ResetScopes resets all changes to the "scopes" field.
+func (m *APIKeyMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
 }
 
-// Where appends a list predicates to the CategoryMutation builder.
-func (m *CategoryMutation) Where(ps ...predicate.Category) {
-	m.predicates = append(m.predicates, ps...)
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *APIKeyMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// WhereP appends storage-level predicates to the CategoryMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CategoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Category, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *APIKeyMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *CategoryMutation) Op() Op {
-	return m.op
+// OldLastUsedAt returns the old "last_used_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *CategoryMutation) SetOp(op Op) {
-	m.op = op
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *APIKeyMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apikey.FieldLastUsedAt] = struct{}{}
 }
 
-// Type returns the node type of this mutation (Category).
-func (m *CategoryMutation) Type() string {
-	return m.typ
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *APIKeyMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldLastUsedAt]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *CategoryMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.status != nil {
-		fields = append(fields, category.FieldStatus)
-	}
-	if m.created_at != nil {
-		fields = append(fields, category.FieldCreatedAt)
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *APIKeyMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apikey.FieldLastUsedAt)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *APIKeyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *APIKeyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *APIKeyMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[apikey.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *APIKeyMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *APIKeyMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, apikey.FieldExpiresAt)
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *APIKeyMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *APIKeyMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *APIKeyMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[apikey.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *APIKeyMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *APIKeyMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, apikey.FieldRevokedAt)
+}
+
+// Where appends a list predicates to the APIKeyMutation builder.
+func (m *APIKeyMutation) Where(ps ...predicate.APIKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the APIKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *APIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *APIKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *APIKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (APIKey).
+func (m *APIKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *APIKeyMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.status != nil {
+		fields = append(fields, apikey.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, apikey.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, category.FieldUpdatedAt)
+		fields = append(fields, apikey.FieldUpdatedAt)
 	}
 	if m.created_by != nil {
-		fields = append(fields, category.FieldCreatedBy)
+		fields = append(fields, apikey.FieldCreatedBy)
 	}
 	if m.updated_by != nil {
-		fields = append(fields, category.FieldUpdatedBy)
+		fields = append(fields, apikey.FieldUpdatedBy)
 	}
-	if m.metadata != nil {
-		fields = append(fields, category.FieldMetadata)
+	if m.deleted_at != nil {
+		fields = append(fields, apikey.FieldDeletedAt)
 	}
-	if m.name != nil {
-		fields = append(fields, category.FieldName)
+	if m.deleted_by != nil {
+		fields = append(fields, apikey.FieldDeletedBy)
 	}
-	if m.slug != nil {
-		fields = append(fields, category.FieldSlug)
+	if m.label != nil {
+		fields = append(fields, apikey.FieldLabel)
 	}
-	if m.description != nil {
-		fields = append(fields, category.FieldDescription)
+	if m.key_hash != nil {
+		fields = append(fields, apikey.FieldKeyHash)
+	}
+	if m.key_prefix != nil {
+		fields = append(fields, apikey.FieldKeyPrefix)
+	}
+	if m.scopes != nil {
+		fields = append(fields, apikey.FieldScopes)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, apikey.FieldExpiresAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, apikey.FieldRevokedAt)
 	}
 	return fields
 }
@@ -672,26 +880,36 @@ func (m *CategoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
+func (m *APIKeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case category.FieldStatus:
+	case apikey.FieldStatus:
 		return m.Status()
-	case category.FieldCreatedAt:
+	case apikey.FieldCreatedAt:
 		return m.CreatedAt()
-	case category.FieldUpdatedAt:
+	case apikey.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case category.FieldCreatedBy:
+	case apikey.FieldCreatedBy:
 		return m.CreatedBy()
-	case category.FieldUpdatedBy:
+	case apikey.FieldUpdatedBy:
 		return m.UpdatedBy()
-	case category.FieldMetadata:
-		return m.Metadata()
-	case category.FieldName:
-		return m.Name()
-	case category.FieldSlug:
-		return m.Slug()
-	case category.FieldDescription:
-		return m.Description()
+	case apikey.FieldDeletedAt:
+		return m.DeletedAt()
+	case apikey.FieldDeletedBy:
+		return m.DeletedBy()
+	case apikey.FieldLabel:
+		return m.Label()
+	case apikey.FieldKeyHash:
+		return m.KeyHash()
+	case apikey.FieldKeyPrefix:
+		return m.KeyPrefix()
+	case apikey.FieldScopes:
+		return m.Scopes()
+	case apikey.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case apikey.FieldExpiresAt:
+		return m.ExpiresAt()
+	case apikey.FieldRevokedAt:
+		return m.RevokedAt()
 	}
 	return nil, false
 }
@@ -699,341 +917,368 @@ func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *APIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case category.FieldStatus:
+	case apikey.FieldStatus:
 		return m.OldStatus(ctx)
-	case category.FieldCreatedAt:
+	case apikey.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case category.FieldUpdatedAt:
+	case apikey.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case category.FieldCreatedBy:
+	case apikey.FieldCreatedBy:
 		return m.OldCreatedBy(ctx)
-	case category.FieldUpdatedBy:
+	case apikey.FieldUpdatedBy:
 		return m.OldUpdatedBy(ctx)
-	case category.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case category.FieldName:
-		return m.OldName(ctx)
-	case category.FieldSlug:
-		return m.OldSlug(ctx)
-	case category.FieldDescription:
-		return m.OldDescription(ctx)
-	}
-	return nil, fmt.Errorf("unknown Category field %s", name)
+	case apikey.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case apikey.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	case apikey.FieldLabel:
+		return m.OldLabel(ctx)
+	case apikey.FieldKeyHash:
+		return m.OldKeyHash(ctx)
+	case apikey.FieldKeyPrefix:
+		return m.OldKeyPrefix(ctx)
+	case apikey.FieldScopes:
+		return m.OldScopes(ctx)
+	case apikey.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case apikey.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case apikey.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CategoryMutation) SetField(name string, value ent.Value) error {
+func (m *APIKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case category.FieldStatus:
-		v, ok := value.(string)
+	case apikey.FieldStatus:
+		v, ok := value.(types.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStatus(v)
 		return nil
-	case category.FieldCreatedAt:
+	case apikey.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case category.FieldUpdatedAt:
+	case apikey.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case category.FieldCreatedBy:
+	case apikey.FieldCreatedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedBy(v)
 		return nil
-	case category.FieldUpdatedBy:
+	case apikey.FieldUpdatedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedBy(v)
 		return nil
-	case category.FieldMetadata:
-		v, ok := value.(map[string]string)
+	case apikey.FieldDeletedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetDeletedAt(v)
 		return nil
-	case category.FieldName:
+	case apikey.FieldDeletedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetDeletedBy(v)
 		return nil
-	case category.FieldSlug:
+	case apikey.FieldLabel:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSlug(v)
+		m.SetLabel(v)
 		return nil
-	case category.FieldDescription:
+	case apikey.FieldKeyHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetKeyHash(v)
+		return nil
+	case apikey.FieldKeyPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyPrefix(v)
+		return nil
+	case apikey.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case apikey.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case apikey.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case apikey.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Category field %s", name)
+	return fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CategoryMutation) AddedFields() []string {
+func (m *APIKeyMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CategoryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *APIKeyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CategoryMutation) AddField(name string, value ent.Value) error {
+func (m *APIKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Category numeric field %s", name)
+	return fmt.Errorf("unknown APIKey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CategoryMutation) ClearedFields() []string {
+func (m *APIKeyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(category.FieldCreatedBy) {
-		fields = append(fields, category.FieldCreatedBy)
+	if m.FieldCleared(apikey.FieldCreatedBy) {
+		fields = append(fields, apikey.FieldCreatedBy)
 	}
-	if m.FieldCleared(category.FieldUpdatedBy) {
-		fields = append(fields, category.FieldUpdatedBy)
+	if m.FieldCleared(apikey.FieldUpdatedBy) {
+		fields = append(fields, apikey.FieldUpdatedBy)
 	}
-	if m.FieldCleared(category.FieldMetadata) {
-		fields = append(fields, category.FieldMetadata)
+	if m.FieldCleared(apikey.FieldDeletedAt) {
+		fields = append(fields, apikey.FieldDeletedAt)
 	}
-	if m.FieldCleared(category.FieldDescription) {
-		fields = append(fields, category.FieldDescription)
+	if m.FieldCleared(apikey.FieldDeletedBy) {
+		fields = append(fields, apikey.FieldDeletedBy)
+	}
+	if m.FieldCleared(apikey.FieldLastUsedAt) {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.FieldCleared(apikey.FieldExpiresAt) {
+		fields = append(fields, apikey.FieldExpiresAt)
+	}
+	if m.FieldCleared(apikey.FieldRevokedAt) {
+		fields = append(fields, apikey.FieldRevokedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CategoryMutation) FieldCleared(name string) bool {
+func (m *APIKeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CategoryMutation) ClearField(name string) error {
+func (m *APIKeyMutation) ClearField(name string) error {
 	switch name {
-	case category.FieldCreatedBy:
+	case apikey.FieldCreatedBy:
 		m.ClearCreatedBy()
 		return nil
-	case category.FieldUpdatedBy:
+	case apikey.FieldUpdatedBy:
 		m.ClearUpdatedBy()
 		return nil
-	case category.FieldMetadata:
-		m.ClearMetadata()
+	case apikey.FieldDeletedAt:
+		m.ClearDeletedAt()
 		return nil
-	case category.FieldDescription:
-		m.ClearDescription()
+	case apikey.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
+	case apikey.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case apikey.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ClearRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Category nullable field %s", name)
+	return fmt.Errorf("unknown APIKey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CategoryMutation) ResetField(name string) error {
+func (m *APIKeyMutation) ResetField(name string) error {
 	switch name {
-	case category.FieldStatus:
+	case apikey.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case category.FieldCreatedAt:
+	case apikey.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case category.FieldUpdatedAt:
+	case apikey.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case category.FieldCreatedBy:
+	case apikey.FieldCreatedBy:
 		m.ResetCreatedBy()
 		return nil
-	case category.FieldUpdatedBy:
+	case apikey.FieldUpdatedBy:
 		m.ResetUpdatedBy()
 		return nil
-	case category.FieldMetadata:
-		m.ResetMetadata()
+	case apikey.FieldDeletedAt:
+		m.ResetDeletedAt()
 		return nil
-	case category.FieldName:
-		m.ResetName()
+	case apikey.FieldDeletedBy:
+		m.ResetDeletedBy()
 		return nil
-	case category.FieldSlug:
-		m.ResetSlug()
+	case apikey.FieldLabel:
+		m.ResetLabel()
 		return nil
-	case category.FieldDescription:
-		m.ResetDescription()
+	case apikey.FieldKeyHash:
+		m.ResetKeyHash()
+		return nil
+	case apikey.FieldKeyPrefix:
+		m.ResetKeyPrefix()
+		return nil
+	case apikey.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case apikey.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case apikey.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ResetRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Category field %s", name)
+	return fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CategoryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.places != nil {
-		edges = append(edges, category.EdgePlaces)
-	}
+func (m *APIKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CategoryMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case category.EdgePlaces:
-		ids := make([]ent.Value, 0, len(m.places))
-		for id := range m.places {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *APIKeyMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CategoryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedplaces != nil {
-		edges = append(edges, category.EdgePlaces)
-	}
+func (m *APIKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CategoryMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case category.EdgePlaces:
-		ids := make([]ent.Value, 0, len(m.removedplaces))
-		for id := range m.removedplaces {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *APIKeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CategoryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedplaces {
-		edges = append(edges, category.EdgePlaces)
-	}
+func (m *APIKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CategoryMutation) EdgeCleared(name string) bool {
-	switch name {
-	case category.EdgePlaces:
-		return m.clearedplaces
-	}
+func (m *APIKeyMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CategoryMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Category unique edge %s", name)
+func (m *APIKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown APIKey unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CategoryMutation) ResetEdge(name string) error {
-	switch name {
-	case category.EdgePlaces:
-		m.ResetPlaces()
-		return nil
-	}
-	return fmt.Errorf("unknown Category edge %s", name)
+func (m *APIKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown APIKey edge %s", name)
 }
 
-// EventMutation represents an operation that mutates the Event nodes in the graph.
-type EventMutation struct {
+// CategoryMutation represents an operation that mutates the Category nodes in the graph.
+type CategoryMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *string
-	status              *string
-	created_at          *time.Time
-	updated_at          *time.Time
-	created_by          *string
-	updated_by          *string
-	metadata            *map[string]string
-	slug                *string
-	_type               *string
-	title               *string
-	subtitle            *string
-	description         *string
-	place_id            *string
-	start_date          *time.Time
-	end_date            *time.Time
-	cover_image_url     *string
-	images              *[]string
-	appendimages        []string
-	tags                *[]string
-	appendtags          []string
-	latitude            **decimal.Decimal
-	longitude           **decimal.Decimal
-	location_name       *string
-	view_count          *int
-	addview_count       *int
-	interested_count    *int
-	addinterested_count *int
-	clearedFields       map[string]struct{}
-	occurrences         map[string]struct{}
-	removedoccurrences  map[string]struct{}
-	clearedoccurrences  bool
-	done                bool
-	oldValue            func(context.Context) (*Event, error)
-	predicates          []predicate.Event
+	op            Op
+	typ           string
+	id            *string
+	status        *types.Status
+	created_at    *time.Time
+	updated_at    *time.Time
+	created_by    *string
+	updated_by    *string
+	deleted_at    *time.Time
+	deleted_by    *string
+	metadata      *map[string]string
+	name          *string
+	slug          *string
+	description   *string
+	clearedFields map[string]struct{}
+	places        map[string]struct{}
+	removedplaces map[string]struct{}
+	clearedplaces bool
+	done          bool
+	oldValue      func(context.Context) (*Category, error)
+	predicates    []predicate.Category
 }
 
-var _ ent.Mutation = (*EventMutation)(nil)
+var _ ent.Mutation = (*CategoryMutation)(nil)
 
-// eventOption allows management of the mutation configuration using functional options.
-type eventOption func(*EventMutation)
+// categoryOption allows management of the mutation configuration using functional options.
+type categoryOption func(*CategoryMutation)
 
-// newEventMutation creates new mutation for the Event entity.
-func newEventMutation(c config, op Op, opts ...eventOption) *EventMutation {
-	m := &EventMutation{
+// newCategoryMutation creates new mutation for the Category entity.
+func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutation {
+	m := &CategoryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEvent,
+		typ:           TypeCategory,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1042,20 +1287,20 @@ func newEventMutation(c config, op Op, opts ...eventOption) *EventMutation {
 	return m
 }
 
-// withEventID sets the ID field of the mutation.
-func withEventID(id string) eventOption {
-	return func(m *EventMutation) {
+// withCategoryID sets the ID field of the mutation.
+func withCategoryID(id string) categoryOption {
+	return func(m *CategoryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Event
+			value *Category
 		)
-		m.oldValue = func(ctx context.Context) (*Event, error) {
+		m.oldValue = func(ctx context.Context) (*Category, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Event.Get(ctx, id)
+					value, err = m.Client().Category.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1064,10 +1309,10 @@ func withEventID(id string) eventOption {
 	}
 }
 
-// withEvent sets the old Event of the mutation.
-func withEvent(node *Event) eventOption {
-	return func(m *EventMutation) {
-		m.oldValue = func(context.Context) (*Event, error) {
+// withCategory sets the old Category of the mutation.
+func withCategory(node *Category) categoryOption {
+	return func(m *CategoryMutation) {
+		m.oldValue = func(context.Context) (*Category, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1076,7 +1321,7 @@ func withEvent(node *Event) eventOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EventMutation) Client() *Client {
+func (m CategoryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1084,7 +1329,7 @@ func (m EventMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EventMutation) Tx() (*Tx, error) {
+func (m CategoryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1094,14 +1339,14 @@ func (m EventMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Event entities.
-func (m *EventMutation) SetID(id string) {
+// operation is only accepted on creation of Category entities.
+func (m *CategoryMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EventMutation) ID() (id string, exists bool) {
+func (m *CategoryMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1112,7 +1357,7 @@ func (m *EventMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EventMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *CategoryMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1121,19 +1366,19 @@ func (m *EventMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Event.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Category.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetStatus sets the "status" field.
-func (m *EventMutation) SetStatus(s string) {
-	m.status = &s
+func (m *CategoryMutation) SetStatus(t types.Status) {
+	m.status = &t
 }
 
 // Status returns the value of the "status" field in the mutation.
-func (m *EventMutation) Status() (r string, exists bool) {
+func (m *CategoryMutation) Status() (r types.Status, exists bool) {
 	v := m.status
 	if v == nil {
 		return
@@ -1141,10 +1386,10 @@ func (m *EventMutation) Status() (r string, exists bool) {
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *CategoryMutation) OldStatus(ctx context.Context) (v types.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
@@ -1159,17 +1404,17 @@ func (m *EventMutation) OldStatus(ctx context.Context) (v string, err error) {
 }
 
 // ResetStatus resets all changes to the "status" field.
-func (m *EventMutation) ResetStatus() {
+func (m *CategoryMutation) ResetStatus() {
 	m.status = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *EventMutation) SetCreatedAt(t time.Time) {
+func (m *CategoryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EventMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *CategoryMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1177,10 +1422,10 @@ func (m *EventMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1195,17 +1440,17 @@ func (m *EventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EventMutation) ResetCreatedAt() {
+func (m *CategoryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *EventMutation) SetUpdatedAt(t time.Time) {
+func (m *CategoryMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EventMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *CategoryMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1213,10 +1458,10 @@ func (m *EventMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1231,17 +1476,17 @@ func (m *EventMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EventMutation) ResetUpdatedAt() {
+func (m *CategoryMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetCreatedBy sets the "created_by" field.
-func (m *EventMutation) SetCreatedBy(s string) {
+func (m *CategoryMutation) SetCreatedBy(s string) {
 	m.created_by = &s
 }
 
 // CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *EventMutation) CreatedBy() (r string, exists bool) {
+func (m *CategoryMutation) CreatedBy() (r string, exists bool) {
 	v := m.created_by
 	if v == nil {
 		return
@@ -1249,10 +1494,10 @@ func (m *EventMutation) CreatedBy() (r string, exists bool) {
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+func (m *CategoryMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
@@ -1267,30 +1512,30 @@ func (m *EventMutation) OldCreatedBy(ctx context.Context) (v string, err error)
 }
 
 // ClearCreatedBy clears the value of the "created_by" field.
-func (m *EventMutation) ClearCreatedBy() {
+func (m *CategoryMutation) ClearCreatedBy() {
 	m.created_by = nil
-	m.clearedFields[event.FieldCreatedBy] = struct{}{}
+	m.clearedFields[category.FieldCreatedBy] = struct{}{}
 }
 
 // CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *EventMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[event.FieldCreatedBy]
+func (m *CategoryMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[category.FieldCreatedBy]
 	return ok
 }
 
 // ResetCreatedBy resets all changes to the "created_by" field.
-func (m *EventMutation) ResetCreatedBy() {
+func (m *CategoryMutation) ResetCreatedBy() {
 	m.created_by = nil
-	delete(m.clearedFields, event.FieldCreatedBy)
+	delete(m.clearedFields, category.FieldCreatedBy)
 }
 
 // SetUpdatedBy sets the "updated_by" field.
-func (m *EventMutation) SetUpdatedBy(s string) {
+func (m *CategoryMutation) SetUpdatedBy(s string) {
 	m.updated_by = &s
 }
 
 // UpdatedBy returns the value of the "updated_by" field in the mutation.
-func (m *EventMutation) UpdatedBy() (r string, exists bool) {
+func (m *CategoryMutation) UpdatedBy() (r string, exists bool) {
 	v := m.updated_by
 	if v == nil {
 		return
@@ -1298,10 +1543,10 @@ func (m *EventMutation) UpdatedBy() (r string, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedBy returns the old "updated_by" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedBy returns the old "updated_by" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
+func (m *CategoryMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
 	}
@@ -1316,236 +1561,249 @@ func (m *EventMutation) OldUpdatedBy(ctx context.Context) (v string, err error)
 }
 
 // ClearUpdatedBy clears the value of the "updated_by" field.
-func (m *EventMutation) ClearUpdatedBy() {
+func (m *CategoryMutation) ClearUpdatedBy() {
 	m.updated_by = nil
-	m.clearedFields[event.FieldUpdatedBy] = struct{}{}
+	m.clearedFields[category.FieldUpdatedBy] = struct{}{}
 }
 
 // UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
-func (m *EventMutation) UpdatedByCleared() bool {
-	_, ok := m.clearedFields[event.FieldUpdatedBy]
+func (m *CategoryMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[category.FieldUpdatedBy]
 	return ok
 }
 
 // ResetUpdatedBy resets all changes to the "updated_by" field.
-func (m *EventMutation) ResetUpdatedBy() {
+func (m *CategoryMutation) ResetUpdatedBy() {
 	m.updated_by = nil
-	delete(m.clearedFields, event.FieldUpdatedBy)
+	delete(m.clearedFields, category.FieldUpdatedBy)
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *EventMutation) SetMetadata(value map[string]string) {
-	m.metadata = &value
+// SetDeletedAt sets the "deleted_at" field.
+func (m *CategoryMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *EventMutation) Metadata() (r map[string]string, exists bool) {
-	v := m.metadata
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *CategoryMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+func (m *CategoryMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *EventMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[event.FieldMetadata] = struct{}{}
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *CategoryMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[category.FieldDeletedAt] = struct{}{}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *EventMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[event.FieldMetadata]
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *CategoryMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[category.FieldDeletedAt]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *EventMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, event.FieldMetadata)
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *CategoryMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, category.FieldDeletedAt)
 }
 
-// SetSlug sets the "slug" field.
-func (m *EventMutation) SetSlug(s string) {
-	m.slug = &s
+// SetDeletedBy sets the "deleted_by" field.
+func (m *CategoryMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
 }
 
-// Slug returns the value of the "slug" field in the mutation.
-func (m *EventMutation) Slug() (r string, exists bool) {
-	v := m.slug
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *CategoryMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlug returns the old "slug" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedBy returns the old "deleted_by" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldSlug(ctx context.Context) (v string, err error) {
+func (m *CategoryMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlug requires an ID field in the mutation")
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
 	}
-	return oldValue.Slug, nil
+	return oldValue.DeletedBy, nil
 }
 
-// ResetSlug resets all changes to the "slug" field.
-func (m *EventMutation) ResetSlug() {
-	m.slug = nil
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *CategoryMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[category.FieldDeletedBy] = struct{}{}
 }
 
-// SetType sets the "type" field.
-func (m *EventMutation) SetType(s string) {
-	m._type = &s
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *CategoryMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[category.FieldDeletedBy]
+	return ok
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *EventMutation) GetType() (r string, exists bool) {
-	v := m._type
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *CategoryMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, category.FieldDeletedBy)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *CategoryMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *CategoryMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldMetadata returns the old "metadata" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *CategoryMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Metadata, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *EventMutation) ResetType() {
-	m._type = nil
+// ClearMetadata clears the value of the "metadata" field.
+func (m *CategoryMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[category.FieldMetadata] = struct{}{}
 }
 
-// SetTitle sets the "title" field.
-func (m *EventMutation) SetTitle(s string) {
-	m.title = &s
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *CategoryMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[category.FieldMetadata]
+	return ok
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *EventMutation) Title() (r string, exists bool) {
-	v := m.title
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *CategoryMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, category.FieldMetadata)
+}
+
+// SetName sets the "name" field.
+func (m *CategoryMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CategoryMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *CategoryMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Name, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *EventMutation) ResetTitle() {
-	m.title = nil
+// ResetName resets all changes to the "name" field.
+func (m *CategoryMutation) ResetName() {
+	m.name = nil
 }
 
-// SetSubtitle sets the "subtitle" field.
-func (m *EventMutation) SetSubtitle(s string) {
-	m.subtitle = &s
+// SetSlug sets the "slug" field.
+func (m *CategoryMutation) SetSlug(s string) {
+	m.slug = &s
 }
 
-// Subtitle returns the value of the "subtitle" field in the mutation.
-func (m *EventMutation) Subtitle() (r string, exists bool) {
-	v := m.subtitle
+// Slug returns the value of the "slug" field in the mutation.
+func (m *CategoryMutation) Slug() (r string, exists bool) {
+	v := m.slug
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubtitle returns the old "subtitle" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldSlug returns the old "slug" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldSubtitle(ctx context.Context) (v *string, err error) {
+func (m *CategoryMutation) OldSlug(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubtitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubtitle requires an ID field in the mutation")
+		return v, errors.New("OldSlug requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubtitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
 	}
-	return oldValue.Subtitle, nil
-}
-
-// ClearSubtitle clears the value of the "subtitle" field.
-func (m *EventMutation) ClearSubtitle() {
-	m.subtitle = nil
-	m.clearedFields[event.FieldSubtitle] = struct{}{}
-}
-
-// SubtitleCleared returns if the "subtitle" field was cleared in this mutation.
-func (m *EventMutation) SubtitleCleared() bool {
-	_, ok := m.clearedFields[event.FieldSubtitle]
-	return ok
+	return oldValue.Slug, nil
 }
 
-// ResetSubtitle resets all changes to the "subtitle" field.
-func (m *EventMutation) ResetSubtitle() {
-	m.subtitle = nil
-	delete(m.clearedFields, event.FieldSubtitle)
+// ResetSlug resets all changes to the "slug" field.
+func (m *CategoryMutation) ResetSlug() {
+	m.slug = nil
 }
 
 // SetDescription sets the "description" field.
-func (m *EventMutation) SetDescription(s string) {
+func (m *CategoryMutation) SetDescription(s string) {
 	m.description = &s
 }
 
 // Description returns the value of the "description" field in the mutation.
-func (m *EventMutation) Description() (r string, exists bool) {
+func (m *CategoryMutation) Description() (r string, exists bool) {
 	v := m.description
 	if v == nil {
 		return
@@ -1553,10 +1811,10 @@ func (m *EventMutation) Description() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldDescription(ctx context.Context) (v *string, err error) {
+func (m *CategoryMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
@@ -1571,8072 +1829,14155 @@ func (m *EventMutation) OldDescription(ctx context.Context) (v *string, err erro
 }
 
 // ClearDescription clears the value of the "description" field.
-func (m *EventMutation) ClearDescription() {
+func (m *CategoryMutation) ClearDescription() {
 	m.description = nil
-	m.clearedFields[event.FieldDescription] = struct{}{}
+	m.clearedFields[category.FieldDescription] = struct{}{}
 }
 
 // DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *EventMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[event.FieldDescription]
+func (m *CategoryMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[category.FieldDescription]
 	return ok
 }
 
 // ResetDescription resets all changes to the "description" field.
-func (m *EventMutation) ResetDescription() {
+func (m *CategoryMutation) ResetDescription() {
 	m.description = nil
-	delete(m.clearedFields, event.FieldDescription)
+	delete(m.clearedFields, category.FieldDescription)
 }
 
-// SetPlaceID sets the "place_id" field.
-func (m *EventMutation) SetPlaceID(s string) {
-	m.place_id = &s
+// AddPlaceIDs adds the "places" edge to the Place entity by ids.
+func (m *CategoryMutation) AddPlaceIDs(ids ...string) {
+	if m.places == nil {
+		m.places = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.places[ids[i]] = struct{}{}
+	}
 }
 
-// PlaceID returns the value of the "place_id" field in the mutation.
-func (m *EventMutation) PlaceID() (r string, exists bool) {
-	v := m.place_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearPlaces clears the "places" edge to the Place entity.
+func (m *CategoryMutation) ClearPlaces() {
+	m.clearedplaces = true
 }
 
-// OldPlaceID returns the old "place_id" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldPlaceID(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlaceID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlaceID requires an ID field in the mutation")
+// PlacesCleared reports if the "places" edge to the Place entity was cleared.
+func (m *CategoryMutation) PlacesCleared() bool {
+	return m.clearedplaces
+}
+
+// RemovePlaceIDs removes the "places" edge to the Place entity by IDs.
+func (m *CategoryMutation) RemovePlaceIDs(ids ...string) {
+	if m.removedplaces == nil {
+		m.removedplaces = make(map[string]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlaceID: %w", err)
+	for i := range ids {
+		delete(m.places, ids[i])
+		m.removedplaces[ids[i]] = struct{}{}
 	}
-	return oldValue.PlaceID, nil
 }
 
-// ClearPlaceID clears the value of the "place_id" field.
-func (m *EventMutation) ClearPlaceID() {
-	m.place_id = nil
-	m.clearedFields[event.FieldPlaceID] = struct{}{}
+// RemovedPlaces returns the removed IDs of the "places" edge to the Place entity.
+func (m *CategoryMutation) RemovedPlacesIDs() (ids []string) {
+	for id := range m.removedplaces {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// PlaceIDCleared returns if the "place_id" field was cleared in this mutation.
-func (m *EventMutation) PlaceIDCleared() bool {
-	_, ok := m.clearedFields[event.FieldPlaceID]
-	return ok
+// PlacesIDs returns the "places" edge IDs in the mutation.
+func (m *CategoryMutation) PlacesIDs() (ids []string) {
+	for id := range m.places {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ResetPlaceID resets all changes to the "place_id" field.
-func (m *EventMutation) ResetPlaceID() {
-	m.place_id = nil
-	delete(m.clearedFields, event.FieldPlaceID)
+// ResetPlaces resets all changes to the "places" edge.
+func (m *CategoryMutation) ResetPlaces() {
+	m.places = nil
+	m.clearedplaces = false
+	m.removedplaces = nil
 }
 
-// SetStartDate sets the "start_date" field.
-func (m *EventMutation) SetStartDate(t time.Time) {
-	m.start_date = &t
+// Where appends a list predicates to the CategoryMutation builder.
+func (m *CategoryMutation) Where(ps ...predicate.Category) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// StartDate returns the value of the "start_date" field in the mutation.
-func (m *EventMutation) StartDate() (r time.Time, exists bool) {
-	v := m.start_date
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the CategoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CategoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Category, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldStartDate returns the old "start_date" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldStartDate(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartDate is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartDate requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartDate: %w", err)
-	}
-	return oldValue.StartDate, nil
+// Op returns the operation name.
+func (m *CategoryMutation) Op() Op {
+	return m.op
 }
 
-// ResetStartDate resets all changes to the "start_date" field.
-func (m *EventMutation) ResetStartDate() {
-	m.start_date = nil
+// SetOp allows setting the mutation operation.
+func (m *CategoryMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetEndDate sets the "end_date" field.
-func (m *EventMutation) SetEndDate(t time.Time) {
-	m.end_date = &t
+// Type returns the node type of this mutation (Category).
+func (m *CategoryMutation) Type() string {
+	return m.typ
 }
 
-// EndDate returns the value of the "end_date" field in the mutation.
-func (m *EventMutation) EndDate() (r time.Time, exists bool) {
-	v := m.end_date
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CategoryMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.status != nil {
+		fields = append(fields, category.FieldStatus)
 	}
-	return *v, true
-}
-
-// OldEndDate returns the old "end_date" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldEndDate(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndDate is only allowed on UpdateOne operations")
+	if m.created_at != nil {
+		fields = append(fields, category.FieldCreatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndDate requires an ID field in the mutation")
+	if m.updated_at != nil {
+		fields = append(fields, category.FieldUpdatedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndDate: %w", err)
+	if m.created_by != nil {
+		fields = append(fields, category.FieldCreatedBy)
 	}
-	return oldValue.EndDate, nil
+	if m.updated_by != nil {
+		fields = append(fields, category.FieldUpdatedBy)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, category.FieldDeletedAt)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, category.FieldDeletedBy)
+	}
+	if m.metadata != nil {
+		fields = append(fields, category.FieldMetadata)
+	}
+	if m.name != nil {
+		fields = append(fields, category.FieldName)
+	}
+	if m.slug != nil {
+		fields = append(fields, category.FieldSlug)
+	}
+	if m.description != nil {
+		fields = append(fields, category.FieldDescription)
+	}
+	return fields
 }
 
-// ClearEndDate clears the value of the "end_date" field.
-func (m *EventMutation) ClearEndDate() {
-	m.end_date = nil
-	m.clearedFields[event.FieldEndDate] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case category.FieldStatus:
+		return m.Status()
+	case category.FieldCreatedAt:
+		return m.CreatedAt()
+	case category.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case category.FieldCreatedBy:
+		return m.CreatedBy()
+	case category.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case category.FieldDeletedAt:
+		return m.DeletedAt()
+	case category.FieldDeletedBy:
+		return m.DeletedBy()
+	case category.FieldMetadata:
+		return m.Metadata()
+	case category.FieldName:
+		return m.Name()
+	case category.FieldSlug:
+		return m.Slug()
+	case category.FieldDescription:
+		return m.Description()
+	}
+	return nil, false
 }
 
-// EndDateCleared returns if the "end_date" field was cleared in this mutation.
-func (m *EventMutation) EndDateCleared() bool {
-	_, ok := m.clearedFields[event.FieldEndDate]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case category.FieldStatus:
+		return m.OldStatus(ctx)
+	case category.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case category.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case category.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case category.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case category.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case category.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	case category.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case category.FieldName:
+		return m.OldName(ctx)
+	case category.FieldSlug:
+		return m.OldSlug(ctx)
+	case category.FieldDescription:
+		return m.OldDescription(ctx)
+	}
+	return nil, fmt.Errorf("unknown Category field %s", name)
 }
 
-// ResetEndDate resets all changes to the "end_date" field.
-func (m *EventMutation) ResetEndDate() {
-	m.end_date = nil
-	delete(m.clearedFields, event.FieldEndDate)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case category.FieldStatus:
+		v, ok := value.(types.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case category.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case category.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case category.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case category.FieldUpdatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case category.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case category.FieldDeletedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
+	case category.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case category.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case category.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case category.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Category field %s", name)
 }
 
-// SetCoverImageURL sets the "cover_image_url" field.
-func (m *EventMutation) SetCoverImageURL(s string) {
-	m.cover_image_url = &s
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CategoryMutation) AddedFields() []string {
+	return nil
 }
 
-// CoverImageURL returns the value of the "cover_image_url" field in the mutation.
-func (m *EventMutation) CoverImageURL() (r string, exists bool) {
-	v := m.cover_image_url
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CategoryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown Category numeric field %s", name)
 }
 
-// OldCoverImageURL returns the old "cover_image_url" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldCoverImageURL(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCoverImageURL is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CategoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(category.FieldCreatedBy) {
+		fields = append(fields, category.FieldCreatedBy)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCoverImageURL requires an ID field in the mutation")
+	if m.FieldCleared(category.FieldUpdatedBy) {
+		fields = append(fields, category.FieldUpdatedBy)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCoverImageURL: %w", err)
+	if m.FieldCleared(category.FieldDeletedAt) {
+		fields = append(fields, category.FieldDeletedAt)
 	}
-	return oldValue.CoverImageURL, nil
-}
-
-// ClearCoverImageURL clears the value of the "cover_image_url" field.
-func (m *EventMutation) ClearCoverImageURL() {
-	m.cover_image_url = nil
-	m.clearedFields[event.FieldCoverImageURL] = struct{}{}
+	if m.FieldCleared(category.FieldDeletedBy) {
+		fields = append(fields, category.FieldDeletedBy)
+	}
+	if m.FieldCleared(category.FieldMetadata) {
+		fields = append(fields, category.FieldMetadata)
+	}
+	if m.FieldCleared(category.FieldDescription) {
+		fields = append(fields, category.FieldDescription)
+	}
+	return fields
 }
 
-// CoverImageURLCleared returns if the "cover_image_url" field was cleared in this mutation.
-func (m *EventMutation) CoverImageURLCleared() bool {
-	_, ok := m.clearedFields[event.FieldCoverImageURL]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CategoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetCoverImageURL resets all changes to the "cover_image_url" field.
-func (m *EventMutation) ResetCoverImageURL() {
-	m.cover_image_url = nil
-	delete(m.clearedFields, event.FieldCoverImageURL)
-}
-
-// SetImages sets the "images" field.
-func (m *EventMutation) SetImages(s []string) {
-	m.images = &s
-	m.appendimages = nil
-}
-
-// Images returns the value of the "images" field in the mutation.
-func (m *EventMutation) Images() (r []string, exists bool) {
-	v := m.images
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CategoryMutation) ClearField(name string) error {
+	switch name {
+	case category.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case category.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	case category.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case category.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
+	case category.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case category.FieldDescription:
+		m.ClearDescription()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Category nullable field %s", name)
 }
 
-// OldImages returns the old "images" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldImages(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImages is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImages requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImages: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CategoryMutation) ResetField(name string) error {
+	switch name {
+	case category.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case category.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case category.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case category.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case category.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case category.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case category.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
+	case category.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case category.FieldName:
+		m.ResetName()
+		return nil
+	case category.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case category.FieldDescription:
+		m.ResetDescription()
+		return nil
 	}
-	return oldValue.Images, nil
+	return fmt.Errorf("unknown Category field %s", name)
 }
 
-// AppendImages adds s to the "images" field.
-func (m *EventMutation) AppendImages(s []string) {
-	m.appendimages = append(m.appendimages, s...)
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CategoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.places != nil {
+		edges = append(edges, category.EdgePlaces)
+	}
+	return edges
 }
 
-// AppendedImages returns the list of values that were appended to the "images" field in this mutation.
-func (m *EventMutation) AppendedImages() ([]string, bool) {
-	if len(m.appendimages) == 0 {
-		return nil, false
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CategoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case category.EdgePlaces:
+		ids := make([]ent.Value, 0, len(m.places))
+		for id := range m.places {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return m.appendimages, true
+	return nil
 }
 
-// ClearImages clears the value of the "images" field.
-func (m *EventMutation) ClearImages() {
-	m.images = nil
-	m.appendimages = nil
-	m.clearedFields[event.FieldImages] = struct{}{}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CategoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedplaces != nil {
+		edges = append(edges, category.EdgePlaces)
+	}
+	return edges
 }
 
-// ImagesCleared returns if the "images" field was cleared in this mutation.
-func (m *EventMutation) ImagesCleared() bool {
-	_, ok := m.clearedFields[event.FieldImages]
-	return ok
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CategoryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case category.EdgePlaces:
+		ids := make([]ent.Value, 0, len(m.removedplaces))
+		for id := range m.removedplaces {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// ResetImages resets all changes to the "images" field.
-func (m *EventMutation) ResetImages() {
-	m.images = nil
-	m.appendimages = nil
-	delete(m.clearedFields, event.FieldImages)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CategoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedplaces {
+		edges = append(edges, category.EdgePlaces)
+	}
+	return edges
 }
 
-// SetTags sets the "tags" field.
-func (m *EventMutation) SetTags(s []string) {
-	m.tags = &s
-	m.appendtags = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CategoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case category.EdgePlaces:
+		return m.clearedplaces
+	}
+	return false
 }
 
-// Tags returns the value of the "tags" field in the mutation.
-func (m *EventMutation) Tags() (r []string, exists bool) {
-	v := m.tags
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CategoryMutation) ClearEdge(name string) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown Category unique edge %s", name)
 }
 
-// OldTags returns the old "tags" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldTags(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTags is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTags requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CategoryMutation) ResetEdge(name string) error {
+	switch name {
+	case category.EdgePlaces:
+		m.ResetPlaces()
+		return nil
 	}
-	return oldValue.Tags, nil
+	return fmt.Errorf("unknown Category edge %s", name)
 }
 
-// AppendTags adds s to the "tags" field.
-func (m *EventMutation) AppendTags(s []string) {
-	m.appendtags = append(m.appendtags, s...)
+// EventMutation represents an operation that mutates the Event nodes in the graph.
+type EventMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *string
+	status              *types.Status
+	created_at          *time.Time
+	updated_at          *time.Time
+	created_by          *string
+	updated_by          *string
+	deleted_at          *time.Time
+	deleted_by          *string
+	metadata            *map[string]string
+	slug                *string
+	_type               *string
+	title               *string
+	subtitle            *string
+	description         *string
+	place_id            *string
+	start_date          *time.Time
+	end_date            *time.Time
+	cover_image_url     *string
+	images              *[]string
+	appendimages        []string
+	tags                *[]string
+	appendtags          []string
+	latitude            **decimal.Decimal
+	longitude           **decimal.Decimal
+	location_name       *string
+	view_count          *int
+	addview_count       *int
+	interested_count    *int
+	addinterested_count *int
+	clearedFields       map[string]struct{}
+	occurrences         map[string]struct{}
+	removedoccurrences  map[string]struct{}
+	clearedoccurrences  bool
+	done                bool
+	oldValue            func(context.Context) (*Event, error)
+	predicates          []predicate.Event
 }
 
-// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
-func (m *EventMutation) AppendedTags() ([]string, bool) {
-	if len(m.appendtags) == 0 {
-		return nil, false
+var _ ent.Mutation = (*EventMutation)(nil)
+
+// eventOption allows management of the mutation configuration using functional options.
+type eventOption func(*EventMutation)
+
+// newEventMutation creates new mutation for the Event entity.
+func newEventMutation(c config, op Op, opts ...eventOption) *EventMutation {
+	m := &EventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEvent,
+		clearedFields: make(map[string]struct{}),
 	}
-	return m.appendtags, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ClearTags clears the value of the "tags" field.
-func (m *EventMutation) ClearTags() {
-	m.tags = nil
-	m.appendtags = nil
-	m.clearedFields[event.FieldTags] = struct{}{}
+// withEventID sets the ID field of the mutation.
+func withEventID(id string) eventOption {
+	return func(m *EventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Event
+		)
+		m.oldValue = func(ctx context.Context) (*Event, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Event.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// TagsCleared returns if the "tags" field was cleared in this mutation.
-func (m *EventMutation) TagsCleared() bool {
-	_, ok := m.clearedFields[event.FieldTags]
-	return ok
+// withEvent sets the old Event of the mutation.
+func withEvent(node *Event) eventOption {
+	return func(m *EventMutation) {
+		m.oldValue = func(context.Context) (*Event, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ResetTags resets all changes to the "tags" field.
-func (m *EventMutation) ResetTags() {
-	m.tags = nil
-	m.appendtags = nil
-	delete(m.clearedFields, event.FieldTags)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetLatitude sets the "latitude" field.
-func (m *EventMutation) SetLatitude(d *decimal.Decimal) {
-	m.latitude = &d
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// Latitude returns the value of the "latitude" field in the mutation.
-func (m *EventMutation) Latitude() (r *decimal.Decimal, exists bool) {
-	v := m.latitude
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Event entities.
+func (m *EventMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EventMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EventMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Event.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStatus sets the "status" field.
+func (m *EventMutation) SetStatus(t types.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *EventMutation) Status() (r types.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLatitude returns the old "latitude" field's value of the Event entity.
+// OldStatus returns the old "status" field's value of the Event entity.
 // If the Event object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldLatitude(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *EventMutation) OldStatus(ctx context.Context) (v types.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLatitude is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLatitude requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLatitude: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Latitude, nil
-}
-
-// ClearLatitude clears the value of the "latitude" field.
-func (m *EventMutation) ClearLatitude() {
-	m.latitude = nil
-	m.clearedFields[event.FieldLatitude] = struct{}{}
-}
-
-// LatitudeCleared returns if the "latitude" field was cleared in this mutation.
-func (m *EventMutation) LatitudeCleared() bool {
-	_, ok := m.clearedFields[event.FieldLatitude]
-	return ok
+	return oldValue.Status, nil
 }
 
-// ResetLatitude resets all changes to the "latitude" field.
-func (m *EventMutation) ResetLatitude() {
-	m.latitude = nil
-	delete(m.clearedFields, event.FieldLatitude)
+// ResetStatus resets all changes to the "status" field.
+func (m *EventMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetLongitude sets the "longitude" field.
-func (m *EventMutation) SetLongitude(d *decimal.Decimal) {
-	m.longitude = &d
+// SetCreatedAt sets the "created_at" field.
+func (m *EventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Longitude returns the value of the "longitude" field in the mutation.
-func (m *EventMutation) Longitude() (r *decimal.Decimal, exists bool) {
-	v := m.longitude
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLongitude returns the old "longitude" field's value of the Event entity.
+// OldCreatedAt returns the old "created_at" field's value of the Event entity.
 // If the Event object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldLongitude(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *EventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLongitude is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLongitude requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLongitude: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Longitude, nil
-}
-
-// ClearLongitude clears the value of the "longitude" field.
-func (m *EventMutation) ClearLongitude() {
-	m.longitude = nil
-	m.clearedFields[event.FieldLongitude] = struct{}{}
-}
-
-// LongitudeCleared returns if the "longitude" field was cleared in this mutation.
-func (m *EventMutation) LongitudeCleared() bool {
-	_, ok := m.clearedFields[event.FieldLongitude]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetLongitude resets all changes to the "longitude" field.
-func (m *EventMutation) ResetLongitude() {
-	m.longitude = nil
-	delete(m.clearedFields, event.FieldLongitude)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EventMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetLocationName sets the "location_name" field.
-func (m *EventMutation) SetLocationName(s string) {
-	m.location_name = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EventMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// LocationName returns the value of the "location_name" field in the mutation.
-func (m *EventMutation) LocationName() (r string, exists bool) {
-	v := m.location_name
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EventMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLocationName returns the old "location_name" field's value of the Event entity.
+// OldUpdatedAt returns the old "updated_at" field's value of the Event entity.
 // If the Event object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldLocationName(ctx context.Context) (v *string, err error) {
+func (m *EventMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLocationName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLocationName requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLocationName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.LocationName, nil
-}
-
-// ClearLocationName clears the value of the "location_name" field.
-func (m *EventMutation) ClearLocationName() {
-	m.location_name = nil
-	m.clearedFields[event.FieldLocationName] = struct{}{}
-}
-
-// LocationNameCleared returns if the "location_name" field was cleared in this mutation.
-func (m *EventMutation) LocationNameCleared() bool {
-	_, ok := m.clearedFields[event.FieldLocationName]
-	return ok
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetLocationName resets all changes to the "location_name" field.
-func (m *EventMutation) ResetLocationName() {
-	m.location_name = nil
-	delete(m.clearedFields, event.FieldLocationName)
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EventMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetViewCount sets the "view_count" field.
-func (m *EventMutation) SetViewCount(i int) {
-	m.view_count = &i
-	m.addview_count = nil
+// SetCreatedBy sets the "created_by" field.
+func (m *EventMutation) SetCreatedBy(s string) {
+	m.created_by = &s
 }
 
-// ViewCount returns the value of the "view_count" field in the mutation.
-func (m *EventMutation) ViewCount() (r int, exists bool) {
-	v := m.view_count
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *EventMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldViewCount returns the old "view_count" field's value of the Event entity.
+// OldCreatedBy returns the old "created_by" field's value of the Event entity.
 // If the Event object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldViewCount(ctx context.Context) (v int, err error) {
+func (m *EventMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldViewCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldViewCount requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldViewCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.ViewCount, nil
+	return oldValue.CreatedBy, nil
 }
 
-// AddViewCount adds i to the "view_count" field.
-func (m *EventMutation) AddViewCount(i int) {
-	if m.addview_count != nil {
-		*m.addview_count += i
-	} else {
-		m.addview_count = &i
-	}
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *EventMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[event.FieldCreatedBy] = struct{}{}
 }
 
-// AddedViewCount returns the value that was added to the "view_count" field in this mutation.
-func (m *EventMutation) AddedViewCount() (r int, exists bool) {
-	v := m.addview_count
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *EventMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[event.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *EventMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, event.FieldCreatedBy)
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (m *EventMutation) SetUpdatedBy(s string) {
+	m.updated_by = &s
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *EventMutation) UpdatedBy() (r string, exists bool) {
+	v := m.updated_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetViewCount resets all changes to the "view_count" field.
-func (m *EventMutation) ResetViewCount() {
-	m.view_count = nil
-	m.addview_count = nil
+// OldUpdatedBy returns the old "updated_by" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	}
+	return oldValue.UpdatedBy, nil
 }
 
-// SetInterestedCount sets the "interested_count" field.
-func (m *EventMutation) SetInterestedCount(i int) {
-	m.interested_count = &i
-	m.addinterested_count = nil
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *EventMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.clearedFields[event.FieldUpdatedBy] = struct{}{}
 }
 
-// InterestedCount returns the value of the "interested_count" field in the mutation.
-func (m *EventMutation) InterestedCount() (r int, exists bool) {
-	v := m.interested_count
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *EventMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[event.FieldUpdatedBy]
+	return ok
+}
+
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *EventMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	delete(m.clearedFields, event.FieldUpdatedBy)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *EventMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *EventMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInterestedCount returns the old "interested_count" field's value of the Event entity.
+// OldDeletedAt returns the old "deleted_at" field's value of the Event entity.
 // If the Event object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldInterestedCount(ctx context.Context) (v int, err error) {
+func (m *EventMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInterestedCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInterestedCount requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInterestedCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.InterestedCount, nil
+	return oldValue.DeletedAt, nil
 }
 
-// AddInterestedCount adds i to the "interested_count" field.
-func (m *EventMutation) AddInterestedCount(i int) {
-	if m.addinterested_count != nil {
-		*m.addinterested_count += i
-	} else {
-		m.addinterested_count = &i
-	}
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *EventMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[event.FieldDeletedAt] = struct{}{}
 }
 
-// AddedInterestedCount returns the value that was added to the "interested_count" field in this mutation.
-func (m *EventMutation) AddedInterestedCount() (r int, exists bool) {
-	v := m.addinterested_count
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *EventMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[event.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *EventMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, event.FieldDeletedAt)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *EventMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *EventMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetInterestedCount resets all changes to the "interested_count" field.
-func (m *EventMutation) ResetInterestedCount() {
-	m.interested_count = nil
-	m.addinterested_count = nil
-}
-
-// AddOccurrenceIDs adds the "occurrences" edge to the EventOccurrence entity by ids.
-func (m *EventMutation) AddOccurrenceIDs(ids ...string) {
-	if m.occurrences == nil {
-		m.occurrences = make(map[string]struct{})
+// OldDeletedBy returns the old "deleted_by" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.occurrences[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
 	}
+	return oldValue.DeletedBy, nil
 }
 
-// ClearOccurrences clears the "occurrences" edge to the EventOccurrence entity.
-func (m *EventMutation) ClearOccurrences() {
-	m.clearedoccurrences = true
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *EventMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[event.FieldDeletedBy] = struct{}{}
 }
 
-// OccurrencesCleared reports if the "occurrences" edge to the EventOccurrence entity was cleared.
-func (m *EventMutation) OccurrencesCleared() bool {
-	return m.clearedoccurrences
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *EventMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[event.FieldDeletedBy]
+	return ok
 }
 
-// RemoveOccurrenceIDs removes the "occurrences" edge to the EventOccurrence entity by IDs.
-func (m *EventMutation) RemoveOccurrenceIDs(ids ...string) {
-	if m.removedoccurrences == nil {
-		m.removedoccurrences = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.occurrences, ids[i])
-		m.removedoccurrences[ids[i]] = struct{}{}
-	}
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *EventMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, event.FieldDeletedBy)
 }
 
-// RemovedOccurrences returns the removed IDs of the "occurrences" edge to the EventOccurrence entity.
-func (m *EventMutation) RemovedOccurrencesIDs() (ids []string) {
-	for id := range m.removedoccurrences {
-		ids = append(ids, id)
-	}
-	return
+// SetMetadata sets the "metadata" field.
+func (m *EventMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
 }
 
-// OccurrencesIDs returns the "occurrences" edge IDs in the mutation.
-func (m *EventMutation) OccurrencesIDs() (ids []string) {
-	for id := range m.occurrences {
-		ids = append(ids, id)
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *EventMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetOccurrences resets all changes to the "occurrences" edge.
-func (m *EventMutation) ResetOccurrences() {
-	m.occurrences = nil
-	m.clearedoccurrences = false
-	m.removedoccurrences = nil
+// OldMetadata returns the old "metadata" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
 }
 
-// Where appends a list predicates to the EventMutation builder.
-func (m *EventMutation) Where(ps ...predicate.Event) {
-	m.predicates = append(m.predicates, ps...)
+// ClearMetadata clears the value of the "metadata" field.
+func (m *EventMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[event.FieldMetadata] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the EventMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Event, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *EventMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[event.FieldMetadata]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *EventMutation) Op() Op {
-	return m.op
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *EventMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, event.FieldMetadata)
 }
 
-// SetOp allows setting the mutation operation.
-func (m *EventMutation) SetOp(op Op) {
-	m.op = op
+// SetSlug sets the "slug" field.
+func (m *EventMutation) SetSlug(s string) {
+	m.slug = &s
 }
 
-// Type returns the node type of this mutation (Event).
-func (m *EventMutation) Type() string {
-	return m.typ
+// Slug returns the value of the "slug" field in the mutation.
+func (m *EventMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *EventMutation) Fields() []string {
-	fields := make([]string, 0, 22)
-	if m.status != nil {
-		fields = append(fields, event.FieldStatus)
+// OldSlug returns the old "slug" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, event.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, event.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
 	}
-	if m.created_by != nil {
-		fields = append(fields, event.FieldCreatedBy)
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *EventMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// SetType sets the "type" field.
+func (m *EventMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *EventMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
 	}
-	if m.updated_by != nil {
-		fields = append(fields, event.FieldUpdatedBy)
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
-	if m.metadata != nil {
-		fields = append(fields, event.FieldMetadata)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
-	if m.slug != nil {
-		fields = append(fields, event.FieldSlug)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	if m._type != nil {
-		fields = append(fields, event.FieldType)
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *EventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *EventMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *EventMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
 	}
-	if m.title != nil {
-		fields = append(fields, event.FieldTitle)
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
-	if m.subtitle != nil {
-		fields = append(fields, event.FieldSubtitle)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
-	if m.description != nil {
-		fields = append(fields, event.FieldDescription)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	if m.place_id != nil {
-		fields = append(fields, event.FieldPlaceID)
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *EventMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (m *EventMutation) SetSubtitle(s string) {
+	m.subtitle = &s
+}
+
+// Subtitle returns the value of the "subtitle" field in the mutation.
+func (m *EventMutation) Subtitle() (r string, exists bool) {
+	v := m.subtitle
+	if v == nil {
+		return
 	}
-	if m.start_date != nil {
-		fields = append(fields, event.FieldStartDate)
+	return *v, true
+}
+
+// OldSubtitle returns the old "subtitle" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldSubtitle(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubtitle is only allowed on UpdateOne operations")
 	}
-	if m.end_date != nil {
-		fields = append(fields, event.FieldEndDate)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubtitle requires an ID field in the mutation")
 	}
-	if m.cover_image_url != nil {
-		fields = append(fields, event.FieldCoverImageURL)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubtitle: %w", err)
 	}
-	if m.images != nil {
-		fields = append(fields, event.FieldImages)
+	return oldValue.Subtitle, nil
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (m *EventMutation) ClearSubtitle() {
+	m.subtitle = nil
+	m.clearedFields[event.FieldSubtitle] = struct{}{}
+}
+
+// SubtitleCleared returns if the "subtitle" field was cleared in this mutation.
+func (m *EventMutation) SubtitleCleared() bool {
+	_, ok := m.clearedFields[event.FieldSubtitle]
+	return ok
+}
+
+// ResetSubtitle resets all changes to the "subtitle" field.
+func (m *EventMutation) ResetSubtitle() {
+	m.subtitle = nil
+	delete(m.clearedFields, event.FieldSubtitle)
+}
+
+// SetDescription sets the "description" field.
+func (m *EventMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *EventMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
 	}
-	if m.tags != nil {
-		fields = append(fields, event.FieldTags)
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
-	if m.latitude != nil {
-		fields = append(fields, event.FieldLatitude)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
-	if m.longitude != nil {
-		fields = append(fields, event.FieldLongitude)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	if m.location_name != nil {
-		fields = append(fields, event.FieldLocationName)
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *EventMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[event.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *EventMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[event.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *EventMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, event.FieldDescription)
+}
+
+// SetPlaceID sets the "place_id" field.
+func (m *EventMutation) SetPlaceID(s string) {
+	m.place_id = &s
+}
+
+// PlaceID returns the value of the "place_id" field in the mutation.
+func (m *EventMutation) PlaceID() (r string, exists bool) {
+	v := m.place_id
+	if v == nil {
+		return
 	}
-	if m.view_count != nil {
-		fields = append(fields, event.FieldViewCount)
+	return *v, true
+}
+
+// OldPlaceID returns the old "place_id" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldPlaceID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlaceID is only allowed on UpdateOne operations")
 	}
-	if m.interested_count != nil {
-		fields = append(fields, event.FieldInterestedCount)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlaceID requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlaceID: %w", err)
+	}
+	return oldValue.PlaceID, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *EventMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case event.FieldStatus:
-		return m.Status()
-	case event.FieldCreatedAt:
-		return m.CreatedAt()
-	case event.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case event.FieldCreatedBy:
-		return m.CreatedBy()
-	case event.FieldUpdatedBy:
-		return m.UpdatedBy()
-	case event.FieldMetadata:
-		return m.Metadata()
-	case event.FieldSlug:
-		return m.Slug()
-	case event.FieldType:
-		return m.GetType()
-	case event.FieldTitle:
-		return m.Title()
-	case event.FieldSubtitle:
-		return m.Subtitle()
-	case event.FieldDescription:
-		return m.Description()
-	case event.FieldPlaceID:
-		return m.PlaceID()
-	case event.FieldStartDate:
-		return m.StartDate()
-	case event.FieldEndDate:
-		return m.EndDate()
-	case event.FieldCoverImageURL:
-		return m.CoverImageURL()
-	case event.FieldImages:
-		return m.Images()
-	case event.FieldTags:
-		return m.Tags()
-	case event.FieldLatitude:
-		return m.Latitude()
-	case event.FieldLongitude:
-		return m.Longitude()
-	case event.FieldLocationName:
-		return m.LocationName()
-	case event.FieldViewCount:
-		return m.ViewCount()
-	case event.FieldInterestedCount:
-		return m.InterestedCount()
-	}
-	return nil, false
+// ClearPlaceID clears the value of the "place_id" field.
+func (m *EventMutation) ClearPlaceID() {
+	m.place_id = nil
+	m.clearedFields[event.FieldPlaceID] = struct{}{}
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *EventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case event.FieldStatus:
-		return m.OldStatus(ctx)
-	case event.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case event.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case event.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case event.FieldUpdatedBy:
-		return m.OldUpdatedBy(ctx)
-	case event.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case event.FieldSlug:
-		return m.OldSlug(ctx)
-	case event.FieldType:
-		return m.OldType(ctx)
-	case event.FieldTitle:
-		return m.OldTitle(ctx)
-	case event.FieldSubtitle:
-		return m.OldSubtitle(ctx)
-	case event.FieldDescription:
-		return m.OldDescription(ctx)
-	case event.FieldPlaceID:
-		return m.OldPlaceID(ctx)
-	case event.FieldStartDate:
-		return m.OldStartDate(ctx)
-	case event.FieldEndDate:
-		return m.OldEndDate(ctx)
-	case event.FieldCoverImageURL:
-		return m.OldCoverImageURL(ctx)
-	case event.FieldImages:
-		return m.OldImages(ctx)
-	case event.FieldTags:
-		return m.OldTags(ctx)
-	case event.FieldLatitude:
-		return m.OldLatitude(ctx)
-	case event.FieldLongitude:
-		return m.OldLongitude(ctx)
-	case event.FieldLocationName:
-		return m.OldLocationName(ctx)
-	case event.FieldViewCount:
-		return m.OldViewCount(ctx)
-	case event.FieldInterestedCount:
-		return m.OldInterestedCount(ctx)
-	}
-	return nil, fmt.Errorf("unknown Event field %s", name)
+// PlaceIDCleared returns if the "place_id" field was cleared in this mutation.
+func (m *EventMutation) PlaceIDCleared() bool {
+	_, ok := m.clearedFields[event.FieldPlaceID]
+	return ok
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EventMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case event.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case event.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case event.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case event.FieldCreatedBy:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedBy(v)
-		return nil
-	case event.FieldUpdatedBy:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedBy(v)
-		return nil
-	case event.FieldMetadata:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMetadata(v)
-		return nil
-	case event.FieldSlug:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSlug(v)
-		return nil
-	case event.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case event.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case event.FieldSubtitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSubtitle(v)
-		return nil
-	case event.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case event.FieldPlaceID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPlaceID(v)
-		return nil
-	case event.FieldStartDate:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartDate(v)
-		return nil
-	case event.FieldEndDate:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEndDate(v)
-		return nil
-	case event.FieldCoverImageURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCoverImageURL(v)
-		return nil
-	case event.FieldImages:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetImages(v)
-		return nil
-	case event.FieldTags:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTags(v)
-		return nil
-	case event.FieldLatitude:
-		v, ok := value.(*decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLatitude(v)
-		return nil
-	case event.FieldLongitude:
-		v, ok := value.(*decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLongitude(v)
-		return nil
-	case event.FieldLocationName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLocationName(v)
-		return nil
-	case event.FieldViewCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetViewCount(v)
-		return nil
-	case event.FieldInterestedCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInterestedCount(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Event field %s", name)
+// ResetPlaceID resets all changes to the "place_id" field.
+func (m *EventMutation) ResetPlaceID() {
+	m.place_id = nil
+	delete(m.clearedFields, event.FieldPlaceID)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *EventMutation) AddedFields() []string {
-	var fields []string
-	if m.addview_count != nil {
-		fields = append(fields, event.FieldViewCount)
-	}
-	if m.addinterested_count != nil {
-		fields = append(fields, event.FieldInterestedCount)
-	}
-	return fields
+// SetStartDate sets the "start_date" field.
+func (m *EventMutation) SetStartDate(t time.Time) {
+	m.start_date = &t
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *EventMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case event.FieldViewCount:
-		return m.AddedViewCount()
-	case event.FieldInterestedCount:
-		return m.AddedInterestedCount()
+// StartDate returns the value of the "start_date" field in the mutation.
+func (m *EventMutation) StartDate() (r time.Time, exists bool) {
+	v := m.start_date
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EventMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case event.FieldViewCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddViewCount(v)
-		return nil
-	case event.FieldInterestedCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddInterestedCount(v)
-		return nil
+// OldStartDate returns the old "start_date" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldStartDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartDate is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Event numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartDate: %w", err)
+	}
+	return oldValue.StartDate, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *EventMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(event.FieldCreatedBy) {
-		fields = append(fields, event.FieldCreatedBy)
+// ResetStartDate resets all changes to the "start_date" field.
+func (m *EventMutation) ResetStartDate() {
+	m.start_date = nil
+}
+
+// SetEndDate sets the "end_date" field.
+func (m *EventMutation) SetEndDate(t time.Time) {
+	m.end_date = &t
+}
+
+// EndDate returns the value of the "end_date" field in the mutation.
+func (m *EventMutation) EndDate() (r time.Time, exists bool) {
+	v := m.end_date
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(event.FieldUpdatedBy) {
-		fields = append(fields, event.FieldUpdatedBy)
+	return *v, true
+}
+
+// OldEndDate returns the old "end_date" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldEndDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndDate is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(event.FieldMetadata) {
-		fields = append(fields, event.FieldMetadata)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndDate requires an ID field in the mutation")
 	}
-	if m.FieldCleared(event.FieldSubtitle) {
-		fields = append(fields, event.FieldSubtitle)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndDate: %w", err)
 	}
-	if m.FieldCleared(event.FieldDescription) {
-		fields = append(fields, event.FieldDescription)
+	return oldValue.EndDate, nil
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (m *EventMutation) ClearEndDate() {
+	m.end_date = nil
+	m.clearedFields[event.FieldEndDate] = struct{}{}
+}
+
+// EndDateCleared returns if the "end_date" field was cleared in this mutation.
+func (m *EventMutation) EndDateCleared() bool {
+	_, ok := m.clearedFields[event.FieldEndDate]
+	return ok
+}
+
+// ResetEndDate resets all changes to the "end_date" field.
+func (m *EventMutation) ResetEndDate() {
+	m.end_date = nil
+	delete(m.clearedFields, event.FieldEndDate)
+}
+
+// SetCoverImageURL sets the "cover_image_url" field.
+func (m *EventMutation) SetCoverImageURL(s string) {
+	m.cover_image_url = &s
+}
+
+// CoverImageURL returns the value of the "cover_image_url" field in the mutation.
+func (m *EventMutation) CoverImageURL() (r string, exists bool) {
+	v := m.cover_image_url
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(event.FieldPlaceID) {
-		fields = append(fields, event.FieldPlaceID)
+	return *v, true
+}
+
+// OldCoverImageURL returns the old "cover_image_url" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldCoverImageURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCoverImageURL is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(event.FieldEndDate) {
-		fields = append(fields, event.FieldEndDate)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCoverImageURL requires an ID field in the mutation")
 	}
-	if m.FieldCleared(event.FieldCoverImageURL) {
-		fields = append(fields, event.FieldCoverImageURL)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCoverImageURL: %w", err)
 	}
-	if m.FieldCleared(event.FieldImages) {
-		fields = append(fields, event.FieldImages)
+	return oldValue.CoverImageURL, nil
+}
+
+// ClearCoverImageURL clears the value of the "cover_image_url" field.
+func (m *EventMutation) ClearCoverImageURL() {
+	m.cover_image_url = nil
+	m.clearedFields[event.FieldCoverImageURL] = struct{}{}
+}
+
+// CoverImageURLCleared returns if the "cover_image_url" field was cleared in this mutation.
+func (m *EventMutation) CoverImageURLCleared() bool {
+	_, ok := m.clearedFields[event.FieldCoverImageURL]
+	return ok
+}
+
+// ResetCoverImageURL resets all changes to the "cover_image_url" field.
+func (m *EventMutation) ResetCoverImageURL() {
+	m.cover_image_url = nil
+	delete(m.clearedFields, event.FieldCoverImageURL)
+}
+
+// SetImages sets the "images" field.
+func (m *EventMutation) SetImages(s []string) {
+	m.images = &s
+	m.appendimages = nil
+}
+
+// Images returns the value of the "images" field in the mutation.
+func (m *EventMutation) Images() (r []string, exists bool) {
+	v := m.images
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(event.FieldTags) {
-		fields = append(fields, event.FieldTags)
+	return *v, true
+}
+
+// OldImages returns the old "images" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldImages(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImages is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(event.FieldLatitude) {
-		fields = append(fields, event.FieldLatitude)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImages requires an ID field in the mutation")
 	}
-	if m.FieldCleared(event.FieldLongitude) {
-		fields = append(fields, event.FieldLongitude)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImages: %w", err)
 	}
-	if m.FieldCleared(event.FieldLocationName) {
-		fields = append(fields, event.FieldLocationName)
+	return oldValue.Images, nil
+}
+
+// AppendImages adds s to the "images" field.
+func (m *EventMutation) AppendImages(s []string) {
+	m.appendimages = append(m.appendimages, s...)
+}
+
+// AppendedImages returns the list of values that were appended to the "images" field in this mutation.
+func (m *EventMutation) AppendedImages() ([]string, bool) {
+	if len(m.appendimages) == 0 {
+		return nil, false
 	}
-	return fields
+	return m.appendimages, true
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *EventMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearImages clears the value of the "images" field.
+func (m *EventMutation) ClearImages() {
+	m.images = nil
+	m.appendimages = nil
+	m.clearedFields[event.FieldImages] = struct{}{}
+}
+
+// ImagesCleared returns if the "images" field was cleared in this mutation.
+func (m *EventMutation) ImagesCleared() bool {
+	_, ok := m.clearedFields[event.FieldImages]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *EventMutation) ClearField(name string) error {
-	switch name {
-	case event.FieldCreatedBy:
-		m.ClearCreatedBy()
-		return nil
-	case event.FieldUpdatedBy:
-		m.ClearUpdatedBy()
-		return nil
-	case event.FieldMetadata:
-		m.ClearMetadata()
-		return nil
-	case event.FieldSubtitle:
-		m.ClearSubtitle()
-		return nil
-	case event.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case event.FieldPlaceID:
-		m.ClearPlaceID()
-		return nil
-	case event.FieldEndDate:
-		m.ClearEndDate()
-		return nil
-	case event.FieldCoverImageURL:
-		m.ClearCoverImageURL()
-		return nil
-	case event.FieldImages:
-		m.ClearImages()
-		return nil
-	case event.FieldTags:
-		m.ClearTags()
-		return nil
-	case event.FieldLatitude:
-		m.ClearLatitude()
-		return nil
-	case event.FieldLongitude:
-		m.ClearLongitude()
-		return nil
-	case event.FieldLocationName:
-		m.ClearLocationName()
-		return nil
-	}
-	return fmt.Errorf("unknown Event nullable field %s", name)
+// ResetImages resets all changes to the "images" field.
+func (m *EventMutation) ResetImages() {
+	m.images = nil
+	m.appendimages = nil
+	delete(m.clearedFields, event.FieldImages)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *EventMutation) ResetField(name string) error {
-	switch name {
-	case event.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case event.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case event.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case event.FieldCreatedBy:
-		m.ResetCreatedBy()
-		return nil
-	case event.FieldUpdatedBy:
-		m.ResetUpdatedBy()
-		return nil
-	case event.FieldMetadata:
-		m.ResetMetadata()
-		return nil
-	case event.FieldSlug:
-		m.ResetSlug()
-		return nil
-	case event.FieldType:
-		m.ResetType()
-		return nil
-	case event.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case event.FieldSubtitle:
-		m.ResetSubtitle()
-		return nil
-	case event.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case event.FieldPlaceID:
-		m.ResetPlaceID()
-		return nil
-	case event.FieldStartDate:
-		m.ResetStartDate()
-		return nil
-	case event.FieldEndDate:
-		m.ResetEndDate()
-		return nil
-	case event.FieldCoverImageURL:
-		m.ResetCoverImageURL()
-		return nil
-	case event.FieldImages:
-		m.ResetImages()
-		return nil
-	case event.FieldTags:
-		m.ResetTags()
-		return nil
-	case event.FieldLatitude:
-		m.ResetLatitude()
-		return nil
-	case event.FieldLongitude:
-		m.ResetLongitude()
-		return nil
-	case event.FieldLocationName:
-		m.ResetLocationName()
-		return nil
-	case event.FieldViewCount:
-		m.ResetViewCount()
-		return nil
-	case event.FieldInterestedCount:
-		m.ResetInterestedCount()
-		return nil
-	}
-	return fmt.Errorf("unknown Event field %s", name)
+// SetTags sets the "tags" field.
+func (m *EventMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EventMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.occurrences != nil {
-		edges = append(edges, event.EdgeOccurrences)
+// Tags returns the value of the "tags" field in the mutation.
+func (m *EventMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *EventMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case event.EdgeOccurrences:
-		ids := make([]ent.Value, 0, len(m.occurrences))
-		for id := range m.occurrences {
-			ids = append(ids, id)
-		}
-		return ids
+// OldTags returns the old "tags" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
 	}
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EventMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedoccurrences != nil {
-		edges = append(edges, event.EdgeOccurrences)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
 	}
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *EventMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case event.EdgeOccurrences:
-		ids := make([]ent.Value, 0, len(m.removedoccurrences))
-		for id := range m.removedoccurrences {
-			ids = append(ids, id)
-		}
-		return ids
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
 	}
-	return nil
+	return oldValue.Tags, nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EventMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedoccurrences {
-		edges = append(edges, event.EdgeOccurrences)
-	}
-	return edges
+// AppendTags adds s to the "tags" field.
+func (m *EventMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *EventMutation) EdgeCleared(name string) bool {
-	switch name {
-	case event.EdgeOccurrences:
-		return m.clearedoccurrences
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *EventMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
 	}
-	return false
+	return m.appendtags, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *EventMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Event unique edge %s", name)
+// ClearTags clears the value of the "tags" field.
+func (m *EventMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[event.FieldTags] = struct{}{}
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *EventMutation) ResetEdge(name string) error {
-	switch name {
-	case event.EdgeOccurrences:
-		m.ResetOccurrences()
-		return nil
-	}
-	return fmt.Errorf("unknown Event edge %s", name)
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *EventMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[event.FieldTags]
+	return ok
 }
 
-// EventOccurrenceMutation represents an operation that mutates the EventOccurrence nodes in the graph.
-type EventOccurrenceMutation struct {
-	config
-	op                    Op
-	typ                   string
-	id                    *string
-	status                *string
-	created_at            *time.Time
-	updated_at            *time.Time
-	created_by            *string
-	updated_by            *string
-	metadata              *map[string]string
-	recurrence_type       *string
-	start_time            *time.Time
-	end_time              *time.Time
-	duration_minutes      *int
-	addduration_minutes   *int
-	day_of_week           *int
-	addday_of_week        *int
-	day_of_month          *int
-	addday_of_month       *int
-	month_of_year         *int
-	addmonth_of_year      *int
-	exception_dates       *[]string
-	appendexception_dates []string
-	clearedFields         map[string]struct{}
-	event                 *string
-	clearedevent          bool
-	done                  bool
-	oldValue              func(context.Context) (*EventOccurrence, error)
-	predicates            []predicate.EventOccurrence
+// ResetTags resets all changes to the "tags" field.
+func (m *EventMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, event.FieldTags)
 }
 
-var _ ent.Mutation = (*EventOccurrenceMutation)(nil)
+// SetLatitude sets the "latitude" field.
+func (m *EventMutation) SetLatitude(d *decimal.Decimal) {
+	m.latitude = &d
+}
 
-// eventoccurrenceOption allows management of the mutation configuration using functional options.
-type eventoccurrenceOption func(*EventOccurrenceMutation)
+// Latitude returns the value of the "latitude" field in the mutation.
+func (m *EventMutation) Latitude() (r *decimal.Decimal, exists bool) {
+	v := m.latitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newEventOccurrenceMutation creates new mutation for the EventOccurrence entity.
-func newEventOccurrenceMutation(c config, op Op, opts ...eventoccurrenceOption) *EventOccurrenceMutation {
-	m := &EventOccurrenceMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeEventOccurrence,
-		clearedFields: make(map[string]struct{}),
+// OldLatitude returns the old "latitude" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldLatitude(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatitude is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatitude requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatitude: %w", err)
+	}
+	return oldValue.Latitude, nil
 }
 
-// withEventOccurrenceID sets the ID field of the mutation.
-func withEventOccurrenceID(id string) eventoccurrenceOption {
-	return func(m *EventOccurrenceMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *EventOccurrence
-		)
-		m.oldValue = func(ctx context.Context) (*EventOccurrence, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().EventOccurrence.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
-}
-
-// withEventOccurrence sets the old EventOccurrence of the mutation.
-func withEventOccurrence(node *EventOccurrence) eventoccurrenceOption {
-	return func(m *EventOccurrenceMutation) {
-		m.oldValue = func(context.Context) (*EventOccurrence, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EventOccurrenceMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m EventOccurrenceMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of EventOccurrence entities.
-func (m *EventOccurrenceMutation) SetID(id string) {
-	m.id = &id
+// ClearLatitude clears the value of the "latitude" field.
+func (m *EventMutation) ClearLatitude() {
+	m.latitude = nil
+	m.clearedFields[event.FieldLatitude] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *EventOccurrenceMutation) ID() (id string, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// LatitudeCleared returns if the "latitude" field was cleared in this mutation.
+func (m *EventMutation) LatitudeCleared() bool {
+	_, ok := m.clearedFields[event.FieldLatitude]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *EventOccurrenceMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().EventOccurrence.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetLatitude resets all changes to the "latitude" field.
+func (m *EventMutation) ResetLatitude() {
+	m.latitude = nil
+	delete(m.clearedFields, event.FieldLatitude)
 }
 
-// SetStatus sets the "status" field.
-func (m *EventOccurrenceMutation) SetStatus(s string) {
-	m.status = &s
+// SetLongitude sets the "longitude" field.
+func (m *EventMutation) SetLongitude(d *decimal.Decimal) {
+	m.longitude = &d
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *EventOccurrenceMutation) Status() (r string, exists bool) {
-	v := m.status
+// Longitude returns the value of the "longitude" field in the mutation.
+func (m *EventMutation) Longitude() (r *decimal.Decimal, exists bool) {
+	v := m.longitude
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the EventOccurrence entity.
-// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// OldLongitude returns the old "longitude" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventOccurrenceMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *EventMutation) OldLongitude(ctx context.Context) (v *decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldLongitude is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldLongitude requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldLongitude: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Longitude, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *EventOccurrenceMutation) ResetStatus() {
-	m.status = nil
+// ClearLongitude clears the value of the "longitude" field.
+func (m *EventMutation) ClearLongitude() {
+	m.longitude = nil
+	m.clearedFields[event.FieldLongitude] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *EventOccurrenceMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// LongitudeCleared returns if the "longitude" field was cleared in this mutation.
+func (m *EventMutation) LongitudeCleared() bool {
+	_, ok := m.clearedFields[event.FieldLongitude]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EventOccurrenceMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetLongitude resets all changes to the "longitude" field.
+func (m *EventMutation) ResetLongitude() {
+	m.longitude = nil
+	delete(m.clearedFields, event.FieldLongitude)
+}
+
+// SetLocationName sets the "location_name" field.
+func (m *EventMutation) SetLocationName(s string) {
+	m.location_name = &s
+}
+
+// LocationName returns the value of the "location_name" field in the mutation.
+func (m *EventMutation) LocationName() (r string, exists bool) {
+	v := m.location_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the EventOccurrence entity.
-// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// OldLocationName returns the old "location_name" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventOccurrenceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EventMutation) OldLocationName(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLocationName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLocationName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLocationName: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.LocationName, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EventOccurrenceMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearLocationName clears the value of the "location_name" field.
+func (m *EventMutation) ClearLocationName() {
+	m.location_name = nil
+	m.clearedFields[event.FieldLocationName] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *EventOccurrenceMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// LocationNameCleared returns if the "location_name" field was cleared in this mutation.
+func (m *EventMutation) LocationNameCleared() bool {
+	_, ok := m.clearedFields[event.FieldLocationName]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EventOccurrenceMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetLocationName resets all changes to the "location_name" field.
+func (m *EventMutation) ResetLocationName() {
+	m.location_name = nil
+	delete(m.clearedFields, event.FieldLocationName)
+}
+
+// SetViewCount sets the "view_count" field.
+func (m *EventMutation) SetViewCount(i int) {
+	m.view_count = &i
+	m.addview_count = nil
+}
+
+// ViewCount returns the value of the "view_count" field in the mutation.
+func (m *EventMutation) ViewCount() (r int, exists bool) {
+	v := m.view_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the EventOccurrence entity.
-// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// OldViewCount returns the old "view_count" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventOccurrenceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EventMutation) OldViewCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldViewCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldViewCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldViewCount: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.ViewCount, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EventOccurrenceMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AddViewCount adds i to the "view_count" field.
+func (m *EventMutation) AddViewCount(i int) {
+	if m.addview_count != nil {
+		*m.addview_count += i
+	} else {
+		m.addview_count = &i
+	}
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *EventOccurrenceMutation) SetCreatedBy(s string) {
-	m.created_by = &s
+// AddedViewCount returns the value that was added to the "view_count" field in this mutation.
+func (m *EventMutation) AddedViewCount() (r int, exists bool) {
+	v := m.addview_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *EventOccurrenceMutation) CreatedBy() (r string, exists bool) {
-	v := m.created_by
+// ResetViewCount resets all changes to the "view_count" field.
+func (m *EventMutation) ResetViewCount() {
+	m.view_count = nil
+	m.addview_count = nil
+}
+
+// SetInterestedCount sets the "interested_count" field.
+func (m *EventMutation) SetInterestedCount(i int) {
+	m.interested_count = &i
+	m.addinterested_count = nil
+}
+
+// InterestedCount returns the value of the "interested_count" field in the mutation.
+func (m *EventMutation) InterestedCount() (r int, exists bool) {
+	v := m.interested_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the EventOccurrence entity.
-// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// OldInterestedCount returns the old "interested_count" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventOccurrenceMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+func (m *EventMutation) OldInterestedCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldInterestedCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+		return v, errors.New("OldInterestedCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldInterestedCount: %w", err)
 	}
-	return oldValue.CreatedBy, nil
-}
-
-// ClearCreatedBy clears the value of the "created_by" field.
-func (m *EventOccurrenceMutation) ClearCreatedBy() {
-	m.created_by = nil
-	m.clearedFields[eventoccurrence.FieldCreatedBy] = struct{}{}
+	return oldValue.InterestedCount, nil
 }
 
-// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *EventOccurrenceMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[eventoccurrence.FieldCreatedBy]
-	return ok
-}
-
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *EventOccurrenceMutation) ResetCreatedBy() {
-	m.created_by = nil
-	delete(m.clearedFields, eventoccurrence.FieldCreatedBy)
-}
-
-// SetUpdatedBy sets the "updated_by" field.
-func (m *EventOccurrenceMutation) SetUpdatedBy(s string) {
-	m.updated_by = &s
+// AddInterestedCount adds i to the "interested_count" field.
+func (m *EventMutation) AddInterestedCount(i int) {
+	if m.addinterested_count != nil {
+		*m.addinterested_count += i
+	} else {
+		m.addinterested_count = &i
+	}
 }
 
-// UpdatedBy returns the value of the "updated_by" field in the mutation.
-func (m *EventOccurrenceMutation) UpdatedBy() (r string, exists bool) {
-	v := m.updated_by
+// AddedInterestedCount returns the value that was added to the "interested_count" field in this mutation.
+func (m *EventMutation) AddedInterestedCount() (r int, exists bool) {
+	v := m.addinterested_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedBy returns the old "updated_by" field's value of the EventOccurrence entity.
-// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventOccurrenceMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+// ResetInterestedCount resets all changes to the "interested_count" field.
+func (m *EventMutation) ResetInterestedCount() {
+	m.interested_count = nil
+	m.addinterested_count = nil
+}
+
+// AddOccurrenceIDs adds the "occurrences" edge to the EventOccurrence entity by ids.
+func (m *EventMutation) AddOccurrenceIDs(ids ...string) {
+	if m.occurrences == nil {
+		m.occurrences = make(map[string]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	for i := range ids {
+		m.occurrences[ids[i]] = struct{}{}
 	}
-	return oldValue.UpdatedBy, nil
 }
 
-// ClearUpdatedBy clears the value of the "updated_by" field.
-func (m *EventOccurrenceMutation) ClearUpdatedBy() {
-	m.updated_by = nil
-	m.clearedFields[eventoccurrence.FieldUpdatedBy] = struct{}{}
+// ClearOccurrences clears the "occurrences" edge to the EventOccurrence entity.
+func (m *EventMutation) ClearOccurrences() {
+	m.clearedoccurrences = true
 }
 
-// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
-func (m *EventOccurrenceMutation) UpdatedByCleared() bool {
-	_, ok := m.clearedFields[eventoccurrence.FieldUpdatedBy]
-	return ok
+// OccurrencesCleared reports if the "occurrences" edge to the EventOccurrence entity was cleared.
+func (m *EventMutation) OccurrencesCleared() bool {
+	return m.clearedoccurrences
 }
 
-// ResetUpdatedBy resets all changes to the "updated_by" field.
-func (m *EventOccurrenceMutation) ResetUpdatedBy() {
-	m.updated_by = nil
-	delete(m.clearedFields, eventoccurrence.FieldUpdatedBy)
+// RemoveOccurrenceIDs removes the "occurrences" edge to the EventOccurrence entity by IDs.
+func (m *EventMutation) RemoveOccurrenceIDs(ids ...string) {
+	if m.removedoccurrences == nil {
+		m.removedoccurrences = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.occurrences, ids[i])
+		m.removedoccurrences[ids[i]] = struct{}{}
+	}
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *EventOccurrenceMutation) SetMetadata(value map[string]string) {
-	m.metadata = &value
+// RemovedOccurrences returns the removed IDs of the "occurrences" edge to the EventOccurrence entity.
+func (m *EventMutation) RemovedOccurrencesIDs() (ids []string) {
+	for id := range m.removedoccurrences {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *EventOccurrenceMutation) Metadata() (r map[string]string, exists bool) {
-	v := m.metadata
-	if v == nil {
-		return
+// OccurrencesIDs returns the "occurrences" edge IDs in the mutation.
+func (m *EventMutation) OccurrencesIDs() (ids []string) {
+	for id := range m.occurrences {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldMetadata returns the old "metadata" field's value of the EventOccurrence entity.
-// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventOccurrenceMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
-	}
-	return oldValue.Metadata, nil
+// ResetOccurrences resets all changes to the "occurrences" edge.
+func (m *EventMutation) ResetOccurrences() {
+	m.occurrences = nil
+	m.clearedoccurrences = false
+	m.removedoccurrences = nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *EventOccurrenceMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[eventoccurrence.FieldMetadata] = struct{}{}
+// Where appends a list predicates to the EventMutation builder.
+func (m *EventMutation) Where(ps ...predicate.Event) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *EventOccurrenceMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[eventoccurrence.FieldMetadata]
-	return ok
+// WhereP appends storage-level predicates to the EventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Event, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *EventOccurrenceMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, eventoccurrence.FieldMetadata)
+// Op returns the operation name.
+func (m *EventMutation) Op() Op {
+	return m.op
 }
 
-// SetEventID sets the "event_id" field.
-func (m *EventOccurrenceMutation) SetEventID(s string) {
-	m.event = &s
+// SetOp allows setting the mutation operation.
+func (m *EventMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// EventID returns the value of the "event_id" field in the mutation.
-func (m *EventOccurrenceMutation) EventID() (r string, exists bool) {
-	v := m.event
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (Event).
+func (m *EventMutation) Type() string {
+	return m.typ
 }
 
-// OldEventID returns the old "event_id" field's value of the EventOccurrence entity.
-// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventOccurrenceMutation) OldEventID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EventMutation) Fields() []string {
+	fields := make([]string, 0, 24)
+	if m.status != nil {
+		fields = append(fields, event.FieldStatus)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEventID requires an ID field in the mutation")
+	if m.created_at != nil {
+		fields = append(fields, event.FieldCreatedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	if m.updated_at != nil {
+		fields = append(fields, event.FieldUpdatedAt)
 	}
-	return oldValue.EventID, nil
-}
-
-// ResetEventID resets all changes to the "event_id" field.
-func (m *EventOccurrenceMutation) ResetEventID() {
-	m.event = nil
-}
-
-// SetRecurrenceType sets the "recurrence_type" field.
-func (m *EventOccurrenceMutation) SetRecurrenceType(s string) {
-	m.recurrence_type = &s
-}
-
-// RecurrenceType returns the value of the "recurrence_type" field in the mutation.
-func (m *EventOccurrenceMutation) RecurrenceType() (r string, exists bool) {
-	v := m.recurrence_type
-	if v == nil {
-		return
+	if m.created_by != nil {
+		fields = append(fields, event.FieldCreatedBy)
 	}
-	return *v, true
-}
-
-// OldRecurrenceType returns the old "recurrence_type" field's value of the EventOccurrence entity.
-// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventOccurrenceMutation) OldRecurrenceType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRecurrenceType is only allowed on UpdateOne operations")
+	if m.updated_by != nil {
+		fields = append(fields, event.FieldUpdatedBy)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRecurrenceType requires an ID field in the mutation")
+	if m.deleted_at != nil {
+		fields = append(fields, event.FieldDeletedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRecurrenceType: %w", err)
+	if m.deleted_by != nil {
+		fields = append(fields, event.FieldDeletedBy)
 	}
-	return oldValue.RecurrenceType, nil
-}
-
-// ResetRecurrenceType resets all changes to the "recurrence_type" field.
-func (m *EventOccurrenceMutation) ResetRecurrenceType() {
-	m.recurrence_type = nil
-}
-
-// SetStartTime sets the "start_time" field.
-func (m *EventOccurrenceMutation) SetStartTime(t time.Time) {
-	m.start_time = &t
-}
-
-// StartTime returns the value of the "start_time" field in the mutation.
-func (m *EventOccurrenceMutation) StartTime() (r time.Time, exists bool) {
-	v := m.start_time
-	if v == nil {
-		return
+	if m.metadata != nil {
+		fields = append(fields, event.FieldMetadata)
 	}
-	return *v, true
-}
-
-// OldStartTime returns the old "start_time" field's value of the EventOccurrence entity.
-// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventOccurrenceMutation) OldStartTime(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+	if m.slug != nil {
+		fields = append(fields, event.FieldSlug)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	if m._type != nil {
+		fields = append(fields, event.FieldType)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	if m.title != nil {
+		fields = append(fields, event.FieldTitle)
 	}
-	return oldValue.StartTime, nil
-}
-
-// ClearStartTime clears the value of the "start_time" field.
-func (m *EventOccurrenceMutation) ClearStartTime() {
-	m.start_time = nil
-	m.clearedFields[eventoccurrence.FieldStartTime] = struct{}{}
-}
-
-// StartTimeCleared returns if the "start_time" field was cleared in this mutation.
-func (m *EventOccurrenceMutation) StartTimeCleared() bool {
-	_, ok := m.clearedFields[eventoccurrence.FieldStartTime]
-	return ok
-}
-
-// ResetStartTime resets all changes to the "start_time" field.
-func (m *EventOccurrenceMutation) ResetStartTime() {
-	m.start_time = nil
-	delete(m.clearedFields, eventoccurrence.FieldStartTime)
-}
-
-// SetEndTime sets the "end_time" field.
-func (m *EventOccurrenceMutation) SetEndTime(t time.Time) {
-	m.end_time = &t
-}
-
-// EndTime returns the value of the "end_time" field in the mutation.
-func (m *EventOccurrenceMutation) EndTime() (r time.Time, exists bool) {
-	v := m.end_time
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEndTime returns the old "end_time" field's value of the EventOccurrence entity.
-// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventOccurrenceMutation) OldEndTime(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	if m.subtitle != nil {
+		fields = append(fields, event.FieldSubtitle)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	if m.description != nil {
+		fields = append(fields, event.FieldDescription)
 	}
-	return oldValue.EndTime, nil
-}
-
-// ClearEndTime clears the value of the "end_time" field.
-func (m *EventOccurrenceMutation) ClearEndTime() {
-	m.end_time = nil
-	m.clearedFields[eventoccurrence.FieldEndTime] = struct{}{}
-}
-
-// EndTimeCleared returns if the "end_time" field was cleared in this mutation.
-func (m *EventOccurrenceMutation) EndTimeCleared() bool {
-	_, ok := m.clearedFields[eventoccurrence.FieldEndTime]
-	return ok
-}
-
-// ResetEndTime resets all changes to the "end_time" field.
-func (m *EventOccurrenceMutation) ResetEndTime() {
-	m.end_time = nil
-	delete(m.clearedFields, eventoccurrence.FieldEndTime)
-}
-
-// SetDurationMinutes sets the "duration_minutes" field.
-func (m *EventOccurrenceMutation) SetDurationMinutes(i int) {
-	m.duration_minutes = &i
-	m.addduration_minutes = nil
-}
-
-// DurationMinutes returns the value of the "duration_minutes" field in the mutation.
-func (m *EventOccurrenceMutation) DurationMinutes() (r int, exists bool) {
-	v := m.duration_minutes
-	if v == nil {
-		return
+	if m.place_id != nil {
+		fields = append(fields, event.FieldPlaceID)
 	}
-	return *v, true
-}
-
-// OldDurationMinutes returns the old "duration_minutes" field's value of the EventOccurrence entity.
-// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventOccurrenceMutation) OldDurationMinutes(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDurationMinutes is only allowed on UpdateOne operations")
+	if m.start_date != nil {
+		fields = append(fields, event.FieldStartDate)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDurationMinutes requires an ID field in the mutation")
+	if m.end_date != nil {
+		fields = append(fields, event.FieldEndDate)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDurationMinutes: %w", err)
+	if m.cover_image_url != nil {
+		fields = append(fields, event.FieldCoverImageURL)
 	}
-	return oldValue.DurationMinutes, nil
-}
-
-// AddDurationMinutes adds i to the "duration_minutes" field.
-func (m *EventOccurrenceMutation) AddDurationMinutes(i int) {
-	if m.addduration_minutes != nil {
-		*m.addduration_minutes += i
-	} else {
-		m.addduration_minutes = &i
+	if m.images != nil {
+		fields = append(fields, event.FieldImages)
 	}
-}
-
-// AddedDurationMinutes returns the value that was added to the "duration_minutes" field in this mutation.
-func (m *EventOccurrenceMutation) AddedDurationMinutes() (r int, exists bool) {
-	v := m.addduration_minutes
-	if v == nil {
-		return
+	if m.tags != nil {
+		fields = append(fields, event.FieldTags)
 	}
-	return *v, true
-}
-
-// ClearDurationMinutes clears the value of the "duration_minutes" field.
-func (m *EventOccurrenceMutation) ClearDurationMinutes() {
-	m.duration_minutes = nil
-	m.addduration_minutes = nil
-	m.clearedFields[eventoccurrence.FieldDurationMinutes] = struct{}{}
-}
-
-// DurationMinutesCleared returns if the "duration_minutes" field was cleared in this mutation.
-func (m *EventOccurrenceMutation) DurationMinutesCleared() bool {
-	_, ok := m.clearedFields[eventoccurrence.FieldDurationMinutes]
-	return ok
-}
-
-// ResetDurationMinutes resets all changes to the "duration_minutes" field.
-func (m *EventOccurrenceMutation) ResetDurationMinutes() {
-	m.duration_minutes = nil
-	m.addduration_minutes = nil
-	delete(m.clearedFields, eventoccurrence.FieldDurationMinutes)
-}
-
-// SetDayOfWeek sets the "day_of_week" field.
-func (m *EventOccurrenceMutation) SetDayOfWeek(i int) {
-	m.day_of_week = &i
-	m.addday_of_week = nil
-}
-
-// DayOfWeek returns the value of the "day_of_week" field in the mutation.
-func (m *EventOccurrenceMutation) DayOfWeek() (r int, exists bool) {
-	v := m.day_of_week
-	if v == nil {
-		return
+	if m.latitude != nil {
+		fields = append(fields, event.FieldLatitude)
 	}
-	return *v, true
-}
-
-// OldDayOfWeek returns the old "day_of_week" field's value of the EventOccurrence entity.
-// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventOccurrenceMutation) OldDayOfWeek(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDayOfWeek is only allowed on UpdateOne operations")
+	if m.longitude != nil {
+		fields = append(fields, event.FieldLongitude)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDayOfWeek requires an ID field in the mutation")
+	if m.location_name != nil {
+		fields = append(fields, event.FieldLocationName)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDayOfWeek: %w", err)
+	if m.view_count != nil {
+		fields = append(fields, event.FieldViewCount)
 	}
-	return oldValue.DayOfWeek, nil
-}
-
-// AddDayOfWeek adds i to the "day_of_week" field.
-func (m *EventOccurrenceMutation) AddDayOfWeek(i int) {
-	if m.addday_of_week != nil {
-		*m.addday_of_week += i
-	} else {
-		m.addday_of_week = &i
+	if m.interested_count != nil {
+		fields = append(fields, event.FieldInterestedCount)
 	}
+	return fields
 }
 
-// AddedDayOfWeek returns the value that was added to the "day_of_week" field in this mutation.
-func (m *EventOccurrenceMutation) AddedDayOfWeek() (r int, exists bool) {
-	v := m.addday_of_week
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case event.FieldStatus:
+		return m.Status()
+	case event.FieldCreatedAt:
+		return m.CreatedAt()
+	case event.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case event.FieldCreatedBy:
+		return m.CreatedBy()
+	case event.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case event.FieldDeletedAt:
+		return m.DeletedAt()
+	case event.FieldDeletedBy:
+		return m.DeletedBy()
+	case event.FieldMetadata:
+		return m.Metadata()
+	case event.FieldSlug:
+		return m.Slug()
+	case event.FieldType:
+		return m.GetType()
+	case event.FieldTitle:
+		return m.Title()
+	case event.FieldSubtitle:
+		return m.Subtitle()
+	case event.FieldDescription:
+		return m.Description()
+	case event.FieldPlaceID:
+		return m.PlaceID()
+	case event.FieldStartDate:
+		return m.StartDate()
+	case event.FieldEndDate:
+		return m.EndDate()
+	case event.FieldCoverImageURL:
+		return m.CoverImageURL()
+	case event.FieldImages:
+		return m.Images()
+	case event.FieldTags:
+		return m.Tags()
+	case event.FieldLatitude:
+		return m.Latitude()
+	case event.FieldLongitude:
+		return m.Longitude()
+	case event.FieldLocationName:
+		return m.LocationName()
+	case event.FieldViewCount:
+		return m.ViewCount()
+	case event.FieldInterestedCount:
+		return m.InterestedCount()
 	}
-	return *v, true
-}
-
-// ClearDayOfWeek clears the value of the "day_of_week" field.
-func (m *EventOccurrenceMutation) ClearDayOfWeek() {
-	m.day_of_week = nil
-	m.addday_of_week = nil
-	m.clearedFields[eventoccurrence.FieldDayOfWeek] = struct{}{}
+	return nil, false
 }
 
-// DayOfWeekCleared returns if the "day_of_week" field was cleared in this mutation.
-func (m *EventOccurrenceMutation) DayOfWeekCleared() bool {
-	_, ok := m.clearedFields[eventoccurrence.FieldDayOfWeek]
-	return ok
-}
-
-// ResetDayOfWeek resets all changes to the "day_of_week" field.
-func (m *EventOccurrenceMutation) ResetDayOfWeek() {
-	m.day_of_week = nil
-	m.addday_of_week = nil
-	delete(m.clearedFields, eventoccurrence.FieldDayOfWeek)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case event.FieldStatus:
+		return m.OldStatus(ctx)
+	case event.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case event.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case event.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case event.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case event.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case event.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	case event.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case event.FieldSlug:
+		return m.OldSlug(ctx)
+	case event.FieldType:
+		return m.OldType(ctx)
+	case event.FieldTitle:
+		return m.OldTitle(ctx)
+	case event.FieldSubtitle:
+		return m.OldSubtitle(ctx)
+	case event.FieldDescription:
+		return m.OldDescription(ctx)
+	case event.FieldPlaceID:
+		return m.OldPlaceID(ctx)
+	case event.FieldStartDate:
+		return m.OldStartDate(ctx)
+	case event.FieldEndDate:
+		return m.OldEndDate(ctx)
+	case event.FieldCoverImageURL:
+		return m.OldCoverImageURL(ctx)
+	case event.FieldImages:
+		return m.OldImages(ctx)
+	case event.FieldTags:
+		return m.OldTags(ctx)
+	case event.FieldLatitude:
+		return m.OldLatitude(ctx)
+	case event.FieldLongitude:
+		return m.OldLongitude(ctx)
+	case event.FieldLocationName:
+		return m.OldLocationName(ctx)
+	case event.FieldViewCount:
+		return m.OldViewCount(ctx)
+	case event.FieldInterestedCount:
+		return m.OldInterestedCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown Event field %s", name)
 }
 
-// SetDayOfMonth sets the "day_of_month" field.
-func (m *EventOccurrenceMutation) SetDayOfMonth(i int) {
-	m.day_of_month = &i
-	m.addday_of_month = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case event.FieldStatus:
+		v, ok := value.(types.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case event.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case event.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case event.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case event.FieldUpdatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case event.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case event.FieldDeletedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
+	case event.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case event.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case event.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case event.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case event.FieldSubtitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubtitle(v)
+		return nil
+	case event.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case event.FieldPlaceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlaceID(v)
+		return nil
+	case event.FieldStartDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartDate(v)
+		return nil
+	case event.FieldEndDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndDate(v)
+		return nil
+	case event.FieldCoverImageURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCoverImageURL(v)
+		return nil
+	case event.FieldImages:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImages(v)
+		return nil
+	case event.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case event.FieldLatitude:
+		v, ok := value.(*decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatitude(v)
+		return nil
+	case event.FieldLongitude:
+		v, ok := value.(*decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLongitude(v)
+		return nil
+	case event.FieldLocationName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocationName(v)
+		return nil
+	case event.FieldViewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetViewCount(v)
+		return nil
+	case event.FieldInterestedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInterestedCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Event field %s", name)
 }
 
-// DayOfMonth returns the value of the "day_of_month" field in the mutation.
-func (m *EventOccurrenceMutation) DayOfMonth() (r int, exists bool) {
-	v := m.day_of_month
-	if v == nil {
-		return
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EventMutation) AddedFields() []string {
+	var fields []string
+	if m.addview_count != nil {
+		fields = append(fields, event.FieldViewCount)
 	}
-	return *v, true
+	if m.addinterested_count != nil {
+		fields = append(fields, event.FieldInterestedCount)
+	}
+	return fields
 }
 
-// OldDayOfMonth returns the old "day_of_month" field's value of the EventOccurrence entity.
-// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventOccurrenceMutation) OldDayOfMonth(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDayOfMonth is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDayOfMonth requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDayOfMonth: %w", err)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case event.FieldViewCount:
+		return m.AddedViewCount()
+	case event.FieldInterestedCount:
+		return m.AddedInterestedCount()
 	}
-	return oldValue.DayOfMonth, nil
+	return nil, false
 }
 
-// AddDayOfMonth adds i to the "day_of_month" field.
-func (m *EventOccurrenceMutation) AddDayOfMonth(i int) {
-	if m.addday_of_month != nil {
-		*m.addday_of_month += i
-	} else {
-		m.addday_of_month = &i
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case event.FieldViewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddViewCount(v)
+		return nil
+	case event.FieldInterestedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInterestedCount(v)
+		return nil
 	}
+	return fmt.Errorf("unknown Event numeric field %s", name)
 }
 
-// AddedDayOfMonth returns the value that was added to the "day_of_month" field in this mutation.
-func (m *EventOccurrenceMutation) AddedDayOfMonth() (r int, exists bool) {
-	v := m.addday_of_month
-	if v == nil {
-		return
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(event.FieldCreatedBy) {
+		fields = append(fields, event.FieldCreatedBy)
 	}
-	return *v, true
-}
-
-// ClearDayOfMonth clears the value of the "day_of_month" field.
-func (m *EventOccurrenceMutation) ClearDayOfMonth() {
-	m.day_of_month = nil
-	m.addday_of_month = nil
-	m.clearedFields[eventoccurrence.FieldDayOfMonth] = struct{}{}
-}
-
-// DayOfMonthCleared returns if the "day_of_month" field was cleared in this mutation.
-func (m *EventOccurrenceMutation) DayOfMonthCleared() bool {
-	_, ok := m.clearedFields[eventoccurrence.FieldDayOfMonth]
-	return ok
-}
-
-// ResetDayOfMonth resets all changes to the "day_of_month" field.
-func (m *EventOccurrenceMutation) ResetDayOfMonth() {
-	m.day_of_month = nil
-	m.addday_of_month = nil
-	delete(m.clearedFields, eventoccurrence.FieldDayOfMonth)
-}
-
-// SetMonthOfYear sets the "month_of_year" field.
-func (m *EventOccurrenceMutation) SetMonthOfYear(i int) {
-	m.month_of_year = &i
-	m.addmonth_of_year = nil
-}
-
-// MonthOfYear returns the value of the "month_of_year" field in the mutation.
-func (m *EventOccurrenceMutation) MonthOfYear() (r int, exists bool) {
-	v := m.month_of_year
-	if v == nil {
-		return
+	if m.FieldCleared(event.FieldUpdatedBy) {
+		fields = append(fields, event.FieldUpdatedBy)
 	}
-	return *v, true
-}
-
-// OldMonthOfYear returns the old "month_of_year" field's value of the EventOccurrence entity.
-// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventOccurrenceMutation) OldMonthOfYear(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMonthOfYear is only allowed on UpdateOne operations")
+	if m.FieldCleared(event.FieldDeletedAt) {
+		fields = append(fields, event.FieldDeletedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMonthOfYear requires an ID field in the mutation")
+	if m.FieldCleared(event.FieldDeletedBy) {
+		fields = append(fields, event.FieldDeletedBy)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMonthOfYear: %w", err)
+	if m.FieldCleared(event.FieldMetadata) {
+		fields = append(fields, event.FieldMetadata)
 	}
-	return oldValue.MonthOfYear, nil
-}
-
-// AddMonthOfYear adds i to the "month_of_year" field.
-func (m *EventOccurrenceMutation) AddMonthOfYear(i int) {
-	if m.addmonth_of_year != nil {
-		*m.addmonth_of_year += i
-	} else {
-		m.addmonth_of_year = &i
+	if m.FieldCleared(event.FieldSubtitle) {
+		fields = append(fields, event.FieldSubtitle)
 	}
-}
-
-// AddedMonthOfYear returns the value that was added to the "month_of_year" field in this mutation.
-func (m *EventOccurrenceMutation) AddedMonthOfYear() (r int, exists bool) {
-	v := m.addmonth_of_year
-	if v == nil {
-		return
+	if m.FieldCleared(event.FieldDescription) {
+		fields = append(fields, event.FieldDescription)
 	}
-	return *v, true
-}
-
-// ClearMonthOfYear clears the value of the "month_of_year" field.
-func (m *EventOccurrenceMutation) ClearMonthOfYear() {
-	m.month_of_year = nil
-	m.addmonth_of_year = nil
-	m.clearedFields[eventoccurrence.FieldMonthOfYear] = struct{}{}
-}
-
-// MonthOfYearCleared returns if the "month_of_year" field was cleared in this mutation.
-func (m *EventOccurrenceMutation) MonthOfYearCleared() bool {
-	_, ok := m.clearedFields[eventoccurrence.FieldMonthOfYear]
-	return ok
-}
-
-// ResetMonthOfYear resets all changes to the "month_of_year" field.
-func (m *EventOccurrenceMutation) ResetMonthOfYear() {
-	m.month_of_year = nil
-	m.addmonth_of_year = nil
-	delete(m.clearedFields, eventoccurrence.FieldMonthOfYear)
-}
-
-// SetExceptionDates sets the "exception_dates" field.
-func (m *EventOccurrenceMutation) SetExceptionDates(s []string) {
-	m.exception_dates = &s
-	m.appendexception_dates = nil
-}
-
-// ExceptionDates returns the value of the "exception_dates" field in the mutation.
-func (m *EventOccurrenceMutation) ExceptionDates() (r []string, exists bool) {
-	v := m.exception_dates
-	if v == nil {
-		return
+	if m.FieldCleared(event.FieldPlaceID) {
+		fields = append(fields, event.FieldPlaceID)
 	}
-	return *v, true
-}
-
-// OldExceptionDates returns the old "exception_dates" field's value of the EventOccurrence entity.
-// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventOccurrenceMutation) OldExceptionDates(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExceptionDates is only allowed on UpdateOne operations")
+	if m.FieldCleared(event.FieldEndDate) {
+		fields = append(fields, event.FieldEndDate)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExceptionDates requires an ID field in the mutation")
+	if m.FieldCleared(event.FieldCoverImageURL) {
+		fields = append(fields, event.FieldCoverImageURL)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExceptionDates: %w", err)
+	if m.FieldCleared(event.FieldImages) {
+		fields = append(fields, event.FieldImages)
 	}
-	return oldValue.ExceptionDates, nil
-}
-
-// AppendExceptionDates adds s to the "exception_dates" field.
-func (m *EventOccurrenceMutation) AppendExceptionDates(s []string) {
-	m.appendexception_dates = append(m.appendexception_dates, s...)
-}
-
-// AppendedExceptionDates returns the list of values that were appended to the "exception_dates" field in this mutation.
-func (m *EventOccurrenceMutation) AppendedExceptionDates() ([]string, bool) {
-	if len(m.appendexception_dates) == 0 {
-		return nil, false
+	if m.FieldCleared(event.FieldTags) {
+		fields = append(fields, event.FieldTags)
 	}
-	return m.appendexception_dates, true
-}
-
-// ClearExceptionDates clears the value of the "exception_dates" field.
-func (m *EventOccurrenceMutation) ClearExceptionDates() {
-	m.exception_dates = nil
-	m.appendexception_dates = nil
-	m.clearedFields[eventoccurrence.FieldExceptionDates] = struct{}{}
+	if m.FieldCleared(event.FieldLatitude) {
+		fields = append(fields, event.FieldLatitude)
+	}
+	if m.FieldCleared(event.FieldLongitude) {
+		fields = append(fields, event.FieldLongitude)
+	}
+	if m.FieldCleared(event.FieldLocationName) {
+		fields = append(fields, event.FieldLocationName)
+	}
+	return fields
 }
 
-// ExceptionDatesCleared returns if the "exception_dates" field was cleared in this mutation.
-func (m *EventOccurrenceMutation) ExceptionDatesCleared() bool {
-	_, ok := m.clearedFields[eventoccurrence.FieldExceptionDates]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetExceptionDates resets all changes to the "exception_dates" field.
-func (m *EventOccurrenceMutation) ResetExceptionDates() {
-	m.exception_dates = nil
-	m.appendexception_dates = nil
-	delete(m.clearedFields, eventoccurrence.FieldExceptionDates)
-}
-
-// ClearEvent clears the "event" edge to the Event entity.
-func (m *EventOccurrenceMutation) ClearEvent() {
-	m.clearedevent = true
-	m.clearedFields[eventoccurrence.FieldEventID] = struct{}{}
-}
-
-// EventCleared reports if the "event" edge to the Event entity was cleared.
-func (m *EventOccurrenceMutation) EventCleared() bool {
-	return m.clearedevent
-}
-
-// EventIDs returns the "event" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EventID instead. It exists only for internal usage by the builders.
-func (m *EventOccurrenceMutation) EventIDs() (ids []string) {
-	if id := m.event; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetEvent resets all changes to the "event" edge.
-func (m *EventOccurrenceMutation) ResetEvent() {
-	m.event = nil
-	m.clearedevent = false
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventMutation) ClearField(name string) error {
+	switch name {
+	case event.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case event.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	case event.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case event.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
+	case event.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case event.FieldSubtitle:
+		m.ClearSubtitle()
+		return nil
+	case event.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case event.FieldPlaceID:
+		m.ClearPlaceID()
+		return nil
+	case event.FieldEndDate:
+		m.ClearEndDate()
+		return nil
+	case event.FieldCoverImageURL:
+		m.ClearCoverImageURL()
+		return nil
+	case event.FieldImages:
+		m.ClearImages()
+		return nil
+	case event.FieldTags:
+		m.ClearTags()
+		return nil
+	case event.FieldLatitude:
+		m.ClearLatitude()
+		return nil
+	case event.FieldLongitude:
+		m.ClearLongitude()
+		return nil
+	case event.FieldLocationName:
+		m.ClearLocationName()
+		return nil
+	}
+	return fmt.Errorf("unknown Event nullable field %s", name)
 }
 
-// Where appends a list predicates to the EventOccurrenceMutation builder.
-func (m *EventOccurrenceMutation) Where(ps ...predicate.EventOccurrence) {
-	m.predicates = append(m.predicates, ps...)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EventMutation) ResetField(name string) error {
+	switch name {
+	case event.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case event.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case event.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case event.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case event.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case event.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case event.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
+	case event.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case event.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case event.FieldType:
+		m.ResetType()
+		return nil
+	case event.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case event.FieldSubtitle:
+		m.ResetSubtitle()
+		return nil
+	case event.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case event.FieldPlaceID:
+		m.ResetPlaceID()
+		return nil
+	case event.FieldStartDate:
+		m.ResetStartDate()
+		return nil
+	case event.FieldEndDate:
+		m.ResetEndDate()
+		return nil
+	case event.FieldCoverImageURL:
+		m.ResetCoverImageURL()
+		return nil
+	case event.FieldImages:
+		m.ResetImages()
+		return nil
+	case event.FieldTags:
+		m.ResetTags()
+		return nil
+	case event.FieldLatitude:
+		m.ResetLatitude()
+		return nil
+	case event.FieldLongitude:
+		m.ResetLongitude()
+		return nil
+	case event.FieldLocationName:
+		m.ResetLocationName()
+		return nil
+	case event.FieldViewCount:
+		m.ResetViewCount()
+		return nil
+	case event.FieldInterestedCount:
+		m.ResetInterestedCount()
+		return nil
+	}
+	return fmt.Errorf("unknown Event field %s", name)
 }
 
-// WhereP appends storage-level predicates to the EventOccurrenceMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EventOccurrenceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.EventOccurrence, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.occurrences != nil {
+		edges = append(edges, event.EdgeOccurrences)
 	}
-	m.Where(p...)
+	return edges
 }
 
-// Op returns the operation name.
-func (m *EventOccurrenceMutation) Op() Op {
-	return m.op
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case event.EdgeOccurrences:
+		ids := make([]ent.Value, 0, len(m.occurrences))
+		for id := range m.occurrences {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *EventOccurrenceMutation) SetOp(op Op) {
-	m.op = op
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedoccurrences != nil {
+		edges = append(edges, event.EdgeOccurrences)
+	}
+	return edges
 }
 
-// Type returns the node type of this mutation (EventOccurrence).
-func (m *EventOccurrenceMutation) Type() string {
-	return m.typ
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EventMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case event.EdgeOccurrences:
+		ids := make([]ent.Value, 0, len(m.removedoccurrences))
+		for id := range m.removedoccurrences {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *EventOccurrenceMutation) Fields() []string {
-	fields := make([]string, 0, 15)
-	if m.status != nil {
-		fields = append(fields, eventoccurrence.FieldStatus)
-	}
-	if m.created_at != nil {
-		fields = append(fields, eventoccurrence.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, eventoccurrence.FieldUpdatedAt)
-	}
-	if m.created_by != nil {
-		fields = append(fields, eventoccurrence.FieldCreatedBy)
-	}
-	if m.updated_by != nil {
-		fields = append(fields, eventoccurrence.FieldUpdatedBy)
-	}
-	if m.metadata != nil {
-		fields = append(fields, eventoccurrence.FieldMetadata)
-	}
-	if m.event != nil {
-		fields = append(fields, eventoccurrence.FieldEventID)
-	}
-	if m.recurrence_type != nil {
-		fields = append(fields, eventoccurrence.FieldRecurrenceType)
-	}
-	if m.start_time != nil {
-		fields = append(fields, eventoccurrence.FieldStartTime)
-	}
-	if m.end_time != nil {
-		fields = append(fields, eventoccurrence.FieldEndTime)
-	}
-	if m.duration_minutes != nil {
-		fields = append(fields, eventoccurrence.FieldDurationMinutes)
-	}
-	if m.day_of_week != nil {
-		fields = append(fields, eventoccurrence.FieldDayOfWeek)
-	}
-	if m.day_of_month != nil {
-		fields = append(fields, eventoccurrence.FieldDayOfMonth)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedoccurrences {
+		edges = append(edges, event.EdgeOccurrences)
 	}
-	if m.month_of_year != nil {
-		fields = append(fields, eventoccurrence.FieldMonthOfYear)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case event.EdgeOccurrences:
+		return m.clearedoccurrences
 	}
-	if m.exception_dates != nil {
-		fields = append(fields, eventoccurrence.FieldExceptionDates)
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EventMutation) ClearEdge(name string) error {
+	switch name {
 	}
-	return fields
+	return fmt.Errorf("unknown Event unique edge %s", name)
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *EventOccurrenceMutation) Field(name string) (ent.Value, bool) {
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EventMutation) ResetEdge(name string) error {
 	switch name {
-	case eventoccurrence.FieldStatus:
-		return m.Status()
-	case eventoccurrence.FieldCreatedAt:
-		return m.CreatedAt()
-	case eventoccurrence.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case eventoccurrence.FieldCreatedBy:
-		return m.CreatedBy()
-	case eventoccurrence.FieldUpdatedBy:
-		return m.UpdatedBy()
-	case eventoccurrence.FieldMetadata:
-		return m.Metadata()
-	case eventoccurrence.FieldEventID:
-		return m.EventID()
-	case eventoccurrence.FieldRecurrenceType:
-		return m.RecurrenceType()
-	case eventoccurrence.FieldStartTime:
-		return m.StartTime()
-	case eventoccurrence.FieldEndTime:
-		return m.EndTime()
-	case eventoccurrence.FieldDurationMinutes:
-		return m.DurationMinutes()
-	case eventoccurrence.FieldDayOfWeek:
-		return m.DayOfWeek()
-	case eventoccurrence.FieldDayOfMonth:
-		return m.DayOfMonth()
-	case eventoccurrence.FieldMonthOfYear:
-		return m.MonthOfYear()
-	case eventoccurrence.FieldExceptionDates:
-		return m.ExceptionDates()
+	case event.EdgeOccurrences:
+		m.ResetOccurrences()
+		return nil
 	}
-	return nil, false
+	return fmt.Errorf("unknown Event edge %s", name)
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *EventOccurrenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case eventoccurrence.FieldStatus:
-		return m.OldStatus(ctx)
-	case eventoccurrence.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case eventoccurrence.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case eventoccurrence.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case eventoccurrence.FieldUpdatedBy:
-		return m.OldUpdatedBy(ctx)
-	case eventoccurrence.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case eventoccurrence.FieldEventID:
-		return m.OldEventID(ctx)
-	case eventoccurrence.FieldRecurrenceType:
-		return m.OldRecurrenceType(ctx)
-	case eventoccurrence.FieldStartTime:
-		return m.OldStartTime(ctx)
-	case eventoccurrence.FieldEndTime:
-		return m.OldEndTime(ctx)
-	case eventoccurrence.FieldDurationMinutes:
-		return m.OldDurationMinutes(ctx)
-	case eventoccurrence.FieldDayOfWeek:
-		return m.OldDayOfWeek(ctx)
-	case eventoccurrence.FieldDayOfMonth:
-		return m.OldDayOfMonth(ctx)
-	case eventoccurrence.FieldMonthOfYear:
-		return m.OldMonthOfYear(ctx)
-	case eventoccurrence.FieldExceptionDates:
-		return m.OldExceptionDates(ctx)
+// EventOccurrenceMutation represents an operation that mutates the EventOccurrence nodes in the graph.
+type EventOccurrenceMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *string
+	status                *types.Status
+	created_at            *time.Time
+	updated_at            *time.Time
+	created_by            *string
+	updated_by            *string
+	deleted_at            *time.Time
+	deleted_by            *string
+	metadata              *map[string]string
+	recurrence_type       *string
+	start_time            *time.Time
+	end_time              *time.Time
+	duration_minutes      *int
+	addduration_minutes   *int
+	day_of_week           *int
+	addday_of_week        *int
+	day_of_month          *int
+	addday_of_month       *int
+	month_of_year         *int
+	addmonth_of_year      *int
+	exception_dates       *[]string
+	appendexception_dates []string
+	clearedFields         map[string]struct{}
+	event                 *string
+	clearedevent          bool
+	done                  bool
+	oldValue              func(context.Context) (*EventOccurrence, error)
+	predicates            []predicate.EventOccurrence
+}
+
+var _ ent.Mutation = (*EventOccurrenceMutation)(nil)
+
+// eventoccurrenceOption allows management of the mutation configuration using functional options.
+type eventoccurrenceOption func(*EventOccurrenceMutation)
+
+// newEventOccurrenceMutation creates new mutation for the EventOccurrence entity.
+func newEventOccurrenceMutation(c config, op Op, opts ...eventoccurrenceOption) *EventOccurrenceMutation {
+	m := &EventOccurrenceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEventOccurrence,
+		clearedFields: make(map[string]struct{}),
 	}
-	return nil, fmt.Errorf("unknown EventOccurrence field %s", name)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EventOccurrenceMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case eventoccurrence.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case eventoccurrence.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case eventoccurrence.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case eventoccurrence.FieldCreatedBy:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedBy(v)
-		return nil
-	case eventoccurrence.FieldUpdatedBy:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedBy(v)
-		return nil
-	case eventoccurrence.FieldMetadata:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMetadata(v)
-		return nil
-	case eventoccurrence.FieldEventID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEventID(v)
-		return nil
-	case eventoccurrence.FieldRecurrenceType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRecurrenceType(v)
-		return nil
-	case eventoccurrence.FieldStartTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartTime(v)
-		return nil
-	case eventoccurrence.FieldEndTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEndTime(v)
-		return nil
-	case eventoccurrence.FieldDurationMinutes:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDurationMinutes(v)
-		return nil
-	case eventoccurrence.FieldDayOfWeek:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDayOfWeek(v)
-		return nil
-	case eventoccurrence.FieldDayOfMonth:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDayOfMonth(v)
-		return nil
-	case eventoccurrence.FieldMonthOfYear:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+// withEventOccurrenceID sets the ID field of the mutation.
+func withEventOccurrenceID(id string) eventoccurrenceOption {
+	return func(m *EventOccurrenceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EventOccurrence
+		)
+		m.oldValue = func(ctx context.Context) (*EventOccurrence, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EventOccurrence.Get(ctx, id)
+				}
+			})
+			return value, err
 		}
-		m.SetMonthOfYear(v)
-		return nil
-	case eventoccurrence.FieldExceptionDates:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		m.id = &id
+	}
+}
+
+// withEventOccurrence sets the old EventOccurrence of the mutation.
+func withEventOccurrence(node *EventOccurrence) eventoccurrenceOption {
+	return func(m *EventOccurrenceMutation) {
+		m.oldValue = func(context.Context) (*EventOccurrence, error) {
+			return node, nil
 		}
-		m.SetExceptionDates(v)
-		return nil
+		m.id = &node.ID
 	}
-	return fmt.Errorf("unknown EventOccurrence field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *EventOccurrenceMutation) AddedFields() []string {
-	var fields []string
-	if m.addduration_minutes != nil {
-		fields = append(fields, eventoccurrence.FieldDurationMinutes)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EventOccurrenceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EventOccurrenceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	if m.addday_of_week != nil {
-		fields = append(fields, eventoccurrence.FieldDayOfWeek)
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EventOccurrence entities.
+func (m *EventOccurrenceMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EventOccurrenceMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
 	}
-	if m.addday_of_month != nil {
-		fields = append(fields, eventoccurrence.FieldDayOfMonth)
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EventOccurrenceMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EventOccurrence.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	if m.addmonth_of_year != nil {
-		fields = append(fields, eventoccurrence.FieldMonthOfYear)
+}
+
+// SetStatus sets the "status" field.
+func (m *EventOccurrenceMutation) SetStatus(t types.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *EventOccurrenceMutation) Status() (r types.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *EventOccurrenceMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case eventoccurrence.FieldDurationMinutes:
-		return m.AddedDurationMinutes()
-	case eventoccurrence.FieldDayOfWeek:
-		return m.AddedDayOfWeek()
-	case eventoccurrence.FieldDayOfMonth:
-		return m.AddedDayOfMonth()
-	case eventoccurrence.FieldMonthOfYear:
-		return m.AddedMonthOfYear()
+// OldStatus returns the old "status" field's value of the EventOccurrence entity.
+// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventOccurrenceMutation) OldStatus(ctx context.Context) (v types.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EventOccurrenceMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case eventoccurrence.FieldDurationMinutes:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDurationMinutes(v)
-		return nil
-	case eventoccurrence.FieldDayOfWeek:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDayOfWeek(v)
-		return nil
-	case eventoccurrence.FieldDayOfMonth:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDayOfMonth(v)
-		return nil
-	case eventoccurrence.FieldMonthOfYear:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMonthOfYear(v)
-		return nil
+// ResetStatus resets all changes to the "status" field.
+func (m *EventOccurrenceMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EventOccurrenceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EventOccurrenceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown EventOccurrence numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *EventOccurrenceMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(eventoccurrence.FieldCreatedBy) {
-		fields = append(fields, eventoccurrence.FieldCreatedBy)
+// OldCreatedAt returns the old "created_at" field's value of the EventOccurrence entity.
+// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventOccurrenceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(eventoccurrence.FieldUpdatedBy) {
-		fields = append(fields, eventoccurrence.FieldUpdatedBy)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	if m.FieldCleared(eventoccurrence.FieldMetadata) {
-		fields = append(fields, eventoccurrence.FieldMetadata)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	if m.FieldCleared(eventoccurrence.FieldStartTime) {
-		fields = append(fields, eventoccurrence.FieldStartTime)
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EventOccurrenceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EventOccurrenceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EventOccurrenceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(eventoccurrence.FieldEndTime) {
-		fields = append(fields, eventoccurrence.FieldEndTime)
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the EventOccurrence entity.
+// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventOccurrenceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(eventoccurrence.FieldDurationMinutes) {
-		fields = append(fields, eventoccurrence.FieldDurationMinutes)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	if m.FieldCleared(eventoccurrence.FieldDayOfWeek) {
-		fields = append(fields, eventoccurrence.FieldDayOfWeek)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	if m.FieldCleared(eventoccurrence.FieldDayOfMonth) {
-		fields = append(fields, eventoccurrence.FieldDayOfMonth)
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EventOccurrenceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *EventOccurrenceMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *EventOccurrenceMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(eventoccurrence.FieldMonthOfYear) {
-		fields = append(fields, eventoccurrence.FieldMonthOfYear)
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the EventOccurrence entity.
+// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventOccurrenceMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(eventoccurrence.FieldExceptionDates) {
-		fields = append(fields, eventoccurrence.FieldExceptionDates)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *EventOccurrenceMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *EventOccurrenceMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[eventoccurrence.FieldCreatedBy] = struct{}{}
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *EventOccurrenceMutation) ClearField(name string) error {
-	switch name {
-	case eventoccurrence.FieldCreatedBy:
-		m.ClearCreatedBy()
-		return nil
-	case eventoccurrence.FieldUpdatedBy:
-		m.ClearUpdatedBy()
-		return nil
-	case eventoccurrence.FieldMetadata:
-		m.ClearMetadata()
-		return nil
-	case eventoccurrence.FieldStartTime:
-		m.ClearStartTime()
-		return nil
-	case eventoccurrence.FieldEndTime:
-		m.ClearEndTime()
-		return nil
-	case eventoccurrence.FieldDurationMinutes:
-		m.ClearDurationMinutes()
-		return nil
-	case eventoccurrence.FieldDayOfWeek:
-		m.ClearDayOfWeek()
-		return nil
-	case eventoccurrence.FieldDayOfMonth:
-		m.ClearDayOfMonth()
-		return nil
-	case eventoccurrence.FieldMonthOfYear:
-		m.ClearMonthOfYear()
-		return nil
-	case eventoccurrence.FieldExceptionDates:
-		m.ClearExceptionDates()
-		return nil
-	}
-	return fmt.Errorf("unknown EventOccurrence nullable field %s", name)
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *EventOccurrenceMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[eventoccurrence.FieldCreatedBy]
+	return ok
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *EventOccurrenceMutation) ResetField(name string) error {
-	switch name {
-	case eventoccurrence.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case eventoccurrence.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case eventoccurrence.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case eventoccurrence.FieldCreatedBy:
-		m.ResetCreatedBy()
-		return nil
-	case eventoccurrence.FieldUpdatedBy:
-		m.ResetUpdatedBy()
-		return nil
-	case eventoccurrence.FieldMetadata:
-		m.ResetMetadata()
-		return nil
-	case eventoccurrence.FieldEventID:
-		m.ResetEventID()
-		return nil
-	case eventoccurrence.FieldRecurrenceType:
-		m.ResetRecurrenceType()
-		return nil
-	case eventoccurrence.FieldStartTime:
-		m.ResetStartTime()
-		return nil
-	case eventoccurrence.FieldEndTime:
-		m.ResetEndTime()
-		return nil
-	case eventoccurrence.FieldDurationMinutes:
-		m.ResetDurationMinutes()
-		return nil
-	case eventoccurrence.FieldDayOfWeek:
-		m.ResetDayOfWeek()
-		return nil
-	case eventoccurrence.FieldDayOfMonth:
-		m.ResetDayOfMonth()
-		return nil
-	case eventoccurrence.FieldMonthOfYear:
-		m.ResetMonthOfYear()
-		return nil
-	case eventoccurrence.FieldExceptionDates:
-		m.ResetExceptionDates()
-		return nil
-	}
-	return fmt.Errorf("unknown EventOccurrence field %s", name)
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *EventOccurrenceMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, eventoccurrence.FieldCreatedBy)
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EventOccurrenceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.event != nil {
-		edges = append(edges, eventoccurrence.EdgeEvent)
+// SetUpdatedBy sets the "updated_by" field.
+func (m *EventOccurrenceMutation) SetUpdatedBy(s string) {
+	m.updated_by = &s
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *EventOccurrenceMutation) UpdatedBy() (r string, exists bool) {
+	v := m.updated_by
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *EventOccurrenceMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case eventoccurrence.EdgeEvent:
-		if id := m.event; id != nil {
-			return []ent.Value{*id}
-		}
+// OldUpdatedBy returns the old "updated_by" field's value of the EventOccurrence entity.
+// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventOccurrenceMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	}
+	return oldValue.UpdatedBy, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EventOccurrenceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *EventOccurrenceMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.clearedFields[eventoccurrence.FieldUpdatedBy] = struct{}{}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *EventOccurrenceMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *EventOccurrenceMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[eventoccurrence.FieldUpdatedBy]
+	return ok
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EventOccurrenceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedevent {
-		edges = append(edges, eventoccurrence.EdgeEvent)
-	}
-	return edges
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *EventOccurrenceMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	delete(m.clearedFields, eventoccurrence.FieldUpdatedBy)
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *EventOccurrenceMutation) EdgeCleared(name string) bool {
-	switch name {
-	case eventoccurrence.EdgeEvent:
-		return m.clearedevent
-	}
-	return false
+// SetDeletedAt sets the "deleted_at" field.
+func (m *EventOccurrenceMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *EventOccurrenceMutation) ClearEdge(name string) error {
-	switch name {
-	case eventoccurrence.EdgeEvent:
-		m.ClearEvent()
-		return nil
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *EventOccurrenceMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown EventOccurrence unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *EventOccurrenceMutation) ResetEdge(name string) error {
-	switch name {
-	case eventoccurrence.EdgeEvent:
-		m.ResetEvent()
-		return nil
+// OldDeletedAt returns the old "deleted_at" field's value of the EventOccurrence entity.
+// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventOccurrenceMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown EventOccurrence edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
 }
 
-// HotelMutation represents an operation that mutates the Hotel nodes in the graph.
-type HotelMutation struct {
-	config
-	op                Op
-	typ               string
-	id                *string
-	status            *string
-	created_at        *time.Time
-	updated_at        *time.Time
-	created_by        *string
-	updated_by        *string
-	metadata          *map[string]string
-	slug              *string
-	name              *string
-	description       *string
-	star_rating       *int
-	addstar_rating    *int
-	room_count        *int
-	addroom_count     *int
-	check_in_time     *time.Time
-	check_out_time    *time.Time
-	address           *map[string]string
-	latitude          *decimal.Decimal
-	longitude         *decimal.Decimal
-	phone             *string
-	email             *string
-	website           *string
-	primary_image_url *string
-	thumbnail_url     *string
-	price_min         *decimal.Decimal
-	price_max         *decimal.Decimal
-	currency          *string
-	view_count        *int
-	addview_count     *int
-	rating_avg        *decimal.Decimal
-	rating_count      *int
-	addrating_count   *int
-	last_viewed_at    *time.Time
-	popularity_score  *decimal.Decimal
-	clearedFields     map[string]struct{}
-	done              bool
-	oldValue          func(context.Context) (*Hotel, error)
-	predicates        []predicate.Hotel
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *EventOccurrenceMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[eventoccurrence.FieldDeletedAt] = struct{}{}
 }
 
-var _ ent.Mutation = (*HotelMutation)(nil)
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *EventOccurrenceMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[eventoccurrence.FieldDeletedAt]
+	return ok
+}
 
-// hotelOption allows management of the mutation configuration using functional options.
-type hotelOption func(*HotelMutation)
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *EventOccurrenceMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, eventoccurrence.FieldDeletedAt)
+}
 
-// newHotelMutation creates new mutation for the Hotel entity.
-func newHotelMutation(c config, op Op, opts ...hotelOption) *HotelMutation {
-	m := &HotelMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeHotel,
-		clearedFields: make(map[string]struct{}),
+// SetDeletedBy sets the "deleted_by" field.
+func (m *EventOccurrenceMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *EventOccurrenceMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
+	if v == nil {
+		return
 	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+	return *v, true
 }
 
-// withHotelID sets the ID field of the mutation.
-func withHotelID(id string) hotelOption {
-	return func(m *HotelMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Hotel
-		)
-		m.oldValue = func(ctx context.Context) (*Hotel, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Hotel.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldDeletedBy returns the old "deleted_by" field's value of the EventOccurrence entity.
+// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventOccurrenceMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
 	}
-}
-
-// withHotel sets the old Hotel of the mutation.
-func withHotel(node *Hotel) hotelOption {
-	return func(m *HotelMutation) {
-		m.oldValue = func(context.Context) (*Hotel, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
 	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m HotelMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m HotelMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return oldValue.DeletedBy, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Hotel entities.
-func (m *HotelMutation) SetID(id string) {
-	m.id = &id
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *EventOccurrenceMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[eventoccurrence.FieldDeletedBy] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *HotelMutation) ID() (id string, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *EventOccurrenceMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[eventoccurrence.FieldDeletedBy]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *HotelMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Hotel.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *EventOccurrenceMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, eventoccurrence.FieldDeletedBy)
 }
 
-// SetStatus sets the "status" field.
-func (m *HotelMutation) SetStatus(s string) {
-	m.status = &s
+// SetMetadata sets the "metadata" field.
+func (m *EventOccurrenceMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *HotelMutation) Status() (r string, exists bool) {
-	v := m.status
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *EventOccurrenceMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// OldMetadata returns the old "metadata" field's value of the EventOccurrence entity.
+// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *EventOccurrenceMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Metadata, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *HotelMutation) ResetStatus() {
-	m.status = nil
+// ClearMetadata clears the value of the "metadata" field.
+func (m *EventOccurrenceMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[eventoccurrence.FieldMetadata] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *HotelMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *EventOccurrenceMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[eventoccurrence.FieldMetadata]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *HotelMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *EventOccurrenceMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, eventoccurrence.FieldMetadata)
+}
+
+// SetEventID sets the "event_id" field.
+func (m *EventOccurrenceMutation) SetEventID(s string) {
+	m.event = &s
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *EventOccurrenceMutation) EventID() (r string, exists bool) {
+	v := m.event
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// OldEventID returns the old "event_id" field's value of the EventOccurrence entity.
+// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EventOccurrenceMutation) OldEventID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEventID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.EventID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *HotelMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetEventID resets all changes to the "event_id" field.
+func (m *EventOccurrenceMutation) ResetEventID() {
+	m.event = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *HotelMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetRecurrenceType sets the "recurrence_type" field.
+func (m *EventOccurrenceMutation) SetRecurrenceType(s string) {
+	m.recurrence_type = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *HotelMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// RecurrenceType returns the value of the "recurrence_type" field in the mutation.
+func (m *EventOccurrenceMutation) RecurrenceType() (r string, exists bool) {
+	v := m.recurrence_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// OldRecurrenceType returns the old "recurrence_type" field's value of the EventOccurrence entity.
+// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EventOccurrenceMutation) OldRecurrenceType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRecurrenceType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRecurrenceType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRecurrenceType: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.RecurrenceType, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *HotelMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetRecurrenceType resets all changes to the "recurrence_type" field.
+func (m *EventOccurrenceMutation) ResetRecurrenceType() {
+	m.recurrence_type = nil
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *HotelMutation) SetCreatedBy(s string) {
-	m.created_by = &s
+// SetStartTime sets the "start_time" field.
+func (m *EventOccurrenceMutation) SetStartTime(t time.Time) {
+	m.start_time = &t
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *HotelMutation) CreatedBy() (r string, exists bool) {
-	v := m.created_by
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *EventOccurrenceMutation) StartTime() (r time.Time, exists bool) {
+	v := m.start_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// OldStartTime returns the old "start_time" field's value of the EventOccurrence entity.
+// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+func (m *EventOccurrenceMutation) OldStartTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
 	}
-	return oldValue.CreatedBy, nil
+	return oldValue.StartTime, nil
 }
 
-// ClearCreatedBy clears the value of the "created_by" field.
-func (m *HotelMutation) ClearCreatedBy() {
-	m.created_by = nil
-	m.clearedFields[hotel.FieldCreatedBy] = struct{}{}
+// ClearStartTime clears the value of the "start_time" field.
+func (m *EventOccurrenceMutation) ClearStartTime() {
+	m.start_time = nil
+	m.clearedFields[eventoccurrence.FieldStartTime] = struct{}{}
 }
 
-// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *HotelMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[hotel.FieldCreatedBy]
+// StartTimeCleared returns if the "start_time" field was cleared in this mutation.
+func (m *EventOccurrenceMutation) StartTimeCleared() bool {
+	_, ok := m.clearedFields[eventoccurrence.FieldStartTime]
 	return ok
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *HotelMutation) ResetCreatedBy() {
-	m.created_by = nil
-	delete(m.clearedFields, hotel.FieldCreatedBy)
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *EventOccurrenceMutation) ResetStartTime() {
+	m.start_time = nil
+	delete(m.clearedFields, eventoccurrence.FieldStartTime)
 }
 
-// SetUpdatedBy sets the "updated_by" field.
-func (m *HotelMutation) SetUpdatedBy(s string) {
-	m.updated_by = &s
+// SetEndTime sets the "end_time" field.
+func (m *EventOccurrenceMutation) SetEndTime(t time.Time) {
+	m.end_time = &t
 }
 
-// UpdatedBy returns the value of the "updated_by" field in the mutation.
-func (m *HotelMutation) UpdatedBy() (r string, exists bool) {
-	v := m.updated_by
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *EventOccurrenceMutation) EndTime() (r time.Time, exists bool) {
+	v := m.end_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedBy returns the old "updated_by" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// OldEndTime returns the old "end_time" field's value of the EventOccurrence entity.
+// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
+func (m *EventOccurrenceMutation) OldEndTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
 	}
-	return oldValue.UpdatedBy, nil
+	return oldValue.EndTime, nil
 }
 
-// ClearUpdatedBy clears the value of the "updated_by" field.
-func (m *HotelMutation) ClearUpdatedBy() {
-	m.updated_by = nil
-	m.clearedFields[hotel.FieldUpdatedBy] = struct{}{}
+// ClearEndTime clears the value of the "end_time" field.
+func (m *EventOccurrenceMutation) ClearEndTime() {
+	m.end_time = nil
+	m.clearedFields[eventoccurrence.FieldEndTime] = struct{}{}
 }
 
-// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
-func (m *HotelMutation) UpdatedByCleared() bool {
-	_, ok := m.clearedFields[hotel.FieldUpdatedBy]
+// EndTimeCleared returns if the "end_time" field was cleared in this mutation.
+func (m *EventOccurrenceMutation) EndTimeCleared() bool {
+	_, ok := m.clearedFields[eventoccurrence.FieldEndTime]
 	return ok
 }
 
-// ResetUpdatedBy resets all changes to the "updated_by" field.
-func (m *HotelMutation) ResetUpdatedBy() {
-	m.updated_by = nil
-	delete(m.clearedFields, hotel.FieldUpdatedBy)
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *EventOccurrenceMutation) ResetEndTime() {
+	m.end_time = nil
+	delete(m.clearedFields, eventoccurrence.FieldEndTime)
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *HotelMutation) SetMetadata(value map[string]string) {
-	m.metadata = &value
+// SetDurationMinutes sets the "duration_minutes" field.
+func (m *EventOccurrenceMutation) SetDurationMinutes(i int) {
+	m.duration_minutes = &i
+	m.addduration_minutes = nil
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *HotelMutation) Metadata() (r map[string]string, exists bool) {
-	v := m.metadata
+// DurationMinutes returns the value of the "duration_minutes" field in the mutation.
+func (m *EventOccurrenceMutation) DurationMinutes() (r int, exists bool) {
+	v := m.duration_minutes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// OldDurationMinutes returns the old "duration_minutes" field's value of the EventOccurrence entity.
+// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+func (m *EventOccurrenceMutation) OldDurationMinutes(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldDurationMinutes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldDurationMinutes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldDurationMinutes: %w", err)
 	}
-	return oldValue.Metadata, nil
-}
-
-// ClearMetadata clears the value of the "metadata" field.
-func (m *HotelMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[hotel.FieldMetadata] = struct{}{}
-}
-
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *HotelMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[hotel.FieldMetadata]
-	return ok
-}
-
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *HotelMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, hotel.FieldMetadata)
+	return oldValue.DurationMinutes, nil
 }
 
-// SetSlug sets the "slug" field.
-func (m *HotelMutation) SetSlug(s string) {
-	m.slug = &s
+// AddDurationMinutes adds i to the "duration_minutes" field.
+func (m *EventOccurrenceMutation) AddDurationMinutes(i int) {
+	if m.addduration_minutes != nil {
+		*m.addduration_minutes += i
+	} else {
+		m.addduration_minutes = &i
+	}
 }
 
-// Slug returns the value of the "slug" field in the mutation.
-func (m *HotelMutation) Slug() (r string, exists bool) {
-	v := m.slug
+// AddedDurationMinutes returns the value that was added to the "duration_minutes" field in this mutation.
+func (m *EventOccurrenceMutation) AddedDurationMinutes() (r int, exists bool) {
+	v := m.addduration_minutes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlug returns the old "slug" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldSlug(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlug requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
-	}
-	return oldValue.Slug, nil
+// ClearDurationMinutes clears the value of the "duration_minutes" field.
+func (m *EventOccurrenceMutation) ClearDurationMinutes() {
+	m.duration_minutes = nil
+	m.addduration_minutes = nil
+	m.clearedFields[eventoccurrence.FieldDurationMinutes] = struct{}{}
 }
 
-// ResetSlug resets all changes to the "slug" field.
-func (m *HotelMutation) ResetSlug() {
-	m.slug = nil
+// DurationMinutesCleared returns if the "duration_minutes" field was cleared in this mutation.
+func (m *EventOccurrenceMutation) DurationMinutesCleared() bool {
+	_, ok := m.clearedFields[eventoccurrence.FieldDurationMinutes]
+	return ok
 }
 
-// SetName sets the "name" field.
-func (m *HotelMutation) SetName(s string) {
-	m.name = &s
+// ResetDurationMinutes resets all changes to the "duration_minutes" field.
+func (m *EventOccurrenceMutation) ResetDurationMinutes() {
+	m.duration_minutes = nil
+	m.addduration_minutes = nil
+	delete(m.clearedFields, eventoccurrence.FieldDurationMinutes)
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *HotelMutation) Name() (r string, exists bool) {
-	v := m.name
+// SetDayOfWeek sets the "day_of_week" field.
+func (m *EventOccurrenceMutation) SetDayOfWeek(i int) {
+	m.day_of_week = &i
+	m.addday_of_week = nil
+}
+
+// DayOfWeek returns the value of the "day_of_week" field in the mutation.
+func (m *EventOccurrenceMutation) DayOfWeek() (r int, exists bool) {
+	v := m.day_of_week
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// OldDayOfWeek returns the old "day_of_week" field's value of the EventOccurrence entity.
+// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *EventOccurrenceMutation) OldDayOfWeek(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldDayOfWeek is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldDayOfWeek requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldDayOfWeek: %w", err)
 	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *HotelMutation) ResetName() {
-	m.name = nil
+	return oldValue.DayOfWeek, nil
 }
 
-// SetDescription sets the "description" field.
-func (m *HotelMutation) SetDescription(s string) {
-	m.description = &s
+// AddDayOfWeek adds i to the "day_of_week" field.
+func (m *EventOccurrenceMutation) AddDayOfWeek(i int) {
+	if m.addday_of_week != nil {
+		*m.addday_of_week += i
+	} else {
+		m.addday_of_week = &i
+	}
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *HotelMutation) Description() (r string, exists bool) {
-	v := m.description
+// AddedDayOfWeek returns the value that was added to the "day_of_week" field in this mutation.
+func (m *EventOccurrenceMutation) AddedDayOfWeek() (r int, exists bool) {
+	v := m.addday_of_week
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
-	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *HotelMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[hotel.FieldDescription] = struct{}{}
+// ClearDayOfWeek clears the value of the "day_of_week" field.
+func (m *EventOccurrenceMutation) ClearDayOfWeek() {
+	m.day_of_week = nil
+	m.addday_of_week = nil
+	m.clearedFields[eventoccurrence.FieldDayOfWeek] = struct{}{}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *HotelMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[hotel.FieldDescription]
+// DayOfWeekCleared returns if the "day_of_week" field was cleared in this mutation.
+func (m *EventOccurrenceMutation) DayOfWeekCleared() bool {
+	_, ok := m.clearedFields[eventoccurrence.FieldDayOfWeek]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *HotelMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, hotel.FieldDescription)
+// ResetDayOfWeek resets all changes to the "day_of_week" field.
+func (m *EventOccurrenceMutation) ResetDayOfWeek() {
+	m.day_of_week = nil
+	m.addday_of_week = nil
+	delete(m.clearedFields, eventoccurrence.FieldDayOfWeek)
 }
 
-// SetStarRating sets the "star_rating" field.
-func (m *HotelMutation) SetStarRating(i int) {
-	m.star_rating = &i
-	m.addstar_rating = nil
+// SetDayOfMonth sets the "day_of_month" field.
+func (m *EventOccurrenceMutation) SetDayOfMonth(i int) {
+	m.day_of_month = &i
+	m.addday_of_month = nil
 }
 
-// StarRating returns the value of the "star_rating" field in the mutation.
-func (m *HotelMutation) StarRating() (r int, exists bool) {
-	v := m.star_rating
+// DayOfMonth returns the value of the "day_of_month" field in the mutation.
+func (m *EventOccurrenceMutation) DayOfMonth() (r int, exists bool) {
+	v := m.day_of_month
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStarRating returns the old "star_rating" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// OldDayOfMonth returns the old "day_of_month" field's value of the EventOccurrence entity.
+// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldStarRating(ctx context.Context) (v int, err error) {
+func (m *EventOccurrenceMutation) OldDayOfMonth(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStarRating is only allowed on UpdateOne operations")
+		return v, errors.New("OldDayOfMonth is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStarRating requires an ID field in the mutation")
+		return v, errors.New("OldDayOfMonth requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStarRating: %w", err)
+		return v, fmt.Errorf("querying old value for OldDayOfMonth: %w", err)
 	}
-	return oldValue.StarRating, nil
+	return oldValue.DayOfMonth, nil
 }
 
-// AddStarRating adds i to the "star_rating" field.
-func (m *HotelMutation) AddStarRating(i int) {
-	if m.addstar_rating != nil {
-		*m.addstar_rating += i
+// AddDayOfMonth adds i to the "day_of_month" field.
+func (m *EventOccurrenceMutation) AddDayOfMonth(i int) {
+	if m.addday_of_month != nil {
+		*m.addday_of_month += i
 	} else {
-		m.addstar_rating = &i
+		m.addday_of_month = &i
 	}
 }
 
-// AddedStarRating returns the value that was added to the "star_rating" field in this mutation.
-func (m *HotelMutation) AddedStarRating() (r int, exists bool) {
-	v := m.addstar_rating
+// AddedDayOfMonth returns the value that was added to the "day_of_month" field in this mutation.
+func (m *EventOccurrenceMutation) AddedDayOfMonth() (r int, exists bool) {
+	v := m.addday_of_month
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetStarRating resets all changes to the "star_rating" field.
-func (m *HotelMutation) ResetStarRating() {
-	m.star_rating = nil
-	m.addstar_rating = nil
+// ClearDayOfMonth clears the value of the "day_of_month" field.
+func (m *EventOccurrenceMutation) ClearDayOfMonth() {
+	m.day_of_month = nil
+	m.addday_of_month = nil
+	m.clearedFields[eventoccurrence.FieldDayOfMonth] = struct{}{}
 }
 
-// SetRoomCount sets the "room_count" field.
-func (m *HotelMutation) SetRoomCount(i int) {
-	m.room_count = &i
-	m.addroom_count = nil
+// DayOfMonthCleared returns if the "day_of_month" field was cleared in this mutation.
+func (m *EventOccurrenceMutation) DayOfMonthCleared() bool {
+	_, ok := m.clearedFields[eventoccurrence.FieldDayOfMonth]
+	return ok
 }
 
-// RoomCount returns the value of the "room_count" field in the mutation.
-func (m *HotelMutation) RoomCount() (r int, exists bool) {
-	v := m.room_count
+// ResetDayOfMonth resets all changes to the "day_of_month" field.
+func (m *EventOccurrenceMutation) ResetDayOfMonth() {
+	m.day_of_month = nil
+	m.addday_of_month = nil
+	delete(m.clearedFields, eventoccurrence.FieldDayOfMonth)
+}
+
+// SetMonthOfYear sets the "month_of_year" field.
+func (m *EventOccurrenceMutation) SetMonthOfYear(i int) {
+	m.month_of_year = &i
+	m.addmonth_of_year = nil
+}
+
+// MonthOfYear returns the value of the "month_of_year" field in the mutation.
+func (m *EventOccurrenceMutation) MonthOfYear() (r int, exists bool) {
+	v := m.month_of_year
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRoomCount returns the old "room_count" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// OldMonthOfYear returns the old "month_of_year" field's value of the EventOccurrence entity.
+// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldRoomCount(ctx context.Context) (v int, err error) {
+func (m *EventOccurrenceMutation) OldMonthOfYear(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoomCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldMonthOfYear is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoomCount requires an ID field in the mutation")
+		return v, errors.New("OldMonthOfYear requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoomCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldMonthOfYear: %w", err)
 	}
-	return oldValue.RoomCount, nil
+	return oldValue.MonthOfYear, nil
 }
 
-// AddRoomCount adds i to the "room_count" field.
-func (m *HotelMutation) AddRoomCount(i int) {
-	if m.addroom_count != nil {
-		*m.addroom_count += i
+// AddMonthOfYear adds i to the "month_of_year" field.
+func (m *EventOccurrenceMutation) AddMonthOfYear(i int) {
+	if m.addmonth_of_year != nil {
+		*m.addmonth_of_year += i
 	} else {
-		m.addroom_count = &i
+		m.addmonth_of_year = &i
 	}
 }
 
-// AddedRoomCount returns the value that was added to the "room_count" field in this mutation.
-func (m *HotelMutation) AddedRoomCount() (r int, exists bool) {
-	v := m.addroom_count
+// AddedMonthOfYear returns the value that was added to the "month_of_year" field in this mutation.
+func (m *EventOccurrenceMutation) AddedMonthOfYear() (r int, exists bool) {
+	v := m.addmonth_of_year
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRoomCount resets all changes to the "room_count" field.
-func (m *HotelMutation) ResetRoomCount() {
-	m.room_count = nil
-	m.addroom_count = nil
+// ClearMonthOfYear clears the value of the "month_of_year" field.
+func (m *EventOccurrenceMutation) ClearMonthOfYear() {
+	m.month_of_year = nil
+	m.addmonth_of_year = nil
+	m.clearedFields[eventoccurrence.FieldMonthOfYear] = struct{}{}
 }
 
-// SetCheckInTime sets the "check_in_time" field.
-func (m *HotelMutation) SetCheckInTime(t time.Time) {
-	m.check_in_time = &t
+// MonthOfYearCleared returns if the "month_of_year" field was cleared in this mutation.
+func (m *EventOccurrenceMutation) MonthOfYearCleared() bool {
+	_, ok := m.clearedFields[eventoccurrence.FieldMonthOfYear]
+	return ok
 }
 
-// CheckInTime returns the value of the "check_in_time" field in the mutation.
-func (m *HotelMutation) CheckInTime() (r time.Time, exists bool) {
-	v := m.check_in_time
+// ResetMonthOfYear resets all changes to the "month_of_year" field.
+func (m *EventOccurrenceMutation) ResetMonthOfYear() {
+	m.month_of_year = nil
+	m.addmonth_of_year = nil
+	delete(m.clearedFields, eventoccurrence.FieldMonthOfYear)
+}
+
+// SetExceptionDates sets the "exception_dates" field.
+func (m *EventOccurrenceMutation) SetExceptionDates(s []string) {
+	m.exception_dates = &s
+	m.appendexception_dates = nil
+}
+
+// ExceptionDates returns the value of the "exception_dates" field in the mutation.
+func (m *EventOccurrenceMutation) ExceptionDates() (r []string, exists bool) {
+	v := m.exception_dates
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCheckInTime returns the old "check_in_time" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// OldExceptionDates returns the old "exception_dates" field's value of the EventOccurrence entity.
+// If the EventOccurrence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldCheckInTime(ctx context.Context) (v time.Time, err error) {
+func (m *EventOccurrenceMutation) OldExceptionDates(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCheckInTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldExceptionDates is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCheckInTime requires an ID field in the mutation")
+		return v, errors.New("OldExceptionDates requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCheckInTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldExceptionDates: %w", err)
 	}
-	return oldValue.CheckInTime, nil
+	return oldValue.ExceptionDates, nil
 }
 
-// ClearCheckInTime clears the value of the "check_in_time" field.
-func (m *HotelMutation) ClearCheckInTime() {
-	m.check_in_time = nil
-	m.clearedFields[hotel.FieldCheckInTime] = struct{}{}
+// AppendExceptionDates adds s to the "exception_dates" field.
+func (m *EventOccurrenceMutation) AppendExceptionDates(s []string) {
+	m.appendexception_dates = append(m.appendexception_dates, s...)
 }
 
-// CheckInTimeCleared returns if the "check_in_time" field was cleared in this mutation.
-func (m *HotelMutation) CheckInTimeCleared() bool {
-	_, ok := m.clearedFields[hotel.FieldCheckInTime]
-	return ok
+// AppendedExceptionDates returns the list of values that were appended to the "exception_dates" field in this mutation.
+func (m *EventOccurrenceMutation) AppendedExceptionDates() ([]string, bool) {
+	if len(m.appendexception_dates) == 0 {
+		return nil, false
+	}
+	return m.appendexception_dates, true
 }
 
-// ResetCheckInTime resets all changes to the "check_in_time" field.
-func (m *HotelMutation) ResetCheckInTime() {
-	m.check_in_time = nil
-	delete(m.clearedFields, hotel.FieldCheckInTime)
+// ClearExceptionDates clears the value of the "exception_dates" field.
+func (m *EventOccurrenceMutation) ClearExceptionDates() {
+	m.exception_dates = nil
+	m.appendexception_dates = nil
+	m.clearedFields[eventoccurrence.FieldExceptionDates] = struct{}{}
 }
 
-// SetCheckOutTime sets the "check_out_time" field.
-func (m *HotelMutation) SetCheckOutTime(t time.Time) {
-	m.check_out_time = &t
+// ExceptionDatesCleared returns if the "exception_dates" field was cleared in this mutation.
+func (m *EventOccurrenceMutation) ExceptionDatesCleared() bool {
+	_, ok := m.clearedFields[eventoccurrence.FieldExceptionDates]
+	return ok
 }
 
-// CheckOutTime returns the value of the "check_out_time" field in the mutation.
-func (m *HotelMutation) CheckOutTime() (r time.Time, exists bool) {
-	v := m.check_out_time
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetExceptionDates resets all changes to the "exception_dates" field.
+func (m *EventOccurrenceMutation) ResetExceptionDates() {
+	m.exception_dates = nil
+	m.appendexception_dates = nil
+	delete(m.clearedFields, eventoccurrence.FieldExceptionDates)
 }
 
-// OldCheckOutTime returns the old "check_out_time" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldCheckOutTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCheckOutTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCheckOutTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCheckOutTime: %w", err)
-	}
-	return oldValue.CheckOutTime, nil
+// ClearEvent clears the "event" edge to the Event entity.
+func (m *EventOccurrenceMutation) ClearEvent() {
+	m.clearedevent = true
+	m.clearedFields[eventoccurrence.FieldEventID] = struct{}{}
 }
 
-// ClearCheckOutTime clears the value of the "check_out_time" field.
-func (m *HotelMutation) ClearCheckOutTime() {
-	m.check_out_time = nil
-	m.clearedFields[hotel.FieldCheckOutTime] = struct{}{}
+// EventCleared reports if the "event" edge to the Event entity was cleared.
+func (m *EventOccurrenceMutation) EventCleared() bool {
+	return m.clearedevent
 }
 
-// CheckOutTimeCleared returns if the "check_out_time" field was cleared in this mutation.
-func (m *HotelMutation) CheckOutTimeCleared() bool {
-	_, ok := m.clearedFields[hotel.FieldCheckOutTime]
-	return ok
+// EventIDs returns the "event" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EventID instead. It exists only for internal usage by the builders.
+func (m *EventOccurrenceMutation) EventIDs() (ids []string) {
+	if id := m.event; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// ResetCheckOutTime resets all changes to the "check_out_time" field.
-func (m *HotelMutation) ResetCheckOutTime() {
-	m.check_out_time = nil
-	delete(m.clearedFields, hotel.FieldCheckOutTime)
+// ResetEvent resets all changes to the "event" edge.
+func (m *EventOccurrenceMutation) ResetEvent() {
+	m.event = nil
+	m.clearedevent = false
 }
 
-// SetAddress sets the "address" field.
-func (m *HotelMutation) SetAddress(value map[string]string) {
-	m.address = &value
+// Where appends a list predicates to the EventOccurrenceMutation builder.
+func (m *EventOccurrenceMutation) Where(ps ...predicate.EventOccurrence) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// Address returns the value of the "address" field in the mutation.
-func (m *HotelMutation) Address() (r map[string]string, exists bool) {
-	v := m.address
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the EventOccurrenceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EventOccurrenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EventOccurrence, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldAddress returns the old "address" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldAddress(ctx context.Context) (v map[string]string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAddress requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
-	}
-	return oldValue.Address, nil
+// Op returns the operation name.
+func (m *EventOccurrenceMutation) Op() Op {
+	return m.op
 }
 
-// ClearAddress clears the value of the "address" field.
-func (m *HotelMutation) ClearAddress() {
-	m.address = nil
-	m.clearedFields[hotel.FieldAddress] = struct{}{}
+// SetOp allows setting the mutation operation.
+func (m *EventOccurrenceMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// AddressCleared returns if the "address" field was cleared in this mutation.
-func (m *HotelMutation) AddressCleared() bool {
-	_, ok := m.clearedFields[hotel.FieldAddress]
-	return ok
-}
-
-// ResetAddress resets all changes to the "address" field.
-func (m *HotelMutation) ResetAddress() {
-	m.address = nil
-	delete(m.clearedFields, hotel.FieldAddress)
-}
-
-// SetLatitude sets the "latitude" field.
-func (m *HotelMutation) SetLatitude(d decimal.Decimal) {
-	m.latitude = &d
+// Type returns the node type of this mutation (EventOccurrence).
+func (m *EventOccurrenceMutation) Type() string {
+	return m.typ
 }
 
-// Latitude returns the value of the "latitude" field in the mutation.
-func (m *HotelMutation) Latitude() (r decimal.Decimal, exists bool) {
-	v := m.latitude
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EventOccurrenceMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.status != nil {
+		fields = append(fields, eventoccurrence.FieldStatus)
 	}
-	return *v, true
-}
-
-// OldLatitude returns the old "latitude" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldLatitude(ctx context.Context) (v decimal.Decimal, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLatitude is only allowed on UpdateOne operations")
+	if m.created_at != nil {
+		fields = append(fields, eventoccurrence.FieldCreatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLatitude requires an ID field in the mutation")
+	if m.updated_at != nil {
+		fields = append(fields, eventoccurrence.FieldUpdatedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLatitude: %w", err)
+	if m.created_by != nil {
+		fields = append(fields, eventoccurrence.FieldCreatedBy)
 	}
-	return oldValue.Latitude, nil
-}
-
-// ResetLatitude resets all changes to the "latitude" field.
-func (m *HotelMutation) ResetLatitude() {
-	m.latitude = nil
-}
-
-// SetLongitude sets the "longitude" field.
-func (m *HotelMutation) SetLongitude(d decimal.Decimal) {
-	m.longitude = &d
-}
-
-// Longitude returns the value of the "longitude" field in the mutation.
-func (m *HotelMutation) Longitude() (r decimal.Decimal, exists bool) {
-	v := m.longitude
-	if v == nil {
-		return
+	if m.updated_by != nil {
+		fields = append(fields, eventoccurrence.FieldUpdatedBy)
 	}
-	return *v, true
-}
-
-// OldLongitude returns the old "longitude" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldLongitude(ctx context.Context) (v decimal.Decimal, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLongitude is only allowed on UpdateOne operations")
+	if m.deleted_at != nil {
+		fields = append(fields, eventoccurrence.FieldDeletedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLongitude requires an ID field in the mutation")
+	if m.deleted_by != nil {
+		fields = append(fields, eventoccurrence.FieldDeletedBy)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLongitude: %w", err)
+	if m.metadata != nil {
+		fields = append(fields, eventoccurrence.FieldMetadata)
 	}
-	return oldValue.Longitude, nil
-}
-
-// ResetLongitude resets all changes to the "longitude" field.
-func (m *HotelMutation) ResetLongitude() {
-	m.longitude = nil
-}
-
-// SetPhone sets the "phone" field.
-func (m *HotelMutation) SetPhone(s string) {
-	m.phone = &s
-}
-
-// Phone returns the value of the "phone" field in the mutation.
-func (m *HotelMutation) Phone() (r string, exists bool) {
-	v := m.phone
-	if v == nil {
-		return
+	if m.event != nil {
+		fields = append(fields, eventoccurrence.FieldEventID)
 	}
-	return *v, true
-}
-
-// OldPhone returns the old "phone" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldPhone(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
+	if m.recurrence_type != nil {
+		fields = append(fields, eventoccurrence.FieldRecurrenceType)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPhone requires an ID field in the mutation")
+	if m.start_time != nil {
+		fields = append(fields, eventoccurrence.FieldStartTime)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
+	if m.end_time != nil {
+		fields = append(fields, eventoccurrence.FieldEndTime)
 	}
-	return oldValue.Phone, nil
-}
-
-// ClearPhone clears the value of the "phone" field.
-func (m *HotelMutation) ClearPhone() {
-	m.phone = nil
-	m.clearedFields[hotel.FieldPhone] = struct{}{}
-}
-
-// PhoneCleared returns if the "phone" field was cleared in this mutation.
-func (m *HotelMutation) PhoneCleared() bool {
-	_, ok := m.clearedFields[hotel.FieldPhone]
-	return ok
-}
-
-// ResetPhone resets all changes to the "phone" field.
-func (m *HotelMutation) ResetPhone() {
-	m.phone = nil
-	delete(m.clearedFields, hotel.FieldPhone)
-}
-
-// SetEmail sets the "email" field.
-func (m *HotelMutation) SetEmail(s string) {
-	m.email = &s
-}
-
-// Email returns the value of the "email" field in the mutation.
-func (m *HotelMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
+	if m.duration_minutes != nil {
+		fields = append(fields, eventoccurrence.FieldDurationMinutes)
 	}
-	return *v, true
-}
-
-// OldEmail returns the old "email" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	if m.day_of_week != nil {
+		fields = append(fields, eventoccurrence.FieldDayOfWeek)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+	if m.day_of_month != nil {
+		fields = append(fields, eventoccurrence.FieldDayOfMonth)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	if m.month_of_year != nil {
+		fields = append(fields, eventoccurrence.FieldMonthOfYear)
 	}
-	return oldValue.Email, nil
-}
-
-// ClearEmail clears the value of the "email" field.
-func (m *HotelMutation) ClearEmail() {
-	m.email = nil
-	m.clearedFields[hotel.FieldEmail] = struct{}{}
-}
-
-// EmailCleared returns if the "email" field was cleared in this mutation.
-func (m *HotelMutation) EmailCleared() bool {
-	_, ok := m.clearedFields[hotel.FieldEmail]
-	return ok
-}
-
-// ResetEmail resets all changes to the "email" field.
-func (m *HotelMutation) ResetEmail() {
-	m.email = nil
-	delete(m.clearedFields, hotel.FieldEmail)
-}
-
-// SetWebsite sets the "website" field.
-func (m *HotelMutation) SetWebsite(s string) {
-	m.website = &s
+	if m.exception_dates != nil {
+		fields = append(fields, eventoccurrence.FieldExceptionDates)
+	}
+	return fields
 }
 
-// Website returns the value of the "website" field in the mutation.
-func (m *HotelMutation) Website() (r string, exists bool) {
-	v := m.website
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EventOccurrenceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case eventoccurrence.FieldStatus:
+		return m.Status()
+	case eventoccurrence.FieldCreatedAt:
+		return m.CreatedAt()
+	case eventoccurrence.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case eventoccurrence.FieldCreatedBy:
+		return m.CreatedBy()
+	case eventoccurrence.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case eventoccurrence.FieldDeletedAt:
+		return m.DeletedAt()
+	case eventoccurrence.FieldDeletedBy:
+		return m.DeletedBy()
+	case eventoccurrence.FieldMetadata:
+		return m.Metadata()
+	case eventoccurrence.FieldEventID:
+		return m.EventID()
+	case eventoccurrence.FieldRecurrenceType:
+		return m.RecurrenceType()
+	case eventoccurrence.FieldStartTime:
+		return m.StartTime()
+	case eventoccurrence.FieldEndTime:
+		return m.EndTime()
+	case eventoccurrence.FieldDurationMinutes:
+		return m.DurationMinutes()
+	case eventoccurrence.FieldDayOfWeek:
+		return m.DayOfWeek()
+	case eventoccurrence.FieldDayOfMonth:
+		return m.DayOfMonth()
+	case eventoccurrence.FieldMonthOfYear:
+		return m.MonthOfYear()
+	case eventoccurrence.FieldExceptionDates:
+		return m.ExceptionDates()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldWebsite returns the old "website" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldWebsite(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWebsite is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWebsite requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWebsite: %w", err)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EventOccurrenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case eventoccurrence.FieldStatus:
+		return m.OldStatus(ctx)
+	case eventoccurrence.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case eventoccurrence.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case eventoccurrence.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case eventoccurrence.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case eventoccurrence.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case eventoccurrence.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	case eventoccurrence.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case eventoccurrence.FieldEventID:
+		return m.OldEventID(ctx)
+	case eventoccurrence.FieldRecurrenceType:
+		return m.OldRecurrenceType(ctx)
+	case eventoccurrence.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case eventoccurrence.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case eventoccurrence.FieldDurationMinutes:
+		return m.OldDurationMinutes(ctx)
+	case eventoccurrence.FieldDayOfWeek:
+		return m.OldDayOfWeek(ctx)
+	case eventoccurrence.FieldDayOfMonth:
+		return m.OldDayOfMonth(ctx)
+	case eventoccurrence.FieldMonthOfYear:
+		return m.OldMonthOfYear(ctx)
+	case eventoccurrence.FieldExceptionDates:
+		return m.OldExceptionDates(ctx)
 	}
-	return oldValue.Website, nil
-}
-
-// ClearWebsite clears the value of the "website" field.
-func (m *HotelMutation) ClearWebsite() {
-	m.website = nil
-	m.clearedFields[hotel.FieldWebsite] = struct{}{}
-}
-
-// WebsiteCleared returns if the "website" field was cleared in this mutation.
-func (m *HotelMutation) WebsiteCleared() bool {
-	_, ok := m.clearedFields[hotel.FieldWebsite]
-	return ok
-}
-
-// ResetWebsite resets all changes to the "website" field.
-func (m *HotelMutation) ResetWebsite() {
-	m.website = nil
-	delete(m.clearedFields, hotel.FieldWebsite)
-}
-
-// SetPrimaryImageURL sets the "primary_image_url" field.
-func (m *HotelMutation) SetPrimaryImageURL(s string) {
-	m.primary_image_url = &s
+	return nil, fmt.Errorf("unknown EventOccurrence field %s", name)
 }
 
-// PrimaryImageURL returns the value of the "primary_image_url" field in the mutation.
-func (m *HotelMutation) PrimaryImageURL() (r string, exists bool) {
-	v := m.primary_image_url
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventOccurrenceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case eventoccurrence.FieldStatus:
+		v, ok := value.(types.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case eventoccurrence.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case eventoccurrence.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case eventoccurrence.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case eventoccurrence.FieldUpdatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case eventoccurrence.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case eventoccurrence.FieldDeletedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
+	case eventoccurrence.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case eventoccurrence.FieldEventID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case eventoccurrence.FieldRecurrenceType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecurrenceType(v)
+		return nil
+	case eventoccurrence.FieldStartTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case eventoccurrence.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case eventoccurrence.FieldDurationMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMinutes(v)
+		return nil
+	case eventoccurrence.FieldDayOfWeek:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDayOfWeek(v)
+		return nil
+	case eventoccurrence.FieldDayOfMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDayOfMonth(v)
+		return nil
+	case eventoccurrence.FieldMonthOfYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonthOfYear(v)
+		return nil
+	case eventoccurrence.FieldExceptionDates:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExceptionDates(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown EventOccurrence field %s", name)
 }
 
-// OldPrimaryImageURL returns the old "primary_image_url" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldPrimaryImageURL(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrimaryImageURL is only allowed on UpdateOne operations")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EventOccurrenceMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_minutes != nil {
+		fields = append(fields, eventoccurrence.FieldDurationMinutes)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrimaryImageURL requires an ID field in the mutation")
+	if m.addday_of_week != nil {
+		fields = append(fields, eventoccurrence.FieldDayOfWeek)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrimaryImageURL: %w", err)
+	if m.addday_of_month != nil {
+		fields = append(fields, eventoccurrence.FieldDayOfMonth)
 	}
-	return oldValue.PrimaryImageURL, nil
-}
-
-// ClearPrimaryImageURL clears the value of the "primary_image_url" field.
-func (m *HotelMutation) ClearPrimaryImageURL() {
-	m.primary_image_url = nil
-	m.clearedFields[hotel.FieldPrimaryImageURL] = struct{}{}
-}
-
-// PrimaryImageURLCleared returns if the "primary_image_url" field was cleared in this mutation.
-func (m *HotelMutation) PrimaryImageURLCleared() bool {
-	_, ok := m.clearedFields[hotel.FieldPrimaryImageURL]
-	return ok
-}
-
-// ResetPrimaryImageURL resets all changes to the "primary_image_url" field.
-func (m *HotelMutation) ResetPrimaryImageURL() {
-	m.primary_image_url = nil
-	delete(m.clearedFields, hotel.FieldPrimaryImageURL)
+	if m.addmonth_of_year != nil {
+		fields = append(fields, eventoccurrence.FieldMonthOfYear)
+	}
+	return fields
 }
 
-// SetThumbnailURL sets the "thumbnail_url" field.
-func (m *HotelMutation) SetThumbnailURL(s string) {
-	m.thumbnail_url = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EventOccurrenceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case eventoccurrence.FieldDurationMinutes:
+		return m.AddedDurationMinutes()
+	case eventoccurrence.FieldDayOfWeek:
+		return m.AddedDayOfWeek()
+	case eventoccurrence.FieldDayOfMonth:
+		return m.AddedDayOfMonth()
+	case eventoccurrence.FieldMonthOfYear:
+		return m.AddedMonthOfYear()
+	}
+	return nil, false
 }
 
-// ThumbnailURL returns the value of the "thumbnail_url" field in the mutation.
-func (m *HotelMutation) ThumbnailURL() (r string, exists bool) {
-	v := m.thumbnail_url
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventOccurrenceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case eventoccurrence.FieldDurationMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMinutes(v)
+		return nil
+	case eventoccurrence.FieldDayOfWeek:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDayOfWeek(v)
+		return nil
+	case eventoccurrence.FieldDayOfMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDayOfMonth(v)
+		return nil
+	case eventoccurrence.FieldMonthOfYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonthOfYear(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown EventOccurrence numeric field %s", name)
 }
 
-// OldThumbnailURL returns the old "thumbnail_url" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldThumbnailURL(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThumbnailURL is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EventOccurrenceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(eventoccurrence.FieldCreatedBy) {
+		fields = append(fields, eventoccurrence.FieldCreatedBy)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThumbnailURL requires an ID field in the mutation")
+	if m.FieldCleared(eventoccurrence.FieldUpdatedBy) {
+		fields = append(fields, eventoccurrence.FieldUpdatedBy)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThumbnailURL: %w", err)
+	if m.FieldCleared(eventoccurrence.FieldDeletedAt) {
+		fields = append(fields, eventoccurrence.FieldDeletedAt)
 	}
-	return oldValue.ThumbnailURL, nil
-}
-
-// ClearThumbnailURL clears the value of the "thumbnail_url" field.
-func (m *HotelMutation) ClearThumbnailURL() {
-	m.thumbnail_url = nil
-	m.clearedFields[hotel.FieldThumbnailURL] = struct{}{}
+	if m.FieldCleared(eventoccurrence.FieldDeletedBy) {
+		fields = append(fields, eventoccurrence.FieldDeletedBy)
+	}
+	if m.FieldCleared(eventoccurrence.FieldMetadata) {
+		fields = append(fields, eventoccurrence.FieldMetadata)
+	}
+	if m.FieldCleared(eventoccurrence.FieldStartTime) {
+		fields = append(fields, eventoccurrence.FieldStartTime)
+	}
+	if m.FieldCleared(eventoccurrence.FieldEndTime) {
+		fields = append(fields, eventoccurrence.FieldEndTime)
+	}
+	if m.FieldCleared(eventoccurrence.FieldDurationMinutes) {
+		fields = append(fields, eventoccurrence.FieldDurationMinutes)
+	}
+	if m.FieldCleared(eventoccurrence.FieldDayOfWeek) {
+		fields = append(fields, eventoccurrence.FieldDayOfWeek)
+	}
+	if m.FieldCleared(eventoccurrence.FieldDayOfMonth) {
+		fields = append(fields, eventoccurrence.FieldDayOfMonth)
+	}
+	if m.FieldCleared(eventoccurrence.FieldMonthOfYear) {
+		fields = append(fields, eventoccurrence.FieldMonthOfYear)
+	}
+	if m.FieldCleared(eventoccurrence.FieldExceptionDates) {
+		fields = append(fields, eventoccurrence.FieldExceptionDates)
+	}
+	return fields
 }
 
-// ThumbnailURLCleared returns if the "thumbnail_url" field was cleared in this mutation.
-func (m *HotelMutation) ThumbnailURLCleared() bool {
-	_, ok := m.clearedFields[hotel.FieldThumbnailURL]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EventOccurrenceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetThumbnailURL resets all changes to the "thumbnail_url" field.
-func (m *HotelMutation) ResetThumbnailURL() {
-	m.thumbnail_url = nil
-	delete(m.clearedFields, hotel.FieldThumbnailURL)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventOccurrenceMutation) ClearField(name string) error {
+	switch name {
+	case eventoccurrence.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case eventoccurrence.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	case eventoccurrence.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case eventoccurrence.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
+	case eventoccurrence.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case eventoccurrence.FieldStartTime:
+		m.ClearStartTime()
+		return nil
+	case eventoccurrence.FieldEndTime:
+		m.ClearEndTime()
+		return nil
+	case eventoccurrence.FieldDurationMinutes:
+		m.ClearDurationMinutes()
+		return nil
+	case eventoccurrence.FieldDayOfWeek:
+		m.ClearDayOfWeek()
+		return nil
+	case eventoccurrence.FieldDayOfMonth:
+		m.ClearDayOfMonth()
+		return nil
+	case eventoccurrence.FieldMonthOfYear:
+		m.ClearMonthOfYear()
+		return nil
+	case eventoccurrence.FieldExceptionDates:
+		m.ClearExceptionDates()
+		return nil
+	}
+	return fmt.Errorf("unknown EventOccurrence nullable field %s", name)
 }
 
-// SetPriceMin sets the "price_min" field.
-func (m *HotelMutation) SetPriceMin(d decimal.Decimal) {
-	m.price_min = &d
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EventOccurrenceMutation) ResetField(name string) error {
+	switch name {
+	case eventoccurrence.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case eventoccurrence.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case eventoccurrence.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case eventoccurrence.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case eventoccurrence.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case eventoccurrence.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case eventoccurrence.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
+	case eventoccurrence.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case eventoccurrence.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case eventoccurrence.FieldRecurrenceType:
+		m.ResetRecurrenceType()
+		return nil
+	case eventoccurrence.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case eventoccurrence.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case eventoccurrence.FieldDurationMinutes:
+		m.ResetDurationMinutes()
+		return nil
+	case eventoccurrence.FieldDayOfWeek:
+		m.ResetDayOfWeek()
+		return nil
+	case eventoccurrence.FieldDayOfMonth:
+		m.ResetDayOfMonth()
+		return nil
+	case eventoccurrence.FieldMonthOfYear:
+		m.ResetMonthOfYear()
+		return nil
+	case eventoccurrence.FieldExceptionDates:
+		m.ResetExceptionDates()
+		return nil
+	}
+	return fmt.Errorf("unknown EventOccurrence field %s", name)
 }
 
-// PriceMin returns the value of the "price_min" field in the mutation.
-func (m *HotelMutation) PriceMin() (r decimal.Decimal, exists bool) {
-	v := m.price_min
-	if v == nil {
-		return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EventOccurrenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.event != nil {
+		edges = append(edges, eventoccurrence.EdgeEvent)
 	}
-	return *v, true
+	return edges
 }
 
-// OldPriceMin returns the old "price_min" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldPriceMin(ctx context.Context) (v decimal.Decimal, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriceMin is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriceMin requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriceMin: %w", err)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EventOccurrenceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case eventoccurrence.EdgeEvent:
+		if id := m.event; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.PriceMin, nil
+	return nil
 }
 
-// ClearPriceMin clears the value of the "price_min" field.
-func (m *HotelMutation) ClearPriceMin() {
-	m.price_min = nil
-	m.clearedFields[hotel.FieldPriceMin] = struct{}{}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EventOccurrenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// PriceMinCleared returns if the "price_min" field was cleared in this mutation.
-func (m *HotelMutation) PriceMinCleared() bool {
-	_, ok := m.clearedFields[hotel.FieldPriceMin]
-	return ok
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EventOccurrenceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetPriceMin resets all changes to the "price_min" field.
-func (m *HotelMutation) ResetPriceMin() {
-	m.price_min = nil
-	delete(m.clearedFields, hotel.FieldPriceMin)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EventOccurrenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedevent {
+		edges = append(edges, eventoccurrence.EdgeEvent)
+	}
+	return edges
 }
 
-// SetPriceMax sets the "price_max" field.
-func (m *HotelMutation) SetPriceMax(d decimal.Decimal) {
-	m.price_max = &d
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EventOccurrenceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case eventoccurrence.EdgeEvent:
+		return m.clearedevent
+	}
+	return false
 }
 
-// PriceMax returns the value of the "price_max" field in the mutation.
-func (m *HotelMutation) PriceMax() (r decimal.Decimal, exists bool) {
-	v := m.price_max
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EventOccurrenceMutation) ClearEdge(name string) error {
+	switch name {
+	case eventoccurrence.EdgeEvent:
+		m.ClearEvent()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown EventOccurrence unique edge %s", name)
 }
 
-// OldPriceMax returns the old "price_max" field's value of the Hotel entity.
-// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldPriceMax(ctx context.Context) (v decimal.Decimal, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriceMax is only allowed on UpdateOne operations")
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EventOccurrenceMutation) ResetEdge(name string) error {
+	switch name {
+	case eventoccurrence.EdgeEvent:
+		m.ResetEvent()
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriceMax requires an ID field in the mutation")
+	return fmt.Errorf("unknown EventOccurrence edge %s", name)
+}
+
+// HotelMutation represents an operation that mutates the Hotel nodes in the graph.
+type HotelMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *string
+	status            *types.Status
+	created_at        *time.Time
+	updated_at        *time.Time
+	created_by        *string
+	updated_by        *string
+	deleted_at        *time.Time
+	deleted_by        *string
+	metadata          *map[string]string
+	slug              *string
+	name              *string
+	description       *string
+	star_rating       *int
+	addstar_rating    *int
+	room_count        *int
+	addroom_count     *int
+	check_in_time     *time.Time
+	check_out_time    *time.Time
+	address           *map[string]string
+	latitude          *decimal.Decimal
+	longitude         *decimal.Decimal
+	phone             *string
+	email             *string
+	website           *string
+	primary_image_url *string
+	thumbnail_url     *string
+	price_min         *decimal.Decimal
+	price_max         *decimal.Decimal
+	currency          *string
+	view_count        *int
+	addview_count     *int
+	rating_avg        *decimal.Decimal
+	rating_count      *int
+	addrating_count   *int
+	last_viewed_at    *time.Time
+	popularity_score  *decimal.Decimal
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*Hotel, error)
+	predicates        []predicate.Hotel
+}
+
+var _ ent.Mutation = (*HotelMutation)(nil)
+
+// hotelOption allows management of the mutation configuration using functional options.
+type hotelOption func(*HotelMutation)
+
+// newHotelMutation creates new mutation for the Hotel entity.
+func newHotelMutation(c config, op Op, opts ...hotelOption) *HotelMutation {
+	m := &HotelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHotel,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriceMax: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.PriceMax, nil
+	return m
 }
 
-// ClearPriceMax clears the value of the "price_max" field.
-func (m *HotelMutation) ClearPriceMax() {
-	m.price_max = nil
-	m.clearedFields[hotel.FieldPriceMax] = struct{}{}
+// withHotelID sets the ID field of the mutation.
+func withHotelID(id string) hotelOption {
+	return func(m *HotelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Hotel
+		)
+		m.oldValue = func(ctx context.Context) (*Hotel, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Hotel.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// PriceMaxCleared returns if the "price_max" field was cleared in this mutation.
-func (m *HotelMutation) PriceMaxCleared() bool {
-	_, ok := m.clearedFields[hotel.FieldPriceMax]
-	return ok
+// withHotel sets the old Hotel of the mutation.
+func withHotel(node *Hotel) hotelOption {
+	return func(m *HotelMutation) {
+		m.oldValue = func(context.Context) (*Hotel, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ResetPriceMax resets all changes to the "price_max" field.
-func (m *HotelMutation) ResetPriceMax() {
-	m.price_max = nil
-	delete(m.clearedFields, hotel.FieldPriceMax)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HotelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetCurrency sets the "currency" field.
-func (m *HotelMutation) SetCurrency(s string) {
-	m.currency = &s
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HotelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// Currency returns the value of the "currency" field in the mutation.
-func (m *HotelMutation) Currency() (r string, exists bool) {
-	v := m.currency
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Hotel entities.
+func (m *HotelMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HotelMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HotelMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Hotel.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStatus sets the "status" field.
+func (m *HotelMutation) SetStatus(t types.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *HotelMutation) Status() (r types.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCurrency returns the old "currency" field's value of the Hotel entity.
+// OldStatus returns the old "status" field's value of the Hotel entity.
 // If the Hotel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldCurrency(ctx context.Context) (v string, err error) {
+func (m *HotelMutation) OldStatus(ctx context.Context) (v types.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrency requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Currency, nil
-}
-
-// ClearCurrency clears the value of the "currency" field.
-func (m *HotelMutation) ClearCurrency() {
-	m.currency = nil
-	m.clearedFields[hotel.FieldCurrency] = struct{}{}
-}
-
-// CurrencyCleared returns if the "currency" field was cleared in this mutation.
-func (m *HotelMutation) CurrencyCleared() bool {
-	_, ok := m.clearedFields[hotel.FieldCurrency]
-	return ok
+	return oldValue.Status, nil
 }
 
-// ResetCurrency resets all changes to the "currency" field.
-func (m *HotelMutation) ResetCurrency() {
-	m.currency = nil
-	delete(m.clearedFields, hotel.FieldCurrency)
+// ResetStatus resets all changes to the "status" field.
+func (m *HotelMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetViewCount sets the "view_count" field.
-func (m *HotelMutation) SetViewCount(i int) {
-	m.view_count = &i
-	m.addview_count = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *HotelMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ViewCount returns the value of the "view_count" field in the mutation.
-func (m *HotelMutation) ViewCount() (r int, exists bool) {
-	v := m.view_count
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *HotelMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldViewCount returns the old "view_count" field's value of the Hotel entity.
+// OldCreatedAt returns the old "created_at" field's value of the Hotel entity.
 // If the Hotel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldViewCount(ctx context.Context) (v int, err error) {
+func (m *HotelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldViewCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldViewCount requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldViewCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.ViewCount, nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddViewCount adds i to the "view_count" field.
-func (m *HotelMutation) AddViewCount(i int) {
-	if m.addview_count != nil {
-		*m.addview_count += i
-	} else {
-		m.addview_count = &i
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *HotelMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AddedViewCount returns the value that was added to the "view_count" field in this mutation.
-func (m *HotelMutation) AddedViewCount() (r int, exists bool) {
-	v := m.addview_count
+// SetUpdatedAt sets the "updated_at" field.
+func (m *HotelMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *HotelMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetViewCount resets all changes to the "view_count" field.
-func (m *HotelMutation) ResetViewCount() {
-	m.view_count = nil
-	m.addview_count = nil
+// OldUpdatedAt returns the old "updated_at" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// SetRatingAvg sets the "rating_avg" field.
-func (m *HotelMutation) SetRatingAvg(d decimal.Decimal) {
-	m.rating_avg = &d
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *HotelMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// RatingAvg returns the value of the "rating_avg" field in the mutation.
-func (m *HotelMutation) RatingAvg() (r decimal.Decimal, exists bool) {
-	v := m.rating_avg
+// SetCreatedBy sets the "created_by" field.
+func (m *HotelMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *HotelMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRatingAvg returns the old "rating_avg" field's value of the Hotel entity.
+// OldCreatedBy returns the old "created_by" field's value of the Hotel entity.
 // If the Hotel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldRatingAvg(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *HotelMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRatingAvg is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRatingAvg requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRatingAvg: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.RatingAvg, nil
+	return oldValue.CreatedBy, nil
 }
 
-// ResetRatingAvg resets all changes to the "rating_avg" field.
-func (m *HotelMutation) ResetRatingAvg() {
-	m.rating_avg = nil
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *HotelMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[hotel.FieldCreatedBy] = struct{}{}
 }
 
-// SetRatingCount sets the "rating_count" field.
-func (m *HotelMutation) SetRatingCount(i int) {
-	m.rating_count = &i
-	m.addrating_count = nil
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *HotelMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[hotel.FieldCreatedBy]
+	return ok
 }
 
-// RatingCount returns the value of the "rating_count" field in the mutation.
-func (m *HotelMutation) RatingCount() (r int, exists bool) {
-	v := m.rating_count
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *HotelMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, hotel.FieldCreatedBy)
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (m *HotelMutation) SetUpdatedBy(s string) {
+	m.updated_by = &s
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *HotelMutation) UpdatedBy() (r string, exists bool) {
+	v := m.updated_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRatingCount returns the old "rating_count" field's value of the Hotel entity.
+// OldUpdatedBy returns the old "updated_by" field's value of the Hotel entity.
 // If the Hotel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldRatingCount(ctx context.Context) (v int, err error) {
+func (m *HotelMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRatingCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRatingCount requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRatingCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
 	}
-	return oldValue.RatingCount, nil
+	return oldValue.UpdatedBy, nil
 }
 
-// AddRatingCount adds i to the "rating_count" field.
-func (m *HotelMutation) AddRatingCount(i int) {
-	if m.addrating_count != nil {
-		*m.addrating_count += i
-	} else {
-		m.addrating_count = &i
-	}
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *HotelMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.clearedFields[hotel.FieldUpdatedBy] = struct{}{}
 }
 
-// AddedRatingCount returns the value that was added to the "rating_count" field in this mutation.
-func (m *HotelMutation) AddedRatingCount() (r int, exists bool) {
-	v := m.addrating_count
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *HotelMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[hotel.FieldUpdatedBy]
+	return ok
+}
+
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *HotelMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	delete(m.clearedFields, hotel.FieldUpdatedBy)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *HotelMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *HotelMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRatingCount resets all changes to the "rating_count" field.
-func (m *HotelMutation) ResetRatingCount() {
-	m.rating_count = nil
-	m.addrating_count = nil
+// OldDeletedAt returns the old "deleted_at" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
 }
 
-// SetLastViewedAt sets the "last_viewed_at" field.
-func (m *HotelMutation) SetLastViewedAt(t time.Time) {
-	m.last_viewed_at = &t
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *HotelMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[hotel.FieldDeletedAt] = struct{}{}
 }
 
-// LastViewedAt returns the value of the "last_viewed_at" field in the mutation.
-func (m *HotelMutation) LastViewedAt() (r time.Time, exists bool) {
-	v := m.last_viewed_at
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *HotelMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[hotel.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *HotelMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, hotel.FieldDeletedAt)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *HotelMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *HotelMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastViewedAt returns the old "last_viewed_at" field's value of the Hotel entity.
+// OldDeletedBy returns the old "deleted_by" field's value of the Hotel entity.
 // If the Hotel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldLastViewedAt(ctx context.Context) (v time.Time, err error) {
+func (m *HotelMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastViewedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastViewedAt requires an ID field in the mutation")
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastViewedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
 	}
-	return oldValue.LastViewedAt, nil
+	return oldValue.DeletedBy, nil
 }
 
-// ClearLastViewedAt clears the value of the "last_viewed_at" field.
-func (m *HotelMutation) ClearLastViewedAt() {
-	m.last_viewed_at = nil
-	m.clearedFields[hotel.FieldLastViewedAt] = struct{}{}
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *HotelMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[hotel.FieldDeletedBy] = struct{}{}
 }
 
-// LastViewedAtCleared returns if the "last_viewed_at" field was cleared in this mutation.
-func (m *HotelMutation) LastViewedAtCleared() bool {
-	_, ok := m.clearedFields[hotel.FieldLastViewedAt]
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *HotelMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[hotel.FieldDeletedBy]
 	return ok
 }
 
-// ResetLastViewedAt resets all changes to the "last_viewed_at" field.
-func (m *HotelMutation) ResetLastViewedAt() {
-	m.last_viewed_at = nil
-	delete(m.clearedFields, hotel.FieldLastViewedAt)
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *HotelMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, hotel.FieldDeletedBy)
 }
 
-// SetPopularityScore sets the "popularity_score" field.
-func (m *HotelMutation) SetPopularityScore(d decimal.Decimal) {
-	m.popularity_score = &d
+// SetMetadata sets the "metadata" field.
+func (m *HotelMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
 }
 
-// PopularityScore returns the value of the "popularity_score" field in the mutation.
-func (m *HotelMutation) PopularityScore() (r decimal.Decimal, exists bool) {
-	v := m.popularity_score
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *HotelMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPopularityScore returns the old "popularity_score" field's value of the Hotel entity.
+// OldMetadata returns the old "metadata" field's value of the Hotel entity.
 // If the Hotel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HotelMutation) OldPopularityScore(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *HotelMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPopularityScore is only allowed on UpdateOne operations")
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPopularityScore requires an ID field in the mutation")
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPopularityScore: %w", err)
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
 	}
-	return oldValue.PopularityScore, nil
+	return oldValue.Metadata, nil
 }
 
-// ResetPopularityScore resets all changes to the "popularity_score" field.
-func (m *HotelMutation) ResetPopularityScore() {
-	m.popularity_score = nil
+// ClearMetadata clears the value of the "metadata" field.
+func (m *HotelMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[hotel.FieldMetadata] = struct{}{}
 }
 
-// Where appends a list predicates to the HotelMutation builder.
-func (m *HotelMutation) Where(ps ...predicate.Hotel) {
-	m.predicates = append(m.predicates, ps...)
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *HotelMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[hotel.FieldMetadata]
+	return ok
 }
 
-// WhereP appends storage-level predicates to the HotelMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *HotelMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Hotel, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *HotelMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, hotel.FieldMetadata)
 }
 
-// Op returns the operation name.
-func (m *HotelMutation) Op() Op {
-	return m.op
+// SetSlug sets the "slug" field.
+func (m *HotelMutation) SetSlug(s string) {
+	m.slug = &s
 }
 
-// SetOp allows setting the mutation operation.
-func (m *HotelMutation) SetOp(op Op) {
-	m.op = op
+// Slug returns the value of the "slug" field in the mutation.
+func (m *HotelMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Type returns the node type of this mutation (Hotel).
-func (m *HotelMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *HotelMutation) Fields() []string {
-	fields := make([]string, 0, 29)
-	if m.status != nil {
-		fields = append(fields, hotel.FieldStatus)
-	}
-	if m.created_at != nil {
-		fields = append(fields, hotel.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, hotel.FieldUpdatedAt)
-	}
-	if m.created_by != nil {
-		fields = append(fields, hotel.FieldCreatedBy)
-	}
-	if m.updated_by != nil {
-		fields = append(fields, hotel.FieldUpdatedBy)
-	}
-	if m.metadata != nil {
-		fields = append(fields, hotel.FieldMetadata)
-	}
-	if m.slug != nil {
-		fields = append(fields, hotel.FieldSlug)
-	}
-	if m.name != nil {
-		fields = append(fields, hotel.FieldName)
+// OldSlug returns the old "slug" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
 	}
-	if m.description != nil {
-		fields = append(fields, hotel.FieldDescription)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
 	}
-	if m.star_rating != nil {
-		fields = append(fields, hotel.FieldStarRating)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
 	}
-	if m.room_count != nil {
-		fields = append(fields, hotel.FieldRoomCount)
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *HotelMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// SetName sets the "name" field.
+func (m *HotelMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *HotelMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	if m.check_in_time != nil {
-		fields = append(fields, hotel.FieldCheckInTime)
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	if m.check_out_time != nil {
-		fields = append(fields, hotel.FieldCheckOutTime)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
-	if m.address != nil {
-		fields = append(fields, hotel.FieldAddress)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	if m.latitude != nil {
-		fields = append(fields, hotel.FieldLatitude)
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *HotelMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *HotelMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *HotelMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
 	}
-	if m.longitude != nil {
-		fields = append(fields, hotel.FieldLongitude)
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
-	if m.phone != nil {
-		fields = append(fields, hotel.FieldPhone)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
-	if m.email != nil {
-		fields = append(fields, hotel.FieldEmail)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	if m.website != nil {
-		fields = append(fields, hotel.FieldWebsite)
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *HotelMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[hotel.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *HotelMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[hotel.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *HotelMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, hotel.FieldDescription)
+}
+
+// SetStarRating sets the "star_rating" field.
+func (m *HotelMutation) SetStarRating(i int) {
+	m.star_rating = &i
+	m.addstar_rating = nil
+}
+
+// StarRating returns the value of the "star_rating" field in the mutation.
+func (m *HotelMutation) StarRating() (r int, exists bool) {
+	v := m.star_rating
+	if v == nil {
+		return
 	}
-	if m.primary_image_url != nil {
-		fields = append(fields, hotel.FieldPrimaryImageURL)
+	return *v, true
+}
+
+// OldStarRating returns the old "star_rating" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldStarRating(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStarRating is only allowed on UpdateOne operations")
 	}
-	if m.thumbnail_url != nil {
-		fields = append(fields, hotel.FieldThumbnailURL)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStarRating requires an ID field in the mutation")
 	}
-	if m.price_min != nil {
-		fields = append(fields, hotel.FieldPriceMin)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStarRating: %w", err)
 	}
-	if m.price_max != nil {
-		fields = append(fields, hotel.FieldPriceMax)
+	return oldValue.StarRating, nil
+}
+
+// AddStarRating adds i to the "star_rating" field.
+func (m *HotelMutation) AddStarRating(i int) {
+	if m.addstar_rating != nil {
+		*m.addstar_rating += i
+	} else {
+		m.addstar_rating = &i
 	}
-	if m.currency != nil {
-		fields = append(fields, hotel.FieldCurrency)
+}
+
+// AddedStarRating returns the value that was added to the "star_rating" field in this mutation.
+func (m *HotelMutation) AddedStarRating() (r int, exists bool) {
+	v := m.addstar_rating
+	if v == nil {
+		return
 	}
-	if m.view_count != nil {
-		fields = append(fields, hotel.FieldViewCount)
+	return *v, true
+}
+
+// ResetStarRating resets all changes to the "star_rating" field.
+func (m *HotelMutation) ResetStarRating() {
+	m.star_rating = nil
+	m.addstar_rating = nil
+}
+
+// SetRoomCount sets the "room_count" field.
+func (m *HotelMutation) SetRoomCount(i int) {
+	m.room_count = &i
+	m.addroom_count = nil
+}
+
+// RoomCount returns the value of the "room_count" field in the mutation.
+func (m *HotelMutation) RoomCount() (r int, exists bool) {
+	v := m.room_count
+	if v == nil {
+		return
 	}
-	if m.rating_avg != nil {
-		fields = append(fields, hotel.FieldRatingAvg)
+	return *v, true
+}
+
+// OldRoomCount returns the old "room_count" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldRoomCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomCount is only allowed on UpdateOne operations")
 	}
-	if m.rating_count != nil {
-		fields = append(fields, hotel.FieldRatingCount)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomCount requires an ID field in the mutation")
 	}
-	if m.last_viewed_at != nil {
-		fields = append(fields, hotel.FieldLastViewedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomCount: %w", err)
 	}
-	if m.popularity_score != nil {
-		fields = append(fields, hotel.FieldPopularityScore)
+	return oldValue.RoomCount, nil
+}
+
+// AddRoomCount adds i to the "room_count" field.
+func (m *HotelMutation) AddRoomCount(i int) {
+	if m.addroom_count != nil {
+		*m.addroom_count += i
+	} else {
+		m.addroom_count = &i
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *HotelMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case hotel.FieldStatus:
-		return m.Status()
-	case hotel.FieldCreatedAt:
-		return m.CreatedAt()
-	case hotel.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case hotel.FieldCreatedBy:
-		return m.CreatedBy()
-	case hotel.FieldUpdatedBy:
-		return m.UpdatedBy()
-	case hotel.FieldMetadata:
-		return m.Metadata()
-	case hotel.FieldSlug:
-		return m.Slug()
-	case hotel.FieldName:
-		return m.Name()
-	case hotel.FieldDescription:
-		return m.Description()
-	case hotel.FieldStarRating:
-		return m.StarRating()
-	case hotel.FieldRoomCount:
-		return m.RoomCount()
-	case hotel.FieldCheckInTime:
-		return m.CheckInTime()
-	case hotel.FieldCheckOutTime:
-		return m.CheckOutTime()
-	case hotel.FieldAddress:
-		return m.Address()
-	case hotel.FieldLatitude:
-		return m.Latitude()
-	case hotel.FieldLongitude:
-		return m.Longitude()
-	case hotel.FieldPhone:
-		return m.Phone()
-	case hotel.FieldEmail:
-		return m.Email()
-	case hotel.FieldWebsite:
-		return m.Website()
-	case hotel.FieldPrimaryImageURL:
-		return m.PrimaryImageURL()
-	case hotel.FieldThumbnailURL:
-		return m.ThumbnailURL()
-	case hotel.FieldPriceMin:
-		return m.PriceMin()
-	case hotel.FieldPriceMax:
-		return m.PriceMax()
-	case hotel.FieldCurrency:
-		return m.Currency()
-	case hotel.FieldViewCount:
-		return m.ViewCount()
-	case hotel.FieldRatingAvg:
-		return m.RatingAvg()
-	case hotel.FieldRatingCount:
-		return m.RatingCount()
-	case hotel.FieldLastViewedAt:
-		return m.LastViewedAt()
-	case hotel.FieldPopularityScore:
-		return m.PopularityScore()
+// AddedRoomCount returns the value that was added to the "room_count" field in this mutation.
+func (m *HotelMutation) AddedRoomCount() (r int, exists bool) {
+	v := m.addroom_count
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *HotelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case hotel.FieldStatus:
-		return m.OldStatus(ctx)
-	case hotel.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case hotel.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case hotel.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case hotel.FieldUpdatedBy:
-		return m.OldUpdatedBy(ctx)
-	case hotel.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case hotel.FieldSlug:
-		return m.OldSlug(ctx)
-	case hotel.FieldName:
-		return m.OldName(ctx)
-	case hotel.FieldDescription:
-		return m.OldDescription(ctx)
-	case hotel.FieldStarRating:
-		return m.OldStarRating(ctx)
-	case hotel.FieldRoomCount:
-		return m.OldRoomCount(ctx)
-	case hotel.FieldCheckInTime:
-		return m.OldCheckInTime(ctx)
-	case hotel.FieldCheckOutTime:
-		return m.OldCheckOutTime(ctx)
-	case hotel.FieldAddress:
-		return m.OldAddress(ctx)
-	case hotel.FieldLatitude:
-		return m.OldLatitude(ctx)
-	case hotel.FieldLongitude:
-		return m.OldLongitude(ctx)
-	case hotel.FieldPhone:
-		return m.OldPhone(ctx)
-	case hotel.FieldEmail:
-		return m.OldEmail(ctx)
-	case hotel.FieldWebsite:
-		return m.OldWebsite(ctx)
-	case hotel.FieldPrimaryImageURL:
-		return m.OldPrimaryImageURL(ctx)
-	case hotel.FieldThumbnailURL:
-		return m.OldThumbnailURL(ctx)
-	case hotel.FieldPriceMin:
-		return m.OldPriceMin(ctx)
-	case hotel.FieldPriceMax:
-		return m.OldPriceMax(ctx)
-	case hotel.FieldCurrency:
-		return m.OldCurrency(ctx)
-	case hotel.FieldViewCount:
-		return m.OldViewCount(ctx)
-	case hotel.FieldRatingAvg:
-		return m.OldRatingAvg(ctx)
-	case hotel.FieldRatingCount:
-		return m.OldRatingCount(ctx)
-	case hotel.FieldLastViewedAt:
-		return m.OldLastViewedAt(ctx)
-	case hotel.FieldPopularityScore:
-		return m.OldPopularityScore(ctx)
+// ResetRoomCount resets all changes to the "room_count" field.
+func (m *HotelMutation) ResetRoomCount() {
+	m.room_count = nil
+	m.addroom_count = nil
+}
+
+// SetCheckInTime sets the "check_in_time" field.
+func (m *HotelMutation) SetCheckInTime(t time.Time) {
+	m.check_in_time = &t
+}
+
+// CheckInTime returns the value of the "check_in_time" field in the mutation.
+func (m *HotelMutation) CheckInTime() (r time.Time, exists bool) {
+	v := m.check_in_time
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Hotel field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *HotelMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case hotel.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case hotel.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case hotel.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case hotel.FieldCreatedBy:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedBy(v)
-		return nil
-	case hotel.FieldUpdatedBy:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedBy(v)
-		return nil
-	case hotel.FieldMetadata:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMetadata(v)
-		return nil
-	case hotel.FieldSlug:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSlug(v)
-		return nil
-	case hotel.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case hotel.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case hotel.FieldStarRating:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStarRating(v)
-		return nil
-	case hotel.FieldRoomCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRoomCount(v)
-		return nil
-	case hotel.FieldCheckInTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCheckInTime(v)
-		return nil
-	case hotel.FieldCheckOutTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCheckOutTime(v)
-		return nil
-	case hotel.FieldAddress:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAddress(v)
-		return nil
-	case hotel.FieldLatitude:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLatitude(v)
+// OldCheckInTime returns the old "check_in_time" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldCheckInTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckInTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckInTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckInTime: %w", err)
+	}
+	return oldValue.CheckInTime, nil
+}
+
+// ClearCheckInTime clears the value of the "check_in_time" field.
+func (m *HotelMutation) ClearCheckInTime() {
+	m.check_in_time = nil
+	m.clearedFields[hotel.FieldCheckInTime] = struct{}{}
+}
+
+// CheckInTimeCleared returns if the "check_in_time" field was cleared in this mutation.
+func (m *HotelMutation) CheckInTimeCleared() bool {
+	_, ok := m.clearedFields[hotel.FieldCheckInTime]
+	return ok
+}
+
+// ResetCheckInTime resets all changes to the "check_in_time" field.
+func (m *HotelMutation) ResetCheckInTime() {
+	m.check_in_time = nil
+	delete(m.clearedFields, hotel.FieldCheckInTime)
+}
+
+// SetCheckOutTime sets the "check_out_time" field.
+func (m *HotelMutation) SetCheckOutTime(t time.Time) {
+	m.check_out_time = &t
+}
+
+// CheckOutTime returns the value of the "check_out_time" field in the mutation.
+func (m *HotelMutation) CheckOutTime() (r time.Time, exists bool) {
+	v := m.check_out_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheckOutTime returns the old "check_out_time" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldCheckOutTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckOutTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckOutTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckOutTime: %w", err)
+	}
+	return oldValue.CheckOutTime, nil
+}
+
+// ClearCheckOutTime clears the value of the "check_out_time" field.
+func (m *HotelMutation) ClearCheckOutTime() {
+	m.check_out_time = nil
+	m.clearedFields[hotel.FieldCheckOutTime] = struct{}{}
+}
+
+// CheckOutTimeCleared returns if the "check_out_time" field was cleared in this mutation.
+func (m *HotelMutation) CheckOutTimeCleared() bool {
+	_, ok := m.clearedFields[hotel.FieldCheckOutTime]
+	return ok
+}
+
+// ResetCheckOutTime resets all changes to the "check_out_time" field.
+func (m *HotelMutation) ResetCheckOutTime() {
+	m.check_out_time = nil
+	delete(m.clearedFields, hotel.FieldCheckOutTime)
+}
+
+// SetAddress sets the "address" field.
+func (m *HotelMutation) SetAddress(value map[string]string) {
+	m.address = &value
+}
+
+// Address returns the value of the "address" field in the mutation.
+func (m *HotelMutation) Address() (r map[string]string, exists bool) {
+	v := m.address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddress returns the old "address" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldAddress(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+	}
+	return oldValue.Address, nil
+}
+
+// ClearAddress clears the value of the "address" field.
+func (m *HotelMutation) ClearAddress() {
+	m.address = nil
+	m.clearedFields[hotel.FieldAddress] = struct{}{}
+}
+
+// AddressCleared returns if the "address" field was cleared in this mutation.
+func (m *HotelMutation) AddressCleared() bool {
+	_, ok := m.clearedFields[hotel.FieldAddress]
+	return ok
+}
+
+// ResetAddress resets all changes to the "address" field.
+func (m *HotelMutation) ResetAddress() {
+	m.address = nil
+	delete(m.clearedFields, hotel.FieldAddress)
+}
+
+// SetLatitude sets the "latitude" field.
+func (m *HotelMutation) SetLatitude(d decimal.Decimal) {
+	m.latitude = &d
+}
+
+// Latitude returns the value of the "latitude" field in the mutation.
+func (m *HotelMutation) Latitude() (r decimal.Decimal, exists bool) {
+	v := m.latitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatitude returns the old "latitude" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldLatitude(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatitude: %w", err)
+	}
+	return oldValue.Latitude, nil
+}
+
+// ResetLatitude resets all changes to the "latitude" field.
+func (m *HotelMutation) ResetLatitude() {
+	m.latitude = nil
+}
+
+// SetLongitude sets the "longitude" field.
+func (m *HotelMutation) SetLongitude(d decimal.Decimal) {
+	m.longitude = &d
+}
+
+// Longitude returns the value of the "longitude" field in the mutation.
+func (m *HotelMutation) Longitude() (r decimal.Decimal, exists bool) {
+	v := m.longitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLongitude returns the old "longitude" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldLongitude(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLongitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLongitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLongitude: %w", err)
+	}
+	return oldValue.Longitude, nil
+}
+
+// ResetLongitude resets all changes to the "longitude" field.
+func (m *HotelMutation) ResetLongitude() {
+	m.longitude = nil
+}
+
+// SetPhone sets the "phone" field.
+func (m *HotelMutation) SetPhone(s string) {
+	m.phone = &s
+}
+
+// Phone returns the value of the "phone" field in the mutation.
+func (m *HotelMutation) Phone() (r string, exists bool) {
+	v := m.phone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhone returns the old "phone" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldPhone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
+	}
+	return oldValue.Phone, nil
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (m *HotelMutation) ClearPhone() {
+	m.phone = nil
+	m.clearedFields[hotel.FieldPhone] = struct{}{}
+}
+
+// PhoneCleared returns if the "phone" field was cleared in this mutation.
+func (m *HotelMutation) PhoneCleared() bool {
+	_, ok := m.clearedFields[hotel.FieldPhone]
+	return ok
+}
+
+// ResetPhone resets all changes to the "phone" field.
+func (m *HotelMutation) ResetPhone() {
+	m.phone = nil
+	delete(m.clearedFields, hotel.FieldPhone)
+}
+
+// SetEmail sets the "email" field.
+func (m *HotelMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *HotelMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *HotelMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[hotel.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *HotelMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[hotel.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *HotelMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, hotel.FieldEmail)
+}
+
+// SetWebsite sets the "website" field.
+func (m *HotelMutation) SetWebsite(s string) {
+	m.website = &s
+}
+
+// Website returns the value of the "website" field in the mutation.
+func (m *HotelMutation) Website() (r string, exists bool) {
+	v := m.website
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWebsite returns the old "website" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldWebsite(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebsite is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebsite requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebsite: %w", err)
+	}
+	return oldValue.Website, nil
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (m *HotelMutation) ClearWebsite() {
+	m.website = nil
+	m.clearedFields[hotel.FieldWebsite] = struct{}{}
+}
+
+// WebsiteCleared returns if the "website" field was cleared in this mutation.
+func (m *HotelMutation) WebsiteCleared() bool {
+	_, ok := m.clearedFields[hotel.FieldWebsite]
+	return ok
+}
+
+// ResetWebsite resets all changes to the "website" field.
+func (m *HotelMutation) ResetWebsite() {
+	m.website = nil
+	delete(m.clearedFields, hotel.FieldWebsite)
+}
+
+// SetPrimaryImageURL sets the "primary_image_url" field.
+func (m *HotelMutation) SetPrimaryImageURL(s string) {
+	m.primary_image_url = &s
+}
+
+// PrimaryImageURL returns the value of the "primary_image_url" field in the mutation.
+func (m *HotelMutation) PrimaryImageURL() (r string, exists bool) {
+	v := m.primary_image_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrimaryImageURL returns the old "primary_image_url" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldPrimaryImageURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrimaryImageURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrimaryImageURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrimaryImageURL: %w", err)
+	}
+	return oldValue.PrimaryImageURL, nil
+}
+
+// ClearPrimaryImageURL clears the value of the "primary_image_url" field.
+func (m *HotelMutation) ClearPrimaryImageURL() {
+	m.primary_image_url = nil
+	m.clearedFields[hotel.FieldPrimaryImageURL] = struct{}{}
+}
+
+// PrimaryImageURLCleared returns if the "primary_image_url" field was cleared in this mutation.
+func (m *HotelMutation) PrimaryImageURLCleared() bool {
+	_, ok := m.clearedFields[hotel.FieldPrimaryImageURL]
+	return ok
+}
+
+// ResetPrimaryImageURL resets all changes to the "primary_image_url" field.
+func (m *HotelMutation) ResetPrimaryImageURL() {
+	m.primary_image_url = nil
+	delete(m.clearedFields, hotel.FieldPrimaryImageURL)
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (m *HotelMutation) SetThumbnailURL(s string) {
+	m.thumbnail_url = &s
+}
+
+// ThumbnailURL returns the value of the "thumbnail_url" field in the mutation.
+func (m *HotelMutation) ThumbnailURL() (r string, exists bool) {
+	v := m.thumbnail_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnailURL returns the old "thumbnail_url" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldThumbnailURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailURL: %w", err)
+	}
+	return oldValue.ThumbnailURL, nil
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (m *HotelMutation) ClearThumbnailURL() {
+	m.thumbnail_url = nil
+	m.clearedFields[hotel.FieldThumbnailURL] = struct{}{}
+}
+
+// ThumbnailURLCleared returns if the "thumbnail_url" field was cleared in this mutation.
+func (m *HotelMutation) ThumbnailURLCleared() bool {
+	_, ok := m.clearedFields[hotel.FieldThumbnailURL]
+	return ok
+}
+
+// ResetThumbnailURL resets all changes to the "thumbnail_url" field.
+func (m *HotelMutation) ResetThumbnailURL() {
+	m.thumbnail_url = nil
+	delete(m.clearedFields, hotel.FieldThumbnailURL)
+}
+
+// SetPriceMin sets the "price_min" field.
+func (m *HotelMutation) SetPriceMin(d decimal.Decimal) {
+	m.price_min = &d
+}
+
+// PriceMin returns the value of the "price_min" field in the mutation.
+func (m *HotelMutation) PriceMin() (r decimal.Decimal, exists bool) {
+	v := m.price_min
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriceMin returns the old "price_min" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldPriceMin(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriceMin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriceMin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriceMin: %w", err)
+	}
+	return oldValue.PriceMin, nil
+}
+
+// ClearPriceMin clears the value of the "price_min" field.
+func (m *HotelMutation) ClearPriceMin() {
+	m.price_min = nil
+	m.clearedFields[hotel.FieldPriceMin] = struct{}{}
+}
+
+// PriceMinCleared returns if the "price_min" field was cleared in this mutation.
+func (m *HotelMutation) PriceMinCleared() bool {
+	_, ok := m.clearedFields[hotel.FieldPriceMin]
+	return ok
+}
+
+// ResetPriceMin resets all changes to the "price_min" field.
+func (m *HotelMutation) ResetPriceMin() {
+	m.price_min = nil
+	delete(m.clearedFields, hotel.FieldPriceMin)
+}
+
+// SetPriceMax sets the "price_max" field.
+func (m *HotelMutation) SetPriceMax(d decimal.Decimal) {
+	m.price_max = &d
+}
+
+// PriceMax returns the value of the "price_max" field in the mutation.
+func (m *HotelMutation) PriceMax() (r decimal.Decimal, exists bool) {
+	v := m.price_max
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriceMax returns the old "price_max" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldPriceMax(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriceMax is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriceMax requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriceMax: %w", err)
+	}
+	return oldValue.PriceMax, nil
+}
+
+// ClearPriceMax clears the value of the "price_max" field.
+func (m *HotelMutation) ClearPriceMax() {
+	m.price_max = nil
+	m.clearedFields[hotel.FieldPriceMax] = struct{}{}
+}
+
+// PriceMaxCleared returns if the "price_max" field was cleared in this mutation.
+func (m *HotelMutation) PriceMaxCleared() bool {
+	_, ok := m.clearedFields[hotel.FieldPriceMax]
+	return ok
+}
+
+// ResetPriceMax resets all changes to the "price_max" field.
+func (m *HotelMutation) ResetPriceMax() {
+	m.price_max = nil
+	delete(m.clearedFields, hotel.FieldPriceMax)
+}
+
+// SetCurrency sets the "currency" field.
+func (m *HotelMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *HotelMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (m *HotelMutation) ClearCurrency() {
+	m.currency = nil
+	m.clearedFields[hotel.FieldCurrency] = struct{}{}
+}
+
+// CurrencyCleared returns if the "currency" field was cleared in this mutation.
+func (m *HotelMutation) CurrencyCleared() bool {
+	_, ok := m.clearedFields[hotel.FieldCurrency]
+	return ok
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *HotelMutation) ResetCurrency() {
+	m.currency = nil
+	delete(m.clearedFields, hotel.FieldCurrency)
+}
+
+// SetViewCount sets the "view_count" field.
+func (m *HotelMutation) SetViewCount(i int) {
+	m.view_count = &i
+	m.addview_count = nil
+}
+
+// ViewCount returns the value of the "view_count" field in the mutation.
+func (m *HotelMutation) ViewCount() (r int, exists bool) {
+	v := m.view_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldViewCount returns the old "view_count" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldViewCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldViewCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldViewCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldViewCount: %w", err)
+	}
+	return oldValue.ViewCount, nil
+}
+
+// AddViewCount adds i to the "view_count" field.
+func (m *HotelMutation) AddViewCount(i int) {
+	if m.addview_count != nil {
+		*m.addview_count += i
+	} else {
+		m.addview_count = &i
+	}
+}
+
+// AddedViewCount returns the value that was added to the "view_count" field in this mutation.
+func (m *HotelMutation) AddedViewCount() (r int, exists bool) {
+	v := m.addview_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetViewCount resets all changes to the "view_count" field.
+func (m *HotelMutation) ResetViewCount() {
+	m.view_count = nil
+	m.addview_count = nil
+}
+
+// SetRatingAvg sets the "rating_avg" field.
+func (m *HotelMutation) SetRatingAvg(d decimal.Decimal) {
+	m.rating_avg = &d
+}
+
+// RatingAvg returns the value of the "rating_avg" field in the mutation.
+func (m *HotelMutation) RatingAvg() (r decimal.Decimal, exists bool) {
+	v := m.rating_avg
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRatingAvg returns the old "rating_avg" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldRatingAvg(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRatingAvg is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRatingAvg requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRatingAvg: %w", err)
+	}
+	return oldValue.RatingAvg, nil
+}
+
+// ResetRatingAvg resets all changes to the "rating_avg" field.
+func (m *HotelMutation) ResetRatingAvg() {
+	m.rating_avg = nil
+}
+
+// SetRatingCount sets the "rating_count" field.
+func (m *HotelMutation) SetRatingCount(i int) {
+	m.rating_count = &i
+	m.addrating_count = nil
+}
+
+// RatingCount returns the value of the "rating_count" field in the mutation.
+func (m *HotelMutation) RatingCount() (r int, exists bool) {
+	v := m.rating_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRatingCount returns the old "rating_count" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldRatingCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRatingCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRatingCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRatingCount: %w", err)
+	}
+	return oldValue.RatingCount, nil
+}
+
+// AddRatingCount adds i to the "rating_count" field.
+func (m *HotelMutation) AddRatingCount(i int) {
+	if m.addrating_count != nil {
+		*m.addrating_count += i
+	} else {
+		m.addrating_count = &i
+	}
+}
+
+// AddedRatingCount returns the value that was added to the "rating_count" field in this mutation.
+func (m *HotelMutation) AddedRatingCount() (r int, exists bool) {
+	v := m.addrating_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRatingCount resets all changes to the "rating_count" field.
+func (m *HotelMutation) ResetRatingCount() {
+	m.rating_count = nil
+	m.addrating_count = nil
+}
+
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (m *HotelMutation) SetLastViewedAt(t time.Time) {
+	m.last_viewed_at = &t
+}
+
+// LastViewedAt returns the value of the "last_viewed_at" field in the mutation.
+func (m *HotelMutation) LastViewedAt() (r time.Time, exists bool) {
+	v := m.last_viewed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastViewedAt returns the old "last_viewed_at" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldLastViewedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastViewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastViewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastViewedAt: %w", err)
+	}
+	return oldValue.LastViewedAt, nil
+}
+
+// ClearLastViewedAt clears the value of the "last_viewed_at" field.
+func (m *HotelMutation) ClearLastViewedAt() {
+	m.last_viewed_at = nil
+	m.clearedFields[hotel.FieldLastViewedAt] = struct{}{}
+}
+
+// LastViewedAtCleared returns if the "last_viewed_at" field was cleared in this mutation.
+func (m *HotelMutation) LastViewedAtCleared() bool {
+	_, ok := m.clearedFields[hotel.FieldLastViewedAt]
+	return ok
+}
+
+// ResetLastViewedAt resets all changes to the "last_viewed_at" field.
+func (m *HotelMutation) ResetLastViewedAt() {
+	m.last_viewed_at = nil
+	delete(m.clearedFields, hotel.FieldLastViewedAt)
+}
+
+// SetPopularityScore sets the "popularity_score" field.
+func (m *HotelMutation) SetPopularityScore(d decimal.Decimal) {
+	m.popularity_score = &d
+}
+
+// PopularityScore returns the value of the "popularity_score" field in the mutation.
+func (m *HotelMutation) PopularityScore() (r decimal.Decimal, exists bool) {
+	v := m.popularity_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPopularityScore returns the old "popularity_score" field's value of the Hotel entity.
+// If the Hotel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HotelMutation) OldPopularityScore(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPopularityScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPopularityScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPopularityScore: %w", err)
+	}
+	return oldValue.PopularityScore, nil
+}
+
+// ResetPopularityScore resets all changes to the "popularity_score" field.
+func (m *HotelMutation) ResetPopularityScore() {
+	m.popularity_score = nil
+}
+
+// Where appends a list predicates to the HotelMutation builder.
+func (m *HotelMutation) Where(ps ...predicate.Hotel) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HotelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HotelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Hotel, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HotelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HotelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Hotel).
+func (m *HotelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HotelMutation) Fields() []string {
+	fields := make([]string, 0, 31)
+	if m.status != nil {
+		fields = append(fields, hotel.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, hotel.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, hotel.FieldUpdatedAt)
+	}
+	if m.created_by != nil {
+		fields = append(fields, hotel.FieldCreatedBy)
+	}
+	if m.updated_by != nil {
+		fields = append(fields, hotel.FieldUpdatedBy)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, hotel.FieldDeletedAt)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, hotel.FieldDeletedBy)
+	}
+	if m.metadata != nil {
+		fields = append(fields, hotel.FieldMetadata)
+	}
+	if m.slug != nil {
+		fields = append(fields, hotel.FieldSlug)
+	}
+	if m.name != nil {
+		fields = append(fields, hotel.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, hotel.FieldDescription)
+	}
+	if m.star_rating != nil {
+		fields = append(fields, hotel.FieldStarRating)
+	}
+	if m.room_count != nil {
+		fields = append(fields, hotel.FieldRoomCount)
+	}
+	if m.check_in_time != nil {
+		fields = append(fields, hotel.FieldCheckInTime)
+	}
+	if m.check_out_time != nil {
+		fields = append(fields, hotel.FieldCheckOutTime)
+	}
+	if m.address != nil {
+		fields = append(fields, hotel.FieldAddress)
+	}
+	if m.latitude != nil {
+		fields = append(fields, hotel.FieldLatitude)
+	}
+	if m.longitude != nil {
+		fields = append(fields, hotel.FieldLongitude)
+	}
+	if m.phone != nil {
+		fields = append(fields, hotel.FieldPhone)
+	}
+	if m.email != nil {
+		fields = append(fields, hotel.FieldEmail)
+	}
+	if m.website != nil {
+		fields = append(fields, hotel.FieldWebsite)
+	}
+	if m.primary_image_url != nil {
+		fields = append(fields, hotel.FieldPrimaryImageURL)
+	}
+	if m.thumbnail_url != nil {
+		fields = append(fields, hotel.FieldThumbnailURL)
+	}
+	if m.price_min != nil {
+		fields = append(fields, hotel.FieldPriceMin)
+	}
+	if m.price_max != nil {
+		fields = append(fields, hotel.FieldPriceMax)
+	}
+	if m.currency != nil {
+		fields = append(fields, hotel.FieldCurrency)
+	}
+	if m.view_count != nil {
+		fields = append(fields, hotel.FieldViewCount)
+	}
+	if m.rating_avg != nil {
+		fields = append(fields, hotel.FieldRatingAvg)
+	}
+	if m.rating_count != nil {
+		fields = append(fields, hotel.FieldRatingCount)
+	}
+	if m.last_viewed_at != nil {
+		fields = append(fields, hotel.FieldLastViewedAt)
+	}
+	if m.popularity_score != nil {
+		fields = append(fields, hotel.FieldPopularityScore)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HotelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case hotel.FieldStatus:
+		return m.Status()
+	case hotel.FieldCreatedAt:
+		return m.CreatedAt()
+	case hotel.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case hotel.FieldCreatedBy:
+		return m.CreatedBy()
+	case hotel.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case hotel.FieldDeletedAt:
+		return m.DeletedAt()
+	case hotel.FieldDeletedBy:
+		return m.DeletedBy()
+	case hotel.FieldMetadata:
+		return m.Metadata()
+	case hotel.FieldSlug:
+		return m.Slug()
+	case hotel.FieldName:
+		return m.Name()
+	case hotel.FieldDescription:
+		return m.Description()
+	case hotel.FieldStarRating:
+		return m.StarRating()
+	case hotel.FieldRoomCount:
+		return m.RoomCount()
+	case hotel.FieldCheckInTime:
+		return m.CheckInTime()
+	case hotel.FieldCheckOutTime:
+		return m.CheckOutTime()
+	case hotel.FieldAddress:
+		return m.Address()
+	case hotel.FieldLatitude:
+		return m.Latitude()
+	case hotel.FieldLongitude:
+		return m.Longitude()
+	case hotel.FieldPhone:
+		return m.Phone()
+	case hotel.FieldEmail:
+		return m.Email()
+	case hotel.FieldWebsite:
+		return m.Website()
+	case hotel.FieldPrimaryImageURL:
+		return m.PrimaryImageURL()
+	case hotel.FieldThumbnailURL:
+		return m.ThumbnailURL()
+	case hotel.FieldPriceMin:
+		return m.PriceMin()
+	case hotel.FieldPriceMax:
+		return m.PriceMax()
+	case hotel.FieldCurrency:
+		return m.Currency()
+	case hotel.FieldViewCount:
+		return m.ViewCount()
+	case hotel.FieldRatingAvg:
+		return m.RatingAvg()
+	case hotel.FieldRatingCount:
+		return m.RatingCount()
+	case hotel.FieldLastViewedAt:
+		return m.LastViewedAt()
+	case hotel.FieldPopularityScore:
+		return m.PopularityScore()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HotelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case hotel.FieldStatus:
+		return m.OldStatus(ctx)
+	case hotel.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case hotel.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case hotel.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case hotel.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case hotel.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case hotel.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	case hotel.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case hotel.FieldSlug:
+		return m.OldSlug(ctx)
+	case hotel.FieldName:
+		return m.OldName(ctx)
+	case hotel.FieldDescription:
+		return m.OldDescription(ctx)
+	case hotel.FieldStarRating:
+		return m.OldStarRating(ctx)
+	case hotel.FieldRoomCount:
+		return m.OldRoomCount(ctx)
+	case hotel.FieldCheckInTime:
+		return m.OldCheckInTime(ctx)
+	case hotel.FieldCheckOutTime:
+		return m.OldCheckOutTime(ctx)
+	case hotel.FieldAddress:
+		return m.OldAddress(ctx)
+	case hotel.FieldLatitude:
+		return m.OldLatitude(ctx)
+	case hotel.FieldLongitude:
+		return m.OldLongitude(ctx)
+	case hotel.FieldPhone:
+		return m.OldPhone(ctx)
+	case hotel.FieldEmail:
+		return m.OldEmail(ctx)
+	case hotel.FieldWebsite:
+		return m.OldWebsite(ctx)
+	case hotel.FieldPrimaryImageURL:
+		return m.OldPrimaryImageURL(ctx)
+	case hotel.FieldThumbnailURL:
+		return m.OldThumbnailURL(ctx)
+	case hotel.FieldPriceMin:
+		return m.OldPriceMin(ctx)
+	case hotel.FieldPriceMax:
+		return m.OldPriceMax(ctx)
+	case hotel.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case hotel.FieldViewCount:
+		return m.OldViewCount(ctx)
+	case hotel.FieldRatingAvg:
+		return m.OldRatingAvg(ctx)
+	case hotel.FieldRatingCount:
+		return m.OldRatingCount(ctx)
+	case hotel.FieldLastViewedAt:
+		return m.OldLastViewedAt(ctx)
+	case hotel.FieldPopularityScore:
+		return m.OldPopularityScore(ctx)
+	}
+	return nil, fmt.Errorf("unknown Hotel field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HotelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case hotel.FieldStatus:
+		v, ok := value.(types.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case hotel.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case hotel.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case hotel.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case hotel.FieldUpdatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case hotel.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case hotel.FieldDeletedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
+	case hotel.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case hotel.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case hotel.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case hotel.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case hotel.FieldStarRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStarRating(v)
+		return nil
+	case hotel.FieldRoomCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomCount(v)
+		return nil
+	case hotel.FieldCheckInTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckInTime(v)
+		return nil
+	case hotel.FieldCheckOutTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckOutTime(v)
+		return nil
+	case hotel.FieldAddress:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	case hotel.FieldLatitude:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatitude(v)
+		return nil
+	case hotel.FieldLongitude:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLongitude(v)
+		return nil
+	case hotel.FieldPhone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhone(v)
+		return nil
+	case hotel.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case hotel.FieldWebsite:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebsite(v)
+		return nil
+	case hotel.FieldPrimaryImageURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrimaryImageURL(v)
+		return nil
+	case hotel.FieldThumbnailURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnailURL(v)
+		return nil
+	case hotel.FieldPriceMin:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriceMin(v)
+		return nil
+	case hotel.FieldPriceMax:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriceMax(v)
+		return nil
+	case hotel.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case hotel.FieldViewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetViewCount(v)
+		return nil
+	case hotel.FieldRatingAvg:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRatingAvg(v)
+		return nil
+	case hotel.FieldRatingCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRatingCount(v)
+		return nil
+	case hotel.FieldLastViewedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastViewedAt(v)
+		return nil
+	case hotel.FieldPopularityScore:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPopularityScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Hotel field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HotelMutation) AddedFields() []string {
+	var fields []string
+	if m.addstar_rating != nil {
+		fields = append(fields, hotel.FieldStarRating)
+	}
+	if m.addroom_count != nil {
+		fields = append(fields, hotel.FieldRoomCount)
+	}
+	if m.addview_count != nil {
+		fields = append(fields, hotel.FieldViewCount)
+	}
+	if m.addrating_count != nil {
+		fields = append(fields, hotel.FieldRatingCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HotelMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case hotel.FieldStarRating:
+		return m.AddedStarRating()
+	case hotel.FieldRoomCount:
+		return m.AddedRoomCount()
+	case hotel.FieldViewCount:
+		return m.AddedViewCount()
+	case hotel.FieldRatingCount:
+		return m.AddedRatingCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HotelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case hotel.FieldStarRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStarRating(v)
+		return nil
+	case hotel.FieldRoomCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRoomCount(v)
+		return nil
+	case hotel.FieldViewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddViewCount(v)
+		return nil
+	case hotel.FieldRatingCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRatingCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Hotel numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HotelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(hotel.FieldCreatedBy) {
+		fields = append(fields, hotel.FieldCreatedBy)
+	}
+	if m.FieldCleared(hotel.FieldUpdatedBy) {
+		fields = append(fields, hotel.FieldUpdatedBy)
+	}
+	if m.FieldCleared(hotel.FieldDeletedAt) {
+		fields = append(fields, hotel.FieldDeletedAt)
+	}
+	if m.FieldCleared(hotel.FieldDeletedBy) {
+		fields = append(fields, hotel.FieldDeletedBy)
+	}
+	if m.FieldCleared(hotel.FieldMetadata) {
+		fields = append(fields, hotel.FieldMetadata)
+	}
+	if m.FieldCleared(hotel.FieldDescription) {
+		fields = append(fields, hotel.FieldDescription)
+	}
+	if m.FieldCleared(hotel.FieldCheckInTime) {
+		fields = append(fields, hotel.FieldCheckInTime)
+	}
+	if m.FieldCleared(hotel.FieldCheckOutTime) {
+		fields = append(fields, hotel.FieldCheckOutTime)
+	}
+	if m.FieldCleared(hotel.FieldAddress) {
+		fields = append(fields, hotel.FieldAddress)
+	}
+	if m.FieldCleared(hotel.FieldPhone) {
+		fields = append(fields, hotel.FieldPhone)
+	}
+	if m.FieldCleared(hotel.FieldEmail) {
+		fields = append(fields, hotel.FieldEmail)
+	}
+	if m.FieldCleared(hotel.FieldWebsite) {
+		fields = append(fields, hotel.FieldWebsite)
+	}
+	if m.FieldCleared(hotel.FieldPrimaryImageURL) {
+		fields = append(fields, hotel.FieldPrimaryImageURL)
+	}
+	if m.FieldCleared(hotel.FieldThumbnailURL) {
+		fields = append(fields, hotel.FieldThumbnailURL)
+	}
+	if m.FieldCleared(hotel.FieldPriceMin) {
+		fields = append(fields, hotel.FieldPriceMin)
+	}
+	if m.FieldCleared(hotel.FieldPriceMax) {
+		fields = append(fields, hotel.FieldPriceMax)
+	}
+	if m.FieldCleared(hotel.FieldCurrency) {
+		fields = append(fields, hotel.FieldCurrency)
+	}
+	if m.FieldCleared(hotel.FieldLastViewedAt) {
+		fields = append(fields, hotel.FieldLastViewedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HotelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HotelMutation) ClearField(name string) error {
+	switch name {
+	case hotel.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case hotel.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	case hotel.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case hotel.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
+	case hotel.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case hotel.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case hotel.FieldCheckInTime:
+		m.ClearCheckInTime()
+		return nil
+	case hotel.FieldCheckOutTime:
+		m.ClearCheckOutTime()
+		return nil
+	case hotel.FieldAddress:
+		m.ClearAddress()
+		return nil
+	case hotel.FieldPhone:
+		m.ClearPhone()
+		return nil
+	case hotel.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case hotel.FieldWebsite:
+		m.ClearWebsite()
+		return nil
+	case hotel.FieldPrimaryImageURL:
+		m.ClearPrimaryImageURL()
+		return nil
+	case hotel.FieldThumbnailURL:
+		m.ClearThumbnailURL()
+		return nil
+	case hotel.FieldPriceMin:
+		m.ClearPriceMin()
+		return nil
+	case hotel.FieldPriceMax:
+		m.ClearPriceMax()
+		return nil
+	case hotel.FieldCurrency:
+		m.ClearCurrency()
+		return nil
+	case hotel.FieldLastViewedAt:
+		m.ClearLastViewedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Hotel nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HotelMutation) ResetField(name string) error {
+	switch name {
+	case hotel.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case hotel.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case hotel.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case hotel.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case hotel.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case hotel.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case hotel.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
+	case hotel.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case hotel.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case hotel.FieldName:
+		m.ResetName()
+		return nil
+	case hotel.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case hotel.FieldStarRating:
+		m.ResetStarRating()
+		return nil
+	case hotel.FieldRoomCount:
+		m.ResetRoomCount()
+		return nil
+	case hotel.FieldCheckInTime:
+		m.ResetCheckInTime()
+		return nil
+	case hotel.FieldCheckOutTime:
+		m.ResetCheckOutTime()
+		return nil
+	case hotel.FieldAddress:
+		m.ResetAddress()
+		return nil
+	case hotel.FieldLatitude:
+		m.ResetLatitude()
+		return nil
+	case hotel.FieldLongitude:
+		m.ResetLongitude()
+		return nil
+	case hotel.FieldPhone:
+		m.ResetPhone()
+		return nil
+	case hotel.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case hotel.FieldWebsite:
+		m.ResetWebsite()
+		return nil
+	case hotel.FieldPrimaryImageURL:
+		m.ResetPrimaryImageURL()
+		return nil
+	case hotel.FieldThumbnailURL:
+		m.ResetThumbnailURL()
+		return nil
+	case hotel.FieldPriceMin:
+		m.ResetPriceMin()
+		return nil
+	case hotel.FieldPriceMax:
+		m.ResetPriceMax()
+		return nil
+	case hotel.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case hotel.FieldViewCount:
+		m.ResetViewCount()
+		return nil
+	case hotel.FieldRatingAvg:
+		m.ResetRatingAvg()
+		return nil
+	case hotel.FieldRatingCount:
+		m.ResetRatingCount()
+		return nil
+	case hotel.FieldLastViewedAt:
+		m.ResetLastViewedAt()
+		return nil
+	case hotel.FieldPopularityScore:
+		m.ResetPopularityScore()
+		return nil
+	}
+	return fmt.Errorf("unknown Hotel field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HotelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HotelMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HotelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HotelMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HotelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HotelMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HotelMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Hotel unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HotelMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Hotel edge %s", name)
+}
+
+// ItineraryMutation represents an operation that mutates the Itinerary nodes in the graph.
+type ItineraryMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *string
+	status                      *types.Status
+	created_at                  *time.Time
+	updated_at                  *time.Time
+	created_by                  *string
+	updated_by                  *string
+	deleted_at                  *time.Time
+	deleted_by                  *string
+	metadata                    *map[string]string
+	title                       *string
+	description                 *string
+	planned_date                *time.Time
+	start_latitude              *decimal.Decimal
+	start_longitude             *decimal.Decimal
+	preferred_transport_mode    *string
+	total_distance_km           *float64
+	addtotal_distance_km        *float64
+	total_duration_minutes      *int
+	addtotal_duration_minutes   *int
+	total_visit_time_minutes    *int
+	addtotal_visit_time_minutes *int
+	is_optimized                *bool
+	clearedFields               map[string]struct{}
+	user                        *string
+	cleareduser                 bool
+	visits                      map[string]struct{}
+	removedvisits               map[string]struct{}
+	clearedvisits               bool
+	done                        bool
+	oldValue                    func(context.Context) (*Itinerary, error)
+	predicates                  []predicate.Itinerary
+}
+
+var _ ent.Mutation = (*ItineraryMutation)(nil)
+
+// itineraryOption allows management of the mutation configuration using functional options.
+type itineraryOption func(*ItineraryMutation)
+
+// newItineraryMutation creates new mutation for the Itinerary entity.
+func newItineraryMutation(c config, op Op, opts ...itineraryOption) *ItineraryMutation {
+	m := &ItineraryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeItinerary,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withItineraryID sets the ID field of the mutation.
+func withItineraryID(id string) itineraryOption {
+	return func(m *ItineraryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Itinerary
+		)
+		m.oldValue = func(ctx context.Context) (*Itinerary, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Itinerary.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withItinerary sets the old Itinerary of the mutation.
+func withItinerary(node *Itinerary) itineraryOption {
+	return func(m *ItineraryMutation) {
+		m.oldValue = func(context.Context) (*Itinerary, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ItineraryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ItineraryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Itinerary entities.
+func (m *ItineraryMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ItineraryMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ItineraryMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Itinerary.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStatus sets the "status" field.
+func (m *ItineraryMutation) SetStatus(t types.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ItineraryMutation) Status() (r types.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldStatus(ctx context.Context) (v types.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ItineraryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ItineraryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ItineraryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ItineraryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ItineraryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ItineraryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ItineraryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *ItineraryMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *ItineraryMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *ItineraryMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[itinerary.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *ItineraryMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[itinerary.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *ItineraryMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, itinerary.FieldCreatedBy)
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (m *ItineraryMutation) SetUpdatedBy(s string) {
+	m.updated_by = &s
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *ItineraryMutation) UpdatedBy() (r string, exists bool) {
+	v := m.updated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedBy returns the old "updated_by" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	}
+	return oldValue.UpdatedBy, nil
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *ItineraryMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.clearedFields[itinerary.FieldUpdatedBy] = struct{}{}
+}
+
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *ItineraryMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[itinerary.FieldUpdatedBy]
+	return ok
+}
+
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *ItineraryMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	delete(m.clearedFields, itinerary.FieldUpdatedBy)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ItineraryMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ItineraryMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *ItineraryMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[itinerary.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *ItineraryMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[itinerary.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ItineraryMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, itinerary.FieldDeletedAt)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *ItineraryMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *ItineraryMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedBy returns the old "deleted_by" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+	}
+	return oldValue.DeletedBy, nil
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *ItineraryMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[itinerary.FieldDeletedBy] = struct{}{}
+}
+
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *ItineraryMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[itinerary.FieldDeletedBy]
+	return ok
+}
+
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *ItineraryMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, itinerary.FieldDeletedBy)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *ItineraryMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *ItineraryMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *ItineraryMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[itinerary.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *ItineraryMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[itinerary.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *ItineraryMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, itinerary.FieldMetadata)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ItineraryMutation) SetUserID(s string) {
+	m.user = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ItineraryMutation) UserID() (r string, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ItineraryMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *ItineraryMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ItineraryMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ItineraryMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ItineraryMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ItineraryMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ItineraryMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[itinerary.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ItineraryMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[itinerary.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ItineraryMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, itinerary.FieldDescription)
+}
+
+// SetPlannedDate sets the "planned_date" field.
+func (m *ItineraryMutation) SetPlannedDate(t time.Time) {
+	m.planned_date = &t
+}
+
+// PlannedDate returns the value of the "planned_date" field in the mutation.
+func (m *ItineraryMutation) PlannedDate() (r time.Time, exists bool) {
+	v := m.planned_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlannedDate returns the old "planned_date" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldPlannedDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlannedDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlannedDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlannedDate: %w", err)
+	}
+	return oldValue.PlannedDate, nil
+}
+
+// ResetPlannedDate resets all changes to the "planned_date" field.
+func (m *ItineraryMutation) ResetPlannedDate() {
+	m.planned_date = nil
+}
+
+// SetStartLatitude sets the "start_latitude" field.
+func (m *ItineraryMutation) SetStartLatitude(d decimal.Decimal) {
+	m.start_latitude = &d
+}
+
+// StartLatitude returns the value of the "start_latitude" field in the mutation.
+func (m *ItineraryMutation) StartLatitude() (r decimal.Decimal, exists bool) {
+	v := m.start_latitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartLatitude returns the old "start_latitude" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldStartLatitude(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartLatitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartLatitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartLatitude: %w", err)
+	}
+	return oldValue.StartLatitude, nil
+}
+
+// ResetStartLatitude resets all changes to the "start_latitude" field.
+func (m *ItineraryMutation) ResetStartLatitude() {
+	m.start_latitude = nil
+}
+
+// SetStartLongitude sets the "start_longitude" field.
+func (m *ItineraryMutation) SetStartLongitude(d decimal.Decimal) {
+	m.start_longitude = &d
+}
+
+// StartLongitude returns the value of the "start_longitude" field in the mutation.
+func (m *ItineraryMutation) StartLongitude() (r decimal.Decimal, exists bool) {
+	v := m.start_longitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartLongitude returns the old "start_longitude" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldStartLongitude(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartLongitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartLongitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartLongitude: %w", err)
+	}
+	return oldValue.StartLongitude, nil
+}
+
+// ResetStartLongitude resets all changes to the "start_longitude" field.
+func (m *ItineraryMutation) ResetStartLongitude() {
+	m.start_longitude = nil
+}
+
+// SetPreferredTransportMode sets the "preferred_transport_mode" field.
+func (m *ItineraryMutation) SetPreferredTransportMode(s string) {
+	m.preferred_transport_mode = &s
+}
+
+// PreferredTransportMode returns the value of the "preferred_transport_mode" field in the mutation.
+func (m *ItineraryMutation) PreferredTransportMode() (r string, exists bool) {
+	v := m.preferred_transport_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreferredTransportMode returns the old "preferred_transport_mode" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldPreferredTransportMode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreferredTransportMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreferredTransportMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreferredTransportMode: %w", err)
+	}
+	return oldValue.PreferredTransportMode, nil
+}
+
+// ResetPreferredTransportMode resets all changes to the "preferred_transport_mode" field.
+func (m *ItineraryMutation) ResetPreferredTransportMode() {
+	m.preferred_transport_mode = nil
+}
+
+// SetTotalDistanceKm sets the "total_distance_km" field.
+func (m *ItineraryMutation) SetTotalDistanceKm(f float64) {
+	m.total_distance_km = &f
+	m.addtotal_distance_km = nil
+}
+
+// TotalDistanceKm returns the value of the "total_distance_km" field in the mutation.
+func (m *ItineraryMutation) TotalDistanceKm() (r float64, exists bool) {
+	v := m.total_distance_km
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalDistanceKm returns the old "total_distance_km" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldTotalDistanceKm(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalDistanceKm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalDistanceKm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalDistanceKm: %w", err)
+	}
+	return oldValue.TotalDistanceKm, nil
+}
+
+// AddTotalDistanceKm adds f to the "total_distance_km" field.
+func (m *ItineraryMutation) AddTotalDistanceKm(f float64) {
+	if m.addtotal_distance_km != nil {
+		*m.addtotal_distance_km += f
+	} else {
+		m.addtotal_distance_km = &f
+	}
+}
+
+// AddedTotalDistanceKm returns the value that was added to the "total_distance_km" field in this mutation.
+func (m *ItineraryMutation) AddedTotalDistanceKm() (r float64, exists bool) {
+	v := m.addtotal_distance_km
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTotalDistanceKm clears the value of the "total_distance_km" field.
+func (m *ItineraryMutation) ClearTotalDistanceKm() {
+	m.total_distance_km = nil
+	m.addtotal_distance_km = nil
+	m.clearedFields[itinerary.FieldTotalDistanceKm] = struct{}{}
+}
+
+// TotalDistanceKmCleared returns if the "total_distance_km" field was cleared in this mutation.
+func (m *ItineraryMutation) TotalDistanceKmCleared() bool {
+	_, ok := m.clearedFields[itinerary.FieldTotalDistanceKm]
+	return ok
+}
+
+// ResetTotalDistanceKm resets all changes to the "total_distance_km" field.
+func (m *ItineraryMutation) ResetTotalDistanceKm() {
+	m.total_distance_km = nil
+	m.addtotal_distance_km = nil
+	delete(m.clearedFields, itinerary.FieldTotalDistanceKm)
+}
+
+// SetTotalDurationMinutes sets the "total_duration_minutes" field.
+func (m *ItineraryMutation) SetTotalDurationMinutes(i int) {
+	m.total_duration_minutes = &i
+	m.addtotal_duration_minutes = nil
+}
+
+// TotalDurationMinutes returns the value of the "total_duration_minutes" field in the mutation.
+func (m *ItineraryMutation) TotalDurationMinutes() (r int, exists bool) {
+	v := m.total_duration_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalDurationMinutes returns the old "total_duration_minutes" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldTotalDurationMinutes(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalDurationMinutes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalDurationMinutes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalDurationMinutes: %w", err)
+	}
+	return oldValue.TotalDurationMinutes, nil
+}
+
+// AddTotalDurationMinutes adds i to the "total_duration_minutes" field.
+func (m *ItineraryMutation) AddTotalDurationMinutes(i int) {
+	if m.addtotal_duration_minutes != nil {
+		*m.addtotal_duration_minutes += i
+	} else {
+		m.addtotal_duration_minutes = &i
+	}
+}
+
+// AddedTotalDurationMinutes returns the value that was added to the "total_duration_minutes" field in this mutation.
+func (m *ItineraryMutation) AddedTotalDurationMinutes() (r int, exists bool) {
+	v := m.addtotal_duration_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTotalDurationMinutes clears the value of the "total_duration_minutes" field.
+func (m *ItineraryMutation) ClearTotalDurationMinutes() {
+	m.total_duration_minutes = nil
+	m.addtotal_duration_minutes = nil
+	m.clearedFields[itinerary.FieldTotalDurationMinutes] = struct{}{}
+}
+
+// TotalDurationMinutesCleared returns if the "total_duration_minutes" field was cleared in this mutation.
+func (m *ItineraryMutation) TotalDurationMinutesCleared() bool {
+	_, ok := m.clearedFields[itinerary.FieldTotalDurationMinutes]
+	return ok
+}
+
+// ResetTotalDurationMinutes resets all changes to the "total_duration_minutes" field.
+func (m *ItineraryMutation) ResetTotalDurationMinutes() {
+	m.total_duration_minutes = nil
+	m.addtotal_duration_minutes = nil
+	delete(m.clearedFields, itinerary.FieldTotalDurationMinutes)
+}
+
+// SetTotalVisitTimeMinutes sets the "total_visit_time_minutes" field.
+func (m *ItineraryMutation) SetTotalVisitTimeMinutes(i int) {
+	m.total_visit_time_minutes = &i
+	m.addtotal_visit_time_minutes = nil
+}
+
+// TotalVisitTimeMinutes returns the value of the "total_visit_time_minutes" field in the mutation.
+func (m *ItineraryMutation) TotalVisitTimeMinutes() (r int, exists bool) {
+	v := m.total_visit_time_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalVisitTimeMinutes returns the old "total_visit_time_minutes" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldTotalVisitTimeMinutes(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalVisitTimeMinutes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalVisitTimeMinutes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalVisitTimeMinutes: %w", err)
+	}
+	return oldValue.TotalVisitTimeMinutes, nil
+}
+
+// AddTotalVisitTimeMinutes adds i to the "total_visit_time_minutes" field.
+func (m *ItineraryMutation) AddTotalVisitTimeMinutes(i int) {
+	if m.addtotal_visit_time_minutes != nil {
+		*m.addtotal_visit_time_minutes += i
+	} else {
+		m.addtotal_visit_time_minutes = &i
+	}
+}
+
+// AddedTotalVisitTimeMinutes returns the value that was added to the "total_visit_time_minutes" field in this mutation.
+func (m *ItineraryMutation) AddedTotalVisitTimeMinutes() (r int, exists bool) {
+	v := m.addtotal_visit_time_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTotalVisitTimeMinutes clears the value of the "total_visit_time_minutes" field.
+func (m *ItineraryMutation) ClearTotalVisitTimeMinutes() {
+	m.total_visit_time_minutes = nil
+	m.addtotal_visit_time_minutes = nil
+	m.clearedFields[itinerary.FieldTotalVisitTimeMinutes] = struct{}{}
+}
+
+// TotalVisitTimeMinutesCleared returns if the "total_visit_time_minutes" field was cleared in this mutation.
+func (m *ItineraryMutation) TotalVisitTimeMinutesCleared() bool {
+	_, ok := m.clearedFields[itinerary.FieldTotalVisitTimeMinutes]
+	return ok
+}
+
+// ResetTotalVisitTimeMinutes resets all changes to the "total_visit_time_minutes" field.
+func (m *ItineraryMutation) ResetTotalVisitTimeMinutes() {
+	m.total_visit_time_minutes = nil
+	m.addtotal_visit_time_minutes = nil
+	delete(m.clearedFields, itinerary.FieldTotalVisitTimeMinutes)
+}
+
+// SetIsOptimized sets the "is_optimized" field.
+func (m *ItineraryMutation) SetIsOptimized(b bool) {
+	m.is_optimized = &b
+}
+
+// IsOptimized returns the value of the "is_optimized" field in the mutation.
+func (m *ItineraryMutation) IsOptimized() (r bool, exists bool) {
+	v := m.is_optimized
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsOptimized returns the old "is_optimized" field's value of the Itinerary entity.
+// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItineraryMutation) OldIsOptimized(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsOptimized is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsOptimized requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsOptimized: %w", err)
+	}
+	return oldValue.IsOptimized, nil
+}
+
+// ResetIsOptimized resets all changes to the "is_optimized" field.
+func (m *ItineraryMutation) ResetIsOptimized() {
+	m.is_optimized = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ItineraryMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[itinerary.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ItineraryMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ItineraryMutation) UserIDs() (ids []string) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ItineraryMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddVisitIDs adds the "visits" edge to the Visit entity by ids.
+func (m *ItineraryMutation) AddVisitIDs(ids ...string) {
+	if m.visits == nil {
+		m.visits = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.visits[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVisits clears the "visits" edge to the Visit entity.
+func (m *ItineraryMutation) ClearVisits() {
+	m.clearedvisits = true
+}
+
+// VisitsCleared reports if the "visits" edge to the Visit entity was cleared.
+func (m *ItineraryMutation) VisitsCleared() bool {
+	return m.clearedvisits
+}
+
+// RemoveVisitIDs removes the "visits" edge to the Visit entity by IDs.
+func (m *ItineraryMutation) RemoveVisitIDs(ids ...string) {
+	if m.removedvisits == nil {
+		m.removedvisits = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.visits, ids[i])
+		m.removedvisits[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVisits returns the removed IDs of the "visits" edge to the Visit entity.
+func (m *ItineraryMutation) RemovedVisitsIDs() (ids []string) {
+	for id := range m.removedvisits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VisitsIDs returns the "visits" edge IDs in the mutation.
+func (m *ItineraryMutation) VisitsIDs() (ids []string) {
+	for id := range m.visits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVisits resets all changes to the "visits" edge.
+func (m *ItineraryMutation) ResetVisits() {
+	m.visits = nil
+	m.clearedvisits = false
+	m.removedvisits = nil
+}
+
+// Where appends a list predicates to the ItineraryMutation builder.
+func (m *ItineraryMutation) Where(ps ...predicate.Itinerary) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItineraryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItineraryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Itinerary, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItineraryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItineraryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Itinerary).
+func (m *ItineraryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItineraryMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.status != nil {
+		fields = append(fields, itinerary.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, itinerary.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, itinerary.FieldUpdatedAt)
+	}
+	if m.created_by != nil {
+		fields = append(fields, itinerary.FieldCreatedBy)
+	}
+	if m.updated_by != nil {
+		fields = append(fields, itinerary.FieldUpdatedBy)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, itinerary.FieldDeletedAt)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, itinerary.FieldDeletedBy)
+	}
+	if m.metadata != nil {
+		fields = append(fields, itinerary.FieldMetadata)
+	}
+	if m.user != nil {
+		fields = append(fields, itinerary.FieldUserID)
+	}
+	if m.title != nil {
+		fields = append(fields, itinerary.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, itinerary.FieldDescription)
+	}
+	if m.planned_date != nil {
+		fields = append(fields, itinerary.FieldPlannedDate)
+	}
+	if m.start_latitude != nil {
+		fields = append(fields, itinerary.FieldStartLatitude)
+	}
+	if m.start_longitude != nil {
+		fields = append(fields, itinerary.FieldStartLongitude)
+	}
+	if m.preferred_transport_mode != nil {
+		fields = append(fields, itinerary.FieldPreferredTransportMode)
+	}
+	if m.total_distance_km != nil {
+		fields = append(fields, itinerary.FieldTotalDistanceKm)
+	}
+	if m.total_duration_minutes != nil {
+		fields = append(fields, itinerary.FieldTotalDurationMinutes)
+	}
+	if m.total_visit_time_minutes != nil {
+		fields = append(fields, itinerary.FieldTotalVisitTimeMinutes)
+	}
+	if m.is_optimized != nil {
+		fields = append(fields, itinerary.FieldIsOptimized)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItineraryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case itinerary.FieldStatus:
+		return m.Status()
+	case itinerary.FieldCreatedAt:
+		return m.CreatedAt()
+	case itinerary.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case itinerary.FieldCreatedBy:
+		return m.CreatedBy()
+	case itinerary.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case itinerary.FieldDeletedAt:
+		return m.DeletedAt()
+	case itinerary.FieldDeletedBy:
+		return m.DeletedBy()
+	case itinerary.FieldMetadata:
+		return m.Metadata()
+	case itinerary.FieldUserID:
+		return m.UserID()
+	case itinerary.FieldTitle:
+		return m.Title()
+	case itinerary.FieldDescription:
+		return m.Description()
+	case itinerary.FieldPlannedDate:
+		return m.PlannedDate()
+	case itinerary.FieldStartLatitude:
+		return m.StartLatitude()
+	case itinerary.FieldStartLongitude:
+		return m.StartLongitude()
+	case itinerary.FieldPreferredTransportMode:
+		return m.PreferredTransportMode()
+	case itinerary.FieldTotalDistanceKm:
+		return m.TotalDistanceKm()
+	case itinerary.FieldTotalDurationMinutes:
+		return m.TotalDurationMinutes()
+	case itinerary.FieldTotalVisitTimeMinutes:
+		return m.TotalVisitTimeMinutes()
+	case itinerary.FieldIsOptimized:
+		return m.IsOptimized()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItineraryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case itinerary.FieldStatus:
+		return m.OldStatus(ctx)
+	case itinerary.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case itinerary.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case itinerary.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case itinerary.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case itinerary.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case itinerary.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	case itinerary.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case itinerary.FieldUserID:
+		return m.OldUserID(ctx)
+	case itinerary.FieldTitle:
+		return m.OldTitle(ctx)
+	case itinerary.FieldDescription:
+		return m.OldDescription(ctx)
+	case itinerary.FieldPlannedDate:
+		return m.OldPlannedDate(ctx)
+	case itinerary.FieldStartLatitude:
+		return m.OldStartLatitude(ctx)
+	case itinerary.FieldStartLongitude:
+		return m.OldStartLongitude(ctx)
+	case itinerary.FieldPreferredTransportMode:
+		return m.OldPreferredTransportMode(ctx)
+	case itinerary.FieldTotalDistanceKm:
+		return m.OldTotalDistanceKm(ctx)
+	case itinerary.FieldTotalDurationMinutes:
+		return m.OldTotalDurationMinutes(ctx)
+	case itinerary.FieldTotalVisitTimeMinutes:
+		return m.OldTotalVisitTimeMinutes(ctx)
+	case itinerary.FieldIsOptimized:
+		return m.OldIsOptimized(ctx)
+	}
+	return nil, fmt.Errorf("unknown Itinerary field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItineraryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case itinerary.FieldStatus:
+		v, ok := value.(types.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case itinerary.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case itinerary.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case itinerary.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case itinerary.FieldUpdatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case itinerary.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case itinerary.FieldDeletedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
+	case itinerary.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case itinerary.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case itinerary.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case itinerary.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case itinerary.FieldPlannedDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlannedDate(v)
+		return nil
+	case itinerary.FieldStartLatitude:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartLatitude(v)
+		return nil
+	case itinerary.FieldStartLongitude:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartLongitude(v)
+		return nil
+	case itinerary.FieldPreferredTransportMode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreferredTransportMode(v)
+		return nil
+	case itinerary.FieldTotalDistanceKm:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalDistanceKm(v)
+		return nil
+	case itinerary.FieldTotalDurationMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalDurationMinutes(v)
+		return nil
+	case itinerary.FieldTotalVisitTimeMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalVisitTimeMinutes(v)
+		return nil
+	case itinerary.FieldIsOptimized:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsOptimized(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Itinerary field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItineraryMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotal_distance_km != nil {
+		fields = append(fields, itinerary.FieldTotalDistanceKm)
+	}
+	if m.addtotal_duration_minutes != nil {
+		fields = append(fields, itinerary.FieldTotalDurationMinutes)
+	}
+	if m.addtotal_visit_time_minutes != nil {
+		fields = append(fields, itinerary.FieldTotalVisitTimeMinutes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItineraryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case itinerary.FieldTotalDistanceKm:
+		return m.AddedTotalDistanceKm()
+	case itinerary.FieldTotalDurationMinutes:
+		return m.AddedTotalDurationMinutes()
+	case itinerary.FieldTotalVisitTimeMinutes:
+		return m.AddedTotalVisitTimeMinutes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItineraryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case itinerary.FieldTotalDistanceKm:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalDistanceKm(v)
+		return nil
+	case itinerary.FieldTotalDurationMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalDurationMinutes(v)
+		return nil
+	case itinerary.FieldTotalVisitTimeMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalVisitTimeMinutes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Itinerary numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItineraryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(itinerary.FieldCreatedBy) {
+		fields = append(fields, itinerary.FieldCreatedBy)
+	}
+	if m.FieldCleared(itinerary.FieldUpdatedBy) {
+		fields = append(fields, itinerary.FieldUpdatedBy)
+	}
+	if m.FieldCleared(itinerary.FieldDeletedAt) {
+		fields = append(fields, itinerary.FieldDeletedAt)
+	}
+	if m.FieldCleared(itinerary.FieldDeletedBy) {
+		fields = append(fields, itinerary.FieldDeletedBy)
+	}
+	if m.FieldCleared(itinerary.FieldMetadata) {
+		fields = append(fields, itinerary.FieldMetadata)
+	}
+	if m.FieldCleared(itinerary.FieldDescription) {
+		fields = append(fields, itinerary.FieldDescription)
+	}
+	if m.FieldCleared(itinerary.FieldTotalDistanceKm) {
+		fields = append(fields, itinerary.FieldTotalDistanceKm)
+	}
+	if m.FieldCleared(itinerary.FieldTotalDurationMinutes) {
+		fields = append(fields, itinerary.FieldTotalDurationMinutes)
+	}
+	if m.FieldCleared(itinerary.FieldTotalVisitTimeMinutes) {
+		fields = append(fields, itinerary.FieldTotalVisitTimeMinutes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItineraryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItineraryMutation) ClearField(name string) error {
+	switch name {
+	case itinerary.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case itinerary.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	case itinerary.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case itinerary.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
+	case itinerary.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case itinerary.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case itinerary.FieldTotalDistanceKm:
+		m.ClearTotalDistanceKm()
+		return nil
+	case itinerary.FieldTotalDurationMinutes:
+		m.ClearTotalDurationMinutes()
+		return nil
+	case itinerary.FieldTotalVisitTimeMinutes:
+		m.ClearTotalVisitTimeMinutes()
+		return nil
+	}
+	return fmt.Errorf("unknown Itinerary nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItineraryMutation) ResetField(name string) error {
+	switch name {
+	case itinerary.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case itinerary.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case itinerary.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case itinerary.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case itinerary.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case itinerary.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case itinerary.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
+	case itinerary.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case itinerary.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case itinerary.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case itinerary.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case itinerary.FieldPlannedDate:
+		m.ResetPlannedDate()
+		return nil
+	case itinerary.FieldStartLatitude:
+		m.ResetStartLatitude()
+		return nil
+	case itinerary.FieldStartLongitude:
+		m.ResetStartLongitude()
+		return nil
+	case itinerary.FieldPreferredTransportMode:
+		m.ResetPreferredTransportMode()
+		return nil
+	case itinerary.FieldTotalDistanceKm:
+		m.ResetTotalDistanceKm()
+		return nil
+	case itinerary.FieldTotalDurationMinutes:
+		m.ResetTotalDurationMinutes()
+		return nil
+	case itinerary.FieldTotalVisitTimeMinutes:
+		m.ResetTotalVisitTimeMinutes()
+		return nil
+	case itinerary.FieldIsOptimized:
+		m.ResetIsOptimized()
+		return nil
+	}
+	return fmt.Errorf("unknown Itinerary field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItineraryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, itinerary.EdgeUser)
+	}
+	if m.visits != nil {
+		edges = append(edges, itinerary.EdgeVisits)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItineraryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case itinerary.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case itinerary.EdgeVisits:
+		ids := make([]ent.Value, 0, len(m.visits))
+		for id := range m.visits {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItineraryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedvisits != nil {
+		edges = append(edges, itinerary.EdgeVisits)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItineraryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case itinerary.EdgeVisits:
+		ids := make([]ent.Value, 0, len(m.removedvisits))
+		for id := range m.removedvisits {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItineraryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, itinerary.EdgeUser)
+	}
+	if m.clearedvisits {
+		edges = append(edges, itinerary.EdgeVisits)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItineraryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case itinerary.EdgeUser:
+		return m.cleareduser
+	case itinerary.EdgeVisits:
+		return m.clearedvisits
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItineraryMutation) ClearEdge(name string) error {
+	switch name {
+	case itinerary.EdgeUser:
+		m.ClearUser()
 		return nil
-	case hotel.FieldLongitude:
-		v, ok := value.(decimal.Decimal)
+	}
+	return fmt.Errorf("unknown Itinerary unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItineraryMutation) ResetEdge(name string) error {
+	switch name {
+	case itinerary.EdgeUser:
+		m.ResetUser()
+		return nil
+	case itinerary.EdgeVisits:
+		m.ResetVisits()
+		return nil
+	}
+	return fmt.Errorf("unknown Itinerary edge %s", name)
+}
+
+// OutboxEventMutation represents an operation that mutates the OutboxEvent nodes in the graph.
+type OutboxEventMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	event_type     *string
+	aggregate_type *string
+	aggregate_id   *string
+	payload        *json.RawMessage
+	appendpayload  json.RawMessage
+	status         *types.OutboxStatus
+	attempts       *int
+	addattempts    *int
+	last_error     *string
+	available_at   *time.Time
+	sent_at        *time.Time
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*OutboxEvent, error)
+	predicates     []predicate.OutboxEvent
+}
+
+var _ ent.Mutation = (*OutboxEventMutation)(nil)
+
+// outboxeventOption allows management of the mutation configuration using functional options.
+type outboxeventOption func(*OutboxEventMutation)
+
+// newOutboxEventMutation creates new mutation for the OutboxEvent entity.
+func newOutboxEventMutation(c config, op Op, opts ...outboxeventOption) *OutboxEventMutation {
+	m := &OutboxEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOutboxEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOutboxEventID sets the ID field of the mutation.
+func withOutboxEventID(id string) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OutboxEvent
+		)
+		m.oldValue = func(ctx context.Context) (*OutboxEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OutboxEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOutboxEvent sets the old OutboxEvent of the mutation.
+func withOutboxEvent(node *OutboxEvent) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		m.oldValue = func(context.Context) (*OutboxEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OutboxEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OutboxEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OutboxEvent entities.
+func (m *OutboxEventMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OutboxEventMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OutboxEventMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OutboxEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEventType sets the "event_type" field.
+func (m *OutboxEventMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *OutboxEventMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *OutboxEventMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetAggregateType sets the "aggregate_type" field.
+func (m *OutboxEventMutation) SetAggregateType(s string) {
+	m.aggregate_type = &s
+}
+
+// AggregateType returns the value of the "aggregate_type" field in the mutation.
+func (m *OutboxEventMutation) AggregateType() (r string, exists bool) {
+	v := m.aggregate_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAggregateType returns the old "aggregate_type" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAggregateType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAggregateType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAggregateType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAggregateType: %w", err)
+	}
+	return oldValue.AggregateType, nil
+}
+
+// ResetAggregateType resets all changes to the "aggregate_type" field.
+func (m *OutboxEventMutation) ResetAggregateType() {
+	m.aggregate_type = nil
+}
+
+// SetAggregateID sets the "aggregate_id" field.
+func (m *OutboxEventMutation) SetAggregateID(s string) {
+	m.aggregate_id = &s
+}
+
+// AggregateID returns the value of the "aggregate_id" field in the mutation.
+func (m *OutboxEventMutation) AggregateID() (r string, exists bool) {
+	v := m.aggregate_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAggregateID returns the old "aggregate_id" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAggregateID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAggregateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAggregateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAggregateID: %w", err)
+	}
+	return oldValue.AggregateID, nil
+}
+
+// ResetAggregateID resets all changes to the "aggregate_id" field.
+func (m *OutboxEventMutation) ResetAggregateID() {
+	m.aggregate_id = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *OutboxEventMutation) SetPayload(jm json.RawMessage) {
+	m.payload = &jm
+	m.appendpayload = nil
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *OutboxEventMutation) Payload() (r json.RawMessage, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldPayload(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// AppendPayload adds jm to the "payload" field.
+func (m *OutboxEventMutation) AppendPayload(jm json.RawMessage) {
+	m.appendpayload = append(m.appendpayload, jm...)
+}
+
+// AppendedPayload returns the list of values that were appended to the "payload" field in this mutation.
+func (m *OutboxEventMutation) AppendedPayload() (json.RawMessage, bool) {
+	if len(m.appendpayload) == 0 {
+		return nil, false
+	}
+	return m.appendpayload, true
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *OutboxEventMutation) ResetPayload() {
+	m.payload = nil
+	m.appendpayload = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *OutboxEventMutation) SetStatus(ts types.OutboxStatus) {
+	m.status = &ts
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *OutboxEventMutation) Status() (r types.OutboxStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldStatus(ctx context.Context) (v types.OutboxStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *OutboxEventMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *OutboxEventMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *OutboxEventMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *OutboxEventMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *OutboxEventMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *OutboxEventMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *OutboxEventMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *OutboxEventMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *OutboxEventMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[outboxevent.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *OutboxEventMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *OutboxEventMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, outboxevent.FieldLastError)
+}
+
+// SetAvailableAt sets the "available_at" field.
+func (m *OutboxEventMutation) SetAvailableAt(t time.Time) {
+	m.available_at = &t
+}
+
+// AvailableAt returns the value of the "available_at" field in the mutation.
+func (m *OutboxEventMutation) AvailableAt() (r time.Time, exists bool) {
+	v := m.available_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvailableAt returns the old "available_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAvailableAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvailableAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvailableAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvailableAt: %w", err)
+	}
+	return oldValue.AvailableAt, nil
+}
+
+// ResetAvailableAt resets all changes to the "available_at" field.
+func (m *OutboxEventMutation) ResetAvailableAt() {
+	m.available_at = nil
+}
+
+// SetSentAt sets the "sent_at" field.
+func (m *OutboxEventMutation) SetSentAt(t time.Time) {
+	m.sent_at = &t
+}
+
+// SentAt returns the value of the "sent_at" field in the mutation.
+func (m *OutboxEventMutation) SentAt() (r time.Time, exists bool) {
+	v := m.sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentAt returns the old "sent_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldSentAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+	}
+	return oldValue.SentAt, nil
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (m *OutboxEventMutation) ClearSentAt() {
+	m.sent_at = nil
+	m.clearedFields[outboxevent.FieldSentAt] = struct{}{}
+}
+
+// SentAtCleared returns if the "sent_at" field was cleared in this mutation.
+func (m *OutboxEventMutation) SentAtCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldSentAt]
+	return ok
+}
+
+// ResetSentAt resets all changes to the "sent_at" field.
+func (m *OutboxEventMutation) ResetSentAt() {
+	m.sent_at = nil
+	delete(m.clearedFields, outboxevent.FieldSentAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OutboxEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OutboxEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OutboxEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the OutboxEventMutation builder.
+func (m *OutboxEventMutation) Where(ps ...predicate.OutboxEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OutboxEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OutboxEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OutboxEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OutboxEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OutboxEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OutboxEvent).
+func (m *OutboxEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OutboxEventMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.event_type != nil {
+		fields = append(fields, outboxevent.FieldEventType)
+	}
+	if m.aggregate_type != nil {
+		fields = append(fields, outboxevent.FieldAggregateType)
+	}
+	if m.aggregate_id != nil {
+		fields = append(fields, outboxevent.FieldAggregateID)
+	}
+	if m.payload != nil {
+		fields = append(fields, outboxevent.FieldPayload)
+	}
+	if m.status != nil {
+		fields = append(fields, outboxevent.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	if m.last_error != nil {
+		fields = append(fields, outboxevent.FieldLastError)
+	}
+	if m.available_at != nil {
+		fields = append(fields, outboxevent.FieldAvailableAt)
+	}
+	if m.sent_at != nil {
+		fields = append(fields, outboxevent.FieldSentAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, outboxevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OutboxEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldEventType:
+		return m.EventType()
+	case outboxevent.FieldAggregateType:
+		return m.AggregateType()
+	case outboxevent.FieldAggregateID:
+		return m.AggregateID()
+	case outboxevent.FieldPayload:
+		return m.Payload()
+	case outboxevent.FieldStatus:
+		return m.Status()
+	case outboxevent.FieldAttempts:
+		return m.Attempts()
+	case outboxevent.FieldLastError:
+		return m.LastError()
+	case outboxevent.FieldAvailableAt:
+		return m.AvailableAt()
+	case outboxevent.FieldSentAt:
+		return m.SentAt()
+	case outboxevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OutboxEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case outboxevent.FieldEventType:
+		return m.OldEventType(ctx)
+	case outboxevent.FieldAggregateType:
+		return m.OldAggregateType(ctx)
+	case outboxevent.FieldAggregateID:
+		return m.OldAggregateID(ctx)
+	case outboxevent.FieldPayload:
+		return m.OldPayload(ctx)
+	case outboxevent.FieldStatus:
+		return m.OldStatus(ctx)
+	case outboxevent.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case outboxevent.FieldLastError:
+		return m.OldLastError(ctx)
+	case outboxevent.FieldAvailableAt:
+		return m.OldAvailableAt(ctx)
+	case outboxevent.FieldSentAt:
+		return m.OldSentAt(ctx)
+	case outboxevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldEventType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLongitude(v)
+		m.SetEventType(v)
 		return nil
-	case hotel.FieldPhone:
+	case outboxevent.FieldAggregateType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPhone(v)
+		m.SetAggregateType(v)
 		return nil
-	case hotel.FieldEmail:
+	case outboxevent.FieldAggregateID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetAggregateID(v)
 		return nil
-	case hotel.FieldWebsite:
+	case outboxevent.FieldPayload:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case outboxevent.FieldStatus:
+		v, ok := value.(types.OutboxStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case outboxevent.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case outboxevent.FieldLastError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWebsite(v)
+		m.SetLastError(v)
+		return nil
+	case outboxevent.FieldAvailableAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvailableAt(v)
+		return nil
+	case outboxevent.FieldSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentAt(v)
+		return nil
+	case outboxevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OutboxEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OutboxEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OutboxEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(outboxevent.FieldLastError) {
+		fields = append(fields, outboxevent.FieldLastError)
+	}
+	if m.FieldCleared(outboxevent.FieldSentAt) {
+		fields = append(fields, outboxevent.FieldSentAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OutboxEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ClearField(name string) error {
+	switch name {
+	case outboxevent.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case outboxevent.FieldSentAt:
+		m.ClearSentAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ResetField(name string) error {
+	switch name {
+	case outboxevent.FieldEventType:
+		m.ResetEventType()
 		return nil
-	case hotel.FieldPrimaryImageURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPrimaryImageURL(v)
+	case outboxevent.FieldAggregateType:
+		m.ResetAggregateType()
 		return nil
-	case hotel.FieldThumbnailURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetThumbnailURL(v)
+	case outboxevent.FieldAggregateID:
+		m.ResetAggregateID()
 		return nil
-	case hotel.FieldPriceMin:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPriceMin(v)
+	case outboxevent.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case hotel.FieldPriceMax:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPriceMax(v)
+	case outboxevent.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case hotel.FieldCurrency:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCurrency(v)
+	case outboxevent.FieldAttempts:
+		m.ResetAttempts()
 		return nil
-	case hotel.FieldViewCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetViewCount(v)
+	case outboxevent.FieldLastError:
+		m.ResetLastError()
 		return nil
-	case hotel.FieldRatingAvg:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRatingAvg(v)
+	case outboxevent.FieldAvailableAt:
+		m.ResetAvailableAt()
 		return nil
-	case hotel.FieldRatingCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRatingCount(v)
+	case outboxevent.FieldSentAt:
+		m.ResetSentAt()
 		return nil
-	case hotel.FieldLastViewedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastViewedAt(v)
+	case outboxevent.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case hotel.FieldPopularityScore:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OutboxEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OutboxEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OutboxEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OutboxEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OutboxEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OutboxEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OutboxEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OutboxEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent edge %s", name)
+}
+
+// PlaceMutation represents an operation that mutates the Place nodes in the graph.
+type PlaceMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *string
+	status               *types.Status
+	created_at           *time.Time
+	updated_at           *time.Time
+	created_by           *string
+	updated_by           *string
+	deleted_at           *time.Time
+	deleted_by           *string
+	metadata             *map[string]string
+	slug                 *string
+	title                *string
+	subtitle             *string
+	short_description    *string
+	long_description     *string
+	place_type           *string
+	address              *map[string]string
+	latitude             *decimal.Decimal
+	longitude            *decimal.Decimal
+	geohash              *string
+	primary_image_url    *string
+	thumbnail_url        *string
+	view_count           *int
+	addview_count        *int
+	rating_avg           *decimal.Decimal
+	rating_count         *int
+	addrating_count      *int
+	last_viewed_at       *time.Time
+	popularity_score     *decimal.Decimal
+	avg_visit_minutes    *int
+	addavg_visit_minutes *int
+	opening_hours        *map[string]string
+	price_info           **types.PriceInfo
+	contact              **types.Contact
+	accessibility        **types.Accessibility
+	external_refs        *map[string]string
+	geofence_radius_m    *int
+	addgeofence_radius_m *int
+	publish_at           *time.Time
+	unpublish_at         *time.Time
+	clearedFields        map[string]struct{}
+	images               map[string]struct{}
+	removedimages        map[string]struct{}
+	clearedimages        bool
+	category             map[string]struct{}
+	removedcategory      map[string]struct{}
+	clearedcategory      bool
+	visits               map[string]struct{}
+	removedvisits        map[string]struct{}
+	clearedvisits        bool
+	revisions            map[string]struct{}
+	removedrevisions     map[string]struct{}
+	clearedrevisions     bool
+	slug_history         map[string]struct{}
+	removedslug_history  map[string]struct{}
+	clearedslug_history  bool
+	done                 bool
+	oldValue             func(context.Context) (*Place, error)
+	predicates           []predicate.Place
+}
+
+var _ ent.Mutation = (*PlaceMutation)(nil)
+
+// placeOption allows management of the mutation configuration using functional options.
+type placeOption func(*PlaceMutation)
+
+// newPlaceMutation creates new mutation for the Place entity.
+func newPlaceMutation(c config, op Op, opts ...placeOption) *PlaceMutation {
+	m := &PlaceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlace,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlaceID sets the ID field of the mutation.
+func withPlaceID(id string) placeOption {
+	return func(m *PlaceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Place
+		)
+		m.oldValue = func(ctx context.Context) (*Place, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Place.Get(ctx, id)
+				}
+			})
+			return value, err
 		}
-		m.SetPopularityScore(v)
-		return nil
+		m.id = &id
+	}
+}
+
+// withPlace sets the old Place of the mutation.
+func withPlace(node *Place) placeOption {
+	return func(m *PlaceMutation) {
+		m.oldValue = func(context.Context) (*Place, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlaceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlaceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Place entities.
+func (m *PlaceMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlaceMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlaceMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Place.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStatus sets the "status" field.
+func (m *PlaceMutation) SetStatus(t types.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PlaceMutation) Status() (r types.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldStatus(ctx context.Context) (v types.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PlaceMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PlaceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PlaceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PlaceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PlaceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PlaceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PlaceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *PlaceMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *PlaceMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *PlaceMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[place.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *PlaceMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[place.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *PlaceMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, place.FieldCreatedBy)
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (m *PlaceMutation) SetUpdatedBy(s string) {
+	m.updated_by = &s
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *PlaceMutation) UpdatedBy() (r string, exists bool) {
+	v := m.updated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedBy returns the old "updated_by" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	}
+	return oldValue.UpdatedBy, nil
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *PlaceMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.clearedFields[place.FieldUpdatedBy] = struct{}{}
+}
+
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *PlaceMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[place.FieldUpdatedBy]
+	return ok
+}
+
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *PlaceMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	delete(m.clearedFields, place.FieldUpdatedBy)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PlaceMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PlaceMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PlaceMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[place.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PlaceMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[place.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PlaceMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, place.FieldDeletedAt)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *PlaceMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *PlaceMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedBy returns the old "deleted_by" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+	}
+	return oldValue.DeletedBy, nil
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *PlaceMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[place.FieldDeletedBy] = struct{}{}
+}
+
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *PlaceMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[place.FieldDeletedBy]
+	return ok
+}
+
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *PlaceMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, place.FieldDeletedBy)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *PlaceMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *PlaceMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *PlaceMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[place.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *PlaceMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[place.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *PlaceMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, place.FieldMetadata)
+}
+
+// SetSlug sets the "slug" field.
+func (m *PlaceMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *PlaceMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *PlaceMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *PlaceMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *PlaceMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *PlaceMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (m *PlaceMutation) SetSubtitle(s string) {
+	m.subtitle = &s
+}
+
+// Subtitle returns the value of the "subtitle" field in the mutation.
+func (m *PlaceMutation) Subtitle() (r string, exists bool) {
+	v := m.subtitle
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubtitle returns the old "subtitle" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldSubtitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubtitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubtitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubtitle: %w", err)
+	}
+	return oldValue.Subtitle, nil
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (m *PlaceMutation) ClearSubtitle() {
+	m.subtitle = nil
+	m.clearedFields[place.FieldSubtitle] = struct{}{}
+}
+
+// SubtitleCleared returns if the "subtitle" field was cleared in this mutation.
+func (m *PlaceMutation) SubtitleCleared() bool {
+	_, ok := m.clearedFields[place.FieldSubtitle]
+	return ok
+}
+
+// ResetSubtitle resets all changes to the "subtitle" field.
+func (m *PlaceMutation) ResetSubtitle() {
+	m.subtitle = nil
+	delete(m.clearedFields, place.FieldSubtitle)
+}
+
+// SetShortDescription sets the "short_description" field.
+func (m *PlaceMutation) SetShortDescription(s string) {
+	m.short_description = &s
+}
+
+// ShortDescription returns the value of the "short_description" field in the mutation.
+func (m *PlaceMutation) ShortDescription() (r string, exists bool) {
+	v := m.short_description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShortDescription returns the old "short_description" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldShortDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShortDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShortDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShortDescription: %w", err)
+	}
+	return oldValue.ShortDescription, nil
+}
+
+// ClearShortDescription clears the value of the "short_description" field.
+func (m *PlaceMutation) ClearShortDescription() {
+	m.short_description = nil
+	m.clearedFields[place.FieldShortDescription] = struct{}{}
+}
+
+// ShortDescriptionCleared returns if the "short_description" field was cleared in this mutation.
+func (m *PlaceMutation) ShortDescriptionCleared() bool {
+	_, ok := m.clearedFields[place.FieldShortDescription]
+	return ok
+}
+
+// ResetShortDescription resets all changes to the "short_description" field.
+func (m *PlaceMutation) ResetShortDescription() {
+	m.short_description = nil
+	delete(m.clearedFields, place.FieldShortDescription)
+}
+
+// SetLongDescription sets the "long_description" field.
+func (m *PlaceMutation) SetLongDescription(s string) {
+	m.long_description = &s
+}
+
+// LongDescription returns the value of the "long_description" field in the mutation.
+func (m *PlaceMutation) LongDescription() (r string, exists bool) {
+	v := m.long_description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLongDescription returns the old "long_description" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldLongDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLongDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLongDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLongDescription: %w", err)
+	}
+	return oldValue.LongDescription, nil
+}
+
+// ClearLongDescription clears the value of the "long_description" field.
+func (m *PlaceMutation) ClearLongDescription() {
+	m.long_description = nil
+	m.clearedFields[place.FieldLongDescription] = struct{}{}
+}
+
+// LongDescriptionCleared returns if the "long_description" field was cleared in this mutation.
+func (m *PlaceMutation) LongDescriptionCleared() bool {
+	_, ok := m.clearedFields[place.FieldLongDescription]
+	return ok
+}
+
+// ResetLongDescription resets all changes to the "long_description" field.
+func (m *PlaceMutation) ResetLongDescription() {
+	m.long_description = nil
+	delete(m.clearedFields, place.FieldLongDescription)
+}
+
+// SetPlaceType sets the "place_type" field.
+func (m *PlaceMutation) SetPlaceType(s string) {
+	m.place_type = &s
+}
+
+// PlaceType returns the value of the "place_type" field in the mutation.
+func (m *PlaceMutation) PlaceType() (r string, exists bool) {
+	v := m.place_type
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Hotel field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *HotelMutation) AddedFields() []string {
-	var fields []string
-	if m.addstar_rating != nil {
-		fields = append(fields, hotel.FieldStarRating)
+// OldPlaceType returns the old "place_type" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldPlaceType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlaceType is only allowed on UpdateOne operations")
 	}
-	if m.addroom_count != nil {
-		fields = append(fields, hotel.FieldRoomCount)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlaceType requires an ID field in the mutation")
 	}
-	if m.addview_count != nil {
-		fields = append(fields, hotel.FieldViewCount)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlaceType: %w", err)
 	}
-	if m.addrating_count != nil {
-		fields = append(fields, hotel.FieldRatingCount)
+	return oldValue.PlaceType, nil
+}
+
+// ResetPlaceType resets all changes to the "place_type" field.
+func (m *PlaceMutation) ResetPlaceType() {
+	m.place_type = nil
+}
+
+// SetAddress sets the "address" field.
+func (m *PlaceMutation) SetAddress(value map[string]string) {
+	m.address = &value
+}
+
+// Address returns the value of the "address" field in the mutation.
+func (m *PlaceMutation) Address() (r map[string]string, exists bool) {
+	v := m.address
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *HotelMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case hotel.FieldStarRating:
-		return m.AddedStarRating()
-	case hotel.FieldRoomCount:
-		return m.AddedRoomCount()
-	case hotel.FieldViewCount:
-		return m.AddedViewCount()
-	case hotel.FieldRatingCount:
-		return m.AddedRatingCount()
+// OldAddress returns the old "address" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldAddress(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+	}
+	return oldValue.Address, nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *HotelMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case hotel.FieldStarRating:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddStarRating(v)
-		return nil
-	case hotel.FieldRoomCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRoomCount(v)
-		return nil
-	case hotel.FieldViewCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddViewCount(v)
-		return nil
-	case hotel.FieldRatingCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRatingCount(v)
-		return nil
+// ClearAddress clears the value of the "address" field.
+func (m *PlaceMutation) ClearAddress() {
+	m.address = nil
+	m.clearedFields[place.FieldAddress] = struct{}{}
+}
+
+// AddressCleared returns if the "address" field was cleared in this mutation.
+func (m *PlaceMutation) AddressCleared() bool {
+	_, ok := m.clearedFields[place.FieldAddress]
+	return ok
+}
+
+// ResetAddress resets all changes to the "address" field.
+func (m *PlaceMutation) ResetAddress() {
+	m.address = nil
+	delete(m.clearedFields, place.FieldAddress)
+}
+
+// SetLatitude sets the "latitude" field.
+func (m *PlaceMutation) SetLatitude(d decimal.Decimal) {
+	m.latitude = &d
+}
+
+// Latitude returns the value of the "latitude" field in the mutation.
+func (m *PlaceMutation) Latitude() (r decimal.Decimal, exists bool) {
+	v := m.latitude
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Hotel numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *HotelMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(hotel.FieldCreatedBy) {
-		fields = append(fields, hotel.FieldCreatedBy)
+// OldLatitude returns the old "latitude" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldLatitude(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatitude is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(hotel.FieldUpdatedBy) {
-		fields = append(fields, hotel.FieldUpdatedBy)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatitude requires an ID field in the mutation")
 	}
-	if m.FieldCleared(hotel.FieldMetadata) {
-		fields = append(fields, hotel.FieldMetadata)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatitude: %w", err)
 	}
-	if m.FieldCleared(hotel.FieldDescription) {
-		fields = append(fields, hotel.FieldDescription)
+	return oldValue.Latitude, nil
+}
+
+// ResetLatitude resets all changes to the "latitude" field.
+func (m *PlaceMutation) ResetLatitude() {
+	m.latitude = nil
+}
+
+// SetLongitude sets the "longitude" field.
+func (m *PlaceMutation) SetLongitude(d decimal.Decimal) {
+	m.longitude = &d
+}
+
+// Longitude returns the value of the "longitude" field in the mutation.
+func (m *PlaceMutation) Longitude() (r decimal.Decimal, exists bool) {
+	v := m.longitude
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(hotel.FieldCheckInTime) {
-		fields = append(fields, hotel.FieldCheckInTime)
+	return *v, true
+}
+
+// OldLongitude returns the old "longitude" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldLongitude(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLongitude is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(hotel.FieldCheckOutTime) {
-		fields = append(fields, hotel.FieldCheckOutTime)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLongitude requires an ID field in the mutation")
 	}
-	if m.FieldCleared(hotel.FieldAddress) {
-		fields = append(fields, hotel.FieldAddress)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLongitude: %w", err)
 	}
-	if m.FieldCleared(hotel.FieldPhone) {
-		fields = append(fields, hotel.FieldPhone)
+	return oldValue.Longitude, nil
+}
+
+// ResetLongitude resets all changes to the "longitude" field.
+func (m *PlaceMutation) ResetLongitude() {
+	m.longitude = nil
+}
+
+// SetGeohash sets the "geohash" field.
+func (m *PlaceMutation) SetGeohash(s string) {
+	m.geohash = &s
+}
+
+// Geohash returns the value of the "geohash" field in the mutation.
+func (m *PlaceMutation) Geohash() (r string, exists bool) {
+	v := m.geohash
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(hotel.FieldEmail) {
-		fields = append(fields, hotel.FieldEmail)
+	return *v, true
+}
+
+// OldGeohash returns the old "geohash" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldGeohash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGeohash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGeohash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGeohash: %w", err)
+	}
+	return oldValue.Geohash, nil
+}
+
+// ClearGeohash clears the value of the "geohash" field.
+func (m *PlaceMutation) ClearGeohash() {
+	m.geohash = nil
+	m.clearedFields[place.FieldGeohash] = struct{}{}
+}
+
+// GeohashCleared returns if the "geohash" field was cleared in this mutation.
+func (m *PlaceMutation) GeohashCleared() bool {
+	_, ok := m.clearedFields[place.FieldGeohash]
+	return ok
+}
+
+// ResetGeohash resets all changes to the "geohash" field.
+func (m *PlaceMutation) ResetGeohash() {
+	m.geohash = nil
+	delete(m.clearedFields, place.FieldGeohash)
+}
+
+// SetPrimaryImageURL sets the "primary_image_url" field.
+func (m *PlaceMutation) SetPrimaryImageURL(s string) {
+	m.primary_image_url = &s
+}
+
+// PrimaryImageURL returns the value of the "primary_image_url" field in the mutation.
+func (m *PlaceMutation) PrimaryImageURL() (r string, exists bool) {
+	v := m.primary_image_url
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(hotel.FieldWebsite) {
-		fields = append(fields, hotel.FieldWebsite)
+	return *v, true
+}
+
+// OldPrimaryImageURL returns the old "primary_image_url" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldPrimaryImageURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrimaryImageURL is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(hotel.FieldPrimaryImageURL) {
-		fields = append(fields, hotel.FieldPrimaryImageURL)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrimaryImageURL requires an ID field in the mutation")
 	}
-	if m.FieldCleared(hotel.FieldThumbnailURL) {
-		fields = append(fields, hotel.FieldThumbnailURL)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrimaryImageURL: %w", err)
 	}
-	if m.FieldCleared(hotel.FieldPriceMin) {
-		fields = append(fields, hotel.FieldPriceMin)
+	return oldValue.PrimaryImageURL, nil
+}
+
+// ClearPrimaryImageURL clears the value of the "primary_image_url" field.
+func (m *PlaceMutation) ClearPrimaryImageURL() {
+	m.primary_image_url = nil
+	m.clearedFields[place.FieldPrimaryImageURL] = struct{}{}
+}
+
+// PrimaryImageURLCleared returns if the "primary_image_url" field was cleared in this mutation.
+func (m *PlaceMutation) PrimaryImageURLCleared() bool {
+	_, ok := m.clearedFields[place.FieldPrimaryImageURL]
+	return ok
+}
+
+// ResetPrimaryImageURL resets all changes to the "primary_image_url" field.
+func (m *PlaceMutation) ResetPrimaryImageURL() {
+	m.primary_image_url = nil
+	delete(m.clearedFields, place.FieldPrimaryImageURL)
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (m *PlaceMutation) SetThumbnailURL(s string) {
+	m.thumbnail_url = &s
+}
+
+// ThumbnailURL returns the value of the "thumbnail_url" field in the mutation.
+func (m *PlaceMutation) ThumbnailURL() (r string, exists bool) {
+	v := m.thumbnail_url
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(hotel.FieldPriceMax) {
-		fields = append(fields, hotel.FieldPriceMax)
+	return *v, true
+}
+
+// OldThumbnailURL returns the old "thumbnail_url" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldThumbnailURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailURL is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(hotel.FieldCurrency) {
-		fields = append(fields, hotel.FieldCurrency)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailURL requires an ID field in the mutation")
 	}
-	if m.FieldCleared(hotel.FieldLastViewedAt) {
-		fields = append(fields, hotel.FieldLastViewedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailURL: %w", err)
 	}
-	return fields
+	return oldValue.ThumbnailURL, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *HotelMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (m *PlaceMutation) ClearThumbnailURL() {
+	m.thumbnail_url = nil
+	m.clearedFields[place.FieldThumbnailURL] = struct{}{}
+}
+
+// ThumbnailURLCleared returns if the "thumbnail_url" field was cleared in this mutation.
+func (m *PlaceMutation) ThumbnailURLCleared() bool {
+	_, ok := m.clearedFields[place.FieldThumbnailURL]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *HotelMutation) ClearField(name string) error {
-	switch name {
-	case hotel.FieldCreatedBy:
-		m.ClearCreatedBy()
-		return nil
-	case hotel.FieldUpdatedBy:
-		m.ClearUpdatedBy()
-		return nil
-	case hotel.FieldMetadata:
-		m.ClearMetadata()
-		return nil
-	case hotel.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case hotel.FieldCheckInTime:
-		m.ClearCheckInTime()
-		return nil
-	case hotel.FieldCheckOutTime:
-		m.ClearCheckOutTime()
-		return nil
-	case hotel.FieldAddress:
-		m.ClearAddress()
-		return nil
-	case hotel.FieldPhone:
-		m.ClearPhone()
-		return nil
-	case hotel.FieldEmail:
-		m.ClearEmail()
-		return nil
-	case hotel.FieldWebsite:
-		m.ClearWebsite()
-		return nil
-	case hotel.FieldPrimaryImageURL:
-		m.ClearPrimaryImageURL()
-		return nil
-	case hotel.FieldThumbnailURL:
-		m.ClearThumbnailURL()
-		return nil
-	case hotel.FieldPriceMin:
-		m.ClearPriceMin()
-		return nil
-	case hotel.FieldPriceMax:
-		m.ClearPriceMax()
-		return nil
-	case hotel.FieldCurrency:
-		m.ClearCurrency()
-		return nil
-	case hotel.FieldLastViewedAt:
-		m.ClearLastViewedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Hotel nullable field %s", name)
+// ResetThumbnailURL resets all changes to the "thumbnail_url" field.
+func (m *PlaceMutation) ResetThumbnailURL() {
+	m.thumbnail_url = nil
+	delete(m.clearedFields, place.FieldThumbnailURL)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *HotelMutation) ResetField(name string) error {
-	switch name {
-	case hotel.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case hotel.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case hotel.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case hotel.FieldCreatedBy:
-		m.ResetCreatedBy()
-		return nil
-	case hotel.FieldUpdatedBy:
-		m.ResetUpdatedBy()
-		return nil
-	case hotel.FieldMetadata:
-		m.ResetMetadata()
-		return nil
-	case hotel.FieldSlug:
-		m.ResetSlug()
-		return nil
-	case hotel.FieldName:
-		m.ResetName()
-		return nil
-	case hotel.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case hotel.FieldStarRating:
-		m.ResetStarRating()
-		return nil
-	case hotel.FieldRoomCount:
-		m.ResetRoomCount()
-		return nil
-	case hotel.FieldCheckInTime:
-		m.ResetCheckInTime()
-		return nil
-	case hotel.FieldCheckOutTime:
-		m.ResetCheckOutTime()
-		return nil
-	case hotel.FieldAddress:
-		m.ResetAddress()
-		return nil
-	case hotel.FieldLatitude:
-		m.ResetLatitude()
-		return nil
-	case hotel.FieldLongitude:
-		m.ResetLongitude()
-		return nil
-	case hotel.FieldPhone:
-		m.ResetPhone()
-		return nil
-	case hotel.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case hotel.FieldWebsite:
-		m.ResetWebsite()
-		return nil
-	case hotel.FieldPrimaryImageURL:
-		m.ResetPrimaryImageURL()
-		return nil
-	case hotel.FieldThumbnailURL:
-		m.ResetThumbnailURL()
-		return nil
-	case hotel.FieldPriceMin:
-		m.ResetPriceMin()
-		return nil
-	case hotel.FieldPriceMax:
-		m.ResetPriceMax()
-		return nil
-	case hotel.FieldCurrency:
-		m.ResetCurrency()
-		return nil
-	case hotel.FieldViewCount:
-		m.ResetViewCount()
-		return nil
-	case hotel.FieldRatingAvg:
-		m.ResetRatingAvg()
-		return nil
-	case hotel.FieldRatingCount:
-		m.ResetRatingCount()
-		return nil
-	case hotel.FieldLastViewedAt:
-		m.ResetLastViewedAt()
-		return nil
-	case hotel.FieldPopularityScore:
-		m.ResetPopularityScore()
-		return nil
+// SetViewCount sets the "view_count" field.
+func (m *PlaceMutation) SetViewCount(i int) {
+	m.view_count = &i
+	m.addview_count = nil
+}
+
+// ViewCount returns the value of the "view_count" field in the mutation.
+func (m *PlaceMutation) ViewCount() (r int, exists bool) {
+	v := m.view_count
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Hotel field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *HotelMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// OldViewCount returns the old "view_count" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldViewCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldViewCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldViewCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldViewCount: %w", err)
+	}
+	return oldValue.ViewCount, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *HotelMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// AddViewCount adds i to the "view_count" field.
+func (m *PlaceMutation) AddViewCount(i int) {
+	if m.addview_count != nil {
+		*m.addview_count += i
+	} else {
+		m.addview_count = &i
+	}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *HotelMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// AddedViewCount returns the value that was added to the "view_count" field in this mutation.
+func (m *PlaceMutation) AddedViewCount() (r int, exists bool) {
+	v := m.addview_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *HotelMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ResetViewCount resets all changes to the "view_count" field.
+func (m *PlaceMutation) ResetViewCount() {
+	m.view_count = nil
+	m.addview_count = nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *HotelMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// SetRatingAvg sets the "rating_avg" field.
+func (m *PlaceMutation) SetRatingAvg(d decimal.Decimal) {
+	m.rating_avg = &d
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *HotelMutation) EdgeCleared(name string) bool {
-	return false
+// RatingAvg returns the value of the "rating_avg" field in the mutation.
+func (m *PlaceMutation) RatingAvg() (r decimal.Decimal, exists bool) {
+	v := m.rating_avg
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *HotelMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Hotel unique edge %s", name)
+// OldRatingAvg returns the old "rating_avg" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldRatingAvg(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRatingAvg is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRatingAvg requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRatingAvg: %w", err)
+	}
+	return oldValue.RatingAvg, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *HotelMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Hotel edge %s", name)
+// ResetRatingAvg resets all changes to the "rating_avg" field.
+func (m *PlaceMutation) ResetRatingAvg() {
+	m.rating_avg = nil
 }
 
-// ItineraryMutation represents an operation that mutates the Itinerary nodes in the graph.
-type ItineraryMutation struct {
-	config
-	op                          Op
-	typ                         string
-	id                          *string
-	status                      *string
-	created_at                  *time.Time
-	updated_at                  *time.Time
-	created_by                  *string
-	updated_by                  *string
-	metadata                    *map[string]string
-	title                       *string
-	description                 *string
-	planned_date                *time.Time
-	start_latitude              *decimal.Decimal
-	start_longitude             *decimal.Decimal
-	preferred_transport_mode    *string
-	total_distance_km           *float64
-	addtotal_distance_km        *float64
-	total_duration_minutes      *int
-	addtotal_duration_minutes   *int
-	total_visit_time_minutes    *int
-	addtotal_visit_time_minutes *int
-	is_optimized                *bool
-	clearedFields               map[string]struct{}
-	user                        *string
-	cleareduser                 bool
-	visits                      map[string]struct{}
-	removedvisits               map[string]struct{}
-	clearedvisits               bool
-	done                        bool
-	oldValue                    func(context.Context) (*Itinerary, error)
-	predicates                  []predicate.Itinerary
+// SetRatingCount sets the "rating_count" field.
+func (m *PlaceMutation) SetRatingCount(i int) {
+	m.rating_count = &i
+	m.addrating_count = nil
 }
 
-var _ ent.Mutation = (*ItineraryMutation)(nil)
-
-// itineraryOption allows management of the mutation configuration using functional options.
-type itineraryOption func(*ItineraryMutation)
+// RatingCount returns the value of the "rating_count" field in the mutation.
+func (m *PlaceMutation) RatingCount() (r int, exists bool) {
+	v := m.rating_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newItineraryMutation creates new mutation for the Itinerary entity.
-func newItineraryMutation(c config, op Op, opts ...itineraryOption) *ItineraryMutation {
-	m := &ItineraryMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeItinerary,
-		clearedFields: make(map[string]struct{}),
+// OldRatingCount returns the old "rating_count" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldRatingCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRatingCount is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRatingCount requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRatingCount: %w", err)
+	}
+	return oldValue.RatingCount, nil
 }
 
-// withItineraryID sets the ID field of the mutation.
-func withItineraryID(id string) itineraryOption {
-	return func(m *ItineraryMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Itinerary
-		)
-		m.oldValue = func(ctx context.Context) (*Itinerary, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Itinerary.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// AddRatingCount adds i to the "rating_count" field.
+func (m *PlaceMutation) AddRatingCount(i int) {
+	if m.addrating_count != nil {
+		*m.addrating_count += i
+	} else {
+		m.addrating_count = &i
 	}
 }
 
-// withItinerary sets the old Itinerary of the mutation.
-func withItinerary(node *Itinerary) itineraryOption {
-	return func(m *ItineraryMutation) {
-		m.oldValue = func(context.Context) (*Itinerary, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AddedRatingCount returns the value that was added to the "rating_count" field in this mutation.
+func (m *PlaceMutation) AddedRatingCount() (r int, exists bool) {
+	v := m.addrating_count
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ItineraryMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetRatingCount resets all changes to the "rating_count" field.
+func (m *PlaceMutation) ResetRatingCount() {
+	m.rating_count = nil
+	m.addrating_count = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ItineraryMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (m *PlaceMutation) SetLastViewedAt(t time.Time) {
+	m.last_viewed_at = &t
+}
+
+// LastViewedAt returns the value of the "last_viewed_at" field in the mutation.
+func (m *PlaceMutation) LastViewedAt() (r time.Time, exists bool) {
+	v := m.last_viewed_at
+	if v == nil {
+		return
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return *v, true
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Itinerary entities.
-func (m *ItineraryMutation) SetID(id string) {
-	m.id = &id
+// OldLastViewedAt returns the old "last_viewed_at" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceMutation) OldLastViewedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastViewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastViewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastViewedAt: %w", err)
+	}
+	return oldValue.LastViewedAt, nil
+}
+
+// ClearLastViewedAt clears the value of the "last_viewed_at" field.
+func (m *PlaceMutation) ClearLastViewedAt() {
+	m.last_viewed_at = nil
+	m.clearedFields[place.FieldLastViewedAt] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ItineraryMutation) ID() (id string, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// LastViewedAtCleared returns if the "last_viewed_at" field was cleared in this mutation.
+func (m *PlaceMutation) LastViewedAtCleared() bool {
+	_, ok := m.clearedFields[place.FieldLastViewedAt]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ItineraryMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Itinerary.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetLastViewedAt resets all changes to the "last_viewed_at" field.
+func (m *PlaceMutation) ResetLastViewedAt() {
+	m.last_viewed_at = nil
+	delete(m.clearedFields, place.FieldLastViewedAt)
 }
 
-// SetStatus sets the "status" field.
-func (m *ItineraryMutation) SetStatus(s string) {
-	m.status = &s
+// SetPopularityScore sets the "popularity_score" field.
+func (m *PlaceMutation) SetPopularityScore(d decimal.Decimal) {
+	m.popularity_score = &d
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ItineraryMutation) Status() (r string, exists bool) {
-	v := m.status
+// PopularityScore returns the value of the "popularity_score" field in the mutation.
+func (m *PlaceMutation) PopularityScore() (r decimal.Decimal, exists bool) {
+	v := m.popularity_score
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Itinerary entity.
-// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// OldPopularityScore returns the old "popularity_score" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItineraryMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *PlaceMutation) OldPopularityScore(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldPopularityScore is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldPopularityScore requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldPopularityScore: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.PopularityScore, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *ItineraryMutation) ResetStatus() {
-	m.status = nil
+// ResetPopularityScore resets all changes to the "popularity_score" field.
+func (m *PlaceMutation) ResetPopularityScore() {
+	m.popularity_score = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ItineraryMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetAvgVisitMinutes sets the "avg_visit_minutes" field.
+func (m *PlaceMutation) SetAvgVisitMinutes(i int) {
+	m.avg_visit_minutes = &i
+	m.addavg_visit_minutes = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ItineraryMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// AvgVisitMinutes returns the value of the "avg_visit_minutes" field in the mutation.
+func (m *PlaceMutation) AvgVisitMinutes() (r int, exists bool) {
+	v := m.avg_visit_minutes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Itinerary entity.
-// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// OldAvgVisitMinutes returns the old "avg_visit_minutes" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItineraryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PlaceMutation) OldAvgVisitMinutes(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAvgVisitMinutes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAvgVisitMinutes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAvgVisitMinutes: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.AvgVisitMinutes, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ItineraryMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddAvgVisitMinutes adds i to the "avg_visit_minutes" field.
+func (m *PlaceMutation) AddAvgVisitMinutes(i int) {
+	if m.addavg_visit_minutes != nil {
+		*m.addavg_visit_minutes += i
+	} else {
+		m.addavg_visit_minutes = &i
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ItineraryMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedAvgVisitMinutes returns the value that was added to the "avg_visit_minutes" field in this mutation.
+func (m *PlaceMutation) AddedAvgVisitMinutes() (r int, exists bool) {
+	v := m.addavg_visit_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ItineraryMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetAvgVisitMinutes resets all changes to the "avg_visit_minutes" field.
+func (m *PlaceMutation) ResetAvgVisitMinutes() {
+	m.avg_visit_minutes = nil
+	m.addavg_visit_minutes = nil
+}
+
+// SetOpeningHours sets the "opening_hours" field.
+func (m *PlaceMutation) SetOpeningHours(value map[string]string) {
+	m.opening_hours = &value
+}
+
+// OpeningHours returns the value of the "opening_hours" field in the mutation.
+func (m *PlaceMutation) OpeningHours() (r map[string]string, exists bool) {
+	v := m.opening_hours
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Itinerary entity.
-// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// OldOpeningHours returns the old "opening_hours" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItineraryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PlaceMutation) OldOpeningHours(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldOpeningHours is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldOpeningHours requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldOpeningHours: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.OpeningHours, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ItineraryMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearOpeningHours clears the value of the "opening_hours" field.
+func (m *PlaceMutation) ClearOpeningHours() {
+	m.opening_hours = nil
+	m.clearedFields[place.FieldOpeningHours] = struct{}{}
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *ItineraryMutation) SetCreatedBy(s string) {
-	m.created_by = &s
+// OpeningHoursCleared returns if the "opening_hours" field was cleared in this mutation.
+func (m *PlaceMutation) OpeningHoursCleared() bool {
+	_, ok := m.clearedFields[place.FieldOpeningHours]
+	return ok
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *ItineraryMutation) CreatedBy() (r string, exists bool) {
-	v := m.created_by
+// ResetOpeningHours resets all changes to the "opening_hours" field.
+func (m *PlaceMutation) ResetOpeningHours() {
+	m.opening_hours = nil
+	delete(m.clearedFields, place.FieldOpeningHours)
+}
+
+// SetPriceInfo sets the "price_info" field.
+func (m *PlaceMutation) SetPriceInfo(ti *types.PriceInfo) {
+	m.price_info = &ti
+}
+
+// PriceInfo returns the value of the "price_info" field in the mutation.
+func (m *PlaceMutation) PriceInfo() (r *types.PriceInfo, exists bool) {
+	v := m.price_info
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the Itinerary entity.
-// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// OldPriceInfo returns the old "price_info" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItineraryMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+func (m *PlaceMutation) OldPriceInfo(ctx context.Context) (v *types.PriceInfo, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldPriceInfo is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+		return v, errors.New("OldPriceInfo requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldPriceInfo: %w", err)
 	}
-	return oldValue.CreatedBy, nil
+	return oldValue.PriceInfo, nil
 }
 
-// ClearCreatedBy clears the value of the "created_by" field.
-func (m *ItineraryMutation) ClearCreatedBy() {
-	m.created_by = nil
-	m.clearedFields[itinerary.FieldCreatedBy] = struct{}{}
+// ClearPriceInfo clears the value of the "price_info" field.
+func (m *PlaceMutation) ClearPriceInfo() {
+	m.price_info = nil
+	m.clearedFields[place.FieldPriceInfo] = struct{}{}
 }
 
-// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *ItineraryMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[itinerary.FieldCreatedBy]
+// PriceInfoCleared returns if the "price_info" field was cleared in this mutation.
+func (m *PlaceMutation) PriceInfoCleared() bool {
+	_, ok := m.clearedFields[place.FieldPriceInfo]
 	return ok
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *ItineraryMutation) ResetCreatedBy() {
-	m.created_by = nil
-	delete(m.clearedFields, itinerary.FieldCreatedBy)
+// ResetPriceInfo resets all changes to the "price_info" field.
+func (m *PlaceMutation) ResetPriceInfo() {
+	m.price_info = nil
+	delete(m.clearedFields, place.FieldPriceInfo)
 }
 
-// SetUpdatedBy sets the "updated_by" field.
-func (m *ItineraryMutation) SetUpdatedBy(s string) {
-	m.updated_by = &s
+// SetContact sets the "contact" field.
+func (m *PlaceMutation) SetContact(t *types.Contact) {
+	m.contact = &t
 }
 
-// UpdatedBy returns the value of the "updated_by" field in the mutation.
-func (m *ItineraryMutation) UpdatedBy() (r string, exists bool) {
-	v := m.updated_by
+// Contact returns the value of the "contact" field in the mutation.
+func (m *PlaceMutation) Contact() (r *types.Contact, exists bool) {
+	v := m.contact
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedBy returns the old "updated_by" field's value of the Itinerary entity.
-// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// OldContact returns the old "contact" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItineraryMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
+func (m *PlaceMutation) OldContact(ctx context.Context) (v *types.Contact, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldContact is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+		return v, errors.New("OldContact requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldContact: %w", err)
 	}
-	return oldValue.UpdatedBy, nil
+	return oldValue.Contact, nil
 }
 
-// ClearUpdatedBy clears the value of the "updated_by" field.
-func (m *ItineraryMutation) ClearUpdatedBy() {
-	m.updated_by = nil
-	m.clearedFields[itinerary.FieldUpdatedBy] = struct{}{}
+// ClearContact clears the value of the "contact" field.
+func (m *PlaceMutation) ClearContact() {
+	m.contact = nil
+	m.clearedFields[place.FieldContact] = struct{}{}
 }
 
-// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
-func (m *ItineraryMutation) UpdatedByCleared() bool {
-	_, ok := m.clearedFields[itinerary.FieldUpdatedBy]
+// ContactCleared returns if the "contact" field was cleared in this mutation.
+func (m *PlaceMutation) ContactCleared() bool {
+	_, ok := m.clearedFields[place.FieldContact]
 	return ok
 }
 
-// ResetUpdatedBy resets all changes to the "updated_by" field.
-func (m *ItineraryMutation) ResetUpdatedBy() {
-	m.updated_by = nil
-	delete(m.clearedFields, itinerary.FieldUpdatedBy)
+// ResetContact resets all changes to the "contact" field.
+func (m *PlaceMutation) ResetContact() {
+	m.contact = nil
+	delete(m.clearedFields, place.FieldContact)
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *ItineraryMutation) SetMetadata(value map[string]string) {
-	m.metadata = &value
+// SetAccessibility sets the "accessibility" field.
+func (m *PlaceMutation) SetAccessibility(t *types.Accessibility) {
+	m.accessibility = &t
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *ItineraryMutation) Metadata() (r map[string]string, exists bool) {
-	v := m.metadata
+// Accessibility returns the value of the "accessibility" field in the mutation.
+func (m *PlaceMutation) Accessibility() (r *types.Accessibility, exists bool) {
+	v := m.accessibility
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Itinerary entity.
-// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// OldAccessibility returns the old "accessibility" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItineraryMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+func (m *PlaceMutation) OldAccessibility(ctx context.Context) (v *types.Accessibility, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccessibility is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldAccessibility requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccessibility: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.Accessibility, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *ItineraryMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[itinerary.FieldMetadata] = struct{}{}
+// ClearAccessibility clears the value of the "accessibility" field.
+func (m *PlaceMutation) ClearAccessibility() {
+	m.accessibility = nil
+	m.clearedFields[place.FieldAccessibility] = struct{}{}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *ItineraryMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[itinerary.FieldMetadata]
+// AccessibilityCleared returns if the "accessibility" field was cleared in this mutation.
+func (m *PlaceMutation) AccessibilityCleared() bool {
+	_, ok := m.clearedFields[place.FieldAccessibility]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *ItineraryMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, itinerary.FieldMetadata)
+// ResetAccessibility resets all changes to the "accessibility" field.
+func (m *PlaceMutation) ResetAccessibility() {
+	m.accessibility = nil
+	delete(m.clearedFields, place.FieldAccessibility)
 }
 
-// SetUserID sets the "user_id" field.
-func (m *ItineraryMutation) SetUserID(s string) {
-	m.user = &s
+// SetExternalRefs sets the "external_refs" field.
+func (m *PlaceMutation) SetExternalRefs(value map[string]string) {
+	m.external_refs = &value
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *ItineraryMutation) UserID() (r string, exists bool) {
-	v := m.user
+// ExternalRefs returns the value of the "external_refs" field in the mutation.
+func (m *PlaceMutation) ExternalRefs() (r map[string]string, exists bool) {
+	v := m.external_refs
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Itinerary entity.
-// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// OldExternalRefs returns the old "external_refs" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItineraryMutation) OldUserID(ctx context.Context) (v string, err error) {
+func (m *PlaceMutation) OldExternalRefs(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldExternalRefs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldExternalRefs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldExternalRefs: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.ExternalRefs, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *ItineraryMutation) ResetUserID() {
-	m.user = nil
+// ClearExternalRefs clears the value of the "external_refs" field.
+func (m *PlaceMutation) ClearExternalRefs() {
+	m.external_refs = nil
+	m.clearedFields[place.FieldExternalRefs] = struct{}{}
+}
+
+// ExternalRefsCleared returns if the "external_refs" field was cleared in this mutation.
+func (m *PlaceMutation) ExternalRefsCleared() bool {
+	_, ok := m.clearedFields[place.FieldExternalRefs]
+	return ok
+}
+
+// ResetExternalRefs resets all changes to the "external_refs" field.
+func (m *PlaceMutation) ResetExternalRefs() {
+	m.external_refs = nil
+	delete(m.clearedFields, place.FieldExternalRefs)
 }
 
-// SetTitle sets the "title" field.
-func (m *ItineraryMutation) SetTitle(s string) {
-	m.title = &s
+// SetGeofenceRadiusM sets the "geofence_radius_m" field.
+func (m *PlaceMutation) SetGeofenceRadiusM(i int) {
+	m.geofence_radius_m = &i
+	m.addgeofence_radius_m = nil
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *ItineraryMutation) Title() (r string, exists bool) {
-	v := m.title
+// GeofenceRadiusM returns the value of the "geofence_radius_m" field in the mutation.
+func (m *PlaceMutation) GeofenceRadiusM() (r int, exists bool) {
+	v := m.geofence_radius_m
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Itinerary entity.
-// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// OldGeofenceRadiusM returns the old "geofence_radius_m" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItineraryMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *PlaceMutation) OldGeofenceRadiusM(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldGeofenceRadiusM is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldGeofenceRadiusM requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldGeofenceRadiusM: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.GeofenceRadiusM, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *ItineraryMutation) ResetTitle() {
-	m.title = nil
+// AddGeofenceRadiusM adds i to the "geofence_radius_m" field.
+func (m *PlaceMutation) AddGeofenceRadiusM(i int) {
+	if m.addgeofence_radius_m != nil {
+		*m.addgeofence_radius_m += i
+	} else {
+		m.addgeofence_radius_m = &i
+	}
 }
 
-// SetDescription sets the "description" field.
-func (m *ItineraryMutation) SetDescription(s string) {
-	m.description = &s
+// AddedGeofenceRadiusM returns the value that was added to the "geofence_radius_m" field in this mutation.
+func (m *PlaceMutation) AddedGeofenceRadiusM() (r int, exists bool) {
+	v := m.addgeofence_radius_m
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ItineraryMutation) Description() (r string, exists bool) {
-	v := m.description
+// ClearGeofenceRadiusM clears the value of the "geofence_radius_m" field.
+func (m *PlaceMutation) ClearGeofenceRadiusM() {
+	m.geofence_radius_m = nil
+	m.addgeofence_radius_m = nil
+	m.clearedFields[place.FieldGeofenceRadiusM] = struct{}{}
+}
+
+// GeofenceRadiusMCleared returns if the "geofence_radius_m" field was cleared in this mutation.
+func (m *PlaceMutation) GeofenceRadiusMCleared() bool {
+	_, ok := m.clearedFields[place.FieldGeofenceRadiusM]
+	return ok
+}
+
+// ResetGeofenceRadiusM resets all changes to the "geofence_radius_m" field.
+func (m *PlaceMutation) ResetGeofenceRadiusM() {
+	m.geofence_radius_m = nil
+	m.addgeofence_radius_m = nil
+	delete(m.clearedFields, place.FieldGeofenceRadiusM)
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (m *PlaceMutation) SetPublishAt(t time.Time) {
+	m.publish_at = &t
+}
+
+// PublishAt returns the value of the "publish_at" field in the mutation.
+func (m *PlaceMutation) PublishAt() (r time.Time, exists bool) {
+	v := m.publish_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Itinerary entity.
-// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// OldPublishAt returns the old "publish_at" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItineraryMutation) OldDescription(ctx context.Context) (v *string, err error) {
+func (m *PlaceMutation) OldPublishAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldPublishAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldPublishAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldPublishAt: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.PublishAt, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *ItineraryMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[itinerary.FieldDescription] = struct{}{}
+// ClearPublishAt clears the value of the "publish_at" field.
+func (m *PlaceMutation) ClearPublishAt() {
+	m.publish_at = nil
+	m.clearedFields[place.FieldPublishAt] = struct{}{}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ItineraryMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[itinerary.FieldDescription]
+// PublishAtCleared returns if the "publish_at" field was cleared in this mutation.
+func (m *PlaceMutation) PublishAtCleared() bool {
+	_, ok := m.clearedFields[place.FieldPublishAt]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ItineraryMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, itinerary.FieldDescription)
+// ResetPublishAt resets all changes to the "publish_at" field.
+func (m *PlaceMutation) ResetPublishAt() {
+	m.publish_at = nil
+	delete(m.clearedFields, place.FieldPublishAt)
 }
 
-// SetPlannedDate sets the "planned_date" field.
-func (m *ItineraryMutation) SetPlannedDate(t time.Time) {
-	m.planned_date = &t
+// SetUnpublishAt sets the "unpublish_at" field.
+func (m *PlaceMutation) SetUnpublishAt(t time.Time) {
+	m.unpublish_at = &t
 }
 
-// PlannedDate returns the value of the "planned_date" field in the mutation.
-func (m *ItineraryMutation) PlannedDate() (r time.Time, exists bool) {
-	v := m.planned_date
+// UnpublishAt returns the value of the "unpublish_at" field in the mutation.
+func (m *PlaceMutation) UnpublishAt() (r time.Time, exists bool) {
+	v := m.unpublish_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlannedDate returns the old "planned_date" field's value of the Itinerary entity.
-// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
+// OldUnpublishAt returns the old "unpublish_at" field's value of the Place entity.
+// If the Place object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItineraryMutation) OldPlannedDate(ctx context.Context) (v time.Time, err error) {
+func (m *PlaceMutation) OldUnpublishAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlannedDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldUnpublishAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlannedDate requires an ID field in the mutation")
+		return v, errors.New("OldUnpublishAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlannedDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldUnpublishAt: %w", err)
 	}
-	return oldValue.PlannedDate, nil
+	return oldValue.UnpublishAt, nil
 }
 
-// ResetPlannedDate resets all changes to the "planned_date" field.
-func (m *ItineraryMutation) ResetPlannedDate() {
-	m.planned_date = nil
+// ClearUnpublishAt clears the value of the "unpublish_at" field.
+func (m *PlaceMutation) ClearUnpublishAt() {
+	m.unpublish_at = nil
+	m.clearedFields[place.FieldUnpublishAt] = struct{}{}
 }
 
-// SetStartLatitude sets the "start_latitude" field.
-func (m *ItineraryMutation) SetStartLatitude(d decimal.Decimal) {
-	m.start_latitude = &d
+// UnpublishAtCleared returns if the "unpublish_at" field was cleared in this mutation.
+func (m *PlaceMutation) UnpublishAtCleared() bool {
+	_, ok := m.clearedFields[place.FieldUnpublishAt]
+	return ok
 }
 
-// StartLatitude returns the value of the "start_latitude" field in the mutation.
-func (m *ItineraryMutation) StartLatitude() (r decimal.Decimal, exists bool) {
-	v := m.start_latitude
-	if v == nil {
-		return
+// ResetUnpublishAt resets all changes to the "unpublish_at" field.
+func (m *PlaceMutation) ResetUnpublishAt() {
+	m.unpublish_at = nil
+	delete(m.clearedFields, place.FieldUnpublishAt)
+}
+
+// AddImageIDs adds the "images" edge to the PlaceImage entity by ids.
+func (m *PlaceMutation) AddImageIDs(ids ...string) {
+	if m.images == nil {
+		m.images = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.images[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldStartLatitude returns the old "start_latitude" field's value of the Itinerary entity.
-// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItineraryMutation) OldStartLatitude(ctx context.Context) (v decimal.Decimal, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartLatitude is only allowed on UpdateOne operations")
+// ClearImages clears the "images" edge to the PlaceImage entity.
+func (m *PlaceMutation) ClearImages() {
+	m.clearedimages = true
+}
+
+// ImagesCleared reports if the "images" edge to the PlaceImage entity was cleared.
+func (m *PlaceMutation) ImagesCleared() bool {
+	return m.clearedimages
+}
+
+// RemoveImageIDs removes the "images" edge to the PlaceImage entity by IDs.
+func (m *PlaceMutation) RemoveImageIDs(ids ...string) {
+	if m.removedimages == nil {
+		m.removedimages = make(map[string]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartLatitude requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.images, ids[i])
+		m.removedimages[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartLatitude: %w", err)
+}
+
+// RemovedImages returns the removed IDs of the "images" edge to the PlaceImage entity.
+func (m *PlaceMutation) RemovedImagesIDs() (ids []string) {
+	for id := range m.removedimages {
+		ids = append(ids, id)
 	}
-	return oldValue.StartLatitude, nil
+	return
 }
 
-// ResetStartLatitude resets all changes to the "start_latitude" field.
-func (m *ItineraryMutation) ResetStartLatitude() {
-	m.start_latitude = nil
+// ImagesIDs returns the "images" edge IDs in the mutation.
+func (m *PlaceMutation) ImagesIDs() (ids []string) {
+	for id := range m.images {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetStartLongitude sets the "start_longitude" field.
-func (m *ItineraryMutation) SetStartLongitude(d decimal.Decimal) {
-	m.start_longitude = &d
+// ResetImages resets all changes to the "images" edge.
+func (m *PlaceMutation) ResetImages() {
+	m.images = nil
+	m.clearedimages = false
+	m.removedimages = nil
 }
 
-// StartLongitude returns the value of the "start_longitude" field in the mutation.
-func (m *ItineraryMutation) StartLongitude() (r decimal.Decimal, exists bool) {
-	v := m.start_longitude
-	if v == nil {
-		return
+// AddCategoryIDs adds the "category" edge to the Category entity by ids.
+func (m *PlaceMutation) AddCategoryIDs(ids ...string) {
+	if m.category == nil {
+		m.category = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.category[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldStartLongitude returns the old "start_longitude" field's value of the Itinerary entity.
-// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItineraryMutation) OldStartLongitude(ctx context.Context) (v decimal.Decimal, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartLongitude is only allowed on UpdateOne operations")
+// ClearCategory clears the "category" edge to the Category entity.
+func (m *PlaceMutation) ClearCategory() {
+	m.clearedcategory = true
+}
+
+// CategoryCleared reports if the "category" edge to the Category entity was cleared.
+func (m *PlaceMutation) CategoryCleared() bool {
+	return m.clearedcategory
+}
+
+// RemoveCategoryIDs removes the "category" edge to the Category entity by IDs.
+func (m *PlaceMutation) RemoveCategoryIDs(ids ...string) {
+	if m.removedcategory == nil {
+		m.removedcategory = make(map[string]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartLongitude requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.category, ids[i])
+		m.removedcategory[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartLongitude: %w", err)
+}
+
+// RemovedCategory returns the removed IDs of the "category" edge to the Category entity.
+func (m *PlaceMutation) RemovedCategoryIDs() (ids []string) {
+	for id := range m.removedcategory {
+		ids = append(ids, id)
 	}
-	return oldValue.StartLongitude, nil
+	return
 }
 
-// ResetStartLongitude resets all changes to the "start_longitude" field.
-func (m *ItineraryMutation) ResetStartLongitude() {
-	m.start_longitude = nil
+// CategoryIDs returns the "category" edge IDs in the mutation.
+func (m *PlaceMutation) CategoryIDs() (ids []string) {
+	for id := range m.category {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetPreferredTransportMode sets the "preferred_transport_mode" field.
-func (m *ItineraryMutation) SetPreferredTransportMode(s string) {
-	m.preferred_transport_mode = &s
+// ResetCategory resets all changes to the "category" edge.
+func (m *PlaceMutation) ResetCategory() {
+	m.category = nil
+	m.clearedcategory = false
+	m.removedcategory = nil
 }
 
-// PreferredTransportMode returns the value of the "preferred_transport_mode" field in the mutation.
-func (m *ItineraryMutation) PreferredTransportMode() (r string, exists bool) {
-	v := m.preferred_transport_mode
-	if v == nil {
-		return
+// AddVisitIDs adds the "visits" edge to the Visit entity by ids.
+func (m *PlaceMutation) AddVisitIDs(ids ...string) {
+	if m.visits == nil {
+		m.visits = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.visits[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldPreferredTransportMode returns the old "preferred_transport_mode" field's value of the Itinerary entity.
-// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItineraryMutation) OldPreferredTransportMode(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPreferredTransportMode is only allowed on UpdateOne operations")
+// ClearVisits clears the "visits" edge to the Visit entity.
+func (m *PlaceMutation) ClearVisits() {
+	m.clearedvisits = true
+}
+
+// VisitsCleared reports if the "visits" edge to the Visit entity was cleared.
+func (m *PlaceMutation) VisitsCleared() bool {
+	return m.clearedvisits
+}
+
+// RemoveVisitIDs removes the "visits" edge to the Visit entity by IDs.
+func (m *PlaceMutation) RemoveVisitIDs(ids ...string) {
+	if m.removedvisits == nil {
+		m.removedvisits = make(map[string]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPreferredTransportMode requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.visits, ids[i])
+		m.removedvisits[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPreferredTransportMode: %w", err)
+}
+
+// RemovedVisits returns the removed IDs of the "visits" edge to the Visit entity.
+func (m *PlaceMutation) RemovedVisitsIDs() (ids []string) {
+	for id := range m.removedvisits {
+		ids = append(ids, id)
 	}
-	return oldValue.PreferredTransportMode, nil
+	return
 }
 
-// ResetPreferredTransportMode resets all changes to the "preferred_transport_mode" field.
-func (m *ItineraryMutation) ResetPreferredTransportMode() {
-	m.preferred_transport_mode = nil
+// VisitsIDs returns the "visits" edge IDs in the mutation.
+func (m *PlaceMutation) VisitsIDs() (ids []string) {
+	for id := range m.visits {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetTotalDistanceKm sets the "total_distance_km" field.
-func (m *ItineraryMutation) SetTotalDistanceKm(f float64) {
-	m.total_distance_km = &f
-	m.addtotal_distance_km = nil
+// ResetVisits resets all changes to the "visits" edge.
+func (m *PlaceMutation) ResetVisits() {
+	m.visits = nil
+	m.clearedvisits = false
+	m.removedvisits = nil
 }
 
-// TotalDistanceKm returns the value of the "total_distance_km" field in the mutation.
-func (m *ItineraryMutation) TotalDistanceKm() (r float64, exists bool) {
-	v := m.total_distance_km
-	if v == nil {
-		return
+// AddRevisionIDs adds the "revisions" edge to the PlaceRevision entity by ids.
+func (m *PlaceMutation) AddRevisionIDs(ids ...string) {
+	if m.revisions == nil {
+		m.revisions = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldTotalDistanceKm returns the old "total_distance_km" field's value of the Itinerary entity.
-// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItineraryMutation) OldTotalDistanceKm(ctx context.Context) (v *float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalDistanceKm is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalDistanceKm requires an ID field in the mutation")
+// ClearRevisions clears the "revisions" edge to the PlaceRevision entity.
+func (m *PlaceMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the PlaceRevision entity was cleared.
+func (m *PlaceMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the PlaceRevision entity by IDs.
+func (m *PlaceMutation) RemoveRevisionIDs(ids ...string) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[string]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalDistanceKm: %w", err)
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
 	}
-	return oldValue.TotalDistanceKm, nil
 }
 
-// AddTotalDistanceKm adds f to the "total_distance_km" field.
-func (m *ItineraryMutation) AddTotalDistanceKm(f float64) {
-	if m.addtotal_distance_km != nil {
-		*m.addtotal_distance_km += f
-	} else {
-		m.addtotal_distance_km = &f
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the PlaceRevision entity.
+func (m *PlaceMutation) RemovedRevisionsIDs() (ids []string) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedTotalDistanceKm returns the value that was added to the "total_distance_km" field in this mutation.
-func (m *ItineraryMutation) AddedTotalDistanceKm() (r float64, exists bool) {
-	v := m.addtotal_distance_km
-	if v == nil {
-		return
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *PlaceMutation) RevisionsIDs() (ids []string) {
+	for id := range m.revisions {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// ClearTotalDistanceKm clears the value of the "total_distance_km" field.
-func (m *ItineraryMutation) ClearTotalDistanceKm() {
-	m.total_distance_km = nil
-	m.addtotal_distance_km = nil
-	m.clearedFields[itinerary.FieldTotalDistanceKm] = struct{}{}
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *PlaceMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
 }
 
-// TotalDistanceKmCleared returns if the "total_distance_km" field was cleared in this mutation.
-func (m *ItineraryMutation) TotalDistanceKmCleared() bool {
-	_, ok := m.clearedFields[itinerary.FieldTotalDistanceKm]
-	return ok
+// AddSlugHistoryIDs adds the "slug_history" edge to the PlaceSlug entity by ids.
+func (m *PlaceMutation) AddSlugHistoryIDs(ids ...string) {
+	if m.slug_history == nil {
+		m.slug_history = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.slug_history[ids[i]] = struct{}{}
+	}
 }
 
-// ResetTotalDistanceKm resets all changes to the "total_distance_km" field.
-func (m *ItineraryMutation) ResetTotalDistanceKm() {
-	m.total_distance_km = nil
-	m.addtotal_distance_km = nil
-	delete(m.clearedFields, itinerary.FieldTotalDistanceKm)
+// ClearSlugHistory clears the "slug_history" edge to the PlaceSlug entity.
+func (m *PlaceMutation) ClearSlugHistory() {
+	m.clearedslug_history = true
 }
 
-// SetTotalDurationMinutes sets the "total_duration_minutes" field.
-func (m *ItineraryMutation) SetTotalDurationMinutes(i int) {
-	m.total_duration_minutes = &i
-	m.addtotal_duration_minutes = nil
+// SlugHistoryCleared reports if the "slug_history" edge to the PlaceSlug entity was cleared.
+func (m *PlaceMutation) SlugHistoryCleared() bool {
+	return m.clearedslug_history
 }
 
-// TotalDurationMinutes returns the value of the "total_duration_minutes" field in the mutation.
-func (m *ItineraryMutation) TotalDurationMinutes() (r int, exists bool) {
-	v := m.total_duration_minutes
-	if v == nil {
-		return
+// RemoveSlugHistoryIDs removes the "slug_history" edge to the PlaceSlug entity by IDs.
+func (m *PlaceMutation) RemoveSlugHistoryIDs(ids ...string) {
+	if m.removedslug_history == nil {
+		m.removedslug_history = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.slug_history, ids[i])
+		m.removedslug_history[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldTotalDurationMinutes returns the old "total_duration_minutes" field's value of the Itinerary entity.
-// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItineraryMutation) OldTotalDurationMinutes(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalDurationMinutes is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalDurationMinutes requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalDurationMinutes: %w", err)
+// RemovedSlugHistory returns the removed IDs of the "slug_history" edge to the PlaceSlug entity.
+func (m *PlaceMutation) RemovedSlugHistoryIDs() (ids []string) {
+	for id := range m.removedslug_history {
+		ids = append(ids, id)
 	}
-	return oldValue.TotalDurationMinutes, nil
+	return
 }
 
-// AddTotalDurationMinutes adds i to the "total_duration_minutes" field.
-func (m *ItineraryMutation) AddTotalDurationMinutes(i int) {
-	if m.addtotal_duration_minutes != nil {
-		*m.addtotal_duration_minutes += i
-	} else {
-		m.addtotal_duration_minutes = &i
+// SlugHistoryIDs returns the "slug_history" edge IDs in the mutation.
+func (m *PlaceMutation) SlugHistoryIDs() (ids []string) {
+	for id := range m.slug_history {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedTotalDurationMinutes returns the value that was added to the "total_duration_minutes" field in this mutation.
-func (m *ItineraryMutation) AddedTotalDurationMinutes() (r int, exists bool) {
-	v := m.addtotal_duration_minutes
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetSlugHistory resets all changes to the "slug_history" edge.
+func (m *PlaceMutation) ResetSlugHistory() {
+	m.slug_history = nil
+	m.clearedslug_history = false
+	m.removedslug_history = nil
 }
 
-// ClearTotalDurationMinutes clears the value of the "total_duration_minutes" field.
-func (m *ItineraryMutation) ClearTotalDurationMinutes() {
-	m.total_duration_minutes = nil
-	m.addtotal_duration_minutes = nil
-	m.clearedFields[itinerary.FieldTotalDurationMinutes] = struct{}{}
+// Where appends a list predicates to the PlaceMutation builder.
+func (m *PlaceMutation) Where(ps ...predicate.Place) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// TotalDurationMinutesCleared returns if the "total_duration_minutes" field was cleared in this mutation.
-func (m *ItineraryMutation) TotalDurationMinutesCleared() bool {
-	_, ok := m.clearedFields[itinerary.FieldTotalDurationMinutes]
-	return ok
+// WhereP appends storage-level predicates to the PlaceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PlaceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Place, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// ResetTotalDurationMinutes resets all changes to the "total_duration_minutes" field.
-func (m *ItineraryMutation) ResetTotalDurationMinutes() {
-	m.total_duration_minutes = nil
-	m.addtotal_duration_minutes = nil
-	delete(m.clearedFields, itinerary.FieldTotalDurationMinutes)
+// Op returns the operation name.
+func (m *PlaceMutation) Op() Op {
+	return m.op
 }
 
-// SetTotalVisitTimeMinutes sets the "total_visit_time_minutes" field.
-func (m *ItineraryMutation) SetTotalVisitTimeMinutes(i int) {
-	m.total_visit_time_minutes = &i
-	m.addtotal_visit_time_minutes = nil
+// SetOp allows setting the mutation operation.
+func (m *PlaceMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// TotalVisitTimeMinutes returns the value of the "total_visit_time_minutes" field in the mutation.
-func (m *ItineraryMutation) TotalVisitTimeMinutes() (r int, exists bool) {
-	v := m.total_visit_time_minutes
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (Place).
+func (m *PlaceMutation) Type() string {
+	return m.typ
 }
 
-// OldTotalVisitTimeMinutes returns the old "total_visit_time_minutes" field's value of the Itinerary entity.
-// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItineraryMutation) OldTotalVisitTimeMinutes(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalVisitTimeMinutes is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlaceMutation) Fields() []string {
+	fields := make([]string, 0, 34)
+	if m.status != nil {
+		fields = append(fields, place.FieldStatus)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalVisitTimeMinutes requires an ID field in the mutation")
+	if m.created_at != nil {
+		fields = append(fields, place.FieldCreatedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalVisitTimeMinutes: %w", err)
+	if m.updated_at != nil {
+		fields = append(fields, place.FieldUpdatedAt)
+	}
+	if m.created_by != nil {
+		fields = append(fields, place.FieldCreatedBy)
+	}
+	if m.updated_by != nil {
+		fields = append(fields, place.FieldUpdatedBy)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, place.FieldDeletedAt)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, place.FieldDeletedBy)
+	}
+	if m.metadata != nil {
+		fields = append(fields, place.FieldMetadata)
+	}
+	if m.slug != nil {
+		fields = append(fields, place.FieldSlug)
+	}
+	if m.title != nil {
+		fields = append(fields, place.FieldTitle)
+	}
+	if m.subtitle != nil {
+		fields = append(fields, place.FieldSubtitle)
+	}
+	if m.short_description != nil {
+		fields = append(fields, place.FieldShortDescription)
+	}
+	if m.long_description != nil {
+		fields = append(fields, place.FieldLongDescription)
+	}
+	if m.place_type != nil {
+		fields = append(fields, place.FieldPlaceType)
+	}
+	if m.address != nil {
+		fields = append(fields, place.FieldAddress)
+	}
+	if m.latitude != nil {
+		fields = append(fields, place.FieldLatitude)
+	}
+	if m.longitude != nil {
+		fields = append(fields, place.FieldLongitude)
+	}
+	if m.geohash != nil {
+		fields = append(fields, place.FieldGeohash)
+	}
+	if m.primary_image_url != nil {
+		fields = append(fields, place.FieldPrimaryImageURL)
+	}
+	if m.thumbnail_url != nil {
+		fields = append(fields, place.FieldThumbnailURL)
+	}
+	if m.view_count != nil {
+		fields = append(fields, place.FieldViewCount)
+	}
+	if m.rating_avg != nil {
+		fields = append(fields, place.FieldRatingAvg)
+	}
+	if m.rating_count != nil {
+		fields = append(fields, place.FieldRatingCount)
+	}
+	if m.last_viewed_at != nil {
+		fields = append(fields, place.FieldLastViewedAt)
+	}
+	if m.popularity_score != nil {
+		fields = append(fields, place.FieldPopularityScore)
+	}
+	if m.avg_visit_minutes != nil {
+		fields = append(fields, place.FieldAvgVisitMinutes)
+	}
+	if m.opening_hours != nil {
+		fields = append(fields, place.FieldOpeningHours)
 	}
-	return oldValue.TotalVisitTimeMinutes, nil
-}
-
-// AddTotalVisitTimeMinutes adds i to the "total_visit_time_minutes" field.
-func (m *ItineraryMutation) AddTotalVisitTimeMinutes(i int) {
-	if m.addtotal_visit_time_minutes != nil {
-		*m.addtotal_visit_time_minutes += i
-	} else {
-		m.addtotal_visit_time_minutes = &i
+	if m.price_info != nil {
+		fields = append(fields, place.FieldPriceInfo)
 	}
-}
-
-// AddedTotalVisitTimeMinutes returns the value that was added to the "total_visit_time_minutes" field in this mutation.
-func (m *ItineraryMutation) AddedTotalVisitTimeMinutes() (r int, exists bool) {
-	v := m.addtotal_visit_time_minutes
-	if v == nil {
-		return
+	if m.contact != nil {
+		fields = append(fields, place.FieldContact)
 	}
-	return *v, true
-}
-
-// ClearTotalVisitTimeMinutes clears the value of the "total_visit_time_minutes" field.
-func (m *ItineraryMutation) ClearTotalVisitTimeMinutes() {
-	m.total_visit_time_minutes = nil
-	m.addtotal_visit_time_minutes = nil
-	m.clearedFields[itinerary.FieldTotalVisitTimeMinutes] = struct{}{}
-}
-
-// TotalVisitTimeMinutesCleared returns if the "total_visit_time_minutes" field was cleared in this mutation.
-func (m *ItineraryMutation) TotalVisitTimeMinutesCleared() bool {
-	_, ok := m.clearedFields[itinerary.FieldTotalVisitTimeMinutes]
-	return ok
-}
-
-// ResetTotalVisitTimeMinutes resets all changes to the "total_visit_time_minutes" field.
-func (m *ItineraryMutation) ResetTotalVisitTimeMinutes() {
-	m.total_visit_time_minutes = nil
-	m.addtotal_visit_time_minutes = nil
-	delete(m.clearedFields, itinerary.FieldTotalVisitTimeMinutes)
-}
-
-// SetIsOptimized sets the "is_optimized" field.
-func (m *ItineraryMutation) SetIsOptimized(b bool) {
-	m.is_optimized = &b
-}
-
-// IsOptimized returns the value of the "is_optimized" field in the mutation.
-func (m *ItineraryMutation) IsOptimized() (r bool, exists bool) {
-	v := m.is_optimized
-	if v == nil {
-		return
+	if m.accessibility != nil {
+		fields = append(fields, place.FieldAccessibility)
 	}
-	return *v, true
-}
-
-// OldIsOptimized returns the old "is_optimized" field's value of the Itinerary entity.
-// If the Itinerary object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItineraryMutation) OldIsOptimized(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsOptimized is only allowed on UpdateOne operations")
+	if m.external_refs != nil {
+		fields = append(fields, place.FieldExternalRefs)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsOptimized requires an ID field in the mutation")
+	if m.geofence_radius_m != nil {
+		fields = append(fields, place.FieldGeofenceRadiusM)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsOptimized: %w", err)
+	if m.publish_at != nil {
+		fields = append(fields, place.FieldPublishAt)
 	}
-	return oldValue.IsOptimized, nil
-}
-
-// ResetIsOptimized resets all changes to the "is_optimized" field.
-func (m *ItineraryMutation) ResetIsOptimized() {
-	m.is_optimized = nil
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *ItineraryMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[itinerary.FieldUserID] = struct{}{}
-}
-
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *ItineraryMutation) UserCleared() bool {
-	return m.cleareduser
+	if m.unpublish_at != nil {
+		fields = append(fields, place.FieldUnpublishAt)
+	}
+	return fields
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *ItineraryMutation) UserIDs() (ids []string) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlaceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case place.FieldStatus:
+		return m.Status()
+	case place.FieldCreatedAt:
+		return m.CreatedAt()
+	case place.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case place.FieldCreatedBy:
+		return m.CreatedBy()
+	case place.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case place.FieldDeletedAt:
+		return m.DeletedAt()
+	case place.FieldDeletedBy:
+		return m.DeletedBy()
+	case place.FieldMetadata:
+		return m.Metadata()
+	case place.FieldSlug:
+		return m.Slug()
+	case place.FieldTitle:
+		return m.Title()
+	case place.FieldSubtitle:
+		return m.Subtitle()
+	case place.FieldShortDescription:
+		return m.ShortDescription()
+	case place.FieldLongDescription:
+		return m.LongDescription()
+	case place.FieldPlaceType:
+		return m.PlaceType()
+	case place.FieldAddress:
+		return m.Address()
+	case place.FieldLatitude:
+		return m.Latitude()
+	case place.FieldLongitude:
+		return m.Longitude()
+	case place.FieldGeohash:
+		return m.Geohash()
+	case place.FieldPrimaryImageURL:
+		return m.PrimaryImageURL()
+	case place.FieldThumbnailURL:
+		return m.ThumbnailURL()
+	case place.FieldViewCount:
+		return m.ViewCount()
+	case place.FieldRatingAvg:
+		return m.RatingAvg()
+	case place.FieldRatingCount:
+		return m.RatingCount()
+	case place.FieldLastViewedAt:
+		return m.LastViewedAt()
+	case place.FieldPopularityScore:
+		return m.PopularityScore()
+	case place.FieldAvgVisitMinutes:
+		return m.AvgVisitMinutes()
+	case place.FieldOpeningHours:
+		return m.OpeningHours()
+	case place.FieldPriceInfo:
+		return m.PriceInfo()
+	case place.FieldContact:
+		return m.Contact()
+	case place.FieldAccessibility:
+		return m.Accessibility()
+	case place.FieldExternalRefs:
+		return m.ExternalRefs()
+	case place.FieldGeofenceRadiusM:
+		return m.GeofenceRadiusM()
+	case place.FieldPublishAt:
+		return m.PublishAt()
+	case place.FieldUnpublishAt:
+		return m.UnpublishAt()
 	}
-	return
+	return nil, false
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *ItineraryMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case place.FieldStatus:
+		return m.OldStatus(ctx)
+	case place.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case place.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case place.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case place.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case place.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case place.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	case place.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case place.FieldSlug:
+		return m.OldSlug(ctx)
+	case place.FieldTitle:
+		return m.OldTitle(ctx)
+	case place.FieldSubtitle:
+		return m.OldSubtitle(ctx)
+	case place.FieldShortDescription:
+		return m.OldShortDescription(ctx)
+	case place.FieldLongDescription:
+		return m.OldLongDescription(ctx)
+	case place.FieldPlaceType:
+		return m.OldPlaceType(ctx)
+	case place.FieldAddress:
+		return m.OldAddress(ctx)
+	case place.FieldLatitude:
+		return m.OldLatitude(ctx)
+	case place.FieldLongitude:
+		return m.OldLongitude(ctx)
+	case place.FieldGeohash:
+		return m.OldGeohash(ctx)
+	case place.FieldPrimaryImageURL:
+		return m.OldPrimaryImageURL(ctx)
+	case place.FieldThumbnailURL:
+		return m.OldThumbnailURL(ctx)
+	case place.FieldViewCount:
+		return m.OldViewCount(ctx)
+	case place.FieldRatingAvg:
+		return m.OldRatingAvg(ctx)
+	case place.FieldRatingCount:
+		return m.OldRatingCount(ctx)
+	case place.FieldLastViewedAt:
+		return m.OldLastViewedAt(ctx)
+	case place.FieldPopularityScore:
+		return m.OldPopularityScore(ctx)
+	case place.FieldAvgVisitMinutes:
+		return m.OldAvgVisitMinutes(ctx)
+	case place.FieldOpeningHours:
+		return m.OldOpeningHours(ctx)
+	case place.FieldPriceInfo:
+		return m.OldPriceInfo(ctx)
+	case place.FieldContact:
+		return m.OldContact(ctx)
+	case place.FieldAccessibility:
+		return m.OldAccessibility(ctx)
+	case place.FieldExternalRefs:
+		return m.OldExternalRefs(ctx)
+	case place.FieldGeofenceRadiusM:
+		return m.OldGeofenceRadiusM(ctx)
+	case place.FieldPublishAt:
+		return m.OldPublishAt(ctx)
+	case place.FieldUnpublishAt:
+		return m.OldUnpublishAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Place field %s", name)
 }
 
-// AddVisitIDs adds the "visits" edge to the Visit entity by ids.
-func (m *ItineraryMutation) AddVisitIDs(ids ...string) {
-	if m.visits == nil {
-		m.visits = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.visits[ids[i]] = struct{}{}
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlaceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case place.FieldStatus:
+		v, ok := value.(types.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case place.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case place.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case place.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case place.FieldUpdatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case place.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case place.FieldDeletedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
+	case place.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case place.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case place.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case place.FieldSubtitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubtitle(v)
+		return nil
+	case place.FieldShortDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShortDescription(v)
+		return nil
+	case place.FieldLongDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLongDescription(v)
+		return nil
+	case place.FieldPlaceType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlaceType(v)
+		return nil
+	case place.FieldAddress:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	case place.FieldLatitude:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatitude(v)
+		return nil
+	case place.FieldLongitude:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLongitude(v)
+		return nil
+	case place.FieldGeohash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGeohash(v)
+		return nil
+	case place.FieldPrimaryImageURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrimaryImageURL(v)
+		return nil
+	case place.FieldThumbnailURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnailURL(v)
+		return nil
+	case place.FieldViewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetViewCount(v)
+		return nil
+	case place.FieldRatingAvg:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRatingAvg(v)
+		return nil
+	case place.FieldRatingCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRatingCount(v)
+		return nil
+	case place.FieldLastViewedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastViewedAt(v)
+		return nil
+	case place.FieldPopularityScore:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPopularityScore(v)
+		return nil
+	case place.FieldAvgVisitMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvgVisitMinutes(v)
+		return nil
+	case place.FieldOpeningHours:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpeningHours(v)
+		return nil
+	case place.FieldPriceInfo:
+		v, ok := value.(*types.PriceInfo)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriceInfo(v)
+		return nil
+	case place.FieldContact:
+		v, ok := value.(*types.Contact)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContact(v)
+		return nil
+	case place.FieldAccessibility:
+		v, ok := value.(*types.Accessibility)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessibility(v)
+		return nil
+	case place.FieldExternalRefs:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalRefs(v)
+		return nil
+	case place.FieldGeofenceRadiusM:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGeofenceRadiusM(v)
+		return nil
+	case place.FieldPublishAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishAt(v)
+		return nil
+	case place.FieldUnpublishAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnpublishAt(v)
+		return nil
 	}
+	return fmt.Errorf("unknown Place field %s", name)
 }
 
-// ClearVisits clears the "visits" edge to the Visit entity.
-func (m *ItineraryMutation) ClearVisits() {
-	m.clearedvisits = true
-}
-
-// VisitsCleared reports if the "visits" edge to the Visit entity was cleared.
-func (m *ItineraryMutation) VisitsCleared() bool {
-	return m.clearedvisits
-}
-
-// RemoveVisitIDs removes the "visits" edge to the Visit entity by IDs.
-func (m *ItineraryMutation) RemoveVisitIDs(ids ...string) {
-	if m.removedvisits == nil {
-		m.removedvisits = make(map[string]struct{})
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlaceMutation) AddedFields() []string {
+	var fields []string
+	if m.addview_count != nil {
+		fields = append(fields, place.FieldViewCount)
 	}
-	for i := range ids {
-		delete(m.visits, ids[i])
-		m.removedvisits[ids[i]] = struct{}{}
+	if m.addrating_count != nil {
+		fields = append(fields, place.FieldRatingCount)
 	}
-}
-
-// RemovedVisits returns the removed IDs of the "visits" edge to the Visit entity.
-func (m *ItineraryMutation) RemovedVisitsIDs() (ids []string) {
-	for id := range m.removedvisits {
-		ids = append(ids, id)
+	if m.addavg_visit_minutes != nil {
+		fields = append(fields, place.FieldAvgVisitMinutes)
 	}
-	return
-}
-
-// VisitsIDs returns the "visits" edge IDs in the mutation.
-func (m *ItineraryMutation) VisitsIDs() (ids []string) {
-	for id := range m.visits {
-		ids = append(ids, id)
+	if m.addgeofence_radius_m != nil {
+		fields = append(fields, place.FieldGeofenceRadiusM)
 	}
-	return
-}
-
-// ResetVisits resets all changes to the "visits" edge.
-func (m *ItineraryMutation) ResetVisits() {
-	m.visits = nil
-	m.clearedvisits = false
-	m.removedvisits = nil
-}
-
-// Where appends a list predicates to the ItineraryMutation builder.
-func (m *ItineraryMutation) Where(ps ...predicate.Itinerary) {
-	m.predicates = append(m.predicates, ps...)
+	return fields
 }
 
-// WhereP appends storage-level predicates to the ItineraryMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ItineraryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Itinerary, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlaceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case place.FieldViewCount:
+		return m.AddedViewCount()
+	case place.FieldRatingCount:
+		return m.AddedRatingCount()
+	case place.FieldAvgVisitMinutes:
+		return m.AddedAvgVisitMinutes()
+	case place.FieldGeofenceRadiusM:
+		return m.AddedGeofenceRadiusM()
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *ItineraryMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *ItineraryMutation) SetOp(op Op) {
-	m.op = op
+	return nil, false
 }
 
-// Type returns the node type of this mutation (Itinerary).
-func (m *ItineraryMutation) Type() string {
-	return m.typ
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlaceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case place.FieldViewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddViewCount(v)
+		return nil
+	case place.FieldRatingCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRatingCount(v)
+		return nil
+	case place.FieldAvgVisitMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAvgVisitMinutes(v)
+		return nil
+	case place.FieldGeofenceRadiusM:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGeofenceRadiusM(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Place numeric field %s", name)
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ItineraryMutation) Fields() []string {
-	fields := make([]string, 0, 17)
-	if m.status != nil {
-		fields = append(fields, itinerary.FieldStatus)
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlaceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(place.FieldCreatedBy) {
+		fields = append(fields, place.FieldCreatedBy)
 	}
-	if m.created_at != nil {
-		fields = append(fields, itinerary.FieldCreatedAt)
+	if m.FieldCleared(place.FieldUpdatedBy) {
+		fields = append(fields, place.FieldUpdatedBy)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, itinerary.FieldUpdatedAt)
+	if m.FieldCleared(place.FieldDeletedAt) {
+		fields = append(fields, place.FieldDeletedAt)
 	}
-	if m.created_by != nil {
-		fields = append(fields, itinerary.FieldCreatedBy)
+	if m.FieldCleared(place.FieldDeletedBy) {
+		fields = append(fields, place.FieldDeletedBy)
 	}
-	if m.updated_by != nil {
-		fields = append(fields, itinerary.FieldUpdatedBy)
+	if m.FieldCleared(place.FieldMetadata) {
+		fields = append(fields, place.FieldMetadata)
 	}
-	if m.metadata != nil {
-		fields = append(fields, itinerary.FieldMetadata)
+	if m.FieldCleared(place.FieldSubtitle) {
+		fields = append(fields, place.FieldSubtitle)
 	}
-	if m.user != nil {
-		fields = append(fields, itinerary.FieldUserID)
+	if m.FieldCleared(place.FieldShortDescription) {
+		fields = append(fields, place.FieldShortDescription)
 	}
-	if m.title != nil {
-		fields = append(fields, itinerary.FieldTitle)
+	if m.FieldCleared(place.FieldLongDescription) {
+		fields = append(fields, place.FieldLongDescription)
 	}
-	if m.description != nil {
-		fields = append(fields, itinerary.FieldDescription)
+	if m.FieldCleared(place.FieldAddress) {
+		fields = append(fields, place.FieldAddress)
 	}
-	if m.planned_date != nil {
-		fields = append(fields, itinerary.FieldPlannedDate)
+	if m.FieldCleared(place.FieldGeohash) {
+		fields = append(fields, place.FieldGeohash)
 	}
-	if m.start_latitude != nil {
-		fields = append(fields, itinerary.FieldStartLatitude)
+	if m.FieldCleared(place.FieldPrimaryImageURL) {
+		fields = append(fields, place.FieldPrimaryImageURL)
 	}
-	if m.start_longitude != nil {
-		fields = append(fields, itinerary.FieldStartLongitude)
+	if m.FieldCleared(place.FieldThumbnailURL) {
+		fields = append(fields, place.FieldThumbnailURL)
 	}
-	if m.preferred_transport_mode != nil {
-		fields = append(fields, itinerary.FieldPreferredTransportMode)
+	if m.FieldCleared(place.FieldLastViewedAt) {
+		fields = append(fields, place.FieldLastViewedAt)
 	}
-	if m.total_distance_km != nil {
-		fields = append(fields, itinerary.FieldTotalDistanceKm)
+	if m.FieldCleared(place.FieldOpeningHours) {
+		fields = append(fields, place.FieldOpeningHours)
 	}
-	if m.total_duration_minutes != nil {
-		fields = append(fields, itinerary.FieldTotalDurationMinutes)
+	if m.FieldCleared(place.FieldPriceInfo) {
+		fields = append(fields, place.FieldPriceInfo)
 	}
-	if m.total_visit_time_minutes != nil {
-		fields = append(fields, itinerary.FieldTotalVisitTimeMinutes)
+	if m.FieldCleared(place.FieldContact) {
+		fields = append(fields, place.FieldContact)
 	}
-	if m.is_optimized != nil {
-		fields = append(fields, itinerary.FieldIsOptimized)
+	if m.FieldCleared(place.FieldAccessibility) {
+		fields = append(fields, place.FieldAccessibility)
+	}
+	if m.FieldCleared(place.FieldExternalRefs) {
+		fields = append(fields, place.FieldExternalRefs)
+	}
+	if m.FieldCleared(place.FieldGeofenceRadiusM) {
+		fields = append(fields, place.FieldGeofenceRadiusM)
+	}
+	if m.FieldCleared(place.FieldPublishAt) {
+		fields = append(fields, place.FieldPublishAt)
+	}
+	if m.FieldCleared(place.FieldUnpublishAt) {
+		fields = append(fields, place.FieldUnpublishAt)
 	}
 	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ItineraryMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case itinerary.FieldStatus:
-		return m.Status()
-	case itinerary.FieldCreatedAt:
-		return m.CreatedAt()
-	case itinerary.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case itinerary.FieldCreatedBy:
-		return m.CreatedBy()
-	case itinerary.FieldUpdatedBy:
-		return m.UpdatedBy()
-	case itinerary.FieldMetadata:
-		return m.Metadata()
-	case itinerary.FieldUserID:
-		return m.UserID()
-	case itinerary.FieldTitle:
-		return m.Title()
-	case itinerary.FieldDescription:
-		return m.Description()
-	case itinerary.FieldPlannedDate:
-		return m.PlannedDate()
-	case itinerary.FieldStartLatitude:
-		return m.StartLatitude()
-	case itinerary.FieldStartLongitude:
-		return m.StartLongitude()
-	case itinerary.FieldPreferredTransportMode:
-		return m.PreferredTransportMode()
-	case itinerary.FieldTotalDistanceKm:
-		return m.TotalDistanceKm()
-	case itinerary.FieldTotalDurationMinutes:
-		return m.TotalDurationMinutes()
-	case itinerary.FieldTotalVisitTimeMinutes:
-		return m.TotalVisitTimeMinutes()
-	case itinerary.FieldIsOptimized:
-		return m.IsOptimized()
-	}
-	return nil, false
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlaceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ItineraryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlaceMutation) ClearField(name string) error {
 	switch name {
-	case itinerary.FieldStatus:
-		return m.OldStatus(ctx)
-	case itinerary.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case itinerary.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case itinerary.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case itinerary.FieldUpdatedBy:
-		return m.OldUpdatedBy(ctx)
-	case itinerary.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case itinerary.FieldUserID:
-		return m.OldUserID(ctx)
-	case itinerary.FieldTitle:
-		return m.OldTitle(ctx)
-	case itinerary.FieldDescription:
-		return m.OldDescription(ctx)
-	case itinerary.FieldPlannedDate:
-		return m.OldPlannedDate(ctx)
-	case itinerary.FieldStartLatitude:
-		return m.OldStartLatitude(ctx)
-	case itinerary.FieldStartLongitude:
-		return m.OldStartLongitude(ctx)
-	case itinerary.FieldPreferredTransportMode:
-		return m.OldPreferredTransportMode(ctx)
-	case itinerary.FieldTotalDistanceKm:
-		return m.OldTotalDistanceKm(ctx)
-	case itinerary.FieldTotalDurationMinutes:
-		return m.OldTotalDurationMinutes(ctx)
-	case itinerary.FieldTotalVisitTimeMinutes:
-		return m.OldTotalVisitTimeMinutes(ctx)
-	case itinerary.FieldIsOptimized:
-		return m.OldIsOptimized(ctx)
+	case place.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case place.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	case place.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case place.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
+	case place.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case place.FieldSubtitle:
+		m.ClearSubtitle()
+		return nil
+	case place.FieldShortDescription:
+		m.ClearShortDescription()
+		return nil
+	case place.FieldLongDescription:
+		m.ClearLongDescription()
+		return nil
+	case place.FieldAddress:
+		m.ClearAddress()
+		return nil
+	case place.FieldGeohash:
+		m.ClearGeohash()
+		return nil
+	case place.FieldPrimaryImageURL:
+		m.ClearPrimaryImageURL()
+		return nil
+	case place.FieldThumbnailURL:
+		m.ClearThumbnailURL()
+		return nil
+	case place.FieldLastViewedAt:
+		m.ClearLastViewedAt()
+		return nil
+	case place.FieldOpeningHours:
+		m.ClearOpeningHours()
+		return nil
+	case place.FieldPriceInfo:
+		m.ClearPriceInfo()
+		return nil
+	case place.FieldContact:
+		m.ClearContact()
+		return nil
+	case place.FieldAccessibility:
+		m.ClearAccessibility()
+		return nil
+	case place.FieldExternalRefs:
+		m.ClearExternalRefs()
+		return nil
+	case place.FieldGeofenceRadiusM:
+		m.ClearGeofenceRadiusM()
+		return nil
+	case place.FieldPublishAt:
+		m.ClearPublishAt()
+		return nil
+	case place.FieldUnpublishAt:
+		m.ClearUnpublishAt()
+		return nil
 	}
-	return nil, fmt.Errorf("unknown Itinerary field %s", name)
+	return fmt.Errorf("unknown Place nullable field %s", name)
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ItineraryMutation) SetField(name string, value ent.Value) error {
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlaceMutation) ResetField(name string) error {
 	switch name {
-	case itinerary.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
+	case place.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case place.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case itinerary.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
+	case place.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case itinerary.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
+	case place.FieldCreatedBy:
+		m.ResetCreatedBy()
 		return nil
-	case itinerary.FieldCreatedBy:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedBy(v)
+	case place.FieldUpdatedBy:
+		m.ResetUpdatedBy()
 		return nil
-	case itinerary.FieldUpdatedBy:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedBy(v)
+	case place.FieldDeletedAt:
+		m.ResetDeletedAt()
 		return nil
-	case itinerary.FieldMetadata:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMetadata(v)
+	case place.FieldDeletedBy:
+		m.ResetDeletedBy()
 		return nil
-	case itinerary.FieldUserID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
+	case place.FieldMetadata:
+		m.ResetMetadata()
 		return nil
-	case itinerary.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
+	case place.FieldSlug:
+		m.ResetSlug()
 		return nil
-	case itinerary.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
+	case place.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case itinerary.FieldPlannedDate:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPlannedDate(v)
+	case place.FieldSubtitle:
+		m.ResetSubtitle()
 		return nil
-	case itinerary.FieldStartLatitude:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartLatitude(v)
+	case place.FieldShortDescription:
+		m.ResetShortDescription()
 		return nil
-	case itinerary.FieldStartLongitude:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartLongitude(v)
+	case place.FieldLongDescription:
+		m.ResetLongDescription()
 		return nil
-	case itinerary.FieldPreferredTransportMode:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPreferredTransportMode(v)
+	case place.FieldPlaceType:
+		m.ResetPlaceType()
 		return nil
-	case itinerary.FieldTotalDistanceKm:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotalDistanceKm(v)
+	case place.FieldAddress:
+		m.ResetAddress()
 		return nil
-	case itinerary.FieldTotalDurationMinutes:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotalDurationMinutes(v)
+	case place.FieldLatitude:
+		m.ResetLatitude()
 		return nil
-	case itinerary.FieldTotalVisitTimeMinutes:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotalVisitTimeMinutes(v)
+	case place.FieldLongitude:
+		m.ResetLongitude()
 		return nil
-	case itinerary.FieldIsOptimized:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsOptimized(v)
+	case place.FieldGeohash:
+		m.ResetGeohash()
+		return nil
+	case place.FieldPrimaryImageURL:
+		m.ResetPrimaryImageURL()
+		return nil
+	case place.FieldThumbnailURL:
+		m.ResetThumbnailURL()
+		return nil
+	case place.FieldViewCount:
+		m.ResetViewCount()
+		return nil
+	case place.FieldRatingAvg:
+		m.ResetRatingAvg()
+		return nil
+	case place.FieldRatingCount:
+		m.ResetRatingCount()
+		return nil
+	case place.FieldLastViewedAt:
+		m.ResetLastViewedAt()
+		return nil
+	case place.FieldPopularityScore:
+		m.ResetPopularityScore()
+		return nil
+	case place.FieldAvgVisitMinutes:
+		m.ResetAvgVisitMinutes()
+		return nil
+	case place.FieldOpeningHours:
+		m.ResetOpeningHours()
+		return nil
+	case place.FieldPriceInfo:
+		m.ResetPriceInfo()
+		return nil
+	case place.FieldContact:
+		m.ResetContact()
+		return nil
+	case place.FieldAccessibility:
+		m.ResetAccessibility()
+		return nil
+	case place.FieldExternalRefs:
+		m.ResetExternalRefs()
+		return nil
+	case place.FieldGeofenceRadiusM:
+		m.ResetGeofenceRadiusM()
+		return nil
+	case place.FieldPublishAt:
+		m.ResetPublishAt()
+		return nil
+	case place.FieldUnpublishAt:
+		m.ResetUnpublishAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Itinerary field %s", name)
+	return fmt.Errorf("unknown Place field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ItineraryMutation) AddedFields() []string {
-	var fields []string
-	if m.addtotal_distance_km != nil {
-		fields = append(fields, itinerary.FieldTotalDistanceKm)
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlaceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.images != nil {
+		edges = append(edges, place.EdgeImages)
 	}
-	if m.addtotal_duration_minutes != nil {
-		fields = append(fields, itinerary.FieldTotalDurationMinutes)
+	if m.category != nil {
+		edges = append(edges, place.EdgeCategory)
 	}
-	if m.addtotal_visit_time_minutes != nil {
-		fields = append(fields, itinerary.FieldTotalVisitTimeMinutes)
+	if m.visits != nil {
+		edges = append(edges, place.EdgeVisits)
 	}
-	return fields
+	if m.revisions != nil {
+		edges = append(edges, place.EdgeRevisions)
+	}
+	if m.slug_history != nil {
+		edges = append(edges, place.EdgeSlugHistory)
+	}
+	return edges
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ItineraryMutation) AddedField(name string) (ent.Value, bool) {
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlaceMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case itinerary.FieldTotalDistanceKm:
-		return m.AddedTotalDistanceKm()
-	case itinerary.FieldTotalDurationMinutes:
-		return m.AddedTotalDurationMinutes()
-	case itinerary.FieldTotalVisitTimeMinutes:
-		return m.AddedTotalVisitTimeMinutes()
+	case place.EdgeImages:
+		ids := make([]ent.Value, 0, len(m.images))
+		for id := range m.images {
+			ids = append(ids, id)
+		}
+		return ids
+	case place.EdgeCategory:
+		ids := make([]ent.Value, 0, len(m.category))
+		for id := range m.category {
+			ids = append(ids, id)
+		}
+		return ids
+	case place.EdgeVisits:
+		ids := make([]ent.Value, 0, len(m.visits))
+		for id := range m.visits {
+			ids = append(ids, id)
+		}
+		return ids
+	case place.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case place.EdgeSlugHistory:
+		ids := make([]ent.Value, 0, len(m.slug_history))
+		for id := range m.slug_history {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return nil, false
+	return nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ItineraryMutation) AddField(name string, value ent.Value) error {
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlaceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedimages != nil {
+		edges = append(edges, place.EdgeImages)
+	}
+	if m.removedcategory != nil {
+		edges = append(edges, place.EdgeCategory)
+	}
+	if m.removedvisits != nil {
+		edges = append(edges, place.EdgeVisits)
+	}
+	if m.removedrevisions != nil {
+		edges = append(edges, place.EdgeRevisions)
+	}
+	if m.removedslug_history != nil {
+		edges = append(edges, place.EdgeSlugHistory)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlaceMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case itinerary.FieldTotalDistanceKm:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+	case place.EdgeImages:
+		ids := make([]ent.Value, 0, len(m.removedimages))
+		for id := range m.removedimages {
+			ids = append(ids, id)
+		}
+		return ids
+	case place.EdgeCategory:
+		ids := make([]ent.Value, 0, len(m.removedcategory))
+		for id := range m.removedcategory {
+			ids = append(ids, id)
 		}
-		m.AddTotalDistanceKm(v)
-		return nil
-	case itinerary.FieldTotalDurationMinutes:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		return ids
+	case place.EdgeVisits:
+		ids := make([]ent.Value, 0, len(m.removedvisits))
+		for id := range m.removedvisits {
+			ids = append(ids, id)
 		}
-		m.AddTotalDurationMinutes(v)
-		return nil
-	case itinerary.FieldTotalVisitTimeMinutes:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		return ids
+	case place.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
 		}
-		m.AddTotalVisitTimeMinutes(v)
-		return nil
+		return ids
+	case place.EdgeSlugHistory:
+		ids := make([]ent.Value, 0, len(m.removedslug_history))
+		for id := range m.removedslug_history {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return fmt.Errorf("unknown Itinerary numeric field %s", name)
+	return nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ItineraryMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(itinerary.FieldCreatedBy) {
-		fields = append(fields, itinerary.FieldCreatedBy)
-	}
-	if m.FieldCleared(itinerary.FieldUpdatedBy) {
-		fields = append(fields, itinerary.FieldUpdatedBy)
-	}
-	if m.FieldCleared(itinerary.FieldMetadata) {
-		fields = append(fields, itinerary.FieldMetadata)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlaceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedimages {
+		edges = append(edges, place.EdgeImages)
 	}
-	if m.FieldCleared(itinerary.FieldDescription) {
-		fields = append(fields, itinerary.FieldDescription)
+	if m.clearedcategory {
+		edges = append(edges, place.EdgeCategory)
 	}
-	if m.FieldCleared(itinerary.FieldTotalDistanceKm) {
-		fields = append(fields, itinerary.FieldTotalDistanceKm)
+	if m.clearedvisits {
+		edges = append(edges, place.EdgeVisits)
 	}
-	if m.FieldCleared(itinerary.FieldTotalDurationMinutes) {
-		fields = append(fields, itinerary.FieldTotalDurationMinutes)
+	if m.clearedrevisions {
+		edges = append(edges, place.EdgeRevisions)
 	}
-	if m.FieldCleared(itinerary.FieldTotalVisitTimeMinutes) {
-		fields = append(fields, itinerary.FieldTotalVisitTimeMinutes)
+	if m.clearedslug_history {
+		edges = append(edges, place.EdgeSlugHistory)
 	}
-	return fields
+	return edges
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ItineraryMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlaceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case place.EdgeImages:
+		return m.clearedimages
+	case place.EdgeCategory:
+		return m.clearedcategory
+	case place.EdgeVisits:
+		return m.clearedvisits
+	case place.EdgeRevisions:
+		return m.clearedrevisions
+	case place.EdgeSlugHistory:
+		return m.clearedslug_history
+	}
+	return false
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ItineraryMutation) ClearField(name string) error {
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlaceMutation) ClearEdge(name string) error {
 	switch name {
-	case itinerary.FieldCreatedBy:
-		m.ClearCreatedBy()
-		return nil
-	case itinerary.FieldUpdatedBy:
-		m.ClearUpdatedBy()
-		return nil
-	case itinerary.FieldMetadata:
-		m.ClearMetadata()
-		return nil
-	case itinerary.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case itinerary.FieldTotalDistanceKm:
-		m.ClearTotalDistanceKm()
-		return nil
-	case itinerary.FieldTotalDurationMinutes:
-		m.ClearTotalDurationMinutes()
-		return nil
-	case itinerary.FieldTotalVisitTimeMinutes:
-		m.ClearTotalVisitTimeMinutes()
-		return nil
 	}
-	return fmt.Errorf("unknown Itinerary nullable field %s", name)
+	return fmt.Errorf("unknown Place unique edge %s", name)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ItineraryMutation) ResetField(name string) error {
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlaceMutation) ResetEdge(name string) error {
 	switch name {
-	case itinerary.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case itinerary.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case itinerary.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case itinerary.FieldCreatedBy:
-		m.ResetCreatedBy()
-		return nil
-	case itinerary.FieldUpdatedBy:
-		m.ResetUpdatedBy()
-		return nil
-	case itinerary.FieldMetadata:
-		m.ResetMetadata()
-		return nil
-	case itinerary.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case itinerary.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case itinerary.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case itinerary.FieldPlannedDate:
-		m.ResetPlannedDate()
-		return nil
-	case itinerary.FieldStartLatitude:
-		m.ResetStartLatitude()
-		return nil
-	case itinerary.FieldStartLongitude:
-		m.ResetStartLongitude()
-		return nil
-	case itinerary.FieldPreferredTransportMode:
-		m.ResetPreferredTransportMode()
+	case place.EdgeImages:
+		m.ResetImages()
 		return nil
-	case itinerary.FieldTotalDistanceKm:
-		m.ResetTotalDistanceKm()
+	case place.EdgeCategory:
+		m.ResetCategory()
 		return nil
-	case itinerary.FieldTotalDurationMinutes:
-		m.ResetTotalDurationMinutes()
+	case place.EdgeVisits:
+		m.ResetVisits()
 		return nil
-	case itinerary.FieldTotalVisitTimeMinutes:
-		m.ResetTotalVisitTimeMinutes()
+	case place.EdgeRevisions:
+		m.ResetRevisions()
 		return nil
-	case itinerary.FieldIsOptimized:
-		m.ResetIsOptimized()
+	case place.EdgeSlugHistory:
+		m.ResetSlugHistory()
 		return nil
 	}
-	return fmt.Errorf("unknown Itinerary field %s", name)
+	return fmt.Errorf("unknown Place edge %s", name)
+}
+
+// PlaceImageMutation represents an operation that mutates the PlaceImage nodes in the graph.
+type PlaceImageMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	status        *types.Status
+	created_at    *time.Time
+	updated_at    *time.Time
+	created_by    *string
+	updated_by    *string
+	deleted_at    *time.Time
+	deleted_by    *string
+	metadata      *map[string]string
+	url           *string
+	alt           *string
+	pos           *int
+	addpos        *int
+	clearedFields map[string]struct{}
+	place         *string
+	clearedplace  bool
+	done          bool
+	oldValue      func(context.Context) (*PlaceImage, error)
+	predicates    []predicate.PlaceImage
+}
+
+var _ ent.Mutation = (*PlaceImageMutation)(nil)
+
+// placeimageOption allows management of the mutation configuration using functional options.
+type placeimageOption func(*PlaceImageMutation)
+
+// newPlaceImageMutation creates new mutation for the PlaceImage entity.
+func newPlaceImageMutation(c config, op Op, opts ...placeimageOption) *PlaceImageMutation {
+	m := &PlaceImageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlaceImage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlaceImageID sets the ID field of the mutation.
+func withPlaceImageID(id string) placeimageOption {
+	return func(m *PlaceImageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PlaceImage
+		)
+		m.oldValue = func(ctx context.Context) (*PlaceImage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PlaceImage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlaceImage sets the old PlaceImage of the mutation.
+func withPlaceImage(node *PlaceImage) placeimageOption {
+	return func(m *PlaceImageMutation) {
+		m.oldValue = func(context.Context) (*PlaceImage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlaceImageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlaceImageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ItineraryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, itinerary.EdgeUser)
-	}
-	if m.visits != nil {
-		edges = append(edges, itinerary.EdgeVisits)
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PlaceImage entities.
+func (m *PlaceImageMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlaceImageMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return edges
+	return *m.id, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ItineraryMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case itinerary.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case itinerary.EdgeVisits:
-		ids := make([]ent.Value, 0, len(m.visits))
-		for id := range m.visits {
-			ids = append(ids, id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlaceImageMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
 		}
-		return ids
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PlaceImage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ItineraryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedvisits != nil {
-		edges = append(edges, itinerary.EdgeVisits)
+// SetStatus sets the "status" field.
+func (m *PlaceImageMutation) SetStatus(t types.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PlaceImageMutation) Status() (r types.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ItineraryMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case itinerary.EdgeVisits:
-		ids := make([]ent.Value, 0, len(m.removedvisits))
-		for id := range m.removedvisits {
-			ids = append(ids, id)
-		}
-		return ids
+// OldStatus returns the old "status" field's value of the PlaceImage entity.
+// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceImageMutation) OldStatus(ctx context.Context) (v types.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ItineraryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, itinerary.EdgeUser)
+// ResetStatus resets all changes to the "status" field.
+func (m *PlaceImageMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PlaceImageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PlaceImageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	if m.clearedvisits {
-		edges = append(edges, itinerary.EdgeVisits)
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PlaceImage entity.
+// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceImageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return edges
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ItineraryMutation) EdgeCleared(name string) bool {
-	switch name {
-	case itinerary.EdgeUser:
-		return m.cleareduser
-	case itinerary.EdgeVisits:
-		return m.clearedvisits
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PlaceImageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PlaceImageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PlaceImageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return false
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ItineraryMutation) ClearEdge(name string) error {
-	switch name {
-	case itinerary.EdgeUser:
-		m.ClearUser()
-		return nil
+// OldUpdatedAt returns the old "updated_at" field's value of the PlaceImage entity.
+// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceImageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Itinerary unique edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ItineraryMutation) ResetEdge(name string) error {
-	switch name {
-	case itinerary.EdgeUser:
-		m.ResetUser()
-		return nil
-	case itinerary.EdgeVisits:
-		m.ResetVisits()
-		return nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PlaceImageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *PlaceImageMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *PlaceImageMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Itinerary edge %s", name)
+	return *v, true
 }
 
-// PlaceMutation represents an operation that mutates the Place nodes in the graph.
-type PlaceMutation struct {
-	config
-	op                   Op
-	typ                  string
-	id                   *string
-	status               *string
-	created_at           *time.Time
-	updated_at           *time.Time
-	created_by           *string
-	updated_by           *string
-	metadata             *map[string]string
-	slug                 *string
-	title                *string
-	subtitle             *string
-	short_description    *string
-	long_description     *string
-	place_type           *string
-	address              *map[string]string
-	latitude             *decimal.Decimal
-	longitude            *decimal.Decimal
-	primary_image_url    *string
-	thumbnail_url        *string
-	view_count           *int
-	addview_count        *int
-	rating_avg           *decimal.Decimal
-	rating_count         *int
-	addrating_count      *int
-	last_viewed_at       *time.Time
-	popularity_score     *decimal.Decimal
-	avg_visit_minutes    *int
-	addavg_visit_minutes *int
-	opening_hours        *map[string]string
-	clearedFields        map[string]struct{}
-	images               map[string]struct{}
-	removedimages        map[string]struct{}
-	clearedimages        bool
-	category             map[string]struct{}
-	removedcategory      map[string]struct{}
-	clearedcategory      bool
-	visits               map[string]struct{}
-	removedvisits        map[string]struct{}
-	clearedvisits        bool
-	done                 bool
-	oldValue             func(context.Context) (*Place, error)
-	predicates           []predicate.Place
+// OldCreatedBy returns the old "created_by" field's value of the PlaceImage entity.
+// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceImageMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
 }
 
-var _ ent.Mutation = (*PlaceMutation)(nil)
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *PlaceImageMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[placeimage.FieldCreatedBy] = struct{}{}
+}
 
-// placeOption allows management of the mutation configuration using functional options.
-type placeOption func(*PlaceMutation)
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *PlaceImageMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[placeimage.FieldCreatedBy]
+	return ok
+}
 
-// newPlaceMutation creates new mutation for the Place entity.
-func newPlaceMutation(c config, op Op, opts ...placeOption) *PlaceMutation {
-	m := &PlaceMutation{
-		config:        c,
-		op:            op,
-		typ:           TypePlace,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *PlaceImageMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, placeimage.FieldCreatedBy)
 }
 
-// withPlaceID sets the ID field of the mutation.
-func withPlaceID(id string) placeOption {
-	return func(m *PlaceMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Place
-		)
-		m.oldValue = func(ctx context.Context) (*Place, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Place.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// SetUpdatedBy sets the "updated_by" field.
+func (m *PlaceImageMutation) SetUpdatedBy(s string) {
+	m.updated_by = &s
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *PlaceImageMutation) UpdatedBy() (r string, exists bool) {
+	v := m.updated_by
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withPlace sets the old Place of the mutation.
-func withPlace(node *Place) placeOption {
-	return func(m *PlaceMutation) {
-		m.oldValue = func(context.Context) (*Place, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldUpdatedBy returns the old "updated_by" field's value of the PlaceImage entity.
+// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceImageMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
 	}
+	return oldValue.UpdatedBy, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PlaceMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *PlaceImageMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.clearedFields[placeimage.FieldUpdatedBy] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PlaceMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *PlaceImageMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[placeimage.FieldUpdatedBy]
+	return ok
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Place entities.
-func (m *PlaceMutation) SetID(id string) {
-	m.id = &id
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *PlaceImageMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	delete(m.clearedFields, placeimage.FieldUpdatedBy)
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *PlaceMutation) ID() (id string, exists bool) {
-	if m.id == nil {
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PlaceImageMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PlaceImageMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PlaceMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Place.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldDeletedAt returns the old "deleted_at" field's value of the PlaceImage entity.
+// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceImageMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
+	return oldValue.DeletedAt, nil
 }
 
-// SetStatus sets the "status" field.
-func (m *PlaceMutation) SetStatus(s string) {
-	m.status = &s
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PlaceImageMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[placeimage.FieldDeletedAt] = struct{}{}
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *PlaceMutation) Status() (r string, exists bool) {
-	v := m.status
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PlaceImageMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[placeimage.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PlaceImageMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, placeimage.FieldDeletedAt)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *PlaceImageMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *PlaceImageMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedBy returns the old "deleted_by" field's value of the PlaceImage entity.
+// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *PlaceImageMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.DeletedBy, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *PlaceMutation) ResetStatus() {
-	m.status = nil
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *PlaceImageMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[placeimage.FieldDeletedBy] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PlaceMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *PlaceImageMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[placeimage.FieldDeletedBy]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PlaceMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *PlaceImageMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, placeimage.FieldDeletedBy)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *PlaceImageMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *PlaceImageMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// OldMetadata returns the old "metadata" field's value of the PlaceImage entity.
+// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PlaceImageMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Metadata, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PlaceMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearMetadata clears the value of the "metadata" field.
+func (m *PlaceImageMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[placeimage.FieldMetadata] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PlaceMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *PlaceImageMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[placeimage.FieldMetadata]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PlaceMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *PlaceImageMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, placeimage.FieldMetadata)
+}
+
+// SetPlaceID sets the "place_id" field.
+func (m *PlaceImageMutation) SetPlaceID(s string) {
+	m.place = &s
+}
+
+// PlaceID returns the value of the "place_id" field in the mutation.
+func (m *PlaceImageMutation) PlaceID() (r string, exists bool) {
+	v := m.place
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// OldPlaceID returns the old "place_id" field's value of the PlaceImage entity.
+// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PlaceImageMutation) OldPlaceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPlaceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldPlaceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPlaceID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.PlaceID, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PlaceMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetPlaceID resets all changes to the "place_id" field.
+func (m *PlaceImageMutation) ResetPlaceID() {
+	m.place = nil
 }
-
-// SetCreatedBy sets the "created_by" field.
-func (m *PlaceMutation) SetCreatedBy(s string) {
-	m.created_by = &s
+
+// SetURL sets the "url" field.
+func (m *PlaceImageMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *PlaceMutation) CreatedBy() (r string, exists bool) {
-	v := m.created_by
+// URL returns the value of the "url" field in the mutation.
+func (m *PlaceImageMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the PlaceImage entity.
+// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+func (m *PlaceImageMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.CreatedBy, nil
-}
-
-// ClearCreatedBy clears the value of the "created_by" field.
-func (m *PlaceMutation) ClearCreatedBy() {
-	m.created_by = nil
-	m.clearedFields[place.FieldCreatedBy] = struct{}{}
-}
-
-// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *PlaceMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[place.FieldCreatedBy]
-	return ok
+	return oldValue.URL, nil
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *PlaceMutation) ResetCreatedBy() {
-	m.created_by = nil
-	delete(m.clearedFields, place.FieldCreatedBy)
+// ResetURL resets all changes to the "url" field.
+func (m *PlaceImageMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetUpdatedBy sets the "updated_by" field.
-func (m *PlaceMutation) SetUpdatedBy(s string) {
-	m.updated_by = &s
+// SetAlt sets the "alt" field.
+func (m *PlaceImageMutation) SetAlt(s string) {
+	m.alt = &s
 }
 
-// UpdatedBy returns the value of the "updated_by" field in the mutation.
-func (m *PlaceMutation) UpdatedBy() (r string, exists bool) {
-	v := m.updated_by
+// Alt returns the value of the "alt" field in the mutation.
+func (m *PlaceImageMutation) Alt() (r string, exists bool) {
+	v := m.alt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedBy returns the old "updated_by" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// OldAlt returns the old "alt" field's value of the PlaceImage entity.
+// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
+func (m *PlaceImageMutation) OldAlt(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldAlt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+		return v, errors.New("OldAlt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldAlt: %w", err)
 	}
-	return oldValue.UpdatedBy, nil
+	return oldValue.Alt, nil
 }
 
-// ClearUpdatedBy clears the value of the "updated_by" field.
-func (m *PlaceMutation) ClearUpdatedBy() {
-	m.updated_by = nil
-	m.clearedFields[place.FieldUpdatedBy] = struct{}{}
+// ClearAlt clears the value of the "alt" field.
+func (m *PlaceImageMutation) ClearAlt() {
+	m.alt = nil
+	m.clearedFields[placeimage.FieldAlt] = struct{}{}
 }
 
-// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
-func (m *PlaceMutation) UpdatedByCleared() bool {
-	_, ok := m.clearedFields[place.FieldUpdatedBy]
+// AltCleared returns if the "alt" field was cleared in this mutation.
+func (m *PlaceImageMutation) AltCleared() bool {
+	_, ok := m.clearedFields[placeimage.FieldAlt]
 	return ok
 }
 
-// ResetUpdatedBy resets all changes to the "updated_by" field.
-func (m *PlaceMutation) ResetUpdatedBy() {
-	m.updated_by = nil
-	delete(m.clearedFields, place.FieldUpdatedBy)
+// ResetAlt resets all changes to the "alt" field.
+func (m *PlaceImageMutation) ResetAlt() {
+	m.alt = nil
+	delete(m.clearedFields, placeimage.FieldAlt)
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *PlaceMutation) SetMetadata(value map[string]string) {
-	m.metadata = &value
+// SetPos sets the "pos" field.
+func (m *PlaceImageMutation) SetPos(i int) {
+	m.pos = &i
+	m.addpos = nil
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *PlaceMutation) Metadata() (r map[string]string, exists bool) {
-	v := m.metadata
+// Pos returns the value of the "pos" field in the mutation.
+func (m *PlaceImageMutation) Pos() (r int, exists bool) {
+	v := m.pos
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// OldPos returns the old "pos" field's value of the PlaceImage entity.
+// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+func (m *PlaceImageMutation) OldPos(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldPos is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldPos requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldPos: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.Pos, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *PlaceMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[place.FieldMetadata] = struct{}{}
+// AddPos adds i to the "pos" field.
+func (m *PlaceImageMutation) AddPos(i int) {
+	if m.addpos != nil {
+		*m.addpos += i
+	} else {
+		m.addpos = &i
+	}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *PlaceMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[place.FieldMetadata]
-	return ok
+// AddedPos returns the value that was added to the "pos" field in this mutation.
+func (m *PlaceImageMutation) AddedPos() (r int, exists bool) {
+	v := m.addpos
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *PlaceMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, place.FieldMetadata)
+// ResetPos resets all changes to the "pos" field.
+func (m *PlaceImageMutation) ResetPos() {
+	m.pos = nil
+	m.addpos = nil
 }
 
-// SetSlug sets the "slug" field.
-func (m *PlaceMutation) SetSlug(s string) {
-	m.slug = &s
+// ClearPlace clears the "place" edge to the Place entity.
+func (m *PlaceImageMutation) ClearPlace() {
+	m.clearedplace = true
+	m.clearedFields[placeimage.FieldPlaceID] = struct{}{}
 }
 
-// Slug returns the value of the "slug" field in the mutation.
-func (m *PlaceMutation) Slug() (r string, exists bool) {
-	v := m.slug
-	if v == nil {
-		return
-	}
-	return *v, true
+// PlaceCleared reports if the "place" edge to the Place entity was cleared.
+func (m *PlaceImageMutation) PlaceCleared() bool {
+	return m.clearedplace
 }
 
-// OldSlug returns the old "slug" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldSlug(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlug requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+// PlaceIDs returns the "place" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlaceID instead. It exists only for internal usage by the builders.
+func (m *PlaceImageMutation) PlaceIDs() (ids []string) {
+	if id := m.place; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Slug, nil
+	return
 }
 
-// ResetSlug resets all changes to the "slug" field.
-func (m *PlaceMutation) ResetSlug() {
-	m.slug = nil
+// ResetPlace resets all changes to the "place" edge.
+func (m *PlaceImageMutation) ResetPlace() {
+	m.place = nil
+	m.clearedplace = false
 }
 
-// SetTitle sets the "title" field.
-func (m *PlaceMutation) SetTitle(s string) {
-	m.title = &s
+// Where appends a list predicates to the PlaceImageMutation builder.
+func (m *PlaceImageMutation) Where(ps ...predicate.PlaceImage) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *PlaceMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the PlaceImageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PlaceImageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PlaceImage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldTitle returns the old "title" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+// Op returns the operation name.
+func (m *PlaceImageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PlaceImageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PlaceImage).
+func (m *PlaceImageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlaceImageMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.status != nil {
+		fields = append(fields, placeimage.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, placeimage.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, placeimage.FieldUpdatedAt)
+	}
+	if m.created_by != nil {
+		fields = append(fields, placeimage.FieldCreatedBy)
+	}
+	if m.updated_by != nil {
+		fields = append(fields, placeimage.FieldUpdatedBy)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, placeimage.FieldDeletedAt)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, placeimage.FieldDeletedBy)
+	}
+	if m.metadata != nil {
+		fields = append(fields, placeimage.FieldMetadata)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+	if m.place != nil {
+		fields = append(fields, placeimage.FieldPlaceID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	if m.url != nil {
+		fields = append(fields, placeimage.FieldURL)
 	}
-	return oldValue.Title, nil
-}
-
-// ResetTitle resets all changes to the "title" field.
-func (m *PlaceMutation) ResetTitle() {
-	m.title = nil
-}
-
-// SetSubtitle sets the "subtitle" field.
-func (m *PlaceMutation) SetSubtitle(s string) {
-	m.subtitle = &s
-}
-
-// Subtitle returns the value of the "subtitle" field in the mutation.
-func (m *PlaceMutation) Subtitle() (r string, exists bool) {
-	v := m.subtitle
-	if v == nil {
-		return
+	if m.alt != nil {
+		fields = append(fields, placeimage.FieldAlt)
 	}
-	return *v, true
+	if m.pos != nil {
+		fields = append(fields, placeimage.FieldPos)
+	}
+	return fields
 }
 
-// OldSubtitle returns the old "subtitle" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldSubtitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubtitle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubtitle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubtitle: %w", err)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlaceImageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case placeimage.FieldStatus:
+		return m.Status()
+	case placeimage.FieldCreatedAt:
+		return m.CreatedAt()
+	case placeimage.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case placeimage.FieldCreatedBy:
+		return m.CreatedBy()
+	case placeimage.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case placeimage.FieldDeletedAt:
+		return m.DeletedAt()
+	case placeimage.FieldDeletedBy:
+		return m.DeletedBy()
+	case placeimage.FieldMetadata:
+		return m.Metadata()
+	case placeimage.FieldPlaceID:
+		return m.PlaceID()
+	case placeimage.FieldURL:
+		return m.URL()
+	case placeimage.FieldAlt:
+		return m.Alt()
+	case placeimage.FieldPos:
+		return m.Pos()
 	}
-	return oldValue.Subtitle, nil
+	return nil, false
 }
 
-// ClearSubtitle clears the value of the "subtitle" field.
-func (m *PlaceMutation) ClearSubtitle() {
-	m.subtitle = nil
-	m.clearedFields[place.FieldSubtitle] = struct{}{}
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlaceImageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case placeimage.FieldStatus:
+		return m.OldStatus(ctx)
+	case placeimage.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case placeimage.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case placeimage.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case placeimage.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case placeimage.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case placeimage.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	case placeimage.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case placeimage.FieldPlaceID:
+		return m.OldPlaceID(ctx)
+	case placeimage.FieldURL:
+		return m.OldURL(ctx)
+	case placeimage.FieldAlt:
+		return m.OldAlt(ctx)
+	case placeimage.FieldPos:
+		return m.OldPos(ctx)
+	}
+	return nil, fmt.Errorf("unknown PlaceImage field %s", name)
 }
 
-// SubtitleCleared returns if the "subtitle" field was cleared in this mutation.
-func (m *PlaceMutation) SubtitleCleared() bool {
-	_, ok := m.clearedFields[place.FieldSubtitle]
-	return ok
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlaceImageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case placeimage.FieldStatus:
+		v, ok := value.(types.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case placeimage.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case placeimage.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case placeimage.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case placeimage.FieldUpdatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case placeimage.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case placeimage.FieldDeletedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
+	case placeimage.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case placeimage.FieldPlaceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlaceID(v)
+		return nil
+	case placeimage.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case placeimage.FieldAlt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlt(v)
+		return nil
+	case placeimage.FieldPos:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPos(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PlaceImage field %s", name)
 }
 
-// ResetSubtitle resets all changes to the "subtitle" field.
-func (m *PlaceMutation) ResetSubtitle() {
-	m.subtitle = nil
-	delete(m.clearedFields, place.FieldSubtitle)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlaceImageMutation) AddedFields() []string {
+	var fields []string
+	if m.addpos != nil {
+		fields = append(fields, placeimage.FieldPos)
+	}
+	return fields
 }
 
-// SetShortDescription sets the "short_description" field.
-func (m *PlaceMutation) SetShortDescription(s string) {
-	m.short_description = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlaceImageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case placeimage.FieldPos:
+		return m.AddedPos()
+	}
+	return nil, false
 }
 
-// ShortDescription returns the value of the "short_description" field in the mutation.
-func (m *PlaceMutation) ShortDescription() (r string, exists bool) {
-	v := m.short_description
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlaceImageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case placeimage.FieldPos:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPos(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown PlaceImage numeric field %s", name)
 }
 
-// OldShortDescription returns the old "short_description" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldShortDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldShortDescription is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlaceImageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(placeimage.FieldCreatedBy) {
+		fields = append(fields, placeimage.FieldCreatedBy)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldShortDescription requires an ID field in the mutation")
+	if m.FieldCleared(placeimage.FieldUpdatedBy) {
+		fields = append(fields, placeimage.FieldUpdatedBy)
+	}
+	if m.FieldCleared(placeimage.FieldDeletedAt) {
+		fields = append(fields, placeimage.FieldDeletedAt)
+	}
+	if m.FieldCleared(placeimage.FieldDeletedBy) {
+		fields = append(fields, placeimage.FieldDeletedBy)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldShortDescription: %w", err)
+	if m.FieldCleared(placeimage.FieldMetadata) {
+		fields = append(fields, placeimage.FieldMetadata)
 	}
-	return oldValue.ShortDescription, nil
-}
-
-// ClearShortDescription clears the value of the "short_description" field.
-func (m *PlaceMutation) ClearShortDescription() {
-	m.short_description = nil
-	m.clearedFields[place.FieldShortDescription] = struct{}{}
+	if m.FieldCleared(placeimage.FieldAlt) {
+		fields = append(fields, placeimage.FieldAlt)
+	}
+	return fields
 }
 
-// ShortDescriptionCleared returns if the "short_description" field was cleared in this mutation.
-func (m *PlaceMutation) ShortDescriptionCleared() bool {
-	_, ok := m.clearedFields[place.FieldShortDescription]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlaceImageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetShortDescription resets all changes to the "short_description" field.
-func (m *PlaceMutation) ResetShortDescription() {
-	m.short_description = nil
-	delete(m.clearedFields, place.FieldShortDescription)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlaceImageMutation) ClearField(name string) error {
+	switch name {
+	case placeimage.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case placeimage.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	case placeimage.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case placeimage.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
+	case placeimage.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case placeimage.FieldAlt:
+		m.ClearAlt()
+		return nil
+	}
+	return fmt.Errorf("unknown PlaceImage nullable field %s", name)
 }
 
-// SetLongDescription sets the "long_description" field.
-func (m *PlaceMutation) SetLongDescription(s string) {
-	m.long_description = &s
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlaceImageMutation) ResetField(name string) error {
+	switch name {
+	case placeimage.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case placeimage.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case placeimage.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case placeimage.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case placeimage.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case placeimage.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case placeimage.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
+	case placeimage.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case placeimage.FieldPlaceID:
+		m.ResetPlaceID()
+		return nil
+	case placeimage.FieldURL:
+		m.ResetURL()
+		return nil
+	case placeimage.FieldAlt:
+		m.ResetAlt()
+		return nil
+	case placeimage.FieldPos:
+		m.ResetPos()
+		return nil
+	}
+	return fmt.Errorf("unknown PlaceImage field %s", name)
 }
 
-// LongDescription returns the value of the "long_description" field in the mutation.
-func (m *PlaceMutation) LongDescription() (r string, exists bool) {
-	v := m.long_description
-	if v == nil {
-		return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlaceImageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.place != nil {
+		edges = append(edges, placeimage.EdgePlace)
 	}
-	return *v, true
+	return edges
 }
 
-// OldLongDescription returns the old "long_description" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldLongDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLongDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLongDescription requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLongDescription: %w", err)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlaceImageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case placeimage.EdgePlace:
+		if id := m.place; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.LongDescription, nil
+	return nil
 }
 
-// ClearLongDescription clears the value of the "long_description" field.
-func (m *PlaceMutation) ClearLongDescription() {
-	m.long_description = nil
-	m.clearedFields[place.FieldLongDescription] = struct{}{}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlaceImageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// LongDescriptionCleared returns if the "long_description" field was cleared in this mutation.
-func (m *PlaceMutation) LongDescriptionCleared() bool {
-	_, ok := m.clearedFields[place.FieldLongDescription]
-	return ok
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlaceImageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetLongDescription resets all changes to the "long_description" field.
-func (m *PlaceMutation) ResetLongDescription() {
-	m.long_description = nil
-	delete(m.clearedFields, place.FieldLongDescription)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlaceImageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedplace {
+		edges = append(edges, placeimage.EdgePlace)
+	}
+	return edges
 }
 
-// SetPlaceType sets the "place_type" field.
-func (m *PlaceMutation) SetPlaceType(s string) {
-	m.place_type = &s
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlaceImageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case placeimage.EdgePlace:
+		return m.clearedplace
+	}
+	return false
 }
 
-// PlaceType returns the value of the "place_type" field in the mutation.
-func (m *PlaceMutation) PlaceType() (r string, exists bool) {
-	v := m.place_type
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlaceImageMutation) ClearEdge(name string) error {
+	switch name {
+	case placeimage.EdgePlace:
+		m.ClearPlace()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown PlaceImage unique edge %s", name)
 }
 
-// OldPlaceType returns the old "place_type" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldPlaceType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlaceType is only allowed on UpdateOne operations")
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlaceImageMutation) ResetEdge(name string) error {
+	switch name {
+	case placeimage.EdgePlace:
+		m.ResetPlace()
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlaceType requires an ID field in the mutation")
+	return fmt.Errorf("unknown PlaceImage edge %s", name)
+}
+
+// PlaceRevisionMutation represents an operation that mutates the PlaceRevision nodes in the graph.
+type PlaceRevisionMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *string
+	status           *types.Status
+	created_at       *time.Time
+	updated_at       *time.Time
+	created_by       *string
+	updated_by       *string
+	deleted_at       *time.Time
+	deleted_by       *string
+	revision         *int
+	addrevision      *int
+	snapshot         *json.RawMessage
+	appendsnapshot   json.RawMessage
+	reverted_from    *int
+	addreverted_from *int
+	clearedFields    map[string]struct{}
+	place            *string
+	clearedplace     bool
+	done             bool
+	oldValue         func(context.Context) (*PlaceRevision, error)
+	predicates       []predicate.PlaceRevision
+}
+
+var _ ent.Mutation = (*PlaceRevisionMutation)(nil)
+
+// placerevisionOption allows management of the mutation configuration using functional options.
+type placerevisionOption func(*PlaceRevisionMutation)
+
+// newPlaceRevisionMutation creates new mutation for the PlaceRevision entity.
+func newPlaceRevisionMutation(c config, op Op, opts ...placerevisionOption) *PlaceRevisionMutation {
+	m := &PlaceRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlaceRevision,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlaceType: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.PlaceType, nil
+	return m
 }
 
-// ResetPlaceType resets all changes to the "place_type" field.
-func (m *PlaceMutation) ResetPlaceType() {
-	m.place_type = nil
+// withPlaceRevisionID sets the ID field of the mutation.
+func withPlaceRevisionID(id string) placerevisionOption {
+	return func(m *PlaceRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PlaceRevision
+		)
+		m.oldValue = func(ctx context.Context) (*PlaceRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PlaceRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetAddress sets the "address" field.
-func (m *PlaceMutation) SetAddress(value map[string]string) {
-	m.address = &value
+// withPlaceRevision sets the old PlaceRevision of the mutation.
+func withPlaceRevision(node *PlaceRevision) placerevisionOption {
+	return func(m *PlaceRevisionMutation) {
+		m.oldValue = func(context.Context) (*PlaceRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// Address returns the value of the "address" field in the mutation.
-func (m *PlaceMutation) Address() (r map[string]string, exists bool) {
-	v := m.address
-	if v == nil {
-		return
-	}
-	return *v, true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlaceRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// OldAddress returns the old "address" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldAddress(ctx context.Context) (v map[string]string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAddress requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlaceRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.Address, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearAddress clears the value of the "address" field.
-func (m *PlaceMutation) ClearAddress() {
-	m.address = nil
-	m.clearedFields[place.FieldAddress] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PlaceRevision entities.
+func (m *PlaceRevisionMutation) SetID(id string) {
+	m.id = &id
 }
 
-// AddressCleared returns if the "address" field was cleared in this mutation.
-func (m *PlaceMutation) AddressCleared() bool {
-	_, ok := m.clearedFields[place.FieldAddress]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlaceRevisionMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetAddress resets all changes to the "address" field.
-func (m *PlaceMutation) ResetAddress() {
-	m.address = nil
-	delete(m.clearedFields, place.FieldAddress)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlaceRevisionMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PlaceRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetLatitude sets the "latitude" field.
-func (m *PlaceMutation) SetLatitude(d decimal.Decimal) {
-	m.latitude = &d
+// SetStatus sets the "status" field.
+func (m *PlaceRevisionMutation) SetStatus(t types.Status) {
+	m.status = &t
 }
 
-// Latitude returns the value of the "latitude" field in the mutation.
-func (m *PlaceMutation) Latitude() (r decimal.Decimal, exists bool) {
-	v := m.latitude
+// Status returns the value of the "status" field in the mutation.
+func (m *PlaceRevisionMutation) Status() (r types.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLatitude returns the old "latitude" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the PlaceRevision entity.
+// If the PlaceRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldLatitude(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *PlaceRevisionMutation) OldStatus(ctx context.Context) (v types.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLatitude is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLatitude requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLatitude: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Latitude, nil
+	return oldValue.Status, nil
 }
 
-// ResetLatitude resets all changes to the "latitude" field.
-func (m *PlaceMutation) ResetLatitude() {
-	m.latitude = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *PlaceRevisionMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetLongitude sets the "longitude" field.
-func (m *PlaceMutation) SetLongitude(d decimal.Decimal) {
-	m.longitude = &d
+// SetCreatedAt sets the "created_at" field.
+func (m *PlaceRevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Longitude returns the value of the "longitude" field in the mutation.
-func (m *PlaceMutation) Longitude() (r decimal.Decimal, exists bool) {
-	v := m.longitude
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PlaceRevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLongitude returns the old "longitude" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the PlaceRevision entity.
+// If the PlaceRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldLongitude(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *PlaceRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLongitude is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLongitude requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLongitude: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Longitude, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetLongitude resets all changes to the "longitude" field.
-func (m *PlaceMutation) ResetLongitude() {
-	m.longitude = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PlaceRevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetPrimaryImageURL sets the "primary_image_url" field.
-func (m *PlaceMutation) SetPrimaryImageURL(s string) {
-	m.primary_image_url = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PlaceRevisionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// PrimaryImageURL returns the value of the "primary_image_url" field in the mutation.
-func (m *PlaceMutation) PrimaryImageURL() (r string, exists bool) {
-	v := m.primary_image_url
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PlaceRevisionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrimaryImageURL returns the old "primary_image_url" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the PlaceRevision entity.
+// If the PlaceRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldPrimaryImageURL(ctx context.Context) (v string, err error) {
+func (m *PlaceRevisionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrimaryImageURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrimaryImageURL requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrimaryImageURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.PrimaryImageURL, nil
-}
-
-// ClearPrimaryImageURL clears the value of the "primary_image_url" field.
-func (m *PlaceMutation) ClearPrimaryImageURL() {
-	m.primary_image_url = nil
-	m.clearedFields[place.FieldPrimaryImageURL] = struct{}{}
-}
-
-// PrimaryImageURLCleared returns if the "primary_image_url" field was cleared in this mutation.
-func (m *PlaceMutation) PrimaryImageURLCleared() bool {
-	_, ok := m.clearedFields[place.FieldPrimaryImageURL]
-	return ok
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetPrimaryImageURL resets all changes to the "primary_image_url" field.
-func (m *PlaceMutation) ResetPrimaryImageURL() {
-	m.primary_image_url = nil
-	delete(m.clearedFields, place.FieldPrimaryImageURL)
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PlaceRevisionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetThumbnailURL sets the "thumbnail_url" field.
-func (m *PlaceMutation) SetThumbnailURL(s string) {
-	m.thumbnail_url = &s
+// SetCreatedBy sets the "created_by" field.
+func (m *PlaceRevisionMutation) SetCreatedBy(s string) {
+	m.created_by = &s
 }
 
-// ThumbnailURL returns the value of the "thumbnail_url" field in the mutation.
-func (m *PlaceMutation) ThumbnailURL() (r string, exists bool) {
-	v := m.thumbnail_url
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *PlaceRevisionMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldThumbnailURL returns the old "thumbnail_url" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the PlaceRevision entity.
+// If the PlaceRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldThumbnailURL(ctx context.Context) (v string, err error) {
+func (m *PlaceRevisionMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThumbnailURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThumbnailURL requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThumbnailURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.ThumbnailURL, nil
+	return oldValue.CreatedBy, nil
 }
 
-// ClearThumbnailURL clears the value of the "thumbnail_url" field.
-func (m *PlaceMutation) ClearThumbnailURL() {
-	m.thumbnail_url = nil
-	m.clearedFields[place.FieldThumbnailURL] = struct{}{}
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *PlaceRevisionMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[placerevision.FieldCreatedBy] = struct{}{}
 }
 
-// ThumbnailURLCleared returns if the "thumbnail_url" field was cleared in this mutation.
-func (m *PlaceMutation) ThumbnailURLCleared() bool {
-	_, ok := m.clearedFields[place.FieldThumbnailURL]
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *PlaceRevisionMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[placerevision.FieldCreatedBy]
 	return ok
 }
 
-// ResetThumbnailURL resets all changes to the "thumbnail_url" field.
-func (m *PlaceMutation) ResetThumbnailURL() {
-	m.thumbnail_url = nil
-	delete(m.clearedFields, place.FieldThumbnailURL)
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *PlaceRevisionMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, placerevision.FieldCreatedBy)
 }
 
-// SetViewCount sets the "view_count" field.
-func (m *PlaceMutation) SetViewCount(i int) {
-	m.view_count = &i
-	m.addview_count = nil
+// SetUpdatedBy sets the "updated_by" field.
+func (m *PlaceRevisionMutation) SetUpdatedBy(s string) {
+	m.updated_by = &s
 }
 
-// ViewCount returns the value of the "view_count" field in the mutation.
-func (m *PlaceMutation) ViewCount() (r int, exists bool) {
-	v := m.view_count
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *PlaceRevisionMutation) UpdatedBy() (r string, exists bool) {
+	v := m.updated_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldViewCount returns the old "view_count" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedBy returns the old "updated_by" field's value of the PlaceRevision entity.
+// If the PlaceRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldViewCount(ctx context.Context) (v int, err error) {
+func (m *PlaceRevisionMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldViewCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldViewCount requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldViewCount: %w", err)
-	}
-	return oldValue.ViewCount, nil
-}
-
-// AddViewCount adds i to the "view_count" field.
-func (m *PlaceMutation) AddViewCount(i int) {
-	if m.addview_count != nil {
-		*m.addview_count += i
-	} else {
-		m.addview_count = &i
-	}
-}
-
-// AddedViewCount returns the value that was added to the "view_count" field in this mutation.
-func (m *PlaceMutation) AddedViewCount() (r int, exists bool) {
-	v := m.addview_count
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
 	}
-	return *v, true
-}
-
-// ResetViewCount resets all changes to the "view_count" field.
-func (m *PlaceMutation) ResetViewCount() {
-	m.view_count = nil
-	m.addview_count = nil
-}
-
-// SetRatingAvg sets the "rating_avg" field.
-func (m *PlaceMutation) SetRatingAvg(d decimal.Decimal) {
-	m.rating_avg = &d
+	return oldValue.UpdatedBy, nil
 }
 
-// RatingAvg returns the value of the "rating_avg" field in the mutation.
-func (m *PlaceMutation) RatingAvg() (r decimal.Decimal, exists bool) {
-	v := m.rating_avg
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *PlaceRevisionMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.clearedFields[placerevision.FieldUpdatedBy] = struct{}{}
 }
 
-// OldRatingAvg returns the old "rating_avg" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldRatingAvg(ctx context.Context) (v decimal.Decimal, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRatingAvg is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRatingAvg requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRatingAvg: %w", err)
-	}
-	return oldValue.RatingAvg, nil
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *PlaceRevisionMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[placerevision.FieldUpdatedBy]
+	return ok
 }
 
-// ResetRatingAvg resets all changes to the "rating_avg" field.
-func (m *PlaceMutation) ResetRatingAvg() {
-	m.rating_avg = nil
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *PlaceRevisionMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	delete(m.clearedFields, placerevision.FieldUpdatedBy)
 }
 
-// SetRatingCount sets the "rating_count" field.
-func (m *PlaceMutation) SetRatingCount(i int) {
-	m.rating_count = &i
-	m.addrating_count = nil
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PlaceRevisionMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// RatingCount returns the value of the "rating_count" field in the mutation.
-func (m *PlaceMutation) RatingCount() (r int, exists bool) {
-	v := m.rating_count
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PlaceRevisionMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRatingCount returns the old "rating_count" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the PlaceRevision entity.
+// If the PlaceRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldRatingCount(ctx context.Context) (v int, err error) {
+func (m *PlaceRevisionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRatingCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRatingCount requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRatingCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.RatingCount, nil
+	return oldValue.DeletedAt, nil
 }
 
-// AddRatingCount adds i to the "rating_count" field.
-func (m *PlaceMutation) AddRatingCount(i int) {
-	if m.addrating_count != nil {
-		*m.addrating_count += i
-	} else {
-		m.addrating_count = &i
-	}
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PlaceRevisionMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[placerevision.FieldDeletedAt] = struct{}{}
 }
 
-// AddedRatingCount returns the value that was added to the "rating_count" field in this mutation.
-func (m *PlaceMutation) AddedRatingCount() (r int, exists bool) {
-	v := m.addrating_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PlaceRevisionMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[placerevision.FieldDeletedAt]
+	return ok
 }
 
-// ResetRatingCount resets all changes to the "rating_count" field.
-func (m *PlaceMutation) ResetRatingCount() {
-	m.rating_count = nil
-	m.addrating_count = nil
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PlaceRevisionMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, placerevision.FieldDeletedAt)
 }
 
-// SetLastViewedAt sets the "last_viewed_at" field.
-func (m *PlaceMutation) SetLastViewedAt(t time.Time) {
-	m.last_viewed_at = &t
+// SetDeletedBy sets the "deleted_by" field.
+func (m *PlaceRevisionMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
 }
 
-// LastViewedAt returns the value of the "last_viewed_at" field in the mutation.
-func (m *PlaceMutation) LastViewedAt() (r time.Time, exists bool) {
-	v := m.last_viewed_at
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *PlaceRevisionMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastViewedAt returns the old "last_viewed_at" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedBy returns the old "deleted_by" field's value of the PlaceRevision entity.
+// If the PlaceRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldLastViewedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PlaceRevisionMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastViewedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastViewedAt requires an ID field in the mutation")
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastViewedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
 	}
-	return oldValue.LastViewedAt, nil
+	return oldValue.DeletedBy, nil
 }
 
-// ClearLastViewedAt clears the value of the "last_viewed_at" field.
-func (m *PlaceMutation) ClearLastViewedAt() {
-	m.last_viewed_at = nil
-	m.clearedFields[place.FieldLastViewedAt] = struct{}{}
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *PlaceRevisionMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[placerevision.FieldDeletedBy] = struct{}{}
 }
 
-// LastViewedAtCleared returns if the "last_viewed_at" field was cleared in this mutation.
-func (m *PlaceMutation) LastViewedAtCleared() bool {
-	_, ok := m.clearedFields[place.FieldLastViewedAt]
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *PlaceRevisionMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[placerevision.FieldDeletedBy]
 	return ok
 }
 
-// ResetLastViewedAt resets all changes to the "last_viewed_at" field.
-func (m *PlaceMutation) ResetLastViewedAt() {
-	m.last_viewed_at = nil
-	delete(m.clearedFields, place.FieldLastViewedAt)
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *PlaceRevisionMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, placerevision.FieldDeletedBy)
 }
 
-// SetPopularityScore sets the "popularity_score" field.
-func (m *PlaceMutation) SetPopularityScore(d decimal.Decimal) {
-	m.popularity_score = &d
+// SetPlaceID sets the "place_id" field.
+func (m *PlaceRevisionMutation) SetPlaceID(s string) {
+	m.place = &s
 }
 
-// PopularityScore returns the value of the "popularity_score" field in the mutation.
-func (m *PlaceMutation) PopularityScore() (r decimal.Decimal, exists bool) {
-	v := m.popularity_score
+// PlaceID returns the value of the "place_id" field in the mutation.
+func (m *PlaceRevisionMutation) PlaceID() (r string, exists bool) {
+	v := m.place
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPopularityScore returns the old "popularity_score" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// OldPlaceID returns the old "place_id" field's value of the PlaceRevision entity.
+// If the PlaceRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldPopularityScore(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *PlaceRevisionMutation) OldPlaceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPopularityScore is only allowed on UpdateOne operations")
+		return v, errors.New("OldPlaceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPopularityScore requires an ID field in the mutation")
+		return v, errors.New("OldPlaceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPopularityScore: %w", err)
+		return v, fmt.Errorf("querying old value for OldPlaceID: %w", err)
 	}
-	return oldValue.PopularityScore, nil
+	return oldValue.PlaceID, nil
 }
 
-// ResetPopularityScore resets all changes to the "popularity_score" field.
-func (m *PlaceMutation) ResetPopularityScore() {
-	m.popularity_score = nil
+// ResetPlaceID resets all changes to the "place_id" field.
+func (m *PlaceRevisionMutation) ResetPlaceID() {
+	m.place = nil
 }
 
-// SetAvgVisitMinutes sets the "avg_visit_minutes" field.
-func (m *PlaceMutation) SetAvgVisitMinutes(i int) {
-	m.avg_visit_minutes = &i
-	m.addavg_visit_minutes = nil
+// SetRevision sets the "revision" field.
+func (m *PlaceRevisionMutation) SetRevision(i int) {
+	m.revision = &i
+	m.addrevision = nil
 }
 
-// AvgVisitMinutes returns the value of the "avg_visit_minutes" field in the mutation.
-func (m *PlaceMutation) AvgVisitMinutes() (r int, exists bool) {
-	v := m.avg_visit_minutes
+// Revision returns the value of the "revision" field in the mutation.
+func (m *PlaceRevisionMutation) Revision() (r int, exists bool) {
+	v := m.revision
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvgVisitMinutes returns the old "avg_visit_minutes" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// OldRevision returns the old "revision" field's value of the PlaceRevision entity.
+// If the PlaceRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldAvgVisitMinutes(ctx context.Context) (v int, err error) {
+func (m *PlaceRevisionMutation) OldRevision(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvgVisitMinutes is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevision is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvgVisitMinutes requires an ID field in the mutation")
+		return v, errors.New("OldRevision requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvgVisitMinutes: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
 	}
-	return oldValue.AvgVisitMinutes, nil
+	return oldValue.Revision, nil
 }
 
-// AddAvgVisitMinutes adds i to the "avg_visit_minutes" field.
-func (m *PlaceMutation) AddAvgVisitMinutes(i int) {
-	if m.addavg_visit_minutes != nil {
-		*m.addavg_visit_minutes += i
+// AddRevision adds i to the "revision" field.
+func (m *PlaceRevisionMutation) AddRevision(i int) {
+	if m.addrevision != nil {
+		*m.addrevision += i
 	} else {
-		m.addavg_visit_minutes = &i
+		m.addrevision = &i
 	}
 }
 
-// AddedAvgVisitMinutes returns the value that was added to the "avg_visit_minutes" field in this mutation.
-func (m *PlaceMutation) AddedAvgVisitMinutes() (r int, exists bool) {
-	v := m.addavg_visit_minutes
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *PlaceRevisionMutation) AddedRevision() (r int, exists bool) {
+	v := m.addrevision
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetAvgVisitMinutes resets all changes to the "avg_visit_minutes" field.
-func (m *PlaceMutation) ResetAvgVisitMinutes() {
-	m.avg_visit_minutes = nil
-	m.addavg_visit_minutes = nil
+// ResetRevision resets all changes to the "revision" field.
+func (m *PlaceRevisionMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
 }
 
-// SetOpeningHours sets the "opening_hours" field.
-func (m *PlaceMutation) SetOpeningHours(value map[string]string) {
-	m.opening_hours = &value
+// SetSnapshot sets the "snapshot" field.
+func (m *PlaceRevisionMutation) SetSnapshot(jm json.RawMessage) {
+	m.snapshot = &jm
+	m.appendsnapshot = nil
 }
 
-// OpeningHours returns the value of the "opening_hours" field in the mutation.
-func (m *PlaceMutation) OpeningHours() (r map[string]string, exists bool) {
-	v := m.opening_hours
+// Snapshot returns the value of the "snapshot" field in the mutation.
+func (m *PlaceRevisionMutation) Snapshot() (r json.RawMessage, exists bool) {
+	v := m.snapshot
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOpeningHours returns the old "opening_hours" field's value of the Place entity.
-// If the Place object wasn't provided to the builder, the object is fetched from the database.
+// OldSnapshot returns the old "snapshot" field's value of the PlaceRevision entity.
+// If the PlaceRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceMutation) OldOpeningHours(ctx context.Context) (v map[string]string, err error) {
+func (m *PlaceRevisionMutation) OldSnapshot(ctx context.Context) (v json.RawMessage, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOpeningHours is only allowed on UpdateOne operations")
+		return v, errors.New("OldSnapshot is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOpeningHours requires an ID field in the mutation")
+		return v, errors.New("OldSnapshot requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOpeningHours: %w", err)
+		return v, fmt.Errorf("querying old value for OldSnapshot: %w", err)
 	}
-	return oldValue.OpeningHours, nil
-}
-
-// ClearOpeningHours clears the value of the "opening_hours" field.
-func (m *PlaceMutation) ClearOpeningHours() {
-	m.opening_hours = nil
-	m.clearedFields[place.FieldOpeningHours] = struct{}{}
-}
-
-// OpeningHoursCleared returns if the "opening_hours" field was cleared in this mutation.
-func (m *PlaceMutation) OpeningHoursCleared() bool {
-	_, ok := m.clearedFields[place.FieldOpeningHours]
-	return ok
+	return oldValue.Snapshot, nil
 }
 
-// ResetOpeningHours resets all changes to the "opening_hours" field.
-func (m *PlaceMutation) ResetOpeningHours() {
-	m.opening_hours = nil
-	delete(m.clearedFields, place.FieldOpeningHours)
+// AppendSnapshot adds jm to the "snapshot" field.
+func (m *PlaceRevisionMutation) AppendSnapshot(jm json.RawMessage) {
+	m.appendsnapshot = append(m.appendsnapshot, jm...)
 }
 
-// AddImageIDs adds the "images" edge to the PlaceImage entity by ids.
-func (m *PlaceMutation) AddImageIDs(ids ...string) {
-	if m.images == nil {
-		m.images = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.images[ids[i]] = struct{}{}
+// AppendedSnapshot returns the list of values that were appended to the "snapshot" field in this mutation.
+func (m *PlaceRevisionMutation) AppendedSnapshot() (json.RawMessage, bool) {
+	if len(m.appendsnapshot) == 0 {
+		return nil, false
 	}
+	return m.appendsnapshot, true
 }
 
-// ClearImages clears the "images" edge to the PlaceImage entity.
-func (m *PlaceMutation) ClearImages() {
-	m.clearedimages = true
-}
-
-// ImagesCleared reports if the "images" edge to the PlaceImage entity was cleared.
-func (m *PlaceMutation) ImagesCleared() bool {
-	return m.clearedimages
-}
-
-// RemoveImageIDs removes the "images" edge to the PlaceImage entity by IDs.
-func (m *PlaceMutation) RemoveImageIDs(ids ...string) {
-	if m.removedimages == nil {
-		m.removedimages = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.images, ids[i])
-		m.removedimages[ids[i]] = struct{}{}
-	}
+// ResetSnapshot resets all changes to the "snapshot" field.
+func (m *PlaceRevisionMutation) ResetSnapshot() {
+	m.snapshot = nil
+	m.appendsnapshot = nil
 }
 
-// RemovedImages returns the removed IDs of the "images" edge to the PlaceImage entity.
-func (m *PlaceMutation) RemovedImagesIDs() (ids []string) {
-	for id := range m.removedimages {
-		ids = append(ids, id)
-	}
-	return
+// SetRevertedFrom sets the "reverted_from" field.
+func (m *PlaceRevisionMutation) SetRevertedFrom(i int) {
+	m.reverted_from = &i
+	m.addreverted_from = nil
 }
 
-// ImagesIDs returns the "images" edge IDs in the mutation.
-func (m *PlaceMutation) ImagesIDs() (ids []string) {
-	for id := range m.images {
-		ids = append(ids, id)
+// RevertedFrom returns the value of the "reverted_from" field in the mutation.
+func (m *PlaceRevisionMutation) RevertedFrom() (r int, exists bool) {
+	v := m.reverted_from
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetImages resets all changes to the "images" edge.
-func (m *PlaceMutation) ResetImages() {
-	m.images = nil
-	m.clearedimages = false
-	m.removedimages = nil
+	return *v, true
 }
 
-// AddCategoryIDs adds the "category" edge to the Category entity by ids.
-func (m *PlaceMutation) AddCategoryIDs(ids ...string) {
-	if m.category == nil {
-		m.category = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.category[ids[i]] = struct{}{}
+// OldRevertedFrom returns the old "reverted_from" field's value of the PlaceRevision entity.
+// If the PlaceRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceRevisionMutation) OldRevertedFrom(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevertedFrom is only allowed on UpdateOne operations")
 	}
-}
-
-// ClearCategory clears the "category" edge to the Category entity.
-func (m *PlaceMutation) ClearCategory() {
-	m.clearedcategory = true
-}
-
-// CategoryCleared reports if the "category" edge to the Category entity was cleared.
-func (m *PlaceMutation) CategoryCleared() bool {
-	return m.clearedcategory
-}
-
-// RemoveCategoryIDs removes the "category" edge to the Category entity by IDs.
-func (m *PlaceMutation) RemoveCategoryIDs(ids ...string) {
-	if m.removedcategory == nil {
-		m.removedcategory = make(map[string]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevertedFrom requires an ID field in the mutation")
 	}
-	for i := range ids {
-		delete(m.category, ids[i])
-		m.removedcategory[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevertedFrom: %w", err)
 	}
+	return oldValue.RevertedFrom, nil
 }
 
-// RemovedCategory returns the removed IDs of the "category" edge to the Category entity.
-func (m *PlaceMutation) RemovedCategoryIDs() (ids []string) {
-	for id := range m.removedcategory {
-		ids = append(ids, id)
+// AddRevertedFrom adds i to the "reverted_from" field.
+func (m *PlaceRevisionMutation) AddRevertedFrom(i int) {
+	if m.addreverted_from != nil {
+		*m.addreverted_from += i
+	} else {
+		m.addreverted_from = &i
 	}
-	return
 }
 
-// CategoryIDs returns the "category" edge IDs in the mutation.
-func (m *PlaceMutation) CategoryIDs() (ids []string) {
-	for id := range m.category {
-		ids = append(ids, id)
+// AddedRevertedFrom returns the value that was added to the "reverted_from" field in this mutation.
+func (m *PlaceRevisionMutation) AddedRevertedFrom() (r int, exists bool) {
+	v := m.addreverted_from
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetCategory resets all changes to the "category" edge.
-func (m *PlaceMutation) ResetCategory() {
-	m.category = nil
-	m.clearedcategory = false
-	m.removedcategory = nil
+	return *v, true
 }
 
-// AddVisitIDs adds the "visits" edge to the Visit entity by ids.
-func (m *PlaceMutation) AddVisitIDs(ids ...string) {
-	if m.visits == nil {
-		m.visits = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.visits[ids[i]] = struct{}{}
-	}
+// ClearRevertedFrom clears the value of the "reverted_from" field.
+func (m *PlaceRevisionMutation) ClearRevertedFrom() {
+	m.reverted_from = nil
+	m.addreverted_from = nil
+	m.clearedFields[placerevision.FieldRevertedFrom] = struct{}{}
 }
 
-// ClearVisits clears the "visits" edge to the Visit entity.
-func (m *PlaceMutation) ClearVisits() {
-	m.clearedvisits = true
+// RevertedFromCleared returns if the "reverted_from" field was cleared in this mutation.
+func (m *PlaceRevisionMutation) RevertedFromCleared() bool {
+	_, ok := m.clearedFields[placerevision.FieldRevertedFrom]
+	return ok
 }
 
-// VisitsCleared reports if the "visits" edge to the Visit entity was cleared.
-func (m *PlaceMutation) VisitsCleared() bool {
-	return m.clearedvisits
+// ResetRevertedFrom resets all changes to the "reverted_from" field.
+func (m *PlaceRevisionMutation) ResetRevertedFrom() {
+	m.reverted_from = nil
+	m.addreverted_from = nil
+	delete(m.clearedFields, placerevision.FieldRevertedFrom)
 }
 
-// RemoveVisitIDs removes the "visits" edge to the Visit entity by IDs.
-func (m *PlaceMutation) RemoveVisitIDs(ids ...string) {
-	if m.removedvisits == nil {
-		m.removedvisits = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.visits, ids[i])
-		m.removedvisits[ids[i]] = struct{}{}
-	}
+// ClearPlace clears the "place" edge to the Place entity.
+func (m *PlaceRevisionMutation) ClearPlace() {
+	m.clearedplace = true
+	m.clearedFields[placerevision.FieldPlaceID] = struct{}{}
 }
 
-// RemovedVisits returns the removed IDs of the "visits" edge to the Visit entity.
-func (m *PlaceMutation) RemovedVisitsIDs() (ids []string) {
-	for id := range m.removedvisits {
-		ids = append(ids, id)
-	}
-	return
+// PlaceCleared reports if the "place" edge to the Place entity was cleared.
+func (m *PlaceRevisionMutation) PlaceCleared() bool {
+	return m.clearedplace
 }
 
-// VisitsIDs returns the "visits" edge IDs in the mutation.
-func (m *PlaceMutation) VisitsIDs() (ids []string) {
-	for id := range m.visits {
-		ids = append(ids, id)
+// PlaceIDs returns the "place" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlaceID instead. It exists only for internal usage by the builders.
+func (m *PlaceRevisionMutation) PlaceIDs() (ids []string) {
+	if id := m.place; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetVisits resets all changes to the "visits" edge.
-func (m *PlaceMutation) ResetVisits() {
-	m.visits = nil
-	m.clearedvisits = false
-	m.removedvisits = nil
+// ResetPlace resets all changes to the "place" edge.
+func (m *PlaceRevisionMutation) ResetPlace() {
+	m.place = nil
+	m.clearedplace = false
 }
 
-// Where appends a list predicates to the PlaceMutation builder.
-func (m *PlaceMutation) Where(ps ...predicate.Place) {
+// Where appends a list predicates to the PlaceRevisionMutation builder.
+func (m *PlaceRevisionMutation) Where(ps ...predicate.PlaceRevision) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PlaceMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PlaceRevisionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PlaceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Place, len(ps))
+func (m *PlaceRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PlaceRevision, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9644,96 +15985,57 @@ func (m *PlaceMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PlaceMutation) Op() Op {
+func (m *PlaceRevisionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PlaceMutation) SetOp(op Op) {
+func (m *PlaceRevisionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Place).
-func (m *PlaceMutation) Type() string {
+// Type returns the node type of this mutation (PlaceRevision).
+func (m *PlaceRevisionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PlaceMutation) Fields() []string {
-	fields := make([]string, 0, 24)
+func (m *PlaceRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.status != nil {
-		fields = append(fields, place.FieldStatus)
+		fields = append(fields, placerevision.FieldStatus)
 	}
 	if m.created_at != nil {
-		fields = append(fields, place.FieldCreatedAt)
+		fields = append(fields, placerevision.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, place.FieldUpdatedAt)
+		fields = append(fields, placerevision.FieldUpdatedAt)
 	}
 	if m.created_by != nil {
-		fields = append(fields, place.FieldCreatedBy)
+		fields = append(fields, placerevision.FieldCreatedBy)
 	}
 	if m.updated_by != nil {
-		fields = append(fields, place.FieldUpdatedBy)
-	}
-	if m.metadata != nil {
-		fields = append(fields, place.FieldMetadata)
-	}
-	if m.slug != nil {
-		fields = append(fields, place.FieldSlug)
-	}
-	if m.title != nil {
-		fields = append(fields, place.FieldTitle)
-	}
-	if m.subtitle != nil {
-		fields = append(fields, place.FieldSubtitle)
-	}
-	if m.short_description != nil {
-		fields = append(fields, place.FieldShortDescription)
-	}
-	if m.long_description != nil {
-		fields = append(fields, place.FieldLongDescription)
-	}
-	if m.place_type != nil {
-		fields = append(fields, place.FieldPlaceType)
-	}
-	if m.address != nil {
-		fields = append(fields, place.FieldAddress)
-	}
-	if m.latitude != nil {
-		fields = append(fields, place.FieldLatitude)
-	}
-	if m.longitude != nil {
-		fields = append(fields, place.FieldLongitude)
-	}
-	if m.primary_image_url != nil {
-		fields = append(fields, place.FieldPrimaryImageURL)
-	}
-	if m.thumbnail_url != nil {
-		fields = append(fields, place.FieldThumbnailURL)
-	}
-	if m.view_count != nil {
-		fields = append(fields, place.FieldViewCount)
+		fields = append(fields, placerevision.FieldUpdatedBy)
 	}
-	if m.rating_avg != nil {
-		fields = append(fields, place.FieldRatingAvg)
+	if m.deleted_at != nil {
+		fields = append(fields, placerevision.FieldDeletedAt)
 	}
-	if m.rating_count != nil {
-		fields = append(fields, place.FieldRatingCount)
+	if m.deleted_by != nil {
+		fields = append(fields, placerevision.FieldDeletedBy)
 	}
-	if m.last_viewed_at != nil {
-		fields = append(fields, place.FieldLastViewedAt)
+	if m.place != nil {
+		fields = append(fields, placerevision.FieldPlaceID)
 	}
-	if m.popularity_score != nil {
-		fields = append(fields, place.FieldPopularityScore)
+	if m.revision != nil {
+		fields = append(fields, placerevision.FieldRevision)
 	}
-	if m.avg_visit_minutes != nil {
-		fields = append(fields, place.FieldAvgVisitMinutes)
+	if m.snapshot != nil {
+		fields = append(fields, placerevision.FieldSnapshot)
 	}
-	if m.opening_hours != nil {
-		fields = append(fields, place.FieldOpeningHours)
+	if m.reverted_from != nil {
+		fields = append(fields, placerevision.FieldRevertedFrom)
 	}
 	return fields
 }
@@ -9741,306 +16043,160 @@ func (m *PlaceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PlaceMutation) Field(name string) (ent.Value, bool) {
+func (m *PlaceRevisionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case place.FieldStatus:
+	case placerevision.FieldStatus:
 		return m.Status()
-	case place.FieldCreatedAt:
+	case placerevision.FieldCreatedAt:
 		return m.CreatedAt()
-	case place.FieldUpdatedAt:
+	case placerevision.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case place.FieldCreatedBy:
+	case placerevision.FieldCreatedBy:
 		return m.CreatedBy()
-	case place.FieldUpdatedBy:
-		return m.UpdatedBy()
-	case place.FieldMetadata:
-		return m.Metadata()
-	case place.FieldSlug:
-		return m.Slug()
-	case place.FieldTitle:
-		return m.Title()
-	case place.FieldSubtitle:
-		return m.Subtitle()
-	case place.FieldShortDescription:
-		return m.ShortDescription()
-	case place.FieldLongDescription:
-		return m.LongDescription()
-	case place.FieldPlaceType:
-		return m.PlaceType()
-	case place.FieldAddress:
-		return m.Address()
-	case place.FieldLatitude:
-		return m.Latitude()
-	case place.FieldLongitude:
-		return m.Longitude()
-	case place.FieldPrimaryImageURL:
-		return m.PrimaryImageURL()
-	case place.FieldThumbnailURL:
-		return m.ThumbnailURL()
-	case place.FieldViewCount:
-		return m.ViewCount()
-	case place.FieldRatingAvg:
-		return m.RatingAvg()
-	case place.FieldRatingCount:
-		return m.RatingCount()
-	case place.FieldLastViewedAt:
-		return m.LastViewedAt()
-	case place.FieldPopularityScore:
-		return m.PopularityScore()
-	case place.FieldAvgVisitMinutes:
-		return m.AvgVisitMinutes()
-	case place.FieldOpeningHours:
-		return m.OpeningHours()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *PlaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case place.FieldStatus:
-		return m.OldStatus(ctx)
-	case place.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case place.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case place.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case place.FieldUpdatedBy:
-		return m.OldUpdatedBy(ctx)
-	case place.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case place.FieldSlug:
-		return m.OldSlug(ctx)
-	case place.FieldTitle:
-		return m.OldTitle(ctx)
-	case place.FieldSubtitle:
-		return m.OldSubtitle(ctx)
-	case place.FieldShortDescription:
-		return m.OldShortDescription(ctx)
-	case place.FieldLongDescription:
-		return m.OldLongDescription(ctx)
-	case place.FieldPlaceType:
-		return m.OldPlaceType(ctx)
-	case place.FieldAddress:
-		return m.OldAddress(ctx)
-	case place.FieldLatitude:
-		return m.OldLatitude(ctx)
-	case place.FieldLongitude:
-		return m.OldLongitude(ctx)
-	case place.FieldPrimaryImageURL:
-		return m.OldPrimaryImageURL(ctx)
-	case place.FieldThumbnailURL:
-		return m.OldThumbnailURL(ctx)
-	case place.FieldViewCount:
-		return m.OldViewCount(ctx)
-	case place.FieldRatingAvg:
-		return m.OldRatingAvg(ctx)
-	case place.FieldRatingCount:
-		return m.OldRatingCount(ctx)
-	case place.FieldLastViewedAt:
-		return m.OldLastViewedAt(ctx)
-	case place.FieldPopularityScore:
-		return m.OldPopularityScore(ctx)
-	case place.FieldAvgVisitMinutes:
-		return m.OldAvgVisitMinutes(ctx)
-	case place.FieldOpeningHours:
-		return m.OldOpeningHours(ctx)
-	}
-	return nil, fmt.Errorf("unknown Place field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PlaceMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case place.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case place.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case place.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case place.FieldCreatedBy:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedBy(v)
-		return nil
-	case place.FieldUpdatedBy:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedBy(v)
-		return nil
-	case place.FieldMetadata:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMetadata(v)
-		return nil
-	case place.FieldSlug:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSlug(v)
-		return nil
-	case place.FieldTitle:
-		v, ok := value.(string)
+	case placerevision.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case placerevision.FieldDeletedAt:
+		return m.DeletedAt()
+	case placerevision.FieldDeletedBy:
+		return m.DeletedBy()
+	case placerevision.FieldPlaceID:
+		return m.PlaceID()
+	case placerevision.FieldRevision:
+		return m.Revision()
+	case placerevision.FieldSnapshot:
+		return m.Snapshot()
+	case placerevision.FieldRevertedFrom:
+		return m.RevertedFrom()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlaceRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case placerevision.FieldStatus:
+		return m.OldStatus(ctx)
+	case placerevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case placerevision.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case placerevision.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case placerevision.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case placerevision.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case placerevision.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	case placerevision.FieldPlaceID:
+		return m.OldPlaceID(ctx)
+	case placerevision.FieldRevision:
+		return m.OldRevision(ctx)
+	case placerevision.FieldSnapshot:
+		return m.OldSnapshot(ctx)
+	case placerevision.FieldRevertedFrom:
+		return m.OldRevertedFrom(ctx)
+	}
+	return nil, fmt.Errorf("unknown PlaceRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlaceRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case placerevision.FieldStatus:
+		v, ok := value.(types.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetStatus(v)
 		return nil
-	case place.FieldSubtitle:
-		v, ok := value.(string)
+	case placerevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSubtitle(v)
+		m.SetCreatedAt(v)
 		return nil
-	case place.FieldShortDescription:
-		v, ok := value.(string)
+	case placerevision.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetShortDescription(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case place.FieldLongDescription:
+	case placerevision.FieldCreatedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLongDescription(v)
+		m.SetCreatedBy(v)
 		return nil
-	case place.FieldPlaceType:
+	case placerevision.FieldUpdatedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPlaceType(v)
-		return nil
-	case place.FieldAddress:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAddress(v)
-		return nil
-	case place.FieldLatitude:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLatitude(v)
+		m.SetUpdatedBy(v)
 		return nil
-	case place.FieldLongitude:
-		v, ok := value.(decimal.Decimal)
+	case placerevision.FieldDeletedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLongitude(v)
+		m.SetDeletedAt(v)
 		return nil
-	case place.FieldPrimaryImageURL:
+	case placerevision.FieldDeletedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrimaryImageURL(v)
+		m.SetDeletedBy(v)
 		return nil
-	case place.FieldThumbnailURL:
+	case placerevision.FieldPlaceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetThumbnailURL(v)
-		return nil
-	case place.FieldViewCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetViewCount(v)
-		return nil
-	case place.FieldRatingAvg:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRatingAvg(v)
+		m.SetPlaceID(v)
 		return nil
-	case place.FieldRatingCount:
+	case placerevision.FieldRevision:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRatingCount(v)
-		return nil
-	case place.FieldLastViewedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastViewedAt(v)
+		m.SetRevision(v)
 		return nil
-	case place.FieldPopularityScore:
-		v, ok := value.(decimal.Decimal)
+	case placerevision.FieldSnapshot:
+		v, ok := value.(json.RawMessage)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPopularityScore(v)
+		m.SetSnapshot(v)
 		return nil
-	case place.FieldAvgVisitMinutes:
+	case placerevision.FieldRevertedFrom:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAvgVisitMinutes(v)
-		return nil
-	case place.FieldOpeningHours:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOpeningHours(v)
+		m.SetRevertedFrom(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Place field %s", name)
+	return fmt.Errorf("unknown PlaceRevision field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PlaceMutation) AddedFields() []string {
+func (m *PlaceRevisionMutation) AddedFields() []string {
 	var fields []string
-	if m.addview_count != nil {
-		fields = append(fields, place.FieldViewCount)
-	}
-	if m.addrating_count != nil {
-		fields = append(fields, place.FieldRatingCount)
+	if m.addrevision != nil {
+		fields = append(fields, placerevision.FieldRevision)
 	}
-	if m.addavg_visit_minutes != nil {
-		fields = append(fields, place.FieldAvgVisitMinutes)
+	if m.addreverted_from != nil {
+		fields = append(fields, placerevision.FieldRevertedFrom)
 	}
 	return fields
 }
@@ -10048,14 +16204,12 @@ func (m *PlaceMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PlaceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PlaceRevisionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case place.FieldViewCount:
-		return m.AddedViewCount()
-	case place.FieldRatingCount:
-		return m.AddedRatingCount()
-	case place.FieldAvgVisitMinutes:
-		return m.AddedAvgVisitMinutes()
+	case placerevision.FieldRevision:
+		return m.AddedRevision()
+	case placerevision.FieldRevertedFrom:
+		return m.AddedRevertedFrom()
 	}
 	return nil, false
 }
@@ -10063,372 +16217,226 @@ func (m *PlaceMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PlaceMutation) AddField(name string, value ent.Value) error {
+func (m *PlaceRevisionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case place.FieldViewCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddViewCount(v)
-		return nil
-	case place.FieldRatingCount:
+	case placerevision.FieldRevision:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRatingCount(v)
+		m.AddRevision(v)
 		return nil
-	case place.FieldAvgVisitMinutes:
+	case placerevision.FieldRevertedFrom:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAvgVisitMinutes(v)
+		m.AddRevertedFrom(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Place numeric field %s", name)
+	return fmt.Errorf("unknown PlaceRevision numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PlaceMutation) ClearedFields() []string {
+func (m *PlaceRevisionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(place.FieldCreatedBy) {
-		fields = append(fields, place.FieldCreatedBy)
-	}
-	if m.FieldCleared(place.FieldUpdatedBy) {
-		fields = append(fields, place.FieldUpdatedBy)
-	}
-	if m.FieldCleared(place.FieldMetadata) {
-		fields = append(fields, place.FieldMetadata)
-	}
-	if m.FieldCleared(place.FieldSubtitle) {
-		fields = append(fields, place.FieldSubtitle)
-	}
-	if m.FieldCleared(place.FieldShortDescription) {
-		fields = append(fields, place.FieldShortDescription)
-	}
-	if m.FieldCleared(place.FieldLongDescription) {
-		fields = append(fields, place.FieldLongDescription)
-	}
-	if m.FieldCleared(place.FieldAddress) {
-		fields = append(fields, place.FieldAddress)
+	if m.FieldCleared(placerevision.FieldCreatedBy) {
+		fields = append(fields, placerevision.FieldCreatedBy)
 	}
-	if m.FieldCleared(place.FieldPrimaryImageURL) {
-		fields = append(fields, place.FieldPrimaryImageURL)
+	if m.FieldCleared(placerevision.FieldUpdatedBy) {
+		fields = append(fields, placerevision.FieldUpdatedBy)
 	}
-	if m.FieldCleared(place.FieldThumbnailURL) {
-		fields = append(fields, place.FieldThumbnailURL)
+	if m.FieldCleared(placerevision.FieldDeletedAt) {
+		fields = append(fields, placerevision.FieldDeletedAt)
 	}
-	if m.FieldCleared(place.FieldLastViewedAt) {
-		fields = append(fields, place.FieldLastViewedAt)
+	if m.FieldCleared(placerevision.FieldDeletedBy) {
+		fields = append(fields, placerevision.FieldDeletedBy)
 	}
-	if m.FieldCleared(place.FieldOpeningHours) {
-		fields = append(fields, place.FieldOpeningHours)
+	if m.FieldCleared(placerevision.FieldRevertedFrom) {
+		fields = append(fields, placerevision.FieldRevertedFrom)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PlaceMutation) FieldCleared(name string) bool {
+func (m *PlaceRevisionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PlaceMutation) ClearField(name string) error {
+func (m *PlaceRevisionMutation) ClearField(name string) error {
 	switch name {
-	case place.FieldCreatedBy:
+	case placerevision.FieldCreatedBy:
 		m.ClearCreatedBy()
 		return nil
-	case place.FieldUpdatedBy:
+	case placerevision.FieldUpdatedBy:
 		m.ClearUpdatedBy()
 		return nil
-	case place.FieldMetadata:
-		m.ClearMetadata()
-		return nil
-	case place.FieldSubtitle:
-		m.ClearSubtitle()
-		return nil
-	case place.FieldShortDescription:
-		m.ClearShortDescription()
-		return nil
-	case place.FieldLongDescription:
-		m.ClearLongDescription()
-		return nil
-	case place.FieldAddress:
-		m.ClearAddress()
-		return nil
-	case place.FieldPrimaryImageURL:
-		m.ClearPrimaryImageURL()
-		return nil
-	case place.FieldThumbnailURL:
-		m.ClearThumbnailURL()
+	case placerevision.FieldDeletedAt:
+		m.ClearDeletedAt()
 		return nil
-	case place.FieldLastViewedAt:
-		m.ClearLastViewedAt()
+	case placerevision.FieldDeletedBy:
+		m.ClearDeletedBy()
 		return nil
-	case place.FieldOpeningHours:
-		m.ClearOpeningHours()
+	case placerevision.FieldRevertedFrom:
+		m.ClearRevertedFrom()
 		return nil
 	}
-	return fmt.Errorf("unknown Place nullable field %s", name)
+	return fmt.Errorf("unknown PlaceRevision nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PlaceMutation) ResetField(name string) error {
+func (m *PlaceRevisionMutation) ResetField(name string) error {
 	switch name {
-	case place.FieldStatus:
+	case placerevision.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case place.FieldCreatedAt:
+	case placerevision.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case place.FieldUpdatedAt:
+	case placerevision.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case place.FieldCreatedBy:
+	case placerevision.FieldCreatedBy:
 		m.ResetCreatedBy()
 		return nil
-	case place.FieldUpdatedBy:
+	case placerevision.FieldUpdatedBy:
 		m.ResetUpdatedBy()
 		return nil
-	case place.FieldMetadata:
-		m.ResetMetadata()
-		return nil
-	case place.FieldSlug:
-		m.ResetSlug()
-		return nil
-	case place.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case place.FieldSubtitle:
-		m.ResetSubtitle()
-		return nil
-	case place.FieldShortDescription:
-		m.ResetShortDescription()
-		return nil
-	case place.FieldLongDescription:
-		m.ResetLongDescription()
-		return nil
-	case place.FieldPlaceType:
-		m.ResetPlaceType()
-		return nil
-	case place.FieldAddress:
-		m.ResetAddress()
-		return nil
-	case place.FieldLatitude:
-		m.ResetLatitude()
-		return nil
-	case place.FieldLongitude:
-		m.ResetLongitude()
-		return nil
-	case place.FieldPrimaryImageURL:
-		m.ResetPrimaryImageURL()
+	case placerevision.FieldDeletedAt:
+		m.ResetDeletedAt()
 		return nil
-	case place.FieldThumbnailURL:
-		m.ResetThumbnailURL()
-		return nil
-	case place.FieldViewCount:
-		m.ResetViewCount()
-		return nil
-	case place.FieldRatingAvg:
-		m.ResetRatingAvg()
+	case placerevision.FieldDeletedBy:
+		m.ResetDeletedBy()
 		return nil
-	case place.FieldRatingCount:
-		m.ResetRatingCount()
-		return nil
-	case place.FieldLastViewedAt:
-		m.ResetLastViewedAt()
-		return nil
-	case place.FieldPopularityScore:
-		m.ResetPopularityScore()
+	case placerevision.FieldPlaceID:
+		m.ResetPlaceID()
 		return nil
-	case place.FieldAvgVisitMinutes:
-		m.ResetAvgVisitMinutes()
+	case placerevision.FieldRevision:
+		m.ResetRevision()
 		return nil
-	case place.FieldOpeningHours:
-		m.ResetOpeningHours()
+	case placerevision.FieldSnapshot:
+		m.ResetSnapshot()
+		return nil
+	case placerevision.FieldRevertedFrom:
+		m.ResetRevertedFrom()
 		return nil
 	}
-	return fmt.Errorf("unknown Place field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PlaceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.images != nil {
-		edges = append(edges, place.EdgeImages)
-	}
-	if m.category != nil {
-		edges = append(edges, place.EdgeCategory)
-	}
-	if m.visits != nil {
-		edges = append(edges, place.EdgeVisits)
+	return fmt.Errorf("unknown PlaceRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlaceRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.place != nil {
+		edges = append(edges, placerevision.EdgePlace)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PlaceMutation) AddedIDs(name string) []ent.Value {
+func (m *PlaceRevisionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case place.EdgeImages:
-		ids := make([]ent.Value, 0, len(m.images))
-		for id := range m.images {
-			ids = append(ids, id)
-		}
-		return ids
-	case place.EdgeCategory:
-		ids := make([]ent.Value, 0, len(m.category))
-		for id := range m.category {
-			ids = append(ids, id)
-		}
-		return ids
-	case place.EdgeVisits:
-		ids := make([]ent.Value, 0, len(m.visits))
-		for id := range m.visits {
-			ids = append(ids, id)
+	case placerevision.EdgePlace:
+		if id := m.place; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PlaceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedimages != nil {
-		edges = append(edges, place.EdgeImages)
-	}
-	if m.removedcategory != nil {
-		edges = append(edges, place.EdgeCategory)
-	}
-	if m.removedvisits != nil {
-		edges = append(edges, place.EdgeVisits)
-	}
+func (m *PlaceRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PlaceMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case place.EdgeImages:
-		ids := make([]ent.Value, 0, len(m.removedimages))
-		for id := range m.removedimages {
-			ids = append(ids, id)
-		}
-		return ids
-	case place.EdgeCategory:
-		ids := make([]ent.Value, 0, len(m.removedcategory))
-		for id := range m.removedcategory {
-			ids = append(ids, id)
-		}
-		return ids
-	case place.EdgeVisits:
-		ids := make([]ent.Value, 0, len(m.removedvisits))
-		for id := range m.removedvisits {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *PlaceRevisionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PlaceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedimages {
-		edges = append(edges, place.EdgeImages)
-	}
-	if m.clearedcategory {
-		edges = append(edges, place.EdgeCategory)
-	}
-	if m.clearedvisits {
-		edges = append(edges, place.EdgeVisits)
+func (m *PlaceRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedplace {
+		edges = append(edges, placerevision.EdgePlace)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PlaceMutation) EdgeCleared(name string) bool {
+func (m *PlaceRevisionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case place.EdgeImages:
-		return m.clearedimages
-	case place.EdgeCategory:
-		return m.clearedcategory
-	case place.EdgeVisits:
-		return m.clearedvisits
+	case placerevision.EdgePlace:
+		return m.clearedplace
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PlaceMutation) ClearEdge(name string) error {
+func (m *PlaceRevisionMutation) ClearEdge(name string) error {
 	switch name {
+	case placerevision.EdgePlace:
+		m.ClearPlace()
+		return nil
 	}
-	return fmt.Errorf("unknown Place unique edge %s", name)
+	return fmt.Errorf("unknown PlaceRevision unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PlaceMutation) ResetEdge(name string) error {
+func (m *PlaceRevisionMutation) ResetEdge(name string) error {
 	switch name {
-	case place.EdgeImages:
-		m.ResetImages()
-		return nil
-	case place.EdgeCategory:
-		m.ResetCategory()
-		return nil
-	case place.EdgeVisits:
-		m.ResetVisits()
+	case placerevision.EdgePlace:
+		m.ResetPlace()
 		return nil
 	}
-	return fmt.Errorf("unknown Place edge %s", name)
+	return fmt.Errorf("unknown PlaceRevision edge %s", name)
 }
 
-// PlaceImageMutation represents an operation that mutates the PlaceImage nodes in the graph.
-type PlaceImageMutation struct {
+// PlaceSlugMutation represents an operation that mutates the PlaceSlug nodes in the graph.
+type PlaceSlugMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *string
-	status        *string
+	status        *types.Status
 	created_at    *time.Time
 	updated_at    *time.Time
 	created_by    *string
 	updated_by    *string
-	metadata      *map[string]string
-	url           *string
-	alt           *string
-	pos           *int
-	addpos        *int
+	deleted_at    *time.Time
+	deleted_by    *string
+	slug          *string
 	clearedFields map[string]struct{}
 	place         *string
 	clearedplace  bool
 	done          bool
-	oldValue      func(context.Context) (*PlaceImage, error)
-	predicates    []predicate.PlaceImage
+	oldValue      func(context.Context) (*PlaceSlug, error)
+	predicates    []predicate.PlaceSlug
 }
 
-var _ ent.Mutation = (*PlaceImageMutation)(nil)
+var _ ent.Mutation = (*PlaceSlugMutation)(nil)
 
-// placeimageOption allows management of the mutation configuration using functional options.
-type placeimageOption func(*PlaceImageMutation)
+// placeslugOption allows management of the mutation configuration using functional options.
+type placeslugOption func(*PlaceSlugMutation)
 
-// newPlaceImageMutation creates new mutation for the PlaceImage entity.
-func newPlaceImageMutation(c config, op Op, opts ...placeimageOption) *PlaceImageMutation {
-	m := &PlaceImageMutation{
+// newPlaceSlugMutation creates new mutation for the PlaceSlug entity.
+func newPlaceSlugMutation(c config, op Op, opts ...placeslugOption) *PlaceSlugMutation {
+	m := &PlaceSlugMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePlaceImage,
+		typ:           TypePlaceSlug,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -10437,20 +16445,20 @@ func newPlaceImageMutation(c config, op Op, opts ...placeimageOption) *PlaceImag
 	return m
 }
 
-// withPlaceImageID sets the ID field of the mutation.
-func withPlaceImageID(id string) placeimageOption {
-	return func(m *PlaceImageMutation) {
+// withPlaceSlugID sets the ID field of the mutation.
+func withPlaceSlugID(id string) placeslugOption {
+	return func(m *PlaceSlugMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PlaceImage
+			value *PlaceSlug
 		)
-		m.oldValue = func(ctx context.Context) (*PlaceImage, error) {
+		m.oldValue = func(ctx context.Context) (*PlaceSlug, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PlaceImage.Get(ctx, id)
+					value, err = m.Client().PlaceSlug.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -10459,10 +16467,10 @@ func withPlaceImageID(id string) placeimageOption {
 	}
 }
 
-// withPlaceImage sets the old PlaceImage of the mutation.
-func withPlaceImage(node *PlaceImage) placeimageOption {
-	return func(m *PlaceImageMutation) {
-		m.oldValue = func(context.Context) (*PlaceImage, error) {
+// withPlaceSlug sets the old PlaceSlug of the mutation.
+func withPlaceSlug(node *PlaceSlug) placeslugOption {
+	return func(m *PlaceSlugMutation) {
+		m.oldValue = func(context.Context) (*PlaceSlug, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -10471,7 +16479,7 @@ func withPlaceImage(node *PlaceImage) placeimageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PlaceImageMutation) Client() *Client {
+func (m PlaceSlugMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -10479,7 +16487,7 @@ func (m PlaceImageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PlaceImageMutation) Tx() (*Tx, error) {
+func (m PlaceSlugMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -10489,14 +16497,14 @@ func (m PlaceImageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PlaceImage entities.
-func (m *PlaceImageMutation) SetID(id string) {
+// operation is only accepted on creation of PlaceSlug entities.
+func (m *PlaceSlugMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PlaceImageMutation) ID() (id string, exists bool) {
+func (m *PlaceSlugMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -10507,7 +16515,7 @@ func (m *PlaceImageMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PlaceImageMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *PlaceSlugMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -10516,19 +16524,19 @@ func (m *PlaceImageMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PlaceImage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PlaceSlug.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetStatus sets the "status" field.
-func (m *PlaceImageMutation) SetStatus(s string) {
-	m.status = &s
+func (m *PlaceSlugMutation) SetStatus(t types.Status) {
+	m.status = &t
 }
 
 // Status returns the value of the "status" field in the mutation.
-func (m *PlaceImageMutation) Status() (r string, exists bool) {
+func (m *PlaceSlugMutation) Status() (r types.Status, exists bool) {
 	v := m.status
 	if v == nil {
 		return
@@ -10536,10 +16544,10 @@ func (m *PlaceImageMutation) Status() (r string, exists bool) {
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the PlaceImage entity.
-// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the PlaceSlug entity.
+// If the PlaceSlug object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceImageMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *PlaceSlugMutation) OldStatus(ctx context.Context) (v types.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
@@ -10554,17 +16562,17 @@ func (m *PlaceImageMutation) OldStatus(ctx context.Context) (v string, err error
 }
 
 // ResetStatus resets all changes to the "status" field.
-func (m *PlaceImageMutation) ResetStatus() {
+func (m *PlaceSlugMutation) ResetStatus() {
 	m.status = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PlaceImageMutation) SetCreatedAt(t time.Time) {
+func (m *PlaceSlugMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PlaceImageMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *PlaceSlugMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -10572,10 +16580,10 @@ func (m *PlaceImageMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PlaceImage entity.
-// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the PlaceSlug entity.
+// If the PlaceSlug object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceImageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PlaceSlugMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -10590,17 +16598,17 @@ func (m *PlaceImageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PlaceImageMutation) ResetCreatedAt() {
+func (m *PlaceSlugMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *PlaceImageMutation) SetUpdatedAt(t time.Time) {
+func (m *PlaceSlugMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PlaceImageMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *PlaceSlugMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -10608,10 +16616,10 @@ func (m *PlaceImageMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PlaceImage entity.
-// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the PlaceSlug entity.
+// If the PlaceSlug object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceImageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PlaceSlugMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -10626,17 +16634,17 @@ func (m *PlaceImageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PlaceImageMutation) ResetUpdatedAt() {
+func (m *PlaceSlugMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetCreatedBy sets the "created_by" field.
-func (m *PlaceImageMutation) SetCreatedBy(s string) {
+func (m *PlaceSlugMutation) SetCreatedBy(s string) {
 	m.created_by = &s
 }
 
 // CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *PlaceImageMutation) CreatedBy() (r string, exists bool) {
+func (m *PlaceSlugMutation) CreatedBy() (r string, exists bool) {
 	v := m.created_by
 	if v == nil {
 		return
@@ -10644,10 +16652,10 @@ func (m *PlaceImageMutation) CreatedBy() (r string, exists bool) {
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the PlaceImage entity.
-// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the PlaceSlug entity.
+// If the PlaceSlug object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceImageMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+func (m *PlaceSlugMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
@@ -10662,30 +16670,30 @@ func (m *PlaceImageMutation) OldCreatedBy(ctx context.Context) (v string, err er
 }
 
 // ClearCreatedBy clears the value of the "created_by" field.
-func (m *PlaceImageMutation) ClearCreatedBy() {
+func (m *PlaceSlugMutation) ClearCreatedBy() {
 	m.created_by = nil
-	m.clearedFields[placeimage.FieldCreatedBy] = struct{}{}
+	m.clearedFields[placeslug.FieldCreatedBy] = struct{}{}
 }
 
 // CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *PlaceImageMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[placeimage.FieldCreatedBy]
+func (m *PlaceSlugMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[placeslug.FieldCreatedBy]
 	return ok
 }
 
 // ResetCreatedBy resets all changes to the "created_by" field.
-func (m *PlaceImageMutation) ResetCreatedBy() {
+func (m *PlaceSlugMutation) ResetCreatedBy() {
 	m.created_by = nil
-	delete(m.clearedFields, placeimage.FieldCreatedBy)
+	delete(m.clearedFields, placeslug.FieldCreatedBy)
 }
 
 // SetUpdatedBy sets the "updated_by" field.
-func (m *PlaceImageMutation) SetUpdatedBy(s string) {
+func (m *PlaceSlugMutation) SetUpdatedBy(s string) {
 	m.updated_by = &s
 }
 
 // UpdatedBy returns the value of the "updated_by" field in the mutation.
-func (m *PlaceImageMutation) UpdatedBy() (r string, exists bool) {
+func (m *PlaceSlugMutation) UpdatedBy() (r string, exists bool) {
 	v := m.updated_by
 	if v == nil {
 		return
@@ -10693,282 +16701,226 @@ func (m *PlaceImageMutation) UpdatedBy() (r string, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedBy returns the old "updated_by" field's value of the PlaceImage entity.
-// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceImageMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
-	}
-	return oldValue.UpdatedBy, nil
-}
-
-// ClearUpdatedBy clears the value of the "updated_by" field.
-func (m *PlaceImageMutation) ClearUpdatedBy() {
-	m.updated_by = nil
-	m.clearedFields[placeimage.FieldUpdatedBy] = struct{}{}
-}
-
-// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
-func (m *PlaceImageMutation) UpdatedByCleared() bool {
-	_, ok := m.clearedFields[placeimage.FieldUpdatedBy]
-	return ok
-}
-
-// ResetUpdatedBy resets all changes to the "updated_by" field.
-func (m *PlaceImageMutation) ResetUpdatedBy() {
-	m.updated_by = nil
-	delete(m.clearedFields, placeimage.FieldUpdatedBy)
-}
-
-// SetMetadata sets the "metadata" field.
-func (m *PlaceImageMutation) SetMetadata(value map[string]string) {
-	m.metadata = &value
-}
-
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *PlaceImageMutation) Metadata() (r map[string]string, exists bool) {
-	v := m.metadata
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldMetadata returns the old "metadata" field's value of the PlaceImage entity.
-// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceImageMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
-	}
-	return oldValue.Metadata, nil
-}
-
-// ClearMetadata clears the value of the "metadata" field.
-func (m *PlaceImageMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[placeimage.FieldMetadata] = struct{}{}
-}
-
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *PlaceImageMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[placeimage.FieldMetadata]
-	return ok
-}
-
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *PlaceImageMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, placeimage.FieldMetadata)
-}
-
-// SetPlaceID sets the "place_id" field.
-func (m *PlaceImageMutation) SetPlaceID(s string) {
-	m.place = &s
-}
-
-// PlaceID returns the value of the "place_id" field in the mutation.
-func (m *PlaceImageMutation) PlaceID() (r string, exists bool) {
-	v := m.place
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPlaceID returns the old "place_id" field's value of the PlaceImage entity.
-// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedBy returns the old "updated_by" field's value of the PlaceSlug entity.
+// If the PlaceSlug object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceImageMutation) OldPlaceID(ctx context.Context) (v string, err error) {
+func (m *PlaceSlugMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlaceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlaceID requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlaceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
 	}
-	return oldValue.PlaceID, nil
+	return oldValue.UpdatedBy, nil
 }
 
-// ResetPlaceID resets all changes to the "place_id" field.
-func (m *PlaceImageMutation) ResetPlaceID() {
-	m.place = nil
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *PlaceSlugMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.clearedFields[placeslug.FieldUpdatedBy] = struct{}{}
 }
 
-// SetURL sets the "url" field.
-func (m *PlaceImageMutation) SetURL(s string) {
-	m.url = &s
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *PlaceSlugMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[placeslug.FieldUpdatedBy]
+	return ok
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *PlaceImageMutation) URL() (r string, exists bool) {
-	v := m.url
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *PlaceSlugMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	delete(m.clearedFields, placeslug.FieldUpdatedBy)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PlaceSlugMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PlaceSlugMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the PlaceImage entity.
-// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the PlaceSlug entity.
+// If the PlaceSlug object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceImageMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *PlaceSlugMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.URL, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *PlaceImageMutation) ResetURL() {
-	m.url = nil
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PlaceSlugMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[placeslug.FieldDeletedAt] = struct{}{}
 }
 
-// SetAlt sets the "alt" field.
-func (m *PlaceImageMutation) SetAlt(s string) {
-	m.alt = &s
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PlaceSlugMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[placeslug.FieldDeletedAt]
+	return ok
 }
 
-// Alt returns the value of the "alt" field in the mutation.
-func (m *PlaceImageMutation) Alt() (r string, exists bool) {
-	v := m.alt
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PlaceSlugMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, placeslug.FieldDeletedAt)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *PlaceSlugMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *PlaceSlugMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAlt returns the old "alt" field's value of the PlaceImage entity.
-// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedBy returns the old "deleted_by" field's value of the PlaceSlug entity.
+// If the PlaceSlug object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceImageMutation) OldAlt(ctx context.Context) (v string, err error) {
+func (m *PlaceSlugMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAlt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAlt requires an ID field in the mutation")
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAlt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
 	}
-	return oldValue.Alt, nil
+	return oldValue.DeletedBy, nil
 }
 
-// ClearAlt clears the value of the "alt" field.
-func (m *PlaceImageMutation) ClearAlt() {
-	m.alt = nil
-	m.clearedFields[placeimage.FieldAlt] = struct{}{}
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *PlaceSlugMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[placeslug.FieldDeletedBy] = struct{}{}
 }
 
-// AltCleared returns if the "alt" field was cleared in this mutation.
-func (m *PlaceImageMutation) AltCleared() bool {
-	_, ok := m.clearedFields[placeimage.FieldAlt]
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *PlaceSlugMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[placeslug.FieldDeletedBy]
 	return ok
 }
 
-// ResetAlt resets all changes to the "alt" field.
-func (m *PlaceImageMutation) ResetAlt() {
-	m.alt = nil
-	delete(m.clearedFields, placeimage.FieldAlt)
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *PlaceSlugMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, placeslug.FieldDeletedBy)
 }
 
-// SetPos sets the "pos" field.
-func (m *PlaceImageMutation) SetPos(i int) {
-	m.pos = &i
-	m.addpos = nil
+// SetPlaceID sets the "place_id" field.
+func (m *PlaceSlugMutation) SetPlaceID(s string) {
+	m.place = &s
 }
 
-// Pos returns the value of the "pos" field in the mutation.
-func (m *PlaceImageMutation) Pos() (r int, exists bool) {
-	v := m.pos
+// PlaceID returns the value of the "place_id" field in the mutation.
+func (m *PlaceSlugMutation) PlaceID() (r string, exists bool) {
+	v := m.place
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPos returns the old "pos" field's value of the PlaceImage entity.
-// If the PlaceImage object wasn't provided to the builder, the object is fetched from the database.
+// OldPlaceID returns the old "place_id" field's value of the PlaceSlug entity.
+// If the PlaceSlug object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlaceImageMutation) OldPos(ctx context.Context) (v int, err error) {
+func (m *PlaceSlugMutation) OldPlaceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPos is only allowed on UpdateOne operations")
+		return v, errors.New("OldPlaceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPos requires an ID field in the mutation")
+		return v, errors.New("OldPlaceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPos: %w", err)
+		return v, fmt.Errorf("querying old value for OldPlaceID: %w", err)
 	}
-	return oldValue.Pos, nil
+	return oldValue.PlaceID, nil
 }
 
-// AddPos adds i to the "pos" field.
-func (m *PlaceImageMutation) AddPos(i int) {
-	if m.addpos != nil {
-		*m.addpos += i
-	} else {
-		m.addpos = &i
-	}
+// ResetPlaceID resets all changes to the "place_id" field.
+func (m *PlaceSlugMutation) ResetPlaceID() {
+	m.place = nil
 }
 
-// AddedPos returns the value that was added to the "pos" field in this mutation.
-func (m *PlaceImageMutation) AddedPos() (r int, exists bool) {
-	v := m.addpos
+// SetSlug sets the "slug" field.
+func (m *PlaceSlugMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *PlaceSlugMutation) Slug() (r string, exists bool) {
+	v := m.slug
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPos resets all changes to the "pos" field.
-func (m *PlaceImageMutation) ResetPos() {
-	m.pos = nil
-	m.addpos = nil
+// OldSlug returns the old "slug" field's value of the PlaceSlug entity.
+// If the PlaceSlug object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceSlugMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *PlaceSlugMutation) ResetSlug() {
+	m.slug = nil
 }
 
 // ClearPlace clears the "place" edge to the Place entity.
-func (m *PlaceImageMutation) ClearPlace() {
+func (m *PlaceSlugMutation) ClearPlace() {
 	m.clearedplace = true
-	m.clearedFields[placeimage.FieldPlaceID] = struct{}{}
+	m.clearedFields[placeslug.FieldPlaceID] = struct{}{}
 }
 
 // PlaceCleared reports if the "place" edge to the Place entity was cleared.
-func (m *PlaceImageMutation) PlaceCleared() bool {
+func (m *PlaceSlugMutation) PlaceCleared() bool {
 	return m.clearedplace
 }
 
 // PlaceIDs returns the "place" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // PlaceID instead. It exists only for internal usage by the builders.
-func (m *PlaceImageMutation) PlaceIDs() (ids []string) {
+func (m *PlaceSlugMutation) PlaceIDs() (ids []string) {
 	if id := m.place; id != nil {
 		ids = append(ids, *id)
 	}
@@ -10976,20 +16928,20 @@ func (m *PlaceImageMutation) PlaceIDs() (ids []string) {
 }
 
 // ResetPlace resets all changes to the "place" edge.
-func (m *PlaceImageMutation) ResetPlace() {
+func (m *PlaceSlugMutation) ResetPlace() {
 	m.place = nil
 	m.clearedplace = false
 }
 
-// Where appends a list predicates to the PlaceImageMutation builder.
-func (m *PlaceImageMutation) Where(ps ...predicate.PlaceImage) {
+// Where appends a list predicates to the PlaceSlugMutation builder.
+func (m *PlaceSlugMutation) Where(ps ...predicate.PlaceSlug) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PlaceImageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PlaceSlugMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PlaceImageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PlaceImage, len(ps))
+func (m *PlaceSlugMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PlaceSlug, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -10997,54 +16949,51 @@ func (m *PlaceImageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PlaceImageMutation) Op() Op {
+func (m *PlaceSlugMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PlaceImageMutation) SetOp(op Op) {
+func (m *PlaceSlugMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PlaceImage).
-func (m *PlaceImageMutation) Type() string {
+// Type returns the node type of this mutation (PlaceSlug).
+func (m *PlaceSlugMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PlaceImageMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+func (m *PlaceSlugMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.status != nil {
-		fields = append(fields, placeimage.FieldStatus)
+		fields = append(fields, placeslug.FieldStatus)
 	}
 	if m.created_at != nil {
-		fields = append(fields, placeimage.FieldCreatedAt)
+		fields = append(fields, placeslug.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, placeimage.FieldUpdatedAt)
+		fields = append(fields, placeslug.FieldUpdatedAt)
 	}
 	if m.created_by != nil {
-		fields = append(fields, placeimage.FieldCreatedBy)
+		fields = append(fields, placeslug.FieldCreatedBy)
 	}
 	if m.updated_by != nil {
-		fields = append(fields, placeimage.FieldUpdatedBy)
-	}
-	if m.metadata != nil {
-		fields = append(fields, placeimage.FieldMetadata)
+		fields = append(fields, placeslug.FieldUpdatedBy)
 	}
-	if m.place != nil {
-		fields = append(fields, placeimage.FieldPlaceID)
+	if m.deleted_at != nil {
+		fields = append(fields, placeslug.FieldDeletedAt)
 	}
-	if m.url != nil {
-		fields = append(fields, placeimage.FieldURL)
+	if m.deleted_by != nil {
+		fields = append(fields, placeslug.FieldDeletedBy)
 	}
-	if m.alt != nil {
-		fields = append(fields, placeimage.FieldAlt)
+	if m.place != nil {
+		fields = append(fields, placeslug.FieldPlaceID)
 	}
-	if m.pos != nil {
-		fields = append(fields, placeimage.FieldPos)
+	if m.slug != nil {
+		fields = append(fields, placeslug.FieldSlug)
 	}
 	return fields
 }
@@ -11052,28 +17001,26 @@ func (m *PlaceImageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PlaceImageMutation) Field(name string) (ent.Value, bool) {
+func (m *PlaceSlugMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case placeimage.FieldStatus:
+	case placeslug.FieldStatus:
 		return m.Status()
-	case placeimage.FieldCreatedAt:
+	case placeslug.FieldCreatedAt:
 		return m.CreatedAt()
-	case placeimage.FieldUpdatedAt:
+	case placeslug.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case placeimage.FieldCreatedBy:
+	case placeslug.FieldCreatedBy:
 		return m.CreatedBy()
-	case placeimage.FieldUpdatedBy:
+	case placeslug.FieldUpdatedBy:
 		return m.UpdatedBy()
-	case placeimage.FieldMetadata:
-		return m.Metadata()
-	case placeimage.FieldPlaceID:
+	case placeslug.FieldDeletedAt:
+		return m.DeletedAt()
+	case placeslug.FieldDeletedBy:
+		return m.DeletedBy()
+	case placeslug.FieldPlaceID:
 		return m.PlaceID()
-	case placeimage.FieldURL:
-		return m.URL()
-	case placeimage.FieldAlt:
-		return m.Alt()
-	case placeimage.FieldPos:
-		return m.Pos()
+	case placeslug.FieldSlug:
+		return m.Slug()
 	}
 	return nil, false
 }
@@ -11081,246 +17028,219 @@ func (m *PlaceImageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PlaceImageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PlaceSlugMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case placeimage.FieldStatus:
+	case placeslug.FieldStatus:
 		return m.OldStatus(ctx)
-	case placeimage.FieldCreatedAt:
+	case placeslug.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case placeimage.FieldUpdatedAt:
+	case placeslug.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case placeimage.FieldCreatedBy:
+	case placeslug.FieldCreatedBy:
 		return m.OldCreatedBy(ctx)
-	case placeimage.FieldUpdatedBy:
+	case placeslug.FieldUpdatedBy:
 		return m.OldUpdatedBy(ctx)
-	case placeimage.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case placeimage.FieldPlaceID:
+	case placeslug.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case placeslug.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	case placeslug.FieldPlaceID:
 		return m.OldPlaceID(ctx)
-	case placeimage.FieldURL:
-		return m.OldURL(ctx)
-	case placeimage.FieldAlt:
-		return m.OldAlt(ctx)
-	case placeimage.FieldPos:
-		return m.OldPos(ctx)
+	case placeslug.FieldSlug:
+		return m.OldSlug(ctx)
 	}
-	return nil, fmt.Errorf("unknown PlaceImage field %s", name)
+	return nil, fmt.Errorf("unknown PlaceSlug field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PlaceImageMutation) SetField(name string, value ent.Value) error {
+func (m *PlaceSlugMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case placeimage.FieldStatus:
-		v, ok := value.(string)
+	case placeslug.FieldStatus:
+		v, ok := value.(types.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStatus(v)
 		return nil
-	case placeimage.FieldCreatedAt:
+	case placeslug.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case placeimage.FieldUpdatedAt:
+	case placeslug.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case placeimage.FieldCreatedBy:
+	case placeslug.FieldCreatedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedBy(v)
 		return nil
-	case placeimage.FieldUpdatedBy:
+	case placeslug.FieldUpdatedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedBy(v)
 		return nil
-	case placeimage.FieldMetadata:
-		v, ok := value.(map[string]string)
+	case placeslug.FieldDeletedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetDeletedAt(v)
 		return nil
-	case placeimage.FieldPlaceID:
+	case placeslug.FieldDeletedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPlaceID(v)
+		m.SetDeletedBy(v)
 		return nil
-	case placeimage.FieldURL:
+	case placeslug.FieldPlaceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetURL(v)
+		m.SetPlaceID(v)
 		return nil
-	case placeimage.FieldAlt:
+	case placeslug.FieldSlug:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAlt(v)
-		return nil
-	case placeimage.FieldPos:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPos(v)
+		m.SetSlug(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PlaceImage field %s", name)
+	return fmt.Errorf("unknown PlaceSlug field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PlaceImageMutation) AddedFields() []string {
-	var fields []string
-	if m.addpos != nil {
-		fields = append(fields, placeimage.FieldPos)
-	}
-	return fields
+func (m *PlaceSlugMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PlaceImageMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case placeimage.FieldPos:
-		return m.AddedPos()
-	}
+func (m *PlaceSlugMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PlaceImageMutation) AddField(name string, value ent.Value) error {
+func (m *PlaceSlugMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case placeimage.FieldPos:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPos(v)
-		return nil
 	}
-	return fmt.Errorf("unknown PlaceImage numeric field %s", name)
+	return fmt.Errorf("unknown PlaceSlug numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PlaceImageMutation) ClearedFields() []string {
+func (m *PlaceSlugMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(placeimage.FieldCreatedBy) {
-		fields = append(fields, placeimage.FieldCreatedBy)
+	if m.FieldCleared(placeslug.FieldCreatedBy) {
+		fields = append(fields, placeslug.FieldCreatedBy)
 	}
-	if m.FieldCleared(placeimage.FieldUpdatedBy) {
-		fields = append(fields, placeimage.FieldUpdatedBy)
+	if m.FieldCleared(placeslug.FieldUpdatedBy) {
+		fields = append(fields, placeslug.FieldUpdatedBy)
 	}
-	if m.FieldCleared(placeimage.FieldMetadata) {
-		fields = append(fields, placeimage.FieldMetadata)
+	if m.FieldCleared(placeslug.FieldDeletedAt) {
+		fields = append(fields, placeslug.FieldDeletedAt)
 	}
-	if m.FieldCleared(placeimage.FieldAlt) {
-		fields = append(fields, placeimage.FieldAlt)
+	if m.FieldCleared(placeslug.FieldDeletedBy) {
+		fields = append(fields, placeslug.FieldDeletedBy)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PlaceImageMutation) FieldCleared(name string) bool {
+func (m *PlaceSlugMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PlaceImageMutation) ClearField(name string) error {
+func (m *PlaceSlugMutation) ClearField(name string) error {
 	switch name {
-	case placeimage.FieldCreatedBy:
+	case placeslug.FieldCreatedBy:
 		m.ClearCreatedBy()
 		return nil
-	case placeimage.FieldUpdatedBy:
+	case placeslug.FieldUpdatedBy:
 		m.ClearUpdatedBy()
 		return nil
-	case placeimage.FieldMetadata:
-		m.ClearMetadata()
+	case placeslug.FieldDeletedAt:
+		m.ClearDeletedAt()
 		return nil
-	case placeimage.FieldAlt:
-		m.ClearAlt()
+	case placeslug.FieldDeletedBy:
+		m.ClearDeletedBy()
 		return nil
 	}
-	return fmt.Errorf("unknown PlaceImage nullable field %s", name)
+	return fmt.Errorf("unknown PlaceSlug nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PlaceImageMutation) ResetField(name string) error {
+func (m *PlaceSlugMutation) ResetField(name string) error {
 	switch name {
-	case placeimage.FieldStatus:
+	case placeslug.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case placeimage.FieldCreatedAt:
+	case placeslug.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case placeimage.FieldUpdatedAt:
+	case placeslug.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case placeimage.FieldCreatedBy:
+	case placeslug.FieldCreatedBy:
 		m.ResetCreatedBy()
 		return nil
-	case placeimage.FieldUpdatedBy:
+	case placeslug.FieldUpdatedBy:
 		m.ResetUpdatedBy()
 		return nil
-	case placeimage.FieldMetadata:
-		m.ResetMetadata()
-		return nil
-	case placeimage.FieldPlaceID:
-		m.ResetPlaceID()
+	case placeslug.FieldDeletedAt:
+		m.ResetDeletedAt()
 		return nil
-	case placeimage.FieldURL:
-		m.ResetURL()
+	case placeslug.FieldDeletedBy:
+		m.ResetDeletedBy()
 		return nil
-	case placeimage.FieldAlt:
-		m.ResetAlt()
+	case placeslug.FieldPlaceID:
+		m.ResetPlaceID()
 		return nil
-	case placeimage.FieldPos:
-		m.ResetPos()
+	case placeslug.FieldSlug:
+		m.ResetSlug()
 		return nil
 	}
-	return fmt.Errorf("unknown PlaceImage field %s", name)
+	return fmt.Errorf("unknown PlaceSlug field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PlaceImageMutation) AddedEdges() []string {
+func (m *PlaceSlugMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.place != nil {
-		edges = append(edges, placeimage.EdgePlace)
+		edges = append(edges, placeslug.EdgePlace)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PlaceImageMutation) AddedIDs(name string) []ent.Value {
+func (m *PlaceSlugMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case placeimage.EdgePlace:
+	case placeslug.EdgePlace:
 		if id := m.place; id != nil {
 			return []ent.Value{*id}
 		}
@@ -11329,31 +17249,31 @@ func (m *PlaceImageMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PlaceImageMutation) RemovedEdges() []string {
+func (m *PlaceSlugMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PlaceImageMutation) RemovedIDs(name string) []ent.Value {
+func (m *PlaceSlugMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PlaceImageMutation) ClearedEdges() []string {
+func (m *PlaceSlugMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.clearedplace {
-		edges = append(edges, placeimage.EdgePlace)
+		edges = append(edges, placeslug.EdgePlace)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PlaceImageMutation) EdgeCleared(name string) bool {
+func (m *PlaceSlugMutation) EdgeCleared(name string) bool {
 	switch name {
-	case placeimage.EdgePlace:
+	case placeslug.EdgePlace:
 		return m.clearedplace
 	}
 	return false
@@ -11361,24 +17281,24 @@ func (m *PlaceImageMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PlaceImageMutation) ClearEdge(name string) error {
+func (m *PlaceSlugMutation) ClearEdge(name string) error {
 	switch name {
-	case placeimage.EdgePlace:
+	case placeslug.EdgePlace:
 		m.ClearPlace()
 		return nil
 	}
-	return fmt.Errorf("unknown PlaceImage unique edge %s", name)
+	return fmt.Errorf("unknown PlaceSlug unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PlaceImageMutation) ResetEdge(name string) error {
+func (m *PlaceSlugMutation) ResetEdge(name string) error {
 	switch name {
-	case placeimage.EdgePlace:
+	case placeslug.EdgePlace:
 		m.ResetPlace()
 		return nil
 	}
-	return fmt.Errorf("unknown PlaceImage edge %s", name)
+	return fmt.Errorf("unknown PlaceSlug edge %s", name)
 }
 
 // ReviewMutation represents an operation that mutates the Review nodes in the graph.
@@ -11387,11 +17307,13 @@ type ReviewMutation struct {
 	op                   Op
 	typ                  string
 	id                   *string
-	status               *string
+	status               *types.Status
 	created_at           *time.Time
 	updated_at           *time.Time
 	created_by           *string
 	updated_by           *string
+	deleted_at           *time.Time
+	deleted_by           *string
 	metadata             *map[string]string
 	entity_type          *string
 	entity_id            *string
@@ -11520,12 +17442,12 @@ func (m *ReviewMutation) IDs(ctx context.Context) ([]string, error) {
 }
 
 // SetStatus sets the "status" field.
-func (m *ReviewMutation) SetStatus(s string) {
-	m.status = &s
+func (m *ReviewMutation) SetStatus(t types.Status) {
+	m.status = &t
 }
 
 // Status returns the value of the "status" field in the mutation.
-func (m *ReviewMutation) Status() (r string, exists bool) {
+func (m *ReviewMutation) Status() (r types.Status, exists bool) {
 	v := m.status
 	if v == nil {
 		return
@@ -11536,7 +17458,7 @@ func (m *ReviewMutation) Status() (r string, exists bool) {
 // OldStatus returns the old "status" field's value of the Review entity.
 // If the Review object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *ReviewMutation) OldStatus(ctx context.Context) (v types.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
@@ -11725,6 +17647,104 @@ func (m *ReviewMutation) ResetUpdatedBy() {
 	delete(m.clearedFields, review.FieldUpdatedBy)
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ReviewMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ReviewMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *ReviewMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[review.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *ReviewMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[review.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ReviewMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, review.FieldDeletedAt)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *ReviewMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *ReviewMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedBy returns the old "deleted_by" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+	}
+	return oldValue.DeletedBy, nil
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *ReviewMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[review.FieldDeletedBy] = struct{}{}
+}
+
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *ReviewMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[review.FieldDeletedBy]
+	return ok
+}
+
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *ReviewMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, review.FieldDeletedBy)
+}
+
 // SetMetadata sets the "metadata" field.
 func (m *ReviewMutation) SetMetadata(value map[string]string) {
 	m.metadata = &value
@@ -12364,7 +18384,7 @@ func (m *ReviewMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ReviewMutation) Fields() []string {
-	fields := make([]string, 0, 18)
+	fields := make([]string, 0, 20)
 	if m.status != nil {
 		fields = append(fields, review.FieldStatus)
 	}
@@ -12380,6 +18400,12 @@ func (m *ReviewMutation) Fields() []string {
 	if m.updated_by != nil {
 		fields = append(fields, review.FieldUpdatedBy)
 	}
+	if m.deleted_at != nil {
+		fields = append(fields, review.FieldDeletedAt)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, review.FieldDeletedBy)
+	}
 	if m.metadata != nil {
 		fields = append(fields, review.FieldMetadata)
 	}
@@ -12437,6 +18463,10 @@ func (m *ReviewMutation) Field(name string) (ent.Value, bool) {
 		return m.CreatedBy()
 	case review.FieldUpdatedBy:
 		return m.UpdatedBy()
+	case review.FieldDeletedAt:
+		return m.DeletedAt()
+	case review.FieldDeletedBy:
+		return m.DeletedBy()
 	case review.FieldMetadata:
 		return m.Metadata()
 	case review.FieldEntityType:
@@ -12482,6 +18512,10 @@ func (m *ReviewMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldCreatedBy(ctx)
 	case review.FieldUpdatedBy:
 		return m.OldUpdatedBy(ctx)
+	case review.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case review.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
 	case review.FieldMetadata:
 		return m.OldMetadata(ctx)
 	case review.FieldEntityType:
@@ -12518,7 +18552,7 @@ func (m *ReviewMutation) OldField(ctx context.Context, name string) (ent.Value,
 func (m *ReviewMutation) SetField(name string, value ent.Value) error {
 	switch name {
 	case review.FieldStatus:
-		v, ok := value.(string)
+		v, ok := value.(types.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
@@ -12552,6 +18586,20 @@ func (m *ReviewMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdatedBy(v)
 		return nil
+	case review.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case review.FieldDeletedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
 	case review.FieldMetadata:
 		v, ok := value.(map[string]string)
 		if !ok {
@@ -12706,6 +18754,12 @@ func (m *ReviewMutation) ClearedFields() []string {
 	if m.FieldCleared(review.FieldUpdatedBy) {
 		fields = append(fields, review.FieldUpdatedBy)
 	}
+	if m.FieldCleared(review.FieldDeletedAt) {
+		fields = append(fields, review.FieldDeletedAt)
+	}
+	if m.FieldCleared(review.FieldDeletedBy) {
+		fields = append(fields, review.FieldDeletedBy)
+	}
 	if m.FieldCleared(review.FieldMetadata) {
 		fields = append(fields, review.FieldMetadata)
 	}
@@ -12741,6 +18795,12 @@ func (m *ReviewMutation) ClearField(name string) error {
 	case review.FieldUpdatedBy:
 		m.ClearUpdatedBy()
 		return nil
+	case review.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case review.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
 	case review.FieldMetadata:
 		m.ClearMetadata()
 		return nil
@@ -12779,6 +18839,12 @@ func (m *ReviewMutation) ResetField(name string) error {
 	case review.FieldUpdatedBy:
 		m.ResetUpdatedBy()
 		return nil
+	case review.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case review.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
 	case review.FieldMetadata:
 		m.ResetMetadata()
 		return nil
@@ -12876,11 +18942,13 @@ type UserMutation struct {
 	op                 Op
 	typ                string
 	id                 *string
-	status             *string
+	status             *types.Status
 	created_at         *time.Time
 	updated_at         *time.Time
 	created_by         *string
 	updated_by         *string
+	deleted_at         *time.Time
+	deleted_by         *string
 	metadata           *map[string]string
 	name               *string
 	email              *string
@@ -13000,12 +19068,12 @@ func (m *UserMutation) IDs(ctx context.Context) ([]string, error) {
 }
 
 // SetStatus sets the "status" field.
-func (m *UserMutation) SetStatus(s string) {
-	m.status = &s
+func (m *UserMutation) SetStatus(t types.Status) {
+	m.status = &t
 }
 
 // Status returns the value of the "status" field in the mutation.
-func (m *UserMutation) Status() (r string, exists bool) {
+func (m *UserMutation) Status() (r types.Status, exists bool) {
 	v := m.status
 	if v == nil {
 		return
@@ -13016,7 +19084,7 @@ func (m *UserMutation) Status() (r string, exists bool) {
 // OldStatus returns the old "status" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldStatus(ctx context.Context) (v types.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
@@ -13205,6 +19273,104 @@ func (m *UserMutation) ResetUpdatedBy() {
 	delete(m.clearedFields, user.FieldUpdatedBy)
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (m *UserMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *UserMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *UserMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[user.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *UserMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *UserMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, user.FieldDeletedAt)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *UserMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *UserMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedBy returns the old "deleted_by" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+	}
+	return oldValue.DeletedBy, nil
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *UserMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[user.FieldDeletedBy] = struct{}{}
+}
+
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *UserMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[user.FieldDeletedBy]
+	return ok
+}
+
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *UserMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, user.FieldDeletedBy)
+}
+
 // SetMetadata sets the "metadata" field.
 func (m *UserMutation) SetMetadata(value map[string]string) {
 	m.metadata = &value
@@ -13486,7 +19652,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+	fields := make([]string, 0, 12)
 	if m.status != nil {
 		fields = append(fields, user.FieldStatus)
 	}
@@ -13502,6 +19668,12 @@ func (m *UserMutation) Fields() []string {
 	if m.updated_by != nil {
 		fields = append(fields, user.FieldUpdatedBy)
 	}
+	if m.deleted_at != nil {
+		fields = append(fields, user.FieldDeletedAt)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, user.FieldDeletedBy)
+	}
 	if m.metadata != nil {
 		fields = append(fields, user.FieldMetadata)
 	}
@@ -13535,6 +19707,10 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.CreatedBy()
 	case user.FieldUpdatedBy:
 		return m.UpdatedBy()
+	case user.FieldDeletedAt:
+		return m.DeletedAt()
+	case user.FieldDeletedBy:
+		return m.DeletedBy()
 	case user.FieldMetadata:
 		return m.Metadata()
 	case user.FieldName:
@@ -13564,6 +19740,10 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldCreatedBy(ctx)
 	case user.FieldUpdatedBy:
 		return m.OldUpdatedBy(ctx)
+	case user.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case user.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
 	case user.FieldMetadata:
 		return m.OldMetadata(ctx)
 	case user.FieldName:
@@ -13584,7 +19764,7 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
 	case user.FieldStatus:
-		v, ok := value.(string)
+		v, ok := value.(types.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
@@ -13618,6 +19798,20 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdatedBy(v)
 		return nil
+	case user.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case user.FieldDeletedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
 	case user.FieldMetadata:
 		v, ok := value.(map[string]string)
 		if !ok {
@@ -13689,6 +19883,12 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldUpdatedBy) {
 		fields = append(fields, user.FieldUpdatedBy)
 	}
+	if m.FieldCleared(user.FieldDeletedAt) {
+		fields = append(fields, user.FieldDeletedAt)
+	}
+	if m.FieldCleared(user.FieldDeletedBy) {
+		fields = append(fields, user.FieldDeletedBy)
+	}
 	if m.FieldCleared(user.FieldMetadata) {
 		fields = append(fields, user.FieldMetadata)
 	}
@@ -13712,6 +19912,12 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldUpdatedBy:
 		m.ClearUpdatedBy()
 		return nil
+	case user.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case user.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
 	case user.FieldMetadata:
 		m.ClearMetadata()
 		return nil
@@ -13738,6 +19944,12 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldUpdatedBy:
 		m.ResetUpdatedBy()
 		return nil
+	case user.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case user.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
 	case user.FieldMetadata:
 		m.ResetMetadata()
 		return nil
@@ -13847,11 +20059,13 @@ type VisitMutation struct {
 	op                                   Op
 	typ                                  string
 	id                                   *string
-	status                               *string
+	status                               *types.Status
 	created_at                           *time.Time
 	updated_at                           *time.Time
 	created_by                           *string
 	updated_by                           *string
+	deleted_at                           *time.Time
+	deleted_by                           *string
 	sequence_order                       *int
 	addsequence_order                    *int
 	planned_duration_minutes             *int
@@ -13977,12 +20191,12 @@ func (m *VisitMutation) IDs(ctx context.Context) ([]string, error) {
 }
 
 // SetStatus sets the "status" field.
-func (m *VisitMutation) SetStatus(s string) {
-	m.status = &s
+func (m *VisitMutation) SetStatus(t types.Status) {
+	m.status = &t
 }
 
 // Status returns the value of the "status" field in the mutation.
-func (m *VisitMutation) Status() (r string, exists bool) {
+func (m *VisitMutation) Status() (r types.Status, exists bool) {
 	v := m.status
 	if v == nil {
 		return
@@ -13993,7 +20207,7 @@ func (m *VisitMutation) Status() (r string, exists bool) {
 // OldStatus returns the old "status" field's value of the Visit entity.
 // If the Visit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VisitMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *VisitMutation) OldStatus(ctx context.Context) (v types.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
@@ -14182,6 +20396,104 @@ func (m *VisitMutation) ResetUpdatedBy() {
 	delete(m.clearedFields, visit.FieldUpdatedBy)
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (m *VisitMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *VisitMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Visit entity.
+// If the Visit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VisitMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *VisitMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[visit.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *VisitMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[visit.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *VisitMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, visit.FieldDeletedAt)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *VisitMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *VisitMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedBy returns the old "deleted_by" field's value of the Visit entity.
+// If the Visit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VisitMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+	}
+	return oldValue.DeletedBy, nil
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *VisitMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[visit.FieldDeletedBy] = struct{}{}
+}
+
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *VisitMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[visit.FieldDeletedBy]
+	return ok
+}
+
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *VisitMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, visit.FieldDeletedBy)
+}
+
 // SetItineraryID sets the "itinerary_id" field.
 func (m *VisitMutation) SetItineraryID(s string) {
 	m.itinerary = &s
@@ -14692,7 +21004,7 @@ func (m *VisitMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *VisitMutation) Fields() []string {
-	fields := make([]string, 0, 13)
+	fields := make([]string, 0, 15)
 	if m.status != nil {
 		fields = append(fields, visit.FieldStatus)
 	}
@@ -14708,6 +21020,12 @@ func (m *VisitMutation) Fields() []string {
 	if m.updated_by != nil {
 		fields = append(fields, visit.FieldUpdatedBy)
 	}
+	if m.deleted_at != nil {
+		fields = append(fields, visit.FieldDeletedAt)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, visit.FieldDeletedBy)
+	}
 	if m.itinerary != nil {
 		fields = append(fields, visit.FieldItineraryID)
 	}
@@ -14750,6 +21068,10 @@ func (m *VisitMutation) Field(name string) (ent.Value, bool) {
 		return m.CreatedBy()
 	case visit.FieldUpdatedBy:
 		return m.UpdatedBy()
+	case visit.FieldDeletedAt:
+		return m.DeletedAt()
+	case visit.FieldDeletedBy:
+		return m.DeletedBy()
 	case visit.FieldItineraryID:
 		return m.ItineraryID()
 	case visit.FieldPlaceID:
@@ -14785,6 +21107,10 @@ func (m *VisitMutation) OldField(ctx context.Context, name string) (ent.Value, e
 		return m.OldCreatedBy(ctx)
 	case visit.FieldUpdatedBy:
 		return m.OldUpdatedBy(ctx)
+	case visit.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case visit.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
 	case visit.FieldItineraryID:
 		return m.OldItineraryID(ctx)
 	case visit.FieldPlaceID:
@@ -14811,7 +21137,7 @@ func (m *VisitMutation) OldField(ctx context.Context, name string) (ent.Value, e
 func (m *VisitMutation) SetField(name string, value ent.Value) error {
 	switch name {
 	case visit.FieldStatus:
-		v, ok := value.(string)
+		v, ok := value.(types.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
@@ -14845,6 +21171,20 @@ func (m *VisitMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdatedBy(v)
 		return nil
+	case visit.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case visit.FieldDeletedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
 	case visit.FieldItineraryID:
 		v, ok := value.(string)
 		if !ok {
@@ -14988,6 +21328,12 @@ func (m *VisitMutation) ClearedFields() []string {
 	if m.FieldCleared(visit.FieldUpdatedBy) {
 		fields = append(fields, visit.FieldUpdatedBy)
 	}
+	if m.FieldCleared(visit.FieldDeletedAt) {
+		fields = append(fields, visit.FieldDeletedAt)
+	}
+	if m.FieldCleared(visit.FieldDeletedBy) {
+		fields = append(fields, visit.FieldDeletedBy)
+	}
 	if m.FieldCleared(visit.FieldDistanceFromPreviousKm) {
 		fields = append(fields, visit.FieldDistanceFromPreviousKm)
 	}
@@ -15020,6 +21366,12 @@ func (m *VisitMutation) ClearField(name string) error {
 	case visit.FieldUpdatedBy:
 		m.ClearUpdatedBy()
 		return nil
+	case visit.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case visit.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
 	case visit.FieldDistanceFromPreviousKm:
 		m.ClearDistanceFromPreviousKm()
 		return nil
@@ -15055,6 +21407,12 @@ func (m *VisitMutation) ResetField(name string) error {
 	case visit.FieldUpdatedBy:
 		m.ResetUpdatedBy()
 		return nil
+	case visit.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case visit.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
 	case visit.FieldItineraryID:
 		m.ResetItineraryID()
 		return nil
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/omkar273/nashikdarshan/ent/place"
+	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -20,7 +21,7 @@ type Place struct {
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
 	// Status holds the value of the "status" field.
-	Status string `json:"status,omitempty"`
+	Status types.Status `json:"status,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -29,6 +30,10 @@ type Place struct {
 	CreatedBy string `json:"created_by,omitempty"`
 	// UpdatedBy holds the value of the "updated_by" field.
 	UpdatedBy string `json:"updated_by,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// DeletedBy holds the value of the "deleted_by" field.
+	DeletedBy string `json:"deleted_by,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]string `json:"metadata,omitempty"`
 	// Slug holds the value of the "slug" field.
@@ -49,6 +54,8 @@ type Place struct {
 	Latitude decimal.Decimal `json:"latitude,omitempty"`
 	// Longitude holds the value of the "longitude" field.
 	Longitude decimal.Decimal `json:"longitude,omitempty"`
+	// Geohash holds the value of the "geohash" field.
+	Geohash string `json:"geohash,omitempty"`
 	// PrimaryImageURL holds the value of the "primary_image_url" field.
 	PrimaryImageURL string `json:"primary_image_url,omitempty"`
 	// ThumbnailURL holds the value of the "thumbnail_url" field.
@@ -67,6 +74,20 @@ type Place struct {
 	AvgVisitMinutes int `json:"avg_visit_minutes,omitempty"`
 	// Opening hours by day: {monday: '9:00-18:00', ...}
 	OpeningHours map[string]string `json:"opening_hours,omitempty"`
+	// Entry fee: {is_free, amount, currency, notes}
+	PriceInfo *types.PriceInfo `json:"price_info,omitempty"`
+	// Contact details: {phone (E.164), website, email}
+	Contact *types.Contact `json:"contact,omitempty"`
+	// Accessibility attributes: {wheelchair_accessible: true, has_ramp: false, ...}
+	Accessibility *types.Accessibility `json:"accessibility,omitempty"`
+	// Identifiers in external datasets by source: {osm: 'node/123'}
+	ExternalRefs map[string]string `json:"external_refs,omitempty"`
+	// Radius in meters of the circular geofence around the place; null when it has none
+	GeofenceRadiusM *int `json:"geofence_radius_m,omitempty"`
+	// When a draft place is published; cleared once it has been
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+	// When a published place is archived; cleared once it has been
+	UnpublishAt *time.Time `json:"unpublish_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PlaceQuery when eager-loading is set.
 	Edges        PlaceEdges `json:"edges"`
@@ -81,9 +102,13 @@ type PlaceEdges struct {
 	Category []*Category `json:"category,omitempty"`
 	// Visits holds the value of the visits edge.
 	Visits []*Visit `json:"visits,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*PlaceRevision `json:"revisions,omitempty"`
+	// SlugHistory holds the value of the slug_history edge.
+	SlugHistory []*PlaceSlug `json:"slug_history,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
 }
 
 // ImagesOrErr returns the Images value or an error if the edge
@@ -113,21 +138,41 @@ func (e PlaceEdges) VisitsOrErr() ([]*Visit, error) {
 	return nil, &NotLoadedError{edge: "visits"}
 }
 
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlaceEdges) RevisionsOrErr() ([]*PlaceRevision, error) {
+	if e.loadedTypes[3] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// SlugHistoryOrErr returns the SlugHistory value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlaceEdges) SlugHistoryOrErr() ([]*PlaceSlug, error) {
+	if e.loadedTypes[4] {
+		return e.SlugHistory, nil
+	}
+	return nil, &NotLoadedError{edge: "slug_history"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Place) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case place.FieldMetadata, place.FieldAddress, place.FieldOpeningHours:
+		case place.FieldMetadata, place.FieldAddress, place.FieldOpeningHours, place.FieldPriceInfo, place.FieldContact, place.FieldAccessibility, place.FieldExternalRefs:
 			values[i] = new([]byte)
 		case place.FieldLatitude, place.FieldLongitude, place.FieldRatingAvg, place.FieldPopularityScore:
 			values[i] = new(decimal.Decimal)
-		case place.FieldViewCount, place.FieldRatingCount, place.FieldAvgVisitMinutes:
+		case place.FieldViewCount, place.FieldRatingCount, place.FieldAvgVisitMinutes, place.FieldGeofenceRadiusM:
 			values[i] = new(sql.NullInt64)
-		case place.FieldID, place.FieldStatus, place.FieldCreatedBy, place.FieldUpdatedBy, place.FieldSlug, place.FieldTitle, place.FieldSubtitle, place.FieldShortDescription, place.FieldLongDescription, place.FieldPlaceType, place.FieldPrimaryImageURL, place.FieldThumbnailURL:
+		case place.FieldID, place.FieldCreatedBy, place.FieldUpdatedBy, place.FieldDeletedBy, place.FieldSlug, place.FieldTitle, place.FieldSubtitle, place.FieldShortDescription, place.FieldLongDescription, place.FieldPlaceType, place.FieldGeohash, place.FieldPrimaryImageURL, place.FieldThumbnailURL:
 			values[i] = new(sql.NullString)
-		case place.FieldCreatedAt, place.FieldUpdatedAt, place.FieldLastViewedAt:
+		case place.FieldCreatedAt, place.FieldUpdatedAt, place.FieldDeletedAt, place.FieldLastViewedAt, place.FieldPublishAt, place.FieldUnpublishAt:
 			values[i] = new(sql.NullTime)
+		case place.FieldStatus:
+			values[i] = new(types.Status)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -150,10 +195,10 @@ func (_m *Place) assignValues(columns []string, values []any) error {
 				_m.ID = value.String
 			}
 		case place.FieldStatus:
-			if value, ok := values[i].(*sql.NullString); !ok {
+			if value, ok := values[i].(*types.Status); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
-			} else if value.Valid {
-				_m.Status = value.String
+			} else if value != nil {
+				_m.Status = *value
 			}
 		case place.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -179,6 +224,19 @@ func (_m *Place) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedBy = value.String
 			}
+		case place.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case place.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = value.String
+			}
 		case place.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -243,6 +301,12 @@ func (_m *Place) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.Longitude = *value
 			}
+		case place.FieldGeohash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field geohash", values[i])
+			} else if value.Valid {
+				_m.Geohash = value.String
+			}
 		case place.FieldPrimaryImageURL:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field primary_image_url", values[i])
@@ -299,6 +363,59 @@ func (_m *Place) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field opening_hours: %w", err)
 				}
 			}
+		case place.FieldPriceInfo:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field price_info", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PriceInfo); err != nil {
+					return fmt.Errorf("unmarshal field price_info: %w", err)
+				}
+			}
+		case place.FieldContact:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field contact", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Contact); err != nil {
+					return fmt.Errorf("unmarshal field contact: %w", err)
+				}
+			}
+		case place.FieldAccessibility:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field accessibility", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Accessibility); err != nil {
+					return fmt.Errorf("unmarshal field accessibility: %w", err)
+				}
+			}
+		case place.FieldExternalRefs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field external_refs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ExternalRefs); err != nil {
+					return fmt.Errorf("unmarshal field external_refs: %w", err)
+				}
+			}
+		case place.FieldGeofenceRadiusM:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field geofence_radius_m", values[i])
+			} else if value.Valid {
+				_m.GeofenceRadiusM = new(int)
+				*_m.GeofenceRadiusM = int(value.Int64)
+			}
+		case place.FieldPublishAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field publish_at", values[i])
+			} else if value.Valid {
+				_m.PublishAt = new(time.Time)
+				*_m.PublishAt = value.Time
+			}
+		case place.FieldUnpublishAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field unpublish_at", values[i])
+			} else if value.Valid {
+				_m.UnpublishAt = new(time.Time)
+				*_m.UnpublishAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -327,6 +444,16 @@ func (_m *Place) QueryVisits() *VisitQuery {
 	return NewPlaceClient(_m.config).QueryVisits(_m)
 }
 
+// QueryRevisions queries the "revisions" edge of the Place entity.
+func (_m *Place) QueryRevisions() *PlaceRevisionQuery {
+	return NewPlaceClient(_m.config).QueryRevisions(_m)
+}
+
+// QuerySlugHistory queries the "slug_history" edge of the Place entity.
+func (_m *Place) QuerySlugHistory() *PlaceSlugQuery {
+	return NewPlaceClient(_m.config).QuerySlugHistory(_m)
+}
+
 // Update returns a builder for updating this Place.
 // Note that you need to call Place.Unwrap() before calling this method if this Place
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -351,7 +478,7 @@ func (_m *Place) String() string {
 	builder.WriteString("Place(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("status=")
-	builder.WriteString(_m.Status)
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
@@ -365,6 +492,14 @@ func (_m *Place) String() string {
 	builder.WriteString("updated_by=")
 	builder.WriteString(_m.UpdatedBy)
 	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(_m.DeletedBy)
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
 	builder.WriteString(", ")
@@ -395,6 +530,9 @@ func (_m *Place) String() string {
 	builder.WriteString("longitude=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Longitude))
 	builder.WriteString(", ")
+	builder.WriteString("geohash=")
+	builder.WriteString(_m.Geohash)
+	builder.WriteString(", ")
 	builder.WriteString("primary_image_url=")
 	builder.WriteString(_m.PrimaryImageURL)
 	builder.WriteString(", ")
@@ -421,6 +559,33 @@ func (_m *Place) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("opening_hours=")
 	builder.WriteString(fmt.Sprintf("%v", _m.OpeningHours))
+	builder.WriteString(", ")
+	builder.WriteString("price_info=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PriceInfo))
+	builder.WriteString(", ")
+	builder.WriteString("contact=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Contact))
+	builder.WriteString(", ")
+	builder.WriteString("accessibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Accessibility))
+	builder.WriteString(", ")
+	builder.WriteString("external_refs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExternalRefs))
+	builder.WriteString(", ")
+	if v := _m.GeofenceRadiusM; v != nil {
+		builder.WriteString("geofence_radius_m=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.PublishAt; v != nil {
+		builder.WriteString("publish_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UnpublishAt; v != nil {
+		builder.WriteString("unpublish_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -5,8 +5,10 @@ package place
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -25,6 +27,10 @@ const (
 	FieldCreatedBy = "created_by"
 	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
 	FieldUpdatedBy = "updated_by"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldDeletedBy holds the string denoting the deleted_by field in the database.
+	FieldDeletedBy = "deleted_by"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
 	// FieldSlug holds the string denoting the slug field in the database.
@@ -45,6 +51,8 @@ const (
 	FieldLatitude = "latitude"
 	// FieldLongitude holds the string denoting the longitude field in the database.
 	FieldLongitude = "longitude"
+	// FieldGeohash holds the string denoting the geohash field in the database.
+	FieldGeohash = "geohash"
 	// FieldPrimaryImageURL holds the string denoting the primary_image_url field in the database.
 	FieldPrimaryImageURL = "primary_image_url"
 	// FieldThumbnailURL holds the string denoting the thumbnail_url field in the database.
@@ -63,12 +71,30 @@ const (
 	FieldAvgVisitMinutes = "avg_visit_minutes"
 	// FieldOpeningHours holds the string denoting the opening_hours field in the database.
 	FieldOpeningHours = "opening_hours"
+	// FieldPriceInfo holds the string denoting the price_info field in the database.
+	FieldPriceInfo = "price_info"
+	// FieldContact holds the string denoting the contact field in the database.
+	FieldContact = "contact"
+	// FieldAccessibility holds the string denoting the accessibility field in the database.
+	FieldAccessibility = "accessibility"
+	// FieldExternalRefs holds the string denoting the external_refs field in the database.
+	FieldExternalRefs = "external_refs"
+	// FieldGeofenceRadiusM holds the string denoting the geofence_radius_m field in the database.
+	FieldGeofenceRadiusM = "geofence_radius_m"
+	// FieldPublishAt holds the string denoting the publish_at field in the database.
+	FieldPublishAt = "publish_at"
+	// FieldUnpublishAt holds the string denoting the unpublish_at field in the database.
+	FieldUnpublishAt = "unpublish_at"
 	// EdgeImages holds the string denoting the images edge name in mutations.
 	EdgeImages = "images"
 	// EdgeCategory holds the string denoting the category edge name in mutations.
 	EdgeCategory = "category"
 	// EdgeVisits holds the string denoting the visits edge name in mutations.
 	EdgeVisits = "visits"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+	// EdgeSlugHistory holds the string denoting the slug_history edge name in mutations.
+	EdgeSlugHistory = "slug_history"
 	// Table holds the table name of the place in the database.
 	Table = "places"
 	// ImagesTable is the table that holds the images relation/edge.
@@ -90,6 +116,20 @@ const (
 	VisitsInverseTable = "visits"
 	// VisitsColumn is the table column denoting the visits relation/edge.
 	VisitsColumn = "place_id"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "place_revisions"
+	// RevisionsInverseTable is the table name for the PlaceRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "placerevision" package.
+	RevisionsInverseTable = "place_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "place_id"
+	// SlugHistoryTable is the table that holds the slug_history relation/edge.
+	SlugHistoryTable = "place_slugs"
+	// SlugHistoryInverseTable is the table name for the PlaceSlug entity.
+	// It exists in this package in order to avoid circular dependency with the "placeslug" package.
+	SlugHistoryInverseTable = "place_slugs"
+	// SlugHistoryColumn is the table column denoting the slug_history relation/edge.
+	SlugHistoryColumn = "place_id"
 )
 
 // Columns holds all SQL columns for place fields.
@@ -100,6 +140,8 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldCreatedBy,
 	FieldUpdatedBy,
+	FieldDeletedAt,
+	FieldDeletedBy,
 	FieldMetadata,
 	FieldSlug,
 	FieldTitle,
@@ -110,6 +152,7 @@ var Columns = []string{
 	FieldAddress,
 	FieldLatitude,
 	FieldLongitude,
+	FieldGeohash,
 	FieldPrimaryImageURL,
 	FieldThumbnailURL,
 	FieldViewCount,
@@ -119,6 +162,13 @@ var Columns = []string{
 	FieldPopularityScore,
 	FieldAvgVisitMinutes,
 	FieldOpeningHours,
+	FieldPriceInfo,
+	FieldContact,
+	FieldAccessibility,
+	FieldExternalRefs,
+	FieldGeofenceRadiusM,
+	FieldPublishAt,
+	FieldUnpublishAt,
 }
 
 var (
@@ -137,9 +187,17 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/omkar273/nashikdarshan/ent/runtime"
 var (
+	Hooks [2]ent.Hook
 	// DefaultStatus holds the default value on creation for the "status" field.
-	DefaultStatus string
+	DefaultStatus types.Status
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -172,6 +230,8 @@ var (
 	DefaultPopularityScore decimal.Decimal
 	// DefaultAvgVisitMinutes holds the default value on creation for the "avg_visit_minutes" field.
 	DefaultAvgVisitMinutes int
+	// GeofenceRadiusMValidator is a validator for the "geofence_radius_m" field. It is called by the builders before save.
+	GeofenceRadiusMValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -209,6 +269,16 @@ func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByDeletedBy orders the results by the deleted_by field.
+func ByDeletedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedBy, opts...).ToFunc()
+}
+
 // BySlug orders the results by the slug field.
 func BySlug(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSlug, opts...).ToFunc()
@@ -249,6 +319,11 @@ func ByLongitude(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLongitude, opts...).ToFunc()
 }
 
+// ByGeohash orders the results by the geohash field.
+func ByGeohash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGeohash, opts...).ToFunc()
+}
+
 // ByPrimaryImageURL orders the results by the primary_image_url field.
 func ByPrimaryImageURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPrimaryImageURL, opts...).ToFunc()
@@ -289,6 +364,21 @@ func ByAvgVisitMinutes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAvgVisitMinutes, opts...).ToFunc()
 }
 
+// ByGeofenceRadiusM orders the results by the geofence_radius_m field.
+func ByGeofenceRadiusM(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGeofenceRadiusM, opts...).ToFunc()
+}
+
+// ByPublishAt orders the results by the publish_at field.
+func ByPublishAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishAt, opts...).ToFunc()
+}
+
+// ByUnpublishAt orders the results by the unpublish_at field.
+func ByUnpublishAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnpublishAt, opts...).ToFunc()
+}
+
 // ByImagesCount orders the results by images count.
 func ByImagesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -330,6 +420,34 @@ func ByVisits(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newVisitsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySlugHistoryCount orders the results by slug_history count.
+func BySlugHistoryCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSlugHistoryStep(), opts...)
+	}
+}
+
+// BySlugHistory orders the results by slug_history terms.
+func BySlugHistory(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSlugHistoryStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newImagesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -351,3 +469,17 @@ func newVisitsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, VisitsTable, VisitsColumn),
 	)
 }
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+	)
+}
+func newSlugHistoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SlugHistoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SlugHistoryTable, SlugHistoryColumn),
+	)
+}
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/omkar273/nashikdarshan/ent/predicate"
+	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -67,7 +68,7 @@ func IDContainsFold(id string) predicate.Place {
 }
 
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
-func Status(v string) predicate.Place {
+func Status(v types.Status) predicate.Place {
 	return predicate.Place(sql.FieldEQ(FieldStatus, v))
 }
 
@@ -91,6 +92,16 @@ func UpdatedBy(v string) predicate.Place {
 	return predicate.Place(sql.FieldEQ(FieldUpdatedBy, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v string) predicate.Place {
+	return predicate.Place(sql.FieldEQ(FieldDeletedBy, v))
+}
+
 // Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
 func Slug(v string) predicate.Place {
 	return predicate.Place(sql.FieldEQ(FieldSlug, v))
@@ -131,6 +142,11 @@ func Longitude(v decimal.Decimal) predicate.Place {
 	return predicate.Place(sql.FieldEQ(FieldLongitude, v))
 }
 
+// Geohash applies equality check predicate on the "geohash" field. It's identical to GeohashEQ.
+func Geohash(v string) predicate.Place {
+	return predicate.Place(sql.FieldEQ(FieldGeohash, v))
+}
+
 // PrimaryImageURL applies equality check predicate on the "primary_image_url" field. It's identical to PrimaryImageURLEQ.
 func PrimaryImageURL(v string) predicate.Place {
 	return predicate.Place(sql.FieldEQ(FieldPrimaryImageURL, v))
@@ -171,69 +187,89 @@ func AvgVisitMinutes(v int) predicate.Place {
 	return predicate.Place(sql.FieldEQ(FieldAvgVisitMinutes, v))
 }
 
+// GeofenceRadiusM applies equality check predicate on the "geofence_radius_m" field. It's identical to GeofenceRadiusMEQ.
+func GeofenceRadiusM(v int) predicate.Place {
+	return predicate.Place(sql.FieldEQ(FieldGeofenceRadiusM, v))
+}
+
+// PublishAt applies equality check predicate on the "publish_at" field. It's identical to PublishAtEQ.
+func PublishAt(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldEQ(FieldPublishAt, v))
+}
+
+// UnpublishAt applies equality check predicate on the "unpublish_at" field. It's identical to UnpublishAtEQ.
+func UnpublishAt(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldEQ(FieldUnpublishAt, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
-func StatusEQ(v string) predicate.Place {
+func StatusEQ(v types.Status) predicate.Place {
 	return predicate.Place(sql.FieldEQ(FieldStatus, v))
 }
 
 // StatusNEQ applies the NEQ predicate on the "status" field.
-func StatusNEQ(v string) predicate.Place {
+func StatusNEQ(v types.Status) predicate.Place {
 	return predicate.Place(sql.FieldNEQ(FieldStatus, v))
 }
 
 // StatusIn applies the In predicate on the "status" field.
-func StatusIn(vs ...string) predicate.Place {
+func StatusIn(vs ...types.Status) predicate.Place {
 	return predicate.Place(sql.FieldIn(FieldStatus, vs...))
 }
 
 // StatusNotIn applies the NotIn predicate on the "status" field.
-func StatusNotIn(vs ...string) predicate.Place {
+func StatusNotIn(vs ...types.Status) predicate.Place {
 	return predicate.Place(sql.FieldNotIn(FieldStatus, vs...))
 }
 
 // StatusGT applies the GT predicate on the "status" field.
-func StatusGT(v string) predicate.Place {
+func StatusGT(v types.Status) predicate.Place {
 	return predicate.Place(sql.FieldGT(FieldStatus, v))
 }
 
 // StatusGTE applies the GTE predicate on the "status" field.
-func StatusGTE(v string) predicate.Place {
+func StatusGTE(v types.Status) predicate.Place {
 	return predicate.Place(sql.FieldGTE(FieldStatus, v))
 }
 
 // StatusLT applies the LT predicate on the "status" field.
-func StatusLT(v string) predicate.Place {
+func StatusLT(v types.Status) predicate.Place {
 	return predicate.Place(sql.FieldLT(FieldStatus, v))
 }
 
 // StatusLTE applies the LTE predicate on the "status" field.
-func StatusLTE(v string) predicate.Place {
+func StatusLTE(v types.Status) predicate.Place {
 	return predicate.Place(sql.FieldLTE(FieldStatus, v))
 }
 
 // StatusContains applies the Contains predicate on the "status" field.
-func StatusContains(v string) predicate.Place {
-	return predicate.Place(sql.FieldContains(FieldStatus, v))
+func StatusContains(v types.Status) predicate.Place {
+	vc := string(v)
+	return predicate.Place(sql.FieldContains(FieldStatus, vc))
 }
 
 // StatusHasPrefix applies the HasPrefix predicate on the "status" field.
-func StatusHasPrefix(v string) predicate.Place {
-	return predicate.Place(sql.FieldHasPrefix(FieldStatus, v))
+func StatusHasPrefix(v types.Status) predicate.Place {
+	vc := string(v)
+	return predicate.Place(sql.FieldHasPrefix(FieldStatus, vc))
 }
 
 // StatusHasSuffix applies the HasSuffix predicate on the "status" field.
-func StatusHasSuffix(v string) predicate.Place {
-	return predicate.Place(sql.FieldHasSuffix(FieldStatus, v))
+func StatusHasSuffix(v types.Status) predicate.Place {
+	vc := string(v)
+	return predicate.Place(sql.FieldHasSuffix(FieldStatus, vc))
 }
 
 // StatusEqualFold applies the EqualFold predicate on the "status" field.
-func StatusEqualFold(v string) predicate.Place {
-	return predicate.Place(sql.FieldEqualFold(FieldStatus, v))
+func StatusEqualFold(v types.Status) predicate.Place {
+	vc := string(v)
+	return predicate.Place(sql.FieldEqualFold(FieldStatus, vc))
 }
 
 // StatusContainsFold applies the ContainsFold predicate on the "status" field.
-func StatusContainsFold(v string) predicate.Place {
-	return predicate.Place(sql.FieldContainsFold(FieldStatus, v))
+func StatusContainsFold(v types.Status) predicate.Place {
+	vc := string(v)
+	return predicate.Place(sql.FieldContainsFold(FieldStatus, vc))
 }
 
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
@@ -466,6 +502,131 @@ func UpdatedByContainsFold(v string) predicate.Place {
 	return predicate.Place(sql.FieldContainsFold(FieldUpdatedBy, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Place {
+	return predicate.Place(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Place {
+	return predicate.Place(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Place {
+	return predicate.Place(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Place {
+	return predicate.Place(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v string) predicate.Place {
+	return predicate.Place(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v string) predicate.Place {
+	return predicate.Place(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...string) predicate.Place {
+	return predicate.Place(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...string) predicate.Place {
+	return predicate.Place(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v string) predicate.Place {
+	return predicate.Place(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v string) predicate.Place {
+	return predicate.Place(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v string) predicate.Place {
+	return predicate.Place(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v string) predicate.Place {
+	return predicate.Place(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByContains applies the Contains predicate on the "deleted_by" field.
+func DeletedByContains(v string) predicate.Place {
+	return predicate.Place(sql.FieldContains(FieldDeletedBy, v))
+}
+
+// DeletedByHasPrefix applies the HasPrefix predicate on the "deleted_by" field.
+func DeletedByHasPrefix(v string) predicate.Place {
+	return predicate.Place(sql.FieldHasPrefix(FieldDeletedBy, v))
+}
+
+// DeletedByHasSuffix applies the HasSuffix predicate on the "deleted_by" field.
+func DeletedByHasSuffix(v string) predicate.Place {
+	return predicate.Place(sql.FieldHasSuffix(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.Place {
+	return predicate.Place(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.Place {
+	return predicate.Place(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// DeletedByEqualFold applies the EqualFold predicate on the "deleted_by" field.
+func DeletedByEqualFold(v string) predicate.Place {
+	return predicate.Place(sql.FieldEqualFold(FieldDeletedBy, v))
+}
+
+// DeletedByContainsFold applies the ContainsFold predicate on the "deleted_by" field.
+func DeletedByContainsFold(v string) predicate.Place {
+	return predicate.Place(sql.FieldContainsFold(FieldDeletedBy, v))
+}
+
 // MetadataIsNil applies the IsNil predicate on the "metadata" field.
 func MetadataIsNil() predicate.Place {
 	return predicate.Place(sql.FieldIsNull(FieldMetadata))
@@ -986,6 +1147,81 @@ func LongitudeLTE(v decimal.Decimal) predicate.Place {
 	return predicate.Place(sql.FieldLTE(FieldLongitude, v))
 }
 
+// GeohashEQ applies the EQ predicate on the "geohash" field.
+func GeohashEQ(v string) predicate.Place {
+	return predicate.Place(sql.FieldEQ(FieldGeohash, v))
+}
+
+// GeohashNEQ applies the NEQ predicate on the "geohash" field.
+func GeohashNEQ(v string) predicate.Place {
+	return predicate.Place(sql.FieldNEQ(FieldGeohash, v))
+}
+
+// GeohashIn applies the In predicate on the "geohash" field.
+func GeohashIn(vs ...string) predicate.Place {
+	return predicate.Place(sql.FieldIn(FieldGeohash, vs...))
+}
+
+// GeohashNotIn applies the NotIn predicate on the "geohash" field.
+func GeohashNotIn(vs ...string) predicate.Place {
+	return predicate.Place(sql.FieldNotIn(FieldGeohash, vs...))
+}
+
+// GeohashGT applies the GT predicate on the "geohash" field.
+func GeohashGT(v string) predicate.Place {
+	return predicate.Place(sql.FieldGT(FieldGeohash, v))
+}
+
+// GeohashGTE applies the GTE predicate on the "geohash" field.
+func GeohashGTE(v string) predicate.Place {
+	return predicate.Place(sql.FieldGTE(FieldGeohash, v))
+}
+
+// GeohashLT applies the LT predicate on the "geohash" field.
+func GeohashLT(v string) predicate.Place {
+	return predicate.Place(sql.FieldLT(FieldGeohash, v))
+}
+
+// GeohashLTE applies the LTE predicate on the "geohash" field.
+func GeohashLTE(v string) predicate.Place {
+	return predicate.Place(sql.FieldLTE(FieldGeohash, v))
+}
+
+// GeohashContains applies the Contains predicate on the "geohash" field.
+func GeohashContains(v string) predicate.Place {
+	return predicate.Place(sql.FieldContains(FieldGeohash, v))
+}
+
+// GeohashHasPrefix applies the HasPrefix predicate on the "geohash" field.
+func GeohashHasPrefix(v string) predicate.Place {
+	return predicate.Place(sql.FieldHasPrefix(FieldGeohash, v))
+}
+
+// GeohashHasSuffix applies the HasSuffix predicate on the "geohash" field.
+func GeohashHasSuffix(v string) predicate.Place {
+	return predicate.Place(sql.FieldHasSuffix(FieldGeohash, v))
+}
+
+// GeohashIsNil applies the IsNil predicate on the "geohash" field.
+func GeohashIsNil() predicate.Place {
+	return predicate.Place(sql.FieldIsNull(FieldGeohash))
+}
+
+// GeohashNotNil applies the NotNil predicate on the "geohash" field.
+func GeohashNotNil() predicate.Place {
+	return predicate.Place(sql.FieldNotNull(FieldGeohash))
+}
+
+// GeohashEqualFold applies the EqualFold predicate on the "geohash" field.
+func GeohashEqualFold(v string) predicate.Place {
+	return predicate.Place(sql.FieldEqualFold(FieldGeohash, v))
+}
+
+// GeohashContainsFold applies the ContainsFold predicate on the "geohash" field.
+func GeohashContainsFold(v string) predicate.Place {
+	return predicate.Place(sql.FieldContainsFold(FieldGeohash, v))
+}
+
 // PrimaryImageURLEQ applies the EQ predicate on the "primary_image_url" field.
 func PrimaryImageURLEQ(v string) predicate.Place {
 	return predicate.Place(sql.FieldEQ(FieldPrimaryImageURL, v))
@@ -1396,6 +1632,196 @@ func OpeningHoursNotNil() predicate.Place {
 	return predicate.Place(sql.FieldNotNull(FieldOpeningHours))
 }
 
+// PriceInfoIsNil applies the IsNil predicate on the "price_info" field.
+func PriceInfoIsNil() predicate.Place {
+	return predicate.Place(sql.FieldIsNull(FieldPriceInfo))
+}
+
+// PriceInfoNotNil applies the NotNil predicate on the "price_info" field.
+func PriceInfoNotNil() predicate.Place {
+	return predicate.Place(sql.FieldNotNull(FieldPriceInfo))
+}
+
+// ContactIsNil applies the IsNil predicate on the "contact" field.
+func ContactIsNil() predicate.Place {
+	return predicate.Place(sql.FieldIsNull(FieldContact))
+}
+
+// ContactNotNil applies the NotNil predicate on the "contact" field.
+func ContactNotNil() predicate.Place {
+	return predicate.Place(sql.FieldNotNull(FieldContact))
+}
+
+// AccessibilityIsNil applies the IsNil predicate on the "accessibility" field.
+func AccessibilityIsNil() predicate.Place {
+	return predicate.Place(sql.FieldIsNull(FieldAccessibility))
+}
+
+// AccessibilityNotNil applies the NotNil predicate on the "accessibility" field.
+func AccessibilityNotNil() predicate.Place {
+	return predicate.Place(sql.FieldNotNull(FieldAccessibility))
+}
+
+// ExternalRefsIsNil applies the IsNil predicate on the "external_refs" field.
+func ExternalRefsIsNil() predicate.Place {
+	return predicate.Place(sql.FieldIsNull(FieldExternalRefs))
+}
+
+// ExternalRefsNotNil applies the NotNil predicate on the "external_refs" field.
+func ExternalRefsNotNil() predicate.Place {
+	return predicate.Place(sql.FieldNotNull(FieldExternalRefs))
+}
+
+// GeofenceRadiusMEQ applies the EQ predicate on the "geofence_radius_m" field.
+func GeofenceRadiusMEQ(v int) predicate.Place {
+	return predicate.Place(sql.FieldEQ(FieldGeofenceRadiusM, v))
+}
+
+// GeofenceRadiusMNEQ applies the NEQ predicate on the "geofence_radius_m" field.
+func GeofenceRadiusMNEQ(v int) predicate.Place {
+	return predicate.Place(sql.FieldNEQ(FieldGeofenceRadiusM, v))
+}
+
+// GeofenceRadiusMIn applies the In predicate on the "geofence_radius_m" field.
+func GeofenceRadiusMIn(vs ...int) predicate.Place {
+	return predicate.Place(sql.FieldIn(FieldGeofenceRadiusM, vs...))
+}
+
+// GeofenceRadiusMNotIn applies the NotIn predicate on the "geofence_radius_m" field.
+func GeofenceRadiusMNotIn(vs ...int) predicate.Place {
+	return predicate.Place(sql.FieldNotIn(FieldGeofenceRadiusM, vs...))
+}
+
+// GeofenceRadiusMGT applies the GT predicate on the "geofence_radius_m" field.
+func GeofenceRadiusMGT(v int) predicate.Place {
+	return predicate.Place(sql.FieldGT(FieldGeofenceRadiusM, v))
+}
+
+// GeofenceRadiusMGTE applies the GTE predicate on the "geofence_radius_m" field.
+func GeofenceRadiusMGTE(v int) predicate.Place {
+	return predicate.Place(sql.FieldGTE(FieldGeofenceRadiusM, v))
+}
+
+// GeofenceRadiusMLT applies the LT predicate on the "geofence_radius_m" field.
+func GeofenceRadiusMLT(v int) predicate.Place {
+	return predicate.Place(sql.FieldLT(FieldGeofenceRadiusM, v))
+}
+
+// GeofenceRadiusMLTE applies the LTE predicate on the "geofence_radius_m" field.
+func GeofenceRadiusMLTE(v int) predicate.Place {
+	return predicate.Place(sql.FieldLTE(FieldGeofenceRadiusM, v))
+}
+
+// GeofenceRadiusMIsNil applies the IsNil predicate on the "geofence_radius_m" field.
+func GeofenceRadiusMIsNil() predicate.Place {
+	return predicate.Place(sql.FieldIsNull(FieldGeofenceRadiusM))
+}
+
+// GeofenceRadiusMNotNil applies the NotNil predicate on the "geofence_radius_m" field.
+func GeofenceRadiusMNotNil() predicate.Place {
+	return predicate.Place(sql.FieldNotNull(FieldGeofenceRadiusM))
+}
+
+// PublishAtEQ applies the EQ predicate on the "publish_at" field.
+func PublishAtEQ(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldEQ(FieldPublishAt, v))
+}
+
+// PublishAtNEQ applies the NEQ predicate on the "publish_at" field.
+func PublishAtNEQ(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldNEQ(FieldPublishAt, v))
+}
+
+// PublishAtIn applies the In predicate on the "publish_at" field.
+func PublishAtIn(vs ...time.Time) predicate.Place {
+	return predicate.Place(sql.FieldIn(FieldPublishAt, vs...))
+}
+
+// PublishAtNotIn applies the NotIn predicate on the "publish_at" field.
+func PublishAtNotIn(vs ...time.Time) predicate.Place {
+	return predicate.Place(sql.FieldNotIn(FieldPublishAt, vs...))
+}
+
+// PublishAtGT applies the GT predicate on the "publish_at" field.
+func PublishAtGT(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldGT(FieldPublishAt, v))
+}
+
+// PublishAtGTE applies the GTE predicate on the "publish_at" field.
+func PublishAtGTE(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldGTE(FieldPublishAt, v))
+}
+
+// PublishAtLT applies the LT predicate on the "publish_at" field.
+func PublishAtLT(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldLT(FieldPublishAt, v))
+}
+
+// PublishAtLTE applies the LTE predicate on the "publish_at" field.
+func PublishAtLTE(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldLTE(FieldPublishAt, v))
+}
+
+// PublishAtIsNil applies the IsNil predicate on the "publish_at" field.
+func PublishAtIsNil() predicate.Place {
+	return predicate.Place(sql.FieldIsNull(FieldPublishAt))
+}
+
+// PublishAtNotNil applies the NotNil predicate on the "publish_at" field.
+func PublishAtNotNil() predicate.Place {
+	return predicate.Place(sql.FieldNotNull(FieldPublishAt))
+}
+
+// UnpublishAtEQ applies the EQ predicate on the "unpublish_at" field.
+func UnpublishAtEQ(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldEQ(FieldUnpublishAt, v))
+}
+
+// UnpublishAtNEQ applies the NEQ predicate on the "unpublish_at" field.
+func UnpublishAtNEQ(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldNEQ(FieldUnpublishAt, v))
+}
+
+// UnpublishAtIn applies the In predicate on the "unpublish_at" field.
+func UnpublishAtIn(vs ...time.Time) predicate.Place {
+	return predicate.Place(sql.FieldIn(FieldUnpublishAt, vs...))
+}
+
+// UnpublishAtNotIn applies the NotIn predicate on the "unpublish_at" field.
+func UnpublishAtNotIn(vs ...time.Time) predicate.Place {
+	return predicate.Place(sql.FieldNotIn(FieldUnpublishAt, vs...))
+}
+
+// UnpublishAtGT applies the GT predicate on the "unpublish_at" field.
+func UnpublishAtGT(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldGT(FieldUnpublishAt, v))
+}
+
+// UnpublishAtGTE applies the GTE predicate on the "unpublish_at" field.
+func UnpublishAtGTE(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldGTE(FieldUnpublishAt, v))
+}
+
+// UnpublishAtLT applies the LT predicate on the "unpublish_at" field.
+func UnpublishAtLT(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldLT(FieldUnpublishAt, v))
+}
+
+// UnpublishAtLTE applies the LTE predicate on the "unpublish_at" field.
+func UnpublishAtLTE(v time.Time) predicate.Place {
+	return predicate.Place(sql.FieldLTE(FieldUnpublishAt, v))
+}
+
+// UnpublishAtIsNil applies the IsNil predicate on the "unpublish_at" field.
+func UnpublishAtIsNil() predicate.Place {
+	return predicate.Place(sql.FieldIsNull(FieldUnpublishAt))
+}
+
+// UnpublishAtNotNil applies the NotNil predicate on the "unpublish_at" field.
+func UnpublishAtNotNil() predicate.Place {
+	return predicate.Place(sql.FieldNotNull(FieldUnpublishAt))
+}
+
 // HasImages applies the HasEdge predicate on the "images" edge.
 func HasImages() predicate.Place {
 	return predicate.Place(func(s *sql.Selector) {
@@ -1465,6 +1891,52 @@ func HasVisitsWith(preds ...predicate.Visit) predicate.Place {
 	})
 }
 
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.Place {
+	return predicate.Place(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.PlaceRevision) predicate.Place {
+	return predicate.Place(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSlugHistory applies the HasEdge predicate on the "slug_history" edge.
+func HasSlugHistory() predicate.Place {
+	return predicate.Place(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SlugHistoryTable, SlugHistoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSlugHistoryWith applies the HasEdge predicate on the "slug_history" edge with a given conditions (other predicates).
+func HasSlugHistoryWith(preds ...predicate.PlaceSlug) predicate.Place {
+	return predicate.Place(func(s *sql.Selector) {
+		step := newSlugHistoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Place) predicate.Place {
 	return predicate.Place(sql.AndPredicates(predicates...))
@@ -8,12 +8,17 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/omkar273/nashikdarshan/ent/category"
 	"github.com/omkar273/nashikdarshan/ent/place"
 	"github.com/omkar273/nashikdarshan/ent/placeimage"
+	"github.com/omkar273/nashikdarshan/ent/placerevision"
+	"github.com/omkar273/nashikdarshan/ent/placeslug"
 	"github.com/omkar273/nashikdarshan/ent/visit"
+	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -22,16 +27,17 @@ type PlaceCreate struct {
 	config
 	mutation *PlaceMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetStatus sets the "status" field.
-func (_c *PlaceCreate) SetStatus(v string) *PlaceCreate {
+func (_c *PlaceCreate) SetStatus(v types.Status) *PlaceCreate {
 	_c.mutation.SetStatus(v)
 	return _c
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_c *PlaceCreate) SetNillableStatus(v *string) *PlaceCreate {
+func (_c *PlaceCreate) SetNillableStatus(v *types.Status) *PlaceCreate {
 	if v != nil {
 		_c.SetStatus(*v)
 	}
@@ -94,6 +100,34 @@ func (_c *PlaceCreate) SetNillableUpdatedBy(v *string) *PlaceCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *PlaceCreate) SetDeletedAt(v time.Time) *PlaceCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *PlaceCreate) SetNillableDeletedAt(v *time.Time) *PlaceCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *PlaceCreate) SetDeletedBy(v string) *PlaceCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *PlaceCreate) SetNillableDeletedBy(v *string) *PlaceCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
 // SetMetadata sets the "metadata" field.
 func (_c *PlaceCreate) SetMetadata(v map[string]string) *PlaceCreate {
 	_c.mutation.SetMetadata(v)
@@ -194,6 +228,20 @@ func (_c *PlaceCreate) SetNillableLongitude(v *decimal.Decimal) *PlaceCreate {
 	return _c
 }
 
+// SetGeohash sets the "geohash" field.
+func (_c *PlaceCreate) SetGeohash(v string) *PlaceCreate {
+	_c.mutation.SetGeohash(v)
+	return _c
+}
+
+// SetNillableGeohash sets the "geohash" field if the given value is not nil.
+func (_c *PlaceCreate) SetNillableGeohash(v *string) *PlaceCreate {
+	if v != nil {
+		_c.SetGeohash(*v)
+	}
+	return _c
+}
+
 // SetPrimaryImageURL sets the "primary_image_url" field.
 func (_c *PlaceCreate) SetPrimaryImageURL(v string) *PlaceCreate {
 	_c.mutation.SetPrimaryImageURL(v)
@@ -312,6 +360,72 @@ func (_c *PlaceCreate) SetOpeningHours(v map[string]string) *PlaceCreate {
 	return _c
 }
 
+// SetPriceInfo sets the "price_info" field.
+func (_c *PlaceCreate) SetPriceInfo(v *types.PriceInfo) *PlaceCreate {
+	_c.mutation.SetPriceInfo(v)
+	return _c
+}
+
+// SetContact sets the "contact" field.
+func (_c *PlaceCreate) SetContact(v *types.Contact) *PlaceCreate {
+	_c.mutation.SetContact(v)
+	return _c
+}
+
+// SetAccessibility sets the "accessibility" field.
+func (_c *PlaceCreate) SetAccessibility(v *types.Accessibility) *PlaceCreate {
+	_c.mutation.SetAccessibility(v)
+	return _c
+}
+
+// SetExternalRefs sets the "external_refs" field.
+func (_c *PlaceCreate) SetExternalRefs(v map[string]string) *PlaceCreate {
+	_c.mutation.SetExternalRefs(v)
+	return _c
+}
+
+// SetGeofenceRadiusM sets the "geofence_radius_m" field.
+func (_c *PlaceCreate) SetGeofenceRadiusM(v int) *PlaceCreate {
+	_c.mutation.SetGeofenceRadiusM(v)
+	return _c
+}
+
+// SetNillableGeofenceRadiusM sets the "geofence_radius_m" field if the given value is not nil.
+func (_c *PlaceCreate) SetNillableGeofenceRadiusM(v *int) *PlaceCreate {
+	if v != nil {
+		_c.SetGeofenceRadiusM(*v)
+	}
+	return _c
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (_c *PlaceCreate) SetPublishAt(v time.Time) *PlaceCreate {
+	_c.mutation.SetPublishAt(v)
+	return _c
+}
+
+// SetNillablePublishAt sets the "publish_at" field if the given value is not nil.
+func (_c *PlaceCreate) SetNillablePublishAt(v *time.Time) *PlaceCreate {
+	if v != nil {
+		_c.SetPublishAt(*v)
+	}
+	return _c
+}
+
+// SetUnpublishAt sets the "unpublish_at" field.
+func (_c *PlaceCreate) SetUnpublishAt(v time.Time) *PlaceCreate {
+	_c.mutation.SetUnpublishAt(v)
+	return _c
+}
+
+// SetNillableUnpublishAt sets the "unpublish_at" field if the given value is not nil.
+func (_c *PlaceCreate) SetNillableUnpublishAt(v *time.Time) *PlaceCreate {
+	if v != nil {
+		_c.SetUnpublishAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *PlaceCreate) SetID(v string) *PlaceCreate {
 	_c.mutation.SetID(v)
@@ -371,6 +485,36 @@ func (_c *PlaceCreate) AddVisits(v ...*Visit) *PlaceCreate {
 	return _c.AddVisitIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the PlaceRevision entity by IDs.
+func (_c *PlaceCreate) AddRevisionIDs(ids ...string) *PlaceCreate {
+	_c.mutation.AddRevisionIDs(ids...)
+	return _c
+}
+
+// AddRevisions adds the "revisions" edges to the PlaceRevision entity.
+func (_c *PlaceCreate) AddRevisions(v ...*PlaceRevision) *PlaceCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevisionIDs(ids...)
+}
+
+// AddSlugHistoryIDs adds the "slug_history" edge to the PlaceSlug entity by IDs.
+func (_c *PlaceCreate) AddSlugHistoryIDs(ids ...string) *PlaceCreate {
+	_c.mutation.AddSlugHistoryIDs(ids...)
+	return _c
+}
+
+// AddSlugHistory adds the "slug_history" edges to the PlaceSlug entity.
+func (_c *PlaceCreate) AddSlugHistory(v ...*PlaceSlug) *PlaceCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSlugHistoryIDs(ids...)
+}
+
 // Mutation returns the PlaceMutation object of the builder.
 func (_c *PlaceCreate) Mutation() *PlaceMutation {
 	return _c.mutation
@@ -378,7 +522,9 @@ func (_c *PlaceCreate) Mutation() *PlaceMutation {
 
 // Save creates the Place in the database.
 func (_c *PlaceCreate) Save(ctx context.Context) (*Place, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -405,16 +551,22 @@ func (_c *PlaceCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *PlaceCreate) defaults() {
+func (_c *PlaceCreate) defaults() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		v := place.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if place.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized place.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := place.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if place.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized place.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := place.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
@@ -451,9 +603,13 @@ func (_c *PlaceCreate) defaults() {
 		_c.mutation.SetAvgVisitMinutes(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if place.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized place.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := place.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -461,6 +617,11 @@ func (_c *PlaceCreate) check() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Place.status"`)}
 	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := place.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Place.status": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Place.created_at"`)}
 	}
@@ -522,6 +683,26 @@ func (_c *PlaceCreate) check() error {
 	if _, ok := _c.mutation.AvgVisitMinutes(); !ok {
 		return &ValidationError{Name: "avg_visit_minutes", err: errors.New(`ent: missing required field "Place.avg_visit_minutes"`)}
 	}
+	if v, ok := _c.mutation.PriceInfo(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "price_info", err: fmt.Errorf(`ent: validator failed for field "Place.price_info": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Contact(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "contact", err: fmt.Errorf(`ent: validator failed for field "Place.contact": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Accessibility(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "accessibility", err: fmt.Errorf(`ent: validator failed for field "Place.accessibility": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.GeofenceRadiusM(); ok {
+		if err := place.GeofenceRadiusMValidator(v); err != nil {
+			return &ValidationError{Name: "geofence_radius_m", err: fmt.Errorf(`ent: validator failed for field "Place.geofence_radius_m": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -553,6 +734,7 @@ func (_c *PlaceCreate) createSpec() (*Place, *sqlgraph.CreateSpec) {
 		_node = &Place{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(place.Table, sqlgraph.NewFieldSpec(place.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -577,6 +759,14 @@ func (_c *PlaceCreate) createSpec() (*Place, *sqlgraph.CreateSpec) {
 		_spec.SetField(place.FieldUpdatedBy, field.TypeString, value)
 		_node.UpdatedBy = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(place.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(place.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
 	if value, ok := _c.mutation.Metadata(); ok {
 		_spec.SetField(place.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -617,6 +807,10 @@ func (_c *PlaceCreate) createSpec() (*Place, *sqlgraph.CreateSpec) {
 		_spec.SetField(place.FieldLongitude, field.TypeOther, value)
 		_node.Longitude = value
 	}
+	if value, ok := _c.mutation.Geohash(); ok {
+		_spec.SetField(place.FieldGeohash, field.TypeString, value)
+		_node.Geohash = value
+	}
 	if value, ok := _c.mutation.PrimaryImageURL(); ok {
 		_spec.SetField(place.FieldPrimaryImageURL, field.TypeString, value)
 		_node.PrimaryImageURL = value
@@ -653,6 +847,34 @@ func (_c *PlaceCreate) createSpec() (*Place, *sqlgraph.CreateSpec) {
 		_spec.SetField(place.FieldOpeningHours, field.TypeJSON, value)
 		_node.OpeningHours = value
 	}
+	if value, ok := _c.mutation.PriceInfo(); ok {
+		_spec.SetField(place.FieldPriceInfo, field.TypeJSON, value)
+		_node.PriceInfo = value
+	}
+	if value, ok := _c.mutation.Contact(); ok {
+		_spec.SetField(place.FieldContact, field.TypeJSON, value)
+		_node.Contact = value
+	}
+	if value, ok := _c.mutation.Accessibility(); ok {
+		_spec.SetField(place.FieldAccessibility, field.TypeJSON, value)
+		_node.Accessibility = value
+	}
+	if value, ok := _c.mutation.ExternalRefs(); ok {
+		_spec.SetField(place.FieldExternalRefs, field.TypeJSON, value)
+		_node.ExternalRefs = value
+	}
+	if value, ok := _c.mutation.GeofenceRadiusM(); ok {
+		_spec.SetField(place.FieldGeofenceRadiusM, field.TypeInt, value)
+		_node.GeofenceRadiusM = &value
+	}
+	if value, ok := _c.mutation.PublishAt(); ok {
+		_spec.SetField(place.FieldPublishAt, field.TypeTime, value)
+		_node.PublishAt = &value
+	}
+	if value, ok := _c.mutation.UnpublishAt(); ok {
+		_spec.SetField(place.FieldUnpublishAt, field.TypeTime, value)
+		_node.UnpublishAt = &value
+	}
 	if nodes := _c.mutation.ImagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -701,89 +923,2110 @@ func (_c *PlaceCreate) createSpec() (*Place, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   place.RevisionsTable,
+			Columns: []string{place.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(placerevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SlugHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   place.SlugHistoryTable,
+			Columns: []string{place.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(placeslug.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
-// PlaceCreateBulk is the builder for creating many Place entities in bulk.
-type PlaceCreateBulk struct {
-	config
-	err      error
-	builders []*PlaceCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Place.Create().
+//		SetStatus(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PlaceUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PlaceCreate) OnConflict(opts ...sql.ConflictOption) *PlaceUpsertOne {
+	_c.conflict = opts
+	return &PlaceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Place.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PlaceCreate) OnConflictColumns(columns ...string) *PlaceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PlaceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PlaceUpsertOne is the builder for "upsert"-ing
+	//  one Place node.
+	PlaceUpsertOne struct {
+		create *PlaceCreate
+	}
+
+	// PlaceUpsert is the "OnConflict" setter.
+	PlaceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *PlaceUpsert) SetStatus(v types.Status) *PlaceUpsert {
+	u.Set(place.FieldStatus, v)
+	return u
 }
 
-// Save creates the Place entities in the database.
-func (_c *PlaceCreateBulk) Save(ctx context.Context) ([]*Place, error) {
-	if _c.err != nil {
-		return nil, _c.err
-	}
-	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
-	nodes := make([]*Place, len(_c.builders))
-	mutators := make([]Mutator, len(_c.builders))
-	for i := range _c.builders {
-		func(i int, root context.Context) {
-			builder := _c.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*PlaceMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
-	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
-			return nil, err
-		}
-	}
-	return nodes, nil
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateStatus() *PlaceUpsert {
+	u.SetExcluded(place.FieldStatus)
+	return u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_c *PlaceCreateBulk) SaveX(ctx context.Context) []*Place {
-	v, err := _c.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlaceUpsert) SetUpdatedAt(v time.Time) *PlaceUpsert {
+	u.Set(place.FieldUpdatedAt, v)
+	return u
 }
 
-// Exec executes the query.
-func (_c *PlaceCreateBulk) Exec(ctx context.Context) error {
-	_, err := _c.Save(ctx)
-	return err
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateUpdatedAt() *PlaceUpsert {
+	u.SetExcluded(place.FieldUpdatedAt)
+	return u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_c *PlaceCreateBulk) ExecX(ctx context.Context) {
-	if err := _c.Exec(ctx); err != nil {
+// SetUpdatedBy sets the "updated_by" field.
+func (u *PlaceUpsert) SetUpdatedBy(v string) *PlaceUpsert {
+	u.Set(place.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateUpdatedBy() *PlaceUpsert {
+	u.SetExcluded(place.FieldUpdatedBy)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *PlaceUpsert) ClearUpdatedBy() *PlaceUpsert {
+	u.SetNull(place.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PlaceUpsert) SetDeletedAt(v time.Time) *PlaceUpsert {
+	u.Set(place.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateDeletedAt() *PlaceUpsert {
+	u.SetExcluded(place.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PlaceUpsert) ClearDeletedAt() *PlaceUpsert {
+	u.SetNull(place.FieldDeletedAt)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PlaceUpsert) SetDeletedBy(v string) *PlaceUpsert {
+	u.Set(place.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateDeletedBy() *PlaceUpsert {
+	u.SetExcluded(place.FieldDeletedBy)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PlaceUpsert) ClearDeletedBy() *PlaceUpsert {
+	u.SetNull(place.FieldDeletedBy)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PlaceUpsert) SetMetadata(v map[string]string) *PlaceUpsert {
+	u.Set(place.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateMetadata() *PlaceUpsert {
+	u.SetExcluded(place.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *PlaceUpsert) ClearMetadata() *PlaceUpsert {
+	u.SetNull(place.FieldMetadata)
+	return u
+}
+
+// SetSlug sets the "slug" field.
+func (u *PlaceUpsert) SetSlug(v string) *PlaceUpsert {
+	u.Set(place.FieldSlug, v)
+	return u
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateSlug() *PlaceUpsert {
+	u.SetExcluded(place.FieldSlug)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *PlaceUpsert) SetTitle(v string) *PlaceUpsert {
+	u.Set(place.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateTitle() *PlaceUpsert {
+	u.SetExcluded(place.FieldTitle)
+	return u
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (u *PlaceUpsert) SetSubtitle(v string) *PlaceUpsert {
+	u.Set(place.FieldSubtitle, v)
+	return u
+}
+
+// UpdateSubtitle sets the "subtitle" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateSubtitle() *PlaceUpsert {
+	u.SetExcluded(place.FieldSubtitle)
+	return u
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (u *PlaceUpsert) ClearSubtitle() *PlaceUpsert {
+	u.SetNull(place.FieldSubtitle)
+	return u
+}
+
+// SetShortDescription sets the "short_description" field.
+func (u *PlaceUpsert) SetShortDescription(v string) *PlaceUpsert {
+	u.Set(place.FieldShortDescription, v)
+	return u
+}
+
+// UpdateShortDescription sets the "short_description" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateShortDescription() *PlaceUpsert {
+	u.SetExcluded(place.FieldShortDescription)
+	return u
+}
+
+// ClearShortDescription clears the value of the "short_description" field.
+func (u *PlaceUpsert) ClearShortDescription() *PlaceUpsert {
+	u.SetNull(place.FieldShortDescription)
+	return u
+}
+
+// SetLongDescription sets the "long_description" field.
+func (u *PlaceUpsert) SetLongDescription(v string) *PlaceUpsert {
+	u.Set(place.FieldLongDescription, v)
+	return u
+}
+
+// UpdateLongDescription sets the "long_description" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateLongDescription() *PlaceUpsert {
+	u.SetExcluded(place.FieldLongDescription)
+	return u
+}
+
+// ClearLongDescription clears the value of the "long_description" field.
+func (u *PlaceUpsert) ClearLongDescription() *PlaceUpsert {
+	u.SetNull(place.FieldLongDescription)
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *PlaceUpsert) SetAddress(v map[string]string) *PlaceUpsert {
+	u.Set(place.FieldAddress, v)
+	return u
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateAddress() *PlaceUpsert {
+	u.SetExcluded(place.FieldAddress)
+	return u
+}
+
+// ClearAddress clears the value of the "address" field.
+func (u *PlaceUpsert) ClearAddress() *PlaceUpsert {
+	u.SetNull(place.FieldAddress)
+	return u
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *PlaceUpsert) SetLatitude(v decimal.Decimal) *PlaceUpsert {
+	u.Set(place.FieldLatitude, v)
+	return u
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateLatitude() *PlaceUpsert {
+	u.SetExcluded(place.FieldLatitude)
+	return u
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *PlaceUpsert) SetLongitude(v decimal.Decimal) *PlaceUpsert {
+	u.Set(place.FieldLongitude, v)
+	return u
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateLongitude() *PlaceUpsert {
+	u.SetExcluded(place.FieldLongitude)
+	return u
+}
+
+// SetGeohash sets the "geohash" field.
+func (u *PlaceUpsert) SetGeohash(v string) *PlaceUpsert {
+	u.Set(place.FieldGeohash, v)
+	return u
+}
+
+// UpdateGeohash sets the "geohash" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateGeohash() *PlaceUpsert {
+	u.SetExcluded(place.FieldGeohash)
+	return u
+}
+
+// ClearGeohash clears the value of the "geohash" field.
+func (u *PlaceUpsert) ClearGeohash() *PlaceUpsert {
+	u.SetNull(place.FieldGeohash)
+	return u
+}
+
+// SetPrimaryImageURL sets the "primary_image_url" field.
+func (u *PlaceUpsert) SetPrimaryImageURL(v string) *PlaceUpsert {
+	u.Set(place.FieldPrimaryImageURL, v)
+	return u
+}
+
+// UpdatePrimaryImageURL sets the "primary_image_url" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdatePrimaryImageURL() *PlaceUpsert {
+	u.SetExcluded(place.FieldPrimaryImageURL)
+	return u
+}
+
+// ClearPrimaryImageURL clears the value of the "primary_image_url" field.
+func (u *PlaceUpsert) ClearPrimaryImageURL() *PlaceUpsert {
+	u.SetNull(place.FieldPrimaryImageURL)
+	return u
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (u *PlaceUpsert) SetThumbnailURL(v string) *PlaceUpsert {
+	u.Set(place.FieldThumbnailURL, v)
+	return u
+}
+
+// UpdateThumbnailURL sets the "thumbnail_url" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateThumbnailURL() *PlaceUpsert {
+	u.SetExcluded(place.FieldThumbnailURL)
+	return u
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (u *PlaceUpsert) ClearThumbnailURL() *PlaceUpsert {
+	u.SetNull(place.FieldThumbnailURL)
+	return u
+}
+
+// SetViewCount sets the "view_count" field.
+func (u *PlaceUpsert) SetViewCount(v int) *PlaceUpsert {
+	u.Set(place.FieldViewCount, v)
+	return u
+}
+
+// UpdateViewCount sets the "view_count" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateViewCount() *PlaceUpsert {
+	u.SetExcluded(place.FieldViewCount)
+	return u
+}
+
+// AddViewCount adds v to the "view_count" field.
+func (u *PlaceUpsert) AddViewCount(v int) *PlaceUpsert {
+	u.Add(place.FieldViewCount, v)
+	return u
+}
+
+// SetRatingAvg sets the "rating_avg" field.
+func (u *PlaceUpsert) SetRatingAvg(v decimal.Decimal) *PlaceUpsert {
+	u.Set(place.FieldRatingAvg, v)
+	return u
+}
+
+// UpdateRatingAvg sets the "rating_avg" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateRatingAvg() *PlaceUpsert {
+	u.SetExcluded(place.FieldRatingAvg)
+	return u
+}
+
+// SetRatingCount sets the "rating_count" field.
+func (u *PlaceUpsert) SetRatingCount(v int) *PlaceUpsert {
+	u.Set(place.FieldRatingCount, v)
+	return u
+}
+
+// UpdateRatingCount sets the "rating_count" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateRatingCount() *PlaceUpsert {
+	u.SetExcluded(place.FieldRatingCount)
+	return u
+}
+
+// AddRatingCount adds v to the "rating_count" field.
+func (u *PlaceUpsert) AddRatingCount(v int) *PlaceUpsert {
+	u.Add(place.FieldRatingCount, v)
+	return u
+}
+
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (u *PlaceUpsert) SetLastViewedAt(v time.Time) *PlaceUpsert {
+	u.Set(place.FieldLastViewedAt, v)
+	return u
+}
+
+// UpdateLastViewedAt sets the "last_viewed_at" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateLastViewedAt() *PlaceUpsert {
+	u.SetExcluded(place.FieldLastViewedAt)
+	return u
+}
+
+// ClearLastViewedAt clears the value of the "last_viewed_at" field.
+func (u *PlaceUpsert) ClearLastViewedAt() *PlaceUpsert {
+	u.SetNull(place.FieldLastViewedAt)
+	return u
+}
+
+// SetPopularityScore sets the "popularity_score" field.
+func (u *PlaceUpsert) SetPopularityScore(v decimal.Decimal) *PlaceUpsert {
+	u.Set(place.FieldPopularityScore, v)
+	return u
+}
+
+// UpdatePopularityScore sets the "popularity_score" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdatePopularityScore() *PlaceUpsert {
+	u.SetExcluded(place.FieldPopularityScore)
+	return u
+}
+
+// SetAvgVisitMinutes sets the "avg_visit_minutes" field.
+func (u *PlaceUpsert) SetAvgVisitMinutes(v int) *PlaceUpsert {
+	u.Set(place.FieldAvgVisitMinutes, v)
+	return u
+}
+
+// UpdateAvgVisitMinutes sets the "avg_visit_minutes" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateAvgVisitMinutes() *PlaceUpsert {
+	u.SetExcluded(place.FieldAvgVisitMinutes)
+	return u
+}
+
+// AddAvgVisitMinutes adds v to the "avg_visit_minutes" field.
+func (u *PlaceUpsert) AddAvgVisitMinutes(v int) *PlaceUpsert {
+	u.Add(place.FieldAvgVisitMinutes, v)
+	return u
+}
+
+// SetOpeningHours sets the "opening_hours" field.
+func (u *PlaceUpsert) SetOpeningHours(v map[string]string) *PlaceUpsert {
+	u.Set(place.FieldOpeningHours, v)
+	return u
+}
+
+// UpdateOpeningHours sets the "opening_hours" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateOpeningHours() *PlaceUpsert {
+	u.SetExcluded(place.FieldOpeningHours)
+	return u
+}
+
+// ClearOpeningHours clears the value of the "opening_hours" field.
+func (u *PlaceUpsert) ClearOpeningHours() *PlaceUpsert {
+	u.SetNull(place.FieldOpeningHours)
+	return u
+}
+
+// SetPriceInfo sets the "price_info" field.
+func (u *PlaceUpsert) SetPriceInfo(v *types.PriceInfo) *PlaceUpsert {
+	u.Set(place.FieldPriceInfo, v)
+	return u
+}
+
+// UpdatePriceInfo sets the "price_info" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdatePriceInfo() *PlaceUpsert {
+	u.SetExcluded(place.FieldPriceInfo)
+	return u
+}
+
+// ClearPriceInfo clears the value of the "price_info" field.
+func (u *PlaceUpsert) ClearPriceInfo() *PlaceUpsert {
+	u.SetNull(place.FieldPriceInfo)
+	return u
+}
+
+// SetContact sets the "contact" field.
+func (u *PlaceUpsert) SetContact(v *types.Contact) *PlaceUpsert {
+	u.Set(place.FieldContact, v)
+	return u
+}
+
+// UpdateContact sets the "contact" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateContact() *PlaceUpsert {
+	u.SetExcluded(place.FieldContact)
+	return u
+}
+
+// ClearContact clears the value of the "contact" field.
+func (u *PlaceUpsert) ClearContact() *PlaceUpsert {
+	u.SetNull(place.FieldContact)
+	return u
+}
+
+// SetAccessibility sets the "accessibility" field.
+func (u *PlaceUpsert) SetAccessibility(v *types.Accessibility) *PlaceUpsert {
+	u.Set(place.FieldAccessibility, v)
+	return u
+}
+
+// UpdateAccessibility sets the "accessibility" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateAccessibility() *PlaceUpsert {
+	u.SetExcluded(place.FieldAccessibility)
+	return u
+}
+
+// ClearAccessibility clears the value of the "accessibility" field.
+func (u *PlaceUpsert) ClearAccessibility() *PlaceUpsert {
+	u.SetNull(place.FieldAccessibility)
+	return u
+}
+
+// SetExternalRefs sets the "external_refs" field.
+func (u *PlaceUpsert) SetExternalRefs(v map[string]string) *PlaceUpsert {
+	u.Set(place.FieldExternalRefs, v)
+	return u
+}
+
+// UpdateExternalRefs sets the "external_refs" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateExternalRefs() *PlaceUpsert {
+	u.SetExcluded(place.FieldExternalRefs)
+	return u
+}
+
+// ClearExternalRefs clears the value of the "external_refs" field.
+func (u *PlaceUpsert) ClearExternalRefs() *PlaceUpsert {
+	u.SetNull(place.FieldExternalRefs)
+	return u
+}
+
+// SetGeofenceRadiusM sets the "geofence_radius_m" field.
+func (u *PlaceUpsert) SetGeofenceRadiusM(v int) *PlaceUpsert {
+	u.Set(place.FieldGeofenceRadiusM, v)
+	return u
+}
+
+// UpdateGeofenceRadiusM sets the "geofence_radius_m" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateGeofenceRadiusM() *PlaceUpsert {
+	u.SetExcluded(place.FieldGeofenceRadiusM)
+	return u
+}
+
+// AddGeofenceRadiusM adds v to the "geofence_radius_m" field.
+func (u *PlaceUpsert) AddGeofenceRadiusM(v int) *PlaceUpsert {
+	u.Add(place.FieldGeofenceRadiusM, v)
+	return u
+}
+
+// ClearGeofenceRadiusM clears the value of the "geofence_radius_m" field.
+func (u *PlaceUpsert) ClearGeofenceRadiusM() *PlaceUpsert {
+	u.SetNull(place.FieldGeofenceRadiusM)
+	return u
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (u *PlaceUpsert) SetPublishAt(v time.Time) *PlaceUpsert {
+	u.Set(place.FieldPublishAt, v)
+	return u
+}
+
+// UpdatePublishAt sets the "publish_at" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdatePublishAt() *PlaceUpsert {
+	u.SetExcluded(place.FieldPublishAt)
+	return u
+}
+
+// ClearPublishAt clears the value of the "publish_at" field.
+func (u *PlaceUpsert) ClearPublishAt() *PlaceUpsert {
+	u.SetNull(place.FieldPublishAt)
+	return u
+}
+
+// SetUnpublishAt sets the "unpublish_at" field.
+func (u *PlaceUpsert) SetUnpublishAt(v time.Time) *PlaceUpsert {
+	u.Set(place.FieldUnpublishAt, v)
+	return u
+}
+
+// UpdateUnpublishAt sets the "unpublish_at" field to the value that was provided on create.
+func (u *PlaceUpsert) UpdateUnpublishAt() *PlaceUpsert {
+	u.SetExcluded(place.FieldUnpublishAt)
+	return u
+}
+
+// ClearUnpublishAt clears the value of the "unpublish_at" field.
+func (u *PlaceUpsert) ClearUnpublishAt() *PlaceUpsert {
+	u.SetNull(place.FieldUnpublishAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Place.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(place.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PlaceUpsertOne) UpdateNewValues() *PlaceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(place.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(place.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(place.FieldCreatedBy)
+		}
+		if _, exists := u.create.mutation.PlaceType(); exists {
+			s.SetIgnore(place.FieldPlaceType)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Place.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PlaceUpsertOne) Ignore() *PlaceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PlaceUpsertOne) DoNothing() *PlaceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PlaceCreate.OnConflict
+// documentation for more info.
+func (u *PlaceUpsertOne) Update(set func(*PlaceUpsert)) *PlaceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PlaceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PlaceUpsertOne) SetStatus(v types.Status) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateStatus() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlaceUpsertOne) SetUpdatedAt(v time.Time) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateUpdatedAt() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *PlaceUpsertOne) SetUpdatedBy(v string) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateUpdatedBy() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *PlaceUpsertOne) ClearUpdatedBy() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PlaceUpsertOne) SetDeletedAt(v time.Time) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateDeletedAt() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PlaceUpsertOne) ClearDeletedAt() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PlaceUpsertOne) SetDeletedBy(v string) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateDeletedBy() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PlaceUpsertOne) ClearDeletedBy() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PlaceUpsertOne) SetMetadata(v map[string]string) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateMetadata() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *PlaceUpsertOne) ClearMetadata() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetSlug sets the "slug" field.
+func (u *PlaceUpsertOne) SetSlug(v string) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateSlug() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *PlaceUpsertOne) SetTitle(v string) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateTitle() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (u *PlaceUpsertOne) SetSubtitle(v string) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetSubtitle(v)
+	})
+}
+
+// UpdateSubtitle sets the "subtitle" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateSubtitle() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateSubtitle()
+	})
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (u *PlaceUpsertOne) ClearSubtitle() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearSubtitle()
+	})
+}
+
+// SetShortDescription sets the "short_description" field.
+func (u *PlaceUpsertOne) SetShortDescription(v string) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetShortDescription(v)
+	})
+}
+
+// UpdateShortDescription sets the "short_description" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateShortDescription() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateShortDescription()
+	})
+}
+
+// ClearShortDescription clears the value of the "short_description" field.
+func (u *PlaceUpsertOne) ClearShortDescription() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearShortDescription()
+	})
+}
+
+// SetLongDescription sets the "long_description" field.
+func (u *PlaceUpsertOne) SetLongDescription(v string) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetLongDescription(v)
+	})
+}
+
+// UpdateLongDescription sets the "long_description" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateLongDescription() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateLongDescription()
+	})
+}
+
+// ClearLongDescription clears the value of the "long_description" field.
+func (u *PlaceUpsertOne) ClearLongDescription() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearLongDescription()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *PlaceUpsertOne) SetAddress(v map[string]string) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateAddress() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// ClearAddress clears the value of the "address" field.
+func (u *PlaceUpsertOne) ClearAddress() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearAddress()
+	})
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *PlaceUpsertOne) SetLatitude(v decimal.Decimal) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetLatitude(v)
+	})
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateLatitude() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateLatitude()
+	})
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *PlaceUpsertOne) SetLongitude(v decimal.Decimal) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetLongitude(v)
+	})
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateLongitude() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateLongitude()
+	})
+}
+
+// SetGeohash sets the "geohash" field.
+func (u *PlaceUpsertOne) SetGeohash(v string) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetGeohash(v)
+	})
+}
+
+// UpdateGeohash sets the "geohash" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateGeohash() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateGeohash()
+	})
+}
+
+// ClearGeohash clears the value of the "geohash" field.
+func (u *PlaceUpsertOne) ClearGeohash() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearGeohash()
+	})
+}
+
+// SetPrimaryImageURL sets the "primary_image_url" field.
+func (u *PlaceUpsertOne) SetPrimaryImageURL(v string) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetPrimaryImageURL(v)
+	})
+}
+
+// UpdatePrimaryImageURL sets the "primary_image_url" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdatePrimaryImageURL() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdatePrimaryImageURL()
+	})
+}
+
+// ClearPrimaryImageURL clears the value of the "primary_image_url" field.
+func (u *PlaceUpsertOne) ClearPrimaryImageURL() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearPrimaryImageURL()
+	})
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (u *PlaceUpsertOne) SetThumbnailURL(v string) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetThumbnailURL(v)
+	})
+}
+
+// UpdateThumbnailURL sets the "thumbnail_url" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateThumbnailURL() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateThumbnailURL()
+	})
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (u *PlaceUpsertOne) ClearThumbnailURL() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearThumbnailURL()
+	})
+}
+
+// SetViewCount sets the "view_count" field.
+func (u *PlaceUpsertOne) SetViewCount(v int) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetViewCount(v)
+	})
+}
+
+// AddViewCount adds v to the "view_count" field.
+func (u *PlaceUpsertOne) AddViewCount(v int) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.AddViewCount(v)
+	})
+}
+
+// UpdateViewCount sets the "view_count" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateViewCount() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateViewCount()
+	})
+}
+
+// SetRatingAvg sets the "rating_avg" field.
+func (u *PlaceUpsertOne) SetRatingAvg(v decimal.Decimal) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetRatingAvg(v)
+	})
+}
+
+// UpdateRatingAvg sets the "rating_avg" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateRatingAvg() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateRatingAvg()
+	})
+}
+
+// SetRatingCount sets the "rating_count" field.
+func (u *PlaceUpsertOne) SetRatingCount(v int) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetRatingCount(v)
+	})
+}
+
+// AddRatingCount adds v to the "rating_count" field.
+func (u *PlaceUpsertOne) AddRatingCount(v int) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.AddRatingCount(v)
+	})
+}
+
+// UpdateRatingCount sets the "rating_count" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateRatingCount() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateRatingCount()
+	})
+}
+
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (u *PlaceUpsertOne) SetLastViewedAt(v time.Time) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetLastViewedAt(v)
+	})
+}
+
+// UpdateLastViewedAt sets the "last_viewed_at" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateLastViewedAt() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateLastViewedAt()
+	})
+}
+
+// ClearLastViewedAt clears the value of the "last_viewed_at" field.
+func (u *PlaceUpsertOne) ClearLastViewedAt() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearLastViewedAt()
+	})
+}
+
+// SetPopularityScore sets the "popularity_score" field.
+func (u *PlaceUpsertOne) SetPopularityScore(v decimal.Decimal) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetPopularityScore(v)
+	})
+}
+
+// UpdatePopularityScore sets the "popularity_score" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdatePopularityScore() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdatePopularityScore()
+	})
+}
+
+// SetAvgVisitMinutes sets the "avg_visit_minutes" field.
+func (u *PlaceUpsertOne) SetAvgVisitMinutes(v int) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetAvgVisitMinutes(v)
+	})
+}
+
+// AddAvgVisitMinutes adds v to the "avg_visit_minutes" field.
+func (u *PlaceUpsertOne) AddAvgVisitMinutes(v int) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.AddAvgVisitMinutes(v)
+	})
+}
+
+// UpdateAvgVisitMinutes sets the "avg_visit_minutes" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateAvgVisitMinutes() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateAvgVisitMinutes()
+	})
+}
+
+// SetOpeningHours sets the "opening_hours" field.
+func (u *PlaceUpsertOne) SetOpeningHours(v map[string]string) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetOpeningHours(v)
+	})
+}
+
+// UpdateOpeningHours sets the "opening_hours" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateOpeningHours() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateOpeningHours()
+	})
+}
+
+// ClearOpeningHours clears the value of the "opening_hours" field.
+func (u *PlaceUpsertOne) ClearOpeningHours() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearOpeningHours()
+	})
+}
+
+// SetPriceInfo sets the "price_info" field.
+func (u *PlaceUpsertOne) SetPriceInfo(v *types.PriceInfo) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetPriceInfo(v)
+	})
+}
+
+// UpdatePriceInfo sets the "price_info" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdatePriceInfo() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdatePriceInfo()
+	})
+}
+
+// ClearPriceInfo clears the value of the "price_info" field.
+func (u *PlaceUpsertOne) ClearPriceInfo() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearPriceInfo()
+	})
+}
+
+// SetContact sets the "contact" field.
+func (u *PlaceUpsertOne) SetContact(v *types.Contact) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetContact(v)
+	})
+}
+
+// UpdateContact sets the "contact" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateContact() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateContact()
+	})
+}
+
+// ClearContact clears the value of the "contact" field.
+func (u *PlaceUpsertOne) ClearContact() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearContact()
+	})
+}
+
+// SetAccessibility sets the "accessibility" field.
+func (u *PlaceUpsertOne) SetAccessibility(v *types.Accessibility) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetAccessibility(v)
+	})
+}
+
+// UpdateAccessibility sets the "accessibility" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateAccessibility() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateAccessibility()
+	})
+}
+
+// ClearAccessibility clears the value of the "accessibility" field.
+func (u *PlaceUpsertOne) ClearAccessibility() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearAccessibility()
+	})
+}
+
+// SetExternalRefs sets the "external_refs" field.
+func (u *PlaceUpsertOne) SetExternalRefs(v map[string]string) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetExternalRefs(v)
+	})
+}
+
+// UpdateExternalRefs sets the "external_refs" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateExternalRefs() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateExternalRefs()
+	})
+}
+
+// ClearExternalRefs clears the value of the "external_refs" field.
+func (u *PlaceUpsertOne) ClearExternalRefs() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearExternalRefs()
+	})
+}
+
+// SetGeofenceRadiusM sets the "geofence_radius_m" field.
+func (u *PlaceUpsertOne) SetGeofenceRadiusM(v int) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetGeofenceRadiusM(v)
+	})
+}
+
+// AddGeofenceRadiusM adds v to the "geofence_radius_m" field.
+func (u *PlaceUpsertOne) AddGeofenceRadiusM(v int) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.AddGeofenceRadiusM(v)
+	})
+}
+
+// UpdateGeofenceRadiusM sets the "geofence_radius_m" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateGeofenceRadiusM() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateGeofenceRadiusM()
+	})
+}
+
+// ClearGeofenceRadiusM clears the value of the "geofence_radius_m" field.
+func (u *PlaceUpsertOne) ClearGeofenceRadiusM() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearGeofenceRadiusM()
+	})
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (u *PlaceUpsertOne) SetPublishAt(v time.Time) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetPublishAt(v)
+	})
+}
+
+// UpdatePublishAt sets the "publish_at" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdatePublishAt() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdatePublishAt()
+	})
+}
+
+// ClearPublishAt clears the value of the "publish_at" field.
+func (u *PlaceUpsertOne) ClearPublishAt() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearPublishAt()
+	})
+}
+
+// SetUnpublishAt sets the "unpublish_at" field.
+func (u *PlaceUpsertOne) SetUnpublishAt(v time.Time) *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetUnpublishAt(v)
+	})
+}
+
+// UpdateUnpublishAt sets the "unpublish_at" field to the value that was provided on create.
+func (u *PlaceUpsertOne) UpdateUnpublishAt() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateUnpublishAt()
+	})
+}
+
+// ClearUnpublishAt clears the value of the "unpublish_at" field.
+func (u *PlaceUpsertOne) ClearUnpublishAt() *PlaceUpsertOne {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearUnpublishAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PlaceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PlaceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PlaceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PlaceUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PlaceUpsertOne.ID is not supported by MySQL driver. Use PlaceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PlaceUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PlaceCreateBulk is the builder for creating many Place entities in bulk.
+type PlaceCreateBulk struct {
+	config
+	err      error
+	builders []*PlaceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Place entities in the database.
+func (_c *PlaceCreateBulk) Save(ctx context.Context) ([]*Place, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Place, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlaceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PlaceCreateBulk) SaveX(ctx context.Context) []*Place {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlaceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlaceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Place.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PlaceUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PlaceCreateBulk) OnConflict(opts ...sql.ConflictOption) *PlaceUpsertBulk {
+	_c.conflict = opts
+	return &PlaceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Place.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PlaceCreateBulk) OnConflictColumns(columns ...string) *PlaceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PlaceUpsertBulk{
+		create: _c,
+	}
+}
+
+// PlaceUpsertBulk is the builder for "upsert"-ing
+// a bulk of Place nodes.
+type PlaceUpsertBulk struct {
+	create *PlaceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Place.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(place.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PlaceUpsertBulk) UpdateNewValues() *PlaceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(place.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(place.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(place.FieldCreatedBy)
+			}
+			if _, exists := b.mutation.PlaceType(); exists {
+				s.SetIgnore(place.FieldPlaceType)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Place.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PlaceUpsertBulk) Ignore() *PlaceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PlaceUpsertBulk) DoNothing() *PlaceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PlaceCreateBulk.OnConflict
+// documentation for more info.
+func (u *PlaceUpsertBulk) Update(set func(*PlaceUpsert)) *PlaceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PlaceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PlaceUpsertBulk) SetStatus(v types.Status) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateStatus() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlaceUpsertBulk) SetUpdatedAt(v time.Time) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateUpdatedAt() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *PlaceUpsertBulk) SetUpdatedBy(v string) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateUpdatedBy() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *PlaceUpsertBulk) ClearUpdatedBy() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PlaceUpsertBulk) SetDeletedAt(v time.Time) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateDeletedAt() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PlaceUpsertBulk) ClearDeletedAt() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PlaceUpsertBulk) SetDeletedBy(v string) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateDeletedBy() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PlaceUpsertBulk) ClearDeletedBy() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PlaceUpsertBulk) SetMetadata(v map[string]string) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateMetadata() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *PlaceUpsertBulk) ClearMetadata() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetSlug sets the "slug" field.
+func (u *PlaceUpsertBulk) SetSlug(v string) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateSlug() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *PlaceUpsertBulk) SetTitle(v string) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateTitle() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (u *PlaceUpsertBulk) SetSubtitle(v string) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetSubtitle(v)
+	})
+}
+
+// UpdateSubtitle sets the "subtitle" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateSubtitle() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateSubtitle()
+	})
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (u *PlaceUpsertBulk) ClearSubtitle() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearSubtitle()
+	})
+}
+
+// SetShortDescription sets the "short_description" field.
+func (u *PlaceUpsertBulk) SetShortDescription(v string) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetShortDescription(v)
+	})
+}
+
+// UpdateShortDescription sets the "short_description" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateShortDescription() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateShortDescription()
+	})
+}
+
+// ClearShortDescription clears the value of the "short_description" field.
+func (u *PlaceUpsertBulk) ClearShortDescription() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearShortDescription()
+	})
+}
+
+// SetLongDescription sets the "long_description" field.
+func (u *PlaceUpsertBulk) SetLongDescription(v string) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetLongDescription(v)
+	})
+}
+
+// UpdateLongDescription sets the "long_description" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateLongDescription() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateLongDescription()
+	})
+}
+
+// ClearLongDescription clears the value of the "long_description" field.
+func (u *PlaceUpsertBulk) ClearLongDescription() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearLongDescription()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *PlaceUpsertBulk) SetAddress(v map[string]string) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateAddress() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// ClearAddress clears the value of the "address" field.
+func (u *PlaceUpsertBulk) ClearAddress() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearAddress()
+	})
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *PlaceUpsertBulk) SetLatitude(v decimal.Decimal) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetLatitude(v)
+	})
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateLatitude() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateLatitude()
+	})
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *PlaceUpsertBulk) SetLongitude(v decimal.Decimal) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetLongitude(v)
+	})
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateLongitude() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateLongitude()
+	})
+}
+
+// SetGeohash sets the "geohash" field.
+func (u *PlaceUpsertBulk) SetGeohash(v string) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetGeohash(v)
+	})
+}
+
+// UpdateGeohash sets the "geohash" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateGeohash() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateGeohash()
+	})
+}
+
+// ClearGeohash clears the value of the "geohash" field.
+func (u *PlaceUpsertBulk) ClearGeohash() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearGeohash()
+	})
+}
+
+// SetPrimaryImageURL sets the "primary_image_url" field.
+func (u *PlaceUpsertBulk) SetPrimaryImageURL(v string) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetPrimaryImageURL(v)
+	})
+}
+
+// UpdatePrimaryImageURL sets the "primary_image_url" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdatePrimaryImageURL() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdatePrimaryImageURL()
+	})
+}
+
+// ClearPrimaryImageURL clears the value of the "primary_image_url" field.
+func (u *PlaceUpsertBulk) ClearPrimaryImageURL() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearPrimaryImageURL()
+	})
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (u *PlaceUpsertBulk) SetThumbnailURL(v string) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetThumbnailURL(v)
+	})
+}
+
+// UpdateThumbnailURL sets the "thumbnail_url" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateThumbnailURL() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateThumbnailURL()
+	})
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (u *PlaceUpsertBulk) ClearThumbnailURL() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearThumbnailURL()
+	})
+}
+
+// SetViewCount sets the "view_count" field.
+func (u *PlaceUpsertBulk) SetViewCount(v int) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetViewCount(v)
+	})
+}
+
+// AddViewCount adds v to the "view_count" field.
+func (u *PlaceUpsertBulk) AddViewCount(v int) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.AddViewCount(v)
+	})
+}
+
+// UpdateViewCount sets the "view_count" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateViewCount() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateViewCount()
+	})
+}
+
+// SetRatingAvg sets the "rating_avg" field.
+func (u *PlaceUpsertBulk) SetRatingAvg(v decimal.Decimal) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetRatingAvg(v)
+	})
+}
+
+// UpdateRatingAvg sets the "rating_avg" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateRatingAvg() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateRatingAvg()
+	})
+}
+
+// SetRatingCount sets the "rating_count" field.
+func (u *PlaceUpsertBulk) SetRatingCount(v int) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetRatingCount(v)
+	})
+}
+
+// AddRatingCount adds v to the "rating_count" field.
+func (u *PlaceUpsertBulk) AddRatingCount(v int) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.AddRatingCount(v)
+	})
+}
+
+// UpdateRatingCount sets the "rating_count" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateRatingCount() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateRatingCount()
+	})
+}
+
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (u *PlaceUpsertBulk) SetLastViewedAt(v time.Time) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetLastViewedAt(v)
+	})
+}
+
+// UpdateLastViewedAt sets the "last_viewed_at" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateLastViewedAt() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateLastViewedAt()
+	})
+}
+
+// ClearLastViewedAt clears the value of the "last_viewed_at" field.
+func (u *PlaceUpsertBulk) ClearLastViewedAt() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearLastViewedAt()
+	})
+}
+
+// SetPopularityScore sets the "popularity_score" field.
+func (u *PlaceUpsertBulk) SetPopularityScore(v decimal.Decimal) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetPopularityScore(v)
+	})
+}
+
+// UpdatePopularityScore sets the "popularity_score" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdatePopularityScore() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdatePopularityScore()
+	})
+}
+
+// SetAvgVisitMinutes sets the "avg_visit_minutes" field.
+func (u *PlaceUpsertBulk) SetAvgVisitMinutes(v int) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetAvgVisitMinutes(v)
+	})
+}
+
+// AddAvgVisitMinutes adds v to the "avg_visit_minutes" field.
+func (u *PlaceUpsertBulk) AddAvgVisitMinutes(v int) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.AddAvgVisitMinutes(v)
+	})
+}
+
+// UpdateAvgVisitMinutes sets the "avg_visit_minutes" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateAvgVisitMinutes() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateAvgVisitMinutes()
+	})
+}
+
+// SetOpeningHours sets the "opening_hours" field.
+func (u *PlaceUpsertBulk) SetOpeningHours(v map[string]string) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetOpeningHours(v)
+	})
+}
+
+// UpdateOpeningHours sets the "opening_hours" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateOpeningHours() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateOpeningHours()
+	})
+}
+
+// ClearOpeningHours clears the value of the "opening_hours" field.
+func (u *PlaceUpsertBulk) ClearOpeningHours() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearOpeningHours()
+	})
+}
+
+// SetPriceInfo sets the "price_info" field.
+func (u *PlaceUpsertBulk) SetPriceInfo(v *types.PriceInfo) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetPriceInfo(v)
+	})
+}
+
+// UpdatePriceInfo sets the "price_info" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdatePriceInfo() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdatePriceInfo()
+	})
+}
+
+// ClearPriceInfo clears the value of the "price_info" field.
+func (u *PlaceUpsertBulk) ClearPriceInfo() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearPriceInfo()
+	})
+}
+
+// SetContact sets the "contact" field.
+func (u *PlaceUpsertBulk) SetContact(v *types.Contact) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetContact(v)
+	})
+}
+
+// UpdateContact sets the "contact" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateContact() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateContact()
+	})
+}
+
+// ClearContact clears the value of the "contact" field.
+func (u *PlaceUpsertBulk) ClearContact() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearContact()
+	})
+}
+
+// SetAccessibility sets the "accessibility" field.
+func (u *PlaceUpsertBulk) SetAccessibility(v *types.Accessibility) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetAccessibility(v)
+	})
+}
+
+// UpdateAccessibility sets the "accessibility" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateAccessibility() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateAccessibility()
+	})
+}
+
+// ClearAccessibility clears the value of the "accessibility" field.
+func (u *PlaceUpsertBulk) ClearAccessibility() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearAccessibility()
+	})
+}
+
+// SetExternalRefs sets the "external_refs" field.
+func (u *PlaceUpsertBulk) SetExternalRefs(v map[string]string) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetExternalRefs(v)
+	})
+}
+
+// UpdateExternalRefs sets the "external_refs" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateExternalRefs() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateExternalRefs()
+	})
+}
+
+// ClearExternalRefs clears the value of the "external_refs" field.
+func (u *PlaceUpsertBulk) ClearExternalRefs() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearExternalRefs()
+	})
+}
+
+// SetGeofenceRadiusM sets the "geofence_radius_m" field.
+func (u *PlaceUpsertBulk) SetGeofenceRadiusM(v int) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetGeofenceRadiusM(v)
+	})
+}
+
+// AddGeofenceRadiusM adds v to the "geofence_radius_m" field.
+func (u *PlaceUpsertBulk) AddGeofenceRadiusM(v int) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.AddGeofenceRadiusM(v)
+	})
+}
+
+// UpdateGeofenceRadiusM sets the "geofence_radius_m" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateGeofenceRadiusM() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateGeofenceRadiusM()
+	})
+}
+
+// ClearGeofenceRadiusM clears the value of the "geofence_radius_m" field.
+func (u *PlaceUpsertBulk) ClearGeofenceRadiusM() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearGeofenceRadiusM()
+	})
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (u *PlaceUpsertBulk) SetPublishAt(v time.Time) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetPublishAt(v)
+	})
+}
+
+// UpdatePublishAt sets the "publish_at" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdatePublishAt() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdatePublishAt()
+	})
+}
+
+// ClearPublishAt clears the value of the "publish_at" field.
+func (u *PlaceUpsertBulk) ClearPublishAt() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearPublishAt()
+	})
+}
+
+// SetUnpublishAt sets the "unpublish_at" field.
+func (u *PlaceUpsertBulk) SetUnpublishAt(v time.Time) *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.SetUnpublishAt(v)
+	})
+}
+
+// UpdateUnpublishAt sets the "unpublish_at" field to the value that was provided on create.
+func (u *PlaceUpsertBulk) UpdateUnpublishAt() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.UpdateUnpublishAt()
+	})
+}
+
+// ClearUnpublishAt clears the value of the "unpublish_at" field.
+func (u *PlaceUpsertBulk) ClearUnpublishAt() *PlaceUpsertBulk {
+	return u.Update(func(s *PlaceUpsert) {
+		s.ClearUnpublishAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PlaceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PlaceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PlaceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PlaceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
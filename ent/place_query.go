@@ -15,6 +15,8 @@ import (
 	"github.com/omkar273/nashikdarshan/ent/category"
 	"github.com/omkar273/nashikdarshan/ent/place"
 	"github.com/omkar273/nashikdarshan/ent/placeimage"
+	"github.com/omkar273/nashikdarshan/ent/placerevision"
+	"github.com/omkar273/nashikdarshan/ent/placeslug"
 	"github.com/omkar273/nashikdarshan/ent/predicate"
 	"github.com/omkar273/nashikdarshan/ent/visit"
 )
@@ -22,13 +24,15 @@ import (
 // PlaceQuery is the builder for querying Place entities.
 type PlaceQuery struct {
 	config
-	ctx          *QueryContext
-	order        []place.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Place
-	withImages   *PlaceImageQuery
-	withCategory *CategoryQuery
-	withVisits   *VisitQuery
+	ctx             *QueryContext
+	order           []place.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Place
+	withImages      *PlaceImageQuery
+	withCategory    *CategoryQuery
+	withVisits      *VisitQuery
+	withRevisions   *PlaceRevisionQuery
+	withSlugHistory *PlaceSlugQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +135,50 @@ func (_q *PlaceQuery) QueryVisits() *VisitQuery {
 	return query
 }
 
+// QueryRevisions chains the current query on the "revisions" edge.
+func (_q *PlaceQuery) QueryRevisions() *PlaceRevisionQuery {
+	query := (&PlaceRevisionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(place.Table, place.FieldID, selector),
+			sqlgraph.To(placerevision.Table, placerevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, place.RevisionsTable, place.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySlugHistory chains the current query on the "slug_history" edge.
+func (_q *PlaceQuery) QuerySlugHistory() *PlaceSlugQuery {
+	query := (&PlaceSlugClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(place.Table, place.FieldID, selector),
+			sqlgraph.To(placeslug.Table, placeslug.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, place.SlugHistoryTable, place.SlugHistoryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Place entity from the query.
 // Returns a *NotFoundError when no Place was found.
 func (_q *PlaceQuery) First(ctx context.Context) (*Place, error) {
@@ -318,14 +366,16 @@ func (_q *PlaceQuery) Clone() *PlaceQuery {
 		return nil
 	}
 	return &PlaceQuery{
-		config:       _q.config,
-		ctx:          _q.ctx.Clone(),
-		order:        append([]place.OrderOption{}, _q.order...),
-		inters:       append([]Interceptor{}, _q.inters...),
-		predicates:   append([]predicate.Place{}, _q.predicates...),
-		withImages:   _q.withImages.Clone(),
-		withCategory: _q.withCategory.Clone(),
-		withVisits:   _q.withVisits.Clone(),
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]place.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.Place{}, _q.predicates...),
+		withImages:      _q.withImages.Clone(),
+		withCategory:    _q.withCategory.Clone(),
+		withVisits:      _q.withVisits.Clone(),
+		withRevisions:   _q.withRevisions.Clone(),
+		withSlugHistory: _q.withSlugHistory.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -365,13 +415,35 @@ func (_q *PlaceQuery) WithVisits(opts ...func(*VisitQuery)) *PlaceQuery {
 	return _q
 }
 
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PlaceQuery) WithRevisions(opts ...func(*PlaceRevisionQuery)) *PlaceQuery {
+	query := (&PlaceRevisionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRevisions = query
+	return _q
+}
+
+// WithSlugHistory tells the query-builder to eager-load the nodes that are connected to
+// the "slug_history" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PlaceQuery) WithSlugHistory(opts ...func(*PlaceSlugQuery)) *PlaceQuery {
+	query := (&PlaceSlugClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSlugHistory = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Status types.Status `json:"status,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
@@ -394,7 +466,7 @@ func (_q *PlaceQuery) GroupBy(field string, fields ...string) *PlaceGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Status types.Status `json:"status,omitempty"`
 //	}
 //
 //	client.Place.Query().
@@ -443,10 +515,12 @@ func (_q *PlaceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Place,
 	var (
 		nodes       = []*Place{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [5]bool{
 			_q.withImages != nil,
 			_q.withCategory != nil,
 			_q.withVisits != nil,
+			_q.withRevisions != nil,
+			_q.withSlugHistory != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -488,6 +562,20 @@ func (_q *PlaceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Place,
 			return nil, err
 		}
 	}
+	if query := _q.withRevisions; query != nil {
+		if err := _q.loadRevisions(ctx, query, nodes,
+			func(n *Place) { n.Edges.Revisions = []*PlaceRevision{} },
+			func(n *Place, e *PlaceRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSlugHistory; query != nil {
+		if err := _q.loadSlugHistory(ctx, query, nodes,
+			func(n *Place) { n.Edges.SlugHistory = []*PlaceSlug{} },
+			func(n *Place, e *PlaceSlug) { n.Edges.SlugHistory = append(n.Edges.SlugHistory, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -612,6 +700,66 @@ func (_q *PlaceQuery) loadVisits(ctx context.Context, query *VisitQuery, nodes [
 	}
 	return nil
 }
+func (_q *PlaceQuery) loadRevisions(ctx context.Context, query *PlaceRevisionQuery, nodes []*Place, init func(*Place), assign func(*Place, *PlaceRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Place)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(placerevision.FieldPlaceID)
+	}
+	query.Where(predicate.PlaceRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(place.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PlaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "place_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PlaceQuery) loadSlugHistory(ctx context.Context, query *PlaceSlugQuery, nodes []*Place, init func(*Place), assign func(*Place, *PlaceSlug)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Place)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(placeslug.FieldPlaceID)
+	}
+	query.Where(predicate.PlaceSlug(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(place.SlugHistoryColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PlaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "place_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *PlaceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -14,8 +14,11 @@ import (
 	"github.com/omkar273/nashikdarshan/ent/category"
 	"github.com/omkar273/nashikdarshan/ent/place"
 	"github.com/omkar273/nashikdarshan/ent/placeimage"
+	"github.com/omkar273/nashikdarshan/ent/placerevision"
+	"github.com/omkar273/nashikdarshan/ent/placeslug"
 	"github.com/omkar273/nashikdarshan/ent/predicate"
 	"github.com/omkar273/nashikdarshan/ent/visit"
+	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -33,13 +36,13 @@ func (_u *PlaceUpdate) Where(ps ...predicate.Place) *PlaceUpdate {
 }
 
 // SetStatus sets the "status" field.
-func (_u *PlaceUpdate) SetStatus(v string) *PlaceUpdate {
+func (_u *PlaceUpdate) SetStatus(v types.Status) *PlaceUpdate {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *PlaceUpdate) SetNillableStatus(v *string) *PlaceUpdate {
+func (_u *PlaceUpdate) SetNillableStatus(v *types.Status) *PlaceUpdate {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -72,6 +75,46 @@ func (_u *PlaceUpdate) ClearUpdatedBy() *PlaceUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PlaceUpdate) SetDeletedAt(v time.Time) *PlaceUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PlaceUpdate) SetNillableDeletedAt(v *time.Time) *PlaceUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *PlaceUpdate) ClearDeletedAt() *PlaceUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *PlaceUpdate) SetDeletedBy(v string) *PlaceUpdate {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *PlaceUpdate) SetNillableDeletedBy(v *string) *PlaceUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *PlaceUpdate) ClearDeletedBy() *PlaceUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *PlaceUpdate) SetMetadata(v map[string]string) *PlaceUpdate {
 	_u.mutation.SetMetadata(v)
@@ -84,6 +127,20 @@ func (_u *PlaceUpdate) ClearMetadata() *PlaceUpdate {
 	return _u
 }
 
+// SetSlug sets the "slug" field.
+func (_u *PlaceUpdate) SetSlug(v string) *PlaceUpdate {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *PlaceUpdate) SetNillableSlug(v *string) *PlaceUpdate {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *PlaceUpdate) SetTitle(v string) *PlaceUpdate {
 	_u.mutation.SetTitle(v)
@@ -198,6 +255,26 @@ func (_u *PlaceUpdate) SetNillableLongitude(v *decimal.Decimal) *PlaceUpdate {
 	return _u
 }
 
+// SetGeohash sets the "geohash" field.
+func (_u *PlaceUpdate) SetGeohash(v string) *PlaceUpdate {
+	_u.mutation.SetGeohash(v)
+	return _u
+}
+
+// SetNillableGeohash sets the "geohash" field if the given value is not nil.
+func (_u *PlaceUpdate) SetNillableGeohash(v *string) *PlaceUpdate {
+	if v != nil {
+		_u.SetGeohash(*v)
+	}
+	return _u
+}
+
+// ClearGeohash clears the value of the "geohash" field.
+func (_u *PlaceUpdate) ClearGeohash() *PlaceUpdate {
+	_u.mutation.ClearGeohash()
+	return _u
+}
+
 // SetPrimaryImageURL sets the "primary_image_url" field.
 func (_u *PlaceUpdate) SetPrimaryImageURL(v string) *PlaceUpdate {
 	_u.mutation.SetPrimaryImageURL(v)
@@ -361,6 +438,121 @@ func (_u *PlaceUpdate) ClearOpeningHours() *PlaceUpdate {
 	return _u
 }
 
+// SetPriceInfo sets the "price_info" field.
+func (_u *PlaceUpdate) SetPriceInfo(v *types.PriceInfo) *PlaceUpdate {
+	_u.mutation.SetPriceInfo(v)
+	return _u
+}
+
+// ClearPriceInfo clears the value of the "price_info" field.
+func (_u *PlaceUpdate) ClearPriceInfo() *PlaceUpdate {
+	_u.mutation.ClearPriceInfo()
+	return _u
+}
+
+// SetContact sets the "contact" field.
+func (_u *PlaceUpdate) SetContact(v *types.Contact) *PlaceUpdate {
+	_u.mutation.SetContact(v)
+	return _u
+}
+
+// ClearContact clears the value of the "contact" field.
+func (_u *PlaceUpdate) ClearContact() *PlaceUpdate {
+	_u.mutation.ClearContact()
+	return _u
+}
+
+// SetAccessibility sets the "accessibility" field.
+func (_u *PlaceUpdate) SetAccessibility(v *types.Accessibility) *PlaceUpdate {
+	_u.mutation.SetAccessibility(v)
+	return _u
+}
+
+// ClearAccessibility clears the value of the "accessibility" field.
+func (_u *PlaceUpdate) ClearAccessibility() *PlaceUpdate {
+	_u.mutation.ClearAccessibility()
+	return _u
+}
+
+// SetExternalRefs sets the "external_refs" field.
+func (_u *PlaceUpdate) SetExternalRefs(v map[string]string) *PlaceUpdate {
+	_u.mutation.SetExternalRefs(v)
+	return _u
+}
+
+// ClearExternalRefs clears the value of the "external_refs" field.
+func (_u *PlaceUpdate) ClearExternalRefs() *PlaceUpdate {
+	_u.mutation.ClearExternalRefs()
+	return _u
+}
+
+// SetGeofenceRadiusM sets the "geofence_radius_m" field.
+func (_u *PlaceUpdate) SetGeofenceRadiusM(v int) *PlaceUpdate {
+	_u.mutation.ResetGeofenceRadiusM()
+	_u.mutation.SetGeofenceRadiusM(v)
+	return _u
+}
+
+// SetNillableGeofenceRadiusM sets the "geofence_radius_m" field if the given value is not nil.
+func (_u *PlaceUpdate) SetNillableGeofenceRadiusM(v *int) *PlaceUpdate {
+	if v != nil {
+		_u.SetGeofenceRadiusM(*v)
+	}
+	return _u
+}
+
+// AddGeofenceRadiusM adds value to the "geofence_radius_m" field.
+func (_u *PlaceUpdate) AddGeofenceRadiusM(v int) *PlaceUpdate {
+	_u.mutation.AddGeofenceRadiusM(v)
+	return _u
+}
+
+// ClearGeofenceRadiusM clears the value of the "geofence_radius_m" field.
+func (_u *PlaceUpdate) ClearGeofenceRadiusM() *PlaceUpdate {
+	_u.mutation.ClearGeofenceRadiusM()
+	return _u
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (_u *PlaceUpdate) SetPublishAt(v time.Time) *PlaceUpdate {
+	_u.mutation.SetPublishAt(v)
+	return _u
+}
+
+// SetNillablePublishAt sets the "publish_at" field if the given value is not nil.
+func (_u *PlaceUpdate) SetNillablePublishAt(v *time.Time) *PlaceUpdate {
+	if v != nil {
+		_u.SetPublishAt(*v)
+	}
+	return _u
+}
+
+// ClearPublishAt clears the value of the "publish_at" field.
+func (_u *PlaceUpdate) ClearPublishAt() *PlaceUpdate {
+	_u.mutation.ClearPublishAt()
+	return _u
+}
+
+// SetUnpublishAt sets the "unpublish_at" field.
+func (_u *PlaceUpdate) SetUnpublishAt(v time.Time) *PlaceUpdate {
+	_u.mutation.SetUnpublishAt(v)
+	return _u
+}
+
+// SetNillableUnpublishAt sets the "unpublish_at" field if the given value is not nil.
+func (_u *PlaceUpdate) SetNillableUnpublishAt(v *time.Time) *PlaceUpdate {
+	if v != nil {
+		_u.SetUnpublishAt(*v)
+	}
+	return _u
+}
+
+// ClearUnpublishAt clears the value of the "unpublish_at" field.
+func (_u *PlaceUpdate) ClearUnpublishAt() *PlaceUpdate {
+	_u.mutation.ClearUnpublishAt()
+	return _u
+}
+
 // AddImageIDs adds the "images" edge to the PlaceImage entity by IDs.
 func (_u *PlaceUpdate) AddImageIDs(ids ...string) *PlaceUpdate {
 	_u.mutation.AddImageIDs(ids...)
@@ -406,6 +598,36 @@ func (_u *PlaceUpdate) AddVisits(v ...*Visit) *PlaceUpdate {
 	return _u.AddVisitIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the PlaceRevision entity by IDs.
+func (_u *PlaceUpdate) AddRevisionIDs(ids ...string) *PlaceUpdate {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the PlaceRevision entity.
+func (_u *PlaceUpdate) AddRevisions(v ...*PlaceRevision) *PlaceUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddSlugHistoryIDs adds the "slug_history" edge to the PlaceSlug entity by IDs.
+func (_u *PlaceUpdate) AddSlugHistoryIDs(ids ...string) *PlaceUpdate {
+	_u.mutation.AddSlugHistoryIDs(ids...)
+	return _u
+}
+
+// AddSlugHistory adds the "slug_history" edges to the PlaceSlug entity.
+func (_u *PlaceUpdate) AddSlugHistory(v ...*PlaceSlug) *PlaceUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSlugHistoryIDs(ids...)
+}
+
 // Mutation returns the PlaceMutation object of the builder.
 func (_u *PlaceUpdate) Mutation() *PlaceMutation {
 	return _u.mutation
@@ -474,9 +696,53 @@ func (_u *PlaceUpdate) RemoveVisits(v ...*Visit) *PlaceUpdate {
 	return _u.RemoveVisitIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the PlaceRevision entity.
+func (_u *PlaceUpdate) ClearRevisions() *PlaceUpdate {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to PlaceRevision entities by IDs.
+func (_u *PlaceUpdate) RemoveRevisionIDs(ids ...string) *PlaceUpdate {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to PlaceRevision entities.
+func (_u *PlaceUpdate) RemoveRevisions(v ...*PlaceRevision) *PlaceUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearSlugHistory clears all "slug_history" edges to the PlaceSlug entity.
+func (_u *PlaceUpdate) ClearSlugHistory() *PlaceUpdate {
+	_u.mutation.ClearSlugHistory()
+	return _u
+}
+
+// RemoveSlugHistoryIDs removes the "slug_history" edge to PlaceSlug entities by IDs.
+func (_u *PlaceUpdate) RemoveSlugHistoryIDs(ids ...string) *PlaceUpdate {
+	_u.mutation.RemoveSlugHistoryIDs(ids...)
+	return _u
+}
+
+// RemoveSlugHistory removes "slug_history" edges to PlaceSlug entities.
+func (_u *PlaceUpdate) RemoveSlugHistory(v ...*PlaceSlug) *PlaceUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSlugHistoryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PlaceUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -503,15 +769,29 @@ func (_u *PlaceUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *PlaceUpdate) defaults() {
+func (_u *PlaceUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if place.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized place.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := place.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *PlaceUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := place.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Place.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := place.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Place.slug": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Title(); ok {
 		if err := place.TitleValidator(v); err != nil {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Place.title": %w`, err)}
@@ -527,6 +807,26 @@ func (_u *PlaceUpdate) check() error {
 			return &ValidationError{Name: "rating_count", err: fmt.Errorf(`ent: validator failed for field "Place.rating_count": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.PriceInfo(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "price_info", err: fmt.Errorf(`ent: validator failed for field "Place.price_info": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Contact(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "contact", err: fmt.Errorf(`ent: validator failed for field "Place.contact": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Accessibility(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "accessibility", err: fmt.Errorf(`ent: validator failed for field "Place.accessibility": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GeofenceRadiusM(); ok {
+		if err := place.GeofenceRadiusMValidator(v); err != nil {
+			return &ValidationError{Name: "geofence_radius_m", err: fmt.Errorf(`ent: validator failed for field "Place.geofence_radius_m": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -557,12 +857,27 @@ func (_u *PlaceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.UpdatedByCleared() {
 		_spec.ClearField(place.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(place.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(place.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(place.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(place.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(place.FieldMetadata, field.TypeJSON, value)
 	}
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(place.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(place.FieldSlug, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(place.FieldTitle, field.TypeString, value)
 	}
@@ -596,6 +911,12 @@ func (_u *PlaceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Longitude(); ok {
 		_spec.SetField(place.FieldLongitude, field.TypeOther, value)
 	}
+	if value, ok := _u.mutation.Geohash(); ok {
+		_spec.SetField(place.FieldGeohash, field.TypeString, value)
+	}
+	if _u.mutation.GeohashCleared() {
+		_spec.ClearField(place.FieldGeohash, field.TypeString)
+	}
 	if value, ok := _u.mutation.PrimaryImageURL(); ok {
 		_spec.SetField(place.FieldPrimaryImageURL, field.TypeString, value)
 	}
@@ -644,6 +965,51 @@ func (_u *PlaceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.OpeningHoursCleared() {
 		_spec.ClearField(place.FieldOpeningHours, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.PriceInfo(); ok {
+		_spec.SetField(place.FieldPriceInfo, field.TypeJSON, value)
+	}
+	if _u.mutation.PriceInfoCleared() {
+		_spec.ClearField(place.FieldPriceInfo, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Contact(); ok {
+		_spec.SetField(place.FieldContact, field.TypeJSON, value)
+	}
+	if _u.mutation.ContactCleared() {
+		_spec.ClearField(place.FieldContact, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Accessibility(); ok {
+		_spec.SetField(place.FieldAccessibility, field.TypeJSON, value)
+	}
+	if _u.mutation.AccessibilityCleared() {
+		_spec.ClearField(place.FieldAccessibility, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ExternalRefs(); ok {
+		_spec.SetField(place.FieldExternalRefs, field.TypeJSON, value)
+	}
+	if _u.mutation.ExternalRefsCleared() {
+		_spec.ClearField(place.FieldExternalRefs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GeofenceRadiusM(); ok {
+		_spec.SetField(place.FieldGeofenceRadiusM, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGeofenceRadiusM(); ok {
+		_spec.AddField(place.FieldGeofenceRadiusM, field.TypeInt, value)
+	}
+	if _u.mutation.GeofenceRadiusMCleared() {
+		_spec.ClearField(place.FieldGeofenceRadiusM, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PublishAt(); ok {
+		_spec.SetField(place.FieldPublishAt, field.TypeTime, value)
+	}
+	if _u.mutation.PublishAtCleared() {
+		_spec.ClearField(place.FieldPublishAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UnpublishAt(); ok {
+		_spec.SetField(place.FieldUnpublishAt, field.TypeTime, value)
+	}
+	if _u.mutation.UnpublishAtCleared() {
+		_spec.ClearField(place.FieldUnpublishAt, field.TypeTime)
+	}
 	if _u.mutation.ImagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -779,6 +1145,96 @@ func (_u *PlaceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   place.RevisionsTable,
+			Columns: []string{place.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(placerevision.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   place.RevisionsTable,
+			Columns: []string{place.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(placerevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   place.RevisionsTable,
+			Columns: []string{place.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(placerevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SlugHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   place.SlugHistoryTable,
+			Columns: []string{place.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(placeslug.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSlugHistoryIDs(); len(nodes) > 0 && !_u.mutation.SlugHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   place.SlugHistoryTable,
+			Columns: []string{place.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(placeslug.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SlugHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   place.SlugHistoryTable,
+			Columns: []string{place.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(placeslug.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{place.Label}
@@ -800,13 +1256,13 @@ type PlaceUpdateOne struct {
 }
 
 // SetStatus sets the "status" field.
-func (_u *PlaceUpdateOne) SetStatus(v string) *PlaceUpdateOne {
+func (_u *PlaceUpdateOne) SetStatus(v types.Status) *PlaceUpdateOne {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *PlaceUpdateOne) SetNillableStatus(v *string) *PlaceUpdateOne {
+func (_u *PlaceUpdateOne) SetNillableStatus(v *types.Status) *PlaceUpdateOne {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -839,6 +1295,46 @@ func (_u *PlaceUpdateOne) ClearUpdatedBy() *PlaceUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PlaceUpdateOne) SetDeletedAt(v time.Time) *PlaceUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PlaceUpdateOne) SetNillableDeletedAt(v *time.Time) *PlaceUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *PlaceUpdateOne) ClearDeletedAt() *PlaceUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *PlaceUpdateOne) SetDeletedBy(v string) *PlaceUpdateOne {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *PlaceUpdateOne) SetNillableDeletedBy(v *string) *PlaceUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *PlaceUpdateOne) ClearDeletedBy() *PlaceUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *PlaceUpdateOne) SetMetadata(v map[string]string) *PlaceUpdateOne {
 	_u.mutation.SetMetadata(v)
@@ -851,6 +1347,20 @@ func (_u *PlaceUpdateOne) ClearMetadata() *PlaceUpdateOne {
 	return _u
 }
 
+// SetSlug sets the "slug" field.
+func (_u *PlaceUpdateOne) SetSlug(v string) *PlaceUpdateOne {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *PlaceUpdateOne) SetNillableSlug(v *string) *PlaceUpdateOne {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *PlaceUpdateOne) SetTitle(v string) *PlaceUpdateOne {
 	_u.mutation.SetTitle(v)
@@ -965,6 +1475,26 @@ func (_u *PlaceUpdateOne) SetNillableLongitude(v *decimal.Decimal) *PlaceUpdateO
 	return _u
 }
 
+// SetGeohash sets the "geohash" field.
+func (_u *PlaceUpdateOne) SetGeohash(v string) *PlaceUpdateOne {
+	_u.mutation.SetGeohash(v)
+	return _u
+}
+
+// SetNillableGeohash sets the "geohash" field if the given value is not nil.
+func (_u *PlaceUpdateOne) SetNillableGeohash(v *string) *PlaceUpdateOne {
+	if v != nil {
+		_u.SetGeohash(*v)
+	}
+	return _u
+}
+
+// ClearGeohash clears the value of the "geohash" field.
+func (_u *PlaceUpdateOne) ClearGeohash() *PlaceUpdateOne {
+	_u.mutation.ClearGeohash()
+	return _u
+}
+
 // SetPrimaryImageURL sets the "primary_image_url" field.
 func (_u *PlaceUpdateOne) SetPrimaryImageURL(v string) *PlaceUpdateOne {
 	_u.mutation.SetPrimaryImageURL(v)
@@ -1128,6 +1658,121 @@ func (_u *PlaceUpdateOne) ClearOpeningHours() *PlaceUpdateOne {
 	return _u
 }
 
+// SetPriceInfo sets the "price_info" field.
+func (_u *PlaceUpdateOne) SetPriceInfo(v *types.PriceInfo) *PlaceUpdateOne {
+	_u.mutation.SetPriceInfo(v)
+	return _u
+}
+
+// ClearPriceInfo clears the value of the "price_info" field.
+func (_u *PlaceUpdateOne) ClearPriceInfo() *PlaceUpdateOne {
+	_u.mutation.ClearPriceInfo()
+	return _u
+}
+
+// SetContact sets the "contact" field.
+func (_u *PlaceUpdateOne) SetContact(v *types.Contact) *PlaceUpdateOne {
+	_u.mutation.SetContact(v)
+	return _u
+}
+
+// ClearContact clears the value of the "contact" field.
+func (_u *PlaceUpdateOne) ClearContact() *PlaceUpdateOne {
+	_u.mutation.ClearContact()
+	return _u
+}
+
+// SetAccessibility sets the "accessibility" field.
+func (_u *PlaceUpdateOne) SetAccessibility(v *types.Accessibility) *PlaceUpdateOne {
+	_u.mutation.SetAccessibility(v)
+	return _u
+}
+
+// ClearAccessibility clears the value of the "accessibility" field.
+func (_u *PlaceUpdateOne) ClearAccessibility() *PlaceUpdateOne {
+	_u.mutation.ClearAccessibility()
+	return _u
+}
+
+// SetExternalRefs sets the "external_refs" field.
+func (_u *PlaceUpdateOne) SetExternalRefs(v map[string]string) *PlaceUpdateOne {
+	_u.mutation.SetExternalRefs(v)
+	return _u
+}
+
+// ClearExternalRefs clears the value of the "external_refs" field.
+func (_u *PlaceUpdateOne) ClearExternalRefs() *PlaceUpdateOne {
+	_u.mutation.ClearExternalRefs()
+	return _u
+}
+
+// SetGeofenceRadiusM sets the "geofence_radius_m" field.
+func (_u *PlaceUpdateOne) SetGeofenceRadiusM(v int) *PlaceUpdateOne {
+	_u.mutation.ResetGeofenceRadiusM()
+	_u.mutation.SetGeofenceRadiusM(v)
+	return _u
+}
+
+// SetNillableGeofenceRadiusM sets the "geofence_radius_m" field if the given value is not nil.
+func (_u *PlaceUpdateOne) SetNillableGeofenceRadiusM(v *int) *PlaceUpdateOne {
+	if v != nil {
+		_u.SetGeofenceRadiusM(*v)
+	}
+	return _u
+}
+
+// AddGeofenceRadiusM adds value to the "geofence_radius_m" field.
+func (_u *PlaceUpdateOne) AddGeofenceRadiusM(v int) *PlaceUpdateOne {
+	_u.mutation.AddGeofenceRadiusM(v)
+	return _u
+}
+
+// ClearGeofenceRadiusM clears the value of the "geofence_radius_m" field.
+func (_u *PlaceUpdateOne) ClearGeofenceRadiusM() *PlaceUpdateOne {
+	_u.mutation.ClearGeofenceRadiusM()
+	return _u
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (_u *PlaceUpdateOne) SetPublishAt(v time.Time) *PlaceUpdateOne {
+	_u.mutation.SetPublishAt(v)
+	return _u
+}
+
+// SetNillablePublishAt sets the "publish_at" field if the given value is not nil.
+func (_u *PlaceUpdateOne) SetNillablePublishAt(v *time.Time) *PlaceUpdateOne {
+	if v != nil {
+		_u.SetPublishAt(*v)
+	}
+	return _u
+}
+
+// ClearPublishAt clears the value of the "publish_at" field.
+func (_u *PlaceUpdateOne) ClearPublishAt() *PlaceUpdateOne {
+	_u.mutation.ClearPublishAt()
+	return _u
+}
+
+// SetUnpublishAt sets the "unpublish_at" field.
+func (_u *PlaceUpdateOne) SetUnpublishAt(v time.Time) *PlaceUpdateOne {
+	_u.mutation.SetUnpublishAt(v)
+	return _u
+}
+
+// SetNillableUnpublishAt sets the "unpublish_at" field if the given value is not nil.
+func (_u *PlaceUpdateOne) SetNillableUnpublishAt(v *time.Time) *PlaceUpdateOne {
+	if v != nil {
+		_u.SetUnpublishAt(*v)
+	}
+	return _u
+}
+
+// ClearUnpublishAt clears the value of the "unpublish_at" field.
+func (_u *PlaceUpdateOne) ClearUnpublishAt() *PlaceUpdateOne {
+	_u.mutation.ClearUnpublishAt()
+	return _u
+}
+
 // AddImageIDs adds the "images" edge to the PlaceImage entity by IDs.
 func (_u *PlaceUpdateOne) AddImageIDs(ids ...string) *PlaceUpdateOne {
 	_u.mutation.AddImageIDs(ids...)
@@ -1173,6 +1818,36 @@ func (_u *PlaceUpdateOne) AddVisits(v ...*Visit) *PlaceUpdateOne {
 	return _u.AddVisitIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the PlaceRevision entity by IDs.
+func (_u *PlaceUpdateOne) AddRevisionIDs(ids ...string) *PlaceUpdateOne {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the PlaceRevision entity.
+func (_u *PlaceUpdateOne) AddRevisions(v ...*PlaceRevision) *PlaceUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddSlugHistoryIDs adds the "slug_history" edge to the PlaceSlug entity by IDs.
+func (_u *PlaceUpdateOne) AddSlugHistoryIDs(ids ...string) *PlaceUpdateOne {
+	_u.mutation.AddSlugHistoryIDs(ids...)
+	return _u
+}
+
+// AddSlugHistory adds the "slug_history" edges to the PlaceSlug entity.
+func (_u *PlaceUpdateOne) AddSlugHistory(v ...*PlaceSlug) *PlaceUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSlugHistoryIDs(ids...)
+}
+
 // Mutation returns the PlaceMutation object of the builder.
 func (_u *PlaceUpdateOne) Mutation() *PlaceMutation {
 	return _u.mutation
@@ -1241,6 +1916,48 @@ func (_u *PlaceUpdateOne) RemoveVisits(v ...*Visit) *PlaceUpdateOne {
 	return _u.RemoveVisitIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the PlaceRevision entity.
+func (_u *PlaceUpdateOne) ClearRevisions() *PlaceUpdateOne {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to PlaceRevision entities by IDs.
+func (_u *PlaceUpdateOne) RemoveRevisionIDs(ids ...string) *PlaceUpdateOne {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to PlaceRevision entities.
+func (_u *PlaceUpdateOne) RemoveRevisions(v ...*PlaceRevision) *PlaceUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearSlugHistory clears all "slug_history" edges to the PlaceSlug entity.
+func (_u *PlaceUpdateOne) ClearSlugHistory() *PlaceUpdateOne {
+	_u.mutation.ClearSlugHistory()
+	return _u
+}
+
+// RemoveSlugHistoryIDs removes the "slug_history" edge to PlaceSlug entities by IDs.
+func (_u *PlaceUpdateOne) RemoveSlugHistoryIDs(ids ...string) *PlaceUpdateOne {
+	_u.mutation.RemoveSlugHistoryIDs(ids...)
+	return _u
+}
+
+// RemoveSlugHistory removes "slug_history" edges to PlaceSlug entities.
+func (_u *PlaceUpdateOne) RemoveSlugHistory(v ...*PlaceSlug) *PlaceUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSlugHistoryIDs(ids...)
+}
+
 // Where appends a list predicates to the PlaceUpdate builder.
 func (_u *PlaceUpdateOne) Where(ps ...predicate.Place) *PlaceUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1256,7 +1973,9 @@ func (_u *PlaceUpdateOne) Select(field string, fields ...string) *PlaceUpdateOne
 
 // Save executes the query and returns the updated Place entity.
 func (_u *PlaceUpdateOne) Save(ctx context.Context) (*Place, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -1283,15 +2002,29 @@ func (_u *PlaceUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *PlaceUpdateOne) defaults() {
+func (_u *PlaceUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if place.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized place.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := place.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *PlaceUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := place.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Place.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := place.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Place.slug": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Title(); ok {
 		if err := place.TitleValidator(v); err != nil {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Place.title": %w`, err)}
@@ -1307,6 +2040,26 @@ func (_u *PlaceUpdateOne) check() error {
 			return &ValidationError{Name: "rating_count", err: fmt.Errorf(`ent: validator failed for field "Place.rating_count": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.PriceInfo(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "price_info", err: fmt.Errorf(`ent: validator failed for field "Place.price_info": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Contact(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "contact", err: fmt.Errorf(`ent: validator failed for field "Place.contact": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Accessibility(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "accessibility", err: fmt.Errorf(`ent: validator failed for field "Place.accessibility": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GeofenceRadiusM(); ok {
+		if err := place.GeofenceRadiusMValidator(v); err != nil {
+			return &ValidationError{Name: "geofence_radius_m", err: fmt.Errorf(`ent: validator failed for field "Place.geofence_radius_m": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1354,12 +2107,27 @@ func (_u *PlaceUpdateOne) sqlSave(ctx context.Context) (_node *Place, err error)
 	if _u.mutation.UpdatedByCleared() {
 		_spec.ClearField(place.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(place.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(place.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(place.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(place.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(place.FieldMetadata, field.TypeJSON, value)
 	}
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(place.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(place.FieldSlug, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(place.FieldTitle, field.TypeString, value)
 	}
@@ -1393,6 +2161,12 @@ func (_u *PlaceUpdateOne) sqlSave(ctx context.Context) (_node *Place, err error)
 	if value, ok := _u.mutation.Longitude(); ok {
 		_spec.SetField(place.FieldLongitude, field.TypeOther, value)
 	}
+	if value, ok := _u.mutation.Geohash(); ok {
+		_spec.SetField(place.FieldGeohash, field.TypeString, value)
+	}
+	if _u.mutation.GeohashCleared() {
+		_spec.ClearField(place.FieldGeohash, field.TypeString)
+	}
 	if value, ok := _u.mutation.PrimaryImageURL(); ok {
 		_spec.SetField(place.FieldPrimaryImageURL, field.TypeString, value)
 	}
@@ -1441,6 +2215,51 @@ func (_u *PlaceUpdateOne) sqlSave(ctx context.Context) (_node *Place, err error)
 	if _u.mutation.OpeningHoursCleared() {
 		_spec.ClearField(place.FieldOpeningHours, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.PriceInfo(); ok {
+		_spec.SetField(place.FieldPriceInfo, field.TypeJSON, value)
+	}
+	if _u.mutation.PriceInfoCleared() {
+		_spec.ClearField(place.FieldPriceInfo, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Contact(); ok {
+		_spec.SetField(place.FieldContact, field.TypeJSON, value)
+	}
+	if _u.mutation.ContactCleared() {
+		_spec.ClearField(place.FieldContact, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Accessibility(); ok {
+		_spec.SetField(place.FieldAccessibility, field.TypeJSON, value)
+	}
+	if _u.mutation.AccessibilityCleared() {
+		_spec.ClearField(place.FieldAccessibility, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ExternalRefs(); ok {
+		_spec.SetField(place.FieldExternalRefs, field.TypeJSON, value)
+	}
+	if _u.mutation.ExternalRefsCleared() {
+		_spec.ClearField(place.FieldExternalRefs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GeofenceRadiusM(); ok {
+		_spec.SetField(place.FieldGeofenceRadiusM, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGeofenceRadiusM(); ok {
+		_spec.AddField(place.FieldGeofenceRadiusM, field.TypeInt, value)
+	}
+	if _u.mutation.GeofenceRadiusMCleared() {
+		_spec.ClearField(place.FieldGeofenceRadiusM, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PublishAt(); ok {
+		_spec.SetField(place.FieldPublishAt, field.TypeTime, value)
+	}
+	if _u.mutation.PublishAtCleared() {
+		_spec.ClearField(place.FieldPublishAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UnpublishAt(); ok {
+		_spec.SetField(place.FieldUnpublishAt, field.TypeTime, value)
+	}
+	if _u.mutation.UnpublishAtCleared() {
+		_spec.ClearField(place.FieldUnpublishAt, field.TypeTime)
+	}
 	if _u.mutation.ImagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1576,6 +2395,96 @@ func (_u *PlaceUpdateOne) sqlSave(ctx context.Context) (_node *Place, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   place.RevisionsTable,
+			Columns: []string{place.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(placerevision.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   place.RevisionsTable,
+			Columns: []string{place.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(placerevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   place.RevisionsTable,
+			Columns: []string{place.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(placerevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SlugHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   place.SlugHistoryTable,
+			Columns: []string{place.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(placeslug.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSlugHistoryIDs(); len(nodes) > 0 && !_u.mutation.SlugHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   place.SlugHistoryTable,
+			Columns: []string{place.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(placeslug.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SlugHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   place.SlugHistoryTable,
+			Columns: []string{place.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(placeslug.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Place{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/omkar273/nashikdarshan/ent/place"
 	"github.com/omkar273/nashikdarshan/ent/placeimage"
+	"github.com/omkar273/nashikdarshan/internal/types"
 )
 
 // PlaceImage is the model entity for the PlaceImage schema.
@@ -20,7 +21,7 @@ type PlaceImage struct {
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
 	// Status holds the value of the "status" field.
-	Status string `json:"status,omitempty"`
+	Status types.Status `json:"status,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -29,6 +30,10 @@ type PlaceImage struct {
 	CreatedBy string `json:"created_by,omitempty"`
 	// UpdatedBy holds the value of the "updated_by" field.
 	UpdatedBy string `json:"updated_by,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// DeletedBy holds the value of the "deleted_by" field.
+	DeletedBy string `json:"deleted_by,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]string `json:"metadata,omitempty"`
 	// PlaceID holds the value of the "place_id" field.
@@ -74,10 +79,12 @@ func (*PlaceImage) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case placeimage.FieldPos:
 			values[i] = new(sql.NullInt64)
-		case placeimage.FieldID, placeimage.FieldStatus, placeimage.FieldCreatedBy, placeimage.FieldUpdatedBy, placeimage.FieldPlaceID, placeimage.FieldURL, placeimage.FieldAlt:
+		case placeimage.FieldID, placeimage.FieldCreatedBy, placeimage.FieldUpdatedBy, placeimage.FieldDeletedBy, placeimage.FieldPlaceID, placeimage.FieldURL, placeimage.FieldAlt:
 			values[i] = new(sql.NullString)
-		case placeimage.FieldCreatedAt, placeimage.FieldUpdatedAt:
+		case placeimage.FieldCreatedAt, placeimage.FieldUpdatedAt, placeimage.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
+		case placeimage.FieldStatus:
+			values[i] = new(types.Status)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -100,10 +107,10 @@ func (_m *PlaceImage) assignValues(columns []string, values []any) error {
 				_m.ID = value.String
 			}
 		case placeimage.FieldStatus:
-			if value, ok := values[i].(*sql.NullString); !ok {
+			if value, ok := values[i].(*types.Status); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
-			} else if value.Valid {
-				_m.Status = value.String
+			} else if value != nil {
+				_m.Status = *value
 			}
 		case placeimage.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -129,6 +136,19 @@ func (_m *PlaceImage) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedBy = value.String
 			}
+		case placeimage.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case placeimage.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = value.String
+			}
 		case placeimage.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -203,7 +223,7 @@ func (_m *PlaceImage) String() string {
 	builder.WriteString("PlaceImage(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("status=")
-	builder.WriteString(_m.Status)
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
@@ -217,6 +237,14 @@ func (_m *PlaceImage) String() string {
 	builder.WriteString("updated_by=")
 	builder.WriteString(_m.UpdatedBy)
 	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(_m.DeletedBy)
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
 	builder.WriteString(", ")
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/omkar273/nashikdarshan/ent/predicate"
+	"github.com/omkar273/nashikdarshan/internal/types"
 )
 
 // ID filters vertices based on their ID field.
@@ -66,7 +67,7 @@ func IDContainsFold(id string) predicate.PlaceImage {
 }
 
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
-func Status(v string) predicate.PlaceImage {
+func Status(v types.Status) predicate.PlaceImage {
 	return predicate.PlaceImage(sql.FieldEQ(FieldStatus, v))
 }
 
@@ -90,6 +91,16 @@ func UpdatedBy(v string) predicate.PlaceImage {
 	return predicate.PlaceImage(sql.FieldEQ(FieldUpdatedBy, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v string) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldEQ(FieldDeletedBy, v))
+}
+
 // PlaceID applies equality check predicate on the "place_id" field. It's identical to PlaceIDEQ.
 func PlaceID(v string) predicate.PlaceImage {
 	return predicate.PlaceImage(sql.FieldEQ(FieldPlaceID, v))
@@ -111,68 +122,73 @@ func Pos(v int) predicate.PlaceImage {
 }
 
 // StatusEQ applies the EQ predicate on the "status" field.
-func StatusEQ(v string) predicate.PlaceImage {
+func StatusEQ(v types.Status) predicate.PlaceImage {
 	return predicate.PlaceImage(sql.FieldEQ(FieldStatus, v))
 }
 
 // StatusNEQ applies the NEQ predicate on the "status" field.
-func StatusNEQ(v string) predicate.PlaceImage {
+func StatusNEQ(v types.Status) predicate.PlaceImage {
 	return predicate.PlaceImage(sql.FieldNEQ(FieldStatus, v))
 }
 
 // StatusIn applies the In predicate on the "status" field.
-func StatusIn(vs ...string) predicate.PlaceImage {
+func StatusIn(vs ...types.Status) predicate.PlaceImage {
 	return predicate.PlaceImage(sql.FieldIn(FieldStatus, vs...))
 }
 
 // StatusNotIn applies the NotIn predicate on the "status" field.
-func StatusNotIn(vs ...string) predicate.PlaceImage {
+func StatusNotIn(vs ...types.Status) predicate.PlaceImage {
 	return predicate.PlaceImage(sql.FieldNotIn(FieldStatus, vs...))
 }
 
 // StatusGT applies the GT predicate on the "status" field.
-func StatusGT(v string) predicate.PlaceImage {
+func StatusGT(v types.Status) predicate.PlaceImage {
 	return predicate.PlaceImage(sql.FieldGT(FieldStatus, v))
 }
 
 // StatusGTE applies the GTE predicate on the "status" field.
-func StatusGTE(v string) predicate.PlaceImage {
+func StatusGTE(v types.Status) predicate.PlaceImage {
 	return predicate.PlaceImage(sql.FieldGTE(FieldStatus, v))
 }
 
 // StatusLT applies the LT predicate on the "status" field.
-func StatusLT(v string) predicate.PlaceImage {
+func StatusLT(v types.Status) predicate.PlaceImage {
 	return predicate.PlaceImage(sql.FieldLT(FieldStatus, v))
 }
 
 // StatusLTE applies the LTE predicate on the "status" field.
-func StatusLTE(v string) predicate.PlaceImage {
+func StatusLTE(v types.Status) predicate.PlaceImage {
 	return predicate.PlaceImage(sql.FieldLTE(FieldStatus, v))
 }
 
 // StatusContains applies the Contains predicate on the "status" field.
-func StatusContains(v string) predicate.PlaceImage {
-	return predicate.PlaceImage(sql.FieldContains(FieldStatus, v))
+func StatusContains(v types.Status) predicate.PlaceImage {
+	vc := string(v)
+	return predicate.PlaceImage(sql.FieldContains(FieldStatus, vc))
 }
 
 // StatusHasPrefix applies the HasPrefix predicate on the "status" field.
-func StatusHasPrefix(v string) predicate.PlaceImage {
-	return predicate.PlaceImage(sql.FieldHasPrefix(FieldStatus, v))
+func StatusHasPrefix(v types.Status) predicate.PlaceImage {
+	vc := string(v)
+	return predicate.PlaceImage(sql.FieldHasPrefix(FieldStatus, vc))
 }
 
 // StatusHasSuffix applies the HasSuffix predicate on the "status" field.
-func StatusHasSuffix(v string) predicate.PlaceImage {
-	return predicate.PlaceImage(sql.FieldHasSuffix(FieldStatus, v))
+func StatusHasSuffix(v types.Status) predicate.PlaceImage {
+	vc := string(v)
+	return predicate.PlaceImage(sql.FieldHasSuffix(FieldStatus, vc))
 }
 
 // StatusEqualFold applies the EqualFold predicate on the "status" field.
-func StatusEqualFold(v string) predicate.PlaceImage {
-	return predicate.PlaceImage(sql.FieldEqualFold(FieldStatus, v))
+func StatusEqualFold(v types.Status) predicate.PlaceImage {
+	vc := string(v)
+	return predicate.PlaceImage(sql.FieldEqualFold(FieldStatus, vc))
 }
 
 // StatusContainsFold applies the ContainsFold predicate on the "status" field.
-func StatusContainsFold(v string) predicate.PlaceImage {
-	return predicate.PlaceImage(sql.FieldContainsFold(FieldStatus, v))
+func StatusContainsFold(v types.Status) predicate.PlaceImage {
+	vc := string(v)
+	return predicate.PlaceImage(sql.FieldContainsFold(FieldStatus, vc))
 }
 
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
@@ -405,6 +421,131 @@ func UpdatedByContainsFold(v string) predicate.PlaceImage {
 	return predicate.PlaceImage(sql.FieldContainsFold(FieldUpdatedBy, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v string) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v string) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...string) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...string) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v string) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v string) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v string) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v string) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByContains applies the Contains predicate on the "deleted_by" field.
+func DeletedByContains(v string) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldContains(FieldDeletedBy, v))
+}
+
+// DeletedByHasPrefix applies the HasPrefix predicate on the "deleted_by" field.
+func DeletedByHasPrefix(v string) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldHasPrefix(FieldDeletedBy, v))
+}
+
+// DeletedByHasSuffix applies the HasSuffix predicate on the "deleted_by" field.
+func DeletedByHasSuffix(v string) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldHasSuffix(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// DeletedByEqualFold applies the EqualFold predicate on the "deleted_by" field.
+func DeletedByEqualFold(v string) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldEqualFold(FieldDeletedBy, v))
+}
+
+// DeletedByContainsFold applies the ContainsFold predicate on the "deleted_by" field.
+func DeletedByContainsFold(v string) predicate.PlaceImage {
+	return predicate.PlaceImage(sql.FieldContainsFold(FieldDeletedBy, v))
+}
+
 // MetadataIsNil applies the IsNil predicate on the "metadata" field.
 func MetadataIsNil() predicate.PlaceImage {
 	return predicate.PlaceImage(sql.FieldIsNull(FieldMetadata))
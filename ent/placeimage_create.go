@@ -8,10 +8,13 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/omkar273/nashikdarshan/ent/place"
 	"github.com/omkar273/nashikdarshan/ent/placeimage"
+	"github.com/omkar273/nashikdarshan/internal/types"
 )
 
 // PlaceImageCreate is the builder for creating a PlaceImage entity.
@@ -19,16 +22,17 @@ type PlaceImageCreate struct {
 	config
 	mutation *PlaceImageMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetStatus sets the "status" field.
-func (_c *PlaceImageCreate) SetStatus(v string) *PlaceImageCreate {
+func (_c *PlaceImageCreate) SetStatus(v types.Status) *PlaceImageCreate {
 	_c.mutation.SetStatus(v)
 	return _c
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_c *PlaceImageCreate) SetNillableStatus(v *string) *PlaceImageCreate {
+func (_c *PlaceImageCreate) SetNillableStatus(v *types.Status) *PlaceImageCreate {
 	if v != nil {
 		_c.SetStatus(*v)
 	}
@@ -91,6 +95,34 @@ func (_c *PlaceImageCreate) SetNillableUpdatedBy(v *string) *PlaceImageCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *PlaceImageCreate) SetDeletedAt(v time.Time) *PlaceImageCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *PlaceImageCreate) SetNillableDeletedAt(v *time.Time) *PlaceImageCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *PlaceImageCreate) SetDeletedBy(v string) *PlaceImageCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *PlaceImageCreate) SetNillableDeletedBy(v *string) *PlaceImageCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
 // SetMetadata sets the "metadata" field.
 func (_c *PlaceImageCreate) SetMetadata(v map[string]string) *PlaceImageCreate {
 	_c.mutation.SetMetadata(v)
@@ -163,7 +195,9 @@ func (_c *PlaceImageCreate) Mutation() *PlaceImageMutation {
 
 // Save creates the PlaceImage in the database.
 func (_c *PlaceImageCreate) Save(ctx context.Context) (*PlaceImage, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -190,16 +224,22 @@ func (_c *PlaceImageCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *PlaceImageCreate) defaults() {
+func (_c *PlaceImageCreate) defaults() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		v := placeimage.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if placeimage.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized placeimage.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := placeimage.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if placeimage.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized placeimage.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := placeimage.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
@@ -212,9 +252,13 @@ func (_c *PlaceImageCreate) defaults() {
 		_c.mutation.SetPos(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if placeimage.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized placeimage.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := placeimage.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -222,6 +266,11 @@ func (_c *PlaceImageCreate) check() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PlaceImage.status"`)}
 	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := placeimage.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PlaceImage.status": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PlaceImage.created_at"`)}
 	}
@@ -281,6 +330,7 @@ func (_c *PlaceImageCreate) createSpec() (*PlaceImage, *sqlgraph.CreateSpec) {
 		_node = &PlaceImage{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(placeimage.Table, sqlgraph.NewFieldSpec(placeimage.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -305,6 +355,14 @@ func (_c *PlaceImageCreate) createSpec() (*PlaceImage, *sqlgraph.CreateSpec) {
 		_spec.SetField(placeimage.FieldUpdatedBy, field.TypeString, value)
 		_node.UpdatedBy = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(placeimage.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(placeimage.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
 	if value, ok := _c.mutation.Metadata(); ok {
 		_spec.SetField(placeimage.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -341,11 +399,491 @@ func (_c *PlaceImageCreate) createSpec() (*PlaceImage, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PlaceImage.Create().
+//		SetStatus(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PlaceImageUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PlaceImageCreate) OnConflict(opts ...sql.ConflictOption) *PlaceImageUpsertOne {
+	_c.conflict = opts
+	return &PlaceImageUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PlaceImage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PlaceImageCreate) OnConflictColumns(columns ...string) *PlaceImageUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PlaceImageUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PlaceImageUpsertOne is the builder for "upsert"-ing
+	//  one PlaceImage node.
+	PlaceImageUpsertOne struct {
+		create *PlaceImageCreate
+	}
+
+	// PlaceImageUpsert is the "OnConflict" setter.
+	PlaceImageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *PlaceImageUpsert) SetStatus(v types.Status) *PlaceImageUpsert {
+	u.Set(placeimage.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PlaceImageUpsert) UpdateStatus() *PlaceImageUpsert {
+	u.SetExcluded(placeimage.FieldStatus)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlaceImageUpsert) SetUpdatedAt(v time.Time) *PlaceImageUpsert {
+	u.Set(placeimage.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlaceImageUpsert) UpdateUpdatedAt() *PlaceImageUpsert {
+	u.SetExcluded(placeimage.FieldUpdatedAt)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *PlaceImageUpsert) SetUpdatedBy(v string) *PlaceImageUpsert {
+	u.Set(placeimage.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *PlaceImageUpsert) UpdateUpdatedBy() *PlaceImageUpsert {
+	u.SetExcluded(placeimage.FieldUpdatedBy)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *PlaceImageUpsert) ClearUpdatedBy() *PlaceImageUpsert {
+	u.SetNull(placeimage.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PlaceImageUpsert) SetDeletedAt(v time.Time) *PlaceImageUpsert {
+	u.Set(placeimage.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PlaceImageUpsert) UpdateDeletedAt() *PlaceImageUpsert {
+	u.SetExcluded(placeimage.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PlaceImageUpsert) ClearDeletedAt() *PlaceImageUpsert {
+	u.SetNull(placeimage.FieldDeletedAt)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PlaceImageUpsert) SetDeletedBy(v string) *PlaceImageUpsert {
+	u.Set(placeimage.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PlaceImageUpsert) UpdateDeletedBy() *PlaceImageUpsert {
+	u.SetExcluded(placeimage.FieldDeletedBy)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PlaceImageUpsert) ClearDeletedBy() *PlaceImageUpsert {
+	u.SetNull(placeimage.FieldDeletedBy)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PlaceImageUpsert) SetMetadata(v map[string]string) *PlaceImageUpsert {
+	u.Set(placeimage.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PlaceImageUpsert) UpdateMetadata() *PlaceImageUpsert {
+	u.SetExcluded(placeimage.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *PlaceImageUpsert) ClearMetadata() *PlaceImageUpsert {
+	u.SetNull(placeimage.FieldMetadata)
+	return u
+}
+
+// SetPlaceID sets the "place_id" field.
+func (u *PlaceImageUpsert) SetPlaceID(v string) *PlaceImageUpsert {
+	u.Set(placeimage.FieldPlaceID, v)
+	return u
+}
+
+// UpdatePlaceID sets the "place_id" field to the value that was provided on create.
+func (u *PlaceImageUpsert) UpdatePlaceID() *PlaceImageUpsert {
+	u.SetExcluded(placeimage.FieldPlaceID)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *PlaceImageUpsert) SetURL(v string) *PlaceImageUpsert {
+	u.Set(placeimage.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *PlaceImageUpsert) UpdateURL() *PlaceImageUpsert {
+	u.SetExcluded(placeimage.FieldURL)
+	return u
+}
+
+// SetAlt sets the "alt" field.
+func (u *PlaceImageUpsert) SetAlt(v string) *PlaceImageUpsert {
+	u.Set(placeimage.FieldAlt, v)
+	return u
+}
+
+// UpdateAlt sets the "alt" field to the value that was provided on create.
+func (u *PlaceImageUpsert) UpdateAlt() *PlaceImageUpsert {
+	u.SetExcluded(placeimage.FieldAlt)
+	return u
+}
+
+// ClearAlt clears the value of the "alt" field.
+func (u *PlaceImageUpsert) ClearAlt() *PlaceImageUpsert {
+	u.SetNull(placeimage.FieldAlt)
+	return u
+}
+
+// SetPos sets the "pos" field.
+func (u *PlaceImageUpsert) SetPos(v int) *PlaceImageUpsert {
+	u.Set(placeimage.FieldPos, v)
+	return u
+}
+
+// UpdatePos sets the "pos" field to the value that was provided on create.
+func (u *PlaceImageUpsert) UpdatePos() *PlaceImageUpsert {
+	u.SetExcluded(placeimage.FieldPos)
+	return u
+}
+
+// AddPos adds v to the "pos" field.
+func (u *PlaceImageUpsert) AddPos(v int) *PlaceImageUpsert {
+	u.Add(placeimage.FieldPos, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PlaceImage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(placeimage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PlaceImageUpsertOne) UpdateNewValues() *PlaceImageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(placeimage.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(placeimage.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(placeimage.FieldCreatedBy)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PlaceImage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PlaceImageUpsertOne) Ignore() *PlaceImageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PlaceImageUpsertOne) DoNothing() *PlaceImageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PlaceImageCreate.OnConflict
+// documentation for more info.
+func (u *PlaceImageUpsertOne) Update(set func(*PlaceImageUpsert)) *PlaceImageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PlaceImageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PlaceImageUpsertOne) SetStatus(v types.Status) *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PlaceImageUpsertOne) UpdateStatus() *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlaceImageUpsertOne) SetUpdatedAt(v time.Time) *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlaceImageUpsertOne) UpdateUpdatedAt() *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *PlaceImageUpsertOne) SetUpdatedBy(v string) *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *PlaceImageUpsertOne) UpdateUpdatedBy() *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *PlaceImageUpsertOne) ClearUpdatedBy() *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PlaceImageUpsertOne) SetDeletedAt(v time.Time) *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PlaceImageUpsertOne) UpdateDeletedAt() *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PlaceImageUpsertOne) ClearDeletedAt() *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PlaceImageUpsertOne) SetDeletedBy(v string) *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PlaceImageUpsertOne) UpdateDeletedBy() *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PlaceImageUpsertOne) ClearDeletedBy() *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PlaceImageUpsertOne) SetMetadata(v map[string]string) *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PlaceImageUpsertOne) UpdateMetadata() *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *PlaceImageUpsertOne) ClearMetadata() *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetPlaceID sets the "place_id" field.
+func (u *PlaceImageUpsertOne) SetPlaceID(v string) *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetPlaceID(v)
+	})
+}
+
+// UpdatePlaceID sets the "place_id" field to the value that was provided on create.
+func (u *PlaceImageUpsertOne) UpdatePlaceID() *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdatePlaceID()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *PlaceImageUpsertOne) SetURL(v string) *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *PlaceImageUpsertOne) UpdateURL() *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetAlt sets the "alt" field.
+func (u *PlaceImageUpsertOne) SetAlt(v string) *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetAlt(v)
+	})
+}
+
+// UpdateAlt sets the "alt" field to the value that was provided on create.
+func (u *PlaceImageUpsertOne) UpdateAlt() *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdateAlt()
+	})
+}
+
+// ClearAlt clears the value of the "alt" field.
+func (u *PlaceImageUpsertOne) ClearAlt() *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.ClearAlt()
+	})
+}
+
+// SetPos sets the "pos" field.
+func (u *PlaceImageUpsertOne) SetPos(v int) *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetPos(v)
+	})
+}
+
+// AddPos adds v to the "pos" field.
+func (u *PlaceImageUpsertOne) AddPos(v int) *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.AddPos(v)
+	})
+}
+
+// UpdatePos sets the "pos" field to the value that was provided on create.
+func (u *PlaceImageUpsertOne) UpdatePos() *PlaceImageUpsertOne {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdatePos()
+	})
+}
+
+// Exec executes the query.
+func (u *PlaceImageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PlaceImageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PlaceImageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PlaceImageUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PlaceImageUpsertOne.ID is not supported by MySQL driver. Use PlaceImageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PlaceImageUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PlaceImageCreateBulk is the builder for creating many PlaceImage entities in bulk.
 type PlaceImageCreateBulk struct {
 	config
 	err      error
 	builders []*PlaceImageCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the PlaceImage entities in the database.
@@ -375,6 +913,7 @@ func (_c *PlaceImageCreateBulk) Save(ctx context.Context) ([]*PlaceImage, error)
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -424,3 +963,305 @@ func (_c *PlaceImageCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PlaceImage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PlaceImageUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PlaceImageCreateBulk) OnConflict(opts ...sql.ConflictOption) *PlaceImageUpsertBulk {
+	_c.conflict = opts
+	return &PlaceImageUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PlaceImage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PlaceImageCreateBulk) OnConflictColumns(columns ...string) *PlaceImageUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PlaceImageUpsertBulk{
+		create: _c,
+	}
+}
+
+// PlaceImageUpsertBulk is the builder for "upsert"-ing
+// a bulk of PlaceImage nodes.
+type PlaceImageUpsertBulk struct {
+	create *PlaceImageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PlaceImage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(placeimage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PlaceImageUpsertBulk) UpdateNewValues() *PlaceImageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(placeimage.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(placeimage.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(placeimage.FieldCreatedBy)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PlaceImage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PlaceImageUpsertBulk) Ignore() *PlaceImageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PlaceImageUpsertBulk) DoNothing() *PlaceImageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PlaceImageCreateBulk.OnConflict
+// documentation for more info.
+func (u *PlaceImageUpsertBulk) Update(set func(*PlaceImageUpsert)) *PlaceImageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PlaceImageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PlaceImageUpsertBulk) SetStatus(v types.Status) *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PlaceImageUpsertBulk) UpdateStatus() *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlaceImageUpsertBulk) SetUpdatedAt(v time.Time) *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlaceImageUpsertBulk) UpdateUpdatedAt() *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *PlaceImageUpsertBulk) SetUpdatedBy(v string) *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *PlaceImageUpsertBulk) UpdateUpdatedBy() *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *PlaceImageUpsertBulk) ClearUpdatedBy() *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PlaceImageUpsertBulk) SetDeletedAt(v time.Time) *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PlaceImageUpsertBulk) UpdateDeletedAt() *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PlaceImageUpsertBulk) ClearDeletedAt() *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PlaceImageUpsertBulk) SetDeletedBy(v string) *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PlaceImageUpsertBulk) UpdateDeletedBy() *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PlaceImageUpsertBulk) ClearDeletedBy() *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PlaceImageUpsertBulk) SetMetadata(v map[string]string) *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PlaceImageUpsertBulk) UpdateMetadata() *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *PlaceImageUpsertBulk) ClearMetadata() *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetPlaceID sets the "place_id" field.
+func (u *PlaceImageUpsertBulk) SetPlaceID(v string) *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetPlaceID(v)
+	})
+}
+
+// UpdatePlaceID sets the "place_id" field to the value that was provided on create.
+func (u *PlaceImageUpsertBulk) UpdatePlaceID() *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdatePlaceID()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *PlaceImageUpsertBulk) SetURL(v string) *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *PlaceImageUpsertBulk) UpdateURL() *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetAlt sets the "alt" field.
+func (u *PlaceImageUpsertBulk) SetAlt(v string) *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetAlt(v)
+	})
+}
+
+// UpdateAlt sets the "alt" field to the value that was provided on create.
+func (u *PlaceImageUpsertBulk) UpdateAlt() *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdateAlt()
+	})
+}
+
+// ClearAlt clears the value of the "alt" field.
+func (u *PlaceImageUpsertBulk) ClearAlt() *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.ClearAlt()
+	})
+}
+
+// SetPos sets the "pos" field.
+func (u *PlaceImageUpsertBulk) SetPos(v int) *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.SetPos(v)
+	})
+}
+
+// AddPos adds v to the "pos" field.
+func (u *PlaceImageUpsertBulk) AddPos(v int) *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.AddPos(v)
+	})
+}
+
+// UpdatePos sets the "pos" field to the value that was provided on create.
+func (u *PlaceImageUpsertBulk) UpdatePos() *PlaceImageUpsertBulk {
+	return u.Update(func(s *PlaceImageUpsert) {
+		s.UpdatePos()
+	})
+}
+
+// Exec executes the query.
+func (u *PlaceImageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PlaceImageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PlaceImageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PlaceImageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -298,7 +298,7 @@ func (_q *PlaceImageQuery) WithPlace(opts ...func(*PlaceQuery)) *PlaceImageQuery
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Status types.Status `json:"status,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
@@ -321,7 +321,7 @@ func (_q *PlaceImageQuery) GroupBy(field string, fields ...string) *PlaceImageGr
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Status types.Status `json:"status,omitempty"`
 //	}
 //
 //	client.PlaceImage.Query().
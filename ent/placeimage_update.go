@@ -14,6 +14,7 @@ import (
 	"github.com/omkar273/nashikdarshan/ent/place"
 	"github.com/omkar273/nashikdarshan/ent/placeimage"
 	"github.com/omkar273/nashikdarshan/ent/predicate"
+	"github.com/omkar273/nashikdarshan/internal/types"
 )
 
 // PlaceImageUpdate is the builder for updating PlaceImage entities.
@@ -30,13 +31,13 @@ func (_u *PlaceImageUpdate) Where(ps ...predicate.PlaceImage) *PlaceImageUpdate
 }
 
 // SetStatus sets the "status" field.
-func (_u *PlaceImageUpdate) SetStatus(v string) *PlaceImageUpdate {
+func (_u *PlaceImageUpdate) SetStatus(v types.Status) *PlaceImageUpdate {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *PlaceImageUpdate) SetNillableStatus(v *string) *PlaceImageUpdate {
+func (_u *PlaceImageUpdate) SetNillableStatus(v *types.Status) *PlaceImageUpdate {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -69,6 +70,46 @@ func (_u *PlaceImageUpdate) ClearUpdatedBy() *PlaceImageUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PlaceImageUpdate) SetDeletedAt(v time.Time) *PlaceImageUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PlaceImageUpdate) SetNillableDeletedAt(v *time.Time) *PlaceImageUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *PlaceImageUpdate) ClearDeletedAt() *PlaceImageUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *PlaceImageUpdate) SetDeletedBy(v string) *PlaceImageUpdate {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *PlaceImageUpdate) SetNillableDeletedBy(v *string) *PlaceImageUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *PlaceImageUpdate) ClearDeletedBy() *PlaceImageUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *PlaceImageUpdate) SetMetadata(v map[string]string) *PlaceImageUpdate {
 	_u.mutation.SetMetadata(v)
@@ -168,7 +209,9 @@ func (_u *PlaceImageUpdate) ClearPlace() *PlaceImageUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PlaceImageUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -195,15 +238,24 @@ func (_u *PlaceImageUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *PlaceImageUpdate) defaults() {
+func (_u *PlaceImageUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if placeimage.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized placeimage.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := placeimage.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *PlaceImageUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := placeimage.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PlaceImage.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.PlaceID(); ok {
 		if err := placeimage.PlaceIDValidator(v); err != nil {
 			return &ValidationError{Name: "place_id", err: fmt.Errorf(`ent: validator failed for field "PlaceImage.place_id": %w`, err)}
@@ -247,6 +299,18 @@ func (_u *PlaceImageUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.UpdatedByCleared() {
 		_spec.ClearField(placeimage.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(placeimage.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(placeimage.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(placeimage.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(placeimage.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(placeimage.FieldMetadata, field.TypeJSON, value)
 	}
@@ -318,13 +382,13 @@ type PlaceImageUpdateOne struct {
 }
 
 // SetStatus sets the "status" field.
-func (_u *PlaceImageUpdateOne) SetStatus(v string) *PlaceImageUpdateOne {
+func (_u *PlaceImageUpdateOne) SetStatus(v types.Status) *PlaceImageUpdateOne {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *PlaceImageUpdateOne) SetNillableStatus(v *string) *PlaceImageUpdateOne {
+func (_u *PlaceImageUpdateOne) SetNillableStatus(v *types.Status) *PlaceImageUpdateOne {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -357,6 +421,46 @@ func (_u *PlaceImageUpdateOne) ClearUpdatedBy() *PlaceImageUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PlaceImageUpdateOne) SetDeletedAt(v time.Time) *PlaceImageUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PlaceImageUpdateOne) SetNillableDeletedAt(v *time.Time) *PlaceImageUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *PlaceImageUpdateOne) ClearDeletedAt() *PlaceImageUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *PlaceImageUpdateOne) SetDeletedBy(v string) *PlaceImageUpdateOne {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *PlaceImageUpdateOne) SetNillableDeletedBy(v *string) *PlaceImageUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *PlaceImageUpdateOne) ClearDeletedBy() *PlaceImageUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *PlaceImageUpdateOne) SetMetadata(v map[string]string) *PlaceImageUpdateOne {
 	_u.mutation.SetMetadata(v)
@@ -469,7 +573,9 @@ func (_u *PlaceImageUpdateOne) Select(field string, fields ...string) *PlaceImag
 
 // Save executes the query and returns the updated PlaceImage entity.
 func (_u *PlaceImageUpdateOne) Save(ctx context.Context) (*PlaceImage, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -496,15 +602,24 @@ func (_u *PlaceImageUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *PlaceImageUpdateOne) defaults() {
+func (_u *PlaceImageUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if placeimage.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized placeimage.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := placeimage.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *PlaceImageUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := placeimage.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PlaceImage.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.PlaceID(); ok {
 		if err := placeimage.PlaceIDValidator(v); err != nil {
 			return &ValidationError{Name: "place_id", err: fmt.Errorf(`ent: validator failed for field "PlaceImage.place_id": %w`, err)}
@@ -565,6 +680,18 @@ func (_u *PlaceImageUpdateOne) sqlSave(ctx context.Context) (_node *PlaceImage,
 	if _u.mutation.UpdatedByCleared() {
 		_spec.ClearField(placeimage.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(placeimage.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(placeimage.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(placeimage.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(placeimage.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(placeimage.FieldMetadata, field.TypeJSON, value)
 	}
@@ -0,0 +1,259 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/nashikdarshan/ent/place"
+	"github.com/omkar273/nashikdarshan/ent/placerevision"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// PlaceRevision is the model entity for the PlaceRevision schema.
+type PlaceRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status types.Status `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// DeletedBy holds the value of the "deleted_by" field.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// PlaceID holds the value of the "place_id" field.
+	PlaceID string `json:"place_id,omitempty"`
+	// Revision holds the value of the "revision" field.
+	Revision int `json:"revision,omitempty"`
+	// Snapshot holds the value of the "snapshot" field.
+	Snapshot json.RawMessage `json:"snapshot,omitempty"`
+	// RevertedFrom holds the value of the "reverted_from" field.
+	RevertedFrom *int `json:"reverted_from,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PlaceRevisionQuery when eager-loading is set.
+	Edges        PlaceRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PlaceRevisionEdges holds the relations/edges for other nodes in the graph.
+type PlaceRevisionEdges struct {
+	// Place holds the value of the place edge.
+	Place *Place `json:"place,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PlaceOrErr returns the Place value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlaceRevisionEdges) PlaceOrErr() (*Place, error) {
+	if e.Place != nil {
+		return e.Place, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: place.Label}
+	}
+	return nil, &NotLoadedError{edge: "place"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PlaceRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case placerevision.FieldSnapshot:
+			values[i] = new([]byte)
+		case placerevision.FieldRevision, placerevision.FieldRevertedFrom:
+			values[i] = new(sql.NullInt64)
+		case placerevision.FieldID, placerevision.FieldCreatedBy, placerevision.FieldUpdatedBy, placerevision.FieldDeletedBy, placerevision.FieldPlaceID:
+			values[i] = new(sql.NullString)
+		case placerevision.FieldCreatedAt, placerevision.FieldUpdatedAt, placerevision.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case placerevision.FieldStatus:
+			values[i] = new(types.Status)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PlaceRevision fields.
+func (_m *PlaceRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case placerevision.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case placerevision.FieldStatus:
+			if value, ok := values[i].(*types.Status); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value != nil {
+				_m.Status = *value
+			}
+		case placerevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case placerevision.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case placerevision.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case placerevision.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = value.String
+			}
+		case placerevision.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case placerevision.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = value.String
+			}
+		case placerevision.FieldPlaceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field place_id", values[i])
+			} else if value.Valid {
+				_m.PlaceID = value.String
+			}
+		case placerevision.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = int(value.Int64)
+			}
+		case placerevision.FieldSnapshot:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Snapshot); err != nil {
+					return fmt.Errorf("unmarshal field snapshot: %w", err)
+				}
+			}
+		case placerevision.FieldRevertedFrom:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reverted_from", values[i])
+			} else if value.Valid {
+				_m.RevertedFrom = new(int)
+				*_m.RevertedFrom = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PlaceRevision.
+// This includes values selected through modifiers, order, etc.
+func (_m *PlaceRevision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPlace queries the "place" edge of the PlaceRevision entity.
+func (_m *PlaceRevision) QueryPlace() *PlaceQuery {
+	return NewPlaceRevisionClient(_m.config).QueryPlace(_m)
+}
+
+// Update returns a builder for updating this PlaceRevision.
+// Note that you need to call PlaceRevision.Unwrap() before calling this method if this PlaceRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PlaceRevision) Update() *PlaceRevisionUpdateOne {
+	return NewPlaceRevisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PlaceRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PlaceRevision) Unwrap() *PlaceRevision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PlaceRevision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PlaceRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("PlaceRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(_m.UpdatedBy)
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(_m.DeletedBy)
+	builder.WriteString(", ")
+	builder.WriteString("place_id=")
+	builder.WriteString(_m.PlaceID)
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("snapshot=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Snapshot))
+	builder.WriteString(", ")
+	if v := _m.RevertedFrom; v != nil {
+		builder.WriteString("reverted_from=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PlaceRevisions is a parsable slice of PlaceRevision.
+type PlaceRevisions []*PlaceRevision
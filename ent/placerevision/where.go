@@ -0,0 +1,735 @@
+// Code generated by ent, DO NOT EDIT.
+
+package placerevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/omkar273/nashikdarshan/ent/predicate"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldContainsFold(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v types.Status) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// PlaceID applies equality check predicate on the "place_id" field. It's identical to PlaceIDEQ.
+func PlaceID(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldPlaceID, v))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevertedFrom applies equality check predicate on the "reverted_from" field. It's identical to RevertedFromEQ.
+func RevertedFrom(v int) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldRevertedFrom, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v types.Status) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v types.Status) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...types.Status) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...types.Status) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v types.Status) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v types.Status) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v types.Status) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v types.Status) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v types.Status) predicate.PlaceRevision {
+	vc := string(v)
+	return predicate.PlaceRevision(sql.FieldContains(FieldStatus, vc))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v types.Status) predicate.PlaceRevision {
+	vc := string(v)
+	return predicate.PlaceRevision(sql.FieldHasPrefix(FieldStatus, vc))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v types.Status) predicate.PlaceRevision {
+	vc := string(v)
+	return predicate.PlaceRevision(sql.FieldHasSuffix(FieldStatus, vc))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v types.Status) predicate.PlaceRevision {
+	vc := string(v)
+	return predicate.PlaceRevision(sql.FieldEqualFold(FieldStatus, vc))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v types.Status) predicate.PlaceRevision {
+	vc := string(v)
+	return predicate.PlaceRevision(sql.FieldContainsFold(FieldStatus, vc))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByContains applies the Contains predicate on the "deleted_by" field.
+func DeletedByContains(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldContains(FieldDeletedBy, v))
+}
+
+// DeletedByHasPrefix applies the HasPrefix predicate on the "deleted_by" field.
+func DeletedByHasPrefix(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldHasPrefix(FieldDeletedBy, v))
+}
+
+// DeletedByHasSuffix applies the HasSuffix predicate on the "deleted_by" field.
+func DeletedByHasSuffix(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldHasSuffix(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// DeletedByEqualFold applies the EqualFold predicate on the "deleted_by" field.
+func DeletedByEqualFold(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEqualFold(FieldDeletedBy, v))
+}
+
+// DeletedByContainsFold applies the ContainsFold predicate on the "deleted_by" field.
+func DeletedByContainsFold(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldContainsFold(FieldDeletedBy, v))
+}
+
+// PlaceIDEQ applies the EQ predicate on the "place_id" field.
+func PlaceIDEQ(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldPlaceID, v))
+}
+
+// PlaceIDNEQ applies the NEQ predicate on the "place_id" field.
+func PlaceIDNEQ(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNEQ(FieldPlaceID, v))
+}
+
+// PlaceIDIn applies the In predicate on the "place_id" field.
+func PlaceIDIn(vs ...string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldIn(FieldPlaceID, vs...))
+}
+
+// PlaceIDNotIn applies the NotIn predicate on the "place_id" field.
+func PlaceIDNotIn(vs ...string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNotIn(FieldPlaceID, vs...))
+}
+
+// PlaceIDGT applies the GT predicate on the "place_id" field.
+func PlaceIDGT(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGT(FieldPlaceID, v))
+}
+
+// PlaceIDGTE applies the GTE predicate on the "place_id" field.
+func PlaceIDGTE(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGTE(FieldPlaceID, v))
+}
+
+// PlaceIDLT applies the LT predicate on the "place_id" field.
+func PlaceIDLT(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLT(FieldPlaceID, v))
+}
+
+// PlaceIDLTE applies the LTE predicate on the "place_id" field.
+func PlaceIDLTE(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLTE(FieldPlaceID, v))
+}
+
+// PlaceIDContains applies the Contains predicate on the "place_id" field.
+func PlaceIDContains(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldContains(FieldPlaceID, v))
+}
+
+// PlaceIDHasPrefix applies the HasPrefix predicate on the "place_id" field.
+func PlaceIDHasPrefix(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldHasPrefix(FieldPlaceID, v))
+}
+
+// PlaceIDHasSuffix applies the HasSuffix predicate on the "place_id" field.
+func PlaceIDHasSuffix(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldHasSuffix(FieldPlaceID, v))
+}
+
+// PlaceIDEqualFold applies the EqualFold predicate on the "place_id" field.
+func PlaceIDEqualFold(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEqualFold(FieldPlaceID, v))
+}
+
+// PlaceIDContainsFold applies the ContainsFold predicate on the "place_id" field.
+func PlaceIDContainsFold(v string) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldContainsFold(FieldPlaceID, v))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLTE(FieldRevision, v))
+}
+
+// RevertedFromEQ applies the EQ predicate on the "reverted_from" field.
+func RevertedFromEQ(v int) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldEQ(FieldRevertedFrom, v))
+}
+
+// RevertedFromNEQ applies the NEQ predicate on the "reverted_from" field.
+func RevertedFromNEQ(v int) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNEQ(FieldRevertedFrom, v))
+}
+
+// RevertedFromIn applies the In predicate on the "reverted_from" field.
+func RevertedFromIn(vs ...int) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldIn(FieldRevertedFrom, vs...))
+}
+
+// RevertedFromNotIn applies the NotIn predicate on the "reverted_from" field.
+func RevertedFromNotIn(vs ...int) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNotIn(FieldRevertedFrom, vs...))
+}
+
+// RevertedFromGT applies the GT predicate on the "reverted_from" field.
+func RevertedFromGT(v int) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGT(FieldRevertedFrom, v))
+}
+
+// RevertedFromGTE applies the GTE predicate on the "reverted_from" field.
+func RevertedFromGTE(v int) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldGTE(FieldRevertedFrom, v))
+}
+
+// RevertedFromLT applies the LT predicate on the "reverted_from" field.
+func RevertedFromLT(v int) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLT(FieldRevertedFrom, v))
+}
+
+// RevertedFromLTE applies the LTE predicate on the "reverted_from" field.
+func RevertedFromLTE(v int) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldLTE(FieldRevertedFrom, v))
+}
+
+// RevertedFromIsNil applies the IsNil predicate on the "reverted_from" field.
+func RevertedFromIsNil() predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldIsNull(FieldRevertedFrom))
+}
+
+// RevertedFromNotNil applies the NotNil predicate on the "reverted_from" field.
+func RevertedFromNotNil() predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.FieldNotNull(FieldRevertedFrom))
+}
+
+// HasPlace applies the HasEdge predicate on the "place" edge.
+func HasPlace() predicate.PlaceRevision {
+	return predicate.PlaceRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlaceTable, PlaceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlaceWith applies the HasEdge predicate on the "place" edge with a given conditions (other predicates).
+func HasPlaceWith(preds ...predicate.Place) predicate.PlaceRevision {
+	return predicate.PlaceRevision(func(s *sql.Selector) {
+		step := newPlaceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PlaceRevision) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PlaceRevision) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PlaceRevision) predicate.PlaceRevision {
+	return predicate.PlaceRevision(sql.NotPredicates(p))
+}
@@ -0,0 +1,1006 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/nashikdarshan/ent/place"
+	"github.com/omkar273/nashikdarshan/ent/placerevision"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// PlaceRevisionCreate is the builder for creating a PlaceRevision entity.
+type PlaceRevisionCreate struct {
+	config
+	mutation *PlaceRevisionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetStatus sets the "status" field.
+func (_c *PlaceRevisionCreate) SetStatus(v types.Status) *PlaceRevisionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PlaceRevisionCreate) SetNillableStatus(v *types.Status) *PlaceRevisionCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PlaceRevisionCreate) SetCreatedAt(v time.Time) *PlaceRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PlaceRevisionCreate) SetNillableCreatedAt(v *time.Time) *PlaceRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PlaceRevisionCreate) SetUpdatedAt(v time.Time) *PlaceRevisionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PlaceRevisionCreate) SetNillableUpdatedAt(v *time.Time) *PlaceRevisionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *PlaceRevisionCreate) SetCreatedBy(v string) *PlaceRevisionCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *PlaceRevisionCreate) SetNillableCreatedBy(v *string) *PlaceRevisionCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *PlaceRevisionCreate) SetUpdatedBy(v string) *PlaceRevisionCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *PlaceRevisionCreate) SetNillableUpdatedBy(v *string) *PlaceRevisionCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *PlaceRevisionCreate) SetDeletedAt(v time.Time) *PlaceRevisionCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *PlaceRevisionCreate) SetNillableDeletedAt(v *time.Time) *PlaceRevisionCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *PlaceRevisionCreate) SetDeletedBy(v string) *PlaceRevisionCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *PlaceRevisionCreate) SetNillableDeletedBy(v *string) *PlaceRevisionCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetPlaceID sets the "place_id" field.
+func (_c *PlaceRevisionCreate) SetPlaceID(v string) *PlaceRevisionCreate {
+	_c.mutation.SetPlaceID(v)
+	return _c
+}
+
+// SetRevision sets the "revision" field.
+func (_c *PlaceRevisionCreate) SetRevision(v int) *PlaceRevisionCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (_c *PlaceRevisionCreate) SetSnapshot(v json.RawMessage) *PlaceRevisionCreate {
+	_c.mutation.SetSnapshot(v)
+	return _c
+}
+
+// SetRevertedFrom sets the "reverted_from" field.
+func (_c *PlaceRevisionCreate) SetRevertedFrom(v int) *PlaceRevisionCreate {
+	_c.mutation.SetRevertedFrom(v)
+	return _c
+}
+
+// SetNillableRevertedFrom sets the "reverted_from" field if the given value is not nil.
+func (_c *PlaceRevisionCreate) SetNillableRevertedFrom(v *int) *PlaceRevisionCreate {
+	if v != nil {
+		_c.SetRevertedFrom(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PlaceRevisionCreate) SetID(v string) *PlaceRevisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PlaceRevisionCreate) SetNillableID(v *string) *PlaceRevisionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPlace sets the "place" edge to the Place entity.
+func (_c *PlaceRevisionCreate) SetPlace(v *Place) *PlaceRevisionCreate {
+	return _c.SetPlaceID(v.ID)
+}
+
+// Mutation returns the PlaceRevisionMutation object of the builder.
+func (_c *PlaceRevisionCreate) Mutation() *PlaceRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the PlaceRevision in the database.
+func (_c *PlaceRevisionCreate) Save(ctx context.Context) (*PlaceRevision, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PlaceRevisionCreate) SaveX(ctx context.Context) *PlaceRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlaceRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlaceRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PlaceRevisionCreate) defaults() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := placerevision.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if placerevision.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized placerevision.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := placerevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if placerevision.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized placerevision.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := placerevision.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if placerevision.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized placerevision.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := placerevision.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PlaceRevisionCreate) check() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PlaceRevision.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := placerevision.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PlaceRevision.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PlaceRevision.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PlaceRevision.updated_at"`)}
+	}
+	if _, ok := _c.mutation.PlaceID(); !ok {
+		return &ValidationError{Name: "place_id", err: errors.New(`ent: missing required field "PlaceRevision.place_id"`)}
+	}
+	if v, ok := _c.mutation.PlaceID(); ok {
+		if err := placerevision.PlaceIDValidator(v); err != nil {
+			return &ValidationError{Name: "place_id", err: fmt.Errorf(`ent: validator failed for field "PlaceRevision.place_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "PlaceRevision.revision"`)}
+	}
+	if v, ok := _c.mutation.Revision(); ok {
+		if err := placerevision.RevisionValidator(v); err != nil {
+			return &ValidationError{Name: "revision", err: fmt.Errorf(`ent: validator failed for field "PlaceRevision.revision": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Snapshot(); !ok {
+		return &ValidationError{Name: "snapshot", err: errors.New(`ent: missing required field "PlaceRevision.snapshot"`)}
+	}
+	if len(_c.mutation.PlaceIDs()) == 0 {
+		return &ValidationError{Name: "place", err: errors.New(`ent: missing required edge "PlaceRevision.place"`)}
+	}
+	return nil
+}
+
+func (_c *PlaceRevisionCreate) sqlSave(ctx context.Context) (*PlaceRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PlaceRevision.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PlaceRevisionCreate) createSpec() (*PlaceRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PlaceRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(placerevision.Table, sqlgraph.NewFieldSpec(placerevision.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(placerevision.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(placerevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(placerevision.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(placerevision.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(placerevision.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(placerevision.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(placerevision.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(placerevision.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.Snapshot(); ok {
+		_spec.SetField(placerevision.FieldSnapshot, field.TypeJSON, value)
+		_node.Snapshot = value
+	}
+	if value, ok := _c.mutation.RevertedFrom(); ok {
+		_spec.SetField(placerevision.FieldRevertedFrom, field.TypeInt, value)
+		_node.RevertedFrom = &value
+	}
+	if nodes := _c.mutation.PlaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   placerevision.PlaceTable,
+			Columns: []string{placerevision.PlaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(place.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PlaceRevision.Create().
+//		SetStatus(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PlaceRevisionUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PlaceRevisionCreate) OnConflict(opts ...sql.ConflictOption) *PlaceRevisionUpsertOne {
+	_c.conflict = opts
+	return &PlaceRevisionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PlaceRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PlaceRevisionCreate) OnConflictColumns(columns ...string) *PlaceRevisionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PlaceRevisionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PlaceRevisionUpsertOne is the builder for "upsert"-ing
+	//  one PlaceRevision node.
+	PlaceRevisionUpsertOne struct {
+		create *PlaceRevisionCreate
+	}
+
+	// PlaceRevisionUpsert is the "OnConflict" setter.
+	PlaceRevisionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *PlaceRevisionUpsert) SetStatus(v types.Status) *PlaceRevisionUpsert {
+	u.Set(placerevision.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PlaceRevisionUpsert) UpdateStatus() *PlaceRevisionUpsert {
+	u.SetExcluded(placerevision.FieldStatus)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlaceRevisionUpsert) SetUpdatedAt(v time.Time) *PlaceRevisionUpsert {
+	u.Set(placerevision.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlaceRevisionUpsert) UpdateUpdatedAt() *PlaceRevisionUpsert {
+	u.SetExcluded(placerevision.FieldUpdatedAt)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *PlaceRevisionUpsert) SetUpdatedBy(v string) *PlaceRevisionUpsert {
+	u.Set(placerevision.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *PlaceRevisionUpsert) UpdateUpdatedBy() *PlaceRevisionUpsert {
+	u.SetExcluded(placerevision.FieldUpdatedBy)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *PlaceRevisionUpsert) ClearUpdatedBy() *PlaceRevisionUpsert {
+	u.SetNull(placerevision.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PlaceRevisionUpsert) SetDeletedAt(v time.Time) *PlaceRevisionUpsert {
+	u.Set(placerevision.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PlaceRevisionUpsert) UpdateDeletedAt() *PlaceRevisionUpsert {
+	u.SetExcluded(placerevision.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PlaceRevisionUpsert) ClearDeletedAt() *PlaceRevisionUpsert {
+	u.SetNull(placerevision.FieldDeletedAt)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PlaceRevisionUpsert) SetDeletedBy(v string) *PlaceRevisionUpsert {
+	u.Set(placerevision.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PlaceRevisionUpsert) UpdateDeletedBy() *PlaceRevisionUpsert {
+	u.SetExcluded(placerevision.FieldDeletedBy)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PlaceRevisionUpsert) ClearDeletedBy() *PlaceRevisionUpsert {
+	u.SetNull(placerevision.FieldDeletedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PlaceRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(placerevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PlaceRevisionUpsertOne) UpdateNewValues() *PlaceRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(placerevision.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(placerevision.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(placerevision.FieldCreatedBy)
+		}
+		if _, exists := u.create.mutation.PlaceID(); exists {
+			s.SetIgnore(placerevision.FieldPlaceID)
+		}
+		if _, exists := u.create.mutation.Revision(); exists {
+			s.SetIgnore(placerevision.FieldRevision)
+		}
+		if _, exists := u.create.mutation.Snapshot(); exists {
+			s.SetIgnore(placerevision.FieldSnapshot)
+		}
+		if _, exists := u.create.mutation.RevertedFrom(); exists {
+			s.SetIgnore(placerevision.FieldRevertedFrom)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PlaceRevision.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PlaceRevisionUpsertOne) Ignore() *PlaceRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PlaceRevisionUpsertOne) DoNothing() *PlaceRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PlaceRevisionCreate.OnConflict
+// documentation for more info.
+func (u *PlaceRevisionUpsertOne) Update(set func(*PlaceRevisionUpsert)) *PlaceRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PlaceRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PlaceRevisionUpsertOne) SetStatus(v types.Status) *PlaceRevisionUpsertOne {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PlaceRevisionUpsertOne) UpdateStatus() *PlaceRevisionUpsertOne {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlaceRevisionUpsertOne) SetUpdatedAt(v time.Time) *PlaceRevisionUpsertOne {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlaceRevisionUpsertOne) UpdateUpdatedAt() *PlaceRevisionUpsertOne {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *PlaceRevisionUpsertOne) SetUpdatedBy(v string) *PlaceRevisionUpsertOne {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *PlaceRevisionUpsertOne) UpdateUpdatedBy() *PlaceRevisionUpsertOne {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *PlaceRevisionUpsertOne) ClearUpdatedBy() *PlaceRevisionUpsertOne {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PlaceRevisionUpsertOne) SetDeletedAt(v time.Time) *PlaceRevisionUpsertOne {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PlaceRevisionUpsertOne) UpdateDeletedAt() *PlaceRevisionUpsertOne {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PlaceRevisionUpsertOne) ClearDeletedAt() *PlaceRevisionUpsertOne {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PlaceRevisionUpsertOne) SetDeletedBy(v string) *PlaceRevisionUpsertOne {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PlaceRevisionUpsertOne) UpdateDeletedBy() *PlaceRevisionUpsertOne {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PlaceRevisionUpsertOne) ClearDeletedBy() *PlaceRevisionUpsertOne {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *PlaceRevisionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PlaceRevisionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PlaceRevisionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PlaceRevisionUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PlaceRevisionUpsertOne.ID is not supported by MySQL driver. Use PlaceRevisionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PlaceRevisionUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PlaceRevisionCreateBulk is the builder for creating many PlaceRevision entities in bulk.
+type PlaceRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*PlaceRevisionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PlaceRevision entities in the database.
+func (_c *PlaceRevisionCreateBulk) Save(ctx context.Context) ([]*PlaceRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PlaceRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlaceRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PlaceRevisionCreateBulk) SaveX(ctx context.Context) []*PlaceRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlaceRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlaceRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PlaceRevision.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PlaceRevisionUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PlaceRevisionCreateBulk) OnConflict(opts ...sql.ConflictOption) *PlaceRevisionUpsertBulk {
+	_c.conflict = opts
+	return &PlaceRevisionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PlaceRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PlaceRevisionCreateBulk) OnConflictColumns(columns ...string) *PlaceRevisionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PlaceRevisionUpsertBulk{
+		create: _c,
+	}
+}
+
+// PlaceRevisionUpsertBulk is the builder for "upsert"-ing
+// a bulk of PlaceRevision nodes.
+type PlaceRevisionUpsertBulk struct {
+	create *PlaceRevisionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PlaceRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(placerevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PlaceRevisionUpsertBulk) UpdateNewValues() *PlaceRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(placerevision.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(placerevision.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(placerevision.FieldCreatedBy)
+			}
+			if _, exists := b.mutation.PlaceID(); exists {
+				s.SetIgnore(placerevision.FieldPlaceID)
+			}
+			if _, exists := b.mutation.Revision(); exists {
+				s.SetIgnore(placerevision.FieldRevision)
+			}
+			if _, exists := b.mutation.Snapshot(); exists {
+				s.SetIgnore(placerevision.FieldSnapshot)
+			}
+			if _, exists := b.mutation.RevertedFrom(); exists {
+				s.SetIgnore(placerevision.FieldRevertedFrom)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PlaceRevision.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PlaceRevisionUpsertBulk) Ignore() *PlaceRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PlaceRevisionUpsertBulk) DoNothing() *PlaceRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PlaceRevisionCreateBulk.OnConflict
+// documentation for more info.
+func (u *PlaceRevisionUpsertBulk) Update(set func(*PlaceRevisionUpsert)) *PlaceRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PlaceRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PlaceRevisionUpsertBulk) SetStatus(v types.Status) *PlaceRevisionUpsertBulk {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PlaceRevisionUpsertBulk) UpdateStatus() *PlaceRevisionUpsertBulk {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlaceRevisionUpsertBulk) SetUpdatedAt(v time.Time) *PlaceRevisionUpsertBulk {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlaceRevisionUpsertBulk) UpdateUpdatedAt() *PlaceRevisionUpsertBulk {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *PlaceRevisionUpsertBulk) SetUpdatedBy(v string) *PlaceRevisionUpsertBulk {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *PlaceRevisionUpsertBulk) UpdateUpdatedBy() *PlaceRevisionUpsertBulk {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *PlaceRevisionUpsertBulk) ClearUpdatedBy() *PlaceRevisionUpsertBulk {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PlaceRevisionUpsertBulk) SetDeletedAt(v time.Time) *PlaceRevisionUpsertBulk {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PlaceRevisionUpsertBulk) UpdateDeletedAt() *PlaceRevisionUpsertBulk {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PlaceRevisionUpsertBulk) ClearDeletedAt() *PlaceRevisionUpsertBulk {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PlaceRevisionUpsertBulk) SetDeletedBy(v string) *PlaceRevisionUpsertBulk {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PlaceRevisionUpsertBulk) UpdateDeletedBy() *PlaceRevisionUpsertBulk {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PlaceRevisionUpsertBulk) ClearDeletedBy() *PlaceRevisionUpsertBulk {
+	return u.Update(func(s *PlaceRevisionUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *PlaceRevisionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PlaceRevisionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PlaceRevisionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PlaceRevisionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,459 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/nashikdarshan/ent/placerevision"
+	"github.com/omkar273/nashikdarshan/ent/predicate"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// PlaceRevisionUpdate is the builder for updating PlaceRevision entities.
+type PlaceRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlaceRevisionMutation
+}
+
+// Where appends a list predicates to the PlaceRevisionUpdate builder.
+func (_u *PlaceRevisionUpdate) Where(ps ...predicate.PlaceRevision) *PlaceRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PlaceRevisionUpdate) SetStatus(v types.Status) *PlaceRevisionUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PlaceRevisionUpdate) SetNillableStatus(v *types.Status) *PlaceRevisionUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PlaceRevisionUpdate) SetUpdatedAt(v time.Time) *PlaceRevisionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *PlaceRevisionUpdate) SetUpdatedBy(v string) *PlaceRevisionUpdate {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *PlaceRevisionUpdate) SetNillableUpdatedBy(v *string) *PlaceRevisionUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *PlaceRevisionUpdate) ClearUpdatedBy() *PlaceRevisionUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PlaceRevisionUpdate) SetDeletedAt(v time.Time) *PlaceRevisionUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PlaceRevisionUpdate) SetNillableDeletedAt(v *time.Time) *PlaceRevisionUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *PlaceRevisionUpdate) ClearDeletedAt() *PlaceRevisionUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *PlaceRevisionUpdate) SetDeletedBy(v string) *PlaceRevisionUpdate {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *PlaceRevisionUpdate) SetNillableDeletedBy(v *string) *PlaceRevisionUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *PlaceRevisionUpdate) ClearDeletedBy() *PlaceRevisionUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// Mutation returns the PlaceRevisionMutation object of the builder.
+func (_u *PlaceRevisionUpdate) Mutation() *PlaceRevisionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PlaceRevisionUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlaceRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PlaceRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlaceRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PlaceRevisionUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if placerevision.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized placerevision.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := placerevision.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlaceRevisionUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := placerevision.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PlaceRevision.status": %w`, err)}
+		}
+	}
+	if _u.mutation.PlaceCleared() && len(_u.mutation.PlaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PlaceRevision.place"`)
+	}
+	return nil
+}
+
+func (_u *PlaceRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(placerevision.Table, placerevision.Columns, sqlgraph.NewFieldSpec(placerevision.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(placerevision.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(placerevision.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(placerevision.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(placerevision.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(placerevision.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(placerevision.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(placerevision.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(placerevision.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(placerevision.FieldDeletedBy, field.TypeString)
+	}
+	if _u.mutation.RevertedFromCleared() {
+		_spec.ClearField(placerevision.FieldRevertedFrom, field.TypeInt)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{placerevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PlaceRevisionUpdateOne is the builder for updating a single PlaceRevision entity.
+type PlaceRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlaceRevisionMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *PlaceRevisionUpdateOne) SetStatus(v types.Status) *PlaceRevisionUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PlaceRevisionUpdateOne) SetNillableStatus(v *types.Status) *PlaceRevisionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PlaceRevisionUpdateOne) SetUpdatedAt(v time.Time) *PlaceRevisionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *PlaceRevisionUpdateOne) SetUpdatedBy(v string) *PlaceRevisionUpdateOne {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *PlaceRevisionUpdateOne) SetNillableUpdatedBy(v *string) *PlaceRevisionUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *PlaceRevisionUpdateOne) ClearUpdatedBy() *PlaceRevisionUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PlaceRevisionUpdateOne) SetDeletedAt(v time.Time) *PlaceRevisionUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PlaceRevisionUpdateOne) SetNillableDeletedAt(v *time.Time) *PlaceRevisionUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *PlaceRevisionUpdateOne) ClearDeletedAt() *PlaceRevisionUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *PlaceRevisionUpdateOne) SetDeletedBy(v string) *PlaceRevisionUpdateOne {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *PlaceRevisionUpdateOne) SetNillableDeletedBy(v *string) *PlaceRevisionUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *PlaceRevisionUpdateOne) ClearDeletedBy() *PlaceRevisionUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// Mutation returns the PlaceRevisionMutation object of the builder.
+func (_u *PlaceRevisionUpdateOne) Mutation() *PlaceRevisionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PlaceRevisionUpdate builder.
+func (_u *PlaceRevisionUpdateOne) Where(ps ...predicate.PlaceRevision) *PlaceRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PlaceRevisionUpdateOne) Select(field string, fields ...string) *PlaceRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PlaceRevision entity.
+func (_u *PlaceRevisionUpdateOne) Save(ctx context.Context) (*PlaceRevision, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlaceRevisionUpdateOne) SaveX(ctx context.Context) *PlaceRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PlaceRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlaceRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PlaceRevisionUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if placerevision.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized placerevision.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := placerevision.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlaceRevisionUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := placerevision.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PlaceRevision.status": %w`, err)}
+		}
+	}
+	if _u.mutation.PlaceCleared() && len(_u.mutation.PlaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PlaceRevision.place"`)
+	}
+	return nil
+}
+
+func (_u *PlaceRevisionUpdateOne) sqlSave(ctx context.Context) (_node *PlaceRevision, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(placerevision.Table, placerevision.Columns, sqlgraph.NewFieldSpec(placerevision.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PlaceRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, placerevision.FieldID)
+		for _, f := range fields {
+			if !placerevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != placerevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(placerevision.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(placerevision.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(placerevision.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(placerevision.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(placerevision.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(placerevision.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(placerevision.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(placerevision.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(placerevision.FieldDeletedBy, field.TypeString)
+	}
+	if _u.mutation.RevertedFromCleared() {
+		_spec.ClearField(placerevision.FieldRevertedFrom, field.TypeInt)
+	}
+	_node = &PlaceRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{placerevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
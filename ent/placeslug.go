@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/nashikdarshan/ent/place"
+	"github.com/omkar273/nashikdarshan/ent/placeslug"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// PlaceSlug is the model entity for the PlaceSlug schema.
+type PlaceSlug struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status types.Status `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// DeletedBy holds the value of the "deleted_by" field.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// PlaceID holds the value of the "place_id" field.
+	PlaceID string `json:"place_id,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PlaceSlugQuery when eager-loading is set.
+	Edges        PlaceSlugEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PlaceSlugEdges holds the relations/edges for other nodes in the graph.
+type PlaceSlugEdges struct {
+	// Place holds the value of the place edge.
+	Place *Place `json:"place,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PlaceOrErr returns the Place value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlaceSlugEdges) PlaceOrErr() (*Place, error) {
+	if e.Place != nil {
+		return e.Place, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: place.Label}
+	}
+	return nil, &NotLoadedError{edge: "place"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PlaceSlug) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case placeslug.FieldID, placeslug.FieldCreatedBy, placeslug.FieldUpdatedBy, placeslug.FieldDeletedBy, placeslug.FieldPlaceID, placeslug.FieldSlug:
+			values[i] = new(sql.NullString)
+		case placeslug.FieldCreatedAt, placeslug.FieldUpdatedAt, placeslug.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case placeslug.FieldStatus:
+			values[i] = new(types.Status)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PlaceSlug fields.
+func (_m *PlaceSlug) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case placeslug.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case placeslug.FieldStatus:
+			if value, ok := values[i].(*types.Status); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value != nil {
+				_m.Status = *value
+			}
+		case placeslug.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case placeslug.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case placeslug.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case placeslug.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = value.String
+			}
+		case placeslug.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case placeslug.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = value.String
+			}
+		case placeslug.FieldPlaceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field place_id", values[i])
+			} else if value.Valid {
+				_m.PlaceID = value.String
+			}
+		case placeslug.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				_m.Slug = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PlaceSlug.
+// This includes values selected through modifiers, order, etc.
+func (_m *PlaceSlug) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPlace queries the "place" edge of the PlaceSlug entity.
+func (_m *PlaceSlug) QueryPlace() *PlaceQuery {
+	return NewPlaceSlugClient(_m.config).QueryPlace(_m)
+}
+
+// Update returns a builder for updating this PlaceSlug.
+// Note that you need to call PlaceSlug.Unwrap() before calling this method if this PlaceSlug
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PlaceSlug) Update() *PlaceSlugUpdateOne {
+	return NewPlaceSlugClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PlaceSlug entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PlaceSlug) Unwrap() *PlaceSlug {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PlaceSlug is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PlaceSlug) String() string {
+	var builder strings.Builder
+	builder.WriteString("PlaceSlug(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(_m.UpdatedBy)
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(_m.DeletedBy)
+	builder.WriteString(", ")
+	builder.WriteString("place_id=")
+	builder.WriteString(_m.PlaceID)
+	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(_m.Slug)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PlaceSlugs is a parsable slice of PlaceSlug.
+type PlaceSlugs []*PlaceSlug
@@ -0,0 +1,705 @@
+// Code generated by ent, DO NOT EDIT.
+
+package placeslug
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/omkar273/nashikdarshan/ent/predicate"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldContainsFold(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v types.Status) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// PlaceID applies equality check predicate on the "place_id" field. It's identical to PlaceIDEQ.
+func PlaceID(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldPlaceID, v))
+}
+
+// Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
+func Slug(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldSlug, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v types.Status) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v types.Status) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...types.Status) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...types.Status) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v types.Status) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v types.Status) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v types.Status) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v types.Status) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v types.Status) predicate.PlaceSlug {
+	vc := string(v)
+	return predicate.PlaceSlug(sql.FieldContains(FieldStatus, vc))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v types.Status) predicate.PlaceSlug {
+	vc := string(v)
+	return predicate.PlaceSlug(sql.FieldHasPrefix(FieldStatus, vc))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v types.Status) predicate.PlaceSlug {
+	vc := string(v)
+	return predicate.PlaceSlug(sql.FieldHasSuffix(FieldStatus, vc))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v types.Status) predicate.PlaceSlug {
+	vc := string(v)
+	return predicate.PlaceSlug(sql.FieldEqualFold(FieldStatus, vc))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v types.Status) predicate.PlaceSlug {
+	vc := string(v)
+	return predicate.PlaceSlug(sql.FieldContainsFold(FieldStatus, vc))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByContains applies the Contains predicate on the "deleted_by" field.
+func DeletedByContains(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldContains(FieldDeletedBy, v))
+}
+
+// DeletedByHasPrefix applies the HasPrefix predicate on the "deleted_by" field.
+func DeletedByHasPrefix(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldHasPrefix(FieldDeletedBy, v))
+}
+
+// DeletedByHasSuffix applies the HasSuffix predicate on the "deleted_by" field.
+func DeletedByHasSuffix(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldHasSuffix(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// DeletedByEqualFold applies the EqualFold predicate on the "deleted_by" field.
+func DeletedByEqualFold(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEqualFold(FieldDeletedBy, v))
+}
+
+// DeletedByContainsFold applies the ContainsFold predicate on the "deleted_by" field.
+func DeletedByContainsFold(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldContainsFold(FieldDeletedBy, v))
+}
+
+// PlaceIDEQ applies the EQ predicate on the "place_id" field.
+func PlaceIDEQ(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldPlaceID, v))
+}
+
+// PlaceIDNEQ applies the NEQ predicate on the "place_id" field.
+func PlaceIDNEQ(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNEQ(FieldPlaceID, v))
+}
+
+// PlaceIDIn applies the In predicate on the "place_id" field.
+func PlaceIDIn(vs ...string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldIn(FieldPlaceID, vs...))
+}
+
+// PlaceIDNotIn applies the NotIn predicate on the "place_id" field.
+func PlaceIDNotIn(vs ...string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNotIn(FieldPlaceID, vs...))
+}
+
+// PlaceIDGT applies the GT predicate on the "place_id" field.
+func PlaceIDGT(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGT(FieldPlaceID, v))
+}
+
+// PlaceIDGTE applies the GTE predicate on the "place_id" field.
+func PlaceIDGTE(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGTE(FieldPlaceID, v))
+}
+
+// PlaceIDLT applies the LT predicate on the "place_id" field.
+func PlaceIDLT(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLT(FieldPlaceID, v))
+}
+
+// PlaceIDLTE applies the LTE predicate on the "place_id" field.
+func PlaceIDLTE(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLTE(FieldPlaceID, v))
+}
+
+// PlaceIDContains applies the Contains predicate on the "place_id" field.
+func PlaceIDContains(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldContains(FieldPlaceID, v))
+}
+
+// PlaceIDHasPrefix applies the HasPrefix predicate on the "place_id" field.
+func PlaceIDHasPrefix(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldHasPrefix(FieldPlaceID, v))
+}
+
+// PlaceIDHasSuffix applies the HasSuffix predicate on the "place_id" field.
+func PlaceIDHasSuffix(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldHasSuffix(FieldPlaceID, v))
+}
+
+// PlaceIDEqualFold applies the EqualFold predicate on the "place_id" field.
+func PlaceIDEqualFold(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEqualFold(FieldPlaceID, v))
+}
+
+// PlaceIDContainsFold applies the ContainsFold predicate on the "place_id" field.
+func PlaceIDContainsFold(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldContainsFold(FieldPlaceID, v))
+}
+
+// SlugEQ applies the EQ predicate on the "slug" field.
+func SlugEQ(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEQ(FieldSlug, v))
+}
+
+// SlugNEQ applies the NEQ predicate on the "slug" field.
+func SlugNEQ(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNEQ(FieldSlug, v))
+}
+
+// SlugIn applies the In predicate on the "slug" field.
+func SlugIn(vs ...string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldIn(FieldSlug, vs...))
+}
+
+// SlugNotIn applies the NotIn predicate on the "slug" field.
+func SlugNotIn(vs ...string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldNotIn(FieldSlug, vs...))
+}
+
+// SlugGT applies the GT predicate on the "slug" field.
+func SlugGT(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGT(FieldSlug, v))
+}
+
+// SlugGTE applies the GTE predicate on the "slug" field.
+func SlugGTE(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldGTE(FieldSlug, v))
+}
+
+// SlugLT applies the LT predicate on the "slug" field.
+func SlugLT(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLT(FieldSlug, v))
+}
+
+// SlugLTE applies the LTE predicate on the "slug" field.
+func SlugLTE(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldLTE(FieldSlug, v))
+}
+
+// SlugContains applies the Contains predicate on the "slug" field.
+func SlugContains(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldContains(FieldSlug, v))
+}
+
+// SlugHasPrefix applies the HasPrefix predicate on the "slug" field.
+func SlugHasPrefix(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldHasPrefix(FieldSlug, v))
+}
+
+// SlugHasSuffix applies the HasSuffix predicate on the "slug" field.
+func SlugHasSuffix(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldHasSuffix(FieldSlug, v))
+}
+
+// SlugEqualFold applies the EqualFold predicate on the "slug" field.
+func SlugEqualFold(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldEqualFold(FieldSlug, v))
+}
+
+// SlugContainsFold applies the ContainsFold predicate on the "slug" field.
+func SlugContainsFold(v string) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.FieldContainsFold(FieldSlug, v))
+}
+
+// HasPlace applies the HasEdge predicate on the "place" edge.
+func HasPlace() predicate.PlaceSlug {
+	return predicate.PlaceSlug(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlaceTable, PlaceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlaceWith applies the HasEdge predicate on the "place" edge with a given conditions (other predicates).
+func HasPlaceWith(preds ...predicate.Place) predicate.PlaceSlug {
+	return predicate.PlaceSlug(func(s *sql.Selector) {
+		step := newPlaceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PlaceSlug) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PlaceSlug) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PlaceSlug) predicate.PlaceSlug {
+	return predicate.PlaceSlug(sql.NotPredicates(p))
+}
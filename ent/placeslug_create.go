@@ -0,0 +1,962 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/nashikdarshan/ent/place"
+	"github.com/omkar273/nashikdarshan/ent/placeslug"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// PlaceSlugCreate is the builder for creating a PlaceSlug entity.
+type PlaceSlugCreate struct {
+	config
+	mutation *PlaceSlugMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetStatus sets the "status" field.
+func (_c *PlaceSlugCreate) SetStatus(v types.Status) *PlaceSlugCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PlaceSlugCreate) SetNillableStatus(v *types.Status) *PlaceSlugCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PlaceSlugCreate) SetCreatedAt(v time.Time) *PlaceSlugCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PlaceSlugCreate) SetNillableCreatedAt(v *time.Time) *PlaceSlugCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PlaceSlugCreate) SetUpdatedAt(v time.Time) *PlaceSlugCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PlaceSlugCreate) SetNillableUpdatedAt(v *time.Time) *PlaceSlugCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *PlaceSlugCreate) SetCreatedBy(v string) *PlaceSlugCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *PlaceSlugCreate) SetNillableCreatedBy(v *string) *PlaceSlugCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *PlaceSlugCreate) SetUpdatedBy(v string) *PlaceSlugCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *PlaceSlugCreate) SetNillableUpdatedBy(v *string) *PlaceSlugCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *PlaceSlugCreate) SetDeletedAt(v time.Time) *PlaceSlugCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *PlaceSlugCreate) SetNillableDeletedAt(v *time.Time) *PlaceSlugCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *PlaceSlugCreate) SetDeletedBy(v string) *PlaceSlugCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *PlaceSlugCreate) SetNillableDeletedBy(v *string) *PlaceSlugCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetPlaceID sets the "place_id" field.
+func (_c *PlaceSlugCreate) SetPlaceID(v string) *PlaceSlugCreate {
+	_c.mutation.SetPlaceID(v)
+	return _c
+}
+
+// SetSlug sets the "slug" field.
+func (_c *PlaceSlugCreate) SetSlug(v string) *PlaceSlugCreate {
+	_c.mutation.SetSlug(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PlaceSlugCreate) SetID(v string) *PlaceSlugCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PlaceSlugCreate) SetNillableID(v *string) *PlaceSlugCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPlace sets the "place" edge to the Place entity.
+func (_c *PlaceSlugCreate) SetPlace(v *Place) *PlaceSlugCreate {
+	return _c.SetPlaceID(v.ID)
+}
+
+// Mutation returns the PlaceSlugMutation object of the builder.
+func (_c *PlaceSlugCreate) Mutation() *PlaceSlugMutation {
+	return _c.mutation
+}
+
+// Save creates the PlaceSlug in the database.
+func (_c *PlaceSlugCreate) Save(ctx context.Context) (*PlaceSlug, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PlaceSlugCreate) SaveX(ctx context.Context) *PlaceSlug {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlaceSlugCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlaceSlugCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PlaceSlugCreate) defaults() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := placeslug.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if placeslug.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized placeslug.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := placeslug.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if placeslug.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized placeslug.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := placeslug.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if placeslug.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized placeslug.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := placeslug.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PlaceSlugCreate) check() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PlaceSlug.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := placeslug.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PlaceSlug.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PlaceSlug.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PlaceSlug.updated_at"`)}
+	}
+	if _, ok := _c.mutation.PlaceID(); !ok {
+		return &ValidationError{Name: "place_id", err: errors.New(`ent: missing required field "PlaceSlug.place_id"`)}
+	}
+	if v, ok := _c.mutation.PlaceID(); ok {
+		if err := placeslug.PlaceIDValidator(v); err != nil {
+			return &ValidationError{Name: "place_id", err: fmt.Errorf(`ent: validator failed for field "PlaceSlug.place_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "PlaceSlug.slug"`)}
+	}
+	if v, ok := _c.mutation.Slug(); ok {
+		if err := placeslug.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "PlaceSlug.slug": %w`, err)}
+		}
+	}
+	if len(_c.mutation.PlaceIDs()) == 0 {
+		return &ValidationError{Name: "place", err: errors.New(`ent: missing required edge "PlaceSlug.place"`)}
+	}
+	return nil
+}
+
+func (_c *PlaceSlugCreate) sqlSave(ctx context.Context) (*PlaceSlug, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PlaceSlug.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PlaceSlugCreate) createSpec() (*PlaceSlug, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PlaceSlug{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(placeslug.Table, sqlgraph.NewFieldSpec(placeslug.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(placeslug.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(placeslug.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(placeslug.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(placeslug.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(placeslug.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(placeslug.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(placeslug.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := _c.mutation.Slug(); ok {
+		_spec.SetField(placeslug.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if nodes := _c.mutation.PlaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   placeslug.PlaceTable,
+			Columns: []string{placeslug.PlaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(place.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PlaceSlug.Create().
+//		SetStatus(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PlaceSlugUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PlaceSlugCreate) OnConflict(opts ...sql.ConflictOption) *PlaceSlugUpsertOne {
+	_c.conflict = opts
+	return &PlaceSlugUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PlaceSlug.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PlaceSlugCreate) OnConflictColumns(columns ...string) *PlaceSlugUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PlaceSlugUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PlaceSlugUpsertOne is the builder for "upsert"-ing
+	//  one PlaceSlug node.
+	PlaceSlugUpsertOne struct {
+		create *PlaceSlugCreate
+	}
+
+	// PlaceSlugUpsert is the "OnConflict" setter.
+	PlaceSlugUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *PlaceSlugUpsert) SetStatus(v types.Status) *PlaceSlugUpsert {
+	u.Set(placeslug.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PlaceSlugUpsert) UpdateStatus() *PlaceSlugUpsert {
+	u.SetExcluded(placeslug.FieldStatus)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlaceSlugUpsert) SetUpdatedAt(v time.Time) *PlaceSlugUpsert {
+	u.Set(placeslug.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlaceSlugUpsert) UpdateUpdatedAt() *PlaceSlugUpsert {
+	u.SetExcluded(placeslug.FieldUpdatedAt)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *PlaceSlugUpsert) SetUpdatedBy(v string) *PlaceSlugUpsert {
+	u.Set(placeslug.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *PlaceSlugUpsert) UpdateUpdatedBy() *PlaceSlugUpsert {
+	u.SetExcluded(placeslug.FieldUpdatedBy)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *PlaceSlugUpsert) ClearUpdatedBy() *PlaceSlugUpsert {
+	u.SetNull(placeslug.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PlaceSlugUpsert) SetDeletedAt(v time.Time) *PlaceSlugUpsert {
+	u.Set(placeslug.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PlaceSlugUpsert) UpdateDeletedAt() *PlaceSlugUpsert {
+	u.SetExcluded(placeslug.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PlaceSlugUpsert) ClearDeletedAt() *PlaceSlugUpsert {
+	u.SetNull(placeslug.FieldDeletedAt)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PlaceSlugUpsert) SetDeletedBy(v string) *PlaceSlugUpsert {
+	u.Set(placeslug.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PlaceSlugUpsert) UpdateDeletedBy() *PlaceSlugUpsert {
+	u.SetExcluded(placeslug.FieldDeletedBy)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PlaceSlugUpsert) ClearDeletedBy() *PlaceSlugUpsert {
+	u.SetNull(placeslug.FieldDeletedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PlaceSlug.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(placeslug.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PlaceSlugUpsertOne) UpdateNewValues() *PlaceSlugUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(placeslug.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(placeslug.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(placeslug.FieldCreatedBy)
+		}
+		if _, exists := u.create.mutation.PlaceID(); exists {
+			s.SetIgnore(placeslug.FieldPlaceID)
+		}
+		if _, exists := u.create.mutation.Slug(); exists {
+			s.SetIgnore(placeslug.FieldSlug)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PlaceSlug.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PlaceSlugUpsertOne) Ignore() *PlaceSlugUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PlaceSlugUpsertOne) DoNothing() *PlaceSlugUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PlaceSlugCreate.OnConflict
+// documentation for more info.
+func (u *PlaceSlugUpsertOne) Update(set func(*PlaceSlugUpsert)) *PlaceSlugUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PlaceSlugUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PlaceSlugUpsertOne) SetStatus(v types.Status) *PlaceSlugUpsertOne {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PlaceSlugUpsertOne) UpdateStatus() *PlaceSlugUpsertOne {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlaceSlugUpsertOne) SetUpdatedAt(v time.Time) *PlaceSlugUpsertOne {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlaceSlugUpsertOne) UpdateUpdatedAt() *PlaceSlugUpsertOne {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *PlaceSlugUpsertOne) SetUpdatedBy(v string) *PlaceSlugUpsertOne {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *PlaceSlugUpsertOne) UpdateUpdatedBy() *PlaceSlugUpsertOne {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *PlaceSlugUpsertOne) ClearUpdatedBy() *PlaceSlugUpsertOne {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PlaceSlugUpsertOne) SetDeletedAt(v time.Time) *PlaceSlugUpsertOne {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PlaceSlugUpsertOne) UpdateDeletedAt() *PlaceSlugUpsertOne {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PlaceSlugUpsertOne) ClearDeletedAt() *PlaceSlugUpsertOne {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PlaceSlugUpsertOne) SetDeletedBy(v string) *PlaceSlugUpsertOne {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PlaceSlugUpsertOne) UpdateDeletedBy() *PlaceSlugUpsertOne {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PlaceSlugUpsertOne) ClearDeletedBy() *PlaceSlugUpsertOne {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *PlaceSlugUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PlaceSlugCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PlaceSlugUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PlaceSlugUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PlaceSlugUpsertOne.ID is not supported by MySQL driver. Use PlaceSlugUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PlaceSlugUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PlaceSlugCreateBulk is the builder for creating many PlaceSlug entities in bulk.
+type PlaceSlugCreateBulk struct {
+	config
+	err      error
+	builders []*PlaceSlugCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PlaceSlug entities in the database.
+func (_c *PlaceSlugCreateBulk) Save(ctx context.Context) ([]*PlaceSlug, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PlaceSlug, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlaceSlugMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PlaceSlugCreateBulk) SaveX(ctx context.Context) []*PlaceSlug {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlaceSlugCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlaceSlugCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PlaceSlug.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PlaceSlugUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PlaceSlugCreateBulk) OnConflict(opts ...sql.ConflictOption) *PlaceSlugUpsertBulk {
+	_c.conflict = opts
+	return &PlaceSlugUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PlaceSlug.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PlaceSlugCreateBulk) OnConflictColumns(columns ...string) *PlaceSlugUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PlaceSlugUpsertBulk{
+		create: _c,
+	}
+}
+
+// PlaceSlugUpsertBulk is the builder for "upsert"-ing
+// a bulk of PlaceSlug nodes.
+type PlaceSlugUpsertBulk struct {
+	create *PlaceSlugCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PlaceSlug.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(placeslug.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PlaceSlugUpsertBulk) UpdateNewValues() *PlaceSlugUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(placeslug.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(placeslug.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(placeslug.FieldCreatedBy)
+			}
+			if _, exists := b.mutation.PlaceID(); exists {
+				s.SetIgnore(placeslug.FieldPlaceID)
+			}
+			if _, exists := b.mutation.Slug(); exists {
+				s.SetIgnore(placeslug.FieldSlug)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PlaceSlug.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PlaceSlugUpsertBulk) Ignore() *PlaceSlugUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PlaceSlugUpsertBulk) DoNothing() *PlaceSlugUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PlaceSlugCreateBulk.OnConflict
+// documentation for more info.
+func (u *PlaceSlugUpsertBulk) Update(set func(*PlaceSlugUpsert)) *PlaceSlugUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PlaceSlugUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PlaceSlugUpsertBulk) SetStatus(v types.Status) *PlaceSlugUpsertBulk {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PlaceSlugUpsertBulk) UpdateStatus() *PlaceSlugUpsertBulk {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlaceSlugUpsertBulk) SetUpdatedAt(v time.Time) *PlaceSlugUpsertBulk {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlaceSlugUpsertBulk) UpdateUpdatedAt() *PlaceSlugUpsertBulk {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *PlaceSlugUpsertBulk) SetUpdatedBy(v string) *PlaceSlugUpsertBulk {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *PlaceSlugUpsertBulk) UpdateUpdatedBy() *PlaceSlugUpsertBulk {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *PlaceSlugUpsertBulk) ClearUpdatedBy() *PlaceSlugUpsertBulk {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PlaceSlugUpsertBulk) SetDeletedAt(v time.Time) *PlaceSlugUpsertBulk {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PlaceSlugUpsertBulk) UpdateDeletedAt() *PlaceSlugUpsertBulk {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PlaceSlugUpsertBulk) ClearDeletedAt() *PlaceSlugUpsertBulk {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PlaceSlugUpsertBulk) SetDeletedBy(v string) *PlaceSlugUpsertBulk {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PlaceSlugUpsertBulk) UpdateDeletedBy() *PlaceSlugUpsertBulk {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PlaceSlugUpsertBulk) ClearDeletedBy() *PlaceSlugUpsertBulk {
+	return u.Update(func(s *PlaceSlugUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *PlaceSlugUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PlaceSlugCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PlaceSlugCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PlaceSlugUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
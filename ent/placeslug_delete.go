@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/nashikdarshan/ent/placeslug"
+	"github.com/omkar273/nashikdarshan/ent/predicate"
+)
+
+// PlaceSlugDelete is the builder for deleting a PlaceSlug entity.
+type PlaceSlugDelete struct {
+	config
+	hooks    []Hook
+	mutation *PlaceSlugMutation
+}
+
+// Where appends a list predicates to the PlaceSlugDelete builder.
+func (_d *PlaceSlugDelete) Where(ps ...predicate.PlaceSlug) *PlaceSlugDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *PlaceSlugDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PlaceSlugDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *PlaceSlugDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(placeslug.Table, sqlgraph.NewFieldSpec(placeslug.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// PlaceSlugDeleteOne is the builder for deleting a single PlaceSlug entity.
+type PlaceSlugDeleteOne struct {
+	_d *PlaceSlugDelete
+}
+
+// Where appends a list predicates to the PlaceSlugDelete builder.
+func (_d *PlaceSlugDeleteOne) Where(ps ...predicate.PlaceSlug) *PlaceSlugDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *PlaceSlugDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{placeslug.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PlaceSlugDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
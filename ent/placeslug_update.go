@@ -0,0 +1,453 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/nashikdarshan/ent/placeslug"
+	"github.com/omkar273/nashikdarshan/ent/predicate"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// PlaceSlugUpdate is the builder for updating PlaceSlug entities.
+type PlaceSlugUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlaceSlugMutation
+}
+
+// Where appends a list predicates to the PlaceSlugUpdate builder.
+func (_u *PlaceSlugUpdate) Where(ps ...predicate.PlaceSlug) *PlaceSlugUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PlaceSlugUpdate) SetStatus(v types.Status) *PlaceSlugUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PlaceSlugUpdate) SetNillableStatus(v *types.Status) *PlaceSlugUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PlaceSlugUpdate) SetUpdatedAt(v time.Time) *PlaceSlugUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *PlaceSlugUpdate) SetUpdatedBy(v string) *PlaceSlugUpdate {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *PlaceSlugUpdate) SetNillableUpdatedBy(v *string) *PlaceSlugUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *PlaceSlugUpdate) ClearUpdatedBy() *PlaceSlugUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PlaceSlugUpdate) SetDeletedAt(v time.Time) *PlaceSlugUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PlaceSlugUpdate) SetNillableDeletedAt(v *time.Time) *PlaceSlugUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *PlaceSlugUpdate) ClearDeletedAt() *PlaceSlugUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *PlaceSlugUpdate) SetDeletedBy(v string) *PlaceSlugUpdate {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *PlaceSlugUpdate) SetNillableDeletedBy(v *string) *PlaceSlugUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *PlaceSlugUpdate) ClearDeletedBy() *PlaceSlugUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// Mutation returns the PlaceSlugMutation object of the builder.
+func (_u *PlaceSlugUpdate) Mutation() *PlaceSlugMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PlaceSlugUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlaceSlugUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PlaceSlugUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlaceSlugUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PlaceSlugUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if placeslug.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized placeslug.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := placeslug.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlaceSlugUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := placeslug.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PlaceSlug.status": %w`, err)}
+		}
+	}
+	if _u.mutation.PlaceCleared() && len(_u.mutation.PlaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PlaceSlug.place"`)
+	}
+	return nil
+}
+
+func (_u *PlaceSlugUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(placeslug.Table, placeslug.Columns, sqlgraph.NewFieldSpec(placeslug.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(placeslug.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(placeslug.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(placeslug.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(placeslug.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(placeslug.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(placeslug.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(placeslug.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(placeslug.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(placeslug.FieldDeletedBy, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{placeslug.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PlaceSlugUpdateOne is the builder for updating a single PlaceSlug entity.
+type PlaceSlugUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlaceSlugMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *PlaceSlugUpdateOne) SetStatus(v types.Status) *PlaceSlugUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PlaceSlugUpdateOne) SetNillableStatus(v *types.Status) *PlaceSlugUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PlaceSlugUpdateOne) SetUpdatedAt(v time.Time) *PlaceSlugUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *PlaceSlugUpdateOne) SetUpdatedBy(v string) *PlaceSlugUpdateOne {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *PlaceSlugUpdateOne) SetNillableUpdatedBy(v *string) *PlaceSlugUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *PlaceSlugUpdateOne) ClearUpdatedBy() *PlaceSlugUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PlaceSlugUpdateOne) SetDeletedAt(v time.Time) *PlaceSlugUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PlaceSlugUpdateOne) SetNillableDeletedAt(v *time.Time) *PlaceSlugUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *PlaceSlugUpdateOne) ClearDeletedAt() *PlaceSlugUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *PlaceSlugUpdateOne) SetDeletedBy(v string) *PlaceSlugUpdateOne {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *PlaceSlugUpdateOne) SetNillableDeletedBy(v *string) *PlaceSlugUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *PlaceSlugUpdateOne) ClearDeletedBy() *PlaceSlugUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// Mutation returns the PlaceSlugMutation object of the builder.
+func (_u *PlaceSlugUpdateOne) Mutation() *PlaceSlugMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PlaceSlugUpdate builder.
+func (_u *PlaceSlugUpdateOne) Where(ps ...predicate.PlaceSlug) *PlaceSlugUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PlaceSlugUpdateOne) Select(field string, fields ...string) *PlaceSlugUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PlaceSlug entity.
+func (_u *PlaceSlugUpdateOne) Save(ctx context.Context) (*PlaceSlug, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlaceSlugUpdateOne) SaveX(ctx context.Context) *PlaceSlug {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PlaceSlugUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlaceSlugUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PlaceSlugUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if placeslug.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized placeslug.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := placeslug.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlaceSlugUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := placeslug.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PlaceSlug.status": %w`, err)}
+		}
+	}
+	if _u.mutation.PlaceCleared() && len(_u.mutation.PlaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PlaceSlug.place"`)
+	}
+	return nil
+}
+
+func (_u *PlaceSlugUpdateOne) sqlSave(ctx context.Context) (_node *PlaceSlug, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(placeslug.Table, placeslug.Columns, sqlgraph.NewFieldSpec(placeslug.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PlaceSlug.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, placeslug.FieldID)
+		for _, f := range fields {
+			if !placeslug.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != placeslug.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(placeslug.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(placeslug.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(placeslug.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(placeslug.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(placeslug.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(placeslug.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(placeslug.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(placeslug.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(placeslug.FieldDeletedBy, field.TypeString)
+	}
+	_node = &PlaceSlug{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{placeslug.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
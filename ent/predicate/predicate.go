@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
 // Category is the predicate function for category builders.
 type Category func(*sql.Selector)
 
@@ -21,12 +24,21 @@ type Hotel func(*sql.Selector)
 // Itinerary is the predicate function for itinerary builders.
 type Itinerary func(*sql.Selector)
 
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
 // Place is the predicate function for place builders.
 type Place func(*sql.Selector)
 
 // PlaceImage is the predicate function for placeimage builders.
 type PlaceImage func(*sql.Selector)
 
+// PlaceRevision is the predicate function for placerevision builders.
+type PlaceRevision func(*sql.Selector)
+
+// PlaceSlug is the predicate function for placeslug builders.
+type PlaceSlug func(*sql.Selector)
+
 // Review is the predicate function for review builders.
 type Review func(*sql.Selector)
 
@@ -8,9 +8,12 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/omkar273/nashikdarshan/ent/review"
+	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -19,16 +22,17 @@ type ReviewCreate struct {
 	config
 	mutation *ReviewMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetStatus sets the "status" field.
-func (_c *ReviewCreate) SetStatus(v string) *ReviewCreate {
+func (_c *ReviewCreate) SetStatus(v types.Status) *ReviewCreate {
 	_c.mutation.SetStatus(v)
 	return _c
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_c *ReviewCreate) SetNillableStatus(v *string) *ReviewCreate {
+func (_c *ReviewCreate) SetNillableStatus(v *types.Status) *ReviewCreate {
 	if v != nil {
 		_c.SetStatus(*v)
 	}
@@ -91,6 +95,34 @@ func (_c *ReviewCreate) SetNillableUpdatedBy(v *string) *ReviewCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ReviewCreate) SetDeletedAt(v time.Time) *ReviewCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ReviewCreate) SetNillableDeletedAt(v *time.Time) *ReviewCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *ReviewCreate) SetDeletedBy(v string) *ReviewCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *ReviewCreate) SetNillableDeletedBy(v *string) *ReviewCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
 // SetMetadata sets the "metadata" field.
 func (_c *ReviewCreate) SetMetadata(v map[string]string) *ReviewCreate {
 	_c.mutation.SetMetadata(v)
@@ -230,7 +262,9 @@ func (_c *ReviewCreate) Mutation() *ReviewMutation {
 
 // Save creates the Review in the database.
 func (_c *ReviewCreate) Save(ctx context.Context) (*Review, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -257,16 +291,22 @@ func (_c *ReviewCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *ReviewCreate) defaults() {
+func (_c *ReviewCreate) defaults() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		v := review.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if review.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized review.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := review.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if review.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized review.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := review.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
@@ -290,6 +330,7 @@ func (_c *ReviewCreate) defaults() {
 		v := review.DefaultIsFeatured
 		_c.mutation.SetIsFeatured(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -297,6 +338,11 @@ func (_c *ReviewCreate) check() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Review.status"`)}
 	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := review.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Review.status": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Review.created_at"`)}
 	}
@@ -388,6 +434,7 @@ func (_c *ReviewCreate) createSpec() (*Review, *sqlgraph.CreateSpec) {
 		_node = &Review{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(review.Table, sqlgraph.NewFieldSpec(review.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -412,6 +459,14 @@ func (_c *ReviewCreate) createSpec() (*Review, *sqlgraph.CreateSpec) {
 		_spec.SetField(review.FieldUpdatedBy, field.TypeString, value)
 		_node.UpdatedBy = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(review.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(review.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
 	if value, ok := _c.mutation.Metadata(); ok {
 		_spec.SetField(review.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -467,11 +522,751 @@ func (_c *ReviewCreate) createSpec() (*Review, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Review.Create().
+//		SetStatus(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReviewUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReviewCreate) OnConflict(opts ...sql.ConflictOption) *ReviewUpsertOne {
+	_c.conflict = opts
+	return &ReviewUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Review.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReviewCreate) OnConflictColumns(columns ...string) *ReviewUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReviewUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReviewUpsertOne is the builder for "upsert"-ing
+	//  one Review node.
+	ReviewUpsertOne struct {
+		create *ReviewCreate
+	}
+
+	// ReviewUpsert is the "OnConflict" setter.
+	ReviewUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *ReviewUpsert) SetStatus(v types.Status) *ReviewUpsert {
+	u.Set(review.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateStatus() *ReviewUpsert {
+	u.SetExcluded(review.FieldStatus)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReviewUpsert) SetUpdatedAt(v time.Time) *ReviewUpsert {
+	u.Set(review.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateUpdatedAt() *ReviewUpsert {
+	u.SetExcluded(review.FieldUpdatedAt)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *ReviewUpsert) SetUpdatedBy(v string) *ReviewUpsert {
+	u.Set(review.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateUpdatedBy() *ReviewUpsert {
+	u.SetExcluded(review.FieldUpdatedBy)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *ReviewUpsert) ClearUpdatedBy() *ReviewUpsert {
+	u.SetNull(review.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ReviewUpsert) SetDeletedAt(v time.Time) *ReviewUpsert {
+	u.Set(review.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateDeletedAt() *ReviewUpsert {
+	u.SetExcluded(review.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ReviewUpsert) ClearDeletedAt() *ReviewUpsert {
+	u.SetNull(review.FieldDeletedAt)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *ReviewUpsert) SetDeletedBy(v string) *ReviewUpsert {
+	u.Set(review.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateDeletedBy() *ReviewUpsert {
+	u.SetExcluded(review.FieldDeletedBy)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *ReviewUpsert) ClearDeletedBy() *ReviewUpsert {
+	u.SetNull(review.FieldDeletedBy)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *ReviewUpsert) SetMetadata(v map[string]string) *ReviewUpsert {
+	u.Set(review.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateMetadata() *ReviewUpsert {
+	u.SetExcluded(review.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *ReviewUpsert) ClearMetadata() *ReviewUpsert {
+	u.SetNull(review.FieldMetadata)
+	return u
+}
+
+// SetEntityType sets the "entity_type" field.
+func (u *ReviewUpsert) SetEntityType(v string) *ReviewUpsert {
+	u.Set(review.FieldEntityType, v)
+	return u
+}
+
+// UpdateEntityType sets the "entity_type" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateEntityType() *ReviewUpsert {
+	u.SetExcluded(review.FieldEntityType)
+	return u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (u *ReviewUpsert) SetEntityID(v string) *ReviewUpsert {
+	u.Set(review.FieldEntityID, v)
+	return u
+}
+
+// UpdateEntityID sets the "entity_id" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateEntityID() *ReviewUpsert {
+	u.SetExcluded(review.FieldEntityID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReviewUpsert) SetUserID(v string) *ReviewUpsert {
+	u.Set(review.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateUserID() *ReviewUpsert {
+	u.SetExcluded(review.FieldUserID)
+	return u
+}
+
+// SetRating sets the "rating" field.
+func (u *ReviewUpsert) SetRating(v decimal.Decimal) *ReviewUpsert {
+	u.Set(review.FieldRating, v)
+	return u
+}
+
+// UpdateRating sets the "rating" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateRating() *ReviewUpsert {
+	u.SetExcluded(review.FieldRating)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *ReviewUpsert) SetTitle(v string) *ReviewUpsert {
+	u.Set(review.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateTitle() *ReviewUpsert {
+	u.SetExcluded(review.FieldTitle)
+	return u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *ReviewUpsert) ClearTitle() *ReviewUpsert {
+	u.SetNull(review.FieldTitle)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *ReviewUpsert) SetContent(v string) *ReviewUpsert {
+	u.Set(review.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateContent() *ReviewUpsert {
+	u.SetExcluded(review.FieldContent)
+	return u
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *ReviewUpsert) ClearContent() *ReviewUpsert {
+	u.SetNull(review.FieldContent)
+	return u
+}
+
+// SetTags sets the "tags" field.
+func (u *ReviewUpsert) SetTags(v []string) *ReviewUpsert {
+	u.Set(review.FieldTags, v)
+	return u
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateTags() *ReviewUpsert {
+	u.SetExcluded(review.FieldTags)
+	return u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *ReviewUpsert) ClearTags() *ReviewUpsert {
+	u.SetNull(review.FieldTags)
+	return u
+}
+
+// SetImages sets the "images" field.
+func (u *ReviewUpsert) SetImages(v []string) *ReviewUpsert {
+	u.Set(review.FieldImages, v)
+	return u
+}
+
+// UpdateImages sets the "images" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateImages() *ReviewUpsert {
+	u.SetExcluded(review.FieldImages)
+	return u
+}
+
+// ClearImages clears the value of the "images" field.
+func (u *ReviewUpsert) ClearImages() *ReviewUpsert {
+	u.SetNull(review.FieldImages)
+	return u
+}
+
+// SetHelpfulCount sets the "helpful_count" field.
+func (u *ReviewUpsert) SetHelpfulCount(v int) *ReviewUpsert {
+	u.Set(review.FieldHelpfulCount, v)
+	return u
+}
+
+// UpdateHelpfulCount sets the "helpful_count" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateHelpfulCount() *ReviewUpsert {
+	u.SetExcluded(review.FieldHelpfulCount)
+	return u
+}
+
+// AddHelpfulCount adds v to the "helpful_count" field.
+func (u *ReviewUpsert) AddHelpfulCount(v int) *ReviewUpsert {
+	u.Add(review.FieldHelpfulCount, v)
+	return u
+}
+
+// SetNotHelpfulCount sets the "not_helpful_count" field.
+func (u *ReviewUpsert) SetNotHelpfulCount(v int) *ReviewUpsert {
+	u.Set(review.FieldNotHelpfulCount, v)
+	return u
+}
+
+// UpdateNotHelpfulCount sets the "not_helpful_count" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateNotHelpfulCount() *ReviewUpsert {
+	u.SetExcluded(review.FieldNotHelpfulCount)
+	return u
+}
+
+// AddNotHelpfulCount adds v to the "not_helpful_count" field.
+func (u *ReviewUpsert) AddNotHelpfulCount(v int) *ReviewUpsert {
+	u.Add(review.FieldNotHelpfulCount, v)
+	return u
+}
+
+// SetIsVerified sets the "is_verified" field.
+func (u *ReviewUpsert) SetIsVerified(v bool) *ReviewUpsert {
+	u.Set(review.FieldIsVerified, v)
+	return u
+}
+
+// UpdateIsVerified sets the "is_verified" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateIsVerified() *ReviewUpsert {
+	u.SetExcluded(review.FieldIsVerified)
+	return u
+}
+
+// SetIsFeatured sets the "is_featured" field.
+func (u *ReviewUpsert) SetIsFeatured(v bool) *ReviewUpsert {
+	u.Set(review.FieldIsFeatured, v)
+	return u
+}
+
+// UpdateIsFeatured sets the "is_featured" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateIsFeatured() *ReviewUpsert {
+	u.SetExcluded(review.FieldIsFeatured)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Review.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(review.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReviewUpsertOne) UpdateNewValues() *ReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(review.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(review.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(review.FieldCreatedBy)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Review.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReviewUpsertOne) Ignore() *ReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReviewUpsertOne) DoNothing() *ReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReviewCreate.OnConflict
+// documentation for more info.
+func (u *ReviewUpsertOne) Update(set func(*ReviewUpsert)) *ReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReviewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReviewUpsertOne) SetStatus(v types.Status) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateStatus() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReviewUpsertOne) SetUpdatedAt(v time.Time) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateUpdatedAt() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *ReviewUpsertOne) SetUpdatedBy(v string) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateUpdatedBy() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *ReviewUpsertOne) ClearUpdatedBy() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ReviewUpsertOne) SetDeletedAt(v time.Time) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateDeletedAt() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ReviewUpsertOne) ClearDeletedAt() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *ReviewUpsertOne) SetDeletedBy(v string) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateDeletedBy() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *ReviewUpsertOne) ClearDeletedBy() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *ReviewUpsertOne) SetMetadata(v map[string]string) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateMetadata() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *ReviewUpsertOne) ClearMetadata() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetEntityType sets the "entity_type" field.
+func (u *ReviewUpsertOne) SetEntityType(v string) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetEntityType(v)
+	})
+}
+
+// UpdateEntityType sets the "entity_type" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateEntityType() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateEntityType()
+	})
+}
+
+// SetEntityID sets the "entity_id" field.
+func (u *ReviewUpsertOne) SetEntityID(v string) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetEntityID(v)
+	})
+}
+
+// UpdateEntityID sets the "entity_id" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateEntityID() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateEntityID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReviewUpsertOne) SetUserID(v string) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateUserID() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetRating sets the "rating" field.
+func (u *ReviewUpsertOne) SetRating(v decimal.Decimal) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetRating(v)
+	})
+}
+
+// UpdateRating sets the "rating" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateRating() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateRating()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ReviewUpsertOne) SetTitle(v string) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateTitle() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *ReviewUpsertOne) ClearTitle() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ReviewUpsertOne) SetContent(v string) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateContent() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *ReviewUpsertOne) ClearContent() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *ReviewUpsertOne) SetTags(v []string) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateTags() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *ReviewUpsertOne) ClearTags() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearTags()
+	})
+}
+
+// SetImages sets the "images" field.
+func (u *ReviewUpsertOne) SetImages(v []string) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetImages(v)
+	})
+}
+
+// UpdateImages sets the "images" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateImages() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateImages()
+	})
+}
+
+// ClearImages clears the value of the "images" field.
+func (u *ReviewUpsertOne) ClearImages() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearImages()
+	})
+}
+
+// SetHelpfulCount sets the "helpful_count" field.
+func (u *ReviewUpsertOne) SetHelpfulCount(v int) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetHelpfulCount(v)
+	})
+}
+
+// AddHelpfulCount adds v to the "helpful_count" field.
+func (u *ReviewUpsertOne) AddHelpfulCount(v int) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.AddHelpfulCount(v)
+	})
+}
+
+// UpdateHelpfulCount sets the "helpful_count" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateHelpfulCount() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateHelpfulCount()
+	})
+}
+
+// SetNotHelpfulCount sets the "not_helpful_count" field.
+func (u *ReviewUpsertOne) SetNotHelpfulCount(v int) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetNotHelpfulCount(v)
+	})
+}
+
+// AddNotHelpfulCount adds v to the "not_helpful_count" field.
+func (u *ReviewUpsertOne) AddNotHelpfulCount(v int) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.AddNotHelpfulCount(v)
+	})
+}
+
+// UpdateNotHelpfulCount sets the "not_helpful_count" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateNotHelpfulCount() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateNotHelpfulCount()
+	})
+}
+
+// SetIsVerified sets the "is_verified" field.
+func (u *ReviewUpsertOne) SetIsVerified(v bool) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetIsVerified(v)
+	})
+}
+
+// UpdateIsVerified sets the "is_verified" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateIsVerified() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateIsVerified()
+	})
+}
+
+// SetIsFeatured sets the "is_featured" field.
+func (u *ReviewUpsertOne) SetIsFeatured(v bool) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetIsFeatured(v)
+	})
+}
+
+// UpdateIsFeatured sets the "is_featured" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateIsFeatured() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateIsFeatured()
+	})
+}
+
+// Exec executes the query.
+func (u *ReviewUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReviewCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReviewUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReviewUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReviewUpsertOne.ID is not supported by MySQL driver. Use ReviewUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReviewUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ReviewCreateBulk is the builder for creating many Review entities in bulk.
 type ReviewCreateBulk struct {
 	config
 	err      error
 	builders []*ReviewCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Review entities in the database.
@@ -501,6 +1296,7 @@ func (_c *ReviewCreateBulk) Save(ctx context.Context) ([]*Review, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -550,3 +1346,445 @@ func (_c *ReviewCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Review.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReviewUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReviewCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReviewUpsertBulk {
+	_c.conflict = opts
+	return &ReviewUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Review.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReviewCreateBulk) OnConflictColumns(columns ...string) *ReviewUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReviewUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReviewUpsertBulk is the builder for "upsert"-ing
+// a bulk of Review nodes.
+type ReviewUpsertBulk struct {
+	create *ReviewCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Review.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(review.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReviewUpsertBulk) UpdateNewValues() *ReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(review.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(review.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(review.FieldCreatedBy)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Review.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReviewUpsertBulk) Ignore() *ReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReviewUpsertBulk) DoNothing() *ReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReviewCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReviewUpsertBulk) Update(set func(*ReviewUpsert)) *ReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReviewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReviewUpsertBulk) SetStatus(v types.Status) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateStatus() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReviewUpsertBulk) SetUpdatedAt(v time.Time) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateUpdatedAt() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *ReviewUpsertBulk) SetUpdatedBy(v string) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateUpdatedBy() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *ReviewUpsertBulk) ClearUpdatedBy() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ReviewUpsertBulk) SetDeletedAt(v time.Time) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateDeletedAt() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ReviewUpsertBulk) ClearDeletedAt() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *ReviewUpsertBulk) SetDeletedBy(v string) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateDeletedBy() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *ReviewUpsertBulk) ClearDeletedBy() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *ReviewUpsertBulk) SetMetadata(v map[string]string) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateMetadata() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *ReviewUpsertBulk) ClearMetadata() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetEntityType sets the "entity_type" field.
+func (u *ReviewUpsertBulk) SetEntityType(v string) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetEntityType(v)
+	})
+}
+
+// UpdateEntityType sets the "entity_type" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateEntityType() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateEntityType()
+	})
+}
+
+// SetEntityID sets the "entity_id" field.
+func (u *ReviewUpsertBulk) SetEntityID(v string) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetEntityID(v)
+	})
+}
+
+// UpdateEntityID sets the "entity_id" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateEntityID() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateEntityID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReviewUpsertBulk) SetUserID(v string) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateUserID() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetRating sets the "rating" field.
+func (u *ReviewUpsertBulk) SetRating(v decimal.Decimal) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetRating(v)
+	})
+}
+
+// UpdateRating sets the "rating" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateRating() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateRating()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ReviewUpsertBulk) SetTitle(v string) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateTitle() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *ReviewUpsertBulk) ClearTitle() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ReviewUpsertBulk) SetContent(v string) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateContent() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *ReviewUpsertBulk) ClearContent() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *ReviewUpsertBulk) SetTags(v []string) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateTags() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *ReviewUpsertBulk) ClearTags() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearTags()
+	})
+}
+
+// SetImages sets the "images" field.
+func (u *ReviewUpsertBulk) SetImages(v []string) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetImages(v)
+	})
+}
+
+// UpdateImages sets the "images" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateImages() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateImages()
+	})
+}
+
+// ClearImages clears the value of the "images" field.
+func (u *ReviewUpsertBulk) ClearImages() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearImages()
+	})
+}
+
+// SetHelpfulCount sets the "helpful_count" field.
+func (u *ReviewUpsertBulk) SetHelpfulCount(v int) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetHelpfulCount(v)
+	})
+}
+
+// AddHelpfulCount adds v to the "helpful_count" field.
+func (u *ReviewUpsertBulk) AddHelpfulCount(v int) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.AddHelpfulCount(v)
+	})
+}
+
+// UpdateHelpfulCount sets the "helpful_count" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateHelpfulCount() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateHelpfulCount()
+	})
+}
+
+// SetNotHelpfulCount sets the "not_helpful_count" field.
+func (u *ReviewUpsertBulk) SetNotHelpfulCount(v int) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetNotHelpfulCount(v)
+	})
+}
+
+// AddNotHelpfulCount adds v to the "not_helpful_count" field.
+func (u *ReviewUpsertBulk) AddNotHelpfulCount(v int) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.AddNotHelpfulCount(v)
+	})
+}
+
+// UpdateNotHelpfulCount sets the "not_helpful_count" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateNotHelpfulCount() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateNotHelpfulCount()
+	})
+}
+
+// SetIsVerified sets the "is_verified" field.
+func (u *ReviewUpsertBulk) SetIsVerified(v bool) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetIsVerified(v)
+	})
+}
+
+// UpdateIsVerified sets the "is_verified" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateIsVerified() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateIsVerified()
+	})
+}
+
+// SetIsFeatured sets the "is_featured" field.
+func (u *ReviewUpsertBulk) SetIsFeatured(v bool) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetIsFeatured(v)
+	})
+}
+
+// UpdateIsFeatured sets the "is_featured" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateIsFeatured() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateIsFeatured()
+	})
+}
+
+// Exec executes the query.
+func (u *ReviewUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReviewCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReviewCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReviewUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
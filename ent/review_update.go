@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/omkar273/nashikdarshan/ent/predicate"
 	"github.com/omkar273/nashikdarshan/ent/review"
+	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -31,13 +32,13 @@ func (_u *ReviewUpdate) Where(ps ...predicate.Review) *ReviewUpdate {
 }
 
 // SetStatus sets the "status" field.
-func (_u *ReviewUpdate) SetStatus(v string) *ReviewUpdate {
+func (_u *ReviewUpdate) SetStatus(v types.Status) *ReviewUpdate {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *ReviewUpdate) SetNillableStatus(v *string) *ReviewUpdate {
+func (_u *ReviewUpdate) SetNillableStatus(v *types.Status) *ReviewUpdate {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -70,6 +71,46 @@ func (_u *ReviewUpdate) ClearUpdatedBy() *ReviewUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ReviewUpdate) SetDeletedAt(v time.Time) *ReviewUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ReviewUpdate) SetNillableDeletedAt(v *time.Time) *ReviewUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ReviewUpdate) ClearDeletedAt() *ReviewUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *ReviewUpdate) SetDeletedBy(v string) *ReviewUpdate {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *ReviewUpdate) SetNillableDeletedBy(v *string) *ReviewUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *ReviewUpdate) ClearDeletedBy() *ReviewUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *ReviewUpdate) SetMetadata(v map[string]string) *ReviewUpdate {
 	_u.mutation.SetMetadata(v)
@@ -291,7 +332,9 @@ func (_u *ReviewUpdate) Mutation() *ReviewMutation {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ReviewUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -318,15 +361,24 @@ func (_u *ReviewUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *ReviewUpdate) defaults() {
+func (_u *ReviewUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if review.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized review.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := review.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *ReviewUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := review.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Review.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.EntityType(); ok {
 		if err := review.EntityTypeValidator(v); err != nil {
 			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "Review.entity_type": %w`, err)}
@@ -382,6 +434,18 @@ func (_u *ReviewUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.UpdatedByCleared() {
 		_spec.ClearField(review.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(review.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(review.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(review.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(review.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(review.FieldMetadata, field.TypeJSON, value)
 	}
@@ -473,13 +537,13 @@ type ReviewUpdateOne struct {
 }
 
 // SetStatus sets the "status" field.
-func (_u *ReviewUpdateOne) SetStatus(v string) *ReviewUpdateOne {
+func (_u *ReviewUpdateOne) SetStatus(v types.Status) *ReviewUpdateOne {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *ReviewUpdateOne) SetNillableStatus(v *string) *ReviewUpdateOne {
+func (_u *ReviewUpdateOne) SetNillableStatus(v *types.Status) *ReviewUpdateOne {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -512,6 +576,46 @@ func (_u *ReviewUpdateOne) ClearUpdatedBy() *ReviewUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ReviewUpdateOne) SetDeletedAt(v time.Time) *ReviewUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ReviewUpdateOne) SetNillableDeletedAt(v *time.Time) *ReviewUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ReviewUpdateOne) ClearDeletedAt() *ReviewUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *ReviewUpdateOne) SetDeletedBy(v string) *ReviewUpdateOne {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *ReviewUpdateOne) SetNillableDeletedBy(v *string) *ReviewUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *ReviewUpdateOne) ClearDeletedBy() *ReviewUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *ReviewUpdateOne) SetMetadata(v map[string]string) *ReviewUpdateOne {
 	_u.mutation.SetMetadata(v)
@@ -746,7 +850,9 @@ func (_u *ReviewUpdateOne) Select(field string, fields ...string) *ReviewUpdateO
 
 // Save executes the query and returns the updated Review entity.
 func (_u *ReviewUpdateOne) Save(ctx context.Context) (*Review, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -773,15 +879,24 @@ func (_u *ReviewUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *ReviewUpdateOne) defaults() {
+func (_u *ReviewUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if review.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized review.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := review.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *ReviewUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := review.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Review.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.EntityType(); ok {
 		if err := review.EntityTypeValidator(v); err != nil {
 			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "Review.entity_type": %w`, err)}
@@ -854,6 +969,18 @@ func (_u *ReviewUpdateOne) sqlSave(ctx context.Context) (_node *Review, err erro
 	if _u.mutation.UpdatedByCleared() {
 		_spec.ClearField(review.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(review.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(review.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(review.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(review.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(review.FieldMetadata, field.TypeJSON, value)
 	}
@@ -2,657 +2,4 @@
 
 package ent
 
-import (
-	"time"
-
-	"github.com/omkar273/nashikdarshan/ent/category"
-	"github.com/omkar273/nashikdarshan/ent/event"
-	"github.com/omkar273/nashikdarshan/ent/eventoccurrence"
-	"github.com/omkar273/nashikdarshan/ent/hotel"
-	"github.com/omkar273/nashikdarshan/ent/itinerary"
-	"github.com/omkar273/nashikdarshan/ent/place"
-	"github.com/omkar273/nashikdarshan/ent/placeimage"
-	"github.com/omkar273/nashikdarshan/ent/review"
-	"github.com/omkar273/nashikdarshan/ent/schema"
-	"github.com/omkar273/nashikdarshan/ent/user"
-	"github.com/omkar273/nashikdarshan/ent/visit"
-	"github.com/shopspring/decimal"
-)
-
-// The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
-// to their package variables.
-func init() {
-	categoryMixin := schema.Category{}.Mixin()
-	categoryMixinFields0 := categoryMixin[0].Fields()
-	_ = categoryMixinFields0
-	categoryMixinFields1 := categoryMixin[1].Fields()
-	_ = categoryMixinFields1
-	categoryFields := schema.Category{}.Fields()
-	_ = categoryFields
-	// categoryDescStatus is the schema descriptor for status field.
-	categoryDescStatus := categoryMixinFields0[0].Descriptor()
-	// category.DefaultStatus holds the default value on creation for the status field.
-	category.DefaultStatus = categoryDescStatus.Default.(string)
-	// categoryDescCreatedAt is the schema descriptor for created_at field.
-	categoryDescCreatedAt := categoryMixinFields0[1].Descriptor()
-	// category.DefaultCreatedAt holds the default value on creation for the created_at field.
-	category.DefaultCreatedAt = categoryDescCreatedAt.Default.(func() time.Time)
-	// categoryDescUpdatedAt is the schema descriptor for updated_at field.
-	categoryDescUpdatedAt := categoryMixinFields0[2].Descriptor()
-	// category.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	category.DefaultUpdatedAt = categoryDescUpdatedAt.Default.(func() time.Time)
-	// category.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	category.UpdateDefaultUpdatedAt = categoryDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// categoryDescMetadata is the schema descriptor for metadata field.
-	categoryDescMetadata := categoryMixinFields1[0].Descriptor()
-	// category.DefaultMetadata holds the default value on creation for the metadata field.
-	category.DefaultMetadata = categoryDescMetadata.Default.(map[string]string)
-	// categoryDescName is the schema descriptor for name field.
-	categoryDescName := categoryFields[1].Descriptor()
-	// category.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	category.NameValidator = categoryDescName.Validators[0].(func(string) error)
-	// categoryDescSlug is the schema descriptor for slug field.
-	categoryDescSlug := categoryFields[2].Descriptor()
-	// category.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
-	category.SlugValidator = categoryDescSlug.Validators[0].(func(string) error)
-	// categoryDescID is the schema descriptor for id field.
-	categoryDescID := categoryFields[0].Descriptor()
-	// category.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	category.IDValidator = categoryDescID.Validators[0].(func(string) error)
-	eventMixin := schema.Event{}.Mixin()
-	eventMixinFields0 := eventMixin[0].Fields()
-	_ = eventMixinFields0
-	eventMixinFields1 := eventMixin[1].Fields()
-	_ = eventMixinFields1
-	eventFields := schema.Event{}.Fields()
-	_ = eventFields
-	// eventDescStatus is the schema descriptor for status field.
-	eventDescStatus := eventMixinFields0[0].Descriptor()
-	// event.DefaultStatus holds the default value on creation for the status field.
-	event.DefaultStatus = eventDescStatus.Default.(string)
-	// eventDescCreatedAt is the schema descriptor for created_at field.
-	eventDescCreatedAt := eventMixinFields0[1].Descriptor()
-	// event.DefaultCreatedAt holds the default value on creation for the created_at field.
-	event.DefaultCreatedAt = eventDescCreatedAt.Default.(func() time.Time)
-	// eventDescUpdatedAt is the schema descriptor for updated_at field.
-	eventDescUpdatedAt := eventMixinFields0[2].Descriptor()
-	// event.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	event.DefaultUpdatedAt = eventDescUpdatedAt.Default.(func() time.Time)
-	// event.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	event.UpdateDefaultUpdatedAt = eventDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// eventDescMetadata is the schema descriptor for metadata field.
-	eventDescMetadata := eventMixinFields1[0].Descriptor()
-	// event.DefaultMetadata holds the default value on creation for the metadata field.
-	event.DefaultMetadata = eventDescMetadata.Default.(map[string]string)
-	// eventDescSlug is the schema descriptor for slug field.
-	eventDescSlug := eventFields[1].Descriptor()
-	// event.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
-	event.SlugValidator = eventDescSlug.Validators[0].(func(string) error)
-	// eventDescType is the schema descriptor for type field.
-	eventDescType := eventFields[2].Descriptor()
-	// event.TypeValidator is a validator for the "type" field. It is called by the builders before save.
-	event.TypeValidator = eventDescType.Validators[0].(func(string) error)
-	// eventDescTitle is the schema descriptor for title field.
-	eventDescTitle := eventFields[3].Descriptor()
-	// event.TitleValidator is a validator for the "title" field. It is called by the builders before save.
-	event.TitleValidator = func() func(string) error {
-		validators := eventDescTitle.Validators
-		fns := [...]func(string) error{
-			validators[0].(func(string) error),
-			validators[1].(func(string) error),
-		}
-		return func(title string) error {
-			for _, fn := range fns {
-				if err := fn(title); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-	}()
-	// eventDescSubtitle is the schema descriptor for subtitle field.
-	eventDescSubtitle := eventFields[4].Descriptor()
-	// event.SubtitleValidator is a validator for the "subtitle" field. It is called by the builders before save.
-	event.SubtitleValidator = eventDescSubtitle.Validators[0].(func(string) error)
-	// eventDescLocationName is the schema descriptor for location_name field.
-	eventDescLocationName := eventFields[14].Descriptor()
-	// event.LocationNameValidator is a validator for the "location_name" field. It is called by the builders before save.
-	event.LocationNameValidator = eventDescLocationName.Validators[0].(func(string) error)
-	// eventDescViewCount is the schema descriptor for view_count field.
-	eventDescViewCount := eventFields[15].Descriptor()
-	// event.DefaultViewCount holds the default value on creation for the view_count field.
-	event.DefaultViewCount = eventDescViewCount.Default.(int)
-	// event.ViewCountValidator is a validator for the "view_count" field. It is called by the builders before save.
-	event.ViewCountValidator = eventDescViewCount.Validators[0].(func(int) error)
-	// eventDescInterestedCount is the schema descriptor for interested_count field.
-	eventDescInterestedCount := eventFields[16].Descriptor()
-	// event.DefaultInterestedCount holds the default value on creation for the interested_count field.
-	event.DefaultInterestedCount = eventDescInterestedCount.Default.(int)
-	// event.InterestedCountValidator is a validator for the "interested_count" field. It is called by the builders before save.
-	event.InterestedCountValidator = eventDescInterestedCount.Validators[0].(func(int) error)
-	// eventDescID is the schema descriptor for id field.
-	eventDescID := eventFields[0].Descriptor()
-	// event.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	event.IDValidator = eventDescID.Validators[0].(func(string) error)
-	eventoccurrenceMixin := schema.EventOccurrence{}.Mixin()
-	eventoccurrenceMixinFields0 := eventoccurrenceMixin[0].Fields()
-	_ = eventoccurrenceMixinFields0
-	eventoccurrenceMixinFields1 := eventoccurrenceMixin[1].Fields()
-	_ = eventoccurrenceMixinFields1
-	eventoccurrenceFields := schema.EventOccurrence{}.Fields()
-	_ = eventoccurrenceFields
-	// eventoccurrenceDescStatus is the schema descriptor for status field.
-	eventoccurrenceDescStatus := eventoccurrenceMixinFields0[0].Descriptor()
-	// eventoccurrence.DefaultStatus holds the default value on creation for the status field.
-	eventoccurrence.DefaultStatus = eventoccurrenceDescStatus.Default.(string)
-	// eventoccurrenceDescCreatedAt is the schema descriptor for created_at field.
-	eventoccurrenceDescCreatedAt := eventoccurrenceMixinFields0[1].Descriptor()
-	// eventoccurrence.DefaultCreatedAt holds the default value on creation for the created_at field.
-	eventoccurrence.DefaultCreatedAt = eventoccurrenceDescCreatedAt.Default.(func() time.Time)
-	// eventoccurrenceDescUpdatedAt is the schema descriptor for updated_at field.
-	eventoccurrenceDescUpdatedAt := eventoccurrenceMixinFields0[2].Descriptor()
-	// eventoccurrence.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	eventoccurrence.DefaultUpdatedAt = eventoccurrenceDescUpdatedAt.Default.(func() time.Time)
-	// eventoccurrence.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	eventoccurrence.UpdateDefaultUpdatedAt = eventoccurrenceDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// eventoccurrenceDescMetadata is the schema descriptor for metadata field.
-	eventoccurrenceDescMetadata := eventoccurrenceMixinFields1[0].Descriptor()
-	// eventoccurrence.DefaultMetadata holds the default value on creation for the metadata field.
-	eventoccurrence.DefaultMetadata = eventoccurrenceDescMetadata.Default.(map[string]string)
-	// eventoccurrenceDescEventID is the schema descriptor for event_id field.
-	eventoccurrenceDescEventID := eventoccurrenceFields[1].Descriptor()
-	// eventoccurrence.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
-	eventoccurrence.EventIDValidator = eventoccurrenceDescEventID.Validators[0].(func(string) error)
-	// eventoccurrenceDescRecurrenceType is the schema descriptor for recurrence_type field.
-	eventoccurrenceDescRecurrenceType := eventoccurrenceFields[2].Descriptor()
-	// eventoccurrence.RecurrenceTypeValidator is a validator for the "recurrence_type" field. It is called by the builders before save.
-	eventoccurrence.RecurrenceTypeValidator = eventoccurrenceDescRecurrenceType.Validators[0].(func(string) error)
-	// eventoccurrenceDescDayOfWeek is the schema descriptor for day_of_week field.
-	eventoccurrenceDescDayOfWeek := eventoccurrenceFields[6].Descriptor()
-	// eventoccurrence.DayOfWeekValidator is a validator for the "day_of_week" field. It is called by the builders before save.
-	eventoccurrence.DayOfWeekValidator = func() func(int) error {
-		validators := eventoccurrenceDescDayOfWeek.Validators
-		fns := [...]func(int) error{
-			validators[0].(func(int) error),
-			validators[1].(func(int) error),
-		}
-		return func(day_of_week int) error {
-			for _, fn := range fns {
-				if err := fn(day_of_week); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-	}()
-	// eventoccurrenceDescDayOfMonth is the schema descriptor for day_of_month field.
-	eventoccurrenceDescDayOfMonth := eventoccurrenceFields[7].Descriptor()
-	// eventoccurrence.DayOfMonthValidator is a validator for the "day_of_month" field. It is called by the builders before save.
-	eventoccurrence.DayOfMonthValidator = func() func(int) error {
-		validators := eventoccurrenceDescDayOfMonth.Validators
-		fns := [...]func(int) error{
-			validators[0].(func(int) error),
-			validators[1].(func(int) error),
-		}
-		return func(day_of_month int) error {
-			for _, fn := range fns {
-				if err := fn(day_of_month); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-	}()
-	// eventoccurrenceDescMonthOfYear is the schema descriptor for month_of_year field.
-	eventoccurrenceDescMonthOfYear := eventoccurrenceFields[8].Descriptor()
-	// eventoccurrence.MonthOfYearValidator is a validator for the "month_of_year" field. It is called by the builders before save.
-	eventoccurrence.MonthOfYearValidator = func() func(int) error {
-		validators := eventoccurrenceDescMonthOfYear.Validators
-		fns := [...]func(int) error{
-			validators[0].(func(int) error),
-			validators[1].(func(int) error),
-		}
-		return func(month_of_year int) error {
-			for _, fn := range fns {
-				if err := fn(month_of_year); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-	}()
-	// eventoccurrenceDescID is the schema descriptor for id field.
-	eventoccurrenceDescID := eventoccurrenceFields[0].Descriptor()
-	// eventoccurrence.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	eventoccurrence.IDValidator = eventoccurrenceDescID.Validators[0].(func(string) error)
-	hotelMixin := schema.Hotel{}.Mixin()
-	hotelMixinFields0 := hotelMixin[0].Fields()
-	_ = hotelMixinFields0
-	hotelMixinFields1 := hotelMixin[1].Fields()
-	_ = hotelMixinFields1
-	hotelFields := schema.Hotel{}.Fields()
-	_ = hotelFields
-	// hotelDescStatus is the schema descriptor for status field.
-	hotelDescStatus := hotelMixinFields0[0].Descriptor()
-	// hotel.DefaultStatus holds the default value on creation for the status field.
-	hotel.DefaultStatus = hotelDescStatus.Default.(string)
-	// hotelDescCreatedAt is the schema descriptor for created_at field.
-	hotelDescCreatedAt := hotelMixinFields0[1].Descriptor()
-	// hotel.DefaultCreatedAt holds the default value on creation for the created_at field.
-	hotel.DefaultCreatedAt = hotelDescCreatedAt.Default.(func() time.Time)
-	// hotelDescUpdatedAt is the schema descriptor for updated_at field.
-	hotelDescUpdatedAt := hotelMixinFields0[2].Descriptor()
-	// hotel.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	hotel.DefaultUpdatedAt = hotelDescUpdatedAt.Default.(func() time.Time)
-	// hotel.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	hotel.UpdateDefaultUpdatedAt = hotelDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// hotelDescMetadata is the schema descriptor for metadata field.
-	hotelDescMetadata := hotelMixinFields1[0].Descriptor()
-	// hotel.DefaultMetadata holds the default value on creation for the metadata field.
-	hotel.DefaultMetadata = hotelDescMetadata.Default.(map[string]string)
-	// hotelDescSlug is the schema descriptor for slug field.
-	hotelDescSlug := hotelFields[1].Descriptor()
-	// hotel.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
-	hotel.SlugValidator = hotelDescSlug.Validators[0].(func(string) error)
-	// hotelDescName is the schema descriptor for name field.
-	hotelDescName := hotelFields[2].Descriptor()
-	// hotel.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	hotel.NameValidator = hotelDescName.Validators[0].(func(string) error)
-	// hotelDescStarRating is the schema descriptor for star_rating field.
-	hotelDescStarRating := hotelFields[4].Descriptor()
-	// hotel.DefaultStarRating holds the default value on creation for the star_rating field.
-	hotel.DefaultStarRating = hotelDescStarRating.Default.(int)
-	// hotel.StarRatingValidator is a validator for the "star_rating" field. It is called by the builders before save.
-	hotel.StarRatingValidator = func() func(int) error {
-		validators := hotelDescStarRating.Validators
-		fns := [...]func(int) error{
-			validators[0].(func(int) error),
-			validators[1].(func(int) error),
-		}
-		return func(star_rating int) error {
-			for _, fn := range fns {
-				if err := fn(star_rating); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-	}()
-	// hotelDescRoomCount is the schema descriptor for room_count field.
-	hotelDescRoomCount := hotelFields[5].Descriptor()
-	// hotel.DefaultRoomCount holds the default value on creation for the room_count field.
-	hotel.DefaultRoomCount = hotelDescRoomCount.Default.(int)
-	// hotel.RoomCountValidator is a validator for the "room_count" field. It is called by the builders before save.
-	hotel.RoomCountValidator = hotelDescRoomCount.Validators[0].(func(int) error)
-	// hotelDescLatitude is the schema descriptor for latitude field.
-	hotelDescLatitude := hotelFields[9].Descriptor()
-	// hotel.DefaultLatitude holds the default value on creation for the latitude field.
-	hotel.DefaultLatitude = hotelDescLatitude.Default.(decimal.Decimal)
-	// hotelDescLongitude is the schema descriptor for longitude field.
-	hotelDescLongitude := hotelFields[10].Descriptor()
-	// hotel.DefaultLongitude holds the default value on creation for the longitude field.
-	hotel.DefaultLongitude = hotelDescLongitude.Default.(decimal.Decimal)
-	// hotelDescPriceMin is the schema descriptor for price_min field.
-	hotelDescPriceMin := hotelFields[16].Descriptor()
-	// hotel.DefaultPriceMin holds the default value on creation for the price_min field.
-	hotel.DefaultPriceMin = hotelDescPriceMin.Default.(decimal.Decimal)
-	// hotelDescPriceMax is the schema descriptor for price_max field.
-	hotelDescPriceMax := hotelFields[17].Descriptor()
-	// hotel.DefaultPriceMax holds the default value on creation for the price_max field.
-	hotel.DefaultPriceMax = hotelDescPriceMax.Default.(decimal.Decimal)
-	// hotelDescCurrency is the schema descriptor for currency field.
-	hotelDescCurrency := hotelFields[18].Descriptor()
-	// hotel.DefaultCurrency holds the default value on creation for the currency field.
-	hotel.DefaultCurrency = hotelDescCurrency.Default.(string)
-	// hotelDescViewCount is the schema descriptor for view_count field.
-	hotelDescViewCount := hotelFields[19].Descriptor()
-	// hotel.DefaultViewCount holds the default value on creation for the view_count field.
-	hotel.DefaultViewCount = hotelDescViewCount.Default.(int)
-	// hotel.ViewCountValidator is a validator for the "view_count" field. It is called by the builders before save.
-	hotel.ViewCountValidator = hotelDescViewCount.Validators[0].(func(int) error)
-	// hotelDescRatingAvg is the schema descriptor for rating_avg field.
-	hotelDescRatingAvg := hotelFields[20].Descriptor()
-	// hotel.DefaultRatingAvg holds the default value on creation for the rating_avg field.
-	hotel.DefaultRatingAvg = hotelDescRatingAvg.Default.(decimal.Decimal)
-	// hotelDescRatingCount is the schema descriptor for rating_count field.
-	hotelDescRatingCount := hotelFields[21].Descriptor()
-	// hotel.DefaultRatingCount holds the default value on creation for the rating_count field.
-	hotel.DefaultRatingCount = hotelDescRatingCount.Default.(int)
-	// hotel.RatingCountValidator is a validator for the "rating_count" field. It is called by the builders before save.
-	hotel.RatingCountValidator = hotelDescRatingCount.Validators[0].(func(int) error)
-	// hotelDescPopularityScore is the schema descriptor for popularity_score field.
-	hotelDescPopularityScore := hotelFields[23].Descriptor()
-	// hotel.DefaultPopularityScore holds the default value on creation for the popularity_score field.
-	hotel.DefaultPopularityScore = hotelDescPopularityScore.Default.(decimal.Decimal)
-	// hotelDescID is the schema descriptor for id field.
-	hotelDescID := hotelFields[0].Descriptor()
-	// hotel.DefaultID holds the default value on creation for the id field.
-	hotel.DefaultID = hotelDescID.Default.(func() string)
-	itineraryMixin := schema.Itinerary{}.Mixin()
-	itineraryMixinFields0 := itineraryMixin[0].Fields()
-	_ = itineraryMixinFields0
-	itineraryMixinFields1 := itineraryMixin[1].Fields()
-	_ = itineraryMixinFields1
-	itineraryFields := schema.Itinerary{}.Fields()
-	_ = itineraryFields
-	// itineraryDescStatus is the schema descriptor for status field.
-	itineraryDescStatus := itineraryMixinFields0[0].Descriptor()
-	// itinerary.DefaultStatus holds the default value on creation for the status field.
-	itinerary.DefaultStatus = itineraryDescStatus.Default.(string)
-	// itineraryDescCreatedAt is the schema descriptor for created_at field.
-	itineraryDescCreatedAt := itineraryMixinFields0[1].Descriptor()
-	// itinerary.DefaultCreatedAt holds the default value on creation for the created_at field.
-	itinerary.DefaultCreatedAt = itineraryDescCreatedAt.Default.(func() time.Time)
-	// itineraryDescUpdatedAt is the schema descriptor for updated_at field.
-	itineraryDescUpdatedAt := itineraryMixinFields0[2].Descriptor()
-	// itinerary.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	itinerary.DefaultUpdatedAt = itineraryDescUpdatedAt.Default.(func() time.Time)
-	// itinerary.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	itinerary.UpdateDefaultUpdatedAt = itineraryDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// itineraryDescMetadata is the schema descriptor for metadata field.
-	itineraryDescMetadata := itineraryMixinFields1[0].Descriptor()
-	// itinerary.DefaultMetadata holds the default value on creation for the metadata field.
-	itinerary.DefaultMetadata = itineraryDescMetadata.Default.(map[string]string)
-	// itineraryDescUserID is the schema descriptor for user_id field.
-	itineraryDescUserID := itineraryFields[1].Descriptor()
-	// itinerary.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
-	itinerary.UserIDValidator = itineraryDescUserID.Validators[0].(func(string) error)
-	// itineraryDescTitle is the schema descriptor for title field.
-	itineraryDescTitle := itineraryFields[2].Descriptor()
-	// itinerary.TitleValidator is a validator for the "title" field. It is called by the builders before save.
-	itinerary.TitleValidator = func() func(string) error {
-		validators := itineraryDescTitle.Validators
-		fns := [...]func(string) error{
-			validators[0].(func(string) error),
-			validators[1].(func(string) error),
-		}
-		return func(title string) error {
-			for _, fn := range fns {
-				if err := fn(title); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-	}()
-	// itineraryDescPlannedDate is the schema descriptor for planned_date field.
-	itineraryDescPlannedDate := itineraryFields[4].Descriptor()
-	// itinerary.DefaultPlannedDate holds the default value on creation for the planned_date field.
-	itinerary.DefaultPlannedDate = itineraryDescPlannedDate.Default.(func() time.Time)
-	// itineraryDescPreferredTransportMode is the schema descriptor for preferred_transport_mode field.
-	itineraryDescPreferredTransportMode := itineraryFields[7].Descriptor()
-	// itinerary.DefaultPreferredTransportMode holds the default value on creation for the preferred_transport_mode field.
-	itinerary.DefaultPreferredTransportMode = itineraryDescPreferredTransportMode.Default.(string)
-	// itineraryDescIsOptimized is the schema descriptor for is_optimized field.
-	itineraryDescIsOptimized := itineraryFields[11].Descriptor()
-	// itinerary.DefaultIsOptimized holds the default value on creation for the is_optimized field.
-	itinerary.DefaultIsOptimized = itineraryDescIsOptimized.Default.(bool)
-	// itineraryDescID is the schema descriptor for id field.
-	itineraryDescID := itineraryFields[0].Descriptor()
-	// itinerary.DefaultID holds the default value on creation for the id field.
-	itinerary.DefaultID = itineraryDescID.Default.(func() string)
-	// itinerary.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	itinerary.IDValidator = itineraryDescID.Validators[0].(func(string) error)
-	placeMixin := schema.Place{}.Mixin()
-	placeMixinFields0 := placeMixin[0].Fields()
-	_ = placeMixinFields0
-	placeMixinFields1 := placeMixin[1].Fields()
-	_ = placeMixinFields1
-	placeFields := schema.Place{}.Fields()
-	_ = placeFields
-	// placeDescStatus is the schema descriptor for status field.
-	placeDescStatus := placeMixinFields0[0].Descriptor()
-	// place.DefaultStatus holds the default value on creation for the status field.
-	place.DefaultStatus = placeDescStatus.Default.(string)
-	// placeDescCreatedAt is the schema descriptor for created_at field.
-	placeDescCreatedAt := placeMixinFields0[1].Descriptor()
-	// place.DefaultCreatedAt holds the default value on creation for the created_at field.
-	place.DefaultCreatedAt = placeDescCreatedAt.Default.(func() time.Time)
-	// placeDescUpdatedAt is the schema descriptor for updated_at field.
-	placeDescUpdatedAt := placeMixinFields0[2].Descriptor()
-	// place.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	place.DefaultUpdatedAt = placeDescUpdatedAt.Default.(func() time.Time)
-	// place.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	place.UpdateDefaultUpdatedAt = placeDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// placeDescMetadata is the schema descriptor for metadata field.
-	placeDescMetadata := placeMixinFields1[0].Descriptor()
-	// place.DefaultMetadata holds the default value on creation for the metadata field.
-	place.DefaultMetadata = placeDescMetadata.Default.(map[string]string)
-	// placeDescSlug is the schema descriptor for slug field.
-	placeDescSlug := placeFields[1].Descriptor()
-	// place.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
-	place.SlugValidator = placeDescSlug.Validators[0].(func(string) error)
-	// placeDescTitle is the schema descriptor for title field.
-	placeDescTitle := placeFields[2].Descriptor()
-	// place.TitleValidator is a validator for the "title" field. It is called by the builders before save.
-	place.TitleValidator = placeDescTitle.Validators[0].(func(string) error)
-	// placeDescPlaceType is the schema descriptor for place_type field.
-	placeDescPlaceType := placeFields[6].Descriptor()
-	// place.PlaceTypeValidator is a validator for the "place_type" field. It is called by the builders before save.
-	place.PlaceTypeValidator = placeDescPlaceType.Validators[0].(func(string) error)
-	// placeDescLatitude is the schema descriptor for latitude field.
-	placeDescLatitude := placeFields[8].Descriptor()
-	// place.DefaultLatitude holds the default value on creation for the latitude field.
-	place.DefaultLatitude = placeDescLatitude.Default.(decimal.Decimal)
-	// placeDescLongitude is the schema descriptor for longitude field.
-	placeDescLongitude := placeFields[9].Descriptor()
-	// place.DefaultLongitude holds the default value on creation for the longitude field.
-	place.DefaultLongitude = placeDescLongitude.Default.(decimal.Decimal)
-	// placeDescViewCount is the schema descriptor for view_count field.
-	placeDescViewCount := placeFields[12].Descriptor()
-	// place.DefaultViewCount holds the default value on creation for the view_count field.
-	place.DefaultViewCount = placeDescViewCount.Default.(int)
-	// place.ViewCountValidator is a validator for the "view_count" field. It is called by the builders before save.
-	place.ViewCountValidator = placeDescViewCount.Validators[0].(func(int) error)
-	// placeDescRatingAvg is the schema descriptor for rating_avg field.
-	placeDescRatingAvg := placeFields[13].Descriptor()
-	// place.DefaultRatingAvg holds the default value on creation for the rating_avg field.
-	place.DefaultRatingAvg = placeDescRatingAvg.Default.(decimal.Decimal)
-	// placeDescRatingCount is the schema descriptor for rating_count field.
-	placeDescRatingCount := placeFields[14].Descriptor()
-	// place.DefaultRatingCount holds the default value on creation for the rating_count field.
-	place.DefaultRatingCount = placeDescRatingCount.Default.(int)
-	// place.RatingCountValidator is a validator for the "rating_count" field. It is called by the builders before save.
-	place.RatingCountValidator = placeDescRatingCount.Validators[0].(func(int) error)
-	// placeDescPopularityScore is the schema descriptor for popularity_score field.
-	placeDescPopularityScore := placeFields[16].Descriptor()
-	// place.DefaultPopularityScore holds the default value on creation for the popularity_score field.
-	place.DefaultPopularityScore = placeDescPopularityScore.Default.(decimal.Decimal)
-	// placeDescAvgVisitMinutes is the schema descriptor for avg_visit_minutes field.
-	placeDescAvgVisitMinutes := placeFields[17].Descriptor()
-	// place.DefaultAvgVisitMinutes holds the default value on creation for the avg_visit_minutes field.
-	place.DefaultAvgVisitMinutes = placeDescAvgVisitMinutes.Default.(int)
-	// placeDescID is the schema descriptor for id field.
-	placeDescID := placeFields[0].Descriptor()
-	// place.DefaultID holds the default value on creation for the id field.
-	place.DefaultID = placeDescID.Default.(func() string)
-	placeimageMixin := schema.PlaceImage{}.Mixin()
-	placeimageMixinFields0 := placeimageMixin[0].Fields()
-	_ = placeimageMixinFields0
-	placeimageMixinFields1 := placeimageMixin[1].Fields()
-	_ = placeimageMixinFields1
-	placeimageFields := schema.PlaceImage{}.Fields()
-	_ = placeimageFields
-	// placeimageDescStatus is the schema descriptor for status field.
-	placeimageDescStatus := placeimageMixinFields0[0].Descriptor()
-	// placeimage.DefaultStatus holds the default value on creation for the status field.
-	placeimage.DefaultStatus = placeimageDescStatus.Default.(string)
-	// placeimageDescCreatedAt is the schema descriptor for created_at field.
-	placeimageDescCreatedAt := placeimageMixinFields0[1].Descriptor()
-	// placeimage.DefaultCreatedAt holds the default value on creation for the created_at field.
-	placeimage.DefaultCreatedAt = placeimageDescCreatedAt.Default.(func() time.Time)
-	// placeimageDescUpdatedAt is the schema descriptor for updated_at field.
-	placeimageDescUpdatedAt := placeimageMixinFields0[2].Descriptor()
-	// placeimage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	placeimage.DefaultUpdatedAt = placeimageDescUpdatedAt.Default.(func() time.Time)
-	// placeimage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	placeimage.UpdateDefaultUpdatedAt = placeimageDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// placeimageDescMetadata is the schema descriptor for metadata field.
-	placeimageDescMetadata := placeimageMixinFields1[0].Descriptor()
-	// placeimage.DefaultMetadata holds the default value on creation for the metadata field.
-	placeimage.DefaultMetadata = placeimageDescMetadata.Default.(map[string]string)
-	// placeimageDescPlaceID is the schema descriptor for place_id field.
-	placeimageDescPlaceID := placeimageFields[1].Descriptor()
-	// placeimage.PlaceIDValidator is a validator for the "place_id" field. It is called by the builders before save.
-	placeimage.PlaceIDValidator = placeimageDescPlaceID.Validators[0].(func(string) error)
-	// placeimageDescURL is the schema descriptor for url field.
-	placeimageDescURL := placeimageFields[2].Descriptor()
-	// placeimage.URLValidator is a validator for the "url" field. It is called by the builders before save.
-	placeimage.URLValidator = placeimageDescURL.Validators[0].(func(string) error)
-	// placeimageDescPos is the schema descriptor for pos field.
-	placeimageDescPos := placeimageFields[4].Descriptor()
-	// placeimage.DefaultPos holds the default value on creation for the pos field.
-	placeimage.DefaultPos = placeimageDescPos.Default.(int)
-	// placeimageDescID is the schema descriptor for id field.
-	placeimageDescID := placeimageFields[0].Descriptor()
-	// placeimage.DefaultID holds the default value on creation for the id field.
-	placeimage.DefaultID = placeimageDescID.Default.(func() string)
-	reviewMixin := schema.Review{}.Mixin()
-	reviewMixinFields0 := reviewMixin[0].Fields()
-	_ = reviewMixinFields0
-	reviewMixinFields1 := reviewMixin[1].Fields()
-	_ = reviewMixinFields1
-	reviewFields := schema.Review{}.Fields()
-	_ = reviewFields
-	// reviewDescStatus is the schema descriptor for status field.
-	reviewDescStatus := reviewMixinFields0[0].Descriptor()
-	// review.DefaultStatus holds the default value on creation for the status field.
-	review.DefaultStatus = reviewDescStatus.Default.(string)
-	// reviewDescCreatedAt is the schema descriptor for created_at field.
-	reviewDescCreatedAt := reviewMixinFields0[1].Descriptor()
-	// review.DefaultCreatedAt holds the default value on creation for the created_at field.
-	review.DefaultCreatedAt = reviewDescCreatedAt.Default.(func() time.Time)
-	// reviewDescUpdatedAt is the schema descriptor for updated_at field.
-	reviewDescUpdatedAt := reviewMixinFields0[2].Descriptor()
-	// review.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	review.DefaultUpdatedAt = reviewDescUpdatedAt.Default.(func() time.Time)
-	// review.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	review.UpdateDefaultUpdatedAt = reviewDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// reviewDescMetadata is the schema descriptor for metadata field.
-	reviewDescMetadata := reviewMixinFields1[0].Descriptor()
-	// review.DefaultMetadata holds the default value on creation for the metadata field.
-	review.DefaultMetadata = reviewDescMetadata.Default.(map[string]string)
-	// reviewDescEntityType is the schema descriptor for entity_type field.
-	reviewDescEntityType := reviewFields[1].Descriptor()
-	// review.EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
-	review.EntityTypeValidator = reviewDescEntityType.Validators[0].(func(string) error)
-	// reviewDescEntityID is the schema descriptor for entity_id field.
-	reviewDescEntityID := reviewFields[2].Descriptor()
-	// review.EntityIDValidator is a validator for the "entity_id" field. It is called by the builders before save.
-	review.EntityIDValidator = reviewDescEntityID.Validators[0].(func(string) error)
-	// reviewDescUserID is the schema descriptor for user_id field.
-	reviewDescUserID := reviewFields[3].Descriptor()
-	// review.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
-	review.UserIDValidator = reviewDescUserID.Validators[0].(func(string) error)
-	// reviewDescHelpfulCount is the schema descriptor for helpful_count field.
-	reviewDescHelpfulCount := reviewFields[9].Descriptor()
-	// review.DefaultHelpfulCount holds the default value on creation for the helpful_count field.
-	review.DefaultHelpfulCount = reviewDescHelpfulCount.Default.(int)
-	// review.HelpfulCountValidator is a validator for the "helpful_count" field. It is called by the builders before save.
-	review.HelpfulCountValidator = reviewDescHelpfulCount.Validators[0].(func(int) error)
-	// reviewDescNotHelpfulCount is the schema descriptor for not_helpful_count field.
-	reviewDescNotHelpfulCount := reviewFields[10].Descriptor()
-	// review.DefaultNotHelpfulCount holds the default value on creation for the not_helpful_count field.
-	review.DefaultNotHelpfulCount = reviewDescNotHelpfulCount.Default.(int)
-	// review.NotHelpfulCountValidator is a validator for the "not_helpful_count" field. It is called by the builders before save.
-	review.NotHelpfulCountValidator = reviewDescNotHelpfulCount.Validators[0].(func(int) error)
-	// reviewDescIsVerified is the schema descriptor for is_verified field.
-	reviewDescIsVerified := reviewFields[11].Descriptor()
-	// review.DefaultIsVerified holds the default value on creation for the is_verified field.
-	review.DefaultIsVerified = reviewDescIsVerified.Default.(bool)
-	// reviewDescIsFeatured is the schema descriptor for is_featured field.
-	reviewDescIsFeatured := reviewFields[12].Descriptor()
-	// review.DefaultIsFeatured holds the default value on creation for the is_featured field.
-	review.DefaultIsFeatured = reviewDescIsFeatured.Default.(bool)
-	// reviewDescID is the schema descriptor for id field.
-	reviewDescID := reviewFields[0].Descriptor()
-	// review.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	review.IDValidator = reviewDescID.Validators[0].(func(string) error)
-	userMixin := schema.User{}.Mixin()
-	userMixinFields0 := userMixin[0].Fields()
-	_ = userMixinFields0
-	userMixinFields1 := userMixin[1].Fields()
-	_ = userMixinFields1
-	userFields := schema.User{}.Fields()
-	_ = userFields
-	// userDescStatus is the schema descriptor for status field.
-	userDescStatus := userMixinFields0[0].Descriptor()
-	// user.DefaultStatus holds the default value on creation for the status field.
-	user.DefaultStatus = userDescStatus.Default.(string)
-	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userMixinFields0[1].Descriptor()
-	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
-	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
-	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userMixinFields0[2].Descriptor()
-	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
-	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// userDescMetadata is the schema descriptor for metadata field.
-	userDescMetadata := userMixinFields1[0].Descriptor()
-	// user.DefaultMetadata holds the default value on creation for the metadata field.
-	user.DefaultMetadata = userDescMetadata.Default.(map[string]string)
-	// userDescName is the schema descriptor for name field.
-	userDescName := userFields[1].Descriptor()
-	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	user.NameValidator = userDescName.Validators[0].(func(string) error)
-	// userDescEmail is the schema descriptor for email field.
-	userDescEmail := userFields[2].Descriptor()
-	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
-	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
-	// userDescRole is the schema descriptor for role field.
-	userDescRole := userFields[4].Descriptor()
-	// user.DefaultRole holds the default value on creation for the role field.
-	user.DefaultRole = userDescRole.Default.(string)
-	// user.RoleValidator is a validator for the "role" field. It is called by the builders before save.
-	user.RoleValidator = userDescRole.Validators[0].(func(string) error)
-	// userDescID is the schema descriptor for id field.
-	userDescID := userFields[0].Descriptor()
-	// user.DefaultID holds the default value on creation for the id field.
-	user.DefaultID = userDescID.Default.(func() string)
-	visitMixin := schema.Visit{}.Mixin()
-	visitMixinFields0 := visitMixin[0].Fields()
-	_ = visitMixinFields0
-	visitFields := schema.Visit{}.Fields()
-	_ = visitFields
-	// visitDescStatus is the schema descriptor for status field.
-	visitDescStatus := visitMixinFields0[0].Descriptor()
-	// visit.DefaultStatus holds the default value on creation for the status field.
-	visit.DefaultStatus = visitDescStatus.Default.(string)
-	// visitDescCreatedAt is the schema descriptor for created_at field.
-	visitDescCreatedAt := visitMixinFields0[1].Descriptor()
-	// visit.DefaultCreatedAt holds the default value on creation for the created_at field.
-	visit.DefaultCreatedAt = visitDescCreatedAt.Default.(func() time.Time)
-	// visitDescUpdatedAt is the schema descriptor for updated_at field.
-	visitDescUpdatedAt := visitMixinFields0[2].Descriptor()
-	// visit.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	visit.DefaultUpdatedAt = visitDescUpdatedAt.Default.(func() time.Time)
-	// visit.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	visit.UpdateDefaultUpdatedAt = visitDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// visitDescItineraryID is the schema descriptor for itinerary_id field.
-	visitDescItineraryID := visitFields[1].Descriptor()
-	// visit.ItineraryIDValidator is a validator for the "itinerary_id" field. It is called by the builders before save.
-	visit.ItineraryIDValidator = visitDescItineraryID.Validators[0].(func(string) error)
-	// visitDescPlaceID is the schema descriptor for place_id field.
-	visitDescPlaceID := visitFields[2].Descriptor()
-	// visit.PlaceIDValidator is a validator for the "place_id" field. It is called by the builders before save.
-	visit.PlaceIDValidator = visitDescPlaceID.Validators[0].(func(string) error)
-	// visitDescSequenceOrder is the schema descriptor for sequence_order field.
-	visitDescSequenceOrder := visitFields[3].Descriptor()
-	// visit.SequenceOrderValidator is a validator for the "sequence_order" field. It is called by the builders before save.
-	visit.SequenceOrderValidator = visitDescSequenceOrder.Validators[0].(func(int) error)
-	// visitDescPlannedDurationMinutes is the schema descriptor for planned_duration_minutes field.
-	visitDescPlannedDurationMinutes := visitFields[4].Descriptor()
-	// visit.DefaultPlannedDurationMinutes holds the default value on creation for the planned_duration_minutes field.
-	visit.DefaultPlannedDurationMinutes = visitDescPlannedDurationMinutes.Default.(int)
-	// visit.PlannedDurationMinutesValidator is a validator for the "planned_duration_minutes" field. It is called by the builders before save.
-	visit.PlannedDurationMinutesValidator = visitDescPlannedDurationMinutes.Validators[0].(func(int) error)
-	// visitDescID is the schema descriptor for id field.
-	visitDescID := visitFields[0].Descriptor()
-	// visit.DefaultID holds the default value on creation for the id field.
-	visit.DefaultID = visitDescID.Default.(func() string)
-	// visit.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	visit.IDValidator = visitDescID.Validators[0].(func(string) error)
-}
+// The schema-stitching logic is generated in github.com/omkar273/nashikdarshan/ent/runtime/runtime.go
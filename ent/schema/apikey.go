@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/nashikdarshan/ent/mixin"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// APIKey holds long-lived credentials for partner integrations.
+// Only a hash of the key is stored; the raw key is shown once on creation.
+type APIKey struct {
+	ent.Schema
+}
+
+func (APIKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_API_KEY)
+			}).
+			Immutable(),
+
+		field.String("label").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			NotEmpty(),
+
+		// SHA-256 hash of the raw key
+		field.String("key_hash").
+			SchemaType(map[string]string{
+				"postgres": "varchar(64)",
+			}).
+			Immutable().
+			Sensitive().
+			NotEmpty(),
+
+		// First characters of the raw key, used to identify a key without exposing it
+		field.String("key_prefix").
+			SchemaType(map[string]string{
+				"postgres": "varchar(32)",
+			}).
+			Immutable().
+			NotEmpty(),
+
+		field.JSON("scopes", []string{}).
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}).
+			Default([]string{}),
+
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (APIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key_hash").
+			Unique(),
+		index.Fields("status"),
+	}
+}
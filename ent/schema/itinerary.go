@@ -108,8 +108,7 @@ func (Itinerary) Edges() []ent.Edge {
 			Unique(),
 
 		// Visit relationship (ordered list of places)
-		edge.To("visits", Visit.Type).
-			StorageKey(edge.Column("itinerary_id")),
+		edge.To("visits", Visit.Type),
 	}
 }
 
@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// OutboxEvent is a change event written in the same transaction as the change it describes
+// and delivered afterwards by the outbox dispatcher, so committed changes are never lost.
+type OutboxEvent struct {
+	ent.Schema
+}
+
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		// Also sent to consumers as the idempotency key of the event
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_OUTBOX)
+			}).
+			Immutable(),
+		field.String("event_type").
+			SchemaType(map[string]string{
+				"postgres": "varchar(100)",
+			}).
+			Immutable().
+			NotEmpty(),
+		field.String("aggregate_type").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Immutable().
+			NotEmpty(),
+		field.String("aggregate_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			Immutable().
+			NotEmpty(),
+		field.JSON("payload", json.RawMessage{}).
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}).
+			Immutable(),
+		field.String("status").
+			GoType(types.OutboxStatus("")).
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			Default(string(types.OutboxStatusPending)),
+		// Delivery attempts so far, counted when an event is claimed
+		field.Int("attempts").
+			SchemaType(map[string]string{
+				"postgres": "integer",
+			}).
+			Default(0),
+		field.String("last_error").
+			SchemaType(map[string]string{
+				"postgres": "text",
+			}).
+			Optional(),
+		// Earliest time of the next delivery attempt; pushed forward while an attempt is in flight
+		field.Time("available_at").
+			Default(types.NowUTC),
+		field.Time("sent_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Immutable().
+			Default(types.NowUTC),
+	}
+}
+
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// Serves the dispatcher's scan for due events
+		index.Fields("available_at").
+			Annotations(entsql.IndexWhere("status = 'pending'")),
+		index.Fields("aggregate_type", "aggregate_id"),
+	}
+}
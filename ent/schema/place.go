@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	baseMixin "github.com/omkar273/nashikdarshan/ent/mixin"
 	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
@@ -31,11 +32,11 @@ func (Place) Fields() []ent.Field {
 			}).
 			Immutable(),
 
+		// Changed only by the admin re-slug job, which keeps the old slug in slug_history
 		field.String("slug").
 			SchemaType(map[string]string{
 				"postgres": "text",
 			}).
-			Immutable().
 			NotEmpty(),
 
 		field.String("title").
@@ -87,6 +88,14 @@ func (Place) Fields() []ent.Field {
 			}).
 			Default(decimal.Zero),
 
+		// Geohash of the location at full precision, so regions can be filtered by prefix
+		// without spatial operations. Rows written before it existed are filled by the migrator.
+		field.String("geohash").
+			SchemaType(map[string]string{
+				"postgres": "varchar(12)",
+			}).
+			Optional(),
+
 		field.String("primary_image_url").
 			SchemaType(map[string]string{
 				"postgres": "text",
@@ -146,6 +155,59 @@ func (Place) Fields() []ent.Field {
 			}).
 			Optional().
 			Comment("Opening hours by day: {monday: '9:00-18:00', ...}"),
+
+		field.JSON("price_info", &types.PriceInfo{}).
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}).
+			Optional().
+			Comment("Entry fee: {is_free, amount, currency, notes}"),
+
+		field.JSON("contact", &types.Contact{}).
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}).
+			Optional().
+			Comment("Contact details: {phone (E.164), website, email}"),
+
+		field.JSON("accessibility", &types.Accessibility{}).
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}).
+			Optional().
+			Comment("Accessibility attributes: {wheelchair_accessible: true, has_ramp: false, ...}"),
+
+		field.JSON("external_refs", map[string]string{}).
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}).
+			Optional().
+			Comment("Identifiers in external datasets by source: {osm: 'node/123'}"),
+
+		field.Int("geofence_radius_m").
+			SchemaType(map[string]string{
+				"postgres": "integer",
+			}).
+			Optional().
+			Nillable().
+			Positive().
+			Comment("Radius in meters of the circular geofence around the place; null when it has none"),
+
+		field.Time("publish_at").
+			SchemaType(map[string]string{
+				"postgres": "timestamp with time zone",
+			}).
+			Optional().
+			Nillable().
+			Comment("When a draft place is published; cleared once it has been"),
+
+		field.Time("unpublish_at").
+			SchemaType(map[string]string{
+				"postgres": "timestamp with time zone",
+			}).
+			Optional().
+			Nillable().
+			Comment("When a published place is archived; cleared once it has been"),
 	}
 }
 
@@ -155,11 +217,17 @@ func (Place) Edges() []ent.Edge {
 		edge.From("category", Category.Type).
 			Ref("places"),
 		edge.To("visits", Visit.Type),
+		edge.To("revisions", PlaceRevision.Type),
+		edge.To("slug_history", PlaceSlug.Type),
 	}
 }
 
 func (Place) Indexes() []ent.Index {
 	return []ent.Index{
-		// TODO: Add indexes
+		// Unique slug, so concurrent creates cannot both claim one
+		index.Fields("slug").
+			Unique(),
+		// Editorial dashboards list the places a user last edited, newest first
+		index.Fields("updated_by", "updated_at"),
 	}
 }
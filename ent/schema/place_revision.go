@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/nashikdarshan/ent/mixin"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// PlaceRevision is an append-only snapshot of a place's editable content,
+// recorded on every change so past versions can be inspected and restored.
+type PlaceRevision struct {
+	ent.Schema
+}
+
+func (PlaceRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+func (PlaceRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PLACE_REV)
+			}).
+			Immutable(),
+		field.String("place_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			Immutable().
+			NotEmpty(),
+		// Sequential revision number per place, starting at 1
+		field.Int("revision").
+			SchemaType(map[string]string{
+				"postgres": "integer",
+			}).
+			Immutable().
+			Positive(),
+		field.JSON("snapshot", json.RawMessage{}).
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}).
+			Immutable(),
+		// Set when this revision was produced by reverting to an earlier one
+		field.Int("reverted_from").
+			SchemaType(map[string]string{
+				"postgres": "integer",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
+	}
+}
+
+func (PlaceRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("place", Place.Type).
+			Ref("revisions").
+			Field("place_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (PlaceRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		// One row per revision number per place; also serves ordered history lookups
+		index.Fields("place_id", "revision").
+			Unique(),
+	}
+}
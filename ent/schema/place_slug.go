@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/nashikdarshan/ent/mixin"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// PlaceSlug records a slug a place used to have, so links to it keep resolving after the
+// place is re-slugged.
+type PlaceSlug struct {
+	ent.Schema
+}
+
+func (PlaceSlug) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+func (PlaceSlug) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PLACE_SLUG)
+			}).
+			Immutable(),
+		field.String("place_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			Immutable().
+			NotEmpty(),
+		field.String("slug").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			Immutable().
+			NotEmpty(),
+	}
+}
+
+func (PlaceSlug) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("place", Place.Type).
+			Ref("slug_history").
+			Field("place_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (PlaceSlug) Indexes() []ent.Index {
+	return []ent.Index{
+		// An old slug leads to one place: the last one to give it up
+		index.Fields("slug").
+			Unique(),
+		index.Fields("place_id"),
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/omkar273/nashikdarshan/ent/itinerary"
 	"github.com/omkar273/nashikdarshan/ent/place"
 	"github.com/omkar273/nashikdarshan/ent/visit"
+	"github.com/omkar273/nashikdarshan/internal/types"
 )
 
 // Visit is the model entity for the Visit schema.
@@ -21,7 +22,7 @@ type Visit struct {
 	// Unique visit identifier with prefix visit_
 	ID string `json:"id,omitempty"`
 	// Status holds the value of the "status" field.
-	Status string `json:"status,omitempty"`
+	Status types.Status `json:"status,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -30,6 +31,10 @@ type Visit struct {
 	CreatedBy string `json:"created_by,omitempty"`
 	// UpdatedBy holds the value of the "updated_by" field.
 	UpdatedBy string `json:"updated_by,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// DeletedBy holds the value of the "deleted_by" field.
+	DeletedBy string `json:"deleted_by,omitempty"`
 	// FK to parent itinerary
 	ItineraryID string `json:"itinerary_id,omitempty"`
 	// FK to place being visited
@@ -94,10 +99,12 @@ func (*Visit) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullFloat64)
 		case visit.FieldSequenceOrder, visit.FieldPlannedDurationMinutes, visit.FieldTravelTimeFromPreviousMinutes:
 			values[i] = new(sql.NullInt64)
-		case visit.FieldID, visit.FieldStatus, visit.FieldCreatedBy, visit.FieldUpdatedBy, visit.FieldItineraryID, visit.FieldPlaceID, visit.FieldTransportMode, visit.FieldNotes:
+		case visit.FieldID, visit.FieldCreatedBy, visit.FieldUpdatedBy, visit.FieldDeletedBy, visit.FieldItineraryID, visit.FieldPlaceID, visit.FieldTransportMode, visit.FieldNotes:
 			values[i] = new(sql.NullString)
-		case visit.FieldCreatedAt, visit.FieldUpdatedAt:
+		case visit.FieldCreatedAt, visit.FieldUpdatedAt, visit.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
+		case visit.FieldStatus:
+			values[i] = new(types.Status)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -120,10 +127,10 @@ func (_m *Visit) assignValues(columns []string, values []any) error {
 				_m.ID = value.String
 			}
 		case visit.FieldStatus:
-			if value, ok := values[i].(*sql.NullString); !ok {
+			if value, ok := values[i].(*types.Status); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
-			} else if value.Valid {
-				_m.Status = value.String
+			} else if value != nil {
+				_m.Status = *value
 			}
 		case visit.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -149,6 +156,19 @@ func (_m *Visit) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedBy = value.String
 			}
+		case visit.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case visit.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = value.String
+			}
 		case visit.FieldItineraryID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field itinerary_id", values[i])
@@ -248,7 +268,7 @@ func (_m *Visit) String() string {
 	builder.WriteString("Visit(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("status=")
-	builder.WriteString(_m.Status)
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
@@ -262,6 +282,14 @@ func (_m *Visit) String() string {
 	builder.WriteString("updated_by=")
 	builder.WriteString(_m.UpdatedBy)
 	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(_m.DeletedBy)
+	builder.WriteString(", ")
 	builder.WriteString("itinerary_id=")
 	builder.WriteString(_m.ItineraryID)
 	builder.WriteString(", ")
@@ -8,11 +8,14 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/omkar273/nashikdarshan/ent/itinerary"
 	"github.com/omkar273/nashikdarshan/ent/place"
 	"github.com/omkar273/nashikdarshan/ent/visit"
+	"github.com/omkar273/nashikdarshan/internal/types"
 )
 
 // VisitCreate is the builder for creating a Visit entity.
@@ -20,16 +23,17 @@ type VisitCreate struct {
 	config
 	mutation *VisitMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetStatus sets the "status" field.
-func (_c *VisitCreate) SetStatus(v string) *VisitCreate {
+func (_c *VisitCreate) SetStatus(v types.Status) *VisitCreate {
 	_c.mutation.SetStatus(v)
 	return _c
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_c *VisitCreate) SetNillableStatus(v *string) *VisitCreate {
+func (_c *VisitCreate) SetNillableStatus(v *types.Status) *VisitCreate {
 	if v != nil {
 		_c.SetStatus(*v)
 	}
@@ -92,6 +96,34 @@ func (_c *VisitCreate) SetNillableUpdatedBy(v *string) *VisitCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *VisitCreate) SetDeletedAt(v time.Time) *VisitCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *VisitCreate) SetNillableDeletedAt(v *time.Time) *VisitCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *VisitCreate) SetDeletedBy(v string) *VisitCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *VisitCreate) SetNillableDeletedBy(v *string) *VisitCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
 // SetItineraryID sets the "itinerary_id" field.
 func (_c *VisitCreate) SetItineraryID(v string) *VisitCreate {
 	_c.mutation.SetItineraryID(v)
@@ -211,7 +243,9 @@ func (_c *VisitCreate) Mutation() *VisitMutation {
 
 // Save creates the Visit in the database.
 func (_c *VisitCreate) Save(ctx context.Context) (*Visit, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -238,16 +272,22 @@ func (_c *VisitCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *VisitCreate) defaults() {
+func (_c *VisitCreate) defaults() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		v := visit.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if visit.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized visit.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := visit.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if visit.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized visit.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := visit.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
@@ -256,9 +296,13 @@ func (_c *VisitCreate) defaults() {
 		_c.mutation.SetPlannedDurationMinutes(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if visit.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized visit.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := visit.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -266,6 +310,11 @@ func (_c *VisitCreate) check() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Visit.status"`)}
 	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := visit.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Visit.status": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Visit.created_at"`)}
 	}
@@ -346,6 +395,7 @@ func (_c *VisitCreate) createSpec() (*Visit, *sqlgraph.CreateSpec) {
 		_node = &Visit{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(visit.Table, sqlgraph.NewFieldSpec(visit.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -370,6 +420,14 @@ func (_c *VisitCreate) createSpec() (*Visit, *sqlgraph.CreateSpec) {
 		_spec.SetField(visit.FieldUpdatedBy, field.TypeString, value)
 		_node.UpdatedBy = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(visit.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(visit.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
 	if value, ok := _c.mutation.SequenceOrder(); ok {
 		_spec.SetField(visit.FieldSequenceOrder, field.TypeInt, value)
 		_node.SequenceOrder = value
@@ -431,11 +489,634 @@ func (_c *VisitCreate) createSpec() (*Visit, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Visit.Create().
+//		SetStatus(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VisitUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *VisitCreate) OnConflict(opts ...sql.ConflictOption) *VisitUpsertOne {
+	_c.conflict = opts
+	return &VisitUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Visit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *VisitCreate) OnConflictColumns(columns ...string) *VisitUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &VisitUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// VisitUpsertOne is the builder for "upsert"-ing
+	//  one Visit node.
+	VisitUpsertOne struct {
+		create *VisitCreate
+	}
+
+	// VisitUpsert is the "OnConflict" setter.
+	VisitUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *VisitUpsert) SetStatus(v types.Status) *VisitUpsert {
+	u.Set(visit.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *VisitUpsert) UpdateStatus() *VisitUpsert {
+	u.SetExcluded(visit.FieldStatus)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VisitUpsert) SetUpdatedAt(v time.Time) *VisitUpsert {
+	u.Set(visit.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VisitUpsert) UpdateUpdatedAt() *VisitUpsert {
+	u.SetExcluded(visit.FieldUpdatedAt)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *VisitUpsert) SetUpdatedBy(v string) *VisitUpsert {
+	u.Set(visit.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *VisitUpsert) UpdateUpdatedBy() *VisitUpsert {
+	u.SetExcluded(visit.FieldUpdatedBy)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *VisitUpsert) ClearUpdatedBy() *VisitUpsert {
+	u.SetNull(visit.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *VisitUpsert) SetDeletedAt(v time.Time) *VisitUpsert {
+	u.Set(visit.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *VisitUpsert) UpdateDeletedAt() *VisitUpsert {
+	u.SetExcluded(visit.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *VisitUpsert) ClearDeletedAt() *VisitUpsert {
+	u.SetNull(visit.FieldDeletedAt)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *VisitUpsert) SetDeletedBy(v string) *VisitUpsert {
+	u.Set(visit.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *VisitUpsert) UpdateDeletedBy() *VisitUpsert {
+	u.SetExcluded(visit.FieldDeletedBy)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *VisitUpsert) ClearDeletedBy() *VisitUpsert {
+	u.SetNull(visit.FieldDeletedBy)
+	return u
+}
+
+// SetItineraryID sets the "itinerary_id" field.
+func (u *VisitUpsert) SetItineraryID(v string) *VisitUpsert {
+	u.Set(visit.FieldItineraryID, v)
+	return u
+}
+
+// UpdateItineraryID sets the "itinerary_id" field to the value that was provided on create.
+func (u *VisitUpsert) UpdateItineraryID() *VisitUpsert {
+	u.SetExcluded(visit.FieldItineraryID)
+	return u
+}
+
+// SetPlaceID sets the "place_id" field.
+func (u *VisitUpsert) SetPlaceID(v string) *VisitUpsert {
+	u.Set(visit.FieldPlaceID, v)
+	return u
+}
+
+// UpdatePlaceID sets the "place_id" field to the value that was provided on create.
+func (u *VisitUpsert) UpdatePlaceID() *VisitUpsert {
+	u.SetExcluded(visit.FieldPlaceID)
+	return u
+}
+
+// SetSequenceOrder sets the "sequence_order" field.
+func (u *VisitUpsert) SetSequenceOrder(v int) *VisitUpsert {
+	u.Set(visit.FieldSequenceOrder, v)
+	return u
+}
+
+// UpdateSequenceOrder sets the "sequence_order" field to the value that was provided on create.
+func (u *VisitUpsert) UpdateSequenceOrder() *VisitUpsert {
+	u.SetExcluded(visit.FieldSequenceOrder)
+	return u
+}
+
+// AddSequenceOrder adds v to the "sequence_order" field.
+func (u *VisitUpsert) AddSequenceOrder(v int) *VisitUpsert {
+	u.Add(visit.FieldSequenceOrder, v)
+	return u
+}
+
+// SetPlannedDurationMinutes sets the "planned_duration_minutes" field.
+func (u *VisitUpsert) SetPlannedDurationMinutes(v int) *VisitUpsert {
+	u.Set(visit.FieldPlannedDurationMinutes, v)
+	return u
+}
+
+// UpdatePlannedDurationMinutes sets the "planned_duration_minutes" field to the value that was provided on create.
+func (u *VisitUpsert) UpdatePlannedDurationMinutes() *VisitUpsert {
+	u.SetExcluded(visit.FieldPlannedDurationMinutes)
+	return u
+}
+
+// AddPlannedDurationMinutes adds v to the "planned_duration_minutes" field.
+func (u *VisitUpsert) AddPlannedDurationMinutes(v int) *VisitUpsert {
+	u.Add(visit.FieldPlannedDurationMinutes, v)
+	return u
+}
+
+// SetDistanceFromPreviousKm sets the "distance_from_previous_km" field.
+func (u *VisitUpsert) SetDistanceFromPreviousKm(v float64) *VisitUpsert {
+	u.Set(visit.FieldDistanceFromPreviousKm, v)
+	return u
+}
+
+// UpdateDistanceFromPreviousKm sets the "distance_from_previous_km" field to the value that was provided on create.
+func (u *VisitUpsert) UpdateDistanceFromPreviousKm() *VisitUpsert {
+	u.SetExcluded(visit.FieldDistanceFromPreviousKm)
+	return u
+}
+
+// AddDistanceFromPreviousKm adds v to the "distance_from_previous_km" field.
+func (u *VisitUpsert) AddDistanceFromPreviousKm(v float64) *VisitUpsert {
+	u.Add(visit.FieldDistanceFromPreviousKm, v)
+	return u
+}
+
+// ClearDistanceFromPreviousKm clears the value of the "distance_from_previous_km" field.
+func (u *VisitUpsert) ClearDistanceFromPreviousKm() *VisitUpsert {
+	u.SetNull(visit.FieldDistanceFromPreviousKm)
+	return u
+}
+
+// SetTravelTimeFromPreviousMinutes sets the "travel_time_from_previous_minutes" field.
+func (u *VisitUpsert) SetTravelTimeFromPreviousMinutes(v int) *VisitUpsert {
+	u.Set(visit.FieldTravelTimeFromPreviousMinutes, v)
+	return u
+}
+
+// UpdateTravelTimeFromPreviousMinutes sets the "travel_time_from_previous_minutes" field to the value that was provided on create.
+func (u *VisitUpsert) UpdateTravelTimeFromPreviousMinutes() *VisitUpsert {
+	u.SetExcluded(visit.FieldTravelTimeFromPreviousMinutes)
+	return u
+}
+
+// AddTravelTimeFromPreviousMinutes adds v to the "travel_time_from_previous_minutes" field.
+func (u *VisitUpsert) AddTravelTimeFromPreviousMinutes(v int) *VisitUpsert {
+	u.Add(visit.FieldTravelTimeFromPreviousMinutes, v)
+	return u
+}
+
+// ClearTravelTimeFromPreviousMinutes clears the value of the "travel_time_from_previous_minutes" field.
+func (u *VisitUpsert) ClearTravelTimeFromPreviousMinutes() *VisitUpsert {
+	u.SetNull(visit.FieldTravelTimeFromPreviousMinutes)
+	return u
+}
+
+// SetTransportMode sets the "transport_mode" field.
+func (u *VisitUpsert) SetTransportMode(v string) *VisitUpsert {
+	u.Set(visit.FieldTransportMode, v)
+	return u
+}
+
+// UpdateTransportMode sets the "transport_mode" field to the value that was provided on create.
+func (u *VisitUpsert) UpdateTransportMode() *VisitUpsert {
+	u.SetExcluded(visit.FieldTransportMode)
+	return u
+}
+
+// ClearTransportMode clears the value of the "transport_mode" field.
+func (u *VisitUpsert) ClearTransportMode() *VisitUpsert {
+	u.SetNull(visit.FieldTransportMode)
+	return u
+}
+
+// SetNotes sets the "notes" field.
+func (u *VisitUpsert) SetNotes(v string) *VisitUpsert {
+	u.Set(visit.FieldNotes, v)
+	return u
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *VisitUpsert) UpdateNotes() *VisitUpsert {
+	u.SetExcluded(visit.FieldNotes)
+	return u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *VisitUpsert) ClearNotes() *VisitUpsert {
+	u.SetNull(visit.FieldNotes)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Visit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(visit.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VisitUpsertOne) UpdateNewValues() *VisitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(visit.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(visit.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(visit.FieldCreatedBy)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Visit.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *VisitUpsertOne) Ignore() *VisitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VisitUpsertOne) DoNothing() *VisitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VisitCreate.OnConflict
+// documentation for more info.
+func (u *VisitUpsertOne) Update(set func(*VisitUpsert)) *VisitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VisitUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *VisitUpsertOne) SetStatus(v types.Status) *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *VisitUpsertOne) UpdateStatus() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VisitUpsertOne) SetUpdatedAt(v time.Time) *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VisitUpsertOne) UpdateUpdatedAt() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *VisitUpsertOne) SetUpdatedBy(v string) *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *VisitUpsertOne) UpdateUpdatedBy() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *VisitUpsertOne) ClearUpdatedBy() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *VisitUpsertOne) SetDeletedAt(v time.Time) *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *VisitUpsertOne) UpdateDeletedAt() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *VisitUpsertOne) ClearDeletedAt() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *VisitUpsertOne) SetDeletedBy(v string) *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *VisitUpsertOne) UpdateDeletedBy() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *VisitUpsertOne) ClearDeletedBy() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetItineraryID sets the "itinerary_id" field.
+func (u *VisitUpsertOne) SetItineraryID(v string) *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetItineraryID(v)
+	})
+}
+
+// UpdateItineraryID sets the "itinerary_id" field to the value that was provided on create.
+func (u *VisitUpsertOne) UpdateItineraryID() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateItineraryID()
+	})
+}
+
+// SetPlaceID sets the "place_id" field.
+func (u *VisitUpsertOne) SetPlaceID(v string) *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetPlaceID(v)
+	})
+}
+
+// UpdatePlaceID sets the "place_id" field to the value that was provided on create.
+func (u *VisitUpsertOne) UpdatePlaceID() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdatePlaceID()
+	})
+}
+
+// SetSequenceOrder sets the "sequence_order" field.
+func (u *VisitUpsertOne) SetSequenceOrder(v int) *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetSequenceOrder(v)
+	})
+}
+
+// AddSequenceOrder adds v to the "sequence_order" field.
+func (u *VisitUpsertOne) AddSequenceOrder(v int) *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.AddSequenceOrder(v)
+	})
+}
+
+// UpdateSequenceOrder sets the "sequence_order" field to the value that was provided on create.
+func (u *VisitUpsertOne) UpdateSequenceOrder() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateSequenceOrder()
+	})
+}
+
+// SetPlannedDurationMinutes sets the "planned_duration_minutes" field.
+func (u *VisitUpsertOne) SetPlannedDurationMinutes(v int) *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetPlannedDurationMinutes(v)
+	})
+}
+
+// AddPlannedDurationMinutes adds v to the "planned_duration_minutes" field.
+func (u *VisitUpsertOne) AddPlannedDurationMinutes(v int) *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.AddPlannedDurationMinutes(v)
+	})
+}
+
+// UpdatePlannedDurationMinutes sets the "planned_duration_minutes" field to the value that was provided on create.
+func (u *VisitUpsertOne) UpdatePlannedDurationMinutes() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdatePlannedDurationMinutes()
+	})
+}
+
+// SetDistanceFromPreviousKm sets the "distance_from_previous_km" field.
+func (u *VisitUpsertOne) SetDistanceFromPreviousKm(v float64) *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetDistanceFromPreviousKm(v)
+	})
+}
+
+// AddDistanceFromPreviousKm adds v to the "distance_from_previous_km" field.
+func (u *VisitUpsertOne) AddDistanceFromPreviousKm(v float64) *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.AddDistanceFromPreviousKm(v)
+	})
+}
+
+// UpdateDistanceFromPreviousKm sets the "distance_from_previous_km" field to the value that was provided on create.
+func (u *VisitUpsertOne) UpdateDistanceFromPreviousKm() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateDistanceFromPreviousKm()
+	})
+}
+
+// ClearDistanceFromPreviousKm clears the value of the "distance_from_previous_km" field.
+func (u *VisitUpsertOne) ClearDistanceFromPreviousKm() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.ClearDistanceFromPreviousKm()
+	})
+}
+
+// SetTravelTimeFromPreviousMinutes sets the "travel_time_from_previous_minutes" field.
+func (u *VisitUpsertOne) SetTravelTimeFromPreviousMinutes(v int) *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetTravelTimeFromPreviousMinutes(v)
+	})
+}
+
+// AddTravelTimeFromPreviousMinutes adds v to the "travel_time_from_previous_minutes" field.
+func (u *VisitUpsertOne) AddTravelTimeFromPreviousMinutes(v int) *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.AddTravelTimeFromPreviousMinutes(v)
+	})
+}
+
+// UpdateTravelTimeFromPreviousMinutes sets the "travel_time_from_previous_minutes" field to the value that was provided on create.
+func (u *VisitUpsertOne) UpdateTravelTimeFromPreviousMinutes() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateTravelTimeFromPreviousMinutes()
+	})
+}
+
+// ClearTravelTimeFromPreviousMinutes clears the value of the "travel_time_from_previous_minutes" field.
+func (u *VisitUpsertOne) ClearTravelTimeFromPreviousMinutes() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.ClearTravelTimeFromPreviousMinutes()
+	})
+}
+
+// SetTransportMode sets the "transport_mode" field.
+func (u *VisitUpsertOne) SetTransportMode(v string) *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetTransportMode(v)
+	})
+}
+
+// UpdateTransportMode sets the "transport_mode" field to the value that was provided on create.
+func (u *VisitUpsertOne) UpdateTransportMode() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateTransportMode()
+	})
+}
+
+// ClearTransportMode clears the value of the "transport_mode" field.
+func (u *VisitUpsertOne) ClearTransportMode() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.ClearTransportMode()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *VisitUpsertOne) SetNotes(v string) *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *VisitUpsertOne) UpdateNotes() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *VisitUpsertOne) ClearNotes() *VisitUpsertOne {
+	return u.Update(func(s *VisitUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// Exec executes the query.
+func (u *VisitUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VisitCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VisitUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *VisitUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: VisitUpsertOne.ID is not supported by MySQL driver. Use VisitUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *VisitUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // VisitCreateBulk is the builder for creating many Visit entities in bulk.
 type VisitCreateBulk struct {
 	config
 	err      error
 	builders []*VisitCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Visit entities in the database.
@@ -465,6 +1146,7 @@ func (_c *VisitCreateBulk) Save(ctx context.Context) ([]*Visit, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -514,3 +1196,382 @@ func (_c *VisitCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Visit.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VisitUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *VisitCreateBulk) OnConflict(opts ...sql.ConflictOption) *VisitUpsertBulk {
+	_c.conflict = opts
+	return &VisitUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Visit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *VisitCreateBulk) OnConflictColumns(columns ...string) *VisitUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &VisitUpsertBulk{
+		create: _c,
+	}
+}
+
+// VisitUpsertBulk is the builder for "upsert"-ing
+// a bulk of Visit nodes.
+type VisitUpsertBulk struct {
+	create *VisitCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Visit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(visit.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VisitUpsertBulk) UpdateNewValues() *VisitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(visit.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(visit.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(visit.FieldCreatedBy)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Visit.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *VisitUpsertBulk) Ignore() *VisitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VisitUpsertBulk) DoNothing() *VisitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VisitCreateBulk.OnConflict
+// documentation for more info.
+func (u *VisitUpsertBulk) Update(set func(*VisitUpsert)) *VisitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VisitUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *VisitUpsertBulk) SetStatus(v types.Status) *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *VisitUpsertBulk) UpdateStatus() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VisitUpsertBulk) SetUpdatedAt(v time.Time) *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VisitUpsertBulk) UpdateUpdatedAt() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *VisitUpsertBulk) SetUpdatedBy(v string) *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *VisitUpsertBulk) UpdateUpdatedBy() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *VisitUpsertBulk) ClearUpdatedBy() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *VisitUpsertBulk) SetDeletedAt(v time.Time) *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *VisitUpsertBulk) UpdateDeletedAt() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *VisitUpsertBulk) ClearDeletedAt() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *VisitUpsertBulk) SetDeletedBy(v string) *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *VisitUpsertBulk) UpdateDeletedBy() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *VisitUpsertBulk) ClearDeletedBy() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetItineraryID sets the "itinerary_id" field.
+func (u *VisitUpsertBulk) SetItineraryID(v string) *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetItineraryID(v)
+	})
+}
+
+// UpdateItineraryID sets the "itinerary_id" field to the value that was provided on create.
+func (u *VisitUpsertBulk) UpdateItineraryID() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateItineraryID()
+	})
+}
+
+// SetPlaceID sets the "place_id" field.
+func (u *VisitUpsertBulk) SetPlaceID(v string) *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetPlaceID(v)
+	})
+}
+
+// UpdatePlaceID sets the "place_id" field to the value that was provided on create.
+func (u *VisitUpsertBulk) UpdatePlaceID() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdatePlaceID()
+	})
+}
+
+// SetSequenceOrder sets the "sequence_order" field.
+func (u *VisitUpsertBulk) SetSequenceOrder(v int) *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetSequenceOrder(v)
+	})
+}
+
+// AddSequenceOrder adds v to the "sequence_order" field.
+func (u *VisitUpsertBulk) AddSequenceOrder(v int) *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.AddSequenceOrder(v)
+	})
+}
+
+// UpdateSequenceOrder sets the "sequence_order" field to the value that was provided on create.
+func (u *VisitUpsertBulk) UpdateSequenceOrder() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateSequenceOrder()
+	})
+}
+
+// SetPlannedDurationMinutes sets the "planned_duration_minutes" field.
+func (u *VisitUpsertBulk) SetPlannedDurationMinutes(v int) *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetPlannedDurationMinutes(v)
+	})
+}
+
+// AddPlannedDurationMinutes adds v to the "planned_duration_minutes" field.
+func (u *VisitUpsertBulk) AddPlannedDurationMinutes(v int) *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.AddPlannedDurationMinutes(v)
+	})
+}
+
+// UpdatePlannedDurationMinutes sets the "planned_duration_minutes" field to the value that was provided on create.
+func (u *VisitUpsertBulk) UpdatePlannedDurationMinutes() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdatePlannedDurationMinutes()
+	})
+}
+
+// SetDistanceFromPreviousKm sets the "distance_from_previous_km" field.
+func (u *VisitUpsertBulk) SetDistanceFromPreviousKm(v float64) *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetDistanceFromPreviousKm(v)
+	})
+}
+
+// AddDistanceFromPreviousKm adds v to the "distance_from_previous_km" field.
+func (u *VisitUpsertBulk) AddDistanceFromPreviousKm(v float64) *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.AddDistanceFromPreviousKm(v)
+	})
+}
+
+// UpdateDistanceFromPreviousKm sets the "distance_from_previous_km" field to the value that was provided on create.
+func (u *VisitUpsertBulk) UpdateDistanceFromPreviousKm() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateDistanceFromPreviousKm()
+	})
+}
+
+// ClearDistanceFromPreviousKm clears the value of the "distance_from_previous_km" field.
+func (u *VisitUpsertBulk) ClearDistanceFromPreviousKm() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.ClearDistanceFromPreviousKm()
+	})
+}
+
+// SetTravelTimeFromPreviousMinutes sets the "travel_time_from_previous_minutes" field.
+func (u *VisitUpsertBulk) SetTravelTimeFromPreviousMinutes(v int) *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetTravelTimeFromPreviousMinutes(v)
+	})
+}
+
+// AddTravelTimeFromPreviousMinutes adds v to the "travel_time_from_previous_minutes" field.
+func (u *VisitUpsertBulk) AddTravelTimeFromPreviousMinutes(v int) *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.AddTravelTimeFromPreviousMinutes(v)
+	})
+}
+
+// UpdateTravelTimeFromPreviousMinutes sets the "travel_time_from_previous_minutes" field to the value that was provided on create.
+func (u *VisitUpsertBulk) UpdateTravelTimeFromPreviousMinutes() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateTravelTimeFromPreviousMinutes()
+	})
+}
+
+// ClearTravelTimeFromPreviousMinutes clears the value of the "travel_time_from_previous_minutes" field.
+func (u *VisitUpsertBulk) ClearTravelTimeFromPreviousMinutes() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.ClearTravelTimeFromPreviousMinutes()
+	})
+}
+
+// SetTransportMode sets the "transport_mode" field.
+func (u *VisitUpsertBulk) SetTransportMode(v string) *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetTransportMode(v)
+	})
+}
+
+// UpdateTransportMode sets the "transport_mode" field to the value that was provided on create.
+func (u *VisitUpsertBulk) UpdateTransportMode() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateTransportMode()
+	})
+}
+
+// ClearTransportMode clears the value of the "transport_mode" field.
+func (u *VisitUpsertBulk) ClearTransportMode() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.ClearTransportMode()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *VisitUpsertBulk) SetNotes(v string) *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *VisitUpsertBulk) UpdateNotes() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *VisitUpsertBulk) ClearNotes() *VisitUpsertBulk {
+	return u.Update(func(s *VisitUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// Exec executes the query.
+func (u *VisitUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the VisitCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VisitCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VisitUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -334,7 +334,7 @@ func (_q *VisitQuery) WithPlace(opts ...func(*PlaceQuery)) *VisitQuery {
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Status types.Status `json:"status,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
@@ -357,7 +357,7 @@ func (_q *VisitQuery) GroupBy(field string, fields ...string) *VisitGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Status types.Status `json:"status,omitempty"`
 //	}
 //
 //	client.Visit.Query().
@@ -15,6 +15,7 @@ import (
 	"github.com/omkar273/nashikdarshan/ent/place"
 	"github.com/omkar273/nashikdarshan/ent/predicate"
 	"github.com/omkar273/nashikdarshan/ent/visit"
+	"github.com/omkar273/nashikdarshan/internal/types"
 )
 
 // VisitUpdate is the builder for updating Visit entities.
@@ -31,13 +32,13 @@ func (_u *VisitUpdate) Where(ps ...predicate.Visit) *VisitUpdate {
 }
 
 // SetStatus sets the "status" field.
-func (_u *VisitUpdate) SetStatus(v string) *VisitUpdate {
+func (_u *VisitUpdate) SetStatus(v types.Status) *VisitUpdate {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *VisitUpdate) SetNillableStatus(v *string) *VisitUpdate {
+func (_u *VisitUpdate) SetNillableStatus(v *types.Status) *VisitUpdate {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -70,6 +71,46 @@ func (_u *VisitUpdate) ClearUpdatedBy() *VisitUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *VisitUpdate) SetDeletedAt(v time.Time) *VisitUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *VisitUpdate) SetNillableDeletedAt(v *time.Time) *VisitUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *VisitUpdate) ClearDeletedAt() *VisitUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *VisitUpdate) SetDeletedBy(v string) *VisitUpdate {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *VisitUpdate) SetNillableDeletedBy(v *string) *VisitUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *VisitUpdate) ClearDeletedBy() *VisitUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetItineraryID sets the "itinerary_id" field.
 func (_u *VisitUpdate) SetItineraryID(v string) *VisitUpdate {
 	_u.mutation.SetItineraryID(v)
@@ -263,7 +304,9 @@ func (_u *VisitUpdate) ClearPlace() *VisitUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *VisitUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -290,15 +333,24 @@ func (_u *VisitUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *VisitUpdate) defaults() {
+func (_u *VisitUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if visit.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized visit.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := visit.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *VisitUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := visit.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Visit.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.ItineraryID(); ok {
 		if err := visit.ItineraryIDValidator(v); err != nil {
 			return &ValidationError{Name: "itinerary_id", err: fmt.Errorf(`ent: validator failed for field "Visit.itinerary_id": %w`, err)}
@@ -355,6 +407,18 @@ func (_u *VisitUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.UpdatedByCleared() {
 		_spec.ClearField(visit.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(visit.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(visit.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(visit.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(visit.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.SequenceOrder(); ok {
 		_spec.SetField(visit.FieldSequenceOrder, field.TypeInt, value)
 	}
@@ -476,13 +540,13 @@ type VisitUpdateOne struct {
 }
 
 // SetStatus sets the "status" field.
-func (_u *VisitUpdateOne) SetStatus(v string) *VisitUpdateOne {
+func (_u *VisitUpdateOne) SetStatus(v types.Status) *VisitUpdateOne {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *VisitUpdateOne) SetNillableStatus(v *string) *VisitUpdateOne {
+func (_u *VisitUpdateOne) SetNillableStatus(v *types.Status) *VisitUpdateOne {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -515,6 +579,46 @@ func (_u *VisitUpdateOne) ClearUpdatedBy() *VisitUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *VisitUpdateOne) SetDeletedAt(v time.Time) *VisitUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *VisitUpdateOne) SetNillableDeletedAt(v *time.Time) *VisitUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *VisitUpdateOne) ClearDeletedAt() *VisitUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *VisitUpdateOne) SetDeletedBy(v string) *VisitUpdateOne {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *VisitUpdateOne) SetNillableDeletedBy(v *string) *VisitUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *VisitUpdateOne) ClearDeletedBy() *VisitUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetItineraryID sets the "itinerary_id" field.
 func (_u *VisitUpdateOne) SetItineraryID(v string) *VisitUpdateOne {
 	_u.mutation.SetItineraryID(v)
@@ -721,7 +825,9 @@ func (_u *VisitUpdateOne) Select(field string, fields ...string) *VisitUpdateOne
 
 // Save executes the query and returns the updated Visit entity.
 func (_u *VisitUpdateOne) Save(ctx context.Context) (*Visit, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -748,15 +854,24 @@ func (_u *VisitUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *VisitUpdateOne) defaults() {
+func (_u *VisitUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if visit.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized visit.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := visit.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *VisitUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := visit.StatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Visit.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.ItineraryID(); ok {
 		if err := visit.ItineraryIDValidator(v); err != nil {
 			return &ValidationError{Name: "itinerary_id", err: fmt.Errorf(`ent: validator failed for field "Visit.itinerary_id": %w`, err)}
@@ -830,6 +945,18 @@ func (_u *VisitUpdateOne) sqlSave(ctx context.Context) (_node *Visit, err error)
 	if _u.mutation.UpdatedByCleared() {
 		_spec.ClearField(visit.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(visit.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(visit.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(visit.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(visit.FieldDeletedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.SequenceOrder(); ok {
 		_spec.SetField(visit.FieldSequenceOrder, field.TypeInt, value)
 	}
@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// DuplicatePlacesResponse lists candidate duplicate groups found by a geographic scan.
+// Groups are suggestions only; nothing is merged automatically.
+type DuplicatePlacesResponse struct {
+	RadiusM       float64                 `json:"radius_m"`
+	MinSimilarity float64                 `json:"min_similarity"`
+	TotalGroups   int                     `json:"total_groups"`
+	Groups        []*place.DuplicateGroup `json:"groups"`
+}
+
+// NewDuplicatePlacesResponse builds the response for a duplicate scan
+func NewDuplicatePlacesResponse(groups []*place.DuplicateGroup, filter *types.PlaceDuplicateFilter) *DuplicatePlacesResponse {
+	return &DuplicatePlacesResponse{
+		RadiusM:       filter.GetRadiusM(),
+		MinSimilarity: filter.GetMinSimilarity(),
+		TotalGroups:   len(groups),
+		Groups:        groups,
+	}
+}
+
+// ListIncompletePlacesResponse is a paginated list of places missing content
+type ListIncompletePlacesResponse = types.ListResponse[*place.IncompletePlace]
+
+// NewListIncompletePlacesResponse creates a paginated response for the incomplete places report
+func NewListIncompletePlacesResponse(places []*place.IncompletePlace, total, limit, offset int) *ListIncompletePlacesResponse {
+	response := types.NewListResponse(places, total, limit, offset)
+	return &response
+}
+
+// RefreshViewsResponse reports which materialized views were refreshed
+type RefreshViewsResponse struct {
+	Views       []string  `json:"views"`
+	RefreshedAt time.Time `json:"refreshed_at"`
+}
@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/domain/apikey"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/omkar273/nashikdarshan/internal/validator"
+	"github.com/samber/lo"
+)
+
+type CreateAPIKeyRequest struct {
+	Label     string     `json:"label" binding:"required,min=2,max=255"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// Validate validates the CreateAPIKeyRequest
+func (req *CreateAPIKeyRequest) Validate() error {
+	if err := validator.ValidateRequest(req); err != nil {
+		return err
+	}
+
+	for _, scope := range req.Scopes {
+		if err := types.Scope(scope).Validate(); err != nil {
+			return err
+		}
+	}
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return ierr.NewError("expires_at must be in the future").
+			WithHint("Please provide an expiry time in the future").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+type APIKeyResponse struct {
+	*apikey.APIKey
+}
+
+// CreateAPIKeyResponse includes the raw key, which is only returned once
+type CreateAPIKeyResponse struct {
+	*apikey.APIKey
+	Key string `json:"key"`
+}
+
+type ListAPIKeysResponse struct {
+	Items []*APIKeyResponse `json:"items"`
+}
+
+// NewListAPIKeysResponse creates a list response for API keys
+func NewListAPIKeysResponse(keys []*apikey.APIKey) *ListAPIKeysResponse {
+	return &ListAPIKeysResponse{
+		Items: lo.Map(keys, func(key *apikey.APIKey, _ int) *APIKeyResponse {
+			return &APIKeyResponse{APIKey: key}
+		}),
+	}
+}
@@ -18,9 +18,10 @@ type SignupRequest struct {
 	AccessToken string `json:"access_token" validate:"required"`
 }
 
+// ToUser converts SignupRequest to domain User. The id comes from the identity provider and
+// the creation times are assigned by the service.
 func (r *SignupRequest) ToUser(ctx context.Context) *user.User {
 	return &user.User{
-		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_USER),
 		Email:     r.Email,
 		Phone:     r.Phone,
 		Name:      r.Name,
@@ -45,7 +45,7 @@ func (req *UpdateCategoryRequest) Validate() error {
 	}
 
 	// Validate slug format if provided
-	if req.Slug != nil && *req.Slug != "" {
+	if req.Slug != nil {
 		if err := validator.ValidateSlugFormat(*req.Slug); err != nil {
 			return err
 		}
@@ -71,10 +71,11 @@ func NewListCategoriesResponse(categories []*category.Category, total, limit, of
 	return &response
 }
 
+// ToCategory converts CreateCategoryRequest to domain Category. The id and creation times
+// are assigned by the service.
 func (req *CreateCategoryRequest) ToCategory(ctx context.Context) *category.Category {
 	baseModel := types.GetDefaultBaseModel(ctx)
 	return &category.Category{
-		ID:          types.GenerateUUIDWithPrefix(types.UUID_PREFIX_CATEGORY),
 		Name:        req.Name,
 		Slug:        req.Slug,
 		Description: req.Description,
@@ -92,5 +93,5 @@ func (req *UpdateCategoryRequest) ApplyToCategory(ctx context.Context, cat *cate
 	if req.Description != nil {
 		cat.Description = *req.Description
 	}
-	cat.UpdatedBy = types.GetUserID(ctx)
+	cat.UpdatedBy = types.GetActor(ctx)
 }
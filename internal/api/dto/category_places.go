@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// CategoryPlacesResponse is a page of the published places in a category, alongside the category
+type CategoryPlacesResponse struct {
+	Category *CategoryResponse `json:"category"`
+	*ListPlacesResponse
+}
+
+// CategoryPlacesDataMetaResponse is the data-meta rendering of a CategoryPlacesResponse
+type CategoryPlacesDataMetaResponse struct {
+	Category *CategoryResponse `json:"category"`
+	*types.DataMetaResponse[*PlaceResponse]
+}
+
+// Envelope returns the response in the requested shape, keeping the category in both
+func (r *CategoryPlacesResponse) Envelope(envelope types.ListEnvelope) any {
+	if envelope != types.ListEnvelopeDataMeta {
+		return r
+	}
+	return &CategoryPlacesDataMetaResponse{
+		Category:         r.Category,
+		DataMetaResponse: r.ListPlacesResponse.Envelope(envelope).(*types.DataMetaResponse[*PlaceResponse]),
+	}
+}
+
+// Localize converts the category's and places' timestamps to loc
+func (r *CategoryPlacesResponse) Localize(loc *time.Location) {
+	r.Category.Localize(loc)
+	r.ListPlacesResponse.Localize(loc)
+}
+
+// Translate labels the places in the page in lang
+func (r *CategoryPlacesResponse) Translate(lang types.Language) {
+	r.ListPlacesResponse.Translate(lang)
+}
+
+// MaskPII masks the contact of every place in the page
+func (r *CategoryPlacesResponse) MaskPII() {
+	r.ListPlacesResponse.MaskPII()
+}
@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"math"
+
+	"github.com/omkar273/nashikdarshan/internal/domain/category"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// CategoryStatsResponse aggregates the published places of a category
+type CategoryStatsResponse struct {
+	Slug       string `json:"slug"`
+	Name       string `json:"name"`
+	PlaceCount int64  `json:"place_count"`
+	// AverageRating averages every review of the category's places, rounded to one decimal;
+	// null when they have no reviews
+	AverageRating *float64 `json:"average_rating"`
+	RatingCount   int64    `json:"rating_count"`
+	// WithImages is the number of places with at least one image, and WithImagesPct its
+	// percentage of PlaceCount, rounded to one decimal; 0 for an empty category
+	WithImages    int64                     `json:"with_images"`
+	WithImagesPct float64                   `json:"with_images_pct"`
+	PlaceTypes    []*PlaceTypeCountResponse `json:"place_types"`
+}
+
+// NewCategoryStatsResponse creates a category stats response, labelled in English
+func NewCategoryStatsResponse(cat *category.Category, stats *place.CategoryStats) *CategoryStatsResponse {
+	resp := &CategoryStatsResponse{
+		Slug:        cat.Slug,
+		Name:        cat.Name,
+		PlaceCount:  stats.PlaceCount,
+		RatingCount: stats.RatingCount,
+		WithImages:  stats.WithImages,
+		PlaceTypes:  newPlaceTypeCountResponses(stats.ByType),
+	}
+	if stats.RatingCount > 0 {
+		average := roundTo1(stats.RatingSum / float64(stats.RatingCount))
+		resp.AverageRating = &average
+	}
+	if stats.PlaceCount > 0 {
+		resp.WithImagesPct = roundTo1(float64(stats.WithImages) * 100 / float64(stats.PlaceCount))
+	}
+	return resp
+}
+
+// Translate labels the place types in lang
+func (r *CategoryStatsResponse) Translate(lang types.Language) {
+	translatePlaceTypeCounts(r.PlaceTypes, lang)
+}
+
+func roundTo1(v float64) float64 {
+	return math.Round(v*10) / 10
+}
@@ -0,0 +1,81 @@
+package dto
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/omkar273/nashikdarshan/internal/domain/category"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+func TestNewCategoryStatsResponse(t *testing.T) {
+	temples := &category.Category{Slug: "temples", Name: "Temples"}
+
+	tests := []struct {
+		name        string
+		stats       *place.CategoryStats
+		wantAverage string
+		wantPct     float64
+	}{
+		{
+			name:        "rounded average and share",
+			stats:       &place.CategoryStats{PlaceCount: 3, WithImages: 2, RatingSum: 13, RatingCount: 3},
+			wantAverage: `"average_rating":4.3`,
+			wantPct:     66.7,
+		},
+		{
+			name:        "every place with images",
+			stats:       &place.CategoryStats{PlaceCount: 4, WithImages: 4, RatingSum: 9, RatingCount: 2},
+			wantAverage: `"average_rating":4.5`,
+			wantPct:     100,
+		},
+		{
+			name:        "no reviews",
+			stats:       &place.CategoryStats{PlaceCount: 2, WithImages: 1},
+			wantAverage: `"average_rating":null`,
+			wantPct:     50,
+		},
+		{
+			name:        "empty category",
+			stats:       &place.CategoryStats{},
+			wantAverage: `"average_rating":null`,
+			wantPct:     0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := NewCategoryStatsResponse(temples, tt.stats)
+			if resp.WithImagesPct != tt.wantPct {
+				t.Errorf("WithImagesPct = %v, want %v", resp.WithImagesPct, tt.wantPct)
+			}
+
+			body, err := json.Marshal(resp)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if !strings.Contains(string(body), tt.wantAverage) {
+				t.Errorf("response = %s, want %s", body, tt.wantAverage)
+			}
+			// Types are listed even when there are none
+			if !strings.Contains(string(body), `"place_types":[]`) {
+				t.Errorf("response = %s, want place_types []", body)
+			}
+		})
+	}
+}
+
+func TestCategoryStatsResponseTranslate(t *testing.T) {
+	resp := NewCategoryStatsResponse(&category.Category{Slug: "temples"}, &place.CategoryStats{
+		PlaceCount: 1,
+		ByType:     []*place.PlaceTypeCount{{PlaceType: types.PlaceType("temple"), Count: 1}},
+	})
+	english := resp.PlaceTypes[0].Label
+
+	resp.Translate(types.LanguageMarathi)
+	if resp.PlaceTypes[0].Label == "" || resp.PlaceTypes[0].Label == english {
+		t.Errorf("Label = %q after translating to Marathi, want other than %q", resp.PlaceTypes[0].Label, english)
+	}
+}
@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+)
+
+// indiaCallingCode prefixes Indian numbers in E.164
+const indiaCallingCode = "+91"
+
+// indiaTwoDigitSTDCodes are the metro STD codes with two digits, whose landlines have eight
+// digit subscriber numbers. Other STD codes are taken to have three digits, as most do.
+var indiaTwoDigitSTDCodes = []string{"11", "20", "22", "33", "40", "44", "79", "80"}
+
+// ContactResponse is the contact of a place with display forms added. The stored values stay
+// authoritative: phone is returned in E.164 as stored and phone_display is derived from it.
+type ContactResponse struct {
+	*types.Contact
+	// PhoneDisplay is the phone number grouped for reading, e.g. +91 98765 43210
+	PhoneDisplay *string `json:"phone_display,omitempty"`
+	// Website is the stored website, given an https scheme if it has none
+	Website *string `json:"website,omitempty"`
+}
+
+// NewContactResponse creates a ContactResponse, or nil when the contact is empty
+func NewContactResponse(c *types.Contact) *ContactResponse {
+	if c.IsEmpty() {
+		return nil
+	}
+
+	resp := &ContactResponse{Contact: c}
+	if c.Phone != nil {
+		resp.PhoneDisplay = lo.ToPtr(FormatPhoneDisplay(*c.Phone))
+	}
+	if c.Website != nil {
+		resp.Website = lo.ToPtr(WebsiteWithScheme(*c.Website))
+	}
+	return resp
+}
+
+// FormatPhoneDisplay groups an E.164 Indian number for reading: mobiles as
+// +91 98765 43210 and landlines by STD code, e.g. +91 22 2345 6789 or +91 253 2345678.
+// Other numbers are returned unchanged.
+func FormatPhoneDisplay(phone string) string {
+	national, ok := strings.CutPrefix(phone, indiaCallingCode)
+	if !ok || len(national) != 10 || strings.Trim(national, "0123456789") != "" {
+		return phone
+	}
+
+	switch {
+	case strings.ContainsRune("6789", rune(national[0])):
+		// Mobile numbers start with 6-9 and are read as two groups of five
+		return indiaCallingCode + " " + national[:5] + " " + national[5:]
+	case lo.Contains(indiaTwoDigitSTDCodes, national[:2]):
+		return indiaCallingCode + " " + national[:2] + " " + national[2:6] + " " + national[6:]
+	default:
+		return indiaCallingCode + " " + national[:3] + " " + national[3:]
+	}
+}
+
+// WebsiteWithScheme returns website with an https scheme when it has none, e.g. for
+// www.example.com. Websites that already have a scheme are returned unchanged.
+func WebsiteWithScheme(website string) string {
+	if u, err := url.Parse(website); err == nil && u.Scheme != "" && u.Host != "" {
+		return website
+	}
+	return "https://" + strings.TrimPrefix(website, "//")
+}
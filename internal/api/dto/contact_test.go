@@ -0,0 +1,30 @@
+package dto
+
+import "testing"
+
+func TestFormatPhoneDisplay(t *testing.T) {
+	tests := []struct {
+		name  string
+		phone string
+		want  string
+	}{
+		{name: "mobile", phone: "+919876543210", want: "+91 98765 43210"},
+		{name: "mobile starting with 6", phone: "+916123456789", want: "+91 61234 56789"},
+		{name: "two digit STD code", phone: "+912223456789", want: "+91 22 2345 6789"},
+		{name: "three digit STD code", phone: "+912532345678", want: "+91 253 2345678"},
+		{name: "foreign number", phone: "+14155552671", want: "+14155552671"},
+		{name: "too short", phone: "+91987654321", want: "+91987654321"},
+		{name: "too long", phone: "+9198765432101", want: "+9198765432101"},
+		{name: "non-digits", phone: "+91 9876543210", want: "+91 9876543210"},
+		{name: "no calling code", phone: "9876543210", want: "9876543210"},
+		{name: "empty", phone: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatPhoneDisplay(tt.phone); got != tt.want {
+				t.Errorf("FormatPhoneDisplay(%q) = %q, want %q", tt.phone, got, tt.want)
+			}
+		})
+	}
+}
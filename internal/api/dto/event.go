@@ -97,7 +97,8 @@ func (req *CreateEventRequest) Validate() error {
 	return nil
 }
 
-// ToEvent converts CreateEventRequest to domain Event
+// ToEvent converts CreateEventRequest to domain Event. The id and creation times are
+// assigned by the service.
 func (req *CreateEventRequest) ToEvent(ctx context.Context) (*eventdomain.Event, error) {
 	baseModel := types.GetDefaultBaseModel(ctx)
 
@@ -121,7 +122,6 @@ func (req *CreateEventRequest) ToEvent(ctx context.Context) (*eventdomain.Event,
 	}
 
 	return &eventdomain.Event{
-		ID:              types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EVENT),
 		Slug:            req.Slug,
 		Type:            req.Type,
 		Title:           req.Title,
@@ -393,7 +393,8 @@ func (req *CreateOccurrenceRequest) Validate() error {
 	return nil
 }
 
-// ToOccurrence converts CreateOccurrenceRequest to domain EventOccurrence
+// ToOccurrence converts CreateOccurrenceRequest to domain EventOccurrence. The id and
+// creation times are assigned by the service.
 func (req *CreateOccurrenceRequest) ToOccurrence(ctx context.Context) (*eventdomain.EventOccurrence, error) {
 	baseModel := types.GetDefaultBaseModel(ctx)
 
@@ -418,7 +419,6 @@ func (req *CreateOccurrenceRequest) ToOccurrence(ctx context.Context) (*eventdom
 	}
 
 	return &eventdomain.EventOccurrence{
-		ID:              types.GenerateUUIDWithPrefix(types.UUID_PREFIX_OCCURRENCE),
 		EventID:         req.EventID,
 		RecurrenceType:  req.RecurrenceType,
 		StartTime:       req.StartTime, // Optional/nillable
@@ -561,3 +561,72 @@ func NewExpandedOccurrenceResponse(expanded *eventdomain.ExpandedOccurrence) *Ex
 		ExpandedOccurrence: expanded,
 	}
 }
+
+// ========== Schedule DTOs ==========
+
+const (
+	// DefaultUpcomingEventsWindow is the look-ahead used when no window is requested
+	DefaultUpcomingEventsWindow = 7 * 24 * time.Hour
+	// MaxUpcomingEventsWindow caps the look-ahead to keep occurrence expansion bounded
+	MaxUpcomingEventsWindow = 366 * 24 * time.Hour
+)
+
+// UpcomingEventsRequest represents the query parameters for listing upcoming events
+type UpcomingEventsRequest struct {
+	Within *string `form:"within"` // Go duration string, e.g. "72h" (default 168h, max 8784h)
+}
+
+// Validate validates the UpcomingEventsRequest
+func (req *UpcomingEventsRequest) Validate() error {
+	_, err := req.GetWithin()
+	return err
+}
+
+// GetWithin returns the parsed look-ahead window or the default
+func (req *UpcomingEventsRequest) GetWithin() (time.Duration, error) {
+	if req.Within == nil || *req.Within == "" {
+		return DefaultUpcomingEventsWindow, nil
+	}
+
+	within, err := time.ParseDuration(*req.Within)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("within must be a duration such as 24h or 168h").
+			WithReportableDetails(map[string]any{
+				"within": *req.Within,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if within <= 0 || within > MaxUpcomingEventsWindow {
+		return 0, ierr.NewError("within is out of range").
+			WithHintf("within must be greater than 0 and at most %s", MaxUpcomingEventsWindow).
+			WithReportableDetails(map[string]any{
+				"within": *req.Within,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return within, nil
+}
+
+// UpcomingEventsResponse represents the expanded event instances in a time window
+type UpcomingEventsResponse struct {
+	From  time.Time                     `json:"from"`
+	To    time.Time                     `json:"to"`
+	Items []*ExpandedOccurrenceResponse `json:"items"`
+}
+
+// NewUpcomingEventsResponse creates an UpcomingEventsResponse from expanded occurrences
+func NewUpcomingEventsResponse(instances []*eventdomain.ExpandedOccurrence, from, to time.Time) *UpcomingEventsResponse {
+	items := make([]*ExpandedOccurrenceResponse, len(instances))
+	for i, instance := range instances {
+		items[i] = NewExpandedOccurrenceResponse(instance)
+	}
+
+	return &UpcomingEventsResponse{
+		From:  from,
+		To:    to,
+		Items: items,
+	}
+}
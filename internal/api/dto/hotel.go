@@ -178,12 +178,12 @@ func NewHotelResponse(h *hotel.Hotel) *HotelResponse {
 	}
 }
 
-// ToHotel converts CreateHotelRequest to domain Hotel
+// ToHotel converts CreateHotelRequest to domain Hotel. The id and creation times are
+// assigned by the service.
 func (req *CreateHotelRequest) ToHotel(ctx context.Context) (*hotel.Hotel, error) {
 	baseModel := types.GetDefaultBaseModel(ctx)
 
 	return &hotel.Hotel{
-		ID:              types.GenerateUUIDWithPrefix(types.UUID_PREFIX_HOTEL),
 		Slug:            req.Slug,
 		Name:            req.Name,
 		Description:     req.Description,
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"net/url"
 	"time"
 
 	"github.com/omkar273/nashikdarshan/internal/domain/itinerary"
@@ -172,10 +173,76 @@ type VisitResponse struct {
 
 // ListItinerariesResponse represents a paginated list of itineraries
 type ListItinerariesResponse struct {
-	Itineraries []*ItineraryResponse `json:"itineraries"`
-	Total       int                  `json:"total"`
-	Limit       int                  `json:"limit"`
-	Offset      int                  `json:"offset"`
+	Itineraries []*ItineraryResponse   `json:"itineraries"`
+	Total       int                    `json:"total"`
+	Limit       int                    `json:"limit"`
+	Offset      int                    `json:"offset"`
+	Page        int                    `json:"page,omitempty"`
+	PerPage     int                    `json:"per_page,omitempty"`
+	TotalPages  int                    `json:"total_pages,omitempty"`
+	Links       *types.PaginationLinks `json:"links,omitempty"`
+}
+
+// SetLinks populates the pagination links from the absolute URL of the current request
+func (r *ListItinerariesResponse) SetLinks(requestURL *url.URL) {
+	r.Links = types.NewPaginationLinks(requestURL, r.Total, r.Limit, r.Offset)
+}
+
+// Localize converts the itinerary and visit timestamps to loc for presentation
+func (r *ItineraryResponse) Localize(loc *time.Location) {
+	r.CreatedAt = r.CreatedAt.In(loc)
+	r.UpdatedAt = r.UpdatedAt.In(loc)
+	for _, visit := range r.Visits {
+		visit.Localize(loc)
+	}
+}
+
+// Localize converts the visit timestamps to loc for presentation
+func (r *VisitResponse) Localize(loc *time.Location) {
+	r.CreatedAt = r.CreatedAt.In(loc)
+	r.UpdatedAt = r.UpdatedAt.In(loc)
+	if r.Place != nil {
+		r.Place.Localize(loc)
+	}
+}
+
+// Translate labels the visited places in lang
+func (r *ItineraryResponse) Translate(lang types.Language) {
+	for _, visit := range r.Visits {
+		if visit.Place != nil {
+			visit.Place.Translate(lang)
+		}
+	}
+}
+
+// Translate labels the places visited by every itinerary in lang
+func (r *ListItinerariesResponse) Translate(lang types.Language) {
+	for _, itin := range r.Itineraries {
+		itin.Translate(lang)
+	}
+}
+
+// MaskPII masks the contact of every visited place
+func (r *ItineraryResponse) MaskPII() {
+	for _, visit := range r.Visits {
+		if visit.Place != nil {
+			visit.Place.MaskPII()
+		}
+	}
+}
+
+// MaskPII masks the contact of the places visited by every itinerary
+func (r *ListItinerariesResponse) MaskPII() {
+	for _, itin := range r.Itineraries {
+		itin.MaskPII()
+	}
+}
+
+// Localize converts the timestamps of every itinerary to loc for presentation
+func (r *ListItinerariesResponse) Localize(loc *time.Location) {
+	for _, itin := range r.Itineraries {
+		itin.Localize(loc)
+	}
 }
 
 // NewItineraryResponse creates a new ItineraryResponse from domain model
@@ -242,12 +309,18 @@ func NewVisitResponse(visit *itinerary.Visit) *VisitResponse {
 
 // NewItineraryListResponse creates a new ListItinerariesResponse
 func NewItineraryListResponse(itineraries []*itinerary.Itinerary, total, limit, offset int) *ListItinerariesResponse {
+	pagination := types.PaginationResponse{Total: total, Limit: limit, Offset: offset}
+	pagination.SetPages()
+
 	return &ListItinerariesResponse{
 		Itineraries: lo.Map(itineraries, func(itin *itinerary.Itinerary, _ int) *ItineraryResponse {
 			return NewItineraryResponse(itin)
 		}),
-		Total:  total,
-		Limit:  limit,
-		Offset: offset,
+		Total:      total,
+		Limit:      limit,
+		Offset:     offset,
+		Page:       pagination.Page,
+		PerPage:    pagination.PerPage,
+		TotalPages: pagination.TotalPages,
 	}
 }
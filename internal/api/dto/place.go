@@ -2,27 +2,39 @@ package dto
 
 import (
 	"context"
+	"time"
 
+	eventdomain "github.com/omkar273/nashikdarshan/internal/domain/event"
 	"github.com/omkar273/nashikdarshan/internal/domain/place"
 	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/markdown"
 	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/omkar273/nashikdarshan/internal/validator"
 	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 )
 
-// CreatePlaceRequest represents a request to create a place
+// CreatePlaceRequest represents a request to create a place. When slug is omitted one is
+// generated from the title.
 type CreatePlaceRequest struct {
-	Slug             string            `json:"slug" binding:"required,min=3,max=100"`
-	Title            string            `json:"title" binding:"required,min=2,max=255"`
-	Subtitle         *string           `json:"subtitle,omitempty" binding:"omitempty,max=500"`
-	ShortDescription *string           `json:"short_description,omitempty" binding:"omitempty,max=1000"`
-	LongDescription  *string           `json:"long_description,omitempty" binding:"omitempty,max=10000"`
-	PlaceType        types.PlaceType   `json:"place_type" binding:"required"`
-	Address          map[string]string `json:"address,omitempty"`
-	Location         types.Location    `json:"location" binding:"required"`
-	PrimaryImageURL  *string           `json:"primary_image_url,omitempty" binding:"omitempty,url,max=500"`
-	ThumbnailURL     *string           `json:"thumbnail_url,omitempty" binding:"omitempty,url,max=500"`
+	Slug             string               `json:"slug,omitempty" binding:"omitempty,min=3,max=100"`
+	Title            string               `json:"title" binding:"required,min=2,max=255"`
+	Subtitle         *string              `json:"subtitle,omitempty" binding:"omitempty,max=500"`
+	ShortDescription *string              `json:"short_description,omitempty" binding:"omitempty,max=1000"`
+	LongDescription  *string              `json:"long_description,omitempty" binding:"omitempty,max=10000"`
+	PlaceType        types.PlaceType      `json:"place_type" binding:"required"`
+	Address          map[string]string    `json:"address,omitempty"`
+	Location         types.Location       `json:"location" binding:"required"`
+	PrimaryImageURL  *string              `json:"primary_image_url,omitempty" binding:"omitempty,url,max=500"`
+	ThumbnailURL     *string              `json:"thumbnail_url,omitempty" binding:"omitempty,url,max=500"`
+	PriceInfo        *types.PriceInfo     `json:"price_info,omitempty"`
+	Contact          *types.Contact       `json:"contact,omitempty"`
+	Accessibility    *types.Accessibility `json:"accessibility,omitempty" swaggertype:"object,boolean"`
+	GeofenceRadiusM  *int                 `json:"geofence_radius_m,omitempty"`
+	// PublishAt and UnpublishAt schedule when a draft place is published and when a published
+	// place is archived
+	PublishAt   *time.Time `json:"publish_at,omitempty"`
+	UnpublishAt *time.Time `json:"unpublish_at,omitempty"`
 }
 
 // Validate validates the CreatePlaceRequest
@@ -33,8 +45,10 @@ func (req *CreatePlaceRequest) Validate() error {
 	}
 
 	// Validate slug format (kebab-case)
-	if err := validator.ValidateSlugFormat(req.Slug); err != nil {
-		return err
+	if req.Slug != "" {
+		if err := validator.ValidateSlugFormat(req.Slug); err != nil {
+			return err
+		}
 	}
 
 	// Validate location coordinates
@@ -47,20 +61,73 @@ func (req *CreatePlaceRequest) Validate() error {
 		return err
 	}
 
+	if err := req.PriceInfo.Validate(); err != nil {
+		return err
+	}
+
+	types.NormalizeAddress(req.Address)
+
+	req.Contact.Normalize()
+	if err := req.Contact.Validate(); err != nil {
+		return err
+	}
+
+	if err := req.Accessibility.Validate(); err != nil {
+		return err
+	}
+
+	if err := types.ValidateGeofenceRadius(req.GeofenceRadiusM); err != nil {
+		return err
+	}
+
+	if err := types.ValidatePublishSchedule(req.PublishAt, req.UnpublishAt); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// AdminFields returns the admin-only fields the request sets
+func (req *CreatePlaceRequest) AdminFields() []string {
+	fields := make([]string, 0, len(types.AdminPlaceFields))
+	if req.PublishAt != nil {
+		fields = append(fields, types.PlaceRequestFieldPublishAt)
+	}
+	if req.UnpublishAt != nil {
+		fields = append(fields, types.PlaceRequestFieldUnpublishAt)
+	}
+	return fields
+}
+
+// StripAdminFields drops the admin-only fields from the request
+func (req *CreatePlaceRequest) StripAdminFields() {
+	req.PublishAt = nil
+	req.UnpublishAt = nil
+}
+
 // UpdatePlaceRequest represents a request to update a place
 type UpdatePlaceRequest struct {
-	Slug             *string           `json:"slug,omitempty" binding:"omitempty,min=3,max=100"`
-	Title            *string           `json:"title,omitempty" binding:"omitempty,min=2,max=255"`
-	Subtitle         *string           `json:"subtitle,omitempty" binding:"omitempty,max=500"`
-	ShortDescription *string           `json:"short_description,omitempty" binding:"omitempty,max=1000"`
-	LongDescription  *string           `json:"long_description,omitempty" binding:"omitempty,max=10000"`
-	Address          map[string]string `json:"address,omitempty"`
-	Location         *types.Location   `json:"location,omitempty"`
-	PrimaryImageURL  *string           `json:"primary_image_url,omitempty" binding:"omitempty,url,max=500"`
-	ThumbnailURL     *string           `json:"thumbnail_url,omitempty" binding:"omitempty,url,max=500"`
+	Slug             *string              `json:"slug,omitempty" binding:"omitempty,min=3,max=100"`
+	Title            *string              `json:"title,omitempty" binding:"omitempty,min=2,max=255"`
+	Subtitle         *string              `json:"subtitle,omitempty" binding:"omitempty,max=500"`
+	ShortDescription *string              `json:"short_description,omitempty" binding:"omitempty,max=1000"`
+	LongDescription  *string              `json:"long_description,omitempty" binding:"omitempty,max=10000"`
+	Address          map[string]string    `json:"address,omitempty"`
+	Location         *types.Location      `json:"location,omitempty"`
+	PrimaryImageURL  *string              `json:"primary_image_url,omitempty" binding:"omitempty,url,max=500"`
+	ThumbnailURL     *string              `json:"thumbnail_url,omitempty" binding:"omitempty,url,max=500"`
+	PriceInfo        *types.PriceInfo     `json:"price_info,omitempty"`
+	Contact          *types.Contact       `json:"contact,omitempty"`
+	Accessibility    *types.Accessibility `json:"accessibility,omitempty" swaggertype:"object,boolean"`
+	GeofenceRadiusM  *int                 `json:"geofence_radius_m,omitempty"`
+	PublishAt        *time.Time           `json:"publish_at,omitempty"`
+	UnpublishAt      *time.Time           `json:"unpublish_at,omitempty"`
+	// Status moves the place between published, draft and archived; deleting goes through DELETE
+	Status *types.Status `json:"status,omitempty"`
+
+	// UnmodifiedSince is the If-Unmodified-Since precondition: the update is refused when the
+	// place was modified after it
+	UnmodifiedSince *time.Time `json:"-"`
 }
 
 // Validate validates the UpdatePlaceRequest
@@ -71,7 +138,7 @@ func (req *UpdatePlaceRequest) Validate() error {
 	}
 
 	// Validate slug format if provided
-	if req.Slug != nil && *req.Slug != "" {
+	if req.Slug != nil {
 		if err := validator.ValidateSlugFormat(*req.Slug); err != nil {
 			return err
 		}
@@ -86,13 +153,96 @@ func (req *UpdatePlaceRequest) Validate() error {
 
 	// Validate place type
 
+	if err := req.PriceInfo.Validate(); err != nil {
+		return err
+	}
+
+	types.NormalizeAddress(req.Address)
+
+	req.Contact.Normalize()
+	if err := req.Contact.Validate(); err != nil {
+		return err
+	}
+
+	if err := req.Accessibility.Validate(); err != nil {
+		return err
+	}
+
+	if err := types.ValidateGeofenceRadius(req.GeofenceRadiusM); err != nil {
+		return err
+	}
+
+	if req.Status != nil {
+		if err := validateUpdateStatus(*req.Status); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateUpdateStatus accepts the statuses an update may move a place to
+func validateUpdateStatus(status types.Status) error {
+	if err := status.Validate(); err != nil {
+		return err
+	}
+	if status == types.StatusDeleted {
+		return ierr.NewError("status cannot be set to deleted").
+			WithHint("Please delete the place instead").
+			Mark(ierr.ErrValidation)
+	}
 	return nil
 }
 
+// AdminFields returns the admin-only fields the request sets
+func (req *UpdatePlaceRequest) AdminFields() []string {
+	fields := make([]string, 0, len(types.AdminPlaceFields))
+	if req.Status != nil {
+		fields = append(fields, types.PlaceRequestFieldStatus)
+	}
+	if req.PublishAt != nil {
+		fields = append(fields, types.PlaceRequestFieldPublishAt)
+	}
+	if req.UnpublishAt != nil {
+		fields = append(fields, types.PlaceRequestFieldUnpublishAt)
+	}
+	return fields
+}
+
+// StripAdminFields drops the admin-only fields from the request
+func (req *UpdatePlaceRequest) StripAdminFields() {
+	req.Status = nil
+	req.PublishAt = nil
+	req.UnpublishAt = nil
+}
+
 // PlaceResponse represents a place in the response
 type PlaceResponse struct {
 	*place.Place
-	Images []*PlaceImageResponse `json:"images,omitempty"`
+	// Images is always present, [] when the place has none
+	Images  []*PlaceImageResponse `json:"images"`
+	Contact *ContactResponse      `json:"contact,omitempty"`
+	// PrimaryImageURL and ThumbnailURL fall back to the configured placeholder when the place
+	// has none; HasRealImage tells whether primary_image_url is the place's own image
+	PrimaryImageURL *string `json:"primary_image_url,omitempty"`
+	ThumbnailURL    *string `json:"thumbnail_url,omitempty"`
+	HasRealImage    bool    `json:"has_real_image"`
+	// Completeness is the weighted share (0-100) of the checked content the place has
+	Completeness *int                            `json:"completeness,omitempty"`
+	NextEvent    *eventdomain.ExpandedOccurrence `json:"next_event,omitempty"`
+	// PlaceTypeLabel and StatusLabel name place_type and status in the language picked from
+	// Accept-Language, English by default; place_type and status keep the canonical values
+	PlaceTypeLabel string `json:"place_type_label,omitempty"`
+	StatusLabel    string `json:"status_label,omitempty"`
+	// DistanceM and Score are set on rank=best nearby listings
+	DistanceM *float64 `json:"distance_m,omitempty"`
+	Score     *float64 `json:"score,omitempty"`
+	// LongDescriptionHTML is the long description rendered from Markdown, set with render=html
+	LongDescriptionHTML *string `json:"long_description_html,omitempty"`
+	// DistanceFromCenterKm is the distance from the city center, set with include=center_distance
+	DistanceFromCenterKm *float64 `json:"distance_from_center_km,omitempty"`
+	// Geohash encodes the location at the configured precision or the one the request asks for
+	Geohash string `json:"geohash,omitempty"`
 }
 
 // PlaceImageResponse represents a place image in the response
@@ -113,12 +263,12 @@ func (req *CreatePlaceImageRequest) Validate() error {
 	return validator.ValidateRequest(req)
 }
 
-// ToPlaceImage converts CreatePlaceImageRequest to domain PlaceImage
+// ToPlaceImage converts CreatePlaceImageRequest to domain PlaceImage. The id and creation
+// times are assigned by the service.
 func (req *CreatePlaceImageRequest) ToPlaceImage(ctx context.Context, placeID string) *place.PlaceImage {
 	baseModel := types.GetDefaultBaseModel(ctx)
 
 	image := &place.PlaceImage{
-		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PLACE_IMAGE),
 		PlaceID:   placeID,
 		URL:       req.URL,
 		Pos:       req.Pos,
@@ -161,15 +311,33 @@ func (req *UpdatePlaceImageRequest) ApplyToPlaceImage(ctx context.Context, image
 	}
 	if req.Metadata != nil {
 		image.Metadata = req.Metadata
+	} else if req.Alt != nil && image.Metadata != nil {
+		// Alt text set by an editor has been reviewed
+		metadata := lo.OmitByKeys(*image.Metadata, []string{place.ImageMetadataAltSource})
+		image.Metadata = &metadata
 	}
 }
 
+// DeletePlaceImagesRequest represents a request to delete several images of a place
+type DeletePlaceImagesRequest struct {
+	ImageIDs []string `json:"image_ids" binding:"required,min=1,max=100,dive,required"`
+}
+
+// Validate validates the DeletePlaceImagesRequest and drops duplicate IDs
+func (req *DeletePlaceImagesRequest) Validate() error {
+	if err := validator.ValidateRequest(req); err != nil {
+		return err
+	}
+	req.ImageIDs = lo.Uniq(req.ImageIDs)
+	return nil
+}
+
 // AssignCategoriesRequest represents a request to assign categories to a place
 type AssignCategoriesRequest struct {
 	CategoryIDs []string `json:"category_ids" binding:"required,min=0"`
 }
 
-// Validate validates the AssignCategoriesRequest
+// Validate validates the AssignCategoriesRequest and drops duplicate IDs
 func (req *AssignCategoriesRequest) Validate() error {
 	// Validate struct tags
 	if err := validator.ValidateRequest(req); err != nil {
@@ -183,6 +351,7 @@ func (req *AssignCategoriesRequest) Validate() error {
 				Mark(ierr.ErrValidation)
 		}
 	}
+	req.CategoryIDs = lo.Uniq(req.CategoryIDs)
 
 	return nil
 }
@@ -192,26 +361,123 @@ type ListPlacesResponse = types.ListResponse[*PlaceResponse]
 
 // NewPlaceResponse creates a PlaceResponse from domain Place
 func NewPlaceResponse(p *place.Place) *PlaceResponse {
-	resp := &PlaceResponse{
+	// lo.Map returns an empty slice rather than nil for a place without images, so the
+	// images array is [] rather than null
+	return &PlaceResponse{
 		Place: p,
+		Images: lo.Map(p.Images, func(img *place.PlaceImage, _ int) *PlaceImageResponse {
+			return &PlaceImageResponse{PlaceImage: img}
+		}),
+		Contact:         NewContactResponse(p.Contact),
+		PrimaryImageURL: withPlaceholder(p.PrimaryImageURL, types.PlaceholderImageURL()),
+		ThumbnailURL:    withPlaceholder(p.ThumbnailURL, types.PlaceholderThumbnailURL()),
+		HasRealImage:    p.PrimaryImageURL != nil && *p.PrimaryImageURL != "",
+		Completeness:    p.Completeness(),
+		PlaceTypeLabel:  p.PlaceType.Label(types.LanguageEnglish),
+		StatusLabel:     p.Status.Label(types.LanguageEnglish),
+		Geohash:         p.Location.Geohash(types.GeohashPrecision()),
 	}
+}
 
-	// Convert images using lo.Map
-	if len(p.Images) > 0 {
-		resp.Images = lo.Map(p.Images, func(img *place.PlaceImage, _ int) *PlaceImageResponse {
-			return &PlaceImageResponse{PlaceImage: img}
-		})
+// withPlaceholder returns url, or the placeholder when url is unset and a placeholder is
+// configured. The stored place is never changed.
+func withPlaceholder(url *string, placeholder string) *string {
+	if (url == nil || *url == "") && placeholder != "" {
+		return &placeholder
+	}
+	return url
+}
+
+// RenderHTML renders the Markdown long description into LongDescriptionHTML. The stored
+// Markdown in long_description is returned unchanged.
+func (r *PlaceResponse) RenderHTML() {
+	if r.Place == nil || r.LongDescription == nil {
+		return
+	}
+	rendered := markdown.ToHTML(*r.LongDescription)
+	r.LongDescriptionHTML = &rendered
+}
+
+// SetCenterDistance sets DistanceFromCenterKm to the distance of the place from center
+func (r *PlaceResponse) SetCenterDistance(center types.Location) {
+	if r.Place == nil {
+		return
+	}
+	r.DistanceFromCenterKm = lo.ToPtr(types.CenterDistanceKm(center, r.Location))
+}
+
+// SetGeohash recomputes Geohash at precision
+func (r *PlaceResponse) SetGeohash(precision int) {
+	if r.Place == nil {
+		return
+	}
+	r.Geohash = r.Location.Geohash(precision)
+}
+
+// Localize converts the place and image timestamps to loc for presentation
+func (r *PlaceResponse) Localize(loc *time.Location) {
+	if r.Place != nil {
+		r.Place.Localize(loc)
 	}
+	for _, img := range r.Images {
+		if img.PlaceImage != nil {
+			img.PlaceImage.Localize(loc)
+		}
+	}
+}
 
-	return resp
+// Translate labels the place type and status in lang
+func (r *PlaceResponse) Translate(lang types.Language) {
+	if r.Place == nil {
+		return
+	}
+	r.PlaceTypeLabel = r.PlaceType.Label(lang)
+	r.StatusLabel = r.Status.Label(lang)
 }
 
-// ToPlace converts CreatePlaceRequest to domain Place
+// Translate labels the places in the section in lang
+func (r *FeedSectionResponse) Translate(lang types.Language) {
+	for _, p := range r.Items {
+		p.Translate(lang)
+	}
+}
+
+// Translate labels the places in the feed in lang
+func (r *FeedResponse) Translate(lang types.Language) {
+	for i := range r.Sections {
+		r.Sections[i].Translate(lang)
+	}
+}
+
+// MaskPII masks the contact per the configured contact masking rules. The place itself is
+// left untouched; only the contact sent in the response changes.
+func (r *PlaceResponse) MaskPII() {
+	if r.Place == nil {
+		return
+	}
+	r.Contact = NewContactResponse(r.Place.Contact.Masked())
+}
+
+// MaskPII masks the contact of every place in the section
+func (r *FeedSectionResponse) MaskPII() {
+	for _, p := range r.Items {
+		p.MaskPII()
+	}
+}
+
+// MaskPII masks the contact of every place in the feed
+func (r *FeedResponse) MaskPII() {
+	for i := range r.Sections {
+		r.Sections[i].MaskPII()
+	}
+}
+
+// ToPlace converts CreatePlaceRequest to domain Place. The id and creation times are
+// assigned by the service.
 func (req *CreatePlaceRequest) ToPlace(ctx context.Context) (*place.Place, error) {
 	baseModel := types.GetDefaultBaseModel(ctx)
 
 	return &place.Place{
-		ID:               types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PLACE),
 		Slug:             req.Slug,
 		Title:            req.Title,
 		Subtitle:         req.Subtitle,
@@ -222,12 +488,29 @@ func (req *CreatePlaceRequest) ToPlace(ctx context.Context) (*place.Place, error
 		Location:         req.Location,
 		PrimaryImageURL:  req.PrimaryImageURL,
 		ThumbnailURL:     req.ThumbnailURL,
+		PriceInfo:        req.PriceInfo,
+		Contact:          req.Contact,
+		Accessibility:    req.Accessibility,
+		GeofenceRadiusM:  req.GeofenceRadiusM,
+		PublishAt:        req.PublishAt,
+		UnpublishAt:      req.UnpublishAt,
 		BaseModel:        baseModel,
 	}, nil
 }
 
-// ApplyToPlace applies UpdatePlaceRequest to domain Place
+// ApplyToPlace applies UpdatePlaceRequest to domain Place once its precondition holds,
+// checking the resulting publishing schedule
 func (req *UpdatePlaceRequest) ApplyToPlace(ctx context.Context, p *place.Place) error {
+	if err := req.apply(ctx, p); err != nil {
+		return err
+	}
+	return types.ValidatePublishSchedule(p.PublishAt, p.UnpublishAt)
+}
+
+func (req *UpdatePlaceRequest) apply(ctx context.Context, p *place.Place) error {
+	if err := types.CheckUnmodifiedSince(p.UpdatedAt, req.UnmodifiedSince); err != nil {
+		return err
+	}
 	if req.Slug != nil {
 		p.Slug = *req.Slug
 	}
@@ -255,10 +538,45 @@ func (req *UpdatePlaceRequest) ApplyToPlace(ctx context.Context, p *place.Place)
 	if req.ThumbnailURL != nil {
 		p.ThumbnailURL = req.ThumbnailURL
 	}
-	p.UpdatedBy = types.GetUserID(ctx)
+	if req.PriceInfo != nil {
+		p.PriceInfo = req.PriceInfo
+	}
+	if req.Contact != nil {
+		// An empty contact object clears the contact details
+		p.Contact = req.Contact
+	}
+	if req.Accessibility != nil {
+		p.Accessibility = req.Accessibility
+	}
+	if req.GeofenceRadiusM != nil {
+		p.GeofenceRadiusM = req.GeofenceRadiusM
+	}
+	if req.PublishAt != nil {
+		p.PublishAt = lo.ToPtr(req.PublishAt.UTC())
+	}
+	if req.UnpublishAt != nil {
+		p.UnpublishAt = lo.ToPtr(req.UnpublishAt.UTC())
+	}
+	if req.Status != nil {
+		p.Status = *req.Status
+	}
+	p.UpdatedBy = types.GetActor(ctx)
 	return nil
 }
 
+// NewRankedPlacesResponse creates a paginated list response for a rank=best nearby listing
+func NewRankedPlacesResponse(places []*place.RankedPlace, total, limit, offset int) *ListPlacesResponse {
+	items := lo.Map(places, func(p *place.RankedPlace, _ int) *PlaceResponse {
+		resp := NewPlaceResponse(p.Place)
+		resp.DistanceM = lo.ToPtr(p.DistanceM)
+		resp.Score = lo.ToPtr(p.Score)
+		return resp
+	})
+
+	response := types.NewListResponse(items, total, limit, offset)
+	return &response
+}
+
 // NewListPlacesResponse creates a paginated list response for places
 func NewListPlacesResponse(places []*place.Place, total, limit, offset int) *ListPlacesResponse {
 	items := lo.Map(places, func(p *place.Place, _ int) *PlaceResponse {
@@ -466,6 +784,9 @@ func (req *FeedSectionRequest) ToPlaceFilter(globalFilter *FeedRequest) *types.P
 
 // NewFeedResponse creates a new FeedResponse
 func NewFeedResponse(sections []FeedSectionResponse) *FeedResponse {
+	if sections == nil {
+		sections = []FeedSectionResponse{}
+	}
 	return &FeedResponse{
 		Sections: sections,
 	}
@@ -473,6 +794,9 @@ func NewFeedResponse(sections []FeedSectionResponse) *FeedResponse {
 
 // NewFeedSectionResponse creates a new FeedSectionResponse
 func NewFeedSectionResponse(sectionType types.FeedSectionType, places []*PlaceResponse, total, limit, offset int) FeedSectionResponse {
+	if places == nil {
+		places = []*PlaceResponse{}
+	}
 	return FeedSectionResponse{
 		Type:       sectionType,
 		Items:      places,
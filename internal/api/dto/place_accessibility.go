@@ -0,0 +1,18 @@
+package dto
+
+import "github.com/omkar273/nashikdarshan/internal/types"
+
+// AccessibilityAttributesResponse is the accessibility vocabulary of places
+type AccessibilityAttributesResponse struct {
+	Attributes []types.AccessibilityAttribute `json:"attributes"`
+	// AllowCustom is set when attributes outside the vocabulary are accepted
+	AllowCustom bool `json:"allow_custom"`
+}
+
+// NewAccessibilityAttributesResponse describes the configured accessibility vocabulary
+func NewAccessibilityAttributesResponse() *AccessibilityAttributesResponse {
+	return &AccessibilityAttributesResponse{
+		Attributes:  types.AccessibilityAttributes,
+		AllowCustom: types.CustomAccessibilityKeysAllowed(),
+	}
+}
@@ -0,0 +1,106 @@
+package dto
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/samber/lo"
+)
+
+const (
+	// AtomContentType is the media type of an Atom feed
+	AtomContentType = "application/atom+xml; charset=utf-8"
+
+	atomNamespace   = "http://www.w3.org/2005/Atom"
+	georssNamespace = "http://www.georss.org/georss"
+
+	// atomFeedTitle and atomFeedAuthor name the place feed and its publisher
+	atomFeedTitle  = "Nashik Darshan: new and updated places"
+	atomFeedAuthor = "Nashik Darshan"
+)
+
+// AtomFeed is an Atom (RFC 4287) feed of places, with GeoRSS points on the entries
+type AtomFeed struct {
+	XMLName xml.Name     `xml:"feed"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	GeoRSS  string       `xml:"xmlns:georss,attr"`
+	ID      string       `xml:"id"`
+	Title   string       `xml:"title"`
+	Updated string       `xml:"updated"`
+	Author  AtomPerson   `xml:"author"`
+	Links   []AtomLink   `xml:"link"`
+	Entries []*AtomEntry `xml:"entry"`
+}
+
+// AtomPerson is the author of a feed
+type AtomPerson struct {
+	Name string `xml:"name"`
+}
+
+// AtomLink is a link of a feed or entry
+type AtomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+// AtomEntry is one place in the feed
+type AtomEntry struct {
+	ID        string     `xml:"id"`
+	Title     string     `xml:"title"`
+	Summary   *string    `xml:"summary,omitempty"`
+	Link      AtomLink   `xml:"link"`
+	Published string     `xml:"published"`
+	Updated   string     `xml:"updated"`
+	Category  []AtomTerm `xml:"category"`
+	// Point is the GeoRSS location: latitude and longitude separated by a space
+	Point string `xml:"georss:point"`
+}
+
+// AtomTerm categorizes an entry
+type AtomTerm struct {
+	Term string `xml:"term,attr"`
+}
+
+// NewPlaceAtomFeed creates a feed of places, most recently updated first. feedURL is the
+// address of the feed itself and linkFor gives the page of a place. An empty feed is
+// dated now.
+func NewPlaceAtomFeed(places []*place.Place, feedURL string, linkFor func(p *place.Place) string, now time.Time) *AtomFeed {
+	updated := now
+	if len(places) > 0 {
+		updated = lo.MaxBy(places, func(a, b *place.Place) bool {
+			return a.UpdatedAt.After(b.UpdatedAt)
+		}).UpdatedAt
+	}
+
+	return &AtomFeed{
+		Xmlns:   atomNamespace,
+		GeoRSS:  georssNamespace,
+		ID:      feedURL,
+		Title:   atomFeedTitle,
+		Updated: atomTime(updated),
+		Author:  AtomPerson{Name: atomFeedAuthor},
+		Links:   []AtomLink{{Rel: "self", Type: "application/atom+xml", Href: feedURL}},
+		Entries: lo.Map(places, func(p *place.Place, _ int) *AtomEntry {
+			return &AtomEntry{
+				// Slugs and titles may change; the place id does not
+				ID:        "urn:nashikdarshan:place:" + p.ID,
+				Title:     p.Title,
+				Summary:   p.ShortDescription,
+				Link:      AtomLink{Rel: "alternate", Href: linkFor(p)},
+				Published: atomTime(p.CreatedAt),
+				Updated:   atomTime(p.UpdatedAt),
+				Category:  []AtomTerm{{Term: string(p.PlaceType)}},
+				Point: fmt.Sprintf("%s %s",
+					p.Location.Latitude.String(), p.Location.Longitude.String()),
+			}
+		}),
+	}
+}
+
+// atomTime formats t as an RFC 3339 date in UTC, as Atom requires
+func atomTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
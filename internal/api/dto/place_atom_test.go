@@ -0,0 +1,137 @@
+package dto
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// parsedAtomFeed reads a feed back by namespace, as a feed reader would
+type parsedAtomFeed struct {
+	XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string   `xml:"http://www.w3.org/2005/Atom id"`
+	Updated string   `xml:"http://www.w3.org/2005/Atom updated"`
+	Links   []struct {
+		Rel  string `xml:"rel,attr"`
+		Href string `xml:"href,attr"`
+	} `xml:"http://www.w3.org/2005/Atom link"`
+	Entries []struct {
+		ID      string `xml:"http://www.w3.org/2005/Atom id"`
+		Title   string `xml:"http://www.w3.org/2005/Atom title"`
+		Summary string `xml:"http://www.w3.org/2005/Atom summary"`
+		Link    struct {
+			Rel  string `xml:"rel,attr"`
+			Href string `xml:"href,attr"`
+		} `xml:"http://www.w3.org/2005/Atom link"`
+		Published string `xml:"http://www.w3.org/2005/Atom published"`
+		Updated   string `xml:"http://www.w3.org/2005/Atom updated"`
+		Point     string `xml:"http://www.georss.org/georss point"`
+	} `xml:"http://www.w3.org/2005/Atom entry"`
+}
+
+func TestNewPlaceAtomFeed(t *testing.T) {
+	now := time.Date(2025, 3, 1, 6, 0, 0, 0, time.UTC)
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+
+	tests := []struct {
+		name        string
+		places      []*place.Place
+		wantUpdated string
+	}{
+		{
+			name: "escapes markup in titles and summaries",
+			places: []*place.Place{
+				{
+					ID:               "plc_ramkund",
+					Slug:             "ramkund",
+					Title:            `Ramkund & "Godavari" <Ghat>`,
+					ShortDescription: lo.ToPtr("Holy tank; <b>bathing</b> & rituals"),
+					PlaceType:        types.PlaceType("temple"),
+					Location:         types.Location{Latitude: decimal.RequireFromString("20.0077"), Longitude: decimal.RequireFromString("73.7920")},
+					BaseModel: types.BaseModel{
+						CreatedAt: time.Date(2025, 1, 10, 9, 0, 0, 0, ist),
+						UpdatedAt: time.Date(2025, 2, 20, 9, 0, 0, 0, ist),
+					},
+				},
+				{
+					ID:        "plc_sula",
+					Slug:      "sula-vineyards",
+					Title:     "Sula Vineyards",
+					PlaceType: types.PlaceType("winery"),
+					BaseModel: types.BaseModel{
+						CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			wantUpdated: "2025-02-20T03:30:00Z",
+		},
+		{
+			name:        "empty feed is dated now",
+			wantUpdated: "2025-03-01T06:00:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed := NewPlaceAtomFeed(tt.places, "https://api.example.com/v1/places/feed.xml", func(p *place.Place) string {
+				return "https://example.com/places/" + p.Slug
+			}, now)
+
+			body, err := xml.Marshal(feed)
+			if err != nil {
+				t.Fatalf("xml.Marshal() error = %v", err)
+			}
+
+			var parsed parsedAtomFeed
+			if err := xml.Unmarshal(append([]byte(xml.Header), body...), &parsed); err != nil {
+				t.Fatalf("feed is not well-formed: %v\n%s", err, body)
+			}
+
+			if parsed.XMLName.Space != atomNamespace {
+				t.Errorf("feed namespace = %q, want %q", parsed.XMLName.Space, atomNamespace)
+			}
+			if parsed.Updated != tt.wantUpdated {
+				t.Errorf("feed updated = %s, want %s", parsed.Updated, tt.wantUpdated)
+			}
+			if len(parsed.Links) != 1 || parsed.Links[0].Rel != "self" || parsed.Links[0].Href != feed.ID {
+				t.Errorf("feed links = %+v, want the self link", parsed.Links)
+			}
+			if len(parsed.Entries) != len(tt.places) {
+				t.Fatalf("parsed %d entries, want %d", len(parsed.Entries), len(tt.places))
+			}
+
+			for i, p := range tt.places {
+				entry := parsed.Entries[i]
+				if entry.Title != p.Title {
+					t.Errorf("entry %d title = %q, want %q", i, entry.Title, p.Title)
+				}
+				if want := lo.FromPtr(p.ShortDescription); entry.Summary != want {
+					t.Errorf("entry %d summary = %q, want %q", i, entry.Summary, want)
+				}
+				if want := "https://example.com/places/" + p.Slug; entry.Link.Rel != "alternate" || entry.Link.Href != want {
+					t.Errorf("entry %d link = %+v, want alternate %s", i, entry.Link, want)
+				}
+				if want := p.CreatedAt.UTC().Format(time.RFC3339); entry.Published != want {
+					t.Errorf("entry %d published = %s, want %s", i, entry.Published, want)
+				}
+				if want := p.UpdatedAt.UTC().Format(time.RFC3339); entry.Updated != want {
+					t.Errorf("entry %d updated = %s, want %s", i, entry.Updated, want)
+				}
+				for _, ts := range []string{entry.Published, entry.Updated} {
+					if _, err := time.Parse(time.RFC3339, ts); err != nil {
+						t.Errorf("entry %d timestamp %q is not RFC 3339: %v", i, ts, err)
+					}
+				}
+				if want := p.Location.Latitude.String() + " " + p.Location.Longitude.String(); entry.Point != want {
+					t.Errorf("entry %d georss point = %q, want %q", i, entry.Point, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package dto
+
+import (
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/omkar273/nashikdarshan/internal/validator"
+)
+
+// PlaceCentroidRequest asks for the center of a group of places
+type PlaceCentroidRequest struct {
+	PlaceIDs []string `json:"place_ids" binding:"required,min=1,max=100,dive,required"`
+}
+
+// Validate validates the PlaceCentroidRequest
+func (req *PlaceCentroidRequest) Validate() error {
+	return validator.ValidateRequest(req)
+}
+
+// PlaceCentroidResponse is the geodesic center of a group of places. PlaceIDs lists each
+// place once, in the order given.
+type PlaceCentroidResponse struct {
+	Centroid types.Point `json:"centroid"`
+	PlaceIDs []string    `json:"place_ids"`
+}
@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"context"
+
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/shopspring/decimal"
+)
+
+// ClonePlaceRequest represents a request to duplicate a place as a template. The embedded
+// update fields override the copied content; a new slug is required since slugs are unique.
+type ClonePlaceRequest struct {
+	UpdatePlaceRequest
+
+	// LatitudeOffset and LongitudeOffset shift the (possibly overridden) location, in degrees
+	LatitudeOffset  *decimal.Decimal `json:"latitude_offset,omitempty"`
+	LongitudeOffset *decimal.Decimal `json:"longitude_offset,omitempty"`
+
+	// IncludeImages copies the active gallery images of the source place
+	IncludeImages bool `json:"include_images"`
+}
+
+// Validate validates the ClonePlaceRequest
+func (req *ClonePlaceRequest) Validate() error {
+	if req.Slug == nil || *req.Slug == "" {
+		return ierr.NewError("slug is required").
+			WithHint("Please provide a new slug for the cloned place").
+			Mark(ierr.ErrValidation)
+	}
+
+	return req.UpdatePlaceRequest.Validate()
+}
+
+// ApplyToClone applies the overrides and coordinate offsets to a copy of the source place
+func (req *ClonePlaceRequest) ApplyToClone(ctx context.Context, p *place.Place) error {
+	if err := req.UpdatePlaceRequest.ApplyToPlace(ctx, p); err != nil {
+		return err
+	}
+
+	if req.LatitudeOffset != nil {
+		p.Location.Latitude = p.Location.Latitude.Add(*req.LatitudeOffset)
+	}
+	if req.LongitudeOffset != nil {
+		p.Location.Longitude = p.Location.Longitude.Add(*req.LongitudeOffset)
+	}
+
+	if err := p.Location.Validate(); err != nil {
+		return ierr.WithError(err).
+			WithHint("The shifted location is out of range").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+)
+
+// Sections of a place detail response that are fetched separately and may be degraded
+const (
+	PlaceDetailSectionReviewSummary  = "review_summary"
+	PlaceDetailSectionUpcomingEvents = "upcoming_events"
+	PlaceDetailSectionSimilar        = "similar"
+)
+
+// PlaceDetailResponse is a place with its images and the related data its detail page
+// shows, gathered in one response. A section that failed or timed out is returned empty
+// (review_summary omitted) and listed in degraded.
+type PlaceDetailResponse struct {
+	*PlaceResponse
+	ReviewSummary  *RatingStatsResponse          `json:"review_summary,omitempty"`
+	UpcomingEvents []*ExpandedOccurrenceResponse `json:"upcoming_events"`
+	Similar        []*PlaceStub                  `json:"similar"`
+	Degraded       []string                      `json:"degraded,omitempty"`
+}
+
+// PlaceStub is the compact form of a place used to link to it
+type PlaceStub struct {
+	ID           string          `json:"id"`
+	Slug         string          `json:"slug"`
+	Title        string          `json:"title"`
+	PlaceType    types.PlaceType `json:"place_type"`
+	ThumbnailURL *string         `json:"thumbnail_url,omitempty"`
+}
+
+// NewPlaceStubs creates PlaceStubs from domain places
+func NewPlaceStubs(places []*place.Place) []*PlaceStub {
+	return lo.Map(places, func(p *place.Place, _ int) *PlaceStub {
+		return &PlaceStub{
+			ID:           p.ID,
+			Slug:         p.Slug,
+			Title:        p.Title,
+			PlaceType:    p.PlaceType,
+			ThumbnailURL: withPlaceholder(p.ThumbnailURL, types.PlaceholderThumbnailURL()),
+		}
+	})
+}
@@ -0,0 +1,81 @@
+package dto
+
+import (
+	"strings"
+
+	"github.com/omkar273/nashikdarshan/internal/diff"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+)
+
+// PlaceDryRunResponse previews an update without persisting it: the field-level changes
+// it would make and the place as it would look afterwards
+type PlaceDryRunResponse struct {
+	PlaceID string         `json:"place_id"`
+	DryRun  bool           `json:"dry_run"`
+	Changes []diff.Change  `json:"changes"`
+	Place   *PlaceResponse `json:"place"`
+}
+
+// MaskPII masks the contact of the previewed place and of the contact changes
+func (r *PlaceDryRunResponse) MaskPII() {
+	if r.Place != nil {
+		r.Place.MaskPII()
+	}
+	r.Changes = maskContactChanges(r.Changes)
+}
+
+// contactPath is the diff path of a place contact, e.g. "contact.phone" for its phone
+const contactPath = "contact"
+
+// maskContactChanges masks the contact values in field-level changes of a place per the contact
+// masking rules. Changes of which nothing is left, such as an omitted email, are dropped.
+func maskContactChanges(changes []diff.Change) []diff.Change {
+	return lo.FilterMap(changes, func(change diff.Change, _ int) (diff.Change, bool) {
+		var mask func(any) any
+		switch {
+		case change.Path == contactPath:
+			mask = maskContactValue
+		case strings.HasPrefix(change.Path, contactPath+"."):
+			rule := types.ContactMasking(strings.TrimPrefix(change.Path, contactPath+"."))
+			mask = func(v any) any { return maskContactField(rule, v) }
+		default:
+			return change, true
+		}
+
+		change.Old, change.New = mask(change.Old), mask(change.New)
+		return change, change.Old != nil || change.New != nil
+	})
+}
+
+// maskContactValue masks a whole contact object as decoded by diff, returning nil when nothing
+// is left of it
+func maskContactValue(v any) any {
+	contact, ok := v.(map[string]any)
+	if !ok {
+		return v
+	}
+
+	masked := make(map[string]any, len(contact))
+	for field, value := range contact {
+		if value := maskContactField(types.ContactMasking(field), value); value != nil {
+			masked[field] = value
+		}
+	}
+	if len(masked) == 0 {
+		return nil
+	}
+	return masked
+}
+
+// maskContactField masks a single contact value as decoded by diff
+func maskContactField(rule types.MaskingRule, v any) any {
+	value, ok := v.(string)
+	if !ok {
+		return v
+	}
+	if masked := rule.Mask(&value); masked != nil {
+		return *masked
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"github.com/omkar273/nashikdarshan/internal/validator"
+)
+
+// PlaceExistsRequest asks which of a list of place IDs or slugs still exist
+type PlaceExistsRequest struct {
+	// Identifiers are place IDs or slugs, matched against both
+	Identifiers []string `json:"identifiers" binding:"required,min=1,max=200,dive,required"`
+}
+
+// Validate validates the PlaceExistsRequest
+func (req *PlaceExistsRequest) Validate() error {
+	return validator.ValidateRequest(req)
+}
+
+// PlaceExistence reports whether a place exists. A deleted place does not exist but is
+// reported as deleted; an unknown identifier is neither.
+type PlaceExistence struct {
+	Exists  bool `json:"exists"`
+	Deleted bool `json:"deleted"`
+}
+
+// PlaceExistsResponse maps every requested identifier to its existence
+type PlaceExistsResponse struct {
+	Results map[string]*PlaceExistence `json:"results"`
+}
@@ -0,0 +1,23 @@
+package dto
+
+import (
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// PlaceExtentResponse is the smallest box holding every matching place, for fitting a map
+// to them. A single place, or places sharing one location, give a box of zero size.
+type PlaceExtentResponse struct {
+	SouthWest types.Point `json:"south_west"`
+	NorthEast types.Point `json:"north_east"`
+	// BBox is the same box in GeoJSON order: [min_lng, min_lat, max_lng, max_lat]
+	BBox [4]float64 `json:"bbox"`
+}
+
+// NewPlaceExtentResponse creates an extent response from its corners
+func NewPlaceExtentResponse(sw, ne types.Point) *PlaceExtentResponse {
+	return &PlaceExtentResponse{
+		SouthWest: sw,
+		NorthEast: ne,
+		BBox:      [4]float64{sw.Coordinates[0], sw.Coordinates[1], ne.Coordinates[0], ne.Coordinates[1]},
+	}
+}
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"time"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/omkar273/nashikdarshan/internal/validator"
+)
+
+// GeofenceCheckRequest asks which place geofences contain a coordinate
+type GeofenceCheckRequest struct {
+	Location types.Point `json:"location" binding:"required"`
+}
+
+// Validate validates the GeofenceCheckRequest
+func (req *GeofenceCheckRequest) Validate() error {
+	if err := validator.ValidateRequest(req); err != nil {
+		return err
+	}
+
+	if err := req.Location.Validate(); err != nil {
+		return ierr.WithError(err).
+			WithHint("location must be a GeoJSON Point with valid coordinates").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// GeofenceCheckResponse lists the places whose geofence contains the checked location,
+// nearest first. DistanceM is the distance from the place to the location.
+type GeofenceCheckResponse struct {
+	Location types.Point      `json:"location"`
+	Places   []*PlaceResponse `json:"places"`
+}
+
+// Localize converts the place timestamps to loc for presentation
+func (r *GeofenceCheckResponse) Localize(loc *time.Location) {
+	for _, p := range r.Places {
+		p.Localize(loc)
+	}
+}
+
+// Translate labels the matching places in lang
+func (r *GeofenceCheckResponse) Translate(lang types.Language) {
+	for _, p := range r.Places {
+		p.Translate(lang)
+	}
+}
+
+// MaskPII masks the contact of every matching place
+func (r *GeofenceCheckResponse) MaskPII() {
+	for _, p := range r.Places {
+		p.MaskPII()
+	}
+}
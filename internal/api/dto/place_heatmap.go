@@ -0,0 +1,84 @@
+package dto
+
+import (
+	"math"
+
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/omkar273/nashikdarshan/internal/validator"
+	"github.com/samber/lo"
+)
+
+// PlaceHeatmapRequest asks for the density of the published places in a box
+type PlaceHeatmapRequest struct {
+	// BBox is min_lng,min_lat,max_lng,max_lat
+	BBox string `form:"bbox" binding:"required"`
+	// CellSize is the edge of a grid cell in degrees
+	CellSize float64 `form:"cellsize" binding:"required,gt=0"`
+	// Weight is what each cell sums: count (default) or views
+	Weight types.HeatmapWeight `form:"weight"`
+
+	box types.BBox
+}
+
+// Validate validates the PlaceHeatmapRequest, parses the bbox and refuses grids with more
+// than types.MaxHeatmapCells cells
+func (req *PlaceHeatmapRequest) Validate() error {
+	if err := validator.ValidateRequest(req); err != nil {
+		return err
+	}
+
+	box, err := types.ParseBBox(req.BBox)
+	if err != nil {
+		return err
+	}
+	if cells := box.GridCells(req.CellSize); cells > types.MaxHeatmapCells {
+		minCellSize := math.Sqrt((box.MaxLng - box.MinLng) * (box.MaxLat - box.MinLat) / types.MaxHeatmapCells)
+		return ierr.NewErrorf("heatmap grid of %.0f cells exceeds the limit of %d", cells, types.MaxHeatmapCells).
+			WithHintf("Please use a larger cellsize (about %.4f degrees or more) or a smaller bbox", minCellSize).
+			WithReportableDetails(map[string]any{
+				"bbox":      req.BBox,
+				"cellsize":  req.CellSize,
+				"cells":     cells,
+				"max_cells": types.MaxHeatmapCells,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	req.box = box
+
+	if req.Weight == "" {
+		req.Weight = types.HeatmapWeightCount
+	}
+	return req.Weight.Validate()
+}
+
+// Box returns the bbox parsed by Validate
+func (req *PlaceHeatmapRequest) Box() types.BBox {
+	return req.box
+}
+
+// HeatmapCellResponse is one grid cell, at its center, with its weight
+type HeatmapCellResponse struct {
+	Lat    float64 `json:"lat"`
+	Lng    float64 `json:"lng"`
+	Weight int64   `json:"weight"`
+}
+
+// PlaceHeatmapResponse holds the non-empty cells of a place density grid
+type PlaceHeatmapResponse struct {
+	CellSize float64                `json:"cellsize"`
+	Weight   types.HeatmapWeight    `json:"weight"`
+	Cells    []*HeatmapCellResponse `json:"cells"`
+}
+
+// NewPlaceHeatmapResponse creates a heatmap response from its cells
+func NewPlaceHeatmapResponse(req *PlaceHeatmapRequest, cells []*place.HeatmapCell) *PlaceHeatmapResponse {
+	return &PlaceHeatmapResponse{
+		CellSize: req.CellSize,
+		Weight:   req.Weight,
+		Cells: lo.Map(cells, func(c *place.HeatmapCell, _ int) *HeatmapCellResponse {
+			return &HeatmapCellResponse{Lat: c.Latitude, Lng: c.Longitude, Weight: c.Weight}
+		}),
+	}
+}
@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/omkar273/nashikdarshan/internal/validator"
+)
+
+// OSMImportRequest starts an import of places from OpenStreetMap
+type OSMImportRequest struct {
+	// Query overrides the configured Overpass QL query for this run
+	Query string `json:"query,omitempty" binding:"omitempty,max=10000"`
+	// DryRun reports what the import would do without writing anything
+	DryRun bool `json:"dry_run"`
+}
+
+// Validate validates the OSMImportRequest
+func (req *OSMImportRequest) Validate() error {
+	return validator.ValidateRequest(req)
+}
+
+// OSMImportAction is what an import did, or would do, with one OpenStreetMap element
+type OSMImportAction string
+
+const (
+	OSMImportCreated   OSMImportAction = "created"
+	OSMImportUpdated   OSMImportAction = "updated"
+	OSMImportUnchanged OSMImportAction = "unchanged"
+	OSMImportSkipped   OSMImportAction = "skipped"
+)
+
+// OSMImportItem is the outcome for one OpenStreetMap element
+type OSMImportItem struct {
+	Ref     string          `json:"ref"`
+	Title   string          `json:"title,omitempty"`
+	Action  OSMImportAction `json:"action"`
+	PlaceID string          `json:"place_id,omitempty"`
+	// Reason explains skipped elements
+	Reason string `json:"reason,omitempty"`
+	// Points are the member coordinates of a relation placed at their centroid
+	Points *types.MultiPoint `json:"points,omitempty"`
+}
+
+// OSMImportReport summarizes an import run
+type OSMImportReport struct {
+	DryRun    bool             `json:"dry_run"`
+	Fetched   int              `json:"fetched"`
+	Created   int              `json:"created"`
+	Updated   int              `json:"updated"`
+	Unchanged int              `json:"unchanged"`
+	Skipped   int              `json:"skipped"`
+	Items     []*OSMImportItem `json:"items"`
+}
+
+// Add records the outcome of one element
+func (r *OSMImportReport) Add(item *OSMImportItem) {
+	switch item.Action {
+	case OSMImportCreated:
+		r.Created++
+	case OSMImportUpdated:
+		r.Updated++
+	case OSMImportUnchanged:
+		r.Unchanged++
+	case OSMImportSkipped:
+		r.Skipped++
+	}
+	r.Items = append(r.Items, item)
+}
@@ -0,0 +1,78 @@
+package dto
+
+import (
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/omkar273/nashikdarshan/internal/validator"
+)
+
+const (
+	// MaxNearbyBatchOrigins caps the origins of a batch nearby request
+	MaxNearbyBatchOrigins = 20
+	// MaxNearbyBatchRadiusKm matches the radius cap of the nearby place filter
+	MaxNearbyBatchRadiusKm = 15
+	// DefaultNearbyBatchLimitPer is the number of places per origin when no limit is given
+	DefaultNearbyBatchLimitPer = 10
+	// MaxNearbyBatchLimitPer caps the places returned per origin
+	MaxNearbyBatchLimitPer = 50
+)
+
+// NearbyBatchRequest asks for the nearest places around each of several origins
+type NearbyBatchRequest struct {
+	Origins  []types.Point `json:"origins" binding:"required,min=1,max=20"`
+	RadiusKm float64       `json:"radius_km" binding:"required,gt=0,lte=15"`
+	LimitPer int           `json:"limit_per,omitempty" binding:"omitempty,min=1,max=50"`
+}
+
+// Validate validates the NearbyBatchRequest and applies defaults
+func (req *NearbyBatchRequest) Validate() error {
+	if err := validator.ValidateRequest(req); err != nil {
+		return err
+	}
+
+	for i, origin := range req.Origins {
+		if err := origin.Validate(); err != nil {
+			return ierr.WithError(err).
+				WithHintf("Origin %d must be a GeoJSON Point with valid coordinates", i).
+				WithReportableDetails(map[string]any{
+					"origin_index": i,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	if req.LimitPer == 0 {
+		req.LimitPer = DefaultNearbyBatchLimitPer
+	}
+	return nil
+}
+
+// NearbyOriginResult holds the nearest places around one origin, nearest first
+type NearbyOriginResult struct {
+	OriginIndex int              `json:"origin_index"`
+	Origin      types.Point      `json:"origin"`
+	Places      []*PlaceResponse `json:"places"`
+}
+
+// NearbyBatchResponse holds one result per requested origin, in request order
+type NearbyBatchResponse struct {
+	Results []*NearbyOriginResult `json:"results"`
+}
+
+// Translate labels the places around every origin in lang
+func (r *NearbyBatchResponse) Translate(lang types.Language) {
+	for _, result := range r.Results {
+		for _, p := range result.Places {
+			p.Translate(lang)
+		}
+	}
+}
+
+// MaskPII masks the contact of every place around every origin
+func (r *NearbyBatchResponse) MaskPII() {
+	for _, result := range r.Results {
+		for _, p := range result.Places {
+			p.MaskPII()
+		}
+	}
+}
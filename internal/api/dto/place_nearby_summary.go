@@ -0,0 +1,116 @@
+package dto
+
+import (
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/omkar273/nashikdarshan/internal/validator"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// DefaultNearbySummaryRadiusKm is the radius counted when the request gives none
+	DefaultNearbySummaryRadiusKm = 2
+	// MaxNearbySummaryRadiusKm matches the 15km cap of the geospatial list filter
+	MaxNearbySummaryRadiusKm = 15
+)
+
+// NearbySummaryRequest asks what kinds of published places lie around a point
+type NearbySummaryRequest struct {
+	Latitude  decimal.Decimal `form:"latitude" binding:"required"`
+	Longitude decimal.Decimal `form:"longitude" binding:"required"`
+	// RadiusKm defaults to DefaultNearbySummaryRadiusKm
+	RadiusKm float64 `form:"radius_km" binding:"omitempty,gt=0"`
+}
+
+// Validate validates the NearbySummaryRequest and applies defaults
+func (req *NearbySummaryRequest) Validate() error {
+	if err := validator.ValidateRequest(req); err != nil {
+		return err
+	}
+	if err := req.Center().Validate(); err != nil {
+		return err
+	}
+
+	if req.RadiusKm == 0 {
+		req.RadiusKm = DefaultNearbySummaryRadiusKm
+	}
+	if req.RadiusKm < 0 || req.RadiusKm > MaxNearbySummaryRadiusKm {
+		return ierr.NewErrorf("radius_km must be greater than 0 and at most %d", MaxNearbySummaryRadiusKm).
+			WithHintf("Please provide a radius_km of at most %d", MaxNearbySummaryRadiusKm).
+			WithReportableDetails(map[string]any{
+				"radius_km": req.RadiusKm,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// Center returns the point the places are counted around
+func (req *NearbySummaryRequest) Center() types.Location {
+	return *types.NewLocation(req.Latitude, req.Longitude)
+}
+
+// PlaceTypeCountResponse is the number of places of one type
+type PlaceTypeCountResponse struct {
+	PlaceType types.PlaceType `json:"place_type"`
+	// Label is the display name of the place type in the negotiated language
+	Label string `json:"label"`
+	Count int64  `json:"count"`
+}
+
+// CategoryCountResponse is the number of places in one category
+type CategoryCountResponse struct {
+	Slug  string `json:"slug"`
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+// NearbySummaryResponse counts the published places around a point by place type and by
+// category, most common first. A place with several categories is counted in each of them
+// but once in total.
+type NearbySummaryResponse struct {
+	Latitude   decimal.Decimal           `json:"latitude"`
+	Longitude  decimal.Decimal           `json:"longitude"`
+	RadiusKm   float64                   `json:"radius_km"`
+	Total      int64                     `json:"total"`
+	PlaceTypes []*PlaceTypeCountResponse `json:"place_types"`
+	Categories []*CategoryCountResponse  `json:"categories"`
+}
+
+// NewNearbySummaryResponse creates a nearby summary response, labelled in English
+func NewNearbySummaryResponse(req *NearbySummaryRequest, summary *place.NearbySummary) *NearbySummaryResponse {
+	return &NearbySummaryResponse{
+		Latitude:   req.Latitude,
+		Longitude:  req.Longitude,
+		RadiusKm:   req.RadiusKm,
+		Total:      summary.Total,
+		PlaceTypes: newPlaceTypeCountResponses(summary.ByType),
+		Categories: lo.Map(summary.ByCategory, func(c *place.CategoryCount, _ int) *CategoryCountResponse {
+			return &CategoryCountResponse{Slug: c.Slug, Name: c.Name, Count: c.Count}
+		}),
+	}
+}
+
+// Translate labels the place types in lang
+func (r *NearbySummaryResponse) Translate(lang types.Language) {
+	translatePlaceTypeCounts(r.PlaceTypes, lang)
+}
+
+// newPlaceTypeCountResponses converts place type counts, labelled in English
+func newPlaceTypeCountResponses(counts []*place.PlaceTypeCount) []*PlaceTypeCountResponse {
+	return lo.Map(counts, func(c *place.PlaceTypeCount, _ int) *PlaceTypeCountResponse {
+		return &PlaceTypeCountResponse{
+			PlaceType: c.PlaceType,
+			Label:     c.PlaceType.Label(types.LanguageEnglish),
+			Count:     c.Count,
+		}
+	})
+}
+
+func translatePlaceTypeCounts(counts []*PlaceTypeCountResponse, lang types.Language) {
+	for _, c := range counts {
+		c.Label = c.PlaceType.Label(lang)
+	}
+}
@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"testing"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/shopspring/decimal"
+)
+
+func TestNearbySummaryRequestValidate(t *testing.T) {
+	tests := []struct {
+		name         string
+		lat, lng     string
+		radiusKm     float64
+		wantRadiusKm float64
+		wantErr      bool
+	}{
+		{name: "default radius", lat: "20.0077", lng: "73.7920", wantRadiusKm: DefaultNearbySummaryRadiusKm},
+		{name: "given radius", lat: "20.0077", lng: "73.7920", radiusKm: 5.5, wantRadiusKm: 5.5},
+		{name: "radius at the cap", lat: "20.0077", lng: "73.7920", radiusKm: MaxNearbySummaryRadiusKm, wantRadiusKm: MaxNearbySummaryRadiusKm},
+		{name: "radius over the cap", lat: "20.0077", lng: "73.7920", radiusKm: 15.1, wantErr: true},
+		{name: "negative radius", lat: "20.0077", lng: "73.7920", radiusKm: -1, wantErr: true},
+		{name: "latitude out of range", lat: "91", lng: "73.7920", wantErr: true},
+		{name: "longitude out of range", lat: "20.0077", lng: "-180.5", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &NearbySummaryRequest{
+				Latitude:  decimal.RequireFromString(tt.lat),
+				Longitude: decimal.RequireFromString(tt.lng),
+				RadiusKm:  tt.radiusKm,
+			}
+
+			err := req.Validate()
+			if tt.wantErr {
+				if !ierr.IsValidation(err) {
+					t.Fatalf("Validate() error = %v, want validation error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+			if req.RadiusKm != tt.wantRadiusKm {
+				t.Errorf("RadiusKm = %v, want %v", req.RadiusKm, tt.wantRadiusKm)
+			}
+		})
+	}
+}
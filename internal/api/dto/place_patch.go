@@ -0,0 +1,238 @@
+package dto
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// ContentTypeMergePatch is the media type of an RFC 7396 JSON Merge Patch document
+const ContentTypeMergePatch = "application/merge-patch+json"
+
+// placePatchNullable lists the optional place fields that a null in the patch removes.
+// Every other field is required on a place and rejects null.
+var placePatchNullable = []string{
+	"subtitle",
+	"short_description",
+	"long_description",
+	"address",
+	"primary_image_url",
+	"thumbnail_url",
+	"price_info",
+	"contact",
+	"accessibility",
+	"geofence_radius_m",
+	"publish_at",
+	"unpublish_at",
+}
+
+// PatchPlaceRequest is a place update expressed as an RFC 7396 JSON Merge Patch.
+// Members absent from the patch are left unchanged, members set to null are removed
+// and objects (address, location) are merged recursively rather than replaced.
+// price_info, contact and accessibility are the exception: they are replaced as a whole so their
+// fields are always validated together.
+type PatchPlaceRequest struct {
+	// Update holds the members the patch sets to a value
+	Update UpdatePlaceRequest
+	// Clear holds the optional members the patch sets to null
+	Clear []string
+	// Address holds address keys to set, or nil for keys to remove
+	Address map[string]*string
+	// Latitude and Longitude are set when the patch changes a coordinate
+	Latitude  *decimal.Decimal
+	Longitude *decimal.Decimal
+}
+
+// ParsePlaceMergePatch decodes a JSON Merge Patch document for a place
+func ParsePlaceMergePatch(data []byte) (*PatchPlaceRequest, error) {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil || members == nil {
+		return nil, ierr.NewError("merge patch must be a JSON object").
+			WithHint("Please send a JSON object with the fields to change").
+			Mark(ierr.ErrValidation)
+	}
+
+	req := &PatchPlaceRequest{}
+
+	if raw, ok := members["address"]; ok {
+		delete(members, "address")
+		if !isJSONNull(raw) {
+			if err := json.Unmarshal(raw, &req.Address); err != nil {
+				return nil, ierr.WithError(err).
+					WithHint("address must be an object of strings").
+					Mark(ierr.ErrValidation)
+			}
+		} else {
+			req.Clear = append(req.Clear, "address")
+		}
+	}
+
+	if raw, ok := members["location"]; ok {
+		delete(members, "location")
+		if err := req.parseLocation(raw); err != nil {
+			return nil, err
+		}
+	}
+
+	for name, raw := range members {
+		if !isJSONNull(raw) {
+			continue
+		}
+		if !lo.Contains(placePatchNullable, name) {
+			return nil, ierr.NewErrorf("%s cannot be removed", name).
+				WithHintf("%s is required and cannot be set to null", name).
+				Mark(ierr.ErrValidation)
+		}
+		req.Clear = append(req.Clear, name)
+		delete(members, name)
+	}
+
+	// The remaining members carry values and map directly onto UpdatePlaceRequest
+	remaining, err := json.Marshal(members)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Please check the request payload").
+			Mark(ierr.ErrValidation)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(remaining))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req.Update); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Please check the request payload").
+			Mark(ierr.ErrValidation)
+	}
+
+	return req, nil
+}
+
+// parseLocation merges a location member, where either coordinate may be omitted
+func (req *PatchPlaceRequest) parseLocation(raw json.RawMessage) error {
+	var location map[string]json.RawMessage
+	if isJSONNull(raw) || json.Unmarshal(raw, &location) != nil {
+		return ierr.NewError("location must be an object").
+			WithHint("location is required and can only be changed, not removed").
+			Mark(ierr.ErrValidation)
+	}
+
+	for name, value := range location {
+		var target **decimal.Decimal
+		switch name {
+		case "latitude":
+			target = &req.Latitude
+		case "longitude":
+			target = &req.Longitude
+		default:
+			return ierr.NewErrorf("unknown location field %s", name).
+				WithHint("location accepts latitude and longitude").
+				Mark(ierr.ErrValidation)
+		}
+
+		if isJSONNull(value) {
+			return ierr.NewErrorf("invalid %s", name).
+				WithHintf("%s cannot be null", name).
+				Mark(ierr.ErrValidation)
+		}
+		coordinate, err := types.ParseCoordinate(name, value)
+		if err != nil {
+			return err
+		}
+		*target = &coordinate
+	}
+
+	return nil
+}
+
+// Validate validates the values set by the patch
+func (req *PatchPlaceRequest) Validate() error {
+	return req.Update.Validate()
+}
+
+// AdminFields returns the admin-only fields the patch sets or removes
+func (req *PatchPlaceRequest) AdminFields() []string {
+	fields := req.Update.AdminFields()
+	for _, name := range req.Clear {
+		if lo.Contains(types.AdminPlaceFields, name) && !lo.Contains(fields, name) {
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
+
+// StripAdminFields drops the admin-only fields from the patch
+func (req *PatchPlaceRequest) StripAdminFields() {
+	req.Update.StripAdminFields()
+	req.Clear = lo.Without(req.Clear, types.AdminPlaceFields...)
+}
+
+// ApplyToPlace applies the merge patch to domain Place
+func (req *PatchPlaceRequest) ApplyToPlace(ctx context.Context, p *place.Place) error {
+	if err := req.Update.apply(ctx, p); err != nil {
+		return err
+	}
+
+	for _, name := range req.Clear {
+		switch name {
+		case "subtitle":
+			p.Subtitle = nil
+		case "short_description":
+			p.ShortDescription = nil
+		case "long_description":
+			p.LongDescription = nil
+		case "address":
+			p.Address = nil
+		case "primary_image_url":
+			p.PrimaryImageURL = nil
+		case "thumbnail_url":
+			p.ThumbnailURL = nil
+		case "price_info":
+			p.PriceInfo = nil
+		case "contact":
+			p.Contact = nil
+		case "accessibility":
+			p.Accessibility = nil
+		case "geofence_radius_m":
+			p.GeofenceRadiusM = nil
+		case "publish_at":
+			p.PublishAt = nil
+		case "unpublish_at":
+			p.UnpublishAt = nil
+		}
+	}
+
+	if req.Address != nil {
+		address := lo.Assign(p.Address)
+		for key, value := range req.Address {
+			if value == nil {
+				delete(address, key)
+				continue
+			}
+			address[key] = *value
+		}
+		types.NormalizeAddress(address)
+		p.Address = address
+	}
+
+	if req.Latitude != nil {
+		p.Location.Latitude = *req.Latitude
+	}
+	if req.Longitude != nil {
+		p.Location.Longitude = *req.Longitude
+	}
+	if req.Latitude != nil || req.Longitude != nil {
+		if err := p.Location.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return types.ValidatePublishSchedule(p.PublishAt, p.UnpublishAt)
+}
+
+func isJSONNull(raw json.RawMessage) bool {
+	return string(bytes.TrimSpace(raw)) == "null"
+}
@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"github.com/omkar273/nashikdarshan/internal/validator"
+)
+
+// DefaultReslugBatchSize is the number of places re-slugged per transaction when the request
+// names none
+const DefaultReslugBatchSize = 100
+
+// ReslugPlacesRequest regenerates the slugs of every place under the current slug policy,
+// e.g. after places.slug_prefixes changed
+type ReslugPlacesRequest struct {
+	// DryRun reports the proposed changes without writing anything
+	DryRun bool `json:"dry_run"`
+	// BatchSize is the number of places written per transaction
+	BatchSize int `json:"batch_size,omitempty" binding:"omitempty,min=1,max=1000"`
+}
+
+// Validate validates the ReslugPlacesRequest
+func (req *ReslugPlacesRequest) Validate() error {
+	return validator.ValidateRequest(req)
+}
+
+// GetBatchSize returns the batch size, DefaultReslugBatchSize when unset
+func (req *ReslugPlacesRequest) GetBatchSize() int {
+	if req.BatchSize <= 0 {
+		return DefaultReslugBatchSize
+	}
+	return req.BatchSize
+}
+
+// ReslugChange is a place whose slug the policy changes
+type ReslugChange struct {
+	PlaceID string `json:"place_id"`
+	Title   string `json:"title"`
+	OldSlug string `json:"old_slug"`
+	NewSlug string `json:"new_slug"`
+	// Skipped is set when the place's slug changed or it was deleted while the job ran
+	Skipped bool `json:"skipped,omitempty"`
+}
+
+// ReslugPlacesReport summarizes a re-slug run
+type ReslugPlacesReport struct {
+	DryRun    bool            `json:"dry_run"`
+	Scanned   int             `json:"scanned"`
+	Changed   int             `json:"changed"`
+	Unchanged int             `json:"unchanged"`
+	Skipped   int             `json:"skipped"`
+	Changes   []*ReslugChange `json:"changes"`
+}
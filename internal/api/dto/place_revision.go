@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"github.com/omkar273/nashikdarshan/internal/diff"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/omkar273/nashikdarshan/internal/validator"
+	"github.com/samber/lo"
+)
+
+// PlaceRevisionResponse represents a single revision of a place
+type PlaceRevisionResponse struct {
+	*place.Revision
+}
+
+// MaskPII masks the contact in the snapshot. The revision is copied, so the stored one is left
+// untouched.
+func (r *PlaceRevisionResponse) MaskPII() {
+	if r.Revision == nil || r.Revision.Snapshot == nil {
+		return
+	}
+
+	snapshot := *r.Revision.Snapshot
+	snapshot.Contact = snapshot.Contact.Masked()
+	rev := *r.Revision
+	rev.Snapshot = &snapshot
+	r.Revision = &rev
+}
+
+// ListPlaceRevisionsResponse represents a paginated list of place revisions
+type ListPlaceRevisionsResponse = types.ListResponse[*PlaceRevisionResponse]
+
+// NewPlaceRevisionResponse creates a PlaceRevisionResponse from domain Revision
+func NewPlaceRevisionResponse(rev *place.Revision) *PlaceRevisionResponse {
+	return &PlaceRevisionResponse{
+		Revision: rev,
+	}
+}
+
+// NewListPlaceRevisionsResponse creates a paginated list response of place revisions
+func NewListPlaceRevisionsResponse(revs []*place.Revision, total, limit, offset int) *ListPlaceRevisionsResponse {
+	items := lo.Map(revs, func(rev *place.Revision, _ int) *PlaceRevisionResponse {
+		return NewPlaceRevisionResponse(rev)
+	})
+	response := types.NewListResponse(items, total, limit, offset)
+	return &response
+}
+
+// PlaceRevisionDiffRequest selects the two revisions to compare
+type PlaceRevisionDiffRequest struct {
+	From int `json:"from" form:"from" binding:"required,min=1"`
+	To   int `json:"to" form:"to" binding:"required,min=1"`
+}
+
+// Validate validates the PlaceRevisionDiffRequest
+func (req *PlaceRevisionDiffRequest) Validate() error {
+	if err := validator.ValidateRequest(req); err != nil {
+		return err
+	}
+
+	if req.From == req.To {
+		return ierr.NewError("from and to must be different revisions").
+			WithHint("Please provide two different revision numbers").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// PlaceRevisionDiffResponse is the field-level difference between two revisions of a place
+type PlaceRevisionDiffResponse struct {
+	PlaceID string        `json:"place_id"`
+	From    int           `json:"from"`
+	To      int           `json:"to"`
+	Changes []diff.Change `json:"changes"`
+}
+
+// MaskPII masks the contact values in the changes
+func (r *PlaceRevisionDiffResponse) MaskPII() {
+	r.Changes = maskContactChanges(r.Changes)
+}
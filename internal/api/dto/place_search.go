@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"strings"
+
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/omkar273/nashikdarshan/internal/validator"
+)
+
+const (
+	// DefaultAutocompleteLimit is the number of suggestions returned when no limit is given
+	DefaultAutocompleteLimit = 10
+	// MaxAutocompleteLimit caps the number of suggestions per request
+	MaxAutocompleteLimit = 25
+)
+
+// AutocompleteRequest represents a typeahead query for places
+type AutocompleteRequest struct {
+	Q     string `form:"q" binding:"required,max=100"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=25"`
+}
+
+// Validate validates the AutocompleteRequest and applies defaults
+func (req *AutocompleteRequest) Validate() error {
+	req.Q = strings.TrimSpace(req.Q)
+	if err := validator.ValidateRequest(req); err != nil {
+		return err
+	}
+	if req.Limit == 0 {
+		req.Limit = DefaultAutocompleteLimit
+	}
+	return nil
+}
+
+// AutocompleteResponse holds the suggestions for a typeahead query
+type AutocompleteResponse struct {
+	Suggestions []*place.Suggestion `json:"suggestions"`
+}
+
+// SearchPlacesRequest represents a full-text place search. Q accepts web search syntax:
+// quoted phrases, "or" and -excluded terms.
+type SearchPlacesRequest struct {
+	Q      string `form:"q" binding:"required,max=200"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset int    `form:"offset" binding:"omitempty,min=0"`
+}
+
+// Validate validates the SearchPlacesRequest and applies defaults
+func (req *SearchPlacesRequest) Validate() error {
+	req.Q = strings.TrimSpace(req.Q)
+	if err := validator.ValidateRequest(req); err != nil {
+		return err
+	}
+	if req.Limit == 0 {
+		req.Limit = types.NewDefaultQueryFilter().GetLimit()
+	}
+	return nil
+}
+
+// SearchPlacesResponse is a page of places ranked by search relevance
+type SearchPlacesResponse struct {
+	types.ListResponse[*PlaceResponse]
+	// DidYouMean is a spelling correction offered when the search found nothing
+	DidYouMean *string `json:"did_you_mean,omitempty"`
+}
+
+// NewSearchPlacesResponse creates a paginated search response
+func NewSearchPlacesResponse(places []*place.Place, total, limit, offset int) *SearchPlacesResponse {
+	return &SearchPlacesResponse{ListResponse: *NewListPlacesResponse(places, total, limit, offset)}
+}
@@ -0,0 +1,196 @@
+package dto
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+func TestNewPlaceResponsePlaceholder(t *testing.T) {
+	tests := []struct {
+		name          string
+		image         string
+		thumbnail     string
+		place         *place.Place
+		wantPrimary   *string
+		wantThumbnail *string
+		wantReal      bool
+	}{
+		{
+			name:          "own images are kept",
+			image:         "https://cdn.example.com/placeholder.jpg",
+			place:         &place.Place{PrimaryImageURL: lo.ToPtr("https://cdn.example.com/ramkund.jpg"), ThumbnailURL: lo.ToPtr("https://cdn.example.com/ramkund-thumb.jpg")},
+			wantPrimary:   lo.ToPtr("https://cdn.example.com/ramkund.jpg"),
+			wantThumbnail: lo.ToPtr("https://cdn.example.com/ramkund-thumb.jpg"),
+			wantReal:      true,
+		},
+		{
+			name:          "missing images use the placeholder",
+			image:         "https://cdn.example.com/placeholder.jpg",
+			thumbnail:     "https://cdn.example.com/placeholder-thumb.jpg",
+			place:         &place.Place{},
+			wantPrimary:   lo.ToPtr("https://cdn.example.com/placeholder.jpg"),
+			wantThumbnail: lo.ToPtr("https://cdn.example.com/placeholder-thumb.jpg"),
+		},
+		{
+			name:          "empty image uses the placeholder",
+			image:         "https://cdn.example.com/placeholder.jpg",
+			place:         &place.Place{PrimaryImageURL: lo.ToPtr("")},
+			wantPrimary:   lo.ToPtr("https://cdn.example.com/placeholder.jpg"),
+			wantThumbnail: lo.ToPtr("https://cdn.example.com/placeholder.jpg"),
+		},
+		{
+			name:  "no placeholder configured",
+			place: &place.Place{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			types.SetPlaceholderImage(tt.image, tt.thumbnail)
+			t.Cleanup(func() { types.SetPlaceholderImage("", "") })
+
+			stored := tt.place.PrimaryImageURL
+			resp := NewPlaceResponse(tt.place)
+			if got, want := lo.FromPtr(resp.PrimaryImageURL), lo.FromPtr(tt.wantPrimary); got != want {
+				t.Errorf("PrimaryImageURL = %q, want %q", got, want)
+			}
+			if got, want := lo.FromPtr(resp.ThumbnailURL), lo.FromPtr(tt.wantThumbnail); got != want {
+				t.Errorf("ThumbnailURL = %q, want %q", got, want)
+			}
+			if resp.HasRealImage != tt.wantReal {
+				t.Errorf("HasRealImage = %v, want %v", resp.HasRealImage, tt.wantReal)
+			}
+			if tt.place.PrimaryImageURL != stored {
+				t.Error("the stored primary image was changed")
+			}
+		})
+	}
+}
+
+func TestEmptyCollectionsMarshalAsArrays(t *testing.T) {
+	withoutImages := func() *PlaceResponse {
+		return NewPlaceResponse(&place.Place{ID: "place_1", Slug: "ramkund", Title: "Ramkund"})
+	}
+
+	tests := []struct {
+		name string
+		resp any
+		want []string
+	}{
+		{
+			name: "place without images",
+			resp: withoutImages(),
+			want: []string{`"images":[]`},
+		},
+		{
+			name: "list item without images",
+			resp: types.NewListResponse([]*PlaceResponse{withoutImages()}, 1, 20, 0),
+			want: []string{`"items":[{`, `"images":[]`},
+		},
+		{
+			name: "empty list",
+			resp: types.NewListResponse[*PlaceResponse](nil, 0, 20, 0),
+			want: []string{`"items":[]`},
+		},
+		{
+			name: "empty feed section",
+			resp: NewFeedSectionResponse(types.FeedSectionType("trending"), nil, 0, 10, 0),
+			want: []string{`"items":[]`},
+		},
+		{
+			name: "empty feed",
+			resp: NewFeedResponse(nil),
+			want: []string{`"sections":[]`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := json.Marshal(tt.resp)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(body), want) {
+					t.Errorf("marshaled %s, want %s", body, want)
+				}
+			}
+			if strings.Contains(string(body), "null") {
+				t.Errorf("marshaled %s, want no null collections", body)
+			}
+		})
+	}
+}
+
+func TestPlaceResponseRenderHTML(t *testing.T) {
+	src := "## Rituals\n\n- [Aarti](https://example.com/aarti) at dawn"
+	resp := NewPlaceResponse(&place.Place{ID: "plc_1", Title: "Ramkund", LongDescription: lo.ToPtr(src)})
+	resp.RenderHTML()
+
+	want := "<h2>Rituals</h2>\n<ul>\n<li><a href=\"https://example.com/aarti\" rel=\"nofollow noreferrer\">Aarti</a> at dawn</li>\n</ul>\n"
+	if resp.LongDescriptionHTML == nil || *resp.LongDescriptionHTML != want {
+		t.Errorf("LongDescriptionHTML = %v, want %q", resp.LongDescriptionHTML, want)
+	}
+	// The Markdown stays the source of truth
+	if *resp.LongDescription != src {
+		t.Errorf("LongDescription = %q, want the stored Markdown", *resp.LongDescription)
+	}
+
+	empty := NewPlaceResponse(&place.Place{ID: "plc_2", Title: "Sula Vineyards"})
+	empty.RenderHTML()
+	if empty.LongDescriptionHTML != nil {
+		t.Errorf("LongDescriptionHTML = %q, want nil without a long description", *empty.LongDescriptionHTML)
+	}
+}
+
+func TestNewPlaceResponseCompleteness(t *testing.T) {
+	complete := func() *place.Place {
+		return &place.Place{
+			ID:              "plc_1",
+			Title:           "Ramkund",
+			PrimaryImageURL: lo.ToPtr("https://example.com/ramkund.jpg"),
+			LongDescription: lo.ToPtr("The holiest bathing ghat on the Godavari."),
+			Location:        types.Location{Latitude: decimal.RequireFromString("20.0077"), Longitude: decimal.RequireFromString("73.7920")},
+			CategoryCount:   lo.ToPtr(2),
+			Contact:         &types.Contact{Phone: lo.ToPtr("+919876543210")},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(p *place.Place)
+		want   *int
+	}{
+		{name: "fully populated", modify: func(*place.Place) {}, want: lo.ToPtr(100)},
+		{name: "no image", modify: func(p *place.Place) { p.PrimaryImageURL = nil }, want: lo.ToPtr(75)},
+		{name: "no categories or contact", modify: func(p *place.Place) { p.CategoryCount = lo.ToPtr(0); p.Contact = &types.Contact{} }, want: lo.ToPtr(70)},
+		{
+			name: "nothing optional",
+			modify: func(p *place.Place) {
+				p.PrimaryImageURL, p.LongDescription, p.Contact = nil, lo.ToPtr(""), nil
+				p.Location = types.Location{}
+				p.CategoryCount = lo.ToPtr(0)
+			},
+			want: lo.ToPtr(0),
+		},
+		{name: "categories not loaded", modify: func(p *place.Place) { p.CategoryCount = nil }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := complete()
+			tt.modify(p)
+
+			got := NewPlaceResponse(p).Completeness
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("Completeness = %v, want %v", lo.FromPtr(got), lo.FromPtr(tt.want))
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package dto
+
+import ierr "github.com/omkar273/nashikdarshan/internal/errors"
+
+// UpsertPlaceRequest creates the place with the slug, or replaces the fields of the place
+// that already has it. The slug comes from the path; a slug in the body is ignored.
+type UpsertPlaceRequest struct {
+	CreatePlaceRequest
+}
+
+// Validate validates the UpsertPlaceRequest
+func (req *UpsertPlaceRequest) Validate() error {
+	if req.Slug == "" {
+		return ierr.NewError("slug is required").
+			WithHint("Please provide the slug of the place to create or update").
+			Mark(ierr.ErrValidation)
+	}
+	return req.CreatePlaceRequest.Validate()
+}
+
+// UpsertPlaceResponse is the place an upsert created or updated
+type UpsertPlaceResponse struct {
+	*PlaceResponse
+	// Created is true when the upsert created the place and false when it updated it
+	Created bool `json:"created"`
+}
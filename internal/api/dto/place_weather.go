@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/omkar273/nashikdarshan/internal/weather"
+)
+
+// PlaceWeatherResponse is a place with the current weather at its location. Weather is null
+// when no provider is configured or the provider is unavailable.
+type PlaceWeatherResponse struct {
+	Place   *PlaceResponse      `json:"place"`
+	Weather *weather.Conditions `json:"weather"`
+}
+
+// Localize converts the place timestamps and the observation time to loc for presentation
+func (r *PlaceWeatherResponse) Localize(loc *time.Location) {
+	if r.Place != nil {
+		r.Place.Localize(loc)
+	}
+	r.Weather.Localize(loc)
+}
+
+// Translate labels the place in lang
+func (r *PlaceWeatherResponse) Translate(lang types.Language) {
+	if r.Place != nil {
+		r.Place.Translate(lang)
+	}
+}
+
+// MaskPII masks the contact of the place
+func (r *PlaceWeatherResponse) MaskPII() {
+	if r.Place != nil {
+		r.Place.MaskPII()
+	}
+}
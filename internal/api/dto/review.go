@@ -49,10 +49,10 @@ func (r *CreateReviewRequest) Validate() error {
 	return nil
 }
 
-// ToReview converts CreateReviewRequest to domain Review
+// ToReview converts CreateReviewRequest to domain Review. The id and creation times are
+// assigned by the service.
 func (r *CreateReviewRequest) ToReview(ctx context.Context) *review.Review {
 	return &review.Review{
-		ID:         types.GenerateUUIDWithPrefix(types.UUID_PREFIX_REVIEW),
 		EntityType: r.EntityType,
 		EntityID:   r.EntityID,
 		Rating:     r.Rating,
@@ -6,6 +6,8 @@ import (
 	"github.com/omkar273/nashikdarshan/internal/config"
 	"github.com/omkar273/nashikdarshan/internal/logger"
 	"github.com/omkar273/nashikdarshan/internal/rest/middleware"
+	"github.com/omkar273/nashikdarshan/internal/service"
+	"github.com/omkar273/nashikdarshan/internal/types"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
@@ -20,15 +22,42 @@ type Handlers struct {
 	Hotel     *v1.HotelHandler
 	Event     *v1.EventHandler
 	Itinerary *v1.ItineraryHandler
+	Admin     *v1.AdminHandler
+	APIKey    *v1.APIKeyHandler
 }
 
-func NewRouter(handlers *Handlers, cfg *config.Configuration, logger *logger.Logger) *gin.Engine {
-	router := gin.Default()
+func NewRouter(handlers *Handlers, cfg *config.Configuration, logger *logger.Logger, apiKeyService service.APIKeyService, userService service.UserService) *gin.Engine {
+	v1.SetStrictJSON(cfg.Server.StrictJSON)
+
+	router := gin.New()
+	router.HandleMethodNotAllowed = true
+	setTrustedProxies(router, cfg.Server.TrustedProxies, logger)
 	router.Use(
-		middleware.CORSMiddleware,
-		middleware.RequestIDMiddleware,
+		middleware.Recovery(logger),
+		gin.Logger(),
+	)
+	if cfg.Server.ServerTiming {
+		router.Use(middleware.ServerTiming)
+	}
+	router.Use(
+		middleware.CORSMiddleware(router, cfg.Server.CORSAllowedOrigins, cfg.Server.CORSAllowCredentials, cfg.Server.RequestIDHeader),
+		middleware.RequestIDMiddleware(cfg.Server.RequestIDHeader),
+		middleware.ListEnvelopeMiddleware(cfg.Server.ListEnvelope),
 		middleware.ErrorHandler(),
+		middleware.ValidatePathIDs,
 	)
+	// Turned away before authentication so throttled and maintenance requests cost no lookups
+	if cfg.Server.MaintenanceMode {
+		router.Use(middleware.Maintenance(cfg.Server.GetMaintenanceRetryAfter()))
+	}
+	if cfg.RateLimit.Enabled {
+		router.Use(middleware.RateLimit(cfg.RateLimit.RequestsPerMinute, cfg.RateLimit.Burst))
+	}
+	router.Use(middleware.APIKeyAuth(apiKeyService, logger))
+
+	// Unknown paths and methods get the same JSON errors as handlers
+	router.NoRoute(middleware.NoRouteHandler)
+	router.NoMethod(middleware.NoMethodHandler)
 
 	// Swagger documentation
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -36,6 +65,11 @@ func NewRouter(handlers *Handlers, cfg *config.Configuration, logger *logger.Log
 	// Global health check
 	router.GET("/health", handlers.Health.Health)
 
+	// Resolves the user principal and its scopes; reused by every protected group
+	authenticate := middleware.AuthenticateMiddleware(cfg, logger, userService)
+	// Lets public listings recognise admins asking for soft-deleted rows
+	optionalAuthenticate := middleware.OptionalAuthenticateMiddleware(authenticate)
+
 	v1Router := router.Group("/v1")
 
 	// Public routes
@@ -45,20 +79,24 @@ func NewRouter(handlers *Handlers, cfg *config.Configuration, logger *logger.Log
 
 	// Authenticated routes
 	v1Private := v1Router.Group("/")
-	v1Private.Use(middleware.AuthenticateMiddleware(cfg, logger))
+	v1Private.Use(authenticate)
 	{
 		v1Private.GET("/user/me", handlers.User.Me)
-		v1Private.PUT("/user", handlers.User.Update)
+		v1Private.PUT("/user", middleware.RequireScope(types.ScopeProfileWrite), handlers.User.Update)
 	}
 
 	// Category routes
 	v1Category := v1Router.Group("/categories")
 	{
-		v1Category.GET("", handlers.Category.List)
+		v1Category.GET("", optionalAuthenticate, handlers.Category.List)
 		v1Category.GET("/:id", handlers.Category.Get)
 		v1Category.GET("/slug/:slug", handlers.Category.GetBySlug)
+		v1Category.HEAD("/:id", middleware.DiscardBody, handlers.Category.Get)
+		v1Category.HEAD("/slug/:slug", middleware.DiscardBody, handlers.Category.GetBySlug)
+		v1Category.GET("/:id/places", optionalAuthenticate, handlers.Place.ListByCategory) // :id is the category slug here
+		v1Category.GET("/:id/stats", handlers.Category.GetStats)                           // :id is the category slug here
 
-		v1Category.Use(middleware.AuthenticateMiddleware(cfg, logger))
+		v1Category.Use(authenticate, middleware.RequireScope(types.ScopeCategoriesWrite))
 		v1Category.POST("", handlers.Category.Create)
 		v1Category.PUT("/:id", handlers.Category.Update)
 		v1Category.DELETE("/:id", handlers.Category.Delete)
@@ -67,30 +105,60 @@ func NewRouter(handlers *Handlers, cfg *config.Configuration, logger *logger.Log
 	// Place routes
 	v1Place := v1Router.Group("/places")
 	{
-		v1Place.GET("", handlers.Place.List)
-		v1Place.GET("/slug/:slug", handlers.Place.GetBySlug)
+		v1Place.GET("", optionalAuthenticate, handlers.Place.List)
+		v1Place.GET("/stream", optionalAuthenticate, handlers.Place.Stream)
+		v1Place.GET("/extent", optionalAuthenticate, handlers.Place.Extent)
+		v1Place.GET("/heatmap", handlers.Place.Heatmap)
+		v1Place.GET("/feed.xml", handlers.Place.AtomFeed)
+		v1Place.GET("/slug/:slug", optionalAuthenticate, handlers.Place.GetBySlug)
+		v1Place.HEAD("/slug/:slug", optionalAuthenticate, middleware.DiscardBody, handlers.Place.GetBySlug)
+		v1Place.GET("/autocomplete", handlers.Place.Autocomplete)
+		v1Place.GET("/search", optionalAuthenticate, handlers.Place.Search)
+		v1Place.GET("/accessibility-attributes", handlers.Place.ListAccessibilityAttributes)
+		v1Place.POST("/nearby/batch", optionalAuthenticate, handlers.Place.NearbyBatch)
+		v1Place.GET("/nearby/summary", handlers.Place.NearbySummary)
+		v1Place.POST("/nearby-check", optionalAuthenticate, handlers.Place.CheckGeofences)
+		v1Place.POST("/centroid", handlers.Place.Centroid)
+		v1Place.POST("/exists", handlers.Place.Exists)
 		// More specific routes must come before less specific ones
 		v1Place.GET("/:id/images", handlers.Place.GetImages)
-		v1Place.GET("/:id", handlers.Place.Get)
-
-		v1Place.Use(middleware.AuthenticateMiddleware(cfg, logger))
-		v1Place.POST("", handlers.Place.Create)
-		v1Place.PUT("/:id", handlers.Place.Update)
-		v1Place.DELETE("/:id", handlers.Place.Delete)
-		v1Place.POST("/:id/images", handlers.Place.AddImage)
-		v1Place.PUT("/:id/categories", handlers.Place.AssignCategories)
+		v1Place.GET("/:id/events", handlers.Event.ListByPlace)
+		v1Place.GET("/:id/weather", optionalAuthenticate, handlers.Place.GetWeather)
+		v1Place.GET("/:id/detail", optionalAuthenticate, handlers.Place.GetDetail)
+		v1Place.GET("/:id", optionalAuthenticate, handlers.Place.Get)
+		v1Place.HEAD("/:id", optionalAuthenticate, middleware.DiscardBody, handlers.Place.Get)
+
+		v1Place.Use(authenticate)
+		v1Place.POST("", middleware.RequireScope(types.ScopePlacesWrite), handlers.Place.Create)
+		v1Place.PUT("/by-slug/:slug", middleware.RequireScope(types.ScopePlacesWrite), handlers.Place.Upsert)
+		v1Place.PUT("/:id", middleware.RequireScope(types.ScopePlacesWrite), handlers.Place.Update)
+		v1Place.PATCH("/:id", middleware.RequireScope(types.ScopePlacesWrite), handlers.Place.Patch)
+		v1Place.DELETE("/:id", middleware.RequireScope(types.ScopePlacesWrite), handlers.Place.Delete)
+		v1Place.POST("/:id/clone", middleware.RequireScope(types.ScopePlacesWrite), handlers.Place.Clone)
+		v1Place.POST("/:id/images", middleware.RequireScope(types.ScopeImagesWrite), handlers.Place.AddImage)
+		v1Place.DELETE("/:id/images", middleware.RequireScope(types.ScopeImagesWrite), handlers.Place.DeleteImages)
+		v1Place.PUT("/:id/categories", middleware.RequireScope(types.ScopePlacesWrite), handlers.Place.AssignCategories)
+		v1Place.POST("/:id/events", middleware.RequireScope(types.ScopeEventsWrite), handlers.Event.CreateForPlace)
+		v1Place.PUT("/:id/events/:event_id", middleware.RequireScope(types.ScopeEventsWrite), handlers.Event.UpdateForPlace)
+		v1Place.DELETE("/:id/events/:event_id", middleware.RequireScope(types.ScopeEventsWrite), handlers.Event.DeleteForPlace)
+		v1Place.GET("/:id/revisions", middleware.RequireScope(types.ScopePlacesWrite), handlers.Place.ListRevisions)
+		v1Place.GET("/:id/revisions/diff", middleware.RequireScope(types.ScopePlacesWrite), handlers.Place.DiffRevisions)
+		v1Place.GET("/:id/revisions/:rev", middleware.RequireScope(types.ScopePlacesWrite), handlers.Place.GetRevision)
+		v1Place.POST("/:id/revisions/:rev/revert", middleware.RequireScope(types.ScopePlacesWrite), handlers.Place.RevertToRevision)
 	}
 
-	// Place image routes (authenticated only)
+	// Place image routes; the image itself is public, changes are authenticated
 	v1PlaceImage := v1Router.Group("/places/images")
-	v1PlaceImage.Use(middleware.AuthenticateMiddleware(cfg, logger))
 	{
+		v1PlaceImage.GET("/:image_id", handlers.Place.GetImage)
+
+		v1PlaceImage.Use(authenticate, middleware.RequireScope(types.ScopeImagesWrite))
 		v1PlaceImage.PUT("/:image_id", handlers.Place.UpdateImage)
 		v1PlaceImage.DELETE("/:image_id", handlers.Place.DeleteImage)
 	}
 
 	// Feed routes (public)
-	v1Router.POST("/feed", handlers.Place.GetFeed)
+	v1Router.POST("/feed", optionalAuthenticate, handlers.Place.GetFeed)
 
 	// Engagement tracking routes
 	v1Router.POST("/places/:id/view", handlers.Place.IncrementViewCount) // Public for analytics
@@ -104,7 +172,7 @@ func NewRouter(handlers *Handlers, cfg *config.Configuration, logger *logger.Log
 		v1Review.GET("/stats/:entityType/:entityId", handlers.Review.GetRatingStats)
 
 		// Authenticated review routes
-		v1Review.Use(middleware.AuthenticateMiddleware(cfg, logger))
+		v1Review.Use(authenticate, middleware.RequireScope(types.ScopeReviewsWrite))
 		v1Review.POST("", handlers.Review.CreateReview)
 		v1Review.PUT("/:id", handlers.Review.UpdateReview)
 		v1Review.DELETE("/:id", handlers.Review.DeleteReview)
@@ -117,7 +185,7 @@ func NewRouter(handlers *Handlers, cfg *config.Configuration, logger *logger.Log
 		v1Hotel.GET("/slug/:slug", handlers.Hotel.GetBySlug)
 		v1Hotel.GET("/:id", handlers.Hotel.Get)
 
-		v1Hotel.Use(middleware.AuthenticateMiddleware(cfg, logger))
+		v1Hotel.Use(authenticate, middleware.RequireScope(types.ScopeHotelsWrite))
 		v1Hotel.POST("", handlers.Hotel.Create)
 		v1Hotel.PUT("/:id", handlers.Hotel.Update)
 		v1Hotel.DELETE("/:id", handlers.Hotel.Delete)
@@ -129,6 +197,7 @@ func NewRouter(handlers *Handlers, cfg *config.Configuration, logger *logger.Log
 		// Public event routes (specific paths BEFORE wildcard paths)
 		v1Event.GET("", handlers.Event.List) // Supports expand=true with from_date/to_date for occurrence expansion
 		v1Event.GET("/slug/:slug", handlers.Event.GetBySlug)
+		v1Event.GET("/upcoming", handlers.Event.Upcoming) // Expanded instances across all places
 
 		// Event-specific routes with :id (must come before /:id to avoid conflicts)
 		v1Event.POST("/:id/view", handlers.Event.IncrementView)             // Public for analytics
@@ -138,17 +207,17 @@ func NewRouter(handlers *Handlers, cfg *config.Configuration, logger *logger.Log
 		v1Event.GET("/:id", handlers.Event.Get)
 
 		// Authenticated event routes
-		v1Event.Use(middleware.AuthenticateMiddleware(cfg, logger))
-		v1Event.POST("", handlers.Event.Create)
-		v1Event.PUT("/:id", handlers.Event.Update)
-		v1Event.DELETE("/:id", handlers.Event.Delete)
+		v1Event.Use(authenticate)
+		v1Event.POST("", middleware.RequireScope(types.ScopeEventsWrite), handlers.Event.Create)
+		v1Event.PUT("/:id", middleware.RequireScope(types.ScopeEventsWrite), handlers.Event.Update)
+		v1Event.DELETE("/:id", middleware.RequireScope(types.ScopeEventsWrite), handlers.Event.Delete)
 
 		// Occurrence routes under /events/occurrences for consistency
-		v1Event.GET("/occurrences/:id", handlers.Event.GetOccurrence)       // Public
-		v1Event.POST("/occurrences", handlers.Event.CreateOccurrence)       // Authenticated
-		v1Event.PUT("/occurrences/:id", handlers.Event.UpdateOccurrence)    // Authenticated
-		v1Event.DELETE("/occurrences/:id", handlers.Event.DeleteOccurrence) // Authenticated
-		v1Event.GET("/:id/occurrences", handlers.Event.ListOccurrences)     // Public - list occurrences for specific event
+		v1Event.GET("/occurrences/:id", handlers.Event.GetOccurrence)                                                        // Public
+		v1Event.POST("/occurrences", middleware.RequireScope(types.ScopeEventsWrite), handlers.Event.CreateOccurrence)       // Authenticated
+		v1Event.PUT("/occurrences/:id", middleware.RequireScope(types.ScopeEventsWrite), handlers.Event.UpdateOccurrence)    // Authenticated
+		v1Event.DELETE("/occurrences/:id", middleware.RequireScope(types.ScopeEventsWrite), handlers.Event.DeleteOccurrence) // Authenticated
+		v1Event.GET("/:id/occurrences", handlers.Event.ListOccurrences)                                                      // Public - list occurrences for specific event
 	}
 
 	// Itinerary routes
@@ -162,12 +231,38 @@ func NewRouter(handlers *Handlers, cfg *config.Configuration, logger *logger.Log
 		v1Itinerary.GET("/:id", handlers.Itinerary.Get) // Public get basic info
 
 		// Authenticated itinerary routes
-		v1Itinerary.Use(middleware.AuthenticateMiddleware(cfg, logger))
-		v1Itinerary.GET("/me", handlers.Itinerary.GetMyItineraries) // Get user's itineraries
-		v1Itinerary.POST("", handlers.Itinerary.Create)             // Create optimized itinerary
-		v1Itinerary.PUT("/:id", handlers.Itinerary.Update)          // Update itinerary
-		v1Itinerary.DELETE("/:id", handlers.Itinerary.Delete)       // Delete itinerary
+		v1Itinerary.Use(authenticate)
+		v1Itinerary.GET("/me", handlers.Itinerary.GetMyItineraries)                                                 // Get user's itineraries
+		v1Itinerary.POST("", middleware.RequireScope(types.ScopeItinerariesWrite), handlers.Itinerary.Create)       // Create optimized itinerary
+		v1Itinerary.PUT("/:id", middleware.RequireScope(types.ScopeItinerariesWrite), handlers.Itinerary.Update)    // Update itinerary
+		v1Itinerary.DELETE("/:id", middleware.RequireScope(types.ScopeItinerariesWrite), handlers.Itinerary.Delete) // Delete itinerary
+	}
+
+	// Admin routes
+	v1Admin := v1Router.Group("/admin")
+	v1Admin.Use(authenticate, middleware.RequireScope(types.ScopeAdmin))
+	{
+		v1Admin.GET("/places/duplicates", handlers.Admin.FindDuplicatePlaces)
+		v1Admin.GET("/places/incomplete", handlers.Admin.ListIncompletePlaces)
+		v1Admin.POST("/places/reslug", handlers.Admin.ReslugPlaces)
+		v1Admin.POST("/refresh-views", handlers.Admin.RefreshViews)
+		v1Admin.GET("/cache/stats", handlers.Admin.CacheStats)
+		v1Admin.GET("/config", handlers.Admin.GetConfig)
+		v1Admin.POST("/imports/osm", handlers.Admin.ImportOSM)
+
+		v1Admin.GET("/api-keys", handlers.APIKey.List)
+		v1Admin.POST("/api-keys", handlers.APIKey.Create)
+		v1Admin.DELETE("/api-keys/:id", handlers.APIKey.Revoke)
 	}
 
 	return router
 }
+
+// setTrustedProxies makes the router believe X-Forwarded-For only from the given proxies.
+// Configuration.Validate rejects malformed entries; should one get through, trust no proxy.
+func setTrustedProxies(router *gin.Engine, proxies []string, logger *logger.Logger) {
+	if err := router.SetTrustedProxies(proxies); err != nil {
+		logger.Errorw("invalid trusted proxies, ignoring forwarding headers", "error", err)
+		_ = router.SetTrustedProxies(nil)
+	}
+}
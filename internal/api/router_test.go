@@ -0,0 +1,53 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/config"
+	"github.com/omkar273/nashikdarshan/internal/logger"
+	"go.uber.org/zap"
+)
+
+func TestSetTrustedProxies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name         string
+		proxies      []string
+		remoteAddr   string
+		forwardedFor string
+		wantClientIP string
+	}{
+		{name: "local proxy by default", proxies: config.DefaultTrustedProxies, remoteAddr: "127.0.0.1:41000", forwardedFor: "203.0.113.7", wantClientIP: "203.0.113.7"},
+		{name: "untrusted peer", proxies: config.DefaultTrustedProxies, remoteAddr: "198.51.100.20:41000", forwardedFor: "203.0.113.7", wantClientIP: "198.51.100.20"},
+		{name: "load balancer subnet", proxies: []string{"10.0.0.0/8"}, remoteAddr: "10.0.3.4:41000", forwardedFor: "203.0.113.7", wantClientIP: "203.0.113.7"},
+		// Hops are read from the right, so an address the client made up is never reached
+		{name: "spoofed hop", proxies: []string{"10.0.0.0/8"}, remoteAddr: "10.0.3.4:41000", forwardedFor: "192.0.2.1, 203.0.113.7, 10.0.9.9", wantClientIP: "203.0.113.7"},
+		{name: "no header", proxies: []string{"10.0.0.0/8"}, remoteAddr: "10.0.3.4:41000", wantClientIP: "10.0.3.4"},
+		{name: "no trusted proxies", proxies: []string{}, remoteAddr: "127.0.0.1:41000", forwardedFor: "203.0.113.7", wantClientIP: "127.0.0.1"},
+		{name: "malformed entry trusts none", proxies: []string{"10.0.0.0/33"}, remoteAddr: "10.0.3.4:41000", forwardedFor: "203.0.113.7", wantClientIP: "10.0.3.4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			setTrustedProxies(router, tt.proxies, &logger.Logger{SugaredLogger: zap.NewNop().Sugar()})
+			router.GET("/ip", func(c *gin.Context) { c.String(http.StatusOK, c.ClientIP()) })
+
+			req := httptest.NewRequest(http.MethodGet, "/ip", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.forwardedFor != "" {
+				req.Header.Set("X-Forwarded-For", tt.forwardedFor)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if got := w.Body.String(); got != tt.wantClientIP {
+				t.Errorf("ClientIP() = %s, want %s", got, tt.wantClientIP)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	"github.com/omkar273/nashikdarshan/internal/cache"
+	"github.com/omkar273/nashikdarshan/internal/config"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/service"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+type AdminHandler struct {
+	adminService       service.AdminService
+	placeImportService service.PlaceImportService
+	cacheStore         *cache.Store
+	config             *config.Configuration
+}
+
+func NewAdminHandler(adminService service.AdminService, placeImportService service.PlaceImportService, cacheStore *cache.Store, cfg *config.Configuration) *AdminHandler {
+	return &AdminHandler{adminService: adminService, placeImportService: placeImportService, cacheStore: cacheStore, config: cfg}
+}
+
+// @Summary Find duplicate places
+// @Description Cluster places within a distance and title similarity threshold and report candidate duplicate groups. Nothing is merged.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param filter query types.PlaceDuplicateFilter false "Duplicate detection parameters"
+// @Success 200 {object} dto.DuplicatePlacesResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /admin/places/duplicates [get]
+// @Security Authorization
+func (h *AdminHandler) FindDuplicatePlaces(c *gin.Context) {
+	var filter types.PlaceDuplicateFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Please check the query parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.adminService.FindDuplicatePlaces(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary List incomplete places
+// @Description Report places missing a primary image, long description, coordinates or categories, with what each place is missing
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param filter query types.PlaceIncompleteFilter false "Filter by missing field (primary_image, long_description, coordinates, categories, contact)"
+// @Success 200 {object} dto.ListIncompletePlacesResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /admin/places/incomplete [get]
+// @Security Authorization
+func (h *AdminHandler) ListIncompletePlaces(c *gin.Context) {
+	var filter types.PlaceIncompleteFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Please check the query parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewDefaultQueryFilter()
+	}
+
+	response, err := h.adminService.ListIncompletePlaces(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	response.SetLinks(requestURL(c))
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Refresh materialized views
+// @Description Force a refresh of the precomputed feed listings, e.g. after bulk edits
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} dto.RefreshViewsResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /admin/refresh-views [post]
+// @Security Authorization
+func (h *AdminHandler) RefreshViews(c *gin.Context) {
+	response, err := h.adminService.RefreshViews(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Re-slug places
+// @Description Regenerate the slug of every place that is not deleted under the current slug policy (places.slug_prefixes and places.slug_collision_strategy), e.g. after adding type prefixes. A place keeps its slug when the policy could give it that slug. Every changed place's old slug is kept in the slug history, so GET /places/slug/{slug} still finds the place by it. Changes are written batch_size places per transaction; a place whose slug changed or that was deleted while the job ran is skipped. Set dry_run to get the proposed changes without writing.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body dto.ReslugPlacesRequest true "Dry-run flag and batch size"
+// @Success 200 {object} dto.ReslugPlacesReport
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 409 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /admin/places/reslug [post]
+// @Security Authorization
+func (h *AdminHandler) ReslugPlaces(c *gin.Context) {
+	var req dto.ReslugPlacesRequest
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	report, err := h.adminService.ReslugPlaces(c.Request.Context(), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
+
+// @Summary Get cache stats
+// @Description Report the repository cache's entry count and per-entity hit/miss counters since startup on this instance
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} cache.Stats
+// @Failure 403 {object} ierr.ErrorResponse
+// @Router /admin/cache/stats [get]
+// @Security Authorization
+func (h *AdminHandler) CacheStats(c *gin.Context) {
+	c.JSON(http.StatusOK, h.cacheStore.Stats())
+}
+
+// @Summary Get effective configuration
+// @Description Return the configuration this instance is running with, after config.yaml, .env and environment variables are layered, keyed like config.yaml. Passwords, keys, tokens and other secrets show as "[REDACTED]" when set and "" when unset.
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} ierr.ErrorResponse
+// @Router /admin/config [get]
+// @Security Authorization
+func (h *AdminHandler) GetConfig(c *gin.Context) {
+	c.JSON(http.StatusOK, h.config.Redacted())
+}
+
+// @Summary Import places from OpenStreetMap
+// @Description Run an Overpass query and upsert the named POIs it returns as places, matched to existing places by their OpenStreetMap reference (external_refs.osm). New places are created as drafts; existing ones get their title, location and address refreshed. Ways are placed at the centroid of their outline; relations without a center, such as a site of separate shrines, at the centroid of their members, which the item lists in points. Set dry_run to get the report without writing. One import runs at a time, at most once per osm_import.min_interval_seconds.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body dto.OSMImportRequest true "Optional query override and dry-run flag"
+// @Success 200 {object} dto.OSMImportReport
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 502 {object} ierr.ErrorResponse
+// @Router /admin/imports/osm [post]
+// @Security Authorization
+func (h *AdminHandler) ImportOSM(c *gin.Context) {
+	var req dto.OSMImportRequest
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response, err := h.placeImportService.ImportOSM(c.Request.Context(), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/service"
+)
+
+type APIKeyHandler struct {
+	apiKeyService service.APIKeyService
+}
+
+func NewAPIKeyHandler(apiKeyService service.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{apiKeyService: apiKeyService}
+}
+
+// @Summary Create an API key
+// @Description Create a long-lived API key for a partner integration. The raw key is only returned in this response.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateAPIKeyRequest true "Create API key request"
+// @Success 201 {object} dto.CreateAPIKeyResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /admin/api-keys [post]
+// @Security Authorization
+func (h *APIKeyHandler) Create(c *gin.Context) {
+	var req dto.CreateAPIKeyRequest
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response, err := h.apiKeyService.Create(c.Request.Context(), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusCreated, response)
+}
+
+// @Summary List API keys
+// @Description List all API keys. Raw keys are never returned.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.ListAPIKeysResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /admin/api-keys [get]
+// @Security Authorization
+func (h *APIKeyHandler) List(c *gin.Context) {
+	response, err := h.apiKeyService.List(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Revoke an API key
+// @Description Revoke an API key so it can no longer be used
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "API key ID"
+// @Success 204
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /admin/api-keys/{id} [delete]
+// @Security Authorization
+func (h *APIKeyHandler) Revoke(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("API key ID is required").
+			WithHint("Please provide a valid API key ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.apiKeyService.Revoke(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
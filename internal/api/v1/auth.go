@@ -5,7 +5,6 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/omkar273/nashikdarshan/internal/api/dto"
-	ierr "github.com/omkar273/nashikdarshan/internal/errors"
 	"github.com/omkar273/nashikdarshan/internal/service"
 )
 
@@ -30,10 +29,8 @@ func NewAuthHandler(authService service.AuthService) *AuthHandler {
 func (h *AuthHandler) Signup(c *gin.Context) {
 
 	var req dto.SignupRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.Error(ierr.WithError(err).
-			WithHint("Please check the request payload").
-			Mark(ierr.ErrValidation))
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -0,0 +1,73 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// strictJSON rejects request bodies with members the request type does not declare, see
+// SetStrictJSON
+var strictJSON bool
+
+// SetStrictJSON configures whether request bodies with unknown members are rejected. Lenient
+// decoding, the default, ignores them, so a misspelled key is silently dropped.
+func SetStrictJSON(strict bool) {
+	strictJSON = strict
+}
+
+// jsonBinding decodes a JSON request body, rejecting unknown members when strictJSON is set,
+// and validates the result like gin's JSON binding
+type jsonBinding struct{}
+
+func (jsonBinding) Name() string {
+	return "json"
+}
+
+func (jsonBinding) Bind(req *http.Request, obj any) error {
+	if req == nil || req.Body == nil {
+		return errors.New("invalid request")
+	}
+
+	decoder := json.NewDecoder(req.Body)
+	if strictJSON {
+		decoder.DisallowUnknownFields()
+	}
+	if err := decoder.Decode(obj); err != nil {
+		return err
+	}
+	return binding.Validator.ValidateStruct(obj)
+}
+
+// bindJSON decodes and validates the JSON request body into obj, returning an ErrValidation
+// on failure
+func bindJSON(c *gin.Context, obj any) error {
+	return bindJSONWithHint(c, obj, "Please check the request payload")
+}
+
+// bindJSONWithHint is bindJSON with the hint returned for malformed or invalid bodies
+func bindJSONWithHint(c *gin.Context, obj any, hint string) error {
+	err := c.ShouldBindWith(obj, jsonBinding{})
+	if err == nil {
+		return nil
+	}
+
+	// encoding/json reports unknown members only through the message
+	if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		field = strings.Trim(field, `"`)
+		return ierr.NewErrorf("unknown field %q", field).
+			WithHintf("%s is not a field of this request; please check its spelling", field).
+			WithReportableDetails(map[string]any{
+				"field": field,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return ierr.WithError(err).
+		WithHint(hint).
+		Mark(ierr.ErrValidation)
+}
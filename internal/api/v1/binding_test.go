@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+func TestBindJSON(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	type request struct {
+		Title string `json:"title" binding:"required"`
+		Notes string `json:"notes"`
+	}
+
+	tests := []struct {
+		name      string
+		strict    bool
+		body      string
+		wantTitle string
+		// wantErr is a fragment of the validation error, empty when the body binds
+		wantErr string
+	}{
+		{name: "known fields", body: `{"title":"Ramkund","notes":"ghat"}`, wantTitle: "Ramkund"},
+		{name: "known fields strict", strict: true, body: `{"title":"Ramkund"}`, wantTitle: "Ramkund"},
+		{name: "unknown field lenient", body: `{"title":"Ramkund","titel":"typo"}`, wantTitle: "Ramkund"},
+		{name: "unknown field strict", strict: true, body: `{"title":"Ramkund","titel":"typo"}`, wantErr: `unknown field "titel"`},
+		{name: "missing required field", strict: true, body: `{"notes":"ghat"}`, wantErr: "Title"},
+		{name: "malformed", body: `{"title":`, wantErr: "unexpected EOF"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetStrictJSON(tt.strict)
+			t.Cleanup(func() { SetStrictJSON(false) })
+
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("POST", "/v1/places", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			var req request
+			err := bindJSON(c, &req)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("bindJSON() error = %v", err)
+				}
+				if req.Title != tt.wantTitle {
+					t.Errorf("Title = %q, want %q", req.Title, tt.wantTitle)
+				}
+				return
+			}
+			if !ierr.IsValidation(err) {
+				t.Fatalf("bindJSON() error = %v, want validation error", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("bindJSON() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ func NewCategoryHandler(categoryService service.CategoryService) *CategoryHandle
 // @Produce json
 // @Param request body dto.CreateCategoryRequest true "Create category request"
 // @Success 201 {object} dto.CategoryResponse
+// @Header 201 {string} Location "URL of the created category"
 // @Failure 400 {object} ierr.ErrorResponse
 // @Failure 409 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
@@ -32,10 +33,8 @@ func NewCategoryHandler(categoryService service.CategoryService) *CategoryHandle
 // @Security Authorization
 func (h *CategoryHandler) Create(c *gin.Context) {
 	var req dto.CreateCategoryRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.Error(ierr.WithError(err).
-			WithHint("Please check the request payload").
-			Mark(ierr.ErrValidation))
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -44,7 +43,7 @@ func (h *CategoryHandler) Create(c *gin.Context) {
 		c.Error(err)
 		return
 	}
-	c.JSON(http.StatusCreated, category)
+	created(c, "/categories/"+category.ID, category)
 }
 
 // @Summary Get category by ID
@@ -53,10 +52,15 @@ func (h *CategoryHandler) Create(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Category ID"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
 // @Success 200 {object} dto.CategoryResponse
+// @Header 200 {string} ETag "Hash of the representation"
+// @Header 200 {string} Last-Modified "Time of the last update"
 // @Failure 404 {object} ierr.ErrorResponse
+// @Failure 410 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
 // @Router /categories/{id} [get]
+// @Router /categories/{id} [head]
 func (h *CategoryHandler) Get(c *gin.Context) {
 	id := c.Param("id")
 	if id == "" {
@@ -71,6 +75,14 @@ func (h *CategoryHandler) Get(c *gin.Context) {
 		c.Error(err)
 		return
 	}
+	if err := localize(c, category); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := setValidators(c, category.UpdatedAt, category); err != nil {
+		c.Error(err)
+		return
+	}
 	c.JSON(http.StatusOK, category)
 }
 
@@ -80,10 +92,14 @@ func (h *CategoryHandler) Get(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param slug path string true "Category slug"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
 // @Success 200 {object} dto.CategoryResponse
+// @Header 200 {string} ETag "Hash of the representation"
+// @Header 200 {string} Last-Modified "Time of the last update"
 // @Failure 404 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
 // @Router /categories/slug/{slug} [get]
+// @Router /categories/slug/{slug} [head]
 func (h *CategoryHandler) GetBySlug(c *gin.Context) {
 	slug := c.Param("slug")
 	if slug == "" {
@@ -98,11 +114,47 @@ func (h *CategoryHandler) GetBySlug(c *gin.Context) {
 		c.Error(err)
 		return
 	}
+	if err := localize(c, category); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := setValidators(c, category.UpdatedAt, category); err != nil {
+		c.Error(err)
+		return
+	}
 	c.JSON(http.StatusOK, category)
 }
 
+// @Summary Category stats
+// @Description Aggregate the published places of a category: how many there are, the average rating over all their reviews (null without reviews), how many have images and what percentage that is, and the number of places of each type, most common first. Place type labels follow Accept-Language.
+// @Tags Category
+// @Produce json
+// @Param slug path string true "Category slug"
+// @Param Accept-Language header string false "Language of the place type labels: en, mr or hi (default en)"
+// @Success 200 {object} dto.CategoryStatsResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /categories/{slug}/stats [get]
+func (h *CategoryHandler) GetStats(c *gin.Context) {
+	slug := c.Param("id") // shares the :id segment of the category routes
+	if slug == "" {
+		c.Error(ierr.NewError("category slug is required").
+			WithHint("Please provide a valid category slug").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	stats, err := h.categoryService.GetStats(c.Request.Context(), slug)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	translate(c, stats)
+	c.JSON(http.StatusOK, stats)
+}
+
 // @Summary Update a category
-// @Description Update an existing category
+// @Description Update an existing category. Admins may also update an archived (deleted) category; setting a live status restores it.
 // @Tags Category
 // @Accept json
 // @Produce json
@@ -125,10 +177,8 @@ func (h *CategoryHandler) Update(c *gin.Context) {
 	}
 
 	var req dto.UpdateCategoryRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.Error(ierr.WithError(err).
-			WithHint("Please check the request payload").
-			Mark(ierr.ErrValidation))
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -175,13 +225,22 @@ func (h *CategoryHandler) Delete(c *gin.Context) {
 // @Produce json
 // @Param limit query int false "Limit"
 // @Param offset query int false "Offset"
+// @Param page query int false "Page number from 1; use with per_page instead of limit and offset"
+// @Param per_page query int false "Page size when paging by number; capped at 1000"
 // @Param status query string false "Status"
-// @Param sort query string false "Sort field"
+// @Param sort query string false "Sort field, or a comma list of field:direction applied in order (e.g. title:asc,created_at:desc); id is always the final tiebreaker"
 // @Param order query string false "Sort order (asc/desc)"
 // @Param slug query []string false "Filter by slugs"
 // @Param name query []string false "Filter by names"
+// @Param created_by query string false "Only categories created by this user ID (non-admins: their own ID only)"
+// @Param updated_by query string false "Only categories last updated by this user ID (non-admins: their own ID only)"
+// @Param updated_since query string false "Only categories updated at or after this RFC 3339 time"
+// @Param include_deleted query bool false "Include soft-deleted categories (admins only; ignored otherwise)"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
+// @Param Accept header string false "Use application/json; profile=\"data-meta\" for a {data, meta, links} envelope"
 // @Success 200 {object} dto.ListCategoriesResponse
 // @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
 // @Router /categories [get]
 func (h *CategoryHandler) List(c *gin.Context) {
@@ -213,5 +272,10 @@ func (h *CategoryHandler) List(c *gin.Context) {
 		c.Error(err)
 		return
 	}
-	c.JSON(http.StatusOK, response)
+	response.SetLinks(requestURL(c))
+	if err := localize(c, response); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, envelope(c, response))
 }
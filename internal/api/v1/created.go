@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// created responds 201 Created with the new resource and a Location header pointing at it.
+// path is the resource path below the API version, e.g. "/places/" + id.
+func created(c *gin.Context, path string, resp any) {
+	c.Header("Location", resourceURL(c, path))
+	c.JSON(http.StatusCreated, resp)
+}
+
+// resourceURL is the absolute URL of path under the API version of the current route
+func resourceURL(c *gin.Context, path string) string {
+	version, _, _ := strings.Cut(strings.TrimPrefix(c.FullPath(), "/"), "/")
+
+	u := requestURL(c)
+	u.Path = "/" + version + path
+	u.RawQuery = ""
+	return u.String()
+}
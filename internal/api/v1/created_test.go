@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/service"
+)
+
+// upsertPlaceService answers Upsert with a fixed place; its other methods are not used
+type upsertPlaceService struct {
+	service.PlaceService
+	created bool
+}
+
+func (s upsertPlaceService) Upsert(_ context.Context, req *dto.UpsertPlaceRequest) (*dto.PlaceResponse, bool, error) {
+	p := &place.Place{ID: "place_01J9Z3K4M5N6P7Q8R9S0T1V2W3", Slug: req.Slug, Title: req.Title}
+	return dto.NewPlaceResponse(p), s.created, nil
+}
+
+func TestUpsertStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name         string
+		created      bool
+		header       map[string]string
+		wantStatus   int
+		wantLocation string
+	}{
+		{
+			name:         "created",
+			created:      true,
+			wantStatus:   http.StatusCreated,
+			wantLocation: "http://api.example.com/v1/places/place_01J9Z3K4M5N6P7Q8R9S0T1V2W3",
+		},
+		{
+			name:         "created behind a proxy",
+			created:      true,
+			header:       map[string]string{"X-Forwarded-Proto": "https", "X-Forwarded-Host": "nashik.example.com"},
+			wantStatus:   http.StatusCreated,
+			wantLocation: "https://nashik.example.com/v1/places/place_01J9Z3K4M5N6P7Q8R9S0T1V2W3",
+		},
+		{
+			name:       "updated",
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.PUT("/v1/places/by-slug/:slug", NewPlaceHandler(upsertPlaceService{created: tt.created}).Upsert)
+
+			body := `{"title":"Ramkund","place_type":"temple","location":{"latitude":20.0077,"longitude":73.7920}}`
+			req := httptest.NewRequest(http.MethodPut, "http://api.example.com/v1/places/by-slug/ramkund", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			for k, v := range tt.header {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.wantStatus, w.Body)
+			}
+			if got := w.Header().Get("Location"); got != tt.wantLocation {
+				t.Errorf("Location = %q, want %q", got, tt.wantLocation)
+			}
+
+			var resp struct {
+				ID      string `json:"id"`
+				Slug    string `json:"slug"`
+				Created bool   `json:"created"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if resp.Created != tt.created || resp.Slug != "ramkund" {
+				t.Errorf("response = %+v, want created %v for ramkund", resp, tt.created)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// envelope renders a list response in the shape negotiated for the request
+func envelope(c *gin.Context, resp types.Enveloper) any {
+	return resp.Envelope(types.GetListEnvelope(c.Request.Context()))
+}
@@ -25,6 +25,7 @@ func NewEventHandler(eventService service.EventService) *EventHandler {
 // @Produce json
 // @Param request body dto.CreateEventRequest true "Create event request"
 // @Success 201 {object} dto.EventResponse
+// @Header 201 {string} Location "URL of the created event"
 // @Failure 400 {object} ierr.ErrorResponse
 // @Failure 409 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
@@ -32,10 +33,8 @@ func NewEventHandler(eventService service.EventService) *EventHandler {
 // @Security Authorization
 func (h *EventHandler) Create(c *gin.Context) {
 	var req dto.CreateEventRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.Error(ierr.WithError(err).
-			WithHint("Please check the request payload").
-			Mark(ierr.ErrValidation))
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -44,7 +43,7 @@ func (h *EventHandler) Create(c *gin.Context) {
 		c.Error(err)
 		return
 	}
-	c.JSON(http.StatusCreated, event)
+	created(c, "/events/"+event.ID, event)
 }
 
 // @Summary Get event by ID
@@ -53,6 +52,7 @@ func (h *EventHandler) Create(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Event ID"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
 // @Success 200 {object} dto.EventResponse
 // @Failure 404 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
@@ -71,6 +71,10 @@ func (h *EventHandler) Get(c *gin.Context) {
 		c.Error(err)
 		return
 	}
+	if err := localize(c, event); err != nil {
+		c.Error(err)
+		return
+	}
 	c.JSON(http.StatusOK, event)
 }
 
@@ -80,6 +84,7 @@ func (h *EventHandler) Get(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param slug path string true "Event slug"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
 // @Success 200 {object} dto.EventResponse
 // @Failure 404 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
@@ -98,6 +103,10 @@ func (h *EventHandler) GetBySlug(c *gin.Context) {
 		c.Error(err)
 		return
 	}
+	if err := localize(c, event); err != nil {
+		c.Error(err)
+		return
+	}
 	c.JSON(http.StatusOK, event)
 }
 
@@ -125,10 +134,8 @@ func (h *EventHandler) Update(c *gin.Context) {
 	}
 
 	var req dto.UpdateEventRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.Error(ierr.WithError(err).
-			WithHint("Please check the request payload").
-			Mark(ierr.ErrValidation))
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -177,6 +184,7 @@ func (h *EventHandler) Delete(c *gin.Context) {
 // @Param expand query bool false "Expand occurrences in date range"
 // @Param from_date query string false "Start date for expansion (YYYY-MM-DD)"
 // @Param to_date query string false "End date for expansion (YYYY-MM-DD)"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
 // @Success 200 {object} dto.ListEventsResponse
 // @Failure 400 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
@@ -208,6 +216,11 @@ func (h *EventHandler) List(c *gin.Context) {
 		c.Error(err)
 		return
 	}
+	events.SetLinks(requestURL(c))
+	if err := localize(c, events); err != nil {
+		c.Error(err)
+		return
+	}
 
 	// Check if expansion is requested
 	if filter.Expand != nil && *filter.Expand {
@@ -236,6 +249,7 @@ func (h *EventHandler) List(c *gin.Context) {
 // @Produce json
 // @Param request body dto.CreateOccurrenceRequest true "Create occurrence request"
 // @Success 201 {object} dto.OccurrenceResponse
+// @Header 201 {string} Location "URL of the created occurrence"
 // @Failure 400 {object} ierr.ErrorResponse
 // @Failure 404 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
@@ -243,10 +257,8 @@ func (h *EventHandler) List(c *gin.Context) {
 // @Security Authorization
 func (h *EventHandler) CreateOccurrence(c *gin.Context) {
 	var req dto.CreateOccurrenceRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.Error(ierr.WithError(err).
-			WithHint("Please check the request payload").
-			Mark(ierr.ErrValidation))
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -255,7 +267,7 @@ func (h *EventHandler) CreateOccurrence(c *gin.Context) {
 		c.Error(err)
 		return
 	}
-	c.JSON(http.StatusCreated, occurrence)
+	created(c, "/events/occurrences/"+occurrence.ID, occurrence)
 }
 
 // @Summary Get occurrence by ID
@@ -308,10 +320,8 @@ func (h *EventHandler) UpdateOccurrence(c *gin.Context) {
 	}
 
 	var req dto.UpdateOccurrenceRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.Error(ierr.WithError(err).
-			WithHint("Please check the request payload").
-			Mark(ierr.ErrValidation))
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -431,3 +441,196 @@ func (h *EventHandler) IncrementInterested(c *gin.Context) {
 	}
 	c.Status(http.StatusNoContent)
 }
+
+// @Summary List upcoming events
+// @Description Get concrete event instances (recurrences expanded in IST) starting within the given window across all places
+// @Tags Event
+// @Accept json
+// @Produce json
+// @Param within query string false "Look-ahead window as a duration, e.g. 72h (default 168h)"
+// @Success 200 {object} dto.UpcomingEventsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /events/upcoming [get]
+func (h *EventHandler) Upcoming(c *gin.Context) {
+	var req dto.UpcomingEventsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Please check the query parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	within, err := req.GetWithin()
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response, err := h.eventService.UpcomingEvents(c.Request.Context(), within)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary List events for a place
+// @Description Get a paginated list of events held at a place
+// @Tags Event
+// @Accept json
+// @Produce json
+// @Param id path string true "Place ID"
+// @Param filter query types.EventFilter false "Event filter parameters"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
+// @Success 200 {object} dto.ListEventsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/{id}/events [get]
+func (h *EventHandler) ListByPlace(c *gin.Context) {
+	placeID := c.Param("id")
+	if placeID == "" {
+		c.Error(ierr.NewError("place ID is required").
+			WithHint("Please provide a valid place ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var filter types.EventFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Please check the query parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewDefaultQueryFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid filter parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.eventService.ListByPlace(c.Request.Context(), placeID, &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	response.SetLinks(requestURL(c))
+	if err := localize(c, response); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Create an event for a place
+// @Description Create a new event attached to a place. The place_id in the body is ignored.
+// @Tags Event
+// @Accept json
+// @Produce json
+// @Param id path string true "Place ID"
+// @Param request body dto.CreateEventRequest true "Create event request"
+// @Success 201 {object} dto.EventResponse
+// @Header 201 {string} Location "URL of the created event"
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 409 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/{id}/events [post]
+// @Security Authorization
+func (h *EventHandler) CreateForPlace(c *gin.Context) {
+	placeID := c.Param("id")
+	if placeID == "" {
+		c.Error(ierr.NewError("place ID is required").
+			WithHint("Please provide a valid place ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.CreateEventRequest
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	event, err := h.eventService.CreateForPlace(c.Request.Context(), placeID, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	created(c, "/events/"+event.ID, event)
+}
+
+// @Summary Update an event of a place
+// @Description Update an existing event attached to a place
+// @Tags Event
+// @Accept json
+// @Produce json
+// @Param id path string true "Place ID"
+// @Param event_id path string true "Event ID"
+// @Param request body dto.UpdateEventRequest true "Update event request"
+// @Success 200 {object} dto.EventResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/{id}/events/{event_id} [put]
+// @Security Authorization
+func (h *EventHandler) UpdateForPlace(c *gin.Context) {
+	placeID := c.Param("id")
+	eventID := c.Param("event_id")
+	if placeID == "" || eventID == "" {
+		c.Error(ierr.NewError("place ID and event ID are required").
+			WithHint("Please provide a valid place ID and event ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateEventRequest
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	event, err := h.eventService.UpdateForPlace(c.Request.Context(), placeID, eventID, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, event)
+}
+
+// @Summary Delete an event of a place
+// @Description Soft delete an event attached to a place
+// @Tags Event
+// @Accept json
+// @Produce json
+// @Param id path string true "Place ID"
+// @Param event_id path string true "Event ID"
+// @Success 204
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/{id}/events/{event_id} [delete]
+// @Security Authorization
+func (h *EventHandler) DeleteForPlace(c *gin.Context) {
+	placeID := c.Param("id")
+	eventID := c.Param("event_id")
+	if placeID == "" || eventID == "" {
+		c.Error(ierr.NewError("place ID and event ID are required").
+			WithHint("Please provide a valid place ID and event ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	err := h.eventService.DeleteForPlace(c.Request.Context(), placeID, eventID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
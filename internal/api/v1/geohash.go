@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// geohash recomputes the geohash of the places in resp when the client asks for a precision
+// other than the configured one via geohash_precision
+func geohash(c *gin.Context, resp types.Geohasher) error {
+	value := c.Query("geohash_precision")
+	if value == "" {
+		return nil
+	}
+	precision, err := types.ParseGeohashPrecision(value)
+	if err != nil {
+		return err
+	}
+	resp.SetGeohash(precision)
+	return nil
+}
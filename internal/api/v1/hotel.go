@@ -25,6 +25,7 @@ func NewHotelHandler(hotelService service.HotelService) *HotelHandler {
 // @Produce json
 // @Param request body dto.CreateHotelRequest true "Create hotel request"
 // @Success 201 {object} dto.HotelResponse
+// @Header 201 {string} Location "URL of the created hotel"
 // @Failure 400 {object} ierr.ErrorResponse
 // @Failure 409 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
@@ -32,10 +33,8 @@ func NewHotelHandler(hotelService service.HotelService) *HotelHandler {
 // @Security Authorization
 func (h *HotelHandler) Create(c *gin.Context) {
 	var req dto.CreateHotelRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.Error(ierr.WithError(err).
-			WithHint("Please check the request payload").
-			Mark(ierr.ErrValidation))
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -44,7 +43,7 @@ func (h *HotelHandler) Create(c *gin.Context) {
 		c.Error(err)
 		return
 	}
-	c.JSON(http.StatusCreated, hotel)
+	created(c, "/hotels/"+hotel.ID, hotel)
 }
 
 // @Summary Get hotel by ID
@@ -53,6 +52,7 @@ func (h *HotelHandler) Create(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Hotel ID"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
 // @Success 200 {object} dto.HotelResponse
 // @Failure 404 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
@@ -71,6 +71,10 @@ func (h *HotelHandler) Get(c *gin.Context) {
 		c.Error(err)
 		return
 	}
+	if err := localize(c, hotel); err != nil {
+		c.Error(err)
+		return
+	}
 	c.JSON(http.StatusOK, hotel)
 }
 
@@ -80,6 +84,7 @@ func (h *HotelHandler) Get(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param slug path string true "Hotel slug"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
 // @Success 200 {object} dto.HotelResponse
 // @Failure 404 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
@@ -98,6 +103,10 @@ func (h *HotelHandler) GetBySlug(c *gin.Context) {
 		c.Error(err)
 		return
 	}
+	if err := localize(c, hotel); err != nil {
+		c.Error(err)
+		return
+	}
 	c.JSON(http.StatusOK, hotel)
 }
 
@@ -125,10 +134,8 @@ func (h *HotelHandler) Update(c *gin.Context) {
 	}
 
 	var req dto.UpdateHotelRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.Error(ierr.WithError(err).
-			WithHint("Please check the request payload").
-			Mark(ierr.ErrValidation))
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -174,6 +181,7 @@ func (h *HotelHandler) Delete(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param filter query types.HotelFilter false "Hotel filter parameters"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
 // @Success 200 {object} dto.ListHotelsResponse
 // @Failure 400 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
@@ -207,5 +215,10 @@ func (h *HotelHandler) List(c *gin.Context) {
 		c.Error(err)
 		return
 	}
+	response.SetLinks(requestURL(c))
+	if err := localize(c, response); err != nil {
+		c.Error(err)
+		return
+	}
 	c.JSON(http.StatusOK, response)
 }
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// include adds the optional, computed fields the client asks for via include, such as
+// include=center_distance for the distance of each place from the city center. They are
+// left out by default so large listings do not pay for them.
+func include(c *gin.Context, resp types.CenterDistancer) error {
+	values, err := types.ParseInclude(c.Query("include"))
+	if err != nil {
+		return err
+	}
+	if slices.Contains(values, types.IncludeCenterDistance) {
+		resp.SetCenterDistance(types.CityCenter())
+	}
+	return nil
+}
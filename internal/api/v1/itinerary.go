@@ -25,6 +25,7 @@ func NewItineraryHandler(itineraryService service.ItineraryService) *ItineraryHa
 // @Produce json
 // @Param request body dto.CreateItineraryRequest true "Create itinerary request"
 // @Success 201 {object} dto.ItineraryResponse
+// @Header 201 {string} Location "URL of the created itinerary"
 // @Failure 400 {object} ierr.ErrorResponse "Invalid request payload or validation error"
 // @Failure 404 {object} ierr.ErrorResponse "One or more places not found"
 // @Failure 500 {object} ierr.ErrorResponse "Internal server error"
@@ -42,10 +43,8 @@ func (h *ItineraryHandler) Create(c *gin.Context) {
 	}
 
 	var req dto.CreateItineraryRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.Error(ierr.WithError(err).
-			WithHint("Please check the request payload").
-			Mark(ierr.ErrValidation))
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -54,7 +53,7 @@ func (h *ItineraryHandler) Create(c *gin.Context) {
 		c.Error(err)
 		return
 	}
-	c.JSON(http.StatusCreated, itinerary)
+	created(c, "/itineraries/"+itinerary.ID, itinerary)
 }
 
 // @Summary Get itinerary by ID
@@ -63,6 +62,8 @@ func (h *ItineraryHandler) Create(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Itinerary ID"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
+// @Param Accept-Language header string false "Language of place_type_label and status_label: en, mr or hi (default en)"
 // @Success 200 {object} dto.ItineraryResponse
 // @Failure 404 {object} ierr.ErrorResponse "Itinerary not found"
 // @Failure 500 {object} ierr.ErrorResponse "Internal server error"
@@ -81,6 +82,12 @@ func (h *ItineraryHandler) Get(c *gin.Context) {
 		c.Error(err)
 		return
 	}
+	maskPII(c, itinerary)
+	translate(c, itinerary)
+	if err := localize(c, itinerary); err != nil {
+		c.Error(err)
+		return
+	}
 	c.JSON(http.StatusOK, itinerary)
 }
 
@@ -90,6 +97,8 @@ func (h *ItineraryHandler) Get(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Itinerary ID"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
+// @Param Accept-Language header string false "Language of place_type_label and status_label: en, mr or hi (default en)"
 // @Success 200 {object} dto.ItineraryResponse
 // @Failure 404 {object} ierr.ErrorResponse "Itinerary not found"
 // @Failure 500 {object} ierr.ErrorResponse "Internal server error"
@@ -108,6 +117,12 @@ func (h *ItineraryHandler) GetWithVisits(c *gin.Context) {
 		c.Error(err)
 		return
 	}
+	maskPII(c, itinerary)
+	translate(c, itinerary)
+	if err := localize(c, itinerary); err != nil {
+		c.Error(err)
+		return
+	}
 	c.JSON(http.StatusOK, itinerary)
 }
 
@@ -134,10 +149,8 @@ func (h *ItineraryHandler) Update(c *gin.Context) {
 	}
 
 	var req dto.UpdateItineraryRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.Error(ierr.WithError(err).
-			WithHint("Please check the request payload").
-			Mark(ierr.ErrValidation))
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -184,6 +197,8 @@ func (h *ItineraryHandler) Delete(c *gin.Context) {
 // @Produce json
 // @Param limit query int false "Limit" default(20)
 // @Param offset query int false "Offset" default(0)
+// @Param page query int false "Page number from 1; use with per_page instead of limit and offset"
+// @Param per_page query int false "Page size when paging by number; capped at 1000"
 // @Param status query string false "Status filter (published, draft, archived, deleted)"
 // @Param sort query string false "Sort field" default(created_at)
 // @Param order query string false "Sort order (asc/desc)" default(desc)
@@ -191,6 +206,8 @@ func (h *ItineraryHandler) Delete(c *gin.Context) {
 // @Param from_date query string false "Filter itineraries from date (YYYY-MM-DD)"
 // @Param to_date query string false "Filter itineraries to date (YYYY-MM-DD)"
 // @Param transport_mode query string false "Filter by transport mode (WALKING, DRIVING, TAXI)"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
+// @Param Accept-Language header string false "Language of place_type_label and status_label: en, mr or hi (default en)"
 // @Success 200 {object} dto.ListItinerariesResponse
 // @Failure 400 {object} ierr.ErrorResponse "Invalid query parameters"
 // @Failure 500 {object} ierr.ErrorResponse "Internal server error"
@@ -221,6 +238,13 @@ func (h *ItineraryHandler) List(c *gin.Context) {
 		c.Error(err)
 		return
 	}
+	response.SetLinks(requestURL(c))
+	maskPII(c, response)
+	translate(c, response)
+	if err := localize(c, response); err != nil {
+		c.Error(err)
+		return
+	}
 	c.JSON(http.StatusOK, response)
 }
 
@@ -231,9 +255,13 @@ func (h *ItineraryHandler) List(c *gin.Context) {
 // @Produce json
 // @Param limit query int false "Limit" default(20)
 // @Param offset query int false "Offset" default(0)
+// @Param page query int false "Page number from 1; use with per_page instead of limit and offset"
+// @Param per_page query int false "Page size when paging by number; capped at 1000"
 // @Param status query string false "Status filter"
 // @Param sort query string false "Sort field" default(created_at)
 // @Param order query string false "Sort order (asc/desc)" default(desc)
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
+// @Param Accept-Language header string false "Language of place_type_label and status_label: en, mr or hi (default en)"
 // @Success 200 {object} dto.ListItinerariesResponse
 // @Failure 401 {object} ierr.ErrorResponse "User not authenticated"
 // @Failure 400 {object} ierr.ErrorResponse "Invalid query parameters"
@@ -278,5 +306,12 @@ func (h *ItineraryHandler) GetMyItineraries(c *gin.Context) {
 		c.Error(err)
 		return
 	}
+	response.SetLinks(requestURL(c))
+	maskPII(c, response)
+	translate(c, response)
+	if err := localize(c, response); err != nil {
+		c.Error(err)
+		return
+	}
 	c.JSON(http.StatusOK, response)
 }
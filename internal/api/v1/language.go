@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// translate labels the enum values in resp in the language picked from Accept-Language. The
+// canonical values are left as they are.
+func translate(c *gin.Context, resp types.Translator) {
+	if lang := languageOf(c); lang != types.LanguageEnglish {
+		resp.Translate(lang)
+	}
+}
+
+// languageOf picks the label language from Accept-Language, English when the header names
+// none of the supported languages, and announces it in Content-Language
+func languageOf(c *gin.Context) types.Language {
+	lang := types.MatchLanguage(c.GetHeader("Accept-Language"))
+	c.Header("Content-Language", string(lang))
+	c.Writer.Header().Add("Vary", "Accept-Language")
+	return lang
+}
@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// requestURL reconstructs the absolute URL of the current request, including its query,
+// so list responses can link to other pages. Proxy headers take precedence over the
+// connection when the API runs behind a load balancer.
+func requestURL(c *gin.Context) *url.URL {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := forwardedValue(c.GetHeader("X-Forwarded-Proto")); proto != "" {
+		scheme = proto
+	}
+
+	host := c.Request.Host
+	if forwardedHost := forwardedValue(c.GetHeader("X-Forwarded-Host")); forwardedHost != "" {
+		host = forwardedHost
+	}
+
+	return &url.URL{
+		Scheme:   scheme,
+		Host:     host,
+		Path:     c.Request.URL.Path,
+		RawQuery: c.Request.URL.RawQuery,
+	}
+}
+
+// forwardedValue returns the first entry of a possibly comma-separated proxy header
+func forwardedValue(header string) string {
+	first, _, _ := strings.Cut(header, ",")
+	return strings.TrimSpace(first)
+}
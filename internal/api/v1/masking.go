@@ -0,0 +1,15 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// maskPII masks the personal data in resp, such as place contact phones and emails, for every
+// caller but admins, who see it in full. The rules are configured per field.
+func maskPII(c *gin.Context, resp types.PIIMasker) {
+	if types.HasScope(types.GetScopes(c.Request.Context()), types.ScopeAdmin) {
+		return
+	}
+	resp.MaskPII()
+}
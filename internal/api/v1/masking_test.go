@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+)
+
+func TestMaskPII(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		scopes []string
+		// want and wantAbsent are fragments of the marshaled contact
+		want       []string
+		wantAbsent []string
+	}{
+		{
+			name:       "anonymous",
+			want:       []string{`"phone":"*********3210"`, `"website":"https://sula.example.com"`},
+			wantAbsent: []string{"9876543210", "visit@sula.example.com"},
+		},
+		{
+			name:       "editor",
+			scopes:     []string{string(types.ScopePlacesWrite)},
+			want:       []string{`"phone":"*********3210"`},
+			wantAbsent: []string{"9876543210", "visit@sula.example.com"},
+		},
+		{
+			name:   "admin",
+			scopes: []string{string(types.ScopeAdmin)},
+			want: []string{
+				`"phone":"+919876543210"`,
+				`"phone_display":"+91 98765 43210"`,
+				`"email":"visit@sula.example.com"`,
+				`"website":"https://sula.example.com"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("GET", "/v1/places/sula-vineyards", nil)
+			if tt.scopes != nil {
+				c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), types.CtxScopes, tt.scopes))
+			}
+
+			p := &place.Place{
+				ID:    "plc_sula",
+				Slug:  "sula-vineyards",
+				Title: "Sula Vineyards",
+				Contact: &types.Contact{
+					Phone:   lo.ToPtr("+919876543210"),
+					Email:   lo.ToPtr("visit@sula.example.com"),
+					Website: lo.ToPtr("sula.example.com"),
+				},
+			}
+			resp := dto.NewPlaceResponse(p)
+			maskPII(c, resp)
+
+			body, err := json.Marshal(resp.Contact)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(body), want) {
+					t.Errorf("contact = %s, want it to contain %s", body, want)
+				}
+			}
+			for _, absent := range tt.wantAbsent {
+				if strings.Contains(string(body), absent) {
+					t.Errorf("contact = %s, want no %s", body, absent)
+				}
+			}
+			if *p.Contact.Phone != "+919876543210" {
+				t.Errorf("masking changed the stored phone to %s", *p.Contact.Phone)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// ContentTypeNDJSON is the media type of newline-delimited JSON streams
+const ContentTypeNDJSON = "application/x-ndjson"
+
+// ndjsonFlushInterval bounds how long encoded lines wait in the buffer before being sent
+const ndjsonFlushInterval = time.Second
+
+// ndjsonFlushLines is the number of lines after which the buffer is sent regardless of time
+const ndjsonFlushLines = 100
+
+// ndjsonWriter writes one JSON value per line to a streaming response, flushing every
+// ndjsonFlushLines lines or ndjsonFlushInterval, whichever comes first
+type ndjsonWriter struct {
+	c         *gin.Context
+	enc       *json.Encoder
+	pending   int
+	lastFlush time.Time
+}
+
+// newNDJSONWriter starts a 200 NDJSON response
+func newNDJSONWriter(c *gin.Context) *ndjsonWriter {
+	c.Header("Content-Type", ContentTypeNDJSON)
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Status(http.StatusOK)
+	return &ndjsonWriter{c: c, enc: json.NewEncoder(c.Writer), lastFlush: time.Now()}
+}
+
+// Write encodes v as the next line. A failed write means the client is gone.
+func (w *ndjsonWriter) Write(v any) error {
+	if err := w.enc.Encode(v); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to write to the stream").
+			Mark(ierr.ErrInternal)
+	}
+	w.pending++
+	if w.pending >= ndjsonFlushLines || time.Since(w.lastFlush) >= ndjsonFlushInterval {
+		w.Flush()
+	}
+	return nil
+}
+
+// Flush sends the buffered lines to the client
+func (w *ndjsonWriter) Flush() {
+	w.c.Writer.Flush()
+	w.pending = 0
+	w.lastFlush = time.Now()
+}
+
+// Fail ends a stream that broke after the status was sent with a final error line, so clients
+// can tell a truncated stream from a complete one
+func (w *ndjsonWriter) Fail(err error) {
+	_ = w.enc.Encode(ierr.ErrorResponse{
+		Success: false,
+		Error: ierr.ErrorDetail{
+			Code:    ierr.CodeFromErr(err),
+			Display: "The stream was interrupted; please retry the request",
+		},
+	})
+	w.Flush()
+}
@@ -1,7 +1,11 @@
 package v1
 
 import (
+	"encoding/xml"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/omkar273/nashikdarshan/internal/api/dto"
@@ -25,17 +29,17 @@ func NewPlaceHandler(placeService service.PlaceService) *PlaceHandler {
 // @Produce json
 // @Param request body dto.CreatePlaceRequest true "Create place request"
 // @Success 201 {object} dto.PlaceResponse
+// @Header 201 {string} Location "URL of the created place"
 // @Failure 400 {object} ierr.ErrorResponse
 // @Failure 409 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
 // @Router /places [post]
 // @Security Authorization
 func (h *PlaceHandler) Create(c *gin.Context) {
 	var req dto.CreatePlaceRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.Error(ierr.WithError(err).
-			WithHint("Please check the request payload").
-			Mark(ierr.ErrValidation))
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -44,7 +48,8 @@ func (h *PlaceHandler) Create(c *gin.Context) {
 		c.Error(err)
 		return
 	}
-	c.JSON(http.StatusCreated, place)
+	maskPII(c, place)
+	created(c, "/places/"+place.ID, place)
 }
 
 // @Summary Get place by ID
@@ -53,10 +58,19 @@ func (h *PlaceHandler) Create(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Place ID"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
+// @Param Accept-Language header string false "Language of place_type_label and status_label: en, mr or hi (default en)"
+// @Param render query string false "Set to html to add long_description_html rendered from the Markdown long description"
+// @Param include query string false "Set to center_distance to add distance_from_center_km, the distance of each place from the city center"
+// @Param geohash_precision query int false "Length (1-12) of the geohash of each place; defaults to places.geohash_precision"
 // @Success 200 {object} dto.PlaceResponse
+// @Header 200 {string} ETag "Hash of the representation"
+// @Header 200 {string} Last-Modified "Time of the last update"
 // @Failure 404 {object} ierr.ErrorResponse
+// @Failure 410 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
 // @Router /places/{id} [get]
+// @Router /places/{id} [head]
 func (h *PlaceHandler) Get(c *gin.Context) {
 	id := c.Param("id")
 	if id == "" {
@@ -71,19 +85,99 @@ func (h *PlaceHandler) Get(c *gin.Context) {
 		c.Error(err)
 		return
 	}
+	maskPII(c, place)
+	translate(c, place)
+	if err := localize(c, place); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := render(c, place); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := include(c, place); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := geohash(c, place); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := setValidators(c, place.UpdatedAt, place); err != nil {
+		c.Error(err)
+		return
+	}
 	c.JSON(http.StatusOK, place)
 }
 
+// @Summary Get place detail
+// @Description Get a place with its images, review summary, upcoming event instances (next 30 days) and similar places in one response. Related sections that fail or time out are returned empty and listed in degraded; only the place itself is required.
+// @Tags Place
+// @Accept json
+// @Produce json
+// @Param id path string true "Place ID"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
+// @Param Accept-Language header string false "Language of place_type_label and status_label: en, mr or hi (default en)"
+// @Param render query string false "Set to html to add long_description_html rendered from the Markdown long description"
+// @Param include query string false "Set to center_distance to add distance_from_center_km, the distance of each place from the city center"
+// @Param geohash_precision query int false "Length (1-12) of the geohash of each place; defaults to places.geohash_precision"
+// @Success 200 {object} dto.PlaceDetailResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/{id}/detail [get]
+func (h *PlaceHandler) GetDetail(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("place ID is required").
+			WithHint("Please provide a valid place ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	detail, err := h.placeService.GetDetail(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	maskPII(c, detail)
+	translate(c, detail)
+	if err := localize(c, detail); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := render(c, detail); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := include(c, detail); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := geohash(c, detail); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, detail)
+}
+
 // @Summary Get place by slug
-// @Description Get a place by its slug
+// @Description Get a place by its slug. A slug the place had before an admin re-slug also finds it.
 // @Tags Place
 // @Accept json
 // @Produce json
 // @Param slug path string true "Place slug"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
+// @Param Accept-Language header string false "Language of place_type_label and status_label: en, mr or hi (default en)"
+// @Param render query string false "Set to html to add long_description_html rendered from the Markdown long description"
+// @Param include query string false "Set to center_distance to add distance_from_center_km, the distance of each place from the city center"
+// @Param geohash_precision query int false "Length (1-12) of the geohash of each place; defaults to places.geohash_precision"
 // @Success 200 {object} dto.PlaceResponse
+// @Header 200 {string} ETag "Hash of the representation"
+// @Header 200 {string} Last-Modified "Time of the last update"
 // @Failure 404 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
 // @Router /places/slug/{slug} [get]
+// @Router /places/slug/{slug} [head]
 func (h *PlaceHandler) GetBySlug(c *gin.Context) {
 	slug := c.Param("slug")
 	if slug == "" {
@@ -98,20 +192,85 @@ func (h *PlaceHandler) GetBySlug(c *gin.Context) {
 		c.Error(err)
 		return
 	}
+	maskPII(c, place)
+	translate(c, place)
+	if err := localize(c, place); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := render(c, place); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := include(c, place); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := geohash(c, place); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := setValidators(c, place.UpdatedAt, place); err != nil {
+		c.Error(err)
+		return
+	}
 	c.JSON(http.StatusOK, place)
 }
 
+// @Summary Create or update a place by slug
+// @Description Create the place with the slug, or replace the fields of the place that already has it. Optional fields left out are cleared on update; the type and status of an existing place are kept. Concurrent upserts of a new slug create one place. Responds 201 with a Location header when the place was created and 200 when it was updated; created in the body says the same. Safe to repeat.
+// @Tags Place
+// @Accept json
+// @Produce json
+// @Param slug path string true "Place slug"
+// @Param request body dto.UpsertPlaceRequest true "Place to create or update"
+// @Success 200 {object} dto.UpsertPlaceResponse
+// @Success 201 {object} dto.UpsertPlaceResponse
+// @Header 201 {string} Location "URL of the created place"
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 410 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/by-slug/{slug} [put]
+// @Security Authorization
+func (h *PlaceHandler) Upsert(c *gin.Context) {
+	var req dto.UpsertPlaceRequest
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+	req.Slug = c.Param("slug")
+
+	place, isNew, err := h.placeService.Upsert(c.Request.Context(), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	resp := &dto.UpsertPlaceResponse{PlaceResponse: place, Created: isNew}
+	maskPII(c, resp)
+	if isNew {
+		created(c, "/places/"+place.ID, resp)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
 // @Summary Update a place
-// @Description Update an existing place
+// @Description Update an existing place. Admins may also update an archived (deleted) place; setting a live status restores it.
 // @Tags Place
 // @Accept json
 // @Produce json
 // @Param id path string true "Place ID"
 // @Param request body dto.UpdatePlaceRequest true "Update place request"
+// @Param dry_run query bool false "Validate and return the resulting diff without saving (responds with dto.PlaceDryRunResponse)"
+// @Param If-Unmodified-Since header string false "HTTP-date, e.g. the Last-Modified of a previous read; the update is refused with 412 if the place changed after it"
 // @Success 200 {object} dto.PlaceResponse
 // @Failure 400 {object} ierr.ErrorResponse
 // @Failure 404 {object} ierr.ErrorResponse
 // @Failure 409 {object} ierr.ErrorResponse
+// @Failure 412 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
 // @Router /places/{id} [put]
 // @Security Authorization
@@ -125,18 +284,98 @@ func (h *PlaceHandler) Update(c *gin.Context) {
 	}
 
 	var req dto.UpdatePlaceRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+	req.UnmodifiedSince = unmodifiedSince(c)
+
+	if c.Query("dry_run") == "true" {
+		preview, err := h.placeService.PreviewUpdate(c.Request.Context(), id, &req)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		maskPII(c, preview)
+		c.JSON(http.StatusOK, preview)
+		return
+	}
+
+	place, err := h.placeService.Update(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	maskPII(c, place)
+	c.JSON(http.StatusOK, place)
+}
+
+// @Summary Patch a place
+// @Description Partially update a place with an RFC 7396 JSON Merge Patch. Absent fields are left unchanged, null removes an optional field and address/location are merged.
+// @Tags Place
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param id path string true "Place ID"
+// @Param request body dto.UpdatePlaceRequest true "Merge patch document"
+// @Param dry_run query bool false "Validate and return the resulting diff without saving (responds with dto.PlaceDryRunResponse)"
+// @Param If-Unmodified-Since header string false "HTTP-date, e.g. the Last-Modified of a previous read; the patch is refused with 412 if the place changed after it"
+// @Success 200 {object} dto.PlaceResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 412 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/{id} [patch]
+// @Security Authorization
+func (h *PlaceHandler) Patch(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("place ID is required").
+			WithHint("Please provide a valid place ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if contentType := c.ContentType(); contentType != dto.ContentTypeMergePatch && contentType != gin.MIMEJSON {
+		c.Error(ierr.NewErrorf("unsupported content type %s", contentType).
+			WithHintf("Please send the patch as %s", dto.ContentTypeMergePatch).
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	body, err := c.GetRawData()
+	if err != nil {
 		c.Error(ierr.WithError(err).
 			WithHint("Please check the request payload").
 			Mark(ierr.ErrValidation))
 		return
 	}
 
-	place, err := h.placeService.Update(c.Request.Context(), id, &req)
+	req, err := dto.ParsePlaceMergePatch(body)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	req.Update.UnmodifiedSince = unmodifiedSince(c)
+
+	if c.Query("dry_run") == "true" {
+		preview, err := h.placeService.PreviewPatch(c.Request.Context(), id, req)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		maskPII(c, preview)
+		c.JSON(http.StatusOK, preview)
+		return
+	}
+
+	place, err := h.placeService.Patch(c.Request.Context(), id, req)
 	if err != nil {
 		c.Error(err)
 		return
 	}
+	maskPII(c, place)
 	c.JSON(http.StatusOK, place)
 }
 
@@ -169,27 +408,49 @@ func (h *PlaceHandler) Delete(c *gin.Context) {
 }
 
 // @Summary List places
-// @Description Get a paginated list of places with filtering and pagination
+// @Description Get a paginated list of places with filtering and pagination. Published places outside their publish_at/unpublish_at window are listed for admins only.
 // @Tags Place
 // @Accept json
 // @Produce json
 // @Param limit query int false "Limit"
 // @Param offset query int false "Offset"
+// @Param page query int false "Page number from 1; use with per_page instead of limit and offset"
+// @Param per_page query int false "Page size when paging by number; capped at 1000"
 // @Param status query string false "Status"
-// @Param sort query string false "Sort field"
+// @Param sort query string false "Sort field, or a comma list of field:direction applied in order (e.g. title:asc,created_at:desc); id is always the final tiebreaker"
 // @Param order query string false "Sort order (asc/desc)"
+// @Param collation query string false "Language rules for sorting by title: und, mr, hi, en or binary (default from places.title_collation)"
 // @Param slug query []string false "Filter by slugs"
 // @Param place_types query []string false "Filter by place types"
-// @Param categories query []string false "Filter by categories"
+// @Param categories query []string false "Filter by category slugs"
 // @Param amenities query []string false "Filter by amenities"
 // @Param min_rating query number false "Minimum rating"
 // @Param max_rating query number false "Maximum rating"
 // @Param latitude query number false "Latitude for geospatial filtering"
 // @Param longitude query number false "Longitude for geospatial filtering"
 // @Param radius_km query number false "Radius in kilometers for geospatial filtering"
+// @Param rank query string false "Order of geospatial results: distance (default) or best, a blend of proximity and popularity returned as score"
 // @Param search_query query string false "Search query"
+// @Param free query bool false "Only free (true) or paid (false) places"
+// @Param max_price query number false "Free places and paid places whose entry fee is at most this amount"
+// @Param price_currency query string false "ISO 4217 currency of max_price (default INR)"
+// @Param wheelchair query bool false "Only places stated to be wheelchair accessible (true) or not (false)"
+// @Param pincode query string false "Only places whose address has this 6-digit pincode; spaces are ignored"
+// @Param geohash_prefix query string false "Only places in this geohash cell, e.g. tes3 for central Nashik"
+// @Param min_completeness query int false "Only places whose completeness score (0-100) is at least this"
+// @Param max_completeness query int false "Only places whose completeness score (0-100) is at most this"
+// @Param created_by query string false "Only places created by this user ID (non-admins: their own ID only)"
+// @Param updated_by query string false "Only places last updated by this user ID (non-admins: their own ID only)"
+// @Param updated_since query string false "Only places updated at or after this RFC 3339 time"
+// @Param include_deleted query bool false "Include soft-deleted places (admins only; ignored otherwise)"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
+// @Param Accept-Language header string false "Language of place_type_label and status_label: en, mr or hi (default en)"
+// @Param Accept header string false "Use application/json; profile=\"data-meta\" for a {data, meta, links} envelope"
+// @Param include query string false "Set to center_distance to add distance_from_center_km, the distance of each place from the city center"
+// @Param geohash_precision query int false "Length (1-12) of the geohash of each place; defaults to places.geohash_precision"
 // @Success 200 {object} dto.ListPlacesResponse
 // @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
 // @Router /places [get]
 func (h *PlaceHandler) List(c *gin.Context) {
@@ -221,164 +482,857 @@ func (h *PlaceHandler) List(c *gin.Context) {
 		c.Error(err)
 		return
 	}
-	c.JSON(http.StatusOK, response)
+	response.SetLinks(requestURL(c))
+	maskPII(c, response)
+	translate(c, response)
+	if err := localize(c, response); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := include(c, response); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := geohash(c, response); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, envelope(c, response))
 }
 
-// @Summary Add image to place
-// @Description Add an image to a place
+// @Summary Extent of places
+// @Description Get the smallest box holding every place matching the filters, as south-west and north-east corners and a GeoJSON bbox, so a map can be fitted to them. Accepts the list filters; limit, offset and sort are ignored. Responds 404 when no place matches.
 // @Tags Place
-// @Accept json
 // @Produce json
-// @Param id path string true "Place ID"
-// @Param request body dto.CreatePlaceImageRequest true "Create place image request"
-// @Success 201 {object} dto.PlaceImageResponse
+// @Param status query string false "Status"
+// @Param place_types query []string false "Filter by place types"
+// @Param categories query []string false "Filter by category slugs"
+// @Param latitude query number false "Latitude for geospatial filtering"
+// @Param longitude query number false "Longitude for geospatial filtering"
+// @Param radius_m query number false "Radius in meters for geospatial filtering"
+// @Param free query bool false "Only free (true) or paid (false) places"
+// @Param max_price query number false "Free places and paid places whose entry fee is at most this amount"
+// @Param price_currency query string false "ISO 4217 currency of max_price (default INR)"
+// @Param wheelchair query bool false "Only places stated to be wheelchair accessible (true) or not (false)"
+// @Param pincode query string false "Only places whose address has this 6-digit pincode; spaces are ignored"
+// @Param geohash_prefix query string false "Only places in this geohash cell, e.g. tes3 for central Nashik"
+// @Param include_deleted query bool false "Include soft-deleted places (admins only; ignored otherwise)"
+// @Success 200 {object} dto.PlaceExtentResponse
 // @Failure 400 {object} ierr.ErrorResponse
 // @Failure 404 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
-// @Router /places/{id}/images [post]
-// @Security Authorization
-func (h *PlaceHandler) AddImage(c *gin.Context) {
-	placeID := c.Param("id")
-	if placeID == "" {
-		c.Error(ierr.NewError("place ID is required").
-			WithHint("Please provide a valid place ID").
+// @Router /places/extent [get]
+func (h *PlaceHandler) Extent(c *gin.Context) {
+	var filter types.PlaceFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Please check the query parameters").
 			Mark(ierr.ErrValidation))
 		return
 	}
 
-	var req dto.CreatePlaceImageRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+	if filter.TimeRangeFilter == nil {
+		filter.TimeRangeFilter = &types.TimeRangeFilter{}
+	}
+
+	if err := filter.Validate(); err != nil {
 		c.Error(ierr.WithError(err).
-			WithHint("Please check the request payload").
+			WithHint("Invalid filter parameters").
 			Mark(ierr.ErrValidation))
 		return
 	}
 
-	image, err := h.placeService.AddImage(c.Request.Context(), placeID, &req)
+	response, err := h.placeService.Extent(c.Request.Context(), &filter)
 	if err != nil {
 		c.Error(err)
 		return
 	}
-	c.JSON(http.StatusCreated, image)
+	c.JSON(http.StatusOK, response)
 }
 
-// @Summary Get place images
-// @Description Get all images for a place
+// @Summary Atom feed of updated places
+// @Description Get an Atom feed of the published places updated recently, newest update first, for feed readers. Each entry has the place title, short description as summary, a link to the place, its creation as published and last update as updated, its place type as category and a GeoRSS point. The number of entries and the window in days are configured by places.atom_feed_size and places.atom_feed_days.
 // @Tags Place
-// @Accept json
-// @Produce json
-// @Param id path string true "Place ID"
-// @Success 200 {array} dto.PlaceImageResponse
-// @Failure 404 {object} ierr.ErrorResponse
+// @Produce application/atom+xml
+// @Success 200 {string} string "Atom feed"
 // @Failure 500 {object} ierr.ErrorResponse
-// @Router /places/{id}/images [get]
-func (h *PlaceHandler) GetImages(c *gin.Context) {
-	placeID := c.Param("id")
-	if placeID == "" {
-		c.Error(ierr.NewError("place ID is required").
-			WithHint("Please provide a valid place ID").
-			Mark(ierr.ErrValidation))
+// @Router /places/feed.xml [get]
+func (h *PlaceHandler) AtomFeed(c *gin.Context) {
+	self := requestURL(c)
+	self.RawQuery = ""
+	apiBase := &url.URL{Scheme: self.Scheme, Host: self.Host}
+
+	feed, err := h.placeService.AtomFeed(c.Request.Context(), self.String(), apiBase.String())
+	if err != nil {
+		c.Error(err)
 		return
 	}
 
-	images, err := h.placeService.GetImages(c.Request.Context(), placeID)
+	body, err := xml.Marshal(feed)
 	if err != nil {
-		c.Error(err)
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to encode the feed").
+			Mark(ierr.ErrInternal))
 		return
 	}
-	c.JSON(http.StatusOK, images)
+	c.Data(http.StatusOK, dto.AtomContentType, append([]byte(xml.Header), body...))
 }
 
-// @Summary Update place image
-// @Description Update an existing place image
+// @Summary Place heatmap
+// @Description Get the density of the published places in a box as grid cells for a heatmap layer. Places snap to the nearest node of a grid of cellsize degrees; each non-empty cell is returned at its center with the number of places in it, or their summed view counts with weight=views. Grids spanning more than 10000 cells are refused.
 // @Tags Place
-// @Accept json
 // @Produce json
-// @Param image_id path string true "Image ID"
-// @Param request body dto.UpdatePlaceImageRequest true "Update place image request"
-// @Success 200 {object} dto.PlaceImageResponse
+// @Param bbox query string true "Box as min_lng,min_lat,max_lng,max_lat"
+// @Param cellsize query number true "Cell edge in degrees"
+// @Param weight query string false "What each cell sums: count (default) or views"
+// @Success 200 {object} dto.PlaceHeatmapResponse
 // @Failure 400 {object} ierr.ErrorResponse
-// @Failure 404 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
-// @Router /places/images/{image_id} [put]
-// @Security Authorization
-func (h *PlaceHandler) UpdateImage(c *gin.Context) {
-	imageID := c.Param("image_id")
-	if imageID == "" {
-		c.Error(ierr.NewError("image ID is required").
-			WithHint("Please provide a valid image ID").
+// @Router /places/heatmap [get]
+func (h *PlaceHandler) Heatmap(c *gin.Context) {
+	var req dto.PlaceHeatmapRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Please check the query parameters").
 			Mark(ierr.ErrValidation))
 		return
 	}
 
-	var req dto.UpdatePlaceImageRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.Error(ierr.WithError(err).
-			WithHint("Please check the request payload").
-			Mark(ierr.ErrValidation))
+	if err := req.Validate(); err != nil {
+		c.Error(err)
 		return
 	}
 
-	image, err := h.placeService.UpdateImage(c.Request.Context(), imageID, &req)
+	response, err := h.placeService.Heatmap(c.Request.Context(), &req)
 	if err != nil {
 		c.Error(err)
 		return
 	}
-	c.JSON(http.StatusOK, image)
+	c.JSON(http.StatusOK, response)
 }
 
-// @Summary Delete place image
-// @Description Delete a place image
+// @Summary Nearby places summary
+// @Description Count the published places within radius_km of a point by place type and by category, most common first, e.g. for a "what's around" panel. A place in several categories is counted in each but once in total. Place type labels follow Accept-Language (en, mr, hi).
 // @Tags Place
-// @Accept json
 // @Produce json
-// @Param image_id path string true "Image ID"
-// @Success 204
-// @Failure 404 {object} ierr.ErrorResponse
+// @Param latitude query number true "Latitude of the center"
+// @Param longitude query number true "Longitude of the center"
+// @Param radius_km query number false "Radius in kilometers (default 2, max 15)"
+// @Param Accept-Language header string false "Language of the place type labels: en, mr or hi (default en)"
+// @Success 200 {object} dto.NearbySummaryResponse
+// @Failure 400 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
-// @Router /places/images/{image_id} [delete]
-// @Security Authorization
-func (h *PlaceHandler) DeleteImage(c *gin.Context) {
-	imageID := c.Param("image_id")
-	if imageID == "" {
-		c.Error(ierr.NewError("image ID is required").
-			WithHint("Please provide a valid image ID").
+// @Router /places/nearby/summary [get]
+func (h *PlaceHandler) NearbySummary(c *gin.Context) {
+	var req dto.NearbySummaryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Please check the query parameters").
 			Mark(ierr.ErrValidation))
 		return
 	}
 
-	err := h.placeService.DeleteImage(c.Request.Context(), imageID)
+	if err := req.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response, err := h.placeService.NearbySummary(c.Request.Context(), &req)
 	if err != nil {
 		c.Error(err)
 		return
 	}
-	c.Status(http.StatusNoContent)
+	translate(c, response)
+	c.JSON(http.StatusOK, response)
 }
 
-// @Summary Get feed data
-// @Description Get feed data with multiple sections (trending, popular, latest, nearby)
+// @Summary Stream places
+// @Description Stream every place matching the filters as newline-delimited JSON, one place per line in id order. Accepts the list filters; limit, offset and sort are ignored. The places are read in batches, so the catalog is never held in memory. If the stream fails midway, the last line is an error object instead of a place.
 // @Tags Place
-// @Accept json
-// @Produce json
-// @Param request body dto.FeedRequest true "Feed request with sections"
-// @Success 200 {object} dto.FeedResponse
+// @Produce application/x-ndjson
+// @Param status query string false "Status"
+// @Param place_types query []string false "Filter by place types"
+// @Param categories query []string false "Filter by category slugs"
+// @Param latitude query number false "Latitude for geospatial filtering"
+// @Param longitude query number false "Longitude for geospatial filtering"
+// @Param radius_m query number false "Radius in meters for geospatial filtering"
+// @Param free query bool false "Only free (true) or paid (false) places"
+// @Param max_price query number false "Free places and paid places whose entry fee is at most this amount"
+// @Param price_currency query string false "ISO 4217 currency of max_price (default INR)"
+// @Param wheelchair query bool false "Only places stated to be wheelchair accessible (true) or not (false)"
+// @Param pincode query string false "Only places whose address has this 6-digit pincode; spaces are ignored"
+// @Param geohash_prefix query string false "Only places in this geohash cell, e.g. tes3 for central Nashik"
+// @Param expand query string false "Set to images to include each place's images"
+// @Param include_deleted query bool false "Include soft-deleted places (admins only; ignored otherwise)"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
+// @Param Accept-Language header string false "Language of place_type_label and status_label: en, mr or hi (default en)"
+// @Success 200 {object} dto.PlaceResponse "One place per line"
 // @Failure 400 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
-// @Router /feed [post]
-func (h *PlaceHandler) GetFeed(c *gin.Context) {
-	var req dto.FeedRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+// @Router /places/stream [get]
+func (h *PlaceHandler) Stream(c *gin.Context) {
+	var filter types.PlaceFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
 		c.Error(ierr.WithError(err).
-			WithHint("Please check the request payload").
+			WithHint("Please check the query parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+	if filter.TimeRangeFilter == nil {
+		filter.TimeRangeFilter = &types.TimeRangeFilter{}
+	}
+
+	if err := filter.Validate(); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid filter parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var loc *time.Location
+	if tz := c.Query("tz"); tz != "" {
+		var err error
+		if loc, err = types.ParseDisplayTimezone(tz); err != nil {
+			c.Error(err)
+			return
+		}
+	}
+
+	lang := languageOf(c)
+
+	ctx := c.Request.Context()
+	w := newNDJSONWriter(c)
+	err := h.placeService.Stream(ctx, &filter, func(place *dto.PlaceResponse) error {
+		maskPII(c, place)
+		place.Translate(lang)
+		if loc != nil {
+			place.Localize(loc)
+		}
+		return w.Write(place)
+	})
+	if err != nil && ctx.Err() == nil {
+		w.Fail(err)
+		return
+	}
+	w.Flush()
+}
+
+// @Summary List places in category
+// @Description Get a paginated list of the published places in a category, with the category itself. Accepts the same filters, sort and pagination as the place list.
+// @Tags Category
+// @Accept json
+// @Produce json
+// @Param slug path string true "Category slug"
+// @Param limit query int false "Limit"
+// @Param offset query int false "Offset"
+// @Param page query int false "Page number from 1; use with per_page instead of limit and offset"
+// @Param per_page query int false "Page size when paging by number; capped at 1000"
+// @Param sort query string false "Sort field, or a comma list of field:direction applied in order (e.g. title:asc,created_at:desc); id is always the final tiebreaker"
+// @Param order query string false "Sort order (asc/desc)"
+// @Param collation query string false "Language rules for sorting by title: und, mr, hi, en or binary (default from places.title_collation)"
+// @Param place_types query []string false "Filter by place types"
+// @Param latitude query number false "Latitude for geospatial filtering"
+// @Param longitude query number false "Longitude for geospatial filtering"
+// @Param radius_m query number false "Radius in meters for geospatial filtering"
+// @Param free query bool false "Only free (true) or paid (false) places"
+// @Param max_price query number false "Free places and paid places whose entry fee is at most this amount"
+// @Param wheelchair query bool false "Only places stated to be wheelchair accessible (true) or not (false)"
+// @Param pincode query string false "Only places whose address has this 6-digit pincode; spaces are ignored"
+// @Param geohash_prefix query string false "Only places in this geohash cell, e.g. tes3 for central Nashik"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
+// @Param Accept-Language header string false "Language of place_type_label and status_label: en, mr or hi (default en)"
+// @Param Accept header string false "Use application/json; profile=\"data-meta\" for a {category, data, meta, links} envelope"
+// @Param include query string false "Set to center_distance to add distance_from_center_km, the distance of each place from the city center"
+// @Param geohash_precision query int false "Length (1-12) of the geohash of each place; defaults to places.geohash_precision"
+// @Success 200 {object} dto.CategoryPlacesResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /categories/{slug}/places [get]
+func (h *PlaceHandler) ListByCategory(c *gin.Context) {
+	// The route shares the :id wildcard of /categories/:id, but the segment is a slug
+	slug := c.Param("id")
+	if slug == "" {
+		c.Error(ierr.NewError("category slug is required").
+			WithHint("Please provide a valid category slug").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var filter types.PlaceFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Please check the query parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewDefaultQueryFilter()
+	}
+	if filter.TimeRangeFilter == nil {
+		filter.TimeRangeFilter = &types.TimeRangeFilter{}
+	}
+
+	if err := filter.Validate(); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid filter parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.placeService.ListByCategory(c.Request.Context(), slug, &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	response.SetLinks(requestURL(c))
+	maskPII(c, response)
+	translate(c, response)
+	if err := localize(c, response); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := include(c, response); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := geohash(c, response); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, envelope(c, response))
+}
+
+// @Summary Add image to place
+// @Description Add an image to a place
+// @Tags Place
+// @Accept json
+// @Produce json
+// @Param id path string true "Place ID"
+// @Param request body dto.CreatePlaceImageRequest true "Create place image request"
+// @Success 201 {object} dto.PlaceImageResponse
+// @Header 201 {string} Location "URL of the created image"
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/{id}/images [post]
+// @Security Authorization
+func (h *PlaceHandler) AddImage(c *gin.Context) {
+	placeID := c.Param("id")
+	if placeID == "" {
+		c.Error(ierr.NewError("place ID is required").
+			WithHint("Please provide a valid place ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.CreatePlaceImageRequest
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	image, err := h.placeService.AddImage(c.Request.Context(), placeID, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	created(c, "/places/images/"+image.ID, image)
+}
+
+// @Summary Get place images
+// @Description Get all images for a place
+// @Tags Place
+// @Accept json
+// @Produce json
+// @Param id path string true "Place ID"
+// @Success 200 {array} dto.PlaceImageResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/{id}/images [get]
+func (h *PlaceHandler) GetImages(c *gin.Context) {
+	placeID := c.Param("id")
+	if placeID == "" {
+		c.Error(ierr.NewError("place ID is required").
+			WithHint("Please provide a valid place ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	images, err := h.placeService.GetImages(c.Request.Context(), placeID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, images)
+}
+
+// @Summary Get place image
+// @Description Get a single place image by its ID
+// @Tags Place
+// @Accept json
+// @Produce json
+// @Param image_id path string true "Image ID"
+// @Success 200 {object} dto.PlaceImageResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/images/{image_id} [get]
+func (h *PlaceHandler) GetImage(c *gin.Context) {
+	imageID := c.Param("image_id")
+	if imageID == "" {
+		c.Error(ierr.NewError("image ID is required").
+			WithHint("Please provide a valid image ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	image, err := h.placeService.GetImage(c.Request.Context(), imageID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, image)
+}
+
+// @Summary Update place image
+// @Description Update an existing place image
+// @Tags Place
+// @Accept json
+// @Produce json
+// @Param image_id path string true "Image ID"
+// @Param request body dto.UpdatePlaceImageRequest true "Update place image request"
+// @Success 200 {object} dto.PlaceImageResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/images/{image_id} [put]
+// @Security Authorization
+func (h *PlaceHandler) UpdateImage(c *gin.Context) {
+	imageID := c.Param("image_id")
+	if imageID == "" {
+		c.Error(ierr.NewError("image ID is required").
+			WithHint("Please provide a valid image ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdatePlaceImageRequest
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	image, err := h.placeService.UpdateImage(c.Request.Context(), imageID, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, image)
+}
+
+// @Summary Delete place image
+// @Description Delete a place image. Deleting the image primary_image_url points at repoints it to the next image by position, clears it, or is refused with 409, as places.primary_image_delete says.
+// @Tags Place
+// @Accept json
+// @Produce json
+// @Param image_id path string true "Image ID"
+// @Param force query bool false "Delete the primary image even when places.primary_image_delete is reject; primary_image_url then moves to the next image"
+// @Success 204
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 409 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/images/{image_id} [delete]
+// @Security Authorization
+func (h *PlaceHandler) DeleteImage(c *gin.Context) {
+	imageID := c.Param("image_id")
+	if imageID == "" {
+		c.Error(ierr.NewError("image ID is required").
+			WithHint("Please provide a valid image ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	err := h.placeService.DeleteImage(c.Request.Context(), imageID, c.Query("force") == "true")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary List accessibility attributes
+// @Description The vocabulary of accessibility attributes a place can state, and whether custom attributes are accepted
+// @Tags Place
+// @Produce json
+// @Success 200 {object} dto.AccessibilityAttributesResponse
+// @Router /places/accessibility-attributes [get]
+func (h *PlaceHandler) ListAccessibilityAttributes(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.NewAccessibilityAttributesResponse())
+}
+
+// @Summary Nearby places for many origins
+// @Description Get the nearest published places around each of up to 20 origins in one call, nearest first with their distance. Results are grouped by origin in request order.
+// @Tags Place
+// @Accept json
+// @Produce json
+// @Param request body dto.NearbyBatchRequest true "Origins as GeoJSON points, radius and places per origin (default 10, max 50)"
+// @Success 200 {object} dto.NearbyBatchResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/nearby/batch [post]
+func (h *PlaceHandler) NearbyBatch(c *gin.Context) {
+	var req dto.NearbyBatchRequest
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response, err := h.placeService.NearbyBatch(c.Request.Context(), req.Origins, req.RadiusKm, req.LimitPer)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	maskPII(c, response)
+	translate(c, response)
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Check place geofences
+// @Description Get the published places whose circular geofence (geofence_radius_m around the place) contains the given coordinate, nearest first with their distance. Places without a geofence never match.
+// @Tags Place
+// @Accept json
+// @Produce json
+// @Param request body dto.GeofenceCheckRequest true "Coordinate to check as a GeoJSON point"
+// @Success 200 {object} dto.GeofenceCheckResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/nearby-check [post]
+func (h *PlaceHandler) CheckGeofences(c *gin.Context) {
+	var req dto.GeofenceCheckRequest
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response, err := h.placeService.CheckGeofences(c.Request.Context(), req.Location)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	maskPII(c, response)
+	translate(c, response)
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Centroid of places
+// @Description Get the center of a group of places, computed on the sphere rather than by averaging latitudes and longitudes. Every place must exist and not be deleted; otherwise 404 lists the missing IDs.
+// @Tags Place
+// @Accept json
+// @Produce json
+// @Param request body dto.PlaceCentroidRequest true "Places to center on (1 to 100 IDs)"
+// @Success 200 {object} dto.PlaceCentroidResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/centroid [post]
+func (h *PlaceHandler) Centroid(c *gin.Context) {
+	var req dto.PlaceCentroidRequest
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response, err := h.placeService.Centroid(c.Request.Context(), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Check which places exist
+// @Description Report for each of up to 200 place IDs or slugs whether the place still exists, with a single lookup. Deleted places report exists=false and deleted=true; unknown identifiers report both false.
+// @Tags Place
+// @Accept json
+// @Produce json
+// @Param request body dto.PlaceExistsRequest true "Place IDs or slugs (1 to 200)"
+// @Success 200 {object} dto.PlaceExistsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/exists [post]
+func (h *PlaceHandler) Exists(c *gin.Context) {
+	var req dto.PlaceExistsRequest
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response, err := h.placeService.Exists(c.Request.Context(), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Current weather at a place
+// @Description Get a place with the current weather at its location. Nearby places share a cached observation. When weather is disabled or the provider is unavailable, the place is returned with weather set to null.
+// @Tags Place
+// @Produce json
+// @Param id path string true "Place ID"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
+// @Param Accept-Language header string false "Language of place_type_label and status_label: en, mr or hi (default en)"
+// @Success 200 {object} dto.PlaceWeatherResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/{id}/weather [get]
+func (h *PlaceHandler) GetWeather(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("place ID is required").
+			WithHint("Please provide a valid place ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.placeService.GetWeather(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	maskPII(c, response)
+	translate(c, response)
+	if err := localize(c, response); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Autocomplete places
+// @Description Typeahead suggestions for places whose title starts with the query (case-insensitive)
+// @Tags Place
+// @Produce json
+// @Param q query string true "Title prefix"
+// @Param limit query int false "Maximum suggestions (default 10, max 25)"
+// @Success 200 {object} dto.AutocompleteResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/autocomplete [get]
+func (h *PlaceHandler) Autocomplete(c *gin.Context) {
+	var req dto.AutocompleteRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Please check the query parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	response, err := h.placeService.Autocomplete(c.Request.Context(), req.Q, req.Limit)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Search places
+// @Description Full-text search over place titles, subtitles and descriptions, ranked by relevance. Title matches outrank subtitle matches, which outrank description matches. When nothing matches, did_you_mean carries the closest known place or category spelling, if any.
+// @Tags Place
+// @Produce json
+// @Param q query string true "Search query (supports quoted phrases, or, and -exclusions)"
+// @Param limit query int false "Limit"
+// @Param offset query int false "Offset"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
+// @Param Accept-Language header string false "Language of place_type_label and status_label: en, mr or hi (default en)"
+// @Param include query string false "Set to center_distance to add distance_from_center_km, the distance of each place from the city center"
+// @Param geohash_precision query int false "Length (1-12) of the geohash of each place; defaults to places.geohash_precision"
+// @Success 200 {object} dto.SearchPlacesResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/search [get]
+func (h *PlaceHandler) Search(c *gin.Context) {
+	var req dto.SearchPlacesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Please check the query parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.placeService.Search(c.Request.Context(), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	response.SetLinks(requestURL(c))
+	maskPII(c, response)
+	translate(c, response)
+	if err := localize(c, response); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := include(c, response); err != nil {
+		c.Error(err)
+		return
+	}
+	if err := geohash(c, response); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Clone place
+// @Description Duplicate a place as a template under a new slug. Content and categories are copied, images optionally; views, ratings and reviews are not. Only admins may set status, publish_at and unpublish_at.
+// @Tags Place
+// @Accept json
+// @Produce json
+// @Param id path string true "Source place ID"
+// @Param request body dto.ClonePlaceRequest true "Overrides for the clone (slug required)"
+// @Success 201 {object} dto.PlaceResponse
+// @Header 201 {string} Location "URL of the clone"
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 409 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/{id}/clone [post]
+// @Security Authorization
+func (h *PlaceHandler) Clone(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("place ID is required").
+			WithHint("Please provide a valid place ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.ClonePlaceRequest
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	place, err := h.placeService.Clone(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	maskPII(c, place)
+	created(c, "/places/"+place.ID, place)
+}
+
+// @Summary Delete place images
+// @Description Delete several images of a place in one transaction, or every image with all=true. Remaining images are renumbered without gaps. Deleting the image primary_image_url points at follows places.primary_image_delete, as for a single image.
+// @Tags Place
+// @Accept json
+// @Produce json
+// @Param id path string true "Place ID"
+// @Param all query bool false "Delete every image of the place"
+// @Param force query bool false "Delete the primary image even when places.primary_image_delete is reject"
+// @Param request body dto.DeletePlaceImagesRequest false "Image IDs to delete (required unless all=true)"
+// @Success 204
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 409 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/{id}/images [delete]
+// @Security Authorization
+func (h *PlaceHandler) DeleteImages(c *gin.Context) {
+	placeID := c.Param("id")
+	if placeID == "" {
+		c.Error(ierr.NewError("place ID is required").
+			WithHint("Please provide a valid place ID").
 			Mark(ierr.ErrValidation))
 		return
 	}
 
+	force := c.Query("force") == "true"
+	if c.Query("all") == "true" {
+		if err := h.placeService.DeleteAllImages(c.Request.Context(), placeID, force); err != nil {
+			c.Error(err)
+			return
+		}
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	var req dto.DeletePlaceImagesRequest
+	if err := bindJSONWithHint(c, &req, "Please provide image_ids or set all=true"); err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := h.placeService.DeleteImages(c.Request.Context(), placeID, req.ImageIDs, force); err != nil {
+		c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Get feed data
+// @Description Get feed data with multiple sections (trending, popular, latest, nearby)
+// @Tags Place
+// @Accept json
+// @Produce json
+// @Param request body dto.FeedRequest true "Feed request with sections"
+// @Success 200 {object} dto.FeedResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /feed [post]
+func (h *PlaceHandler) GetFeed(c *gin.Context) {
+	var req dto.FeedRequest
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
 	response, err := h.placeService.GetFeed(c.Request.Context(), &req)
 	if err != nil {
 		c.Error(err)
 		return
 	}
+	maskPII(c, response)
+	translate(c, response)
 	c.JSON(http.StatusOK, response)
 }
 
@@ -433,10 +1387,8 @@ func (h *PlaceHandler) AssignCategories(c *gin.Context) {
 	}
 
 	var req dto.AssignCategoriesRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.Error(ierr.WithError(err).
-			WithHint("Please check the request payload").
-			Mark(ierr.ErrValidation))
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -447,3 +1399,172 @@ func (h *PlaceHandler) AssignCategories(c *gin.Context) {
 	}
 	c.Status(http.StatusNoContent)
 }
+
+// @Summary List place revisions
+// @Description Get the change history of a place, newest revision first
+// @Tags Place
+// @Accept json
+// @Produce json
+// @Param id path string true "Place ID"
+// @Param limit query int false "Limit"
+// @Param offset query int false "Offset"
+// @Param page query int false "Page number from 1; use with per_page instead of limit and offset"
+// @Param per_page query int false "Page size when paging by number; capped at 1000"
+// @Success 200 {object} dto.ListPlaceRevisionsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/{id}/revisions [get]
+// @Security Authorization
+func (h *PlaceHandler) ListRevisions(c *gin.Context) {
+	placeID := c.Param("id")
+	if placeID == "" {
+		c.Error(ierr.NewError("place ID is required").
+			WithHint("Please provide a valid place ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var query types.QueryFilter
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Please check the query parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+	// Checked before the defaults fill in, which would hide page and offset given together
+	if err := query.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+	filter := types.NewDefaultQueryFilter()
+	filter.Merge(query)
+
+	response, err := h.placeService.ListRevisions(c.Request.Context(), placeID, filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	response.SetLinks(requestURL(c))
+	maskPII(c, response)
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Get place revision
+// @Description Get a single revision of a place with its snapshot
+// @Tags Place
+// @Accept json
+// @Produce json
+// @Param id path string true "Place ID"
+// @Param rev path int true "Revision number"
+// @Success 200 {object} dto.PlaceRevisionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/{id}/revisions/{rev} [get]
+// @Security Authorization
+func (h *PlaceHandler) GetRevision(c *gin.Context) {
+	placeID, revision, ok := parseRevisionParams(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.placeService.GetRevision(c.Request.Context(), placeID, revision)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	maskPII(c, response)
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Revert place to revision
+// @Description Restore the content of a place from an earlier revision. The restored state is recorded as a new revision. Admins may revert an archived (deleted) place to a revision from before the delete to restore it. Only admins may revert a status or publish window that differs from the current one.
+// @Tags Place
+// @Accept json
+// @Produce json
+// @Param id path string true "Place ID"
+// @Param rev path int true "Revision number"
+// @Success 200 {object} dto.PlaceRevisionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/{id}/revisions/{rev}/revert [post]
+// @Security Authorization
+func (h *PlaceHandler) RevertToRevision(c *gin.Context) {
+	placeID, revision, ok := parseRevisionParams(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.placeService.RevertToRevision(c.Request.Context(), placeID, revision)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	maskPII(c, response)
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Diff place revisions
+// @Description Get the field-level differences between two revisions of a place
+// @Tags Place
+// @Accept json
+// @Produce json
+// @Param id path string true "Place ID"
+// @Param from query int true "Revision to compare from"
+// @Param to query int true "Revision to compare to"
+// @Success 200 {object} dto.PlaceRevisionDiffResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /places/{id}/revisions/diff [get]
+// @Security Authorization
+func (h *PlaceHandler) DiffRevisions(c *gin.Context) {
+	placeID := c.Param("id")
+	if placeID == "" {
+		c.Error(ierr.NewError("place ID is required").
+			WithHint("Please provide a valid place ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.PlaceRevisionDiffRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Please provide the from and to revision numbers").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.placeService.DiffRevisions(c.Request.Context(), placeID, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	maskPII(c, response)
+	c.JSON(http.StatusOK, response)
+}
+
+// parseRevisionParams reads the place ID and revision number path parameters,
+// recording a validation error on the context when either is invalid
+func parseRevisionParams(c *gin.Context) (string, int, bool) {
+	placeID := c.Param("id")
+	if placeID == "" {
+		c.Error(ierr.NewError("place ID is required").
+			WithHint("Please provide a valid place ID").
+			Mark(ierr.ErrValidation))
+		return "", 0, false
+	}
+
+	revision, err := strconv.Atoi(c.Param("rev"))
+	if err != nil || revision < 1 {
+		c.Error(ierr.NewError("invalid revision number").
+			WithHint("Revision must be a positive integer").
+			Mark(ierr.ErrValidation))
+		return "", 0, false
+	}
+
+	return placeID, revision, true
+}
@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// render adds HTML renderings of the Markdown fields of resp when the client asks for them
+// via render=html. The stored Markdown is always returned as is.
+func render(c *gin.Context, resp types.HTMLRenderer) error {
+	format := c.Query("render")
+	if err := types.ValidateRender(format); err != nil {
+		return err
+	}
+	if format == types.RenderHTML {
+		resp.RenderHTML()
+	}
+	return nil
+}
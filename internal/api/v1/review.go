@@ -26,16 +26,15 @@ func NewReviewHandler(reviewService service.ReviewService) *ReviewHandler {
 // @Produce json
 // @Param request body dto.CreateReviewRequest true "Create review request"
 // @Success 201 {object} dto.ReviewResponse
+// @Header 201 {string} Location "URL of the created review"
 // @Failure 400 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
 // @Router /reviews [post]
 // @Security Authorization
 func (h *ReviewHandler) CreateReview(c *gin.Context) {
 	var req dto.CreateReviewRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.Error(ierr.WithError(err).
-			WithHint("Please check the request payload").
-			Mark(ierr.ErrValidation))
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -44,7 +43,7 @@ func (h *ReviewHandler) CreateReview(c *gin.Context) {
 		c.Error(err)
 		return
 	}
-	c.JSON(http.StatusCreated, review)
+	created(c, "/reviews/"+review.ID, review)
 }
 
 // @Summary Get review by ID
@@ -53,6 +52,7 @@ func (h *ReviewHandler) CreateReview(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Review ID"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
 // @Success 200 {object} dto.ReviewResponse
 // @Failure 404 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
@@ -71,6 +71,10 @@ func (h *ReviewHandler) GetReview(c *gin.Context) {
 		c.Error(err)
 		return
 	}
+	if err := localize(c, review); err != nil {
+		c.Error(err)
+		return
+	}
 	c.JSON(http.StatusOK, review)
 }
 
@@ -97,10 +101,8 @@ func (h *ReviewHandler) UpdateReview(c *gin.Context) {
 	}
 
 	var req dto.UpdateReviewRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.Error(ierr.WithError(err).
-			WithHint("Please check the request payload").
-			Mark(ierr.ErrValidation))
+	if err := bindJSON(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -147,17 +149,22 @@ func (h *ReviewHandler) DeleteReview(c *gin.Context) {
 // @Produce json
 // @Param limit query int false "Limit"
 // @Param offset query int false "Offset"
+// @Param page query int false "Page number from 1; use with per_page instead of limit and offset"
+// @Param per_page query int false "Page size when paging by number; capped at 1000"
 // @Param entity_type query string false "Entity type (place, experience, etc.)"
 // @Param entity_id query string false "Entity ID"
 // @Param user_id query string false "User ID"
 // @Param min_rating query number false "Minimum rating"
 // @Param max_rating query number false "Maximum rating"
+// @Param tz query string false "Timezone for presented timestamps (UTC or Asia/Kolkata)"
 // @Success 200 {object} types.ListResponse[dto.ReviewResponse]
 // @Failure 400 {object} ierr.ErrorResponse
 // @Failure 500 {object} ierr.ErrorResponse
 // @Router /reviews [get]
 func (h *ReviewHandler) ListReviews(c *gin.Context) {
 	filter := types.NewReviewFilter()
+	query := filter.QueryFilter
+	filter.QueryFilter = &types.QueryFilter{}
 
 	// Bind query parameters to filter
 	if err := c.ShouldBindQuery(filter); err != nil {
@@ -166,12 +173,24 @@ func (h *ReviewHandler) ListReviews(c *gin.Context) {
 			Mark(ierr.ErrValidation))
 		return
 	}
+	// Checked before the defaults fill in, which would hide page and offset given together
+	if err := filter.QueryFilter.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+	query.Merge(*filter.QueryFilter)
+	filter.QueryFilter = query
 
 	reviews, err := h.reviewService.ListReviews(c.Request.Context(), filter)
 	if err != nil {
 		c.Error(err)
 		return
 	}
+	reviews.SetLinks(requestURL(c))
+	if err := localize(c, &reviews); err != nil {
+		c.Error(err)
+		return
+	}
 	c.JSON(http.StatusOK, reviews)
 }
 
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// localize presents the timestamps of resp in the zone requested via the tz query parameter.
+// Timestamps are already UTC, so nothing is converted when tz is absent.
+func localize(c *gin.Context, resp types.Localizer) error {
+	tz := c.Query("tz")
+	if tz == "" {
+		return nil
+	}
+
+	loc, err := types.ParseDisplayTimezone(tz)
+	if err != nil {
+		return err
+	}
+	resp.Localize(loc)
+	return nil
+}
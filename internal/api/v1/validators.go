@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// setValidators sets the ETag and Last-Modified headers of a single-resource response. The
+// ETag hashes the representation as sent, so it changes with tz and render as well as with the
+// resource; Last-Modified is the resource's update time.
+func setValidators(c *gin.Context, lastModified time.Time, resp any) error {
+	body, err := json.Marshal(resp)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to encode the response").
+			Mark(ierr.ErrInternal)
+	}
+	sum := sha256.Sum256(body)
+
+	c.Header("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+	if !lastModified.IsZero() {
+		c.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+	return nil
+}
+
+// unmodifiedSince returns the If-Unmodified-Since precondition of the request. As RFC 9110
+// requires, a value that is not a valid HTTP-date is ignored.
+func unmodifiedSince(c *gin.Context) *time.Time {
+	value := c.GetHeader("If-Unmodified-Since")
+	if value == "" {
+		return nil
+	}
+	since, err := http.ParseTime(value)
+	if err != nil {
+		return nil
+	}
+	return &since
+}
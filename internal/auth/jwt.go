@@ -0,0 +1,167 @@
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/config"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+)
+
+// Reasons reported when a token fails registered claim validation
+const (
+	TokenReasonMalformed       = "token_malformed"
+	TokenReasonExpired         = "token_expired"
+	TokenReasonNotYetValid     = "token_not_yet_valid"
+	TokenReasonInvalidIssuer   = "token_invalid_issuer"
+	TokenReasonInvalidAudience = "token_invalid_audience"
+	TokenReasonMissingClaim    = "token_missing_claim"
+)
+
+// RegisteredClaims holds the registered JWT claims that are validated locally,
+// along with the optional scope claims used for authorization
+type RegisteredClaims struct {
+	Issuer    string       `json:"iss"`
+	Audience  stringList   `json:"aud"`
+	ExpiresAt *numericDate `json:"exp"`
+	NotBefore *numericDate `json:"nbf"`
+
+	// Scope is the space separated OAuth2 form, Scopes the array form
+	Scope  string     `json:"scope"`
+	Scopes stringList `json:"scopes"`
+}
+
+// GrantedScopes returns the known scopes carried by the token
+func (c *RegisteredClaims) GrantedScopes() []string {
+	scopes := append(strings.Fields(c.Scope), c.Scopes...)
+	return lo.Uniq(lo.Filter(scopes, func(scope string, _ int) bool {
+		return types.Scope(scope).Validate() == nil
+	}))
+}
+
+// stringList accepts both the single string and the array form of a claim
+type stringList []string
+
+func (a *stringList) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = stringList{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*a = multiple
+	return nil
+}
+
+// numericDate is a JWT NumericDate (seconds since epoch, possibly fractional)
+type numericDate struct {
+	time.Time
+}
+
+func (d *numericDate) UnmarshalJSON(data []byte) error {
+	var seconds float64
+	if err := json.Unmarshal(data, &seconds); err != nil {
+		return err
+	}
+	d.Time = time.Unix(0, int64(seconds*float64(time.Second))).UTC()
+	return nil
+}
+
+// ClaimsValidator validates the iss, aud, exp and nbf claims of a JWT.
+// It does not verify the signature; that is left to the auth provider.
+type ClaimsValidator struct {
+	issuer   string
+	audience string
+	skew     time.Duration
+	now      func() time.Time
+}
+
+// NewClaimsValidator creates a validator using the expected values from config
+func NewClaimsValidator(cfg *config.Configuration) *ClaimsValidator {
+	return &ClaimsValidator{
+		issuer:   cfg.Supabase.GetJWTIssuer(),
+		audience: cfg.Supabase.GetJWTAudience(),
+		skew:     cfg.Supabase.GetJWTClockSkew(),
+		now:      time.Now,
+	}
+}
+
+// Validate parses the token payload and checks its registered claims
+func (v *ClaimsValidator) Validate(token string) (*RegisteredClaims, error) {
+	claims, err := ParseRegisteredClaims(token)
+	if err != nil {
+		return nil, err
+	}
+
+	now := v.now()
+
+	if claims.ExpiresAt == nil {
+		return nil, unauthenticated(TokenReasonMissingClaim, "Token is missing the exp claim", map[string]any{"claim": "exp"})
+	}
+	if !now.Before(claims.ExpiresAt.Add(v.skew)) {
+		return nil, unauthenticated(TokenReasonExpired, "Token has expired", map[string]any{
+			"expired_at": claims.ExpiresAt.Time,
+		})
+	}
+
+	if claims.NotBefore != nil && now.Add(v.skew).Before(claims.NotBefore.Time) {
+		return nil, unauthenticated(TokenReasonNotYetValid, "Token is not valid yet", map[string]any{
+			"not_before": claims.NotBefore.Time,
+		})
+	}
+
+	if claims.Issuer != v.issuer {
+		return nil, unauthenticated(TokenReasonInvalidIssuer, "Token was issued by an unexpected issuer", map[string]any{
+			"issuer": claims.Issuer,
+		})
+	}
+
+	if !lo.Contains(claims.Audience, v.audience) {
+		return nil, unauthenticated(TokenReasonInvalidAudience, "Token is not intended for this audience", map[string]any{
+			"audience": []string(claims.Audience),
+		})
+	}
+
+	return claims, nil
+}
+
+// ParseRegisteredClaims decodes the payload segment of a compact JWT
+func ParseRegisteredClaims(token string) (*RegisteredClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, unauthenticated(TokenReasonMalformed, "Token is malformed", nil)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, unauthenticated(TokenReasonMalformed, "Token is malformed", nil)
+	}
+
+	var claims RegisteredClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, unauthenticated(TokenReasonMalformed, "Token is malformed", nil)
+	}
+
+	return &claims, nil
+}
+
+// unauthenticated builds an ErrUnauthenticated error carrying the rejection reason
+func unauthenticated(reason string, hint string, details map[string]any) error {
+	if details == nil {
+		details = map[string]any{}
+	}
+	details["reason"] = reason
+
+	return ierr.NewError(reason).
+		WithHint(hint).
+		WithReportableDetails(details).
+		Mark(ierr.ErrUnauthenticated)
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// testToken builds an unsigned compact JWT carrying the given claims
+func testToken(t *testing.T, claims map[string]any) string {
+	t.Helper()
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatalf("marshal claims: %v", err)
+	}
+	return "e30." + base64.RawURLEncoding.EncodeToString(payload) + ".sig"
+}
+
+func TestClaimsValidatorValidate(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	validator := &ClaimsValidator{
+		issuer:   "https://auth.example.com",
+		audience: "authenticated",
+		skew:     30 * time.Second,
+		now:      func() time.Time { return now },
+	}
+
+	valid := func(overrides map[string]any) map[string]any {
+		claims := map[string]any{
+			"iss": "https://auth.example.com",
+			"aud": "authenticated",
+			"exp": now.Add(time.Hour).Unix(),
+			"nbf": now.Add(-time.Hour).Unix(),
+		}
+		for k, v := range overrides {
+			if v == nil {
+				delete(claims, k)
+				continue
+			}
+			claims[k] = v
+		}
+		return claims
+	}
+
+	tests := []struct {
+		name       string
+		token      string
+		wantReason string
+	}{
+		{name: "valid", token: testToken(t, valid(nil))},
+		{name: "audience array", token: testToken(t, valid(map[string]any{"aud": []string{"other", "authenticated"}}))},
+		{name: "expired within skew", token: testToken(t, valid(map[string]any{"exp": now.Add(-10 * time.Second).Unix()}))},
+		{name: "not before within skew", token: testToken(t, valid(map[string]any{"nbf": now.Add(10 * time.Second).Unix()}))},
+		{name: "malformed", token: "not-a-jwt", wantReason: TokenReasonMalformed},
+		{name: "undecodable payload", token: "e30.!!!.sig", wantReason: TokenReasonMalformed},
+		{name: "missing exp", token: testToken(t, valid(map[string]any{"exp": nil})), wantReason: TokenReasonMissingClaim},
+		{name: "expired", token: testToken(t, valid(map[string]any{"exp": now.Add(-time.Minute).Unix()})), wantReason: TokenReasonExpired},
+		{name: "not yet valid", token: testToken(t, valid(map[string]any{"nbf": now.Add(time.Minute).Unix()})), wantReason: TokenReasonNotYetValid},
+		{name: "wrong issuer", token: testToken(t, valid(map[string]any{"iss": "https://evil.example.com"})), wantReason: TokenReasonInvalidIssuer},
+		{name: "wrong audience", token: testToken(t, valid(map[string]any{"aud": "anon"})), wantReason: TokenReasonInvalidAudience},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := validator.Validate(tt.token)
+			if tt.wantReason == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				if claims == nil {
+					t.Fatal("Validate() returned nil claims")
+				}
+				return
+			}
+
+			if !ierr.IsUnauthenticated(err) {
+				t.Fatalf("Validate() error = %v, want unauthenticated", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantReason) {
+				t.Errorf("Validate() error = %v, want reason %s", err, tt.wantReason)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 type SupabaseProvider struct {
 	supabase *supabase.Client
+	claims   *ClaimsValidator
 	logger   *logger.Logger
 }
 
@@ -28,6 +29,7 @@ func NewSupabaseProvider(cfg *config.Configuration, logger *logger.Logger) Provi
 
 	return &SupabaseProvider{
 		supabase: client,
+		claims:   NewClaimsValidator(cfg),
 		logger:   logger,
 	}
 }
@@ -44,17 +46,27 @@ func (p *SupabaseProvider) ValidateToken(ctx context.Context, token string) (*au
 			Mark(ierr.ErrValidation)
 	}
 
+	// Reject tokens with unexpected registered claims before calling Supabase
+	registered, err := p.claims.Validate(token)
+	if err != nil {
+		return nil, err
+	}
+
 	supabaseUser, err := p.supabase.Auth.User(ctx, token)
 	if err != nil {
 		p.logger.Error("Failed to validate token", "error", err)
 		return nil, ierr.NewErrorf("invalid or expired token: %w", err).
 			WithHint("Please provide a valid access token").
-			Mark(ierr.ErrValidation)
+			WithReportableDetails(map[string]any{
+				"reason": "token_rejected",
+			}).
+			Mark(ierr.ErrUnauthenticated)
 	}
 
 	claims := &auth.Claims{
 		UserID: supabaseUser.ID,
 		Email:  supabaseUser.Email,
+		Scopes: registered.GrantedScopes(),
 	}
 
 	p.logger.Debug("Token validated", "user_id", claims.UserID, "email", claims.Email)
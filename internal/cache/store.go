@@ -0,0 +1,175 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// Store is a process-local key/value cache with a fixed TTL and a cap on the number of entries.
+// Callers report their lookups through RecordLookup so hits and misses are counted per namespace.
+//
+// Entries are not shared between instances, so a write on one instance only invalidates its
+// own copy; other instances serve the old value until it expires.
+type Store struct {
+	enabled    bool
+	ttl        time.Duration
+	maxEntries int
+
+	mu       sync.Mutex
+	entries  map[string]entry
+	counters map[string]*counter
+}
+
+type entry struct {
+	value     any
+	expiresAt time.Time
+}
+
+type counter struct {
+	hits   uint64
+	misses uint64
+}
+
+// NamespaceStats reports the lookups of one namespace since startup
+type NamespaceStats struct {
+	Hits     uint64  `json:"hits"`
+	Misses   uint64  `json:"misses"`
+	HitRatio float64 `json:"hit_ratio"`
+}
+
+// Stats is a snapshot of the cache
+type Stats struct {
+	Enabled    bool                      `json:"enabled"`
+	Entries    int                       `json:"entries"`
+	MaxEntries int                       `json:"max_entries"`
+	TTLSeconds int                       `json:"ttl_seconds"`
+	Namespaces map[string]NamespaceStats `json:"namespaces"`
+}
+
+// NewStore creates a store. A disabled store holds nothing and every Get misses.
+func NewStore(enabled bool, ttl time.Duration, maxEntries int) *Store {
+	return &Store{
+		enabled:    enabled,
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[string]entry),
+		counters:   make(map[string]*counter),
+	}
+}
+
+// Enabled reports whether the store caches anything
+func (s *Store) Enabled() bool {
+	return s != nil && s.enabled
+}
+
+// Get returns the live value stored under key
+func (s *Store) Get(key string) (any, bool) {
+	if !s.Enabled() {
+		return nil, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok || time.Now().After(e.expiresAt) {
+		delete(s.entries, key)
+		return nil, false
+	}
+	return e.value, true
+}
+
+// RecordLookup counts a cache hit or miss against namespace
+func (s *Store) RecordLookup(namespace string, hit bool) {
+	if !s.Enabled() {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.counters[namespace]
+	if !ok {
+		c = &counter{}
+		s.counters[namespace] = c
+	}
+	if hit {
+		c.hits++
+	} else {
+		c.misses++
+	}
+}
+
+// Set stores value under key until the TTL elapses. When the store is full expired entries
+// are dropped first, then arbitrary ones.
+func (s *Store) Set(key string, value any) {
+	s.SetWithTTL(key, value, s.ttl)
+}
+
+// SetWithTTL is Set with an entry-specific TTL
+func (s *Store) SetWithTTL(key string, value any, ttl time.Duration) {
+	if !s.Enabled() {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.entries[key]; !exists && s.maxEntries > 0 && len(s.entries) >= s.maxEntries {
+		s.evict()
+	}
+	s.entries[key] = entry{value: value, expiresAt: time.Now().Add(ttl)}
+}
+
+// Delete removes the given keys
+func (s *Store) Delete(keys ...string) {
+	if !s.Enabled() {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range keys {
+		delete(s.entries, key)
+	}
+}
+
+// Stats returns the current entry count and per-namespace hit/miss counters
+func (s *Store) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := Stats{
+		Enabled:    s.enabled,
+		Entries:    len(s.entries),
+		MaxEntries: s.maxEntries,
+		TTLSeconds: int(s.ttl / time.Second),
+		Namespaces: make(map[string]NamespaceStats, len(s.counters)),
+	}
+	for ns, c := range s.counters {
+		ratio := 0.0
+		if total := c.hits + c.misses; total > 0 {
+			ratio = float64(c.hits) / float64(total)
+		}
+		stats.Namespaces[ns] = NamespaceStats{Hits: c.hits, Misses: c.misses, HitRatio: ratio}
+	}
+	return stats
+}
+
+// evict frees room for one entry; callers hold mu
+func (s *Store) evict() {
+	now := time.Now()
+	for key, e := range s.entries {
+		if now.After(e.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+	if len(s.entries) < s.maxEntries {
+		return
+	}
+	for key := range s.entries {
+		delete(s.entries, key)
+		break
+	}
+}
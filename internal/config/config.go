@@ -4,21 +4,34 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/omkar273/nashikdarshan/internal/validator"
+	"github.com/shopspring/decimal"
 	"github.com/spf13/viper"
 )
 
 type Configuration struct {
-	Server   ServerConfig   `validate:"required"`
-	Logging  LoggingConfig  `validate:"required"`
-	Postgres PostgresConfig `validate:"required"`
-	Supabase SupabaseConfig `validate:"required"`
-	Secrets  SecretsConfig  `validate:"required"`
-	Routing  RoutingConfig  `validate:"required"`
+	Server     ServerConfig   `validate:"required"`
+	Logging    LoggingConfig  `validate:"required"`
+	Postgres   PostgresConfig `validate:"required"`
+	Supabase   SupabaseConfig `validate:"required"`
+	Secrets    SecretsConfig  `validate:"required"`
+	Routing    RoutingConfig  `validate:"required"`
+	Places     PlacesConfig
+	Categories CategoriesConfig
+	Cache      CacheConfig
+	RateLimit  RateLimitConfig `mapstructure:"rate_limit"`
+	Outbox     OutboxConfig
+	Weather    WeatherConfig
+	Vision     VisionConfig
+	OSMImport  OSMImportConfig `mapstructure:"osm_import"`
 }
 
 type LoggingConfig struct {
@@ -36,37 +49,219 @@ const (
 type ServerConfig struct {
 	Env     Env    `mapstructure:"env" validate:"required"`
 	Address string `mapstructure:"address" validate:"required"`
+	// SystemActor is recorded in created_by/updated_by for writes without an authenticated principal
+	SystemActor string `mapstructure:"system_actor" default:"system"`
+	// ListEnvelope is the shape of paginated list responses when the client does not ask for one
+	ListEnvelope types.ListEnvelope `mapstructure:"list_envelope" default:"flat"`
+	// TrustedProxies are the IPs and CIDRs whose X-Forwarded-For header is believed when
+	// resolving the client IP; requests from anywhere else use the connection's address
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
+	// CORSAllowedOrigins are the origins browsers may call the API from; "*" allows any origin
+	CORSAllowedOrigins []string `mapstructure:"cors_allowed_origins"`
+	// CORSAllowCredentials lets browsers send cookies and HTTP auth on cross-origin requests
+	CORSAllowCredentials bool `mapstructure:"cors_allow_credentials" default:"false"`
+	// MaintenanceMode answers every request but the health check with 503
+	MaintenanceMode bool `mapstructure:"maintenance_mode" default:"false"`
+	// MaintenanceRetryAfterSeconds is the Retry-After sent while in maintenance
+	MaintenanceRetryAfterSeconds int `mapstructure:"maintenance_retry_after_seconds" default:"300"`
+	// ServerTiming adds a Server-Timing header with database, cache and total time to every
+	// response. It reveals internal timings, so it is for debugging outside production only.
+	ServerTiming bool `mapstructure:"server_timing" default:"false"`
+	// StrictJSON rejects request bodies with members the endpoint does not know, instead of
+	// ignoring them
+	StrictJSON bool `mapstructure:"strict_json" default:"false"`
+	// RequestIDHeader is the header a request ID is read from and echoed back in
+	RequestIDHeader string `mapstructure:"request_id_header" default:"X-Request-ID"`
 }
 
 type PostgresConfig struct {
 	Host                   string `mapstructure:"host" validate:"required"`
 	Port                   int    `mapstructure:"port" validate:"required"`
 	User                   string `mapstructure:"user" validate:"required"`
-	Password               string `mapstructure:"password" validate:"required"`
+	Password               string `mapstructure:"password" validate:"required" redact:"true"`
 	DBName                 string `mapstructure:"dbname" validate:"required"`
 	SSLMode                string `mapstructure:"sslmode" validate:"required"`
 	MaxOpenConns           int    `mapstructure:"max_open_conns" default:"10"`
 	MaxIdleConns           int    `mapstructure:"max_idle_conns" default:"5"`
 	ConnMaxLifetimeMinutes int    `mapstructure:"conn_max_lifetime_minutes" default:"60"`
 	AutoMigrate            bool   `mapstructure:"auto_migrate" default:"false"`
+	// Startup connection retries, for databases that come up after the application
+	ConnectMaxAttempts     int `mapstructure:"connect_max_attempts" default:"10"`
+	ConnectMaxDelaySeconds int `mapstructure:"connect_max_delay_seconds" default:"30"`
 }
 
 type SecretsConfig struct {
-	EncryptionKey string `mapstructure:"encryption_key" validate:"required"`
+	EncryptionKey string `mapstructure:"encryption_key" validate:"required" redact:"true"`
 }
 
 type SupabaseConfig struct {
 	URL            string `mapstructure:"url" validate:"required"`
-	PublishableKey string `mapstructure:"publishable_key" validate:"required"` // For client-side use
-	SecretKey      string `mapstructure:"secret_key" validate:"required"`      // For server-side use
+	PublishableKey string `mapstructure:"publishable_key" validate:"required" redact:"false"` // For client-side use
+	SecretKey      string `mapstructure:"secret_key" validate:"required" redact:"true"`       // For server-side use
+
+	// JWT claim validation
+	JWTIssuer           string `mapstructure:"jwt_issuer"`                           // Defaults to <url>/auth/v1
+	JWTAudience         string `mapstructure:"jwt_audience" default:"authenticated"` // Expected aud claim
+	JWTClockSkewSeconds int    `mapstructure:"jwt_clock_skew_seconds" default:"30"`  // Tolerance for exp/nbf checks
 }
 
 type RoutingConfig struct {
 	Provider string `mapstructure:"provider"` // e.g., "google_maps". Optional - when empty routing is disabled
-	APIKey   string `mapstructure:"api_key"`
+	APIKey   string `mapstructure:"api_key" redact:"true"`
 	Timeout  int    `mapstructure:"timeout" default:"30"` // Timeout in seconds
 }
 
+type PlacesConfig struct {
+	// MaxRevisions caps the change history kept per place; the oldest revisions are pruned beyond it
+	MaxRevisions int `mapstructure:"max_revisions" default:"50"`
+	// EstimatedCountThreshold is the catalog size above which unfiltered listings report
+	// an estimated total instead of an exact COUNT(*); 0 always counts exactly
+	EstimatedCountThreshold int `mapstructure:"estimated_count_threshold" default:"10000"`
+	// FeedListingsRefreshSeconds is how often the precomputed feed listings are refreshed; 0 disables the job
+	FeedListingsRefreshSeconds int `mapstructure:"feed_listings_refresh_seconds" default:"300"`
+	// FeedListingsMaxStalenessSeconds is the age beyond which feed sections read live data instead
+	FeedListingsMaxStalenessSeconds int `mapstructure:"feed_listings_max_staleness_seconds" default:"900"`
+	// PublishScheduleSeconds is how often due publish_at/unpublish_at schedules are applied; 0 disables the job
+	PublishScheduleSeconds int `mapstructure:"publish_schedule_seconds" default:"60"`
+	// AtomFeedSize caps the entries of the places Atom feed
+	AtomFeedSize int `mapstructure:"atom_feed_size" default:"50"`
+	// AtomFeedDays is how far back, in days, the places Atom feed looks for updated places
+	AtomFeedDays int `mapstructure:"atom_feed_days" default:"30"`
+	// PlaceholderImageURL is shown for places without a primary image; empty shows none
+	PlaceholderImageURL string `mapstructure:"placeholder_image_url"`
+	// PlaceholderThumbnailURL is shown for places without a thumbnail; empty uses PlaceholderImageURL
+	PlaceholderThumbnailURL string `mapstructure:"placeholder_thumbnail_url"`
+	// SiteURL is the public website; Atom feed entries link to its /places/<slug> pages, or to the API when empty
+	SiteURL string `mapstructure:"site_url"`
+	// Search rank weights (0-1) of matches in the title, subtitle and descriptions
+	SearchWeightTitle       float64 `mapstructure:"search_weight_title" default:"1.0"`
+	SearchWeightSubtitle    float64 `mapstructure:"search_weight_subtitle" default:"0.4"`
+	SearchWeightDescription float64 `mapstructure:"search_weight_description" default:"0.2"`
+	// NearbyDistanceWeight is the share (0-1) of proximity in the rank=best nearby score
+	NearbyDistanceWeight float64 `mapstructure:"nearby_distance_weight" default:"0.6"`
+	// NearbyPopularityHalfViews is the view count at which popularity counts for half in the nearby score
+	NearbyPopularityHalfViews float64 `mapstructure:"nearby_popularity_half_views" default:"100"`
+	// AllowCustomAccessibilityKeys accepts accessibility attributes outside the built-in vocabulary
+	AllowCustomAccessibilityKeys bool `mapstructure:"allow_custom_accessibility_keys" default:"false"`
+	// SlugPrefixes maps a place type to the prefix of the slugs generated for it, e.g. temple -> temple
+	SlugPrefixes map[string]string `mapstructure:"slug_prefixes"`
+	// MaxCategoriesPerPlace caps the categories assigned to one place; 0 removes the cap
+	MaxCategoriesPerPlace int `mapstructure:"max_categories_per_place" default:"20"`
+	// MaxImagesPerPlace caps the active gallery images of one place; 0 removes the cap
+	MaxImagesPerPlace int `mapstructure:"max_images_per_place" default:"50"`
+	// IgnoreAdminFields drops admin-only fields sent by non-admins instead of refusing the request
+	IgnoreAdminFields bool `mapstructure:"ignore_admin_fields" default:"false"`
+	// CompletenessWeights weighs the checked fields in the place completeness score; empty uses the defaults
+	CompletenessWeights map[string]int `mapstructure:"completeness_weights"`
+	// ContactMasking maps a contact field to how it is masked for non-admins: none, last4 or omit
+	ContactMasking map[string]types.MaskingRule `mapstructure:"contact_masking"`
+	// SlugCollisionStrategy is the suffix given to a generated slug that is taken: increment, random or date
+	SlugCollisionStrategy types.SlugCollisionStrategy `mapstructure:"slug_collision_strategy" default:"increment"`
+	// DescriptionHTML is the markup kept in short and long descriptions: strip or basic
+	DescriptionHTML types.HTMLPolicy `mapstructure:"description_html" default:"basic"`
+	// TitleCollation orders titles when a listing sorts by title and names no collation
+	TitleCollation types.Collation `mapstructure:"title_collation" default:"und"`
+	// CityCenterLatitude and CityCenterLongitude are the point include=center_distance
+	// measures place distances from
+	CityCenterLatitude  float64 `mapstructure:"city_center_latitude" default:"19.9975"`
+	CityCenterLongitude float64 `mapstructure:"city_center_longitude" default:"73.7898"`
+	// GeohashPrecision is the length of the geohash in place responses unless a request asks
+	// for another with geohash_precision
+	GeohashPrecision int `mapstructure:"geohash_precision" default:"7"`
+	// PrimaryImageDelete is what deleting the image a place's primary_image_url points at
+	// does to it: repoint, clear or reject
+	PrimaryImageDelete types.PrimaryImageDeletePolicy `mapstructure:"primary_image_delete" default:"repoint"`
+}
+
+type CategoriesConfig struct {
+	// UniqueNames rejects a category whose name matches another live category, ignoring case
+	UniqueNames bool `mapstructure:"unique_names" default:"false"`
+}
+
+type CacheConfig struct {
+	// Enabled turns on the in-process cache of single place and category reads
+	Enabled bool `mapstructure:"enabled" default:"true"`
+	// TTLSeconds bounds how long an entry is served, and so how stale other instances can be
+	TTLSeconds int `mapstructure:"ttl_seconds" default:"60"`
+	// MaxEntries caps the number of cached entries
+	MaxEntries int `mapstructure:"max_entries" default:"10000"`
+	// CountTTLSeconds is how long a place listing total is reused across pages
+	CountTTLSeconds int `mapstructure:"count_ttl_seconds" default:"15"`
+}
+
+type RateLimitConfig struct {
+	// Enabled throttles each client IP, answering requests over the limit with 429
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// RequestsPerMinute is the sustained rate allowed per client IP
+	RequestsPerMinute int `mapstructure:"requests_per_minute" default:"120"`
+	// Burst is the number of requests a client IP may make at once
+	Burst int `mapstructure:"burst" default:"30"`
+}
+
+type OutboxConfig struct {
+	// Enabled records place and category change events and delivers them to WebhookURL
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// WebhookURL receives each event as a JSON POST. Webhook URLs often embed a token, so it
+	// is redacted from config dumps.
+	WebhookURL string `mapstructure:"webhook_url" redact:"true"`
+	// PollIntervalSeconds is how often the dispatcher looks for due events
+	PollIntervalSeconds int `mapstructure:"poll_interval_seconds" default:"5"`
+	// BatchSize is the number of events claimed per poll
+	BatchSize int `mapstructure:"batch_size" default:"50"`
+	// MaxAttempts is the number of deliveries tried before an event is marked dead
+	MaxAttempts int `mapstructure:"max_attempts" default:"10"`
+	// TimeoutSeconds bounds a single delivery
+	TimeoutSeconds int `mapstructure:"timeout_seconds" default:"10"`
+}
+
+type WeatherConfig struct {
+	// Provider looks up current weather: "open_meteo", or empty to disable weather
+	Provider string `mapstructure:"provider"`
+	// BaseURL overrides the provider endpoint
+	BaseURL string `mapstructure:"base_url"`
+	// TimeoutSeconds bounds a single provider request
+	TimeoutSeconds int `mapstructure:"timeout_seconds" default:"5"`
+	// CacheTTLSeconds is how long the conditions of a grid cell are reused
+	CacheTTLSeconds int `mapstructure:"cache_ttl_seconds" default:"600"`
+	// GridDegrees is the size of a grid cell; places in one cell share an observation
+	GridDegrees float64 `mapstructure:"grid_degrees" default:"0.05"`
+	// RequestsPerMinute caps provider requests; lookups beyond it go without weather
+	RequestsPerMinute int `mapstructure:"requests_per_minute" default:"60"`
+	// MaxCells caps the number of cached grid cells
+	MaxCells int `mapstructure:"max_cells" default:"1000"`
+}
+
+type VisionConfig struct {
+	// AltTextEnabled captions place images added without alt text in the background
+	AltTextEnabled bool `mapstructure:"alt_text_enabled" default:"false"`
+	// TimeoutSeconds bounds a single caption request
+	TimeoutSeconds int `mapstructure:"timeout_seconds" default:"30"`
+}
+
+type OSMImportConfig struct {
+	// OverpassURL is the Overpass API interpreter endpoint
+	OverpassURL string `mapstructure:"overpass_url"`
+	// Query is the Overpass QL query selecting the POIs to import; it must output JSON with
+	// geometry or centers so ways can be placed
+	Query string `mapstructure:"query"`
+	// PlaceType is the type given to imported places
+	PlaceType string `mapstructure:"place_type" default:"temple"`
+	// TimeoutSeconds bounds a single Overpass request
+	TimeoutSeconds int `mapstructure:"timeout_seconds" default:"120"`
+	// MaxRetries is the number of retries when Overpass is rate limiting or overloaded
+	MaxRetries int `mapstructure:"max_retries" default:"3"`
+	// MinIntervalSeconds is the least time between the starts of two imports
+	MinIntervalSeconds int `mapstructure:"min_interval_seconds" default:"300"`
+}
+
+// DefaultOSMImportQuery selects named places of worship and tourist attractions around Nashik
+const DefaultOSMImportQuery = `[out:json][timeout:90];
+(
+  nw["amenity"="place_of_worship"]["name"](19.85,73.60,20.20,74.00);
+  nw["tourism"~"^(attraction|museum|viewpoint)$"]["name"](19.85,73.60,20.20,74.00);
+);
+out geom;`
+
 func NewConfig() (*Configuration, error) {
 	v := viper.New()
 
@@ -86,6 +281,81 @@ func NewConfig() (*Configuration, error) {
 	// Step 4: Environment variable key mapping (e.g., CAYGNUS_SUPABASE_URL)
 	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 
+	// Defaults for optional keys so they can also be provided through env variables
+	v.SetDefault("server.system_actor", types.DefaultSystemActor)
+	v.SetDefault("server.list_envelope", string(types.ListEnvelopeFlat))
+	v.SetDefault("server.trusted_proxies", DefaultTrustedProxies)
+	v.SetDefault("server.cors_allowed_origins", []string{"*"})
+	v.SetDefault("server.cors_allow_credentials", false)
+	v.SetDefault("server.maintenance_mode", false)
+	v.SetDefault("server.maintenance_retry_after_seconds", 300)
+	v.SetDefault("server.server_timing", false)
+	v.SetDefault("server.strict_json", false)
+	v.SetDefault("server.request_id_header", types.HeaderRequestID)
+	v.SetDefault("supabase.jwt_issuer", "")
+	v.SetDefault("supabase.jwt_audience", "authenticated")
+	v.SetDefault("supabase.jwt_clock_skew_seconds", 30)
+	v.SetDefault("postgres.connect_max_attempts", 10)
+	v.SetDefault("postgres.connect_max_delay_seconds", 30)
+	v.SetDefault("places.max_revisions", DefaultMaxPlaceRevisions)
+	v.SetDefault("places.estimated_count_threshold", 10000)
+	v.SetDefault("places.feed_listings_refresh_seconds", 300)
+	v.SetDefault("places.publish_schedule_seconds", 60)
+	v.SetDefault("places.atom_feed_size", 50)
+	v.SetDefault("places.atom_feed_days", 30)
+	v.SetDefault("places.site_url", "")
+	v.SetDefault("places.placeholder_image_url", "")
+	v.SetDefault("places.placeholder_thumbnail_url", "")
+	v.SetDefault("places.feed_listings_max_staleness_seconds", 900)
+	v.SetDefault("places.search_weight_title", 1.0)
+	v.SetDefault("places.search_weight_subtitle", 0.4)
+	v.SetDefault("places.search_weight_description", 0.2)
+	v.SetDefault("places.nearby_distance_weight", 0.6)
+	v.SetDefault("places.nearby_popularity_half_views", 100)
+	v.SetDefault("places.allow_custom_accessibility_keys", false)
+	v.SetDefault("places.slug_prefixes", map[string]string{})
+	v.SetDefault("places.slug_collision_strategy", string(types.SlugCollisionIncrement))
+	v.SetDefault("places.max_categories_per_place", 20)
+	v.SetDefault("places.max_images_per_place", 50)
+	v.SetDefault("places.ignore_admin_fields", false)
+	v.SetDefault("places.completeness_weights", map[string]int{})
+	v.SetDefault("places.contact_masking", map[string]string{})
+	v.SetDefault("places.description_html", string(types.HTMLPolicyBasic))
+	v.SetDefault("places.title_collation", string(types.CollationRoot))
+	v.SetDefault("places.city_center_latitude", types.DefaultCityCenter.Latitude.InexactFloat64())
+	v.SetDefault("places.city_center_longitude", types.DefaultCityCenter.Longitude.InexactFloat64())
+	v.SetDefault("places.geohash_precision", types.DefaultGeohashPrecision)
+	v.SetDefault("places.primary_image_delete", string(types.PrimaryImageDeleteRepoint))
+	v.SetDefault("categories.unique_names", false)
+	v.SetDefault("cache.enabled", true)
+	v.SetDefault("cache.ttl_seconds", 60)
+	v.SetDefault("cache.max_entries", 10000)
+	v.SetDefault("cache.count_ttl_seconds", 15)
+	v.SetDefault("rate_limit.enabled", false)
+	v.SetDefault("rate_limit.requests_per_minute", 120)
+	v.SetDefault("rate_limit.burst", 30)
+	v.SetDefault("outbox.enabled", false)
+	v.SetDefault("outbox.webhook_url", "")
+	v.SetDefault("outbox.poll_interval_seconds", 5)
+	v.SetDefault("outbox.batch_size", 50)
+	v.SetDefault("outbox.max_attempts", 10)
+	v.SetDefault("outbox.timeout_seconds", 10)
+	v.SetDefault("weather.provider", "")
+	v.SetDefault("weather.base_url", "")
+	v.SetDefault("weather.timeout_seconds", 5)
+	v.SetDefault("weather.cache_ttl_seconds", 600)
+	v.SetDefault("weather.grid_degrees", 0.05)
+	v.SetDefault("weather.requests_per_minute", 60)
+	v.SetDefault("weather.max_cells", 1000)
+	v.SetDefault("vision.alt_text_enabled", false)
+	v.SetDefault("vision.timeout_seconds", 30)
+	v.SetDefault("osm_import.overpass_url", "https://overpass-api.de/api/interpreter")
+	v.SetDefault("osm_import.query", DefaultOSMImportQuery)
+	v.SetDefault("osm_import.place_type", "temple")
+	v.SetDefault("osm_import.timeout_seconds", 120)
+	v.SetDefault("osm_import.max_retries", 3)
+	v.SetDefault("osm_import.min_interval_seconds", 300)
+
 	// Step 5: Read the YAML file
 	configFileFound := true
 	if err := v.ReadInConfig(); err != nil {
@@ -114,6 +384,14 @@ func NewConfig() (*Configuration, error) {
 		return nil, fmt.Errorf("configuration validation failed: %v\n\nPlease ensure you have either:\n1. A valid config.yaml file in ./internal/config/ or ./config/\n2. A .env file with required variables\n3. Environment variables with CAYGNUS_ prefix\n\nRequired fields: server.address, logging.level, postgres.host, postgres.port, postgres.user, postgres.password, postgres.dbname, postgres.sslmode, supabase.url, supabase.publishable_key, supabase.secret_key, secrets.encryption_key", err)
 	}
 
+	types.SetSystemActor(cfg.Server.SystemActor)
+	types.SetAllowCustomAccessibilityKeys(cfg.Places.AllowCustomAccessibilityKeys)
+	types.SetCompletenessWeights(cfg.Places.CompletenessWeights)
+	types.SetContactMasking(cfg.Places.ContactMasking)
+	types.SetPlaceholderImage(cfg.Places.PlaceholderImageURL, cfg.Places.PlaceholderThumbnailURL)
+	types.SetCityCenter(cfg.Places.GetCityCenter())
+	types.SetGeohashPrecision(cfg.Places.GeohashPrecision)
+
 	// print the config in json format for debugging during development
 	jsonConfig, err := json.MarshalIndent(cfg, "", "  ")
 	if err != nil {
@@ -137,6 +415,131 @@ func (c Configuration) Validate() error {
 		return err
 	}
 
+	if err := c.Server.ListEnvelope.Validate(); err != nil {
+		return fmt.Errorf("server.list_envelope: %w", err)
+	}
+
+	for _, proxy := range c.Server.TrustedProxies {
+		if net.ParseIP(proxy) == nil {
+			if _, _, err := net.ParseCIDR(proxy); err != nil {
+				return fmt.Errorf("server.trusted_proxies: %q is not an IP address or CIDR", proxy)
+			}
+		}
+	}
+
+	for _, origin := range c.Server.CORSAllowedOrigins {
+		if origin == "*" {
+			if c.Server.CORSAllowCredentials {
+				return fmt.Errorf("server.cors_allowed_origins: \"*\" cannot be combined with server.cors_allow_credentials; list the origins instead")
+			}
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.Path != "" {
+			return fmt.Errorf("server.cors_allowed_origins: %q is not an origin such as https://example.com", origin)
+		}
+	}
+
+	if c.Server.ServerTiming && c.Server.Env == EnvProd {
+		return fmt.Errorf("server.server_timing must not be enabled when server.env is prod; it exposes internal timings")
+	}
+
+	if !headerNamePattern.MatchString(c.Server.RequestIDHeader) {
+		return fmt.Errorf("server.request_id_header: %q is not a header name such as X-Request-ID", c.Server.RequestIDHeader)
+	}
+
+	if c.Server.MaintenanceRetryAfterSeconds < 0 {
+		return fmt.Errorf("server.maintenance_retry_after_seconds must not be negative")
+	}
+
+	if c.RateLimit.Enabled && (c.RateLimit.RequestsPerMinute <= 0 || c.RateLimit.Burst <= 0) {
+		return fmt.Errorf("rate_limit.requests_per_minute and rate_limit.burst must be positive when rate_limit.enabled is true")
+	}
+
+	if c.Outbox.Enabled {
+		u, err := url.Parse(c.Outbox.WebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("outbox.webhook_url must be an http(s) URL when outbox.enabled is true")
+		}
+	}
+
+	if err := c.Places.DescriptionHTML.Validate(); err != nil {
+		return fmt.Errorf("places.description_html: %w", err)
+	}
+
+	if err := c.Places.TitleCollation.Validate(); err != nil {
+		return fmt.Errorf("places.title_collation: %w", err)
+	}
+
+	if err := c.Places.GetCityCenter().Validate(); err != nil {
+		return fmt.Errorf("places.city_center_latitude and places.city_center_longitude: %w", err)
+	}
+
+	if c.Places.GeohashPrecision < types.MinGeohashPrecision || c.Places.GeohashPrecision > types.MaxGeohashPrecision {
+		return fmt.Errorf("places.geohash_precision must be between %d and %d", types.MinGeohashPrecision, types.MaxGeohashPrecision)
+	}
+
+	if err := types.PlaceType(c.OSMImport.PlaceType).Validate(); err != nil {
+		return fmt.Errorf("osm_import.place_type: unknown place type %q", c.OSMImport.PlaceType)
+	}
+
+	if c.Places.MaxCategoriesPerPlace < 0 || c.Places.MaxImagesPerPlace < 0 {
+		return fmt.Errorf("places.max_categories_per_place and places.max_images_per_place must not be negative")
+	}
+
+	if err := c.Places.SlugCollisionStrategy.Validate(); err != nil {
+		return fmt.Errorf("places.slug_collision_strategy: %w", err)
+	}
+
+	if err := c.Places.PrimaryImageDelete.Validate(); err != nil {
+		return fmt.Errorf("places.primary_image_delete: %w", err)
+	}
+
+	if c.Places.AtomFeedSize < 1 || c.Places.AtomFeedSize > MaxAtomFeedSize {
+		return fmt.Errorf("places.atom_feed_size must be between 1 and %d", MaxAtomFeedSize)
+	}
+
+	if c.Places.AtomFeedDays < 1 {
+		return fmt.Errorf("places.atom_feed_days must be at least 1")
+	}
+
+	if c.Places.SiteURL != "" {
+		u, err := url.Parse(c.Places.SiteURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("places.site_url: %q is not an http(s) URL such as https://example.com", c.Places.SiteURL)
+		}
+	}
+
+	for name, value := range map[string]string{
+		"places.placeholder_image_url":     c.Places.PlaceholderImageURL,
+		"places.placeholder_thumbnail_url": c.Places.PlaceholderThumbnailURL,
+	} {
+		if value == "" {
+			continue
+		}
+		u, err := url.Parse(value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%s: %q is not an http(s) URL", name, value)
+		}
+	}
+
+	if err := types.ValidateCompletenessWeights(c.Places.CompletenessWeights); err != nil {
+		return fmt.Errorf("places.completeness_weights: %w", err)
+	}
+
+	if err := types.ValidateContactMasking(c.Places.ContactMasking); err != nil {
+		return fmt.Errorf("places.contact_masking: %w", err)
+	}
+
+	for placeType, prefix := range c.Places.SlugPrefixes {
+		if err := types.PlaceType(placeType).Validate(); err != nil {
+			return fmt.Errorf("places.slug_prefixes: unknown place type %q", placeType)
+		}
+		if !validator.IsValidSlug(prefix) {
+			return fmt.Errorf("places.slug_prefixes.%s: %q is not a kebab-case slug", placeType, prefix)
+		}
+	}
+
 	// Conditional validation for Routing
 	// If a provider is specified and it's google_maps, APIKey must be present
 	if strings.TrimSpace(c.Routing.Provider) != "" {
@@ -167,6 +570,136 @@ func GetDefaultConfig() *Configuration {
 	}
 }
 
+// GetMaintenanceRetryAfter returns the Retry-After sent while in maintenance
+func (s ServerConfig) GetMaintenanceRetryAfter() time.Duration {
+	return time.Duration(s.MaintenanceRetryAfterSeconds) * time.Second
+}
+
+// GetJWTIssuer returns the expected iss claim, derived from the project URL when not configured
+func (s SupabaseConfig) GetJWTIssuer() string {
+	if issuer := strings.TrimSpace(s.JWTIssuer); issuer != "" {
+		return issuer
+	}
+	return strings.TrimRight(s.URL, "/") + "/auth/v1"
+}
+
+// GetJWTAudience returns the expected aud claim
+func (s SupabaseConfig) GetJWTAudience() string {
+	if audience := strings.TrimSpace(s.JWTAudience); audience != "" {
+		return audience
+	}
+	return "authenticated"
+}
+
+// GetJWTClockSkew returns the tolerated clock skew when checking exp and nbf
+func (s SupabaseConfig) GetJWTClockSkew() time.Duration {
+	if s.JWTClockSkewSeconds < 0 {
+		return 0
+	}
+	return time.Duration(s.JWTClockSkewSeconds) * time.Second
+}
+
+// DefaultTrustedProxies trusts forwarding headers only from a proxy on the same host
+var DefaultTrustedProxies = []string{"127.0.0.1", "::1"}
+
+// headerNamePattern matches the HTTP header names accepted for server.request_id_header
+var headerNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-]*$`)
+
+// MaxAtomFeedSize caps places.atom_feed_size, so the feed stays a cheap read
+const MaxAtomFeedSize = 500
+
+// DefaultMaxPlaceRevisions is used when places.max_revisions is unset or not positive
+const DefaultMaxPlaceRevisions = 50
+
+// GetCityCenter returns the configured city center as a location
+func (p PlacesConfig) GetCityCenter() types.Location {
+	return types.Location{
+		Latitude:  decimal.NewFromFloat(p.CityCenterLatitude),
+		Longitude: decimal.NewFromFloat(p.CityCenterLongitude),
+	}
+}
+
+// GetMaxRevisions returns the number of revisions retained per place
+func (p PlacesConfig) GetMaxRevisions() int {
+	if p.MaxRevisions <= 0 {
+		return DefaultMaxPlaceRevisions
+	}
+	return p.MaxRevisions
+}
+
+// UseEstimatedCounts reports whether unfiltered listings may use estimated totals
+func (p PlacesConfig) UseEstimatedCounts() bool {
+	return p.EstimatedCountThreshold > 0
+}
+
+// GetFeedListingsRefreshInterval returns how often feed listings are refreshed, or 0 when disabled
+func (p PlacesConfig) GetFeedListingsRefreshInterval() time.Duration {
+	if p.FeedListingsRefreshSeconds <= 0 {
+		return 0
+	}
+	return time.Duration(p.FeedListingsRefreshSeconds) * time.Second
+}
+
+// GetPublishScheduleInterval returns how often due publishing schedules are applied, or 0 when disabled
+func (p PlacesConfig) GetPublishScheduleInterval() time.Duration {
+	if p.PublishScheduleSeconds <= 0 {
+		return 0
+	}
+	return time.Duration(p.PublishScheduleSeconds) * time.Second
+}
+
+// GetAtomFeedWindow returns how far back the places Atom feed looks for updated places
+func (p PlacesConfig) GetAtomFeedWindow() time.Duration {
+	return time.Duration(p.AtomFeedDays) * 24 * time.Hour
+}
+
+// GetFeedListingsMaxStaleness returns how old feed listings may be before live queries are used
+func (p PlacesConfig) GetFeedListingsMaxStaleness() time.Duration {
+	if p.FeedListingsMaxStalenessSeconds <= 0 {
+		return 0
+	}
+	return time.Duration(p.FeedListingsMaxStalenessSeconds) * time.Second
+}
+
+// GetSearchRankWeights returns the ts_rank weights array, ordered {D, C, B, A} as postgres
+// expects. Weights outside 0-1 fall back to the defaults; D is unused by the place document.
+func (p PlacesConfig) GetSearchRankWeights() []float64 {
+	weight := func(w, fallback float64) float64 {
+		if w < 0 || w > 1 {
+			return fallback
+		}
+		return w
+	}
+
+	return []float64{
+		0.1,
+		weight(p.SearchWeightDescription, 0.2),
+		weight(p.SearchWeightSubtitle, 0.4),
+		weight(p.SearchWeightTitle, 1.0),
+	}
+}
+
+// GetSlugPrefix returns the prefix of slugs generated for placeType, or "" when it has none
+func (p PlacesConfig) GetSlugPrefix(placeType types.PlaceType) string {
+	return p.SlugPrefixes[string(placeType)]
+}
+
+// GetConnectMaxAttempts returns how many times the initial connection is attempted
+func (p PostgresConfig) GetConnectMaxAttempts() int {
+	if p.ConnectMaxAttempts < 1 {
+		return 1
+	}
+	return p.ConnectMaxAttempts
+}
+
+// GetConnectMaxDelay returns the upper bound of the backoff between connection attempts
+func (p PostgresConfig) GetConnectMaxDelay() time.Duration {
+	if p.ConnectMaxDelaySeconds < 1 {
+		return time.Second
+	}
+	return time.Duration(p.ConnectMaxDelaySeconds) * time.Second
+}
+
 // GetDSN returns the database connection string (DSN) for direct PostgreSQL connections
 func (p PostgresConfig) GetDSN() string {
 	// Build base DSN
@@ -186,3 +719,150 @@ func (p PostgresConfig) GetDSN() string {
 
 	return dsn
 }
+
+// GetNearbyRankWeights returns the weights of the rank=best nearby score. Out-of-range
+// values fall back to the defaults.
+func (p PlacesConfig) GetNearbyRankWeights() types.NearbyRankWeights {
+	weights := types.NearbyRankWeights{
+		DistanceWeight:      p.NearbyDistanceWeight,
+		PopularityHalfViews: p.NearbyPopularityHalfViews,
+	}
+	if weights.DistanceWeight < 0 || weights.DistanceWeight > 1 {
+		weights.DistanceWeight = 0.6
+	}
+	if weights.PopularityHalfViews <= 0 {
+		weights.PopularityHalfViews = 100
+	}
+	return weights
+}
+
+// IsEnabled reports whether repository reads are cached; a non-positive TTL disables the cache
+func (c CacheConfig) IsEnabled() bool {
+	return c.Enabled && c.TTLSeconds > 0
+}
+
+// GetTTL returns how long a cached entry is served
+func (c CacheConfig) GetTTL() time.Duration {
+	return time.Duration(c.TTLSeconds) * time.Second
+}
+
+// GetCountTTL returns how long a listing total is reused; it never exceeds the entry TTL
+func (c CacheConfig) GetCountTTL() time.Duration {
+	ttl := time.Duration(c.CountTTLSeconds) * time.Second
+	if ttl <= 0 || ttl > c.GetTTL() {
+		return c.GetTTL()
+	}
+	return ttl
+}
+
+// GetMaxEntries returns the entry cap of the cache
+func (c CacheConfig) GetMaxEntries() int {
+	if c.MaxEntries <= 0 {
+		return 10000
+	}
+	return c.MaxEntries
+}
+
+// GetPollInterval returns how often the outbox dispatcher polls for due events
+func (o OutboxConfig) GetPollInterval() time.Duration {
+	if o.PollIntervalSeconds <= 0 {
+		return 5 * time.Second
+	}
+	return time.Duration(o.PollIntervalSeconds) * time.Second
+}
+
+// GetBatchSize returns the number of events claimed per poll
+func (o OutboxConfig) GetBatchSize() int {
+	if o.BatchSize <= 0 {
+		return 50
+	}
+	return o.BatchSize
+}
+
+// GetMaxAttempts returns the number of deliveries tried before an event is given up on
+func (o OutboxConfig) GetMaxAttempts() int {
+	if o.MaxAttempts <= 0 {
+		return 10
+	}
+	return o.MaxAttempts
+}
+
+// GetTimeout returns the time allowed for a single delivery
+func (o OutboxConfig) GetTimeout() time.Duration {
+	if o.TimeoutSeconds <= 0 {
+		return 10 * time.Second
+	}
+	return time.Duration(o.TimeoutSeconds) * time.Second
+}
+
+// GetTimeout returns the time allowed for a single weather request
+func (w WeatherConfig) GetTimeout() time.Duration {
+	if w.TimeoutSeconds <= 0 {
+		return 5 * time.Second
+	}
+	return time.Duration(w.TimeoutSeconds) * time.Second
+}
+
+// GetCacheTTL returns how long the conditions of a grid cell are reused
+func (w WeatherConfig) GetCacheTTL() time.Duration {
+	if w.CacheTTLSeconds <= 0 {
+		return 10 * time.Minute
+	}
+	return time.Duration(w.CacheTTLSeconds) * time.Second
+}
+
+// GetGridDegrees returns the size of a weather grid cell in degrees
+func (w WeatherConfig) GetGridDegrees() float64 {
+	if w.GridDegrees <= 0 || w.GridDegrees > 1 {
+		return 0.05
+	}
+	return w.GridDegrees
+}
+
+// GetRequestsPerMinute returns the cap on weather provider requests
+func (w WeatherConfig) GetRequestsPerMinute() int {
+	if w.RequestsPerMinute <= 0 {
+		return 60
+	}
+	return w.RequestsPerMinute
+}
+
+// GetMaxCells returns the number of grid cells kept in the weather cache
+func (w WeatherConfig) GetMaxCells() int {
+	if w.MaxCells <= 0 {
+		return 1000
+	}
+	return w.MaxCells
+}
+
+// GetTimeout returns the time allowed for a single caption request
+func (v VisionConfig) GetTimeout() time.Duration {
+	if v.TimeoutSeconds <= 0 {
+		return 30 * time.Second
+	}
+	return time.Duration(v.TimeoutSeconds) * time.Second
+}
+
+// GetTimeout returns the time allowed for a single Overpass request
+func (o OSMImportConfig) GetTimeout() time.Duration {
+	if o.TimeoutSeconds <= 0 {
+		return 2 * time.Minute
+	}
+	return time.Duration(o.TimeoutSeconds) * time.Second
+}
+
+// GetMaxRetries returns the number of retries of a busy Overpass instance
+func (o OSMImportConfig) GetMaxRetries() int {
+	if o.MaxRetries < 0 {
+		return 0
+	}
+	return o.MaxRetries
+}
+
+// GetMinInterval returns the least time between the starts of two imports
+func (o OSMImportConfig) GetMinInterval() time.Duration {
+	if o.MinIntervalSeconds < 0 {
+		return 0
+	}
+	return time.Duration(o.MinIntervalSeconds) * time.Second
+}
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPlacesConfigGetSearchRankWeights(t *testing.T) {
+	tests := []struct {
+		name   string
+		places PlacesConfig
+		want   []float64
+	}{
+		{
+			name:   "defaults",
+			places: PlacesConfig{SearchWeightTitle: 1.0, SearchWeightSubtitle: 0.4, SearchWeightDescription: 0.2},
+			want:   []float64{0.1, 0.2, 0.4, 1.0},
+		},
+		{
+			name:   "tuned",
+			places: PlacesConfig{SearchWeightTitle: 0.9, SearchWeightSubtitle: 0.5, SearchWeightDescription: 0.05},
+			want:   []float64{0.1, 0.05, 0.5, 0.9},
+		},
+		{
+			name:   "out of range falls back",
+			places: PlacesConfig{SearchWeightTitle: 2, SearchWeightSubtitle: -0.1, SearchWeightDescription: 1.5},
+			want:   []float64{0.1, 0.2, 0.4, 1.0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.places.GetSearchRankWeights()
+			// ts_rank reads the array as {D, C, B, A}; the title is weighted A, descriptions C
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("GetSearchRankWeights() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
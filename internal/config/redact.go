@@ -0,0 +1,70 @@
+package config
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// RedactedValue replaces a secret that is set in Redacted; unset secrets stay empty so a
+// missing one can still be told apart
+const RedactedValue = "[REDACTED]"
+
+// secretKeyWords are the words of a config key that make it a secret unless its field is
+// tagged redact:"false", so a newly added password or key is never dumped by accident
+var secretKeyWords = []string{"password", "secret", "key", "token", "credentials", "dsn"}
+
+// Redacted returns the configuration as nested maps keyed like config.yaml, with secrets
+// replaced by RedactedValue. A field is a secret when it is tagged redact:"true" or when its
+// key contains one of secretKeyWords and it is not tagged redact:"false".
+func (c *Configuration) Redacted() map[string]any {
+	return redactStruct(reflect.ValueOf(*c))
+}
+
+func redactStruct(v reflect.Value) map[string]any {
+	t := v.Type()
+	out := make(map[string]any, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		key := configKey(field)
+		value := v.Field(i)
+
+		switch {
+		case isSecret(field, key):
+			if value.IsZero() {
+				out[key] = ""
+			} else {
+				out[key] = RedactedValue
+			}
+		case value.Kind() == reflect.Struct:
+			out[key] = redactStruct(value)
+		default:
+			out[key] = value.Interface()
+		}
+	}
+	return out
+}
+
+// configKey is the key viper reads a field from: its mapstructure name, or its lowercased
+// field name when untagged
+func configKey(field reflect.StructField) string {
+	if name, _, _ := strings.Cut(field.Tag.Get("mapstructure"), ","); name != "" {
+		return name
+	}
+	return strings.ToLower(field.Name)
+}
+
+func isSecret(field reflect.StructField, key string) bool {
+	switch field.Tag.Get("redact") {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	return slices.ContainsFunc(strings.Split(key, "_"), func(word string) bool {
+		return slices.Contains(secretKeyWords, word)
+	})
+}
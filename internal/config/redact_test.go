@@ -0,0 +1,96 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConfigurationRedacted(t *testing.T) {
+	cfg := Configuration{
+		Postgres: PostgresConfig{Host: "db.internal", Password: "hunter2"},
+		Supabase: SupabaseConfig{
+			URL:            "https://project.supabase.co",
+			PublishableKey: "sb_publishable_abc",
+			SecretKey:      "sb_secret_xyz",
+		},
+		Routing: RoutingConfig{Provider: "google_maps"},
+	}
+
+	redacted := cfg.Redacted()
+	section := func(name string) map[string]any {
+		t.Helper()
+		m, ok := redacted[name].(map[string]any)
+		if !ok {
+			t.Fatalf("section %s = %#v, want a map", name, redacted[name])
+		}
+		return m
+	}
+
+	tests := []struct {
+		name    string
+		section string
+		key     string
+		want    any
+	}{
+		{name: "tagged secret is redacted", section: "postgres", key: "password", want: RedactedValue},
+		{name: "tagged secret key is redacted", section: "supabase", key: "secret_key", want: RedactedValue},
+		{name: "unset secret stays empty", section: "routing", key: "api_key", want: ""},
+		{name: "redact false passes through", section: "supabase", key: "publishable_key", want: "sb_publishable_abc"},
+		{name: "plain field passes through", section: "postgres", key: "host", want: "db.internal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := section(tt.section)[tt.key]; got != tt.want {
+				t.Errorf("%s.%s = %#v, want %#v", tt.section, tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsSecret(t *testing.T) {
+	type fields struct {
+		AccessToken   string
+		ClientSecret  string `mapstructure:"client_secret"`
+		SentryDSN     string `mapstructure:"sentry_dsn"`
+		PublicKey     string `mapstructure:"public_key" redact:"false"`
+		WebhookURL    string `mapstructure:"webhook_url" redact:"true"`
+		Keyboard      string `mapstructure:"keyboard"`
+		MaxOpenConns  int    `mapstructure:"max_open_conns"`
+		Passwordless  bool   `mapstructure:"passwordless"`
+		TokenTTL      int    `mapstructure:"token_ttl"`
+		CredentialsID string `mapstructure:"credentials_id"`
+	}
+
+	tests := []struct {
+		field string
+		want  bool
+	}{
+		// Untagged keys are secret when one of their words is a secret word
+		{field: "ClientSecret", want: true},
+		{field: "SentryDSN", want: true},
+		{field: "TokenTTL", want: true},
+		{field: "CredentialsID", want: true},
+		// Words are matched whole, so a key merely containing one is not a secret
+		{field: "AccessToken", want: false},
+		{field: "Keyboard", want: false},
+		{field: "Passwordless", want: false},
+		{field: "MaxOpenConns", want: false},
+		// Tags override the key
+		{field: "PublicKey", want: false},
+		{field: "WebhookURL", want: true},
+	}
+
+	typ := reflect.TypeOf(fields{})
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			field, ok := typ.FieldByName(tt.field)
+			if !ok {
+				t.Fatalf("no field %s", tt.field)
+			}
+			if got := isSecret(field, configKey(field)); got != tt.want {
+				t.Errorf("isSecret(%s) = %v, want %v", configKey(field), got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+// Package diff computes field-level differences between two values.
+//
+// Values are compared through their JSON representation, so paths use JSON
+// field names and fields hidden from JSON are never reported. Nested objects
+// and maps are walked key by key. Slices of scalars are compared as sets,
+// which suits ID lists such as categories; other slices are compared by index.
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// ChangeType describes how a field differs between two values
+type ChangeType string
+
+const (
+	ChangeAdded   ChangeType = "added"
+	ChangeRemoved ChangeType = "removed"
+	ChangeChanged ChangeType = "changed"
+)
+
+// Change is a single field-level difference
+type Change struct {
+	// Path is the dotted JSON path of the field, e.g. "address.city" or "images[0].url".
+	// Set elements are reported on the slice path with a "[]" suffix, e.g. "category_ids[]".
+	Path string     `json:"path"`
+	Type ChangeType `json:"type"`
+	Old  any        `json:"old,omitempty"`
+	New  any        `json:"new,omitempty"`
+}
+
+// Compare returns the changes needed to turn from into to, ordered by path
+func Compare(from, to any) ([]Change, error) {
+	fromValue, err := normalize(from)
+	if err != nil {
+		return nil, err
+	}
+	toValue, err := normalize(to)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]Change, 0)
+	walk("", fromValue, toValue, &changes)
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes, nil
+}
+
+// normalize converts a value into generic JSON types (maps, slices and scalars)
+func normalize(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to encode value for comparison").
+			Mark(ierr.ErrInternal)
+	}
+
+	var out any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to decode value for comparison").
+			Mark(ierr.ErrInternal)
+	}
+	return out, nil
+}
+
+func walk(path string, from, to any, changes *[]Change) {
+	switch {
+	case from == nil && to == nil:
+		return
+	case from == nil:
+		*changes = append(*changes, Change{Path: path, Type: ChangeAdded, New: to})
+		return
+	case to == nil:
+		*changes = append(*changes, Change{Path: path, Type: ChangeRemoved, Old: from})
+		return
+	}
+
+	fromMap, fromIsMap := from.(map[string]any)
+	toMap, toIsMap := to.(map[string]any)
+	if fromIsMap && toIsMap {
+		walkMap(path, fromMap, toMap, changes)
+		return
+	}
+
+	fromSlice, fromIsSlice := from.([]any)
+	toSlice, toIsSlice := to.([]any)
+	if fromIsSlice && toIsSlice {
+		walkSlice(path, fromSlice, toSlice, changes)
+		return
+	}
+
+	if !reflect.DeepEqual(from, to) {
+		*changes = append(*changes, Change{Path: path, Type: ChangeChanged, Old: from, New: to})
+	}
+}
+
+func walkMap(path string, from, to map[string]any, changes *[]Change) {
+	keys := make(map[string]struct{}, len(from)+len(to))
+	for k := range from {
+		keys[k] = struct{}{}
+	}
+	for k := range to {
+		keys[k] = struct{}{}
+	}
+
+	for k := range keys {
+		walk(join(path, k), from[k], to[k], changes)
+	}
+}
+
+func walkSlice(path string, from, to []any, changes *[]Change) {
+	if isScalarSlice(from) && isScalarSlice(to) {
+		walkSet(path, from, to, changes)
+		return
+	}
+
+	for i := 0; i < len(from) || i < len(to); i++ {
+		var fromItem, toItem any
+		if i < len(from) {
+			fromItem = from[i]
+		}
+		if i < len(to) {
+			toItem = to[i]
+		}
+		walk(fmt.Sprintf("%s[%d]", path, i), fromItem, toItem, changes)
+	}
+}
+
+// walkSet reports scalar elements that were added to or removed from a slice, ignoring order
+func walkSet(path string, from, to []any, changes *[]Change) {
+	setPath := path + "[]"
+
+	for _, item := range to {
+		if !containsValue(from, item) {
+			*changes = append(*changes, Change{Path: setPath, Type: ChangeAdded, New: item})
+		}
+	}
+	for _, item := range from {
+		if !containsValue(to, item) {
+			*changes = append(*changes, Change{Path: setPath, Type: ChangeRemoved, Old: item})
+		}
+	}
+}
+
+func isScalarSlice(items []any) bool {
+	for _, item := range items {
+		switch item.(type) {
+		case map[string]any, []any:
+			return false
+		}
+	}
+	return true
+}
+
+func containsValue(items []any, v any) bool {
+	for _, item := range items {
+		if reflect.DeepEqual(item, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
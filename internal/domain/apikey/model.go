@@ -0,0 +1,62 @@
+package apikey
+
+import (
+	"time"
+
+	"github.com/omkar273/nashikdarshan/ent"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+)
+
+type APIKey struct {
+	ID         string     `json:"id" db:"id"`
+	Label      string     `json:"label" db:"label"`
+	KeyHash    string     `json:"-" db:"key_hash"`
+	KeyPrefix  string     `json:"key_prefix" db:"key_prefix"`
+	Scopes     []string   `json:"scopes" db:"scopes"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" db:"last_used_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+	types.BaseModel
+}
+
+// FromEnt converts ent.APIKey to domain APIKey
+func FromEnt(key *ent.APIKey) *APIKey {
+	return &APIKey{
+		ID:         key.ID,
+		Label:      key.Label,
+		KeyHash:    key.KeyHash,
+		KeyPrefix:  key.KeyPrefix,
+		Scopes:     key.Scopes,
+		LastUsedAt: key.LastUsedAt,
+		ExpiresAt:  key.ExpiresAt,
+		RevokedAt:  key.RevokedAt,
+		BaseModel: types.BaseModel{
+			Status:    key.Status,
+			CreatedAt: key.CreatedAt.UTC(),
+			UpdatedAt: key.UpdatedAt.UTC(),
+			CreatedBy: key.CreatedBy,
+			UpdatedBy: key.UpdatedBy,
+			DeletedAt: types.UTCPtr(key.DeletedAt),
+			DeletedBy: key.DeletedBy,
+		},
+	}
+}
+
+// FromEntList converts a list of ent.APIKey to domain APIKey
+func FromEntList(keys []*ent.APIKey) []*APIKey {
+	return lo.Map(keys, func(key *ent.APIKey, _ int) *APIKey {
+		return FromEnt(key)
+	})
+}
+
+// IsActive reports whether the key can currently be used to authenticate
+func (k *APIKey) IsActive(now time.Time) bool {
+	if k.Status != types.StatusPublished || k.RevokedAt != nil {
+		return false
+	}
+	if k.ExpiresAt != nil && !now.Before(*k.ExpiresAt) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,16 @@
+package apikey
+
+import (
+	"context"
+	"time"
+)
+
+// Repository defines the interface for API key persistence operations
+type Repository interface {
+	Create(ctx context.Context, key *APIKey) error
+	Get(ctx context.Context, id string) (*APIKey, error)
+	GetByHash(ctx context.Context, keyHash string) (*APIKey, error)
+	List(ctx context.Context) ([]*APIKey, error)
+	Revoke(ctx context.Context, id string) error
+	TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error
+}
@@ -1,6 +1,7 @@
 package auth
 
 type Claims struct {
-	UserID string `json:"user_id"`
-	Email  string `json:"email"`
+	UserID string   `json:"user_id"`
+	Email  string   `json:"email"`
+	Scopes []string `json:"scopes,omitempty"`
 }
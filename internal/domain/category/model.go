@@ -25,11 +25,13 @@ func FromEnt(category *ent.Category) *Category {
 		Description: category.Description,
 		Metadata:    metadata,
 		BaseModel: types.BaseModel{
-			Status:    types.Status(category.Status),
-			CreatedAt: category.CreatedAt,
-			UpdatedAt: category.UpdatedAt,
+			Status:    category.Status,
+			CreatedAt: category.CreatedAt.UTC(),
+			UpdatedAt: category.UpdatedAt.UTC(),
 			CreatedBy: category.CreatedBy,
 			UpdatedBy: category.UpdatedBy,
+			DeletedAt: types.UTCPtr(category.DeletedAt),
+			DeletedBy: category.DeletedBy,
 		},
 	}
 }
@@ -11,9 +11,13 @@ type Repository interface {
 	// Core operations
 	Create(ctx context.Context, category *Category) error
 	Get(ctx context.Context, id string) (*Category, error)
+	// GetIncludingArchived is Get, except that an archived category is returned so it can be restored
+	GetIncludingArchived(ctx context.Context, id string) (*Category, error)
 	GetBySlug(ctx context.Context, slug string) (*Category, error)
 	Update(ctx context.Context, category *Category) error
 	Delete(ctx context.Context, category *Category) error
+	// ExistsByName reports whether a non-deleted category other than excludeID has the name, ignoring case
+	ExistsByName(ctx context.Context, name string, excludeID string) (bool, error)
 
 	// List operations
 	List(ctx context.Context, filter *types.CategoryFilter) ([]*Category, error)
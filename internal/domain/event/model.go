@@ -1,6 +1,8 @@
 package event
 
 import (
+	"math"
+	"sort"
 	"time"
 
 	"github.com/omkar273/nashikdarshan/ent"
@@ -118,11 +120,13 @@ func FromEnt(e *ent.Event) *Event {
 		ViewCount:       e.ViewCount,
 		InterestedCount: e.InterestedCount,
 		BaseModel: types.BaseModel{
-			Status:    types.Status(e.Status),
+			Status:    e.Status,
 			CreatedBy: e.CreatedBy,
 			UpdatedBy: e.UpdatedBy,
-			CreatedAt: e.CreatedAt,
-			UpdatedAt: e.UpdatedAt,
+			DeletedAt: types.UTCPtr(e.DeletedAt),
+			DeletedBy: e.DeletedBy,
+			CreatedAt: e.CreatedAt.UTC(),
+			UpdatedAt: e.UpdatedAt.UTC(),
 		},
 	}
 
@@ -153,11 +157,13 @@ func OccurrenceFromEnt(e *ent.EventOccurrence) *EventOccurrence {
 		ExceptionDates:  e.ExceptionDates,
 		Metadata:        types.NewMetadataFromMap(e.Metadata),
 		BaseModel: types.BaseModel{
-			Status:    types.Status(e.Status),
+			Status:    e.Status,
 			CreatedBy: e.CreatedBy,
 			UpdatedBy: e.UpdatedBy,
-			CreatedAt: e.CreatedAt,
-			UpdatedAt: e.UpdatedAt,
+			DeletedAt: types.UTCPtr(e.DeletedAt),
+			DeletedBy: e.DeletedBy,
+			CreatedAt: e.CreatedAt.UTC(),
+			UpdatedAt: e.UpdatedAt.UTC(),
 		},
 	}
 }
@@ -187,3 +193,165 @@ func OccurrenceFromEntList(occurrences []*ent.EventOccurrence) []*EventOccurrenc
 	}
 	return result
 }
+
+// ExpandOccurrences returns the concrete instances of the event that overlap the [from, to) window.
+// Dates and times of day are resolved in loc so recurrence rules follow the local calendar.
+// An event without occurrence slots is treated as a single instance spanning its validity window.
+func (e *Event) ExpandOccurrences(from, to time.Time, loc *time.Location) []*ExpandedOccurrence {
+	if e == nil || !to.After(from) {
+		return nil
+	}
+
+	now := time.Now().In(loc)
+	result := make([]*ExpandedOccurrence, 0)
+
+	appendInstance := func(occurrenceID string, start, end time.Time) {
+		if !end.After(from) || !start.Before(to) {
+			return
+		}
+		result = append(result, newExpandedOccurrence(e, occurrenceID, start, end, now))
+	}
+
+	if len(e.Occurrences) == 0 {
+		start := e.StartDate.In(loc)
+		end := endOfDay(start)
+		if e.EndDate != nil {
+			end = e.EndDate.In(loc)
+		}
+		appendInstance("", start, end)
+		return result
+	}
+
+	// Bound the day-by-day walk by both the requested window and the event validity window
+	firstDay := startOfDay(maxTime(from, e.StartDate).In(loc))
+	lastDay := startOfDay(to.In(loc))
+	if e.EndDate != nil && e.EndDate.Before(to) {
+		lastDay = startOfDay(e.EndDate.In(loc))
+	}
+
+	for _, occ := range e.Occurrences {
+		if occ.Status != types.StatusPublished {
+			continue
+		}
+
+		if occ.RecurrenceType == types.RecurrenceNone {
+			day := startOfDay(e.StartDate.In(loc))
+			if !occ.isException(day) {
+				start, end := occ.window(day)
+				appendInstance(occ.ID, start, end)
+			}
+			continue
+		}
+
+		for day := firstDay; !day.After(lastDay); day = day.AddDate(0, 0, 1) {
+			if !occ.matches(day) || occ.isException(day) {
+				continue
+			}
+			start, end := occ.window(day)
+			appendInstance(occ.ID, start, end)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StartTime.Before(result[j].StartTime)
+	})
+
+	return result
+}
+
+// NextOccurrence returns the first instance of the event that has not yet ended after the given time,
+// looking ahead at most `within`. Returns nil if there is none.
+func (e *Event) NextOccurrence(after time.Time, within time.Duration, loc *time.Location) *ExpandedOccurrence {
+	instances := e.ExpandOccurrences(after, after.Add(within), loc)
+	if len(instances) == 0 {
+		return nil
+	}
+	return instances[0]
+}
+
+// matches reports whether the occurrence's recurrence rule selects the given local day
+func (o *EventOccurrence) matches(day time.Time) bool {
+	switch o.RecurrenceType {
+	case types.RecurrenceDaily:
+		return true
+	case types.RecurrenceWeekly:
+		return o.DayOfWeek != nil && int(day.Weekday()) == *o.DayOfWeek
+	case types.RecurrenceMonthly:
+		return o.DayOfMonth != nil && day.Day() == *o.DayOfMonth
+	case types.RecurrenceYearly:
+		return o.DayOfMonth != nil && o.MonthOfYear != nil &&
+			day.Day() == *o.DayOfMonth && int(day.Month()) == *o.MonthOfYear
+	default:
+		return false
+	}
+}
+
+// isException reports whether the given local day is listed in the occurrence's exception dates
+func (o *EventOccurrence) isException(day time.Time) bool {
+	date := day.Format("2006-01-02")
+	for _, exception := range o.ExceptionDates {
+		if exception == date {
+			return true
+		}
+	}
+	return false
+}
+
+// window resolves the occurrence's time-of-day configuration onto the given local day.
+// Without a start time the instance spans the whole day.
+func (o *EventOccurrence) window(day time.Time) (time.Time, time.Time) {
+	loc := day.Location()
+	if o.StartTime == nil {
+		return day, endOfDay(day)
+	}
+
+	startClock := o.StartTime.In(loc)
+	start := time.Date(day.Year(), day.Month(), day.Day(), startClock.Hour(), startClock.Minute(), 0, 0, loc)
+
+	switch {
+	case o.EndTime != nil:
+		endClock := o.EndTime.In(loc)
+		end := time.Date(day.Year(), day.Month(), day.Day(), endClock.Hour(), endClock.Minute(), 0, 0, loc)
+		if end.After(start) {
+			return start, end
+		}
+	case o.DurationMinutes != nil && *o.DurationMinutes > 0:
+		return start, start.Add(time.Duration(*o.DurationMinutes) * time.Minute)
+	}
+
+	return start, endOfDay(day)
+}
+
+func newExpandedOccurrence(e *Event, occurrenceID string, start, end, now time.Time) *ExpandedOccurrence {
+	today := startOfDay(now)
+	startDay := startOfDay(start.In(now.Location()))
+
+	expanded := &ExpandedOccurrence{
+		EventID:      e.ID,
+		OccurrenceID: occurrenceID,
+		Title:        e.Title,
+		StartTime:    start,
+		EndTime:      end,
+		IsToday:      startDay.Equal(today),
+		IsUpcoming:   start.After(now),
+	}
+	if startDay.After(today) {
+		expanded.DaysUntil = int(math.Round(startDay.Sub(today).Hours() / 24))
+	}
+	return expanded
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func endOfDay(t time.Time) time.Time {
+	return startOfDay(t).AddDate(0, 0, 1).Add(-time.Second)
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -2,6 +2,7 @@ package event
 
 import (
 	"context"
+	"time"
 
 	"github.com/omkar273/nashikdarshan/internal/types"
 )
@@ -17,6 +18,10 @@ type Repository interface {
 	Update(ctx context.Context, event *Event) error
 	Delete(ctx context.Context, id string) error
 
+	// ListActiveInWindow returns published events (with their occurrences) whose validity window
+	// overlaps [from, to]. When placeID is provided only that place's events are returned.
+	ListActiveInWindow(ctx context.Context, placeID *string, from, to time.Time) ([]*Event, error)
+
 	// EventOccurrence CRUD
 	CreateOccurrence(ctx context.Context, occurrence *EventOccurrence) error
 	GetOccurrence(ctx context.Context, id string) (*EventOccurrence, error)
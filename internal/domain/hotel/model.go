@@ -72,11 +72,13 @@ func FromEnt(hotel *ent.Hotel) *Hotel {
 		PopularityScore: hotel.PopularityScore,
 
 		BaseModel: types.BaseModel{
-			Status:    types.Status(hotel.Status),
-			CreatedAt: hotel.CreatedAt,
-			UpdatedAt: hotel.UpdatedAt,
+			Status:    hotel.Status,
+			CreatedAt: hotel.CreatedAt.UTC(),
+			UpdatedAt: hotel.UpdatedAt.UTC(),
 			CreatedBy: hotel.CreatedBy,
 			UpdatedBy: hotel.UpdatedBy,
+			DeletedAt: types.UTCPtr(hotel.DeletedAt),
+			DeletedBy: hotel.DeletedBy,
 		},
 	}
 
@@ -98,11 +98,13 @@ func FromEnt(e *ent.Itinerary) *Itinerary {
 		IsOptimized:           e.IsOptimized,
 		Metadata:              e.Metadata,
 		BaseModel: types.BaseModel{
-			Status:    types.Status(e.Status),
-			CreatedAt: e.CreatedAt,
-			UpdatedAt: e.UpdatedAt,
+			Status:    e.Status,
+			CreatedAt: e.CreatedAt.UTC(),
+			UpdatedAt: e.UpdatedAt.UTC(),
 			CreatedBy: e.CreatedBy,
 			UpdatedBy: e.UpdatedBy,
+			DeletedAt: types.UTCPtr(e.DeletedAt),
+			DeletedBy: e.DeletedBy,
 		},
 	}
 
@@ -137,11 +139,13 @@ func VisitFromEnt(e *ent.Visit) *Visit {
 		TravelTimeFromPreviousMinutes: e.TravelTimeFromPreviousMinutes,
 		Notes:                         e.Notes,
 		BaseModel: types.BaseModel{
-			Status:    types.Status(e.Status),
-			CreatedAt: e.CreatedAt,
-			UpdatedAt: e.UpdatedAt,
+			Status:    e.Status,
+			CreatedAt: e.CreatedAt.UTC(),
+			UpdatedAt: e.UpdatedAt.UTC(),
 			CreatedBy: e.CreatedBy,
 			UpdatedBy: e.UpdatedBy,
+			DeletedAt: types.UTCPtr(e.DeletedAt),
+			DeletedBy: e.DeletedBy,
 		},
 	}
 
@@ -0,0 +1,68 @@
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/ent"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// Event is a change event waiting in, or delivered from, the transactional outbox
+type Event struct {
+	ID            string                `json:"id" db:"id"`
+	EventType     types.ChangeEventType `json:"event_type" db:"event_type"`
+	AggregateType string                `json:"aggregate_type" db:"aggregate_type"`
+	AggregateID   string                `json:"aggregate_id" db:"aggregate_id"`
+	Payload       json.RawMessage       `json:"payload" db:"payload"`
+	Status        types.OutboxStatus    `json:"status" db:"status"`
+	Attempts      int                   `json:"attempts" db:"attempts"`
+	LastError     string                `json:"last_error,omitempty" db:"last_error"`
+	AvailableAt   time.Time             `json:"available_at" db:"available_at"`
+	SentAt        *time.Time            `json:"sent_at,omitempty" db:"sent_at"`
+	CreatedAt     time.Time             `json:"created_at" db:"created_at"`
+}
+
+// NewEvent builds a pending event with the given id, created and due at now, carrying data
+// as its JSON payload
+func NewEvent(id string, now time.Time, eventType types.ChangeEventType, aggregateType, aggregateID string, data any) (*Event, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to encode the change event").
+			WithReportableDetails(map[string]any{
+				"event_type":   eventType,
+				"aggregate_id": aggregateID,
+			}).
+			Mark(ierr.ErrInternal)
+	}
+
+	return &Event{
+		ID:            id,
+		EventType:     eventType,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Payload:       payload,
+		Status:        types.OutboxStatusPending,
+		AvailableAt:   now,
+		CreatedAt:     now,
+	}, nil
+}
+
+// FromEnt converts ent.OutboxEvent to domain Event
+func FromEnt(e *ent.OutboxEvent) *Event {
+	return &Event{
+		ID:            e.ID,
+		EventType:     types.ChangeEventType(e.EventType),
+		AggregateType: e.AggregateType,
+		AggregateID:   e.AggregateID,
+		Payload:       e.Payload,
+		Status:        e.Status,
+		Attempts:      e.Attempts,
+		LastError:     e.LastError,
+		AvailableAt:   e.AvailableAt.UTC(),
+		SentAt:        e.SentAt,
+		CreatedAt:     e.CreatedAt.UTC(),
+	}
+}
@@ -0,0 +1,19 @@
+package outbox
+
+import (
+	"context"
+	"time"
+)
+
+// Repository defines the interface for outbox persistence operations
+type Repository interface {
+	// Create stores a pending event. Call it with the transaction of the change it describes.
+	Create(ctx context.Context, event *Event) error
+	// ClaimDue returns up to limit pending events that are due, oldest first, and hides them
+	// from other dispatchers until lease elapses. Each claim counts as a delivery attempt.
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*Event, error)
+	// MarkSent records that the event was delivered
+	MarkSent(ctx context.Context, id string) error
+	// MarkFailed records a failed attempt; the event is retried at retryAt, or never again when dead
+	MarkFailed(ctx context.Context, id string, reason string, retryAt time.Time, dead bool) error
+}
@@ -0,0 +1,9 @@
+package place
+
+// HeatmapCell is one cell of a place density grid, located at its center
+type HeatmapCell struct {
+	Latitude  float64
+	Longitude float64
+	// Weight is the number of places in the cell, or their summed view counts
+	Weight int64
+}
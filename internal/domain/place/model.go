@@ -2,6 +2,7 @@ package place
 
 import (
 	"math"
+	"sort"
 	"time"
 
 	"github.com/omkar273/nashikdarshan/ent"
@@ -11,17 +12,28 @@ import (
 )
 
 type Place struct {
-	ID               string            `json:"id" db:"id"`
-	Slug             string            `json:"slug" db:"slug"`
-	Title            string            `json:"title" db:"title"`
-	Subtitle         *string           `json:"subtitle,omitempty" db:"subtitle"`
-	ShortDescription *string           `json:"short_description,omitempty" db:"short_description"`
-	LongDescription  *string           `json:"long_description,omitempty" db:"long_description"`
-	PlaceType        types.PlaceType   `json:"place_type" db:"place_type"`
-	Address          map[string]string `json:"address,omitempty" db:"address"`
-	Location         types.Location    `json:"location" db:"location"`
-	PrimaryImageURL  *string           `json:"primary_image_url,omitempty" db:"primary_image_url"`
-	ThumbnailURL     *string           `json:"thumbnail_url,omitempty" db:"thumbnail_url"`
+	ID               string               `json:"id" db:"id"`
+	Slug             string               `json:"slug" db:"slug"`
+	Title            string               `json:"title" db:"title"`
+	Subtitle         *string              `json:"subtitle,omitempty" db:"subtitle"`
+	ShortDescription *string              `json:"short_description,omitempty" db:"short_description"`
+	LongDescription  *string              `json:"long_description,omitempty" db:"long_description"`
+	PlaceType        types.PlaceType      `json:"place_type" db:"place_type"`
+	Address          map[string]string    `json:"address,omitempty" db:"address"`
+	Location         types.Location       `json:"location" db:"location"`
+	PrimaryImageURL  *string              `json:"primary_image_url,omitempty" db:"primary_image_url"`
+	ThumbnailURL     *string              `json:"thumbnail_url,omitempty" db:"thumbnail_url"`
+	PriceInfo        *types.PriceInfo     `json:"price_info,omitempty" db:"price_info"`
+	Contact          *types.Contact       `json:"contact,omitempty" db:"contact"`
+	Accessibility    *types.Accessibility `json:"accessibility,omitempty" db:"accessibility" swaggertype:"object,boolean"`
+	// ExternalRefs identifies the place in external datasets by source, e.g. osm -> node/123
+	ExternalRefs map[string]string `json:"external_refs,omitempty" db:"external_refs"`
+	// GeofenceRadiusM is the radius of the circular geofence around the place, nil when it has none
+	GeofenceRadiusM *int `json:"geofence_radius_m,omitempty" db:"geofence_radius_m"`
+	// PublishAt is when a draft place is published, nil when it is not scheduled
+	PublishAt *time.Time `json:"publish_at,omitempty" db:"publish_at"`
+	// UnpublishAt is when a published place is archived, nil when it is not scheduled
+	UnpublishAt *time.Time `json:"unpublish_at,omitempty" db:"unpublish_at"`
 
 	// Engagement fields for feed functionality
 	ViewCount       int             `json:"view_count" db:"view_count"`
@@ -34,8 +46,17 @@ type Place struct {
 
 	// Relationships
 	Images []*PlaceImage `json:"images,omitempty"`
+	// CategoryCount is the number of categories of the place, nil when they were not loaded
+	CategoryCount *int `json:"-"`
 }
 
+const (
+	// ImageMetadataAltSource is the image metadata key recording where the alt text came from
+	ImageMetadataAltSource = "alt_source"
+	// AltSourceMachine marks alt text written by a captioner, awaiting review by an editor
+	AltSourceMachine = "machine"
+)
+
 type PlaceImage struct {
 	ID       string          `json:"id" db:"id"`
 	PlaceID  string          `json:"place_id" db:"place_id"`
@@ -62,6 +83,12 @@ func FromEnt(place *ent.Place) *Place {
 		},
 		PrimaryImageURL: lo.ToPtr(place.PrimaryImageURL),
 		ThumbnailURL:    lo.ToPtr(place.ThumbnailURL),
+		PriceInfo:       place.PriceInfo,
+		Contact:         place.Contact,
+		Accessibility:   place.Accessibility,
+		GeofenceRadiusM: place.GeofenceRadiusM,
+		PublishAt:       types.UTCPtr(place.PublishAt),
+		UnpublishAt:     types.UTCPtr(place.UnpublishAt),
 
 		// Engagement fields
 		ViewCount:       place.ViewCount,
@@ -71,11 +98,13 @@ func FromEnt(place *ent.Place) *Place {
 		PopularityScore: place.PopularityScore,
 
 		BaseModel: types.BaseModel{
-			Status:    types.Status(place.Status),
-			CreatedAt: place.CreatedAt,
-			UpdatedAt: place.UpdatedAt,
+			Status:    place.Status,
+			CreatedAt: place.CreatedAt.UTC(),
+			UpdatedAt: place.UpdatedAt.UTC(),
 			CreatedBy: place.CreatedBy,
 			UpdatedBy: place.UpdatedBy,
+			DeletedAt: types.UTCPtr(place.DeletedAt),
+			DeletedBy: place.DeletedBy,
 		},
 	}
 
@@ -83,11 +112,17 @@ func FromEnt(place *ent.Place) *Place {
 	if place.Address != nil {
 		p.Address = place.Address
 	}
+	if place.ExternalRefs != nil {
+		p.ExternalRefs = place.ExternalRefs
+	}
 
 	// Handle edges
 	if place.Edges.Images != nil {
 		p.Images = FromEntImageList(place.Edges.Images)
 	}
+	if categories, err := place.Edges.CategoryOrErr(); err == nil {
+		p.CategoryCount = lo.ToPtr(len(categories))
+	}
 
 	return p
 }
@@ -109,11 +144,13 @@ func FromEntImage(image *ent.PlaceImage) *PlaceImage {
 		Pos:      image.Pos,
 		Metadata: types.NewMetadataFromMap(image.Metadata),
 		BaseModel: types.BaseModel{
-			Status:    types.Status(image.Status),
-			CreatedAt: image.CreatedAt,
-			UpdatedAt: image.UpdatedAt,
+			Status:    image.Status,
+			CreatedAt: image.CreatedAt.UTC(),
+			UpdatedAt: image.UpdatedAt.UTC(),
 			CreatedBy: image.CreatedBy,
 			UpdatedBy: image.UpdatedBy,
+			DeletedAt: types.UTCPtr(image.DeletedAt),
+			DeletedBy: image.DeletedBy,
 		},
 	}
 
@@ -158,3 +195,179 @@ func (p *Place) CalculatePopularityScore() decimal.Decimal {
 
 	return finalScore
 }
+
+// DuplicateCandidate is a place that was flagged as a potential duplicate
+type DuplicateCandidate struct {
+	ID          string          `json:"id"`
+	Slug        string          `json:"slug"`
+	Title       string          `json:"title"`
+	PlaceType   types.PlaceType `json:"place_type"`
+	Location    types.Location  `json:"location"`
+	Status      types.Status    `json:"status"`
+	ViewCount   int             `json:"view_count"`
+	RatingCount int             `json:"rating_count"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+// DuplicatePair links two places that are both spatially close and have similar titles
+type DuplicatePair struct {
+	Left       *DuplicateCandidate `json:"left"`
+	Right      *DuplicateCandidate `json:"right"`
+	DistanceM  float64             `json:"distance_m"`
+	Similarity float64             `json:"similarity"`
+}
+
+// DuplicateGroup is a set of places that likely describe the same real-world place
+type DuplicateGroup struct {
+	CanonicalID   string                `json:"canonical_id"`
+	Members       []*DuplicateCandidate `json:"members"`
+	MaxDistanceM  float64               `json:"max_distance_m"`
+	MinSimilarity float64               `json:"min_similarity"`
+}
+
+// GroupDuplicatePairs merges overlapping pairs into connected groups and picks a
+// suggested canonical place for each group. Groups are ordered by size, largest first.
+func GroupDuplicatePairs(pairs []*DuplicatePair) []*DuplicateGroup {
+	parent := make(map[string]string)
+	candidates := make(map[string]*DuplicateCandidate)
+
+	var find func(id string) string
+	find = func(id string) string {
+		if parent[id] != id {
+			parent[id] = find(parent[id])
+		}
+		return parent[id]
+	}
+
+	for _, pair := range pairs {
+		for _, c := range []*DuplicateCandidate{pair.Left, pair.Right} {
+			if _, ok := parent[c.ID]; !ok {
+				parent[c.ID] = c.ID
+				candidates[c.ID] = c
+			}
+		}
+		if l, r := find(pair.Left.ID), find(pair.Right.ID); l != r {
+			parent[r] = l
+		}
+	}
+
+	groupsByRoot := make(map[string]*DuplicateGroup)
+	for id, c := range candidates {
+		root := find(id)
+		group, ok := groupsByRoot[root]
+		if !ok {
+			group = &DuplicateGroup{MinSimilarity: 1}
+			groupsByRoot[root] = group
+		}
+		group.Members = append(group.Members, c)
+	}
+
+	for _, pair := range pairs {
+		group := groupsByRoot[find(pair.Left.ID)]
+		group.MaxDistanceM = math.Max(group.MaxDistanceM, pair.DistanceM)
+		group.MinSimilarity = math.Min(group.MinSimilarity, pair.Similarity)
+	}
+
+	groups := lo.Values(groupsByRoot)
+	for _, group := range groups {
+		sort.Slice(group.Members, func(i, j int) bool {
+			return group.Members[i].isPreferredOver(group.Members[j])
+		})
+		group.CanonicalID = group.Members[0].ID
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		if len(groups[i].Members) != len(groups[j].Members) {
+			return len(groups[i].Members) > len(groups[j].Members)
+		}
+		return groups[i].CanonicalID < groups[j].CanonicalID
+	})
+
+	return groups
+}
+
+// isPreferredOver reports whether c is a better canonical candidate than other.
+// Published places win, then the most engaged, then the oldest record.
+func (c *DuplicateCandidate) isPreferredOver(other *DuplicateCandidate) bool {
+	cPublished := c.Status == types.StatusPublished
+	otherPublished := other.Status == types.StatusPublished
+	if cPublished != otherPublished {
+		return cPublished
+	}
+	if c.RatingCount != other.RatingCount {
+		return c.RatingCount > other.RatingCount
+	}
+	if c.ViewCount != other.ViewCount {
+		return c.ViewCount > other.ViewCount
+	}
+	if !c.CreatedAt.Equal(other.CreatedAt) {
+		return c.CreatedAt.Before(other.CreatedAt)
+	}
+	return c.ID < other.ID
+}
+
+// IncompletePlace is a place together with the content it is missing
+type IncompletePlace struct {
+	ID        string             `json:"id"`
+	Slug      string             `json:"slug"`
+	Title     string             `json:"title"`
+	PlaceType types.PlaceType    `json:"place_type"`
+	Status    types.Status       `json:"status"`
+	UpdatedAt time.Time          `json:"updated_at"`
+	Missing   []types.PlaceField `json:"missing"`
+}
+
+// MissingFields returns which of the checked fields are missing from the place
+func (p *Place) MissingFields(categoryCount int) []types.PlaceField {
+	missing := make([]types.PlaceField, 0)
+
+	if p.PrimaryImageURL == nil || *p.PrimaryImageURL == "" {
+		missing = append(missing, types.PlaceFieldPrimaryImage)
+	}
+	if p.LongDescription == nil || *p.LongDescription == "" {
+		missing = append(missing, types.PlaceFieldLongDescription)
+	}
+	if p.Location.Latitude.IsZero() && p.Location.Longitude.IsZero() {
+		missing = append(missing, types.PlaceFieldCoordinates)
+	}
+	if categoryCount == 0 {
+		missing = append(missing, types.PlaceFieldCategories)
+	}
+	if p.Contact.IsEmpty() {
+		missing = append(missing, types.PlaceFieldContact)
+	}
+
+	return missing
+}
+
+// Completeness returns the weighted completeness score (0-100) of the place, or nil when its
+// categories were not loaded
+func (p *Place) Completeness() *int {
+	if p.CategoryCount == nil {
+		return nil
+	}
+	return lo.ToPtr(types.CompletenessScore(p.MissingFields(*p.CategoryCount)))
+}
+
+// FeedListingPage is a page of a feed section read from the precomputed listings
+type FeedListingPage struct {
+	PlaceIDs []string
+	// Total is the number of places in the section when the listings were refreshed
+	Total       int
+	RefreshedAt time.Time
+}
+
+// Localize converts the timestamps of the place, its publishing schedule included, to loc
+// for presentation. The instants are unchanged.
+func (p *Place) Localize(loc *time.Location) {
+	if p == nil || loc == nil {
+		return
+	}
+	p.BaseModel.Localize(loc)
+	if p.PublishAt != nil {
+		p.PublishAt = lo.ToPtr(p.PublishAt.In(loc))
+	}
+	if p.UnpublishAt != nil {
+		p.UnpublishAt = lo.ToPtr(p.UnpublishAt.In(loc))
+	}
+}
@@ -0,0 +1,23 @@
+package place
+
+// RankedPlace is a place returned by a rank=best nearby query with its distance from the
+// origin and popularity-weighted score
+type RankedPlace struct {
+	*Place
+	DistanceM float64
+	Score     float64
+}
+
+// NearbyMatch is a place found near one origin of a batch nearby query
+type NearbyMatch struct {
+	// OriginIndex is the zero-based position of the origin in the request
+	OriginIndex int
+	PlaceID     string
+	DistanceM   float64
+}
+
+// GeofenceMatch is a place whose geofence contains a checked coordinate
+type GeofenceMatch struct {
+	PlaceID   string
+	DistanceM float64
+}
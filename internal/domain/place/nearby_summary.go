@@ -0,0 +1,36 @@
+package place
+
+import "github.com/omkar273/nashikdarshan/internal/types"
+
+// NearbySummary counts the published places around a point by place type and by category
+type NearbySummary struct {
+	// Total is the number of places; a place with several categories counts once
+	Total      int64
+	ByType     []*PlaceTypeCount
+	ByCategory []*CategoryCount
+}
+
+// CategoryStats aggregates the published places of a category
+type CategoryStats struct {
+	PlaceCount int64
+	// WithImages is the number of places with at least one active image
+	WithImages int64
+	// RatingSum and RatingCount sum the ratings of the places' reviews, so that the average
+	// weighs every review equally
+	RatingSum   float64
+	RatingCount int64
+	ByType      []*PlaceTypeCount
+}
+
+// PlaceTypeCount is the number of places of one type
+type PlaceTypeCount struct {
+	PlaceType types.PlaceType
+	Count     int64
+}
+
+// CategoryCount is the number of places in one category
+type CategoryCount struct {
+	Slug  string
+	Name  string
+	Count int64
+}
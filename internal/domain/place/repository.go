@@ -2,6 +2,7 @@ package place
 
 import (
 	"context"
+	"time"
 
 	"github.com/omkar273/nashikdarshan/internal/types"
 	"github.com/shopspring/decimal"
@@ -12,14 +13,75 @@ type Repository interface {
 	// Core operations
 	Create(ctx context.Context, place *Place) error
 	Get(ctx context.Context, id string) (*Place, error)
+	// GetIncludingArchived is Get, except that an archived place is returned so it can be restored
+	GetIncludingArchived(ctx context.Context, id string) (*Place, error)
 	GetBySlug(ctx context.Context, slug string) (*Place, error)
 	Update(ctx context.Context, place *Place) error
+	// UpsertBySlug inserts the place or, when a place already has its slug, replaces the
+	// content of that place in the same statement. It returns the ID of the stored place and
+	// ErrGone when the slug belongs to a soft-deleted place.
+	UpsertBySlug(ctx context.Context, place *Place) (string, error)
 	Delete(ctx context.Context, place *Place) error
+	// ExistsBySlug reports whether any place, whatever its status, has the slug
+	ExistsBySlug(ctx context.Context, slug string) (bool, error)
+	// ListByIdentifiers returns the ID, slug and status of the places, deleted ones included,
+	// whose ID or slug is among identifiers
+	ListByIdentifiers(ctx context.Context, identifiers []string) ([]*Place, error)
+	// GetIDBySlug returns the ID of the place, whatever its status, that has the slug
+	GetIDBySlug(ctx context.Context, slug string) (string, error)
+	// GetByExternalRef returns the place, whatever its status, whose reference in the source
+	// dataset is ref
+	GetByExternalRef(ctx context.Context, source, ref string) (*Place, error)
 
 	// List operations
 	List(ctx context.Context, filter *types.PlaceFilter) ([]*Place, error)
 	ListAll(ctx context.Context, filter *types.PlaceFilter) ([]*Place, error)
 	Count(ctx context.Context, filter *types.PlaceFilter) (int, error)
+	// Heatmap sums the published places in box over a grid of cellSize degrees, returning the
+	// non-empty cells
+	Heatmap(ctx context.Context, box types.BBox, cellSize float64, weight types.HeatmapWeight) ([]*HeatmapCell, error)
+	// NearbyCategoryCounts counts the published places within radiusKm of center by place
+	// type and by category, most common first
+	NearbyCategoryCounts(ctx context.Context, center types.Location, radiusKm float64) (*NearbySummary, error)
+	// CategoryStats aggregates the published places of a category
+	CategoryStats(ctx context.Context, categoryID string) (*CategoryStats, error)
+	// BoundingBox returns the south-west and north-east corners of the smallest box holding
+	// every place matching the filter; ErrNotFound when none match
+	BoundingBox(ctx context.Context, filter *types.PlaceFilter) (sw, ne types.Point, err error)
+	// Stream calls fn with every place matching the filter in id order, reading batchSize places
+	// per query so the result is never held in memory at once. Pagination and sort are ignored.
+	// It stops at the first error returned by fn or when ctx is done.
+	Stream(ctx context.Context, filter *types.PlaceFilter, batchSize int, fn func(*Place) error) error
+	// ListNearbyRanked returns places within the filter's radius ordered by the popularity-weighted
+	// nearby score (see types.NearbyRankWeights), best first. Scoring and pagination run in SQL.
+	ListNearbyRanked(ctx context.Context, filter *types.PlaceFilter, weights types.NearbyRankWeights) ([]*RankedPlace, error)
+	// NearbyBatch returns, for each origin, up to limitPer published places within radiusM,
+	// nearest first. All origins are answered by a single query.
+	NearbyBatch(ctx context.Context, origins []types.Location, radiusM float64, limitPer int) ([]*NearbyMatch, error)
+	// InsideGeofences returns the published places whose geofence contains location, nearest first
+	InsideGeofences(ctx context.Context, location types.Location) ([]*GeofenceMatch, error)
+	// Centroid returns the geodesic center of the given places. Every ID must be a place
+	// that is not deleted; otherwise ErrNotFound lists the missing ones.
+	Centroid(ctx context.Context, ids []string) (types.Point, error)
+	// ListByIDs returns the places with the given IDs in the same order, skipping missing ones
+	ListByIDs(ctx context.Context, ids []string) ([]*Place, error)
+	// ListScheduleDue returns the drafts due to be published and the published places due to
+	// be unpublished at now
+	ListScheduleDue(ctx context.Context, now time.Time) ([]*Place, error)
+	// EstimateCount returns the planner's row estimate for the filter's status only,
+	// ignoring every other filter. It is cheap but approximate.
+	EstimateCount(ctx context.Context, filter *types.PlaceFilter) (int, error)
+
+	// Search operations
+	// Autocomplete returns up to limit places whose title starts with prefix (case-insensitive)
+	Autocomplete(ctx context.Context, prefix string, limit int) ([]*Suggestion, error)
+	// Search returns the places matching a web-style full-text query, best ranked first.
+	// weights are the ts_rank weights ordered {D, C, B, A}.
+	Search(ctx context.Context, query string, weights []float64, limit, offset int) ([]*Place, error)
+	CountSearch(ctx context.Context, query string) (int, error)
+	// SuggestTerms returns up to limit lowercased place titles and category names whose
+	// trigram word similarity to term is at least minSimilarity, closest first
+	SuggestTerms(ctx context.Context, term string, minSimilarity float64, limit int) ([]string, error)
 
 	// Image operations
 	AddImage(ctx context.Context, image *PlaceImage) error
@@ -27,6 +89,10 @@ type Repository interface {
 	GetImages(ctx context.Context, placeID string) ([]*PlaceImage, error)
 	UpdateImage(ctx context.Context, image *PlaceImage) error
 	DeleteImage(ctx context.Context, imageID string) error
+	DeleteImages(ctx context.Context, placeID string, imageIDs []string) (int, error)
+	RepackImagePositions(ctx context.Context, placeID string) error
+	// SetPrimaryImageURL points the primary image of a place at url, or clears it when url is nil
+	SetPrimaryImageURL(ctx context.Context, placeID string, url *string) error
 
 	// Feed-specific operations
 	IncrementViewCount(ctx context.Context, placeID string) error
@@ -35,4 +101,38 @@ type Repository interface {
 
 	// Category operations
 	AssignCategories(ctx context.Context, placeID string, categoryIDs []string) error
+	GetCategoryIDs(ctx context.Context, placeID string) ([]string, error)
+
+	// Revision operations
+	CreateRevision(ctx context.Context, rev *Revision) error
+	GetRevision(ctx context.Context, placeID string, revision int) (*Revision, error)
+	ListRevisions(ctx context.Context, placeID string, filter *types.QueryFilter) ([]*Revision, error)
+	CountRevisions(ctx context.Context, placeID string) (int, error)
+	// LatestRevisionNumber returns the highest revision number of the place, or 0 when it has none
+	LatestRevisionNumber(ctx context.Context, placeID string) (int, error)
+	// PruneRevisions deletes the oldest revisions so that at most keep remain, returning how many were deleted
+	PruneRevisions(ctx context.Context, placeID string, keep int) (int, error)
+
+	// Slug history operations
+	// ChangeSlug moves the place from oldSlug to newSlug, reporting false without changing
+	// anything when the place is deleted or no longer has oldSlug
+	ChangeSlug(ctx context.Context, placeID, oldSlug, newSlug string) (bool, error)
+	// RecordPreviousSlug remembers a slug the place gave up, taking it over from any place
+	// that gave it up before
+	RecordPreviousSlug(ctx context.Context, prev *PreviousSlug) error
+	// GetIDByPreviousSlug returns the ID of the place that last gave up the slug
+	GetIDByPreviousSlug(ctx context.Context, slug string) (string, error)
+
+	// Precomputed feed listings
+	// GetFeedListingPage reads a page of a feed section from the listings view; ErrNotFound when it was never populated
+	GetFeedListingPage(ctx context.Context, section types.FeedSectionType, limit, offset int) (*FeedListingPage, error)
+	RefreshFeedListings(ctx context.Context) error
+
+	// Data quality operations
+	// FindDuplicatePairs returns pairs of places that lie within the filter radius
+	// and whose titles meet the trigram similarity threshold
+	FindDuplicatePairs(ctx context.Context, filter *types.PlaceDuplicateFilter) ([]*DuplicatePair, error)
+	// ListIncomplete returns places missing any of the filter's fields
+	ListIncomplete(ctx context.Context, filter *types.PlaceIncompleteFilter) ([]*IncompletePlace, error)
+	CountIncomplete(ctx context.Context, filter *types.PlaceIncompleteFilter) (int, error)
 }
@@ -0,0 +1,139 @@
+package place
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/ent"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+)
+
+// Snapshot is the editable content of a place captured by a revision.
+// Engagement counters and identity fields are not versioned.
+type Snapshot struct {
+	Title            string               `json:"title"`
+	Subtitle         *string              `json:"subtitle,omitempty"`
+	ShortDescription *string              `json:"short_description,omitempty"`
+	LongDescription  *string              `json:"long_description,omitempty"`
+	Address          map[string]string    `json:"address,omitempty"`
+	Location         types.Location       `json:"location"`
+	PrimaryImageURL  *string              `json:"primary_image_url,omitempty"`
+	ThumbnailURL     *string              `json:"thumbnail_url,omitempty"`
+	PriceInfo        *types.PriceInfo     `json:"price_info,omitempty"`
+	Contact          *types.Contact       `json:"contact,omitempty"`
+	Accessibility    *types.Accessibility `json:"accessibility,omitempty"`
+	GeofenceRadiusM  *int                 `json:"geofence_radius_m,omitempty"`
+	PublishAt        *time.Time           `json:"publish_at,omitempty"`
+	UnpublishAt      *time.Time           `json:"unpublish_at,omitempty"`
+	Status           types.Status         `json:"status"`
+	CategoryIDs      []string             `json:"category_ids"`
+}
+
+// Revision is a numbered snapshot of a place
+type Revision struct {
+	ID           string    `json:"id" db:"id"`
+	PlaceID      string    `json:"place_id" db:"place_id"`
+	Revision     int       `json:"revision" db:"revision"`
+	Snapshot     *Snapshot `json:"snapshot" db:"snapshot"`
+	RevertedFrom *int      `json:"reverted_from,omitempty" db:"reverted_from"`
+	types.BaseModel
+}
+
+// NewSnapshot captures the current editable content of a place
+func NewSnapshot(p *Place, categoryIDs []string) *Snapshot {
+	return &Snapshot{
+		Title:            p.Title,
+		Subtitle:         p.Subtitle,
+		ShortDescription: p.ShortDescription,
+		LongDescription:  p.LongDescription,
+		Address:          p.Address,
+		Location:         p.Location,
+		PrimaryImageURL:  p.PrimaryImageURL,
+		ThumbnailURL:     p.ThumbnailURL,
+		PriceInfo:        p.PriceInfo,
+		Contact:          p.Contact,
+		Accessibility:    p.Accessibility,
+		GeofenceRadiusM:  p.GeofenceRadiusM,
+		PublishAt:        p.PublishAt,
+		UnpublishAt:      p.UnpublishAt,
+		Status:           p.Status,
+		CategoryIDs:      lo.Uniq(categoryIDs),
+	}
+}
+
+// ApplyTo restores the snapshot content onto the place. Categories are
+// returned separately by CategoryIDs since they are stored as an edge.
+func (s *Snapshot) ApplyTo(p *Place) {
+	p.Title = s.Title
+	p.Subtitle = s.Subtitle
+	p.ShortDescription = s.ShortDescription
+	p.LongDescription = s.LongDescription
+	p.Address = s.Address
+	p.Location = s.Location
+	p.PrimaryImageURL = s.PrimaryImageURL
+	p.ThumbnailURL = s.ThumbnailURL
+	p.PriceInfo = s.PriceInfo
+	p.Contact = s.Contact
+	p.Accessibility = s.Accessibility
+	p.GeofenceRadiusM = s.GeofenceRadiusM
+	p.PublishAt = s.PublishAt
+	p.UnpublishAt = s.UnpublishAt
+	p.Status = s.Status
+}
+
+// Marshal encodes the snapshot for storage
+func (s *Snapshot) Marshal() (json.RawMessage, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to encode place snapshot").
+			Mark(ierr.ErrInternal)
+	}
+	return data, nil
+}
+
+// FromEntRevision converts ent.PlaceRevision to domain Revision
+func FromEntRevision(rev *ent.PlaceRevision) (*Revision, error) {
+	snapshot := &Snapshot{}
+	if err := json.Unmarshal(rev.Snapshot, snapshot); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to decode place snapshot").
+			WithReportableDetails(map[string]any{
+				"place_id": rev.PlaceID,
+				"revision": rev.Revision,
+			}).
+			Mark(ierr.ErrInternal)
+	}
+
+	return &Revision{
+		ID:           rev.ID,
+		PlaceID:      rev.PlaceID,
+		Revision:     rev.Revision,
+		Snapshot:     snapshot,
+		RevertedFrom: rev.RevertedFrom,
+		BaseModel: types.BaseModel{
+			Status:    rev.Status,
+			CreatedAt: rev.CreatedAt.UTC(),
+			UpdatedAt: rev.UpdatedAt.UTC(),
+			CreatedBy: rev.CreatedBy,
+			UpdatedBy: rev.UpdatedBy,
+			DeletedAt: types.UTCPtr(rev.DeletedAt),
+			DeletedBy: rev.DeletedBy,
+		},
+	}, nil
+}
+
+// FromEntRevisionList converts a list of ent.PlaceRevision to domain Revision
+func FromEntRevisionList(revs []*ent.PlaceRevision) ([]*Revision, error) {
+	result := make([]*Revision, 0, len(revs))
+	for _, rev := range revs {
+		r, err := FromEntRevision(rev)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, nil
+}
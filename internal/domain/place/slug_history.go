@@ -0,0 +1,14 @@
+package place
+
+import (
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// PreviousSlug is a slug a place gave up when it was re-slugged. Lookups by slug fall back
+// to it, so old links keep resolving.
+type PreviousSlug struct {
+	ID      string `json:"id" db:"id"`
+	PlaceID string `json:"place_id" db:"place_id"`
+	Slug    string `json:"slug" db:"slug"`
+	types.BaseModel
+}
@@ -0,0 +1,11 @@
+package place
+
+import "github.com/omkar273/nashikdarshan/internal/types"
+
+// Suggestion is a lightweight search-box match for a place
+type Suggestion struct {
+	ID        string          `json:"id"`
+	Title     string          `json:"title"`
+	Slug      string          `json:"slug"`
+	PlaceType types.PlaceType `json:"place_type"`
+}
@@ -41,11 +41,13 @@ func FromEnt(review *ent.Review) *Review {
 		IsVerified:      review.IsVerified,
 		IsFeatured:      review.IsFeatured,
 		BaseModel: types.BaseModel{
-			Status:    types.Status(review.Status),
-			CreatedAt: review.CreatedAt,
-			UpdatedAt: review.UpdatedAt,
+			Status:    review.Status,
+			CreatedAt: review.CreatedAt.UTC(),
+			UpdatedAt: review.UpdatedAt.UTC(),
 			CreatedBy: review.CreatedBy,
 			UpdatedBy: review.UpdatedBy,
+			DeletedAt: types.UTCPtr(review.DeletedAt),
+			DeletedBy: review.DeletedBy,
 		},
 	}
 
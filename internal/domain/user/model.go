@@ -26,11 +26,13 @@ func FromEnt(user *ent.User) *User {
 		Role:     types.UserRole(user.Role),
 		Metadata: metadata,
 		BaseModel: types.BaseModel{
-			Status:    types.Status(user.Status),
-			CreatedAt: user.CreatedAt,
-			UpdatedAt: user.UpdatedAt,
+			Status:    user.Status,
+			CreatedAt: user.CreatedAt.UTC(),
+			UpdatedAt: user.UpdatedAt.UTC(),
 			CreatedBy: user.CreatedBy,
 			UpdatedBy: user.UpdatedBy,
+			DeletedAt: types.UTCPtr(user.DeletedAt),
+			DeletedBy: user.DeletedBy,
 		},
 	}
 }
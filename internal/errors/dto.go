@@ -1,5 +1,7 @@
 package ierr
 
+import "strconv"
+
 // ErrorResponse represents the standard error response structure
 type ErrorResponse struct {
 	Success bool        `json:"success"`
@@ -8,7 +10,42 @@ type ErrorResponse struct {
 
 // ErrorDetail contains error information
 type ErrorDetail struct {
+	// Code is the machine-readable kind of the error, e.g. not_found or validation_error
+	Code          string         `json:"code"`
 	Display       string         `json:"message"`
 	InternalError string         `json:"internal_error,omitempty"`
 	Details       map[string]any `json:"details,omitempty"`
 }
+
+// MediaTypeJSONAPI is the JSON:API media type. Clients that accept it receive errors as a
+// JSONAPIErrorResponse instead of an ErrorResponse.
+const MediaTypeJSONAPI = "application/vnd.api+json"
+
+// JSONAPIErrorResponse is the JSON:API error document (https://jsonapi.org/format/#errors)
+type JSONAPIErrorResponse struct {
+	Errors []JSONAPIError `json:"errors"`
+}
+
+// JSONAPIError is a JSON:API error object. Title is fixed per error kind; Detail describes
+// this occurrence.
+type JSONAPIError struct {
+	// Status is the HTTP status code, as a string
+	Status string         `json:"status"`
+	Code   string         `json:"code"`
+	Title  string         `json:"title"`
+	Detail string         `json:"detail,omitempty"`
+	Meta   map[string]any `json:"meta,omitempty"`
+}
+
+// NewJSONAPIErrorResponse renders detail, answered with status, as a JSON:API error document
+func NewJSONAPIErrorResponse(status int, detail ErrorDetail) JSONAPIErrorResponse {
+	return JSONAPIErrorResponse{
+		Errors: []JSONAPIError{{
+			Status: strconv.Itoa(status),
+			Code:   detail.Code,
+			Title:  TitleFromCode(detail.Code),
+			Detail: detail.Display,
+			Meta:   detail.Details,
+		}},
+	}
+}
@@ -3,6 +3,7 @@ package ierr
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 )
@@ -10,45 +11,66 @@ import (
 // Common error types that can be used across the application
 // TODO: move to errors.New from cockroachdb/errors
 var (
-	ErrNotFound         = new(ErrCodeNotFound, "resource not found")
-	ErrAlreadyExists    = new(ErrCodeAlreadyExists, "resource already exists")
-	ErrVersionConflict  = new(ErrCodeVersionConflict, "version conflict")
-	ErrValidation       = new(ErrCodeValidation, "validation error")
-	ErrInvalidOperation = new(ErrCodeInvalidOperation, "invalid operation")
-	ErrPermissionDenied = new(ErrCodePermissionDenied, "permission denied")
-	ErrHTTPClient       = new(ErrCodeHTTPClient, "http client error")
-	ErrDatabase         = new(ErrCodeDatabase, "database error")
-	ErrSystem           = new(ErrCodeSystemError, "system error")
-	ErrInternal         = new(ErrCodeInternalError, "internal error")
-	ErrIntegration      = new(ErrCodeIntegration, "integration error")
+	ErrNotFound           = new(ErrCodeNotFound, "resource not found")
+	ErrGone               = new(ErrCodeGone, "resource has been deleted")
+	ErrAlreadyExists      = new(ErrCodeAlreadyExists, "resource already exists")
+	ErrVersionConflict    = new(ErrCodeVersionConflict, "version conflict")
+	ErrConflict           = new(ErrCodeConflict, "conflict with the current state of the resource")
+	ErrValidation         = new(ErrCodeValidation, "validation error")
+	ErrInvalidOperation   = new(ErrCodeInvalidOperation, "invalid operation")
+	ErrPermissionDenied   = new(ErrCodePermissionDenied, "permission denied")
+	ErrUnauthenticated    = new(ErrCodeUnauthenticated, "unauthenticated")
+	ErrHTTPClient         = new(ErrCodeHTTPClient, "http client error")
+	ErrDatabase           = new(ErrCodeDatabase, "database error")
+	ErrSystem             = new(ErrCodeSystemError, "system error")
+	ErrInternal           = new(ErrCodeInternalError, "internal error")
+	ErrIntegration        = new(ErrCodeIntegration, "integration error")
+	ErrMethodNotAllowed   = new(ErrCodeMethodNotAllowed, "method not allowed")
+	ErrPreconditionFailed = new(ErrCodePreconditionFailed, "precondition failed")
+	ErrRateLimited        = new(ErrCodeRateLimited, "rate limited")
+	ErrUnavailable        = new(ErrCodeUnavailable, "service unavailable")
 	// maps errors to http status codes
 	statusCodeMap = map[error]int{
-		ErrHTTPClient:       http.StatusInternalServerError,
-		ErrDatabase:         http.StatusInternalServerError,
-		ErrNotFound:         http.StatusNotFound,
-		ErrAlreadyExists:    http.StatusConflict,
-		ErrVersionConflict:  http.StatusConflict,
-		ErrValidation:       http.StatusBadRequest,
-		ErrInvalidOperation: http.StatusBadRequest,
-		ErrPermissionDenied: http.StatusForbidden,
-		ErrSystem:           http.StatusInternalServerError,
-		ErrInternal:         http.StatusInternalServerError,
-		ErrIntegration:      http.StatusBadGateway,
+		ErrHTTPClient:         http.StatusInternalServerError,
+		ErrDatabase:           http.StatusInternalServerError,
+		ErrNotFound:           http.StatusNotFound,
+		ErrGone:               http.StatusGone,
+		ErrAlreadyExists:      http.StatusConflict,
+		ErrVersionConflict:    http.StatusConflict,
+		ErrConflict:           http.StatusConflict,
+		ErrValidation:         http.StatusBadRequest,
+		ErrInvalidOperation:   http.StatusBadRequest,
+		ErrPermissionDenied:   http.StatusForbidden,
+		ErrUnauthenticated:    http.StatusUnauthorized,
+		ErrSystem:             http.StatusInternalServerError,
+		ErrInternal:           http.StatusInternalServerError,
+		ErrIntegration:        http.StatusBadGateway,
+		ErrMethodNotAllowed:   http.StatusMethodNotAllowed,
+		ErrPreconditionFailed: http.StatusPreconditionFailed,
+		ErrRateLimited:        http.StatusTooManyRequests,
+		ErrUnavailable:        http.StatusServiceUnavailable,
 	}
 )
 
 const (
-	ErrCodeHTTPClient       = "http_client_error"
-	ErrCodeSystemError      = "system_error"
-	ErrCodeInternalError    = "internal_error"
-	ErrCodeNotFound         = "not_found"
-	ErrCodeAlreadyExists    = "already_exists"
-	ErrCodeVersionConflict  = "version_conflict"
-	ErrCodeValidation       = "validation_error"
-	ErrCodeInvalidOperation = "invalid_operation"
-	ErrCodePermissionDenied = "permission_denied"
-	ErrCodeDatabase         = "database_error"
-	ErrCodeIntegration      = "integration_error"
+	ErrCodeHTTPClient         = "http_client_error"
+	ErrCodeSystemError        = "system_error"
+	ErrCodeInternalError      = "internal_error"
+	ErrCodeNotFound           = "not_found"
+	ErrCodeGone               = "gone"
+	ErrCodeAlreadyExists      = "already_exists"
+	ErrCodeVersionConflict    = "version_conflict"
+	ErrCodeConflict           = "conflict"
+	ErrCodeValidation         = "validation_error"
+	ErrCodeInvalidOperation   = "invalid_operation"
+	ErrCodePermissionDenied   = "permission_denied"
+	ErrCodeUnauthenticated    = "unauthenticated"
+	ErrCodeDatabase           = "database_error"
+	ErrCodeIntegration        = "integration_error"
+	ErrCodeMethodNotAllowed   = "method_not_allowed"
+	ErrCodePreconditionFailed = "precondition_failed"
+	ErrCodeRateLimited        = "rate_limited"
+	ErrCodeUnavailable        = "service_unavailable"
 )
 
 // InternalError represents a domain error
@@ -105,6 +127,11 @@ func IsNotFound(err error) bool {
 	return errors.Is(err, ErrNotFound)
 }
 
+// IsGone checks if an error is a gone error, i.e. the resource exists but was soft-deleted
+func IsGone(err error) bool {
+	return errors.Is(err, ErrGone)
+}
+
 func IsDatabase(err error) bool {
 	return errors.Is(err, ErrDatabase)
 }
@@ -127,6 +154,11 @@ func IsVersionConflict(err error) bool {
 	return errors.Is(err, ErrVersionConflict)
 }
 
+// IsConflict checks if an error conflicts with the current state of the resource
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
 // IsValidation checks if an error is a validation error
 func IsValidation(err error) bool {
 	return errors.Is(err, ErrValidation)
@@ -142,16 +174,63 @@ func IsPermissionDenied(err error) bool {
 	return errors.Is(err, ErrPermissionDenied)
 }
 
+// IsUnauthenticated checks if an error is an unauthenticated error
+func IsUnauthenticated(err error) bool {
+	return errors.Is(err, ErrUnauthenticated)
+}
+
 // IsHTTPClient checks if an error is an http client error
 func IsHTTPClient(err error) bool {
 	return errors.Is(err, ErrHTTPClient)
 }
 
+// IsMethodNotAllowed checks if an error is a method not allowed error
+func IsMethodNotAllowed(err error) bool {
+	return errors.Is(err, ErrMethodNotAllowed)
+}
+
+// IsPreconditionFailed checks if an error is a failed conditional request precondition
+func IsPreconditionFailed(err error) bool {
+	return errors.Is(err, ErrPreconditionFailed)
+}
+
+// IsRateLimited checks if an error is a rate limit error
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsUnavailable checks if an error is a service unavailable error
+func IsUnavailable(err error) bool {
+	return errors.Is(err, ErrUnavailable)
+}
+
 // IsIntegration checks if an error is an integration error
 func IsIntegration(err error) bool {
 	return errors.Is(err, ErrIntegration)
 }
 
+// CodeFromErr returns the machine-readable code of the error kind err is marked with,
+// defaulting to internal_error like HTTPStatusFromErr defaults to 500
+func CodeFromErr(err error) string {
+	for e := range statusCodeMap {
+		if errors.Is(err, e) {
+			return e.(*InternalError).Code
+		}
+	}
+	return ErrCodeInternalError
+}
+
+// TitleFromCode returns a short summary of the error kind with the given code, such as
+// "Validation error". Unknown codes are reported as internal errors.
+func TitleFromCode(code string) string {
+	for e := range statusCodeMap {
+		if ie := e.(*InternalError); ie.Code == code {
+			return strings.ToUpper(ie.Message[:1]) + ie.Message[1:]
+		}
+	}
+	return "Internal error"
+}
+
 func HTTPStatusFromErr(err error) int {
 	for e, status := range statusCodeMap {
 		if errors.Is(err, e) {
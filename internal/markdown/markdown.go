@@ -0,0 +1,31 @@
+// Package markdown renders the Markdown that editors write in descriptions to HTML.
+package markdown
+
+import (
+	"bytes"
+
+	"github.com/omkar273/nashikdarshan/internal/sanitize"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+var (
+	// Inline HTML is passed through because descriptions may mix basic tags into Markdown;
+	// the sanitizer below decides what survives.
+	renderer = goldmark.New(
+		goldmark.WithExtensions(extension.Strikethrough, extension.Linkify),
+		goldmark.WithRendererOptions(html.WithUnsafe()),
+	)
+	sanitizer = sanitize.NewRenderedHTML()
+)
+
+// ToHTML renders src as CommonMark with strikethrough and bare-URL links, then sanitizes the
+// result. It never returns unsanitized output: if rendering fails, src is sanitized as is.
+func ToHTML(src string) string {
+	var buf bytes.Buffer
+	if err := renderer.Convert([]byte(src), &buf); err != nil {
+		return sanitizer.Sanitize(src)
+	}
+	return sanitizer.Sanitize(buf.String())
+}
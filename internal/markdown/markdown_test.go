@@ -0,0 +1,56 @@
+package markdown
+
+import "testing"
+
+func TestToHTML(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "headings",
+			src:  "# Ramkund\n\n## History\n\n### Rituals",
+			want: "<h1>Ramkund</h1>\n<h2>History</h2>\n<h3>Rituals</h3>\n",
+		},
+		{
+			name: "lists",
+			src:  "- ghats\n- temples\n\n1. Ramkund\n2. Kalaram Temple",
+			want: "<ul>\n<li>ghats</li>\n<li>temples</li>\n</ul>\n<ol>\n<li>Ramkund</li>\n<li>Kalaram Temple</li>\n</ol>\n",
+		},
+		{
+			name: "links",
+			src:  "[Nashik](https://nashik.gov.in) and https://example.com",
+			want: `<p><a href="https://nashik.gov.in" rel="nofollow noreferrer">Nashik</a> and <a href="https://example.com" rel="nofollow noreferrer">https://example.com</a></p>` + "\n",
+		},
+		{
+			name: "emphasis and strikethrough",
+			src:  "**open** daily, ~~closed on Mondays~~",
+			want: "<p><strong>open</strong> daily, <del>closed on Mondays</del></p>\n",
+		},
+		// The renderer and sanitizer run together, so unsafe Markdown and inline HTML are dropped
+		{
+			name: "javascript link",
+			src:  "[Ramkund](javascript:alert(1))",
+			want: "<p>Ramkund</p>\n",
+		},
+		{
+			name: "inline script and handler",
+			src:  "<script>alert(1)</script>\n\n<p onclick=\"alert(1)\">Ramkund</p>",
+			want: "\n<p>Ramkund</p>",
+		},
+		{
+			name: "image",
+			src:  "![Ramkund](https://example.com/ramkund.jpg)",
+			want: "<p></p>\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToHTML(tt.src); got != tt.want {
+				t.Errorf("ToHTML(%q) = %q, want %q", tt.src, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+// Package osm reads points of interest from OpenStreetMap through the Overpass API.
+package osm
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/logger"
+)
+
+// DefaultOverpassURL is the main public Overpass instance
+const DefaultOverpassURL = "https://overpass-api.de/api/interpreter"
+
+// Element is a node, way or relation returned by an Overpass query with `out geom` or
+// `out center`
+type Element struct {
+	Type     string            `json:"type"`
+	ID       int64             `json:"id"`
+	Lat      *float64          `json:"lat,omitempty"`
+	Lon      *float64          `json:"lon,omitempty"`
+	Center   *Point            `json:"center,omitempty"`
+	Geometry []Point           `json:"geometry,omitempty"`
+	Members  []Member          `json:"members,omitempty"`
+	Tags     map[string]string `json:"tags,omitempty"`
+}
+
+// Member is a member of a relation, carrying its coordinates when queried with `out geom`
+type Member struct {
+	Type     string   `json:"type"`
+	Ref      int64    `json:"ref"`
+	Role     string   `json:"role,omitempty"`
+	Lat      *float64 `json:"lat,omitempty"`
+	Lon      *float64 `json:"lon,omitempty"`
+	Geometry []Point  `json:"geometry,omitempty"`
+}
+
+// Point is a coordinate pair in Overpass output
+type Point struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// Ref identifies the element across OpenStreetMap, e.g. node/123 or way/456
+func (e Element) Ref() string {
+	return e.Type + "/" + strconv.FormatInt(e.ID, 10)
+}
+
+// Position returns where the element is: the coordinates of a node, the center computed by
+// Overpass for `out center`, or the centroid of the geometry for `out geom`. It reports false
+// when the output carries none of these.
+func (e Element) Position() (Point, bool) {
+	switch {
+	case e.Lat != nil && e.Lon != nil:
+		return Point{Lat: *e.Lat, Lon: *e.Lon}, true
+	case e.Center != nil:
+		return *e.Center, true
+	case len(e.Geometry) > 0:
+		return Centroid(e.Geometry), true
+	}
+	return Point{}, false
+}
+
+// MemberPositions returns where the members of a relation are: the coordinates of node
+// members and the centroid of way members. Members without coordinates are left out, so it
+// is empty for nodes, ways and relations queried without `out geom`.
+func (e Element) MemberPositions() []Point {
+	var positions []Point
+	for _, m := range e.Members {
+		switch {
+		case m.Lat != nil && m.Lon != nil:
+			positions = append(positions, Point{Lat: *m.Lat, Lon: *m.Lon})
+		case len(m.Geometry) > 0:
+			positions = append(positions, Centroid(m.Geometry))
+		}
+	}
+	return positions
+}
+
+// Centroid returns the area centroid of a closed ring, which stays inside convex buildings
+// however their vertices are spaced. Open lines and degenerate rings fall back to the mean
+// of the vertices. Coordinates are treated as planar, which is accurate at POI scale.
+func Centroid(points []Point) Point {
+	n := len(points)
+	closed := n >= 4 && points[0] == points[n-1]
+	if closed {
+		var area, cx, cy float64
+		for i := 0; i < n-1; i++ {
+			p, q := points[i], points[i+1]
+			cross := p.Lon*q.Lat - q.Lon*p.Lat
+			area += cross
+			cx += (p.Lon + q.Lon) * cross
+			cy += (p.Lat + q.Lat) * cross
+		}
+		if area != 0 {
+			area /= 2
+			return Point{Lat: cy / (6 * area), Lon: cx / (6 * area)}
+		}
+	}
+
+	var sum Point
+	for _, p := range points {
+		sum.Lat += p.Lat
+		sum.Lon += p.Lon
+	}
+	return Point{Lat: sum.Lat / float64(n), Lon: sum.Lon / float64(n)}
+}
+
+// Client runs Overpass queries. Overpass answers 429 when a client exceeds its slot quota and
+// 504 when it is overloaded; both are retried with backoff, honouring Retry-After.
+type Client struct {
+	url        string
+	httpClient *http.Client
+	maxRetries int
+	log        logger.Logger
+}
+
+// NewClient creates a client for the Overpass interpreter at overpassURL
+func NewClient(overpassURL string, timeout time.Duration, maxRetries int, log *logger.Logger) *Client {
+	if overpassURL == "" {
+		overpassURL = DefaultOverpassURL
+	}
+	return &Client{
+		url:        overpassURL,
+		httpClient: &http.Client{Timeout: timeout},
+		maxRetries: maxRetries,
+		log:        *log,
+	}
+}
+
+type overpassResponse struct {
+	Elements []Element `json:"elements"`
+	Remark   string    `json:"remark,omitempty"`
+}
+
+// Query runs an Overpass QL query, which must ask for JSON output ([out:json])
+func (c *Client) Query(ctx context.Context, query string) ([]Element, error) {
+	for attempt := 0; ; attempt++ {
+		elements, retryAfter, err := c.query(ctx, query)
+		if err == nil || retryAfter == 0 || attempt >= c.maxRetries {
+			return elements, err
+		}
+
+		c.log.Warnw("overpass is busy, retrying",
+			"attempt", attempt+1,
+			"retry_after", retryAfter,
+			"error", err,
+		)
+		select {
+		case <-ctx.Done():
+			return nil, ierr.WithError(ctx.Err()).
+				WithHint("The OpenStreetMap query was cancelled").
+				Mark(ierr.ErrIntegration)
+		case <-time.After(retryAfter):
+		}
+	}
+}
+
+// query runs the query once. A non-zero duration means the failure is worth retrying after it.
+func (c *Client) query(ctx context.Context, query string) ([]Element, time.Duration, error) {
+	form := url.Values{"data": {query}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, 0, ierr.WithError(err).
+			WithHint("Failed to build the OpenStreetMap query").
+			Mark(ierr.ErrIntegration)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, ierr.WithError(err).
+			WithHint("OpenStreetMap is unreachable").
+			Mark(ierr.ErrIntegration)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusTooManyRequests, http.StatusGatewayTimeout:
+		return nil, retryDelay(resp.Header.Get("Retry-After")), ierr.NewErrorf("overpass responded with status %d", resp.StatusCode).
+			WithHint("OpenStreetMap is busy, please try again later").
+			WithReportableDetails(map[string]any{
+				"status": resp.StatusCode,
+			}).
+			Mark(ierr.ErrIntegration)
+	default:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, 0, ierr.NewErrorf("overpass responded with status %d: %s", resp.StatusCode, body).
+			WithHint("The OpenStreetMap query failed; check the query syntax").
+			WithReportableDetails(map[string]any{
+				"status": resp.StatusCode,
+			}).
+			Mark(ierr.ErrIntegration)
+	}
+
+	var body overpassResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, 0, ierr.WithError(err).
+			WithHint("OpenStreetMap returned an unreadable response; the query must use [out:json]").
+			Mark(ierr.ErrIntegration)
+	}
+	if body.Remark != "" && len(body.Elements) == 0 {
+		// Runtime errors such as timeouts come back as 200 with a remark and no elements
+		return nil, 0, ierr.NewErrorf("overpass remark: %s", body.Remark).
+			WithHint("The OpenStreetMap query did not complete").
+			WithReportableDetails(map[string]any{
+				"remark": body.Remark,
+			}).
+			Mark(ierr.ErrIntegration)
+	}
+
+	return body.Elements, 0, nil
+}
+
+// retryDelay parses Retry-After in seconds, defaulting to 30s, the usual Overpass slot wait
+func retryDelay(header string) time.Duration {
+	if seconds, err := strconv.Atoi(header); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return 30 * time.Second
+}
+
+// Name returns the English name of the element, falling back to its default name
+func (e Element) Name() string {
+	if name := strings.TrimSpace(e.Tags["name:en"]); name != "" {
+		return name
+	}
+	return strings.TrimSpace(e.Tags["name"])
+}
+
+// Address returns the addr:* tags of the element keyed without the prefix, e.g. city, street
+// and postcode, or nil when it has none
+func (e Element) Address() map[string]string {
+	var address map[string]string
+	for key, value := range e.Tags {
+		field, ok := strings.CutPrefix(key, "addr:")
+		if !ok || strings.TrimSpace(value) == "" {
+			continue
+		}
+		if address == nil {
+			address = make(map[string]string)
+		}
+		address[field] = strings.TrimSpace(value)
+	}
+	return address
+}
@@ -10,6 +10,7 @@ import (
 	entsql "entgo.io/ent/dialect/sql"
 	_ "github.com/lib/pq"
 	"github.com/omkar273/nashikdarshan/ent"
+	_ "github.com/omkar273/nashikdarshan/ent/runtime" // registers schema defaults and hooks
 	"github.com/omkar273/nashikdarshan/internal/config"
 	"github.com/omkar273/nashikdarshan/internal/logger"
 	"github.com/omkar273/nashikdarshan/internal/types"
@@ -55,9 +56,10 @@ func NewEntClient(config *config.Configuration, logger *logger.Logger) (*ent.Cli
 		return nil, fmt.Errorf("failed to connect to postgres: %w", err)
 	}
 
-	// ✅ Check if the database is actually reachable
-	if err := db.Ping(); err != nil {
-		return nil, fmt.Errorf("unable to reach postgres database: %w", err)
+	// ✅ Check if the database is actually reachable, waiting for it to come up
+	if err := PingWithRetry(context.Background(), db, config.Postgres, logger); err != nil {
+		_ = db.Close()
+		return nil, err
 	}
 	fmt.Print("connected to postgres...")
 
@@ -67,7 +69,10 @@ func NewEntClient(config *config.Configuration, logger *logger.Logger) (*ent.Cli
 	db.SetConnMaxLifetime(time.Duration(config.Postgres.ConnMaxLifetimeMinutes) * time.Minute)
 
 	// Create driver
-	drv := entsql.OpenDB(dialect.Postgres, db)
+	var drv dialect.Driver = entsql.OpenDB(dialect.Postgres, db)
+	if config.Server.ServerTiming {
+		drv = TimedDriver(drv)
+	}
 
 	// Create client with options
 	opts := []ent.Option{
@@ -162,6 +167,27 @@ func (c *Client) TxFromContext(ctx context.Context) *ent.Tx {
 	return nil
 }
 
+// AfterCommit runs fn once the transaction in ctx has committed, or right away outside a
+// transaction. It is not run when the transaction rolls back or fails to commit, so caches
+// are only touched for writes other requests can see.
+func AfterCommit(ctx context.Context, fn func()) {
+	tx, ok := ctx.Value(types.CtxDBTransaction).(*ent.Tx)
+	if !ok {
+		fn()
+		return
+	}
+
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+			fn()
+			return nil
+		})
+	})
+}
+
 // Querier returns the current transaction client if in a transaction, or the regular client
 func (c *Client) Querier(ctx context.Context) *ent.Client {
 	if tx := c.TxFromContext(ctx); tx != nil {
@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/omkar273/nashikdarshan/ent"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// stubDriver hands out transactions that run no statements and end with commitErr
+type stubDriver struct {
+	commitErr error
+}
+
+func (stubDriver) Exec(context.Context, string, any, any) error  { return nil }
+func (stubDriver) Query(context.Context, string, any, any) error { return nil }
+func (stubDriver) Close() error                                  { return nil }
+func (stubDriver) Dialect() string                               { return dialect.Postgres }
+
+func (d stubDriver) Tx(context.Context) (dialect.Tx, error) {
+	return stubTx{stubDriver: d}, nil
+}
+
+type stubTx struct {
+	stubDriver
+}
+
+func (t stubTx) Commit() error { return t.commitErr }
+func (stubTx) Rollback() error { return nil }
+
+func TestAfterCommit(t *testing.T) {
+	errCommit := errors.New("commit failed")
+
+	tests := []struct {
+		name      string
+		inTx      bool
+		commitErr error
+		end       func(*ent.Tx) error
+		wantRun   bool
+	}{
+		{name: "outside a transaction", wantRun: true},
+		{name: "committed", inTx: true, end: (*ent.Tx).Commit, wantRun: true},
+		{name: "rolled back", inTx: true, end: (*ent.Tx).Rollback},
+		{name: "failed commit", inTx: true, commitErr: errCommit, end: (*ent.Tx).Commit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			var tx *ent.Tx
+			if tt.inTx {
+				var err error
+				tx, err = ent.NewClient(ent.Driver(stubDriver{commitErr: tt.commitErr})).Tx(ctx)
+				if err != nil {
+					t.Fatalf("starting transaction: %v", err)
+				}
+				ctx = context.WithValue(ctx, types.CtxDBTransaction, tx)
+			}
+
+			ran := false
+			AfterCommit(ctx, func() { ran = true })
+
+			if tx != nil {
+				if ran {
+					t.Fatal("fn ran before the transaction ended")
+				}
+				if err := tt.end(tx); !errors.Is(err, tt.commitErr) {
+					t.Fatalf("ending transaction: %v", err)
+				}
+			}
+			if ran != tt.wantRun {
+				t.Errorf("ran = %v, want %v", ran, tt.wantRun)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// Collations creates the ICU collations used to sort place titles (see types.Collation).
+// They need a server built with ICU; the migrator only warns when one cannot be created and
+// title sorting falls back to byte order.
+var Collations = collationStatements()
+
+func collationStatements() []string {
+	stmts := make([]string, 0, len(types.Collations))
+	for _, c := range types.Collations {
+		if c.SQLName() == "" {
+			continue
+		}
+		stmts = append(stmts, fmt.Sprintf(`CREATE COLLATION IF NOT EXISTS %s (provider = icu, locale = '%s')`,
+			c.SQLName(), c.ICULocale()))
+	}
+	return stmts
+}
@@ -0,0 +1,19 @@
+package postgres
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCollations(t *testing.T) {
+	want := []string{
+		`CREATE COLLATION IF NOT EXISTS title_und (provider = icu, locale = 'und')`,
+		`CREATE COLLATION IF NOT EXISTS title_mr (provider = icu, locale = 'mr-IN')`,
+		`CREATE COLLATION IF NOT EXISTS title_hi (provider = icu, locale = 'hi-IN')`,
+		`CREATE COLLATION IF NOT EXISTS title_en (provider = icu, locale = 'en-IN')`,
+	}
+	// The binary collation sorts by byte order and needs no database collation
+	if !slices.Equal(Collations, want) {
+		t.Errorf("Collations = %q, want %q", Collations, want)
+	}
+}
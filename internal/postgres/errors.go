@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"errors"
+
+	"github.com/lib/pq"
+)
+
+// uniqueViolation is the SQLSTATE of an insert or update that duplicates a unique key
+const uniqueViolation = "23505"
+
+// Unique indexes whose violations are told apart
+const (
+	// PlaceSlugIndex keeps place slugs unique
+	PlaceSlugIndex = "place_slug"
+	// CategorySlugIndex keeps category slugs unique
+	CategorySlugIndex = "category_slug"
+	// CategoryNameUniqueIndex keeps live category names unique when categories.unique_names is enabled
+	CategoryNameUniqueIndex = "idx_categories_name_unique"
+	// PlaceOSMRefIndex keeps one place per OpenStreetMap element
+	PlaceOSMRefIndex = "idx_places_osm_ref"
+)
+
+// UniqueViolation returns the name of the unique index or constraint err violated, if err
+// is, or wraps, a PostgreSQL unique violation (SQLSTATE 23505)
+func UniqueViolation(err error) (string, bool) {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) || pqErr.Code != uniqueViolation {
+		return "", false
+	}
+	return pqErr.Constraint, true
+}
+
+// IsUniqueViolation reports whether err is a unique violation of the named index or constraint
+func IsUniqueViolation(err error, index string) bool {
+	constraint, ok := UniqueViolation(err)
+	return ok && constraint == index
+}
@@ -0,0 +1,38 @@
+package postgres
+
+// PlaceSearchDocument is the weighted tsvector matched by place full-text search:
+// A = title, B = subtitle, C = short and long description. Queries must use this exact
+// expression for the planner to pick idx_places_search.
+const PlaceSearchDocument = `(setweight(to_tsvector('simple', coalesce(title, '')), 'A') || ` +
+	`setweight(to_tsvector('simple', coalesce(subtitle, '')), 'B') || ` +
+	`setweight(to_tsvector('simple', coalesce(short_description, '') || ' ' || coalesce(long_description, '')), 'C'))`
+
+// PlaceGeometry is the point of a place as a PostGIS geometry. Queries must use this exact
+// expression for the planner to pick idx_places_geometry.
+const PlaceGeometry = `ST_SetSRID(ST_MakePoint(longitude::float8, latitude::float8), 4326)`
+
+const (
+	// CreateCategoryNameUniqueIndex enforces case-insensitive unique names among non-deleted
+	// categories. The migrator creates it only when categories.unique_names is enabled.
+	CreateCategoryNameUniqueIndex = `CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_name_unique ON categories (lower(name)) WHERE status <> 'deleted'`
+	// DropCategoryNameUniqueIndex removes the name uniqueness index when the option is disabled
+	DropCategoryNameUniqueIndex = `DROP INDEX IF EXISTS idx_categories_name_unique`
+)
+
+// Indexes are indexes ent cannot express (expressions, operator classes). The migrator
+// creates them after the ent schema and before the materialized views.
+var Indexes = []string{
+	// Prefix matching on lower(title) for place autocomplete (lower(title) LIKE 'tri%')
+	`CREATE INDEX IF NOT EXISTS idx_places_title_prefix ON places (lower(title) text_pattern_ops)`,
+	// Full-text search over the weighted place document
+	`CREATE INDEX IF NOT EXISTS idx_places_search ON places USING GIN (` + PlaceSearchDocument + `)`,
+	// Bounding box lookups on the place point, e.g. for the heatmap
+	`CREATE INDEX IF NOT EXISTS idx_places_geometry ON places USING GIST (` + PlaceGeometry + `)`,
+	// Region lookups by geohash prefix (geohash LIKE 'tes3%'), which need text_pattern_ops
+	// outside the C locale
+	`CREATE INDEX IF NOT EXISTS idx_places_geohash ON places (geohash text_pattern_ops)`,
+	// Pincode lookups on the place address (address->>'pincode' = '422001')
+	`CREATE INDEX IF NOT EXISTS idx_places_address_pincode ON places ((address->>'pincode'))`,
+	// One place per OpenStreetMap element, so repeated imports cannot create duplicates
+	`CREATE UNIQUE INDEX IF NOT EXISTS idx_places_osm_ref ON places ((external_refs->>'osm')) WHERE external_refs ? 'osm'`,
+}
@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/config"
+	"github.com/omkar273/nashikdarshan/internal/logger"
+)
+
+// connectBaseDelay is the wait after the first failed attempt; it doubles on every retry
+const connectBaseDelay = time.Second
+
+// PingWithRetry pings the database until it answers, backing off exponentially between
+// attempts up to the configured maximum delay. It gives up after the configured number of
+// attempts so the process still exits when the database is really down.
+func PingWithRetry(ctx context.Context, db *sql.DB, cfg config.PostgresConfig, logger *logger.Logger) error {
+	maxAttempts := cfg.GetConnectMaxAttempts()
+	maxDelay := cfg.GetConnectMaxDelay()
+	delay := connectBaseDelay
+
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if err = db.PingContext(ctx); err == nil {
+			if attempt > 1 {
+				logger.Infow("connected to postgres after retrying", "attempt", attempt)
+			}
+			return nil
+		}
+
+		if attempt == maxAttempts {
+			break
+		}
+
+		logger.Warnw("postgres is not reachable, retrying",
+			"host", cfg.Host,
+			"attempt", attempt,
+			"max_attempts", maxAttempts,
+			"retry_in", delay.String(),
+			"error", err,
+		)
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("unable to reach postgres database: %w", ctx.Err())
+		case <-time.After(delay):
+		}
+
+		delay = min(delay*2, maxDelay)
+	}
+
+	return fmt.Errorf("unable to reach postgres database after %d attempts: %w", maxAttempts, err)
+}
@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/omkar273/nashikdarshan/internal/timing"
+)
+
+// TimedDriver records the time of every statement run through driver against the database
+// metric of the request's timing recorder. Only the statement itself is timed: rows read
+// through the *sql.Rows returned by QueryContext are fetched after it returns.
+func TimedDriver(driver dialect.Driver) dialect.Driver {
+	return &timedDriver{Driver: driver}
+}
+
+type timedDriver struct {
+	dialect.Driver
+}
+
+func (d *timedDriver) Exec(ctx context.Context, query string, args, v any) error {
+	defer timing.Since(ctx, timing.MetricDB, time.Now())
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+func (d *timedDriver) Query(ctx context.Context, query string, args, v any) error {
+	defer timing.Since(ctx, timing.MetricDB, time.Now())
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+// ExecContext and QueryContext serve the raw SQL queries of the repositories
+func (d *timedDriver) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	drv, ok := d.Driver.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	defer timing.Since(ctx, timing.MetricDB, time.Now())
+	return drv.ExecContext(ctx, query, args...)
+}
+
+func (d *timedDriver) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	drv, ok := d.Driver.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	defer timing.Since(ctx, timing.MetricDB, time.Now())
+	return drv.QueryContext(ctx, query, args...)
+}
+
+func (d *timedDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	defer timing.Since(ctx, timing.MetricDB, time.Now())
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &timedTx{Tx: tx}, nil
+}
+
+func (d *timedDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.BeginTx is not supported")
+	}
+	defer timing.Since(ctx, timing.MetricDB, time.Now())
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &timedTx{Tx: tx}, nil
+}
+
+// timedTx times the statements of a transaction. Commit and Rollback carry no context, so
+// they are not recorded.
+type timedTx struct {
+	dialect.Tx
+}
+
+func (tx *timedTx) Exec(ctx context.Context, query string, args, v any) error {
+	defer timing.Since(ctx, timing.MetricDB, time.Now())
+	return tx.Tx.Exec(ctx, query, args, v)
+}
+
+func (tx *timedTx) Query(ctx context.Context, query string, args, v any) error {
+	defer timing.Since(ctx, timing.MetricDB, time.Now())
+	return tx.Tx.Query(ctx, query, args, v)
+}
+
+func (tx *timedTx) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	t, ok := tx.Tx.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	defer timing.Since(ctx, timing.MetricDB, time.Now())
+	return t.ExecContext(ctx, query, args...)
+}
+
+func (tx *timedTx) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	t, ok := tx.Tx.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	defer timing.Since(ctx, timing.MetricDB, time.Now())
+	return t.QueryContext(ctx, query, args...)
+}
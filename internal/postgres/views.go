@@ -0,0 +1,43 @@
+package postgres
+
+import "fmt"
+
+// PlaceFeedListingsView is a materialized view with the first rows of the
+// homepage feed sections (latest, popular, trending), so those sections do not
+// rank the whole places table on every request.
+const PlaceFeedListingsView = "place_feed_listings"
+
+// PlaceFeedListingsDepth is the number of ranked places kept per section
+const PlaceFeedListingsDepth = 200
+
+// MaterializedViews are created by the migrator after the ent schema, in order.
+// Every view needs a unique index so it can be refreshed concurrently.
+var MaterializedViews = []string{
+	fmt.Sprintf(`CREATE MATERIALIZED VIEW IF NOT EXISTS place_feed_listings AS
+WITH eligible AS (
+	SELECT id, created_at, popularity_score, last_viewed_at
+	FROM places
+	WHERE status <> 'deleted'
+),
+ranked AS (
+	SELECT 'latest' AS section, id AS place_id,
+		row_number() OVER (ORDER BY created_at DESC, id) AS rank,
+		count(*) OVER () AS section_total
+	FROM eligible
+	UNION ALL
+	SELECT 'popular', id,
+		row_number() OVER (ORDER BY popularity_score DESC, id),
+		count(*) OVER ()
+	FROM eligible
+	UNION ALL
+	SELECT 'trending', id,
+		row_number() OVER (ORDER BY popularity_score DESC, id),
+		count(*) OVER ()
+	FROM eligible
+	WHERE last_viewed_at >= now() - interval '48 hours'
+)
+SELECT section, place_id, rank::integer AS rank, section_total::integer AS section_total, now() AS refreshed_at
+FROM ranked
+WHERE rank <= %d`, PlaceFeedListingsDepth),
+	`CREATE UNIQUE INDEX IF NOT EXISTS place_feed_listings_section_rank ON place_feed_listings (section, rank)`,
+}
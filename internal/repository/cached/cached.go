@@ -0,0 +1,24 @@
+// Package cached provides repository decorators that serve single-entity reads from a
+// cache.Store and invalidate the affected keys once writes commit. They wrap any implementation of
+// the domain repository interface, so services are unaware of the cache.
+package cached
+
+import (
+	"context"
+
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+func idKey(namespace, id string) string {
+	return namespace + ":id:" + id
+}
+
+func slugKey(namespace, slug string) string {
+	return namespace + ":slug:" + slug
+}
+
+// inTransaction reports whether ctx carries a database transaction. Reads inside one bypass
+// the cache so uncommitted rows are neither served from nor written to it.
+func inTransaction(ctx context.Context) bool {
+	return ctx.Value(types.CtxDBTransaction) != nil
+}
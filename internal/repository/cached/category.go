@@ -0,0 +1,130 @@
+package cached
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/cache"
+	domain "github.com/omkar273/nashikdarshan/internal/domain/category"
+	"github.com/omkar273/nashikdarshan/internal/logger"
+	"github.com/omkar273/nashikdarshan/internal/postgres"
+	"github.com/omkar273/nashikdarshan/internal/timing"
+	"github.com/samber/lo"
+)
+
+const categoryNamespace = "category"
+
+// CategoryRepository caches single-category reads of the wrapped repository by id and slug
+// and invalidates them on update and delete. Every other method goes straight to the
+// wrapped repository.
+type CategoryRepository struct {
+	domain.Repository
+	store *cache.Store
+	log   logger.Logger
+}
+
+// NewCategoryRepository wraps repo with the given store
+func NewCategoryRepository(repo domain.Repository, store *cache.Store, logger *logger.Logger) domain.Repository {
+	return &CategoryRepository{
+		Repository: repo,
+		store:      store,
+		log:        *logger,
+	}
+}
+
+func (r *CategoryRepository) Get(ctx context.Context, id string) (*domain.Category, error) {
+	if inTransaction(ctx) {
+		return r.Repository.Get(ctx, id)
+	}
+
+	if c, ok := r.cached(ctx, id); ok {
+		r.store.RecordLookup(categoryNamespace, true)
+		return c, nil
+	}
+	r.store.RecordLookup(categoryNamespace, false)
+
+	c, err := r.Repository.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r.set(ctx, c)
+	return cloneCategory(c), nil
+}
+
+func (r *CategoryRepository) GetBySlug(ctx context.Context, slug string) (*domain.Category, error) {
+	if inTransaction(ctx) {
+		return r.Repository.GetBySlug(ctx, slug)
+	}
+
+	if id, ok := r.lookupSlug(ctx, slug); ok {
+		if c, ok := r.cached(ctx, id); ok && c.Slug == slug {
+			r.store.RecordLookup(categoryNamespace, true)
+			return c, nil
+		}
+	}
+	r.store.RecordLookup(categoryNamespace, false)
+
+	c, err := r.Repository.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	r.set(ctx, c)
+	return cloneCategory(c), nil
+}
+
+func (r *CategoryRepository) Update(ctx context.Context, c *domain.Category) error {
+	defer r.invalidate(ctx, c.ID)
+	return r.Repository.Update(ctx, c)
+}
+
+func (r *CategoryRepository) Delete(ctx context.Context, c *domain.Category) error {
+	defer r.invalidate(ctx, c.ID)
+	return r.Repository.Delete(ctx, c)
+}
+
+// cached returns a copy of the category stored under id
+func (r *CategoryRepository) cached(ctx context.Context, id string) (*domain.Category, bool) {
+	defer timing.Since(ctx, timing.MetricCache, time.Now())
+
+	v, ok := r.store.Get(idKey(categoryNamespace, id))
+	if !ok {
+		return nil, false
+	}
+	return cloneCategory(v.(*domain.Category)), true
+}
+
+// lookupSlug returns the id cached under slug
+func (r *CategoryRepository) lookupSlug(ctx context.Context, slug string) (string, bool) {
+	defer timing.Since(ctx, timing.MetricCache, time.Now())
+
+	id, ok := r.store.Get(slugKey(categoryNamespace, slug))
+	if !ok {
+		return "", false
+	}
+	return id.(string), true
+}
+
+func (r *CategoryRepository) set(ctx context.Context, c *domain.Category) {
+	defer timing.Since(ctx, timing.MetricCache, time.Now())
+
+	r.store.Set(idKey(categoryNamespace, c.ID), cloneCategory(c))
+	r.store.Set(slugKey(categoryNamespace, c.Slug), c.ID)
+}
+
+// invalidate drops the cached category once the write in ctx has committed, see
+// PlaceRepository.invalidate
+func (r *CategoryRepository) invalidate(ctx context.Context, id string) {
+	postgres.AfterCommit(ctx, func() {
+		r.log.Debugw("invalidating cached category", "category_id", id)
+		r.store.Delete(idKey(categoryNamespace, id))
+	})
+}
+
+func cloneCategory(c *domain.Category) *domain.Category {
+	clone := *c
+	if c.Metadata != nil {
+		metadata := lo.Assign(*c.Metadata)
+		clone.Metadata = &metadata
+	}
+	return &clone
+}
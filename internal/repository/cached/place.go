@@ -0,0 +1,223 @@
+package cached
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/cache"
+	domain "github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/logger"
+	"github.com/omkar273/nashikdarshan/internal/postgres"
+	"github.com/omkar273/nashikdarshan/internal/timing"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+const placeNamespace = "place"
+
+// PlaceRepository caches single-place reads of the wrapped repository by id and slug, and
+// listing totals by filter (see Count). Place reads are invalidated when the place, its
+// images or its rating change; totals are invalidated by any place write. Every other
+// method, including the list queries, goes straight to the wrapped repository.
+//
+// View count increments do not invalidate, so a cached place may lag behind its view count
+// by up to the cache TTL.
+type PlaceRepository struct {
+	domain.Repository
+	store    *cache.Store
+	countTTL time.Duration
+	log      logger.Logger
+
+	// countGeneration is part of every count key; bumping it retires all cached totals at once
+	countGeneration atomic.Uint64
+}
+
+// NewPlaceRepository wraps repo with the given store. Totals are kept for countTTL.
+func NewPlaceRepository(repo domain.Repository, store *cache.Store, countTTL time.Duration, logger *logger.Logger) domain.Repository {
+	return &PlaceRepository{
+		Repository: repo,
+		store:      store,
+		countTTL:   countTTL,
+		log:        *logger,
+	}
+}
+
+func (r *PlaceRepository) Create(ctx context.Context, p *domain.Place) error {
+	defer postgres.AfterCommit(ctx, func() { r.countGeneration.Add(1) })
+	return r.Repository.Create(ctx, p)
+}
+
+func (r *PlaceRepository) Get(ctx context.Context, id string) (*domain.Place, error) {
+	if inTransaction(ctx) {
+		return r.Repository.Get(ctx, id)
+	}
+
+	if p, ok := r.cached(ctx, id); ok {
+		r.store.RecordLookup(placeNamespace, true)
+		return p, nil
+	}
+	r.store.RecordLookup(placeNamespace, false)
+
+	p, err := r.Repository.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r.set(ctx, p)
+	return clonePlace(p), nil
+}
+
+func (r *PlaceRepository) GetBySlug(ctx context.Context, slug string) (*domain.Place, error) {
+	if inTransaction(ctx) {
+		return r.Repository.GetBySlug(ctx, slug)
+	}
+
+	// The slug key only maps to the id; the place itself is stored once under its id
+	if id, ok := r.lookupSlug(ctx, slug); ok {
+		if p, ok := r.cached(ctx, id); ok && p.Slug == slug {
+			r.store.RecordLookup(placeNamespace, true)
+			return p, nil
+		}
+	}
+	r.store.RecordLookup(placeNamespace, false)
+
+	p, err := r.Repository.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	r.set(ctx, p)
+	return clonePlace(p), nil
+}
+
+func (r *PlaceRepository) Update(ctx context.Context, p *domain.Place) error {
+	defer r.invalidate(ctx, p.ID)
+	return r.Repository.Update(ctx, p)
+}
+
+func (r *PlaceRepository) UpsertBySlug(ctx context.Context, p *domain.Place) (string, error) {
+	id, err := r.Repository.UpsertBySlug(ctx, p)
+	if err == nil {
+		r.invalidate(ctx, id)
+	}
+	return id, err
+}
+
+func (r *PlaceRepository) ChangeSlug(ctx context.Context, placeID, oldSlug, newSlug string) (bool, error) {
+	defer r.invalidate(ctx, placeID)
+	return r.Repository.ChangeSlug(ctx, placeID, oldSlug, newSlug)
+}
+
+func (r *PlaceRepository) Delete(ctx context.Context, p *domain.Place) error {
+	defer r.invalidate(ctx, p.ID)
+	return r.Repository.Delete(ctx, p)
+}
+
+func (r *PlaceRepository) AddImage(ctx context.Context, image *domain.PlaceImage) error {
+	defer r.invalidate(ctx, image.PlaceID)
+	return r.Repository.AddImage(ctx, image)
+}
+
+func (r *PlaceRepository) UpdateImage(ctx context.Context, image *domain.PlaceImage) error {
+	defer r.invalidate(ctx, image.PlaceID)
+	return r.Repository.UpdateImage(ctx, image)
+}
+
+func (r *PlaceRepository) DeleteImage(ctx context.Context, imageID string) error {
+	// The place is only known through the image, so look it up before it is gone
+	if image, err := r.Repository.GetImage(ctx, imageID); err == nil {
+		defer r.invalidate(ctx, image.PlaceID)
+	}
+	return r.Repository.DeleteImage(ctx, imageID)
+}
+
+func (r *PlaceRepository) DeleteImages(ctx context.Context, placeID string, imageIDs []string) (int, error) {
+	defer r.invalidate(ctx, placeID)
+	return r.Repository.DeleteImages(ctx, placeID, imageIDs)
+}
+
+func (r *PlaceRepository) RepackImagePositions(ctx context.Context, placeID string) error {
+	defer r.invalidate(ctx, placeID)
+	return r.Repository.RepackImagePositions(ctx, placeID)
+}
+
+func (r *PlaceRepository) SetPrimaryImageURL(ctx context.Context, placeID string, url *string) error {
+	defer r.invalidate(ctx, placeID)
+	return r.Repository.SetPrimaryImageURL(ctx, placeID, url)
+}
+
+func (r *PlaceRepository) UpdateRating(ctx context.Context, placeID string, newRating decimal.Decimal) error {
+	defer r.invalidate(ctx, placeID)
+	return r.Repository.UpdateRating(ctx, placeID, newRating)
+}
+
+func (r *PlaceRepository) AssignCategories(ctx context.Context, placeID string, categoryIDs []string) error {
+	defer r.invalidate(ctx, placeID)
+	return r.Repository.AssignCategories(ctx, placeID, categoryIDs)
+}
+
+func (r *PlaceRepository) UpdatePopularityScore(ctx context.Context, placeID string, score decimal.Decimal) error {
+	defer r.invalidate(ctx, placeID)
+	return r.Repository.UpdatePopularityScore(ctx, placeID, score)
+}
+
+// cached returns a copy of the place stored under id
+func (r *PlaceRepository) cached(ctx context.Context, id string) (*domain.Place, bool) {
+	defer timing.Since(ctx, timing.MetricCache, time.Now())
+
+	v, ok := r.store.Get(idKey(placeNamespace, id))
+	if !ok {
+		return nil, false
+	}
+	return clonePlace(v.(*domain.Place)), true
+}
+
+// lookupSlug returns the id cached under slug
+func (r *PlaceRepository) lookupSlug(ctx context.Context, slug string) (string, bool) {
+	defer timing.Since(ctx, timing.MetricCache, time.Now())
+
+	id, ok := r.store.Get(slugKey(placeNamespace, slug))
+	if !ok {
+		return "", false
+	}
+	return id.(string), true
+}
+
+func (r *PlaceRepository) set(ctx context.Context, p *domain.Place) {
+	defer timing.Since(ctx, timing.MetricCache, time.Now())
+
+	r.store.Set(idKey(placeNamespace, p.ID), clonePlace(p))
+	r.store.Set(slugKey(placeNamespace, p.Slug), p.ID)
+}
+
+// invalidate drops the place stored under id and retires every cached total once the write
+// in ctx has committed; before then a concurrent read could cache the row again as it was
+// before the write. The slug key is left behind: it only resolves to an id, and GetBySlug
+// ignores ids whose place is missing or renamed.
+func (r *PlaceRepository) invalidate(ctx context.Context, id string) {
+	postgres.AfterCommit(ctx, func() {
+		r.log.Debugw("invalidating cached place", "place_id", id)
+		r.store.Delete(idKey(placeNamespace, id))
+		r.countGeneration.Add(1)
+	})
+}
+
+// clonePlace copies p deeply enough that callers can modify the copy, its address
+// and its images without touching the cached value
+func clonePlace(p *domain.Place) *domain.Place {
+	clone := *p
+	if p.Address != nil {
+		clone.Address = lo.Assign(p.Address)
+	}
+	if p.Images != nil {
+		clone.Images = make([]*domain.PlaceImage, len(p.Images))
+		for i, img := range p.Images {
+			copied := *img
+			if img.Metadata != nil {
+				metadata := lo.Assign(*img.Metadata)
+				copied.Metadata = &metadata
+			}
+			clone.Images[i] = &copied
+		}
+	}
+	return &clone
+}
@@ -0,0 +1,92 @@
+package cached
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/timing"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+const placeCountNamespace = "place_count"
+
+// Count serves place totals from the cache so paging through a listing does not recount on
+// every page. The first page always counts and refreshes the entry, so a client starting a
+// listing sees a fresh total; later pages reuse it until it expires or a place is written.
+func (r *PlaceRepository) Count(ctx context.Context, filter *types.PlaceFilter) (int, error) {
+	if inTransaction(ctx) {
+		return r.Repository.Count(ctx, filter)
+	}
+
+	key, ok := r.countKey(filter)
+	if !ok {
+		return r.Repository.Count(ctx, filter)
+	}
+
+	if filter.GetOffset() > 0 {
+		if v, ok := r.lookupCount(ctx, key); ok {
+			r.store.RecordLookup(placeCountNamespace, true)
+			return v.(int), nil
+		}
+		r.store.RecordLookup(placeCountNamespace, false)
+	}
+
+	count, err := r.Repository.Count(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	r.storeCount(ctx, key, count)
+	return count, nil
+}
+
+func (r *PlaceRepository) lookupCount(ctx context.Context, key string) (any, bool) {
+	defer timing.Since(ctx, timing.MetricCache, time.Now())
+	return r.store.Get(key)
+}
+
+func (r *PlaceRepository) storeCount(ctx context.Context, key string, count int) {
+	defer timing.Since(ctx, timing.MetricCache, time.Now())
+	r.store.SetWithTTL(key, count, r.countTTL)
+}
+
+// countFingerprint is the part of a place filter that decides its total. Pagination, sort
+// order, collation and expansions do not change the count and are left out, so every page and sort of a
+// listing shares one entry. A reference point only matters through its coordinates and radius:
+// ordering by distance or rank reuses the entry, except that rank=best counts the exact radius
+// rather than the bounding box and so is kept apart.
+type countFingerprint struct {
+	Filter           types.PlaceFilter `json:"filter"`
+	Status           string            `json:"status"`
+	ExactRadius      bool              `json:"exact_radius"`
+	IncludeScheduled bool              `json:"include_scheduled"`
+}
+
+// countKey returns the cache key of the filter's total under the current write generation
+func (r *PlaceRepository) countKey(filter *types.PlaceFilter) (string, bool) {
+	if filter == nil {
+		filter = types.NewPlaceFilter()
+	}
+
+	fp := countFingerprint{
+		Filter:           *filter,
+		Status:           filter.GetStatus(),
+		ExactRadius:      filter.IsRankedNearby(),
+		IncludeScheduled: filter.IncludeScheduled,
+	}
+	fp.Filter.QueryFilter = nil
+	fp.Filter.Rank = ""
+	fp.Filter.Collation = ""
+
+	raw, err := json.Marshal(fp)
+	if err != nil {
+		return "", false
+	}
+	sum := sha256.Sum256(raw)
+
+	generation := strconv.FormatUint(r.countGeneration.Load(), 10)
+	return placeCountNamespace + ":" + generation + ":" + hex.EncodeToString(sum[:]), true
+}
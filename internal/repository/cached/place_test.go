@@ -0,0 +1,124 @@
+package cached
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/omkar273/nashikdarshan/ent"
+	"github.com/omkar273/nashikdarshan/internal/cache"
+	domain "github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/logger"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"go.uber.org/zap"
+)
+
+// stubDriver hands out transactions that run no statements
+type stubDriver struct{}
+
+func (stubDriver) Exec(context.Context, string, any, any) error  { return nil }
+func (stubDriver) Query(context.Context, string, any, any) error { return nil }
+func (stubDriver) Close() error                                  { return nil }
+func (stubDriver) Dialect() string                               { return dialect.Postgres }
+
+func (d stubDriver) Tx(context.Context) (dialect.Tx, error) {
+	return stubTx{stubDriver: d}, nil
+}
+
+type stubTx struct {
+	stubDriver
+}
+
+func (stubTx) Commit() error   { return nil }
+func (stubTx) Rollback() error { return nil }
+
+// memoryPlaceRepo is the wrapped repository: it keeps places in a map and counts reads
+type memoryPlaceRepo struct {
+	domain.Repository
+	places map[string]*domain.Place
+	gets   int
+}
+
+func (r *memoryPlaceRepo) Get(_ context.Context, id string) (*domain.Place, error) {
+	r.gets++
+	p := *r.places[id]
+	return &p, nil
+}
+
+func (r *memoryPlaceRepo) Update(_ context.Context, p *domain.Place) error {
+	stored := *p
+	r.places[p.ID] = &stored
+	return nil
+}
+
+func TestPlaceRepositoryInvalidation(t *testing.T) {
+	tests := []struct {
+		name string
+		// end finishes the transaction the update ran in; nil updates outside one
+		end        func(*ent.Tx) error
+		wantTitle  string
+		wantHits   uint64
+		wantMisses uint64
+	}{
+		{name: "update outside a transaction", wantTitle: "Kalaram Temple", wantMisses: 2},
+		{name: "committed update", end: (*ent.Tx).Commit, wantTitle: "Kalaram Temple", wantMisses: 2},
+		// The cached entry survives and is served without reading through
+		{name: "rolled back update", end: (*ent.Tx).Rollback, wantTitle: "Kalaram Mandir", wantHits: 1, wantMisses: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			inner := &memoryPlaceRepo{places: map[string]*domain.Place{
+				"plc_1": {ID: "plc_1", Slug: "kalaram", Title: "Kalaram Mandir"},
+			}}
+			store := cache.NewStore(true, time.Minute, 100)
+			repo := NewPlaceRepository(inner, store, time.Minute, &logger.Logger{SugaredLogger: zap.NewNop().Sugar()})
+
+			if _, err := repo.Get(ctx, "plc_1"); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+
+			writeCtx := ctx
+			var tx *ent.Tx
+			if tt.end != nil {
+				var err error
+				tx, err = ent.NewClient(ent.Driver(stubDriver{})).Tx(ctx)
+				if err != nil {
+					t.Fatalf("starting transaction: %v", err)
+				}
+				writeCtx = context.WithValue(ctx, types.CtxDBTransaction, tx)
+			}
+
+			updated := &domain.Place{ID: "plc_1", Slug: "kalaram", Title: "Kalaram Temple"}
+			if err := repo.Update(writeCtx, updated); err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+			if tx != nil {
+				if _, ok := store.Get(idKey(placeNamespace, "plc_1")); !ok {
+					t.Error("entry dropped before the transaction ended")
+				}
+				if err := tt.end(tx); err != nil {
+					t.Fatalf("ending transaction: %v", err)
+				}
+			}
+
+			got, err := repo.Get(ctx, "plc_1")
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if got.Title != tt.wantTitle {
+				t.Errorf("Get() title = %q, want %q", got.Title, tt.wantTitle)
+			}
+
+			stats := store.Stats().Namespaces[placeNamespace]
+			if stats.Hits != tt.wantHits || stats.Misses != tt.wantMisses {
+				t.Errorf("lookups = %d hits, %d misses, want %d hits, %d misses", stats.Hits, stats.Misses, tt.wantHits, tt.wantMisses)
+			}
+			if inner.gets != int(tt.wantMisses) {
+				t.Errorf("wrapped repository read %d times, want %d", inner.gets, tt.wantMisses)
+			}
+		})
+	}
+}
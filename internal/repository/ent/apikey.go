@@ -0,0 +1,201 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/ent"
+	"github.com/omkar273/nashikdarshan/ent/apikey"
+	domain "github.com/omkar273/nashikdarshan/internal/domain/apikey"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/logger"
+	"github.com/omkar273/nashikdarshan/internal/postgres"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+type APIKeyRepository struct {
+	client postgres.IClient
+	log    logger.Logger
+}
+
+func NewAPIKeyRepository(client postgres.IClient, logger *logger.Logger) domain.Repository {
+	return &APIKeyRepository{
+		client: client,
+		log:    *logger,
+	}
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, k *domain.APIKey) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating api key",
+		"api_key_id", k.ID,
+		"label", k.Label,
+		"key_prefix", k.KeyPrefix,
+	)
+
+	create := client.APIKey.Create().
+		SetID(k.ID).
+		SetLabel(k.Label).
+		SetKeyHash(k.KeyHash).
+		SetKeyPrefix(k.KeyPrefix).
+		SetScopes(k.Scopes).
+		SetStatus(k.Status).
+		SetCreatedAt(k.CreatedAt).
+		SetUpdatedAt(k.UpdatedAt).
+		SetCreatedBy(k.CreatedBy).
+		SetUpdatedBy(k.UpdatedBy)
+
+	if k.ExpiresAt != nil {
+		create = create.SetExpiresAt(*k.ExpiresAt)
+	}
+
+	_, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("API key already exists").
+				WithReportableDetails(map[string]any{
+					"api_key_id": k.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create API key").
+			WithReportableDetails(map[string]any{
+				"api_key_id": k.ID,
+				"label":      k.Label,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *APIKeyRepository) Get(ctx context.Context, id string) (*domain.APIKey, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting api key", "api_key_id", id)
+
+	entKey, err := client.APIKey.Query().
+		Where(apikey.ID(id)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("API key with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"api_key_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get API key").
+			WithReportableDetails(map[string]any{
+				"api_key_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domain.FromEnt(entKey), nil
+}
+
+// GetByHash looks up a published API key by the hash of its raw value
+func (r *APIKeyRepository) GetByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	client := r.client.Querier(ctx)
+
+	entKey, err := client.APIKey.Query().
+		Where(
+			apikey.KeyHash(keyHash),
+			apikey.Status(types.StatusPublished),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHint("API key was not found").
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get API key").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domain.FromEnt(entKey), nil
+}
+
+func (r *APIKeyRepository) List(ctx context.Context) ([]*domain.APIKey, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing api keys")
+
+	keys, err := client.APIKey.Query().
+		Where(apikey.StatusNEQ(types.StatusDeleted)).
+		Order(ent.Desc(apikey.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list API keys").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domain.FromEntList(keys), nil
+}
+
+// Revoke marks an API key as revoked so it can no longer authenticate
+func (r *APIKeyRepository) Revoke(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("revoking api key", "api_key_id", id)
+
+	now := time.Now().UTC()
+	_, err := client.APIKey.UpdateOneID(id).
+		Where(apikey.StatusEQ(types.StatusPublished)).
+		SetStatus(types.StatusArchived).
+		SetRevokedAt(now).
+		SetUpdatedAt(now).
+		SetUpdatedBy(types.GetActor(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Active API key with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"api_key_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to revoke API key").
+			WithReportableDetails(map[string]any{
+				"api_key_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+// TouchLastUsed records when an API key was last used
+func (r *APIKeyRepository) TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	client := r.client.Querier(ctx)
+
+	err := client.APIKey.UpdateOneID(id).
+		SetLastUsedAt(usedAt).
+		Exec(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to update API key usage").
+			WithReportableDetails(map[string]any{
+				"api_key_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
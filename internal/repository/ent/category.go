@@ -40,7 +40,7 @@ func (r *CategoryRepository) Create(ctx context.Context, c *domain.Category) err
 		SetID(c.ID).
 		SetName(c.Name).
 		SetSlug(c.Slug).
-		SetStatus(string(c.Status)).
+		SetStatus(c.Status).
 		SetDescription(c.Description).
 		SetCreatedAt(c.CreatedAt).
 		SetUpdatedAt(c.UpdatedAt).
@@ -57,12 +57,13 @@ func (r *CategoryRepository) Create(ctx context.Context, c *domain.Category) err
 	_, err := create.Save(ctx)
 
 	if err != nil {
-		if ent.IsConstraintError(err) {
+		if constraint, ok := postgres.UniqueViolation(err); ok {
 			return ierr.WithError(err).
-				WithHint("Category with this slug already exists").
+				WithHint(categoryConflictHint(constraint)).
 				WithReportableDetails(map[string]any{
 					"category_id": c.ID,
 					"slug":        c.Slug,
+					"name":        c.Name,
 				}).
 				Mark(ierr.ErrAlreadyExists)
 		}
@@ -79,6 +80,15 @@ func (r *CategoryRepository) Create(ctx context.Context, c *domain.Category) err
 }
 
 func (r *CategoryRepository) Get(ctx context.Context, id string) (*domain.Category, error) {
+	return r.get(ctx, id, false)
+}
+
+// GetIncludingArchived is Get, except that an archived category is returned so it can be restored
+func (r *CategoryRepository) GetIncludingArchived(ctx context.Context, id string) (*domain.Category, error) {
+	return r.get(ctx, id, true)
+}
+
+func (r *CategoryRepository) get(ctx context.Context, id string, includeArchived bool) (*domain.Category, error) {
 	client := r.client.Querier(ctx)
 
 	r.log.Debugw("getting category", "category_id", id)
@@ -104,9 +114,47 @@ func (r *CategoryRepository) Get(ctx context.Context, id string) (*domain.Catego
 			Mark(ierr.ErrDatabase)
 	}
 
+	// The lookup includes soft-deleted rows so a deleted category is told apart from an unknown ID
+	if entCategory.Status.IsSoftDeleted() && !(includeArchived && entCategory.Status == types.StatusArchived) {
+		return nil, ierr.NewErrorf("category %s has been deleted", id).
+			WithHintf("Category with ID %s has been deleted", id).
+			WithReportableDetails(map[string]any{
+				"category_id": id,
+			}).
+			Mark(ierr.ErrGone)
+	}
+
 	return domain.FromEnt(entCategory), nil
 }
 
+// ExistsByName reports whether a non-deleted category other than excludeID has the name, ignoring case
+func (r *CategoryRepository) ExistsByName(ctx context.Context, name string, excludeID string) (bool, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("checking category name", "name", name, "exclude_id", excludeID)
+
+	query := client.Category.Query().
+		Where(
+			category.NameEqualFold(name),
+			category.StatusNEQ(types.StatusDeleted),
+		)
+	if excludeID != "" {
+		query = query.Where(category.IDNEQ(excludeID))
+	}
+
+	exists, err := query.Exist(ctx)
+	if err != nil {
+		return false, ierr.WithError(err).
+			WithHint("Failed to check category name").
+			WithReportableDetails(map[string]any{
+				"name": name,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return exists, nil
+}
+
 func (r *CategoryRepository) GetBySlug(ctx context.Context, slug string) (*domain.Category, error) {
 	client := r.client.Querier(ctx)
 
@@ -115,7 +163,7 @@ func (r *CategoryRepository) GetBySlug(ctx context.Context, slug string) (*domai
 	entCategory, err := client.Category.Query().
 		Where(
 			category.Slug(slug),
-			category.Status(string(types.StatusPublished)),
+			category.Status(types.StatusPublished),
 		).
 		Only(ctx)
 
@@ -219,9 +267,9 @@ func (r *CategoryRepository) Update(ctx context.Context, c *domain.Category) err
 		SetName(c.Name).
 		SetSlug(c.Slug).
 		SetDescription(c.Description).
-		SetStatus(string(c.Status)).
+		SetStatus(c.Status).
 		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx))
+		SetUpdatedBy(types.GetActor(ctx))
 
 	if c.Metadata != nil {
 		update = update.SetMetadata(c.Metadata.ToMap())
@@ -238,12 +286,13 @@ func (r *CategoryRepository) Update(ctx context.Context, c *domain.Category) err
 				}).
 				Mark(ierr.ErrNotFound)
 		}
-		if ent.IsConstraintError(err) {
+		if constraint, ok := postgres.UniqueViolation(err); ok {
 			return ierr.WithError(err).
-				WithHint("Category with this slug already exists").
+				WithHint(categoryConflictHint(constraint)).
 				WithReportableDetails(map[string]any{
 					"category_id": c.ID,
 					"slug":        c.Slug,
+					"name":        c.Name,
 				}).
 				Mark(ierr.ErrAlreadyExists)
 		}
@@ -266,9 +315,9 @@ func (r *CategoryRepository) Delete(ctx context.Context, c *domain.Category) err
 	)
 
 	_, err := client.Category.UpdateOneID(c.ID).
-		SetStatus(string(types.StatusArchived)).
+		SetStatus(types.StatusArchived).
 		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx)).
+		SetUpdatedBy(types.GetActor(ctx)).
 		Save(ctx)
 
 	if err != nil {
@@ -302,9 +351,9 @@ var _ BaseQueryOptions[CategoryQuery] = (*CategoryQueryOptions)(nil)
 
 func (o CategoryQueryOptions) ApplyStatusFilter(query CategoryQuery, status string) CategoryQuery {
 	if status == "" {
-		return query.Where(category.StatusNotIn(string(types.StatusDeleted)))
+		return query.Where(category.StatusNotIn(types.SoftDeletedStatuses...))
 	}
-	return query.Where(category.Status(status))
+	return query.Where(category.Status(types.Status(status)))
 }
 
 func (o CategoryQueryOptions) ApplySortFilter(query CategoryQuery, field string, order string) CategoryQuery {
@@ -349,6 +398,8 @@ func (o CategoryQueryOptions) GetFieldName(field string) string {
 		return category.FieldName
 	case "slug":
 		return category.FieldSlug
+	case "id":
+		return category.FieldID
 	default:
 		return field
 	}
@@ -383,5 +434,28 @@ func (o CategoryQueryOptions) ApplyEntityQueryOptions(
 		}
 	}
 
+	// Apply contributor filters if specified
+	if f.CreatedBy != nil {
+		query = query.Where(category.CreatedBy(*f.CreatedBy))
+	}
+	if f.UpdatedBy != nil {
+		query = query.Where(category.UpdatedBy(*f.UpdatedBy))
+	}
+	if f.UpdatedSince != nil {
+		query = query.Where(category.UpdatedAtGTE(*f.UpdatedSince))
+	}
+
 	return query
 }
+
+// categoryConflictHint names the field a category duplicates, given the violated unique index
+func categoryConflictHint(constraint string) string {
+	switch constraint {
+	case postgres.CategorySlugIndex:
+		return "Category with this slug already exists"
+	case postgres.CategoryNameUniqueIndex:
+		return "Category with this name already exists"
+	default:
+		return "Category with this slug or name already exists"
+	}
+}
@@ -0,0 +1,154 @@
+package ent
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"math"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/cockroachdb/errors"
+	"github.com/omkar273/nashikdarshan/ent"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/logger"
+	"go.uber.org/zap"
+)
+
+// cannedConnector answers every query with the same rows, as a database would for the
+// statement under test
+type cannedConnector struct {
+	rows    [][]driver.Value
+	queries *int
+	// firstArg is the first argument of the last query
+	firstArg *driver.Value
+}
+
+func (c cannedConnector) Connect(context.Context) (driver.Conn, error) { return cannedConn(c), nil }
+func (c cannedConnector) Driver() driver.Driver                        { return nil }
+
+type cannedConn cannedConnector
+
+func (cannedConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare not supported")
+}
+func (cannedConn) Close() error              { return nil }
+func (cannedConn) Begin() (driver.Tx, error) { return nil, errors.New("transactions not supported") }
+
+func (c cannedConn) QueryContext(_ context.Context, _ string, args []driver.NamedValue) (driver.Rows, error) {
+	*c.queries++
+	if len(args) > 0 {
+		*c.firstArg = args[0].Value
+	}
+	return &cannedRows{rows: c.rows}, nil
+}
+
+type cannedRows struct {
+	rows [][]driver.Value
+}
+
+func (r *cannedRows) Columns() []string { return []string{"ids", "lng", "lat"} }
+func (r *cannedRows) Close() error      { return nil }
+
+func (r *cannedRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+// cannedClient runs every query on client outside a transaction
+type cannedClient struct {
+	client *ent.Client
+}
+
+func (c cannedClient) WithTx(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+func (cannedClient) TxFromContext(context.Context) *ent.Tx { return nil }
+func (c cannedClient) Querier(context.Context) *ent.Client { return c.client }
+
+func TestPlaceRepositoryCentroid(t *testing.T) {
+	tests := []struct {
+		name        string
+		ids         []string
+		row         []driver.Value
+		wantQueries int
+		wantIDsArg  driver.Value
+		wantLngLat  [2]float64
+		wantHint    string
+		wantErr     func(error) bool
+	}{
+		{
+			name:        "all found",
+			ids:         []string{"plc_ramkund", "plc_trimbak", "plc_sula"},
+			row:         []driver.Value{"{plc_ramkund,plc_trimbak,plc_sula}", 73.669478, 19.981999},
+			wantQueries: 1,
+			wantLngLat:  [2]float64{73.669478, 19.981999},
+		},
+		{
+			name:        "duplicate ids",
+			ids:         []string{"plc_ramkund", "plc_ramkund"},
+			row:         []driver.Value{"{plc_ramkund}", 73.7920, 20.0077},
+			wantQueries: 1,
+			wantIDsArg:  `{"plc_ramkund"}`,
+			wantLngLat:  [2]float64{73.7920, 20.0077},
+		},
+		{
+			name:        "missing places",
+			ids:         []string{"plc_ramkund", "plc_gone", "plc_unknown"},
+			row:         []driver.Value{"{plc_ramkund}", 73.7920, 20.0077},
+			wantQueries: 1,
+			wantHint:    "2 of the places were not found",
+			wantErr:     ierr.IsNotFound,
+		},
+		{
+			name:        "nothing found",
+			ids:         []string{"plc_unknown"},
+			row:         []driver.Value{nil, nil, nil},
+			wantQueries: 1,
+			wantHint:    "1 of the places were not found",
+			wantErr:     ierr.IsNotFound,
+		},
+		{name: "no ids", wantErr: ierr.IsValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				queries int
+				idsArg  driver.Value
+			)
+			db := sql.OpenDB(cannedConnector{rows: [][]driver.Value{tt.row}, queries: &queries, firstArg: &idsArg})
+			client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
+			repo := NewPlaceRepository(cannedClient{client: client}, &logger.Logger{SugaredLogger: zap.NewNop().Sugar()})
+
+			got, err := repo.Centroid(context.Background(), tt.ids)
+			if queries != tt.wantQueries {
+				t.Errorf("ran %d queries, want %d", queries, tt.wantQueries)
+			}
+			if tt.wantIDsArg != nil && idsArg != tt.wantIDsArg {
+				t.Errorf("queried ids %v, want %v", idsArg, tt.wantIDsArg)
+			}
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Fatalf("Centroid() error = %v", err)
+				}
+				if hint := errors.FlattenHints(err); tt.wantHint != "" && hint != tt.wantHint {
+					t.Errorf("hint = %q, want %q", hint, tt.wantHint)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Centroid() error = %v", err)
+			}
+			if !got.IsValid() || math.Abs(got.Coordinates[0]-tt.wantLngLat[0]) > 1e-9 || math.Abs(got.Coordinates[1]-tt.wantLngLat[1]) > 1e-9 {
+				t.Errorf("Centroid() = %+v, want %v", got, tt.wantLngLat)
+			}
+		})
+	}
+}
@@ -50,11 +50,11 @@ func (r *EventRepository) Create(ctx context.Context, e *domain.Event) error {
 		SetType(string(e.Type)).
 		SetTitle(e.Title).
 		SetStartDate(e.StartDate).
-		SetStatus(string(e.Status)).
+		SetStatus(e.Status).
 		SetCreatedAt(now).
 		SetUpdatedAt(now).
-		SetCreatedBy(types.GetUserID(ctx)).
-		SetUpdatedBy(types.GetUserID(ctx))
+		SetCreatedBy(types.GetActor(ctx)).
+		SetUpdatedBy(types.GetActor(ctx))
 
 	if e.Subtitle != nil {
 		create = create.SetSubtitle(*e.Subtitle)
@@ -159,7 +159,7 @@ func (r *EventRepository) GetBySlug(ctx context.Context, slug string) (*domain.E
 	entEvent, err := client.Event.Query().
 		Where(
 			event.Slug(slug),
-			event.StatusEQ(string(types.StatusPublished)),
+			event.StatusEQ(types.StatusPublished),
 		).
 		WithOccurrences().
 		Only(ctx)
@@ -248,9 +248,9 @@ func (r *EventRepository) Update(ctx context.Context, e *domain.Event) error {
 		SetType(string(e.Type)).
 		SetTitle(e.Title).
 		SetStartDate(e.StartDate).
-		SetStatus(string(e.Status)).
+		SetStatus(e.Status).
 		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx))
+		SetUpdatedBy(types.GetActor(ctx))
 
 	if e.Subtitle != nil {
 		update = update.SetSubtitle(*e.Subtitle)
@@ -347,9 +347,9 @@ func (r *EventRepository) Delete(ctx context.Context, id string) error {
 	r.log.Debugw("deleting event (soft)", "event_id", id)
 
 	_, err := client.Event.UpdateOneID(id).
-		SetStatus(string(types.StatusArchived)).
+		SetStatus(types.StatusArchived).
 		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx)).
+		SetUpdatedBy(types.GetActor(ctx)).
 		Save(ctx)
 
 	if err != nil {
@@ -372,6 +372,47 @@ func (r *EventRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// ListActiveInWindow returns published events whose validity window overlaps [from, to]
+func (r *EventRepository) ListActiveInWindow(ctx context.Context, placeID *string, from, to time.Time) ([]*domain.Event, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing active events in window",
+		"place_id", placeID,
+		"from", from,
+		"to", to,
+	)
+
+	query := client.Event.Query().
+		Where(
+			event.StatusEQ(types.StatusPublished),
+			event.StartDateLTE(to),
+			event.Or(
+				event.EndDateIsNil(),
+				event.EndDateGTE(from),
+			),
+		).
+		WithOccurrences(func(q *ent.EventOccurrenceQuery) {
+			q.Where(eventoccurrence.StatusEQ(types.StatusPublished))
+		}).
+		Order(ent.Asc(event.FieldStartDate), ent.Asc(event.FieldID))
+
+	if placeID != nil {
+		query = query.Where(event.PlaceID(*placeID))
+	}
+
+	events, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list upcoming events").
+			WithReportableDetails(map[string]any{
+				"place_id": placeID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domain.FromEntList(events), nil
+}
+
 // ========== EventOccurrence CRUD ==========
 
 func (r *EventRepository) CreateOccurrence(ctx context.Context, occ *domain.EventOccurrence) error {
@@ -388,11 +429,11 @@ func (r *EventRepository) CreateOccurrence(ctx context.Context, occ *domain.Even
 		SetID(occ.ID).
 		SetEventID(occ.EventID).
 		SetRecurrenceType(string(occ.RecurrenceType)).
-		SetStatus(string(occ.Status)).
+		SetStatus(occ.Status).
 		SetCreatedAt(now).
 		SetUpdatedAt(now).
-		SetCreatedBy(types.GetUserID(ctx)).
-		SetUpdatedBy(types.GetUserID(ctx))
+		SetCreatedBy(types.GetActor(ctx)).
+		SetUpdatedBy(types.GetActor(ctx))
 
 	if occ.StartTime != nil {
 		create = create.SetStartTime(*occ.StartTime)
@@ -477,9 +518,9 @@ func (r *EventRepository) ListOccurrences(ctx context.Context, filter *types.Occ
 
 	// Apply status filter (default: only published)
 	if filter.Status != nil {
-		query = query.Where(eventoccurrence.StatusEQ(string(*filter.Status)))
+		query = query.Where(eventoccurrence.StatusEQ(*filter.Status))
 	} else {
-		query = query.Where(eventoccurrence.StatusEQ(string(types.StatusPublished)))
+		query = query.Where(eventoccurrence.StatusEQ(types.StatusPublished))
 	}
 
 	// Filter by event ID if specified
@@ -529,9 +570,9 @@ func (r *EventRepository) UpdateOccurrence(ctx context.Context, occ *domain.Even
 
 	update := client.EventOccurrence.UpdateOneID(occ.ID).
 		SetRecurrenceType(string(occ.RecurrenceType)).
-		SetStatus(string(occ.Status)).
+		SetStatus(occ.Status).
 		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx))
+		SetUpdatedBy(types.GetActor(ctx))
 
 	if occ.StartTime != nil {
 		update = update.SetStartTime(*occ.StartTime)
@@ -602,9 +643,9 @@ func (r *EventRepository) DeleteOccurrence(ctx context.Context, id string) error
 	r.log.Debugw("deleting event occurrence (soft)", "occurrence_id", id)
 
 	_, err := client.EventOccurrence.UpdateOneID(id).
-		SetStatus(string(types.StatusArchived)).
+		SetStatus(types.StatusArchived).
 		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx)).
+		SetUpdatedBy(types.GetActor(ctx)).
 		Save(ctx)
 
 	if err != nil {
@@ -704,9 +745,9 @@ func (o EventQueryOptions) ApplyStatusFilter(query EventQuery, status string) Ev
 	if status == "" {
 		// By default, exclude archived and deleted items from queries
 		// Archived can be restored, deleted is permanent
-		return query.Where(event.StatusNotIn(string(types.StatusArchived), string(types.StatusDeleted)))
+		return query.Where(event.StatusNotIn(types.StatusArchived, types.StatusDeleted))
 	}
-	return query.Where(event.Status(status))
+	return query.Where(event.Status(types.Status(status)))
 }
 
 func (o EventQueryOptions) ApplySortFilter(query EventQuery, field string, order string) EventQuery {
@@ -759,6 +800,8 @@ func (o EventQueryOptions) GetFieldName(field string) string {
 		return event.FieldInterestedCount
 	case "title":
 		return event.FieldTitle
+	case "id":
+		return event.FieldID
 	default:
 		return event.FieldStartDate // Default to start date
 	}
@@ -48,11 +48,11 @@ func (r *HotelRepository) Create(ctx context.Context, h *domain.Hotel) error {
 		SetRoomCount(h.RoomCount).
 		SetLatitude(h.Location.Latitude).
 		SetLongitude(h.Location.Longitude).
-		SetStatus(string(h.Status)).
+		SetStatus(h.Status).
 		SetCreatedAt(now).
 		SetUpdatedAt(now).
-		SetCreatedBy(types.GetUserID(ctx)).
-		SetUpdatedBy(types.GetUserID(ctx))
+		SetCreatedBy(types.GetActor(ctx)).
+		SetUpdatedBy(types.GetActor(ctx))
 
 	if h.Description != nil {
 		create = create.SetDescription(*h.Description)
@@ -153,7 +153,7 @@ func (r *HotelRepository) GetBySlug(ctx context.Context, slug string) (*domain.H
 	entHotel, err := client.Hotel.Query().
 		Where(
 			hotel.Slug(slug),
-			hotel.Status(string(types.StatusPublished)),
+			hotel.Status(types.StatusPublished),
 		).
 		Only(ctx)
 
@@ -317,9 +317,9 @@ func (r *HotelRepository) Update(ctx context.Context, h *domain.Hotel) error {
 		SetRoomCount(h.RoomCount).
 		SetLatitude(h.Location.Latitude).
 		SetLongitude(h.Location.Longitude).
-		SetStatus(string(h.Status)).
+		SetStatus(h.Status).
 		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx))
+		SetUpdatedBy(types.GetActor(ctx))
 
 	if h.Description != nil {
 		update = update.SetDescription(*h.Description)
@@ -415,9 +415,9 @@ func (r *HotelRepository) Delete(ctx context.Context, h *domain.Hotel) error {
 	)
 
 	_, err := client.Hotel.UpdateOneID(h.ID).
-		SetStatus(string(types.StatusArchived)).
+		SetStatus(types.StatusArchived).
 		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx)).
+		SetUpdatedBy(types.GetActor(ctx)).
 		Save(ctx)
 
 	if err != nil {
@@ -452,7 +452,7 @@ func (r *HotelRepository) IncrementViewCount(ctx context.Context, hotelID string
 		AddViewCount(1).
 		SetLastViewedAt(now).
 		SetUpdatedAt(now).
-		SetUpdatedBy(types.GetUserID(ctx)).
+		SetUpdatedBy(types.GetActor(ctx)).
 		Save(ctx)
 
 	if err != nil {
@@ -493,7 +493,7 @@ func (r *HotelRepository) UpdateRating(ctx context.Context, hotelID string, newR
 		SetRatingAvg(newAvg).
 		SetRatingCount(newCount).
 		SetUpdatedAt(now).
-		SetUpdatedBy(types.GetUserID(ctx)).
+		SetUpdatedBy(types.GetActor(ctx)).
 		Save(ctx)
 
 	if err != nil {
@@ -516,7 +516,7 @@ func (r *HotelRepository) UpdatePopularityScore(ctx context.Context, hotelID str
 	_, err := client.Hotel.UpdateOneID(hotelID).
 		SetPopularityScore(score).
 		SetUpdatedAt(now).
-		SetUpdatedBy(types.GetUserID(ctx)).
+		SetUpdatedBy(types.GetActor(ctx)).
 		Save(ctx)
 
 	if err != nil {
@@ -541,9 +541,9 @@ func (o HotelQueryOptions) ApplyStatusFilter(query HotelQuery, status string) Ho
 	if status == "" {
 		// By default, exclude archived and deleted items from queries
 		// Archived can be restored, deleted is permanent
-		return query.Where(hotel.StatusNotIn(string(types.StatusArchived), string(types.StatusDeleted)))
+		return query.Where(hotel.StatusNotIn(types.StatusArchived, types.StatusDeleted))
 	}
-	return query.Where(hotel.Status(status))
+	return query.Where(hotel.Status(types.Status(status)))
 }
 
 func (o HotelQueryOptions) ApplySortFilter(query HotelQuery, field string, order string) HotelQuery {
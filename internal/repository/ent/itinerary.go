@@ -60,11 +60,11 @@ func (r *ItineraryRepository) Create(ctx context.Context, itin *domain.Itinerary
 		SetStartLongitude(itin.StartLocation.Longitude).
 		SetPreferredTransportMode(string(itin.TransportMode)).
 		SetIsOptimized(itin.IsOptimized).
-		SetStatus(string(itin.Status)).
+		SetStatus(itin.Status).
 		SetCreatedAt(now).
 		SetUpdatedAt(now).
-		SetCreatedBy(types.GetUserID(ctx)).
-		SetUpdatedBy(types.GetUserID(ctx))
+		SetCreatedBy(types.GetActor(ctx)).
+		SetUpdatedBy(types.GetActor(ctx))
 
 	if itin.Description != nil {
 		create = create.SetDescription(*itin.Description)
@@ -98,11 +98,11 @@ func (r *ItineraryRepository) Create(ctx context.Context, itin *domain.Itinerary
 			SetPlaceID(v.PlaceID).
 			SetSequenceOrder(v.SequenceOrder).
 			SetPlannedDurationMinutes(v.PlannedDurationMinutes).
-			SetStatus(string(v.Status)).
+			SetStatus(v.Status).
 			SetCreatedAt(now).
 			SetUpdatedAt(now).
-			SetCreatedBy(types.GetUserID(ctx)).
-			SetUpdatedBy(types.GetUserID(ctx))
+			SetCreatedBy(types.GetActor(ctx)).
+			SetUpdatedBy(types.GetActor(ctx))
 
 		if v.DistanceFromPreviousKm != nil {
 			visitCreate = visitCreate.SetDistanceFromPreviousKm(*v.DistanceFromPreviousKm)
@@ -211,12 +211,10 @@ func (r *ItineraryRepository) List(ctx context.Context, filter *types.ItineraryF
 
 	// Apply pagination and sorting
 	if filter != nil && filter.QueryFilter != nil {
-		if filter.Limit != nil {
-			query = query.Limit(*filter.Limit)
-		}
-		if filter.Offset != nil {
-			query = query.Offset(*filter.Offset)
+		if !filter.IsUnlimited() {
+			query = query.Limit(filter.GetLimit())
 		}
+		query = query.Offset(filter.GetOffset())
 
 		// Apply sorting
 		if filter.Sort != nil {
@@ -283,9 +281,9 @@ func (r *ItineraryRepository) Update(ctx context.Context, itin *domain.Itinerary
 		SetStartLongitude(itin.StartLocation.Longitude).
 		SetPreferredTransportMode(string(itin.TransportMode)).
 		SetIsOptimized(itin.IsOptimized).
-		SetStatus(string(itin.Status)).
+		SetStatus(itin.Status).
 		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx))
+		SetUpdatedBy(types.GetActor(ctx))
 
 	if itin.Description != nil {
 		update = update.SetDescription(*itin.Description)
@@ -363,11 +361,11 @@ func (r *ItineraryRepository) CreateVisits(ctx context.Context, visits []*domain
 			SetPlaceID(v.PlaceID).
 			SetSequenceOrder(v.SequenceOrder).
 			SetPlannedDurationMinutes(v.PlannedDurationMinutes).
-			SetStatus(string(v.Status)).
+			SetStatus(v.Status).
 			SetCreatedAt(now).
 			SetUpdatedAt(now).
-			SetCreatedBy(types.GetUserID(ctx)).
-			SetUpdatedBy(types.GetUserID(ctx))
+			SetCreatedBy(types.GetActor(ctx)).
+			SetUpdatedBy(types.GetActor(ctx))
 
 		if v.DistanceFromPreviousKm != nil {
 			create = create.SetDistanceFromPreviousKm(*v.DistanceFromPreviousKm)
@@ -426,9 +424,9 @@ func (r *ItineraryRepository) UpdateVisit(ctx context.Context, v *domain.Visit)
 	update := client.Visit.UpdateOneID(v.ID).
 		SetSequenceOrder(v.SequenceOrder).
 		SetPlannedDurationMinutes(v.PlannedDurationMinutes).
-		SetStatus(string(v.Status)).
+		SetStatus(v.Status).
 		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx))
+		SetUpdatedBy(types.GetActor(ctx))
 
 	if v.DistanceFromPreviousKm != nil {
 		update = update.SetDistanceFromPreviousKm(*v.DistanceFromPreviousKm)
@@ -517,7 +515,7 @@ func (r *ItineraryRepository) applyFilters(query *ent.ItineraryQuery, filter *ty
 
 	// Apply base filter (status)
 	if filter.QueryFilter != nil && filter.QueryFilter.Status != nil {
-		predicates = append(predicates, itinerary.Status(string(*filter.QueryFilter.Status)))
+		predicates = append(predicates, itinerary.Status(*filter.QueryFilter.Status))
 	}
 
 	if len(predicates) > 0 {
@@ -0,0 +1,180 @@
+package ent
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/ent"
+	domain "github.com/omkar273/nashikdarshan/internal/domain/outbox"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/logger"
+	"github.com/omkar273/nashikdarshan/internal/postgres"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+type OutboxRepository struct {
+	client postgres.IClient
+	log    logger.Logger
+}
+
+func NewOutboxRepository(client postgres.IClient, logger *logger.Logger) domain.Repository {
+	return &OutboxRepository{
+		client: client,
+		log:    *logger,
+	}
+}
+
+func (r *OutboxRepository) Create(ctx context.Context, e *domain.Event) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("enqueueing outbox event",
+		"event_id", e.ID,
+		"event_type", e.EventType,
+		"aggregate_id", e.AggregateID,
+	)
+
+	_, err := client.OutboxEvent.Create().
+		SetID(e.ID).
+		SetEventType(string(e.EventType)).
+		SetAggregateType(e.AggregateType).
+		SetAggregateID(e.AggregateID).
+		SetPayload(e.Payload).
+		SetStatus(e.Status).
+		SetAvailableAt(e.AvailableAt).
+		SetCreatedAt(e.CreatedAt).
+		Save(ctx)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to record the change event").
+			WithReportableDetails(map[string]any{
+				"event_id":     e.ID,
+				"event_type":   e.EventType,
+				"aggregate_id": e.AggregateID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+// claimDueQuery leases due pending events in one statement. SKIP LOCKED lets concurrent
+// dispatchers claim disjoint batches; pushing available_at forward hides the claimed rows
+// until the lease runs out, so an event whose dispatcher dies is picked up again.
+const claimDueQuery = `
+UPDATE outbox_events
+SET available_at = $1, attempts = attempts + 1
+WHERE id IN (
+	SELECT id FROM outbox_events
+	WHERE status = $2 AND available_at <= $3
+	ORDER BY created_at
+	LIMIT $4
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING id, event_type, aggregate_type, aggregate_id, payload, status, attempts, created_at`
+
+func (r *OutboxRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*domain.Event, error) {
+	client := r.client.Querier(ctx)
+
+	now := types.NowUTC()
+	leasedUntil := now.Add(lease)
+
+	rows, err := client.QueryContext(ctx, claimDueQuery,
+		leasedUntil, string(types.OutboxStatusPending), now, limit)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to claim outbox events").
+			Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	events := make([]*domain.Event, 0, limit)
+	for rows.Next() {
+		e := &domain.Event{AvailableAt: leasedUntil}
+		var payload []byte
+		if err := rows.Scan(&e.ID, &e.EventType, &e.AggregateType, &e.AggregateID,
+			&payload, &e.Status, &e.Attempts, &e.CreatedAt); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to read claimed outbox events").
+				Mark(ierr.ErrDatabase)
+		}
+		e.Payload = payload
+		e.CreatedAt = e.CreatedAt.UTC()
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to read claimed outbox events").
+			Mark(ierr.ErrDatabase)
+	}
+
+	// RETURNING does not preserve the subquery order
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].CreatedAt.Before(events[j].CreatedAt)
+	})
+
+	return events, nil
+}
+
+func (r *OutboxRepository) MarkSent(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("marking outbox event sent", "event_id", id)
+
+	err := client.OutboxEvent.UpdateOneID(id).
+		SetStatus(types.OutboxStatusSent).
+		SetSentAt(types.NowUTC()).
+		ClearLastError().
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Outbox event %s was not found", id).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to mark outbox event sent").
+			WithReportableDetails(map[string]any{
+				"event_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id string, reason string, retryAt time.Time, dead bool) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("marking outbox event failed",
+		"event_id", id,
+		"retry_at", retryAt,
+		"dead", dead,
+	)
+
+	status := types.OutboxStatusPending
+	if dead {
+		status = types.OutboxStatusDead
+	}
+
+	err := client.OutboxEvent.UpdateOneID(id).
+		SetStatus(status).
+		SetLastError(reason).
+		SetAvailableAt(retryAt).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Outbox event %s was not found", id).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to mark outbox event failed").
+			WithReportableDetails(map[string]any{
+				"event_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
@@ -2,24 +2,37 @@ package ent
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"math"
 	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/lib/pq"
 	"github.com/omkar273/nashikdarshan/ent"
+	"github.com/omkar273/nashikdarshan/ent/category"
 	"github.com/omkar273/nashikdarshan/ent/place"
 	"github.com/omkar273/nashikdarshan/ent/placeimage"
+	"github.com/omkar273/nashikdarshan/ent/placerevision"
+	"github.com/omkar273/nashikdarshan/ent/placeslug"
+	"github.com/omkar273/nashikdarshan/ent/predicate"
 	domain "github.com/omkar273/nashikdarshan/internal/domain/place"
 	ierr "github.com/omkar273/nashikdarshan/internal/errors"
 	"github.com/omkar273/nashikdarshan/internal/logger"
 	"github.com/omkar273/nashikdarshan/internal/postgres"
 	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 )
 
 const (
-	// Earth radius in meters (WGS84)
-	earthRadiusM = 6371000.0
 	// Meters per degree at equator
 	metersPerDegreeLat = 111320.0
 )
@@ -46,27 +59,16 @@ func calculateBoundingBox(lat0, lng0, radiusM decimal.Decimal) (decimal.Decimal,
 // haversineDistance calculates distance between two points using Haversine formula
 // Returns distance in meters
 func haversineDistance(lat1, lng1, lat2, lng2 decimal.Decimal) float64 {
-	lat1Rad := lat1.Mul(decimal.NewFromFloat(math.Pi / 180.0)).InexactFloat64()
-	lng1Rad := lng1.Mul(decimal.NewFromFloat(math.Pi / 180.0)).InexactFloat64()
-	lat2Rad := lat2.Mul(decimal.NewFromFloat(math.Pi / 180.0)).InexactFloat64()
-	lng2Rad := lng2.Mul(decimal.NewFromFloat(math.Pi / 180.0)).InexactFloat64()
-
-	dlat := lat2Rad - lat1Rad
-	dlng := lng2Rad - lng1Rad
-
-	a := math.Sin(dlat/2)*math.Sin(dlat/2) +
-		math.Cos(lat1Rad)*math.Cos(lat2Rad)*
-			math.Sin(dlng/2)*math.Sin(dlng/2)
-
-	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
-
-	return earthRadiusM * c
+	return types.NewLocation(lat1, lng1).DistanceKm(*types.NewLocation(lat2, lng2)) * 1000
 }
 
 type PlaceRepository struct {
 	client    postgres.IClient
 	log       logger.Logger
 	queryOpts PlaceQueryOptions
+
+	// collations remembers which title collations exist in the database, by SQL name
+	collations sync.Map
 }
 
 func NewPlaceRepository(client postgres.IClient, logger *logger.Logger) domain.Repository {
@@ -87,6 +89,105 @@ func (r *PlaceRepository) Create(ctx context.Context, p *domain.Place) error {
 		"place_type", p.PlaceType,
 	)
 
+	_, err := r.newCreate(ctx, client, p).Save(ctx)
+
+	if err != nil {
+		if constraint, ok := postgres.UniqueViolation(err); ok {
+			return ierr.WithError(err).
+				WithHint(placeConflictHint(constraint)).
+				WithReportableDetails(map[string]any{
+					"place_id": p.ID,
+					"slug":     p.Slug,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create place").
+			WithReportableDetails(map[string]any{
+				"place_id": p.ID,
+				"slug":     p.Slug,
+				"title":    p.Title,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *PlaceRepository) UpsertBySlug(ctx context.Context, p *domain.Place) (string, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("upserting place",
+		"place_id", p.ID,
+		"slug", p.Slug,
+		"title", p.Title,
+	)
+
+	// The update leaves the identity, type and status of the stored place alone and replaces
+	// its content, as Update does. Soft-deleted places are not updated, so no row comes back.
+	id, err := r.newCreate(ctx, client, p).
+		OnConflict(
+			entsql.ConflictColumns(place.FieldSlug),
+			entsql.UpdateWhere(entsql.NotIn(
+				place.FieldStatus,
+				lo.ToAnySlice(types.SoftDeletedStatuses)...,
+			)),
+		).
+		Update(func(u *ent.PlaceUpsert) {
+			u.UpdateTitle().
+				UpdateSubtitle().
+				UpdateShortDescription().
+				UpdateLongDescription().
+				UpdateAddress().
+				UpdateLatitude().
+				UpdateLongitude().
+				UpdateGeohash().
+				UpdatePrimaryImageURL().
+				UpdateThumbnailURL().
+				UpdatePriceInfo().
+				UpdateContact().
+				UpdateAccessibility().
+				UpdateGeofenceRadiusM().
+				UpdatePublishAt().
+				UpdateUnpublishAt().
+				UpdateUpdatedAt().
+				UpdateUpdatedBy()
+		}).
+		ID(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ierr.WithError(err).
+				WithHintf("Place with slug %s has been deleted", p.Slug).
+				WithReportableDetails(map[string]any{
+					"slug": p.Slug,
+				}).
+				Mark(ierr.ErrGone)
+		}
+		if constraint, ok := postgres.UniqueViolation(err); ok {
+			return "", ierr.WithError(err).
+				WithHint(placeConflictHint(constraint)).
+				WithReportableDetails(map[string]any{
+					"place_id": p.ID,
+					"slug":     p.Slug,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return "", ierr.WithError(err).
+			WithHint("Failed to upsert place").
+			WithReportableDetails(map[string]any{
+				"place_id": p.ID,
+				"slug":     p.Slug,
+				"title":    p.Title,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return id, nil
+}
+
+// newCreate builds the insert of a new place
+func (r *PlaceRepository) newCreate(ctx context.Context, client *ent.Client, p *domain.Place) *ent.PlaceCreate {
 	now := time.Now().UTC()
 	create := client.Place.Create().
 		SetID(p.ID).
@@ -95,11 +196,12 @@ func (r *PlaceRepository) Create(ctx context.Context, p *domain.Place) error {
 		SetPlaceType(string(p.PlaceType)).
 		SetLatitude(p.Location.Latitude).
 		SetLongitude(p.Location.Longitude).
-		SetStatus(string(p.Status)).
+		SetGeohash(p.Location.Geohash(types.MaxGeohashPrecision)).
+		SetStatus(p.Status).
 		SetCreatedAt(now).
 		SetUpdatedAt(now).
-		SetCreatedBy(types.GetUserID(ctx)).
-		SetUpdatedBy(types.GetUserID(ctx))
+		SetCreatedBy(types.GetActor(ctx)).
+		SetUpdatedBy(types.GetActor(ctx))
 
 	if p.Subtitle != nil {
 		create = create.SetSubtitle(*p.Subtitle)
@@ -119,33 +221,41 @@ func (r *PlaceRepository) Create(ctx context.Context, p *domain.Place) error {
 	if p.ThumbnailURL != nil {
 		create = create.SetThumbnailURL(*p.ThumbnailURL)
 	}
-
-	_, err := create.Save(ctx)
-
-	if err != nil {
-		if ent.IsConstraintError(err) {
-			return ierr.WithError(err).
-				WithHint("Place with this slug already exists").
-				WithReportableDetails(map[string]any{
-					"place_id": p.ID,
-					"slug":     p.Slug,
-				}).
-				Mark(ierr.ErrAlreadyExists)
-		}
-		return ierr.WithError(err).
-			WithHint("Failed to create place").
-			WithReportableDetails(map[string]any{
-				"place_id": p.ID,
-				"slug":     p.Slug,
-				"title":    p.Title,
-			}).
-			Mark(ierr.ErrDatabase)
+	if p.PriceInfo != nil {
+		create = create.SetPriceInfo(p.PriceInfo)
+	}
+	if !p.Contact.IsEmpty() {
+		create = create.SetContact(p.Contact)
+	}
+	if p.Accessibility != nil {
+		create = create.SetAccessibility(p.Accessibility)
+	}
+	if len(p.ExternalRefs) > 0 {
+		create = create.SetExternalRefs(p.ExternalRefs)
+	}
+	if p.GeofenceRadiusM != nil {
+		create = create.SetGeofenceRadiusM(*p.GeofenceRadiusM)
+	}
+	if p.PublishAt != nil {
+		create = create.SetPublishAt(*p.PublishAt)
+	}
+	if p.UnpublishAt != nil {
+		create = create.SetUnpublishAt(*p.UnpublishAt)
 	}
 
-	return nil
+	return create
 }
 
 func (r *PlaceRepository) Get(ctx context.Context, id string) (*domain.Place, error) {
+	return r.get(ctx, id, false)
+}
+
+// GetIncludingArchived is Get, except that an archived place is returned so it can be restored
+func (r *PlaceRepository) GetIncludingArchived(ctx context.Context, id string) (*domain.Place, error) {
+	return r.get(ctx, id, true)
+}
+
+func (r *PlaceRepository) get(ctx context.Context, id string, includeArchived bool) (*domain.Place, error) {
 	client := r.client.Querier(ctx)
 
 	r.log.Debugw("getting place", "place_id", id)
@@ -153,6 +263,7 @@ func (r *PlaceRepository) Get(ctx context.Context, id string) (*domain.Place, er
 	entPlace, err := client.Place.Query().
 		Where(place.ID(id)).
 		WithImages().
+		WithCategory(selectCategoryIDs).
 		Only(ctx)
 
 	if err != nil {
@@ -172,6 +283,134 @@ func (r *PlaceRepository) Get(ctx context.Context, id string) (*domain.Place, er
 			Mark(ierr.ErrDatabase)
 	}
 
+	// The lookup includes soft-deleted rows so a deleted place is told apart from an unknown ID
+	if entPlace.Status.IsSoftDeleted() && !(includeArchived && entPlace.Status == types.StatusArchived) {
+		return nil, ierr.NewErrorf("place %s has been deleted", id).
+			WithHintf("Place with ID %s has been deleted", id).
+			WithReportableDetails(map[string]any{
+				"place_id": id,
+			}).
+			Mark(ierr.ErrGone)
+	}
+
+	return domain.FromEnt(entPlace), nil
+}
+
+// placeConflictHint names the field a place duplicates, given the violated unique index
+func placeConflictHint(constraint string) string {
+	switch constraint {
+	case postgres.PlaceSlugIndex:
+		return "Place with this slug already exists"
+	case postgres.PlaceOSMRefIndex:
+		return "Place for this OpenStreetMap element already exists"
+	default:
+		return "Place already exists"
+	}
+}
+
+func (r *PlaceRepository) ExistsBySlug(ctx context.Context, slug string) (bool, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("checking place slug", "slug", slug)
+
+	exists, err := client.Place.Query().
+		Where(place.Slug(slug)).
+		Exist(ctx)
+	if err != nil {
+		return false, ierr.WithError(err).
+			WithHint("Failed to check place slug").
+			WithReportableDetails(map[string]any{
+				"slug": slug,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return exists, nil
+}
+
+func (r *PlaceRepository) ListByIdentifiers(ctx context.Context, identifiers []string) ([]*domain.Place, error) {
+	client := r.client.Querier(ctx)
+
+	if len(identifiers) == 0 {
+		return []*domain.Place{}, nil
+	}
+
+	places, err := client.Place.Query().
+		Where(place.Or(
+			place.IDIn(identifiers...),
+			place.SlugIn(identifiers...),
+		)).
+		Select(place.FieldID, place.FieldSlug, place.FieldStatus).
+		All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to look up places").
+			WithReportableDetails(map[string]any{
+				"identifiers": len(identifiers),
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domain.FromEntList(places), nil
+}
+
+func (r *PlaceRepository) GetIDBySlug(ctx context.Context, slug string) (string, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting place id by slug", "slug", slug)
+
+	id, err := client.Place.Query().
+		Where(place.Slug(slug)).
+		OnlyID(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", ierr.WithError(err).
+				WithHintf("Place with slug %s was not found", slug).
+				WithReportableDetails(map[string]any{
+					"slug": slug,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return "", ierr.WithError(err).
+			WithHint("Failed to get place by slug").
+			WithReportableDetails(map[string]any{
+				"slug": slug,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return id, nil
+}
+
+func (r *PlaceRepository) GetByExternalRef(ctx context.Context, source, ref string) (*domain.Place, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting place by external ref", "source", source, "ref", ref)
+
+	entPlace, err := client.Place.Query().
+		Where(func(s *entsql.Selector) {
+			s.Where(sqljson.ValueEQ(place.FieldExternalRefs, ref, sqljson.Path(source)))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Place with %s reference %s was not found", source, ref).
+				WithReportableDetails(map[string]any{
+					"source": source,
+					"ref":    ref,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get place by external reference").
+			WithReportableDetails(map[string]any{
+				"source": source,
+				"ref":    ref,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
 	return domain.FromEnt(entPlace), nil
 }
 
@@ -183,9 +422,10 @@ func (r *PlaceRepository) GetBySlug(ctx context.Context, slug string) (*domain.P
 	entPlace, err := client.Place.Query().
 		Where(
 			place.Slug(slug),
-			place.Status(string(types.StatusPublished)),
+			place.Status(types.StatusPublished),
 		).
 		WithImages().
+		WithCategory(selectCategoryIDs).
 		Only(ctx)
 
 	if err != nil {
@@ -222,12 +462,17 @@ func (r *PlaceRepository) List(ctx context.Context, filter *types.PlaceFilter) (
 	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
 
 	// Apply common query options (status, pagination, sorting)
-	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+	opts := r.queryOpts
+	if filter != nil {
+		opts.TitleCollation = r.titleCollation(ctx, filter.Collation)
+	}
+	query = ApplyQueryOptions(ctx, query, filter, opts)
 
 	// Load images if expand includes images
 	if filter != nil && filter.GetExpand().Has("images") {
 		query = query.WithImages()
 	}
+	query = query.WithCategory(selectCategoryIDs)
 
 	places, err := query.All(ctx)
 	if err != nil {
@@ -295,601 +540,2496 @@ func (r *PlaceRepository) List(ctx context.Context, filter *types.PlaceFilter) (
 	return domainPlaces, nil
 }
 
-func (r *PlaceRepository) ListAll(ctx context.Context, filter *types.PlaceFilter) ([]*domain.Place, error) {
-	if filter == nil {
-		filter = types.NewNoLimitPlaceFilter()
-	}
-
-	if filter.QueryFilter == nil {
-		filter.QueryFilter = types.NewNoLimitQueryFilter()
-	}
-
-	places, err := r.List(ctx, filter)
-	if err != nil {
-		return nil, err
-	}
-	return places, nil
-}
+// Column aliases selected alongside the place by ListNearbyRanked
+const (
+	nearbyDistanceColumn = "distance_m"
+	nearbyScoreColumn    = "score"
+)
 
-func (r *PlaceRepository) Count(ctx context.Context, filter *types.PlaceFilter) (int, error) {
+func (r *PlaceRepository) ListNearbyRanked(ctx context.Context, filter *types.PlaceFilter, weights types.NearbyRankWeights) ([]*domain.RankedPlace, error) {
 	client := r.client.Querier(ctx)
 
-	r.log.Debugw("counting places")
+	r.log.Debugw("listing ranked nearby places",
+		"latitude", filter.Latitude,
+		"longitude", filter.Longitude,
+		"radius_m", filter.RadiusM,
+		"distance_weight", weights.DistanceWeight,
+	)
 
 	query := client.Place.Query()
-
-	// Apply base filters (status only, no pagination/sorting)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
 	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query = query.
+		Where(withinRadius(filter)).
+		Order(orderByNearbyScore(filter, weights))
+	query = ApplyPagination(query, filter, r.queryOpts)
 
-	// Apply entity-specific filters
-	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	if filter.GetExpand().Has("images") {
+		query = query.WithImages()
+	}
+	query = query.WithCategory(selectCategoryIDs)
 
-	count, err := query.Count(ctx)
+	places, err := query.All(ctx)
 	if err != nil {
-		return 0, ierr.WithError(err).
-			WithHint("Failed to count places").
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list nearby places").
 			WithReportableDetails(map[string]any{
-				"filter": filter,
+				"latitude":  filter.Latitude,
+				"longitude": filter.Longitude,
+				"radius_m":  filter.RadiusM,
 			}).
 			Mark(ierr.ErrDatabase)
 	}
 
-	return count, nil
+	ranked := make([]*domain.RankedPlace, 0, len(places))
+	for _, p := range places {
+		ranked = append(ranked, &domain.RankedPlace{
+			Place:     domain.FromEnt(p),
+			DistanceM: selectedFloat(p, nearbyDistanceColumn),
+			Score:     selectedFloat(p, nearbyScoreColumn),
+		})
+	}
+
+	return ranked, nil
 }
 
-func (r *PlaceRepository) Update(ctx context.Context, p *domain.Place) error {
+// nearbyBatchQuery finds the nearest places of every origin in one round trip. The origins
+// are unnested from parallel coordinate arrays, and a LATERAL subquery runs the radius scan
+// and per-origin limit for each of them.
+const nearbyBatchQuery = `
+WITH origins AS (
+	SELECT o.idx, ST_SetSRID(ST_MakePoint(o.lng, o.lat), 4326)::geography AS geog
+	FROM unnest($1::float8[], $2::float8[]) WITH ORDINALITY AS o(lat, lng, idx)
+)
+SELECT o.idx - 1, n.id, n.distance_m
+FROM origins o
+CROSS JOIN LATERAL (
+	SELECT p.id,
+		ST_Distance(ST_SetSRID(ST_MakePoint(p.longitude::float8, p.latitude::float8), 4326)::geography, o.geog) AS distance_m
+	FROM places p
+	WHERE p.status = $3
+		AND ST_DWithin(ST_SetSRID(ST_MakePoint(p.longitude::float8, p.latitude::float8), 4326)::geography, o.geog, $4::float8)
+	ORDER BY distance_m, p.id
+	LIMIT $5
+) n
+ORDER BY o.idx, n.distance_m, n.id`
+
+func (r *PlaceRepository) NearbyBatch(ctx context.Context, origins []types.Location, radiusM float64, limitPer int) ([]*domain.NearbyMatch, error) {
 	client := r.client.Querier(ctx)
 
-	r.log.Debugw("updating place",
-		"place_id", p.ID,
-		"slug", p.Slug,
-		"title", p.Title,
+	r.log.Debugw("listing nearby places for many origins",
+		"origins", len(origins),
+		"radius_m", radiusM,
+		"limit_per", limitPer,
 	)
 
-	update := client.Place.UpdateOneID(p.ID).
-		SetTitle(p.Title).
-		SetLatitude(p.Location.Latitude).
-		SetLongitude(p.Location.Longitude).
-		SetStatus(string(p.Status)).
-		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx))
+	lats := lo.Map(origins, func(l types.Location, _ int) float64 { return l.Latitude.InexactFloat64() })
+	lngs := lo.Map(origins, func(l types.Location, _ int) float64 { return l.Longitude.InexactFloat64() })
 
-	if p.Subtitle != nil {
-		update = update.SetSubtitle(*p.Subtitle)
-	} else {
-		update = update.ClearSubtitle()
-	}
-	if p.ShortDescription != nil {
-		update = update.SetShortDescription(*p.ShortDescription)
-	} else {
-		update = update.ClearShortDescription()
-	}
-	if p.LongDescription != nil {
-		update = update.SetLongDescription(*p.LongDescription)
-	} else {
-		update = update.ClearLongDescription()
-	}
-	if len(p.Address) > 0 {
-		update = update.SetAddress(p.Address)
-	} else {
-		update = update.ClearAddress()
+	rows, err := client.QueryContext(ctx, nearbyBatchQuery,
+		pq.Array(lats), pq.Array(lngs), string(types.StatusPublished), radiusM, limitPer)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list nearby places. Ensure the postgis extension is installed").
+			WithReportableDetails(map[string]any{
+				"origins":  len(origins),
+				"radius_m": radiusM,
+			}).
+			Mark(ierr.ErrDatabase)
 	}
-	if p.PrimaryImageURL != nil {
-		update = update.SetPrimaryImageURL(*p.PrimaryImageURL)
-	} else {
-		update = update.ClearPrimaryImageURL()
+	defer rows.Close()
+
+	matches := make([]*domain.NearbyMatch, 0)
+	for rows.Next() {
+		m := &domain.NearbyMatch{}
+		if err := rows.Scan(&m.OriginIndex, &m.PlaceID, &m.DistanceM); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to read nearby places").
+				Mark(ierr.ErrDatabase)
+		}
+		matches = append(matches, m)
 	}
-	if p.ThumbnailURL != nil {
-		update = update.SetThumbnailURL(*p.ThumbnailURL)
-	} else {
-		update = update.ClearThumbnailURL()
+	if err := rows.Err(); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to read nearby places").
+			Mark(ierr.ErrDatabase)
 	}
 
-	_, err := update.Save(ctx)
+	return matches, nil
+}
 
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return ierr.WithError(err).
-				WithHintf("Place with ID %s was not found", p.ID).
-				WithReportableDetails(map[string]any{
-					"place_id": p.ID,
-				}).
-				Mark(ierr.ErrNotFound)
-		}
-		if ent.IsConstraintError(err) {
-			return ierr.WithError(err).
-				WithHint("Place with this slug already exists").
-				WithReportableDetails(map[string]any{
-					"place_id": p.ID,
-					"slug":     p.Slug,
-				}).
-				Mark(ierr.ErrAlreadyExists)
-		}
-		return ierr.WithError(err).
-			WithHint("Failed to update place").
-			WithReportableDetails(map[string]any{
-				"place_id": p.ID,
-			}).
-			Mark(ierr.ErrDatabase)
-	}
-
-	return nil
-}
-
-func (r *PlaceRepository) Delete(ctx context.Context, p *domain.Place) error {
+// insideGeofencesQuery finds the places whose circular geofence contains the point. Each
+// place is tested against its own radius, so places without one never match.
+const insideGeofencesQuery = `
+WITH origin AS (
+	SELECT ST_SetSRID(ST_MakePoint($1::float8, $2::float8), 4326)::geography AS geog
+)
+SELECT p.id,
+	ST_Distance(ST_SetSRID(ST_MakePoint(p.longitude::float8, p.latitude::float8), 4326)::geography, o.geog) AS distance_m
+FROM places p, origin o
+WHERE p.status = $3
+	AND p.geofence_radius_m IS NOT NULL
+	AND ST_DWithin(ST_SetSRID(ST_MakePoint(p.longitude::float8, p.latitude::float8), 4326)::geography, o.geog, p.geofence_radius_m::float8)
+ORDER BY distance_m, p.id`
+
+func (r *PlaceRepository) InsideGeofences(ctx context.Context, location types.Location) ([]*domain.GeofenceMatch, error) {
 	client := r.client.Querier(ctx)
 
-	r.log.Debugw("deleting place",
-		"place_id", p.ID,
+	r.log.Debugw("checking place geofences",
+		"latitude", location.Latitude,
+		"longitude", location.Longitude,
 	)
 
-	_, err := client.Place.UpdateOneID(p.ID).
-		SetStatus(string(types.StatusArchived)).
-		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx)).
-		Save(ctx)
-
+	rows, err := client.QueryContext(ctx, insideGeofencesQuery,
+		location.Longitude.InexactFloat64(), location.Latitude.InexactFloat64(), string(types.StatusPublished))
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return ierr.WithError(err).
-				WithHintf("Place with ID %s was not found", p.ID).
-				WithReportableDetails(map[string]any{
-					"place_id": p.ID,
-				}).
-				Mark(ierr.ErrNotFound)
-		}
-		return ierr.WithError(err).
-			WithHint("Failed to delete place").
+		return nil, ierr.WithError(err).
+			WithHint("Failed to check place geofences. Ensure the postgis extension is installed").
 			WithReportableDetails(map[string]any{
-				"place_id": p.ID,
+				"latitude":  location.Latitude,
+				"longitude": location.Longitude,
 			}).
 			Mark(ierr.ErrDatabase)
 	}
+	defer rows.Close()
 
-	return nil
-}
+	matches := make([]*domain.GeofenceMatch, 0)
+	for rows.Next() {
+		m := &domain.GeofenceMatch{}
+		if err := rows.Scan(&m.PlaceID, &m.DistanceM); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to read place geofences").
+				Mark(ierr.ErrDatabase)
+		}
+		matches = append(matches, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to read place geofences").
+			Mark(ierr.ErrDatabase)
+	}
 
-func (r *PlaceRepository) AddImage(ctx context.Context, image *domain.PlaceImage) error {
-	client := r.client.Querier(ctx)
+	return matches, nil
+}
 
-	r.log.Debugw("adding place image",
-		"image_id", image.ID,
-		"place_id", image.PlaceID,
-	)
+// centroidQuery finds the center of the given places. The points are collected into a
+// geography so the centroid is computed on the sphere rather than by averaging degrees.
+const centroidQuery = `
+WITH matched AS (
+	SELECT id, ST_SetSRID(ST_MakePoint(longitude::float8, latitude::float8), 4326) AS geom
+	FROM places
+	WHERE id = ANY($1) AND status <> ALL($2)
+),
+center AS (
+	SELECT ST_Centroid(ST_Collect(geom)::geography)::geometry AS geom FROM matched
+)
+SELECT (SELECT array_agg(id) FROM matched), ST_X(c.geom), ST_Y(c.geom)
+FROM center c`
 
-	now := time.Now().UTC()
-	create := client.PlaceImage.Create().
-		SetID(image.ID).
-		SetPlaceID(image.PlaceID).
-		SetURL(image.URL).
-		SetPos(image.Pos).
-		SetStatus(string(image.Status)).
-		SetCreatedAt(now).
-		SetUpdatedAt(now).
-		SetCreatedBy(types.GetUserID(ctx)).
-		SetUpdatedBy(types.GetUserID(ctx))
+func (r *PlaceRepository) Centroid(ctx context.Context, ids []string) (types.Point, error) {
+	client := r.client.Querier(ctx)
 
-	if image.Alt != "" {
-		create = create.SetAlt(image.Alt)
-	}
-	if image.Metadata != nil && len(image.Metadata.ToMap()) > 0 {
-		create = create.SetMetadata(image.Metadata.ToMap())
+	ids = lo.Uniq(ids)
+	if len(ids) == 0 {
+		return types.Point{}, ierr.NewError("at least one place ID is required").
+			WithHint("Please provide at least one place ID").
+			Mark(ierr.ErrValidation)
 	}
 
-	_, err := create.Save(ctx)
+	r.log.Debugw("computing place centroid", "place_ids", ids)
 
+	rows, err := client.QueryContext(ctx, centroidQuery, pq.Array(ids), softDeletedStatuses)
 	if err != nil {
-		if ent.IsConstraintError(err) {
-			return ierr.WithError(err).
-				WithHint("Place image with this ID already exists").
-				WithReportableDetails(map[string]any{
-					"image_id": image.ID,
-					"place_id": image.PlaceID,
-				}).
-				Mark(ierr.ErrAlreadyExists)
+		return types.Point{}, ierr.WithError(err).
+			WithHint("Failed to compute the place centroid. Ensure the postgis extension is installed").
+			WithReportableDetails(map[string]any{
+				"place_ids": ids,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	var found []string
+	var lng, lat sql.NullFloat64
+	for rows.Next() {
+		if err := rows.Scan(pq.Array(&found), &lng, &lat); err != nil {
+			return types.Point{}, ierr.WithError(err).
+				WithHint("Failed to read the place centroid").
+				Mark(ierr.ErrDatabase)
 		}
-		return ierr.WithError(err).
-			WithHint("Failed to add place image").
+	}
+	if err := rows.Err(); err != nil {
+		return types.Point{}, ierr.WithError(err).
+			WithHint("Failed to read the place centroid").
+			Mark(ierr.ErrDatabase)
+	}
+
+	if missing, _ := lo.Difference(ids, found); len(missing) > 0 {
+		return types.Point{}, ierr.NewError("places not found").
+			WithHintf("%d of the places were not found", len(missing)).
 			WithReportableDetails(map[string]any{
-				"image_id": image.ID,
-				"place_id": image.PlaceID,
+				"place_ids": missing,
 			}).
+			Mark(ierr.ErrNotFound)
+	}
+	if !lng.Valid || !lat.Valid {
+		return types.Point{}, ierr.NewError("place centroid is empty").
+			WithHint("Failed to compute the place centroid").
 			Mark(ierr.ErrDatabase)
 	}
 
-	return nil
+	return types.Point{Type: types.GeoJSONTypePoint, Coordinates: [2]float64{lng.Float64, lat.Float64}}, nil
 }
 
-func (r *PlaceRepository) GetImage(ctx context.Context, imageID string) (*domain.PlaceImage, error) {
+// heatmapQuery snaps the published places in a box to a grid and sums each cell. $1-$4 are the
+// box, $5 the cell size in degrees and $6 whether to sum view counts rather than count places.
+// Points snap to the nearest grid node, which is the center of their cell.
+var heatmapQuery = `
+SELECT ST_Y(cell), ST_X(cell), SUM(weight)::bigint
+FROM (
+	SELECT ST_SnapToGrid(` + postgres.PlaceGeometry + `, $5, $5) AS cell,
+		CASE WHEN $6 THEN view_count ELSE 1 END AS weight
+	FROM places
+	WHERE ` + postgres.PlaceGeometry + ` && ST_MakeEnvelope($1, $2, $3, $4, 4326)
+		AND status = $7
+		AND (publish_at IS NULL OR publish_at <= $8)
+		AND (unpublish_at IS NULL OR unpublish_at > $8)
+) snapped
+GROUP BY cell
+ORDER BY 1, 2`
+
+func (r *PlaceRepository) Heatmap(ctx context.Context, box types.BBox, cellSize float64, weight types.HeatmapWeight) ([]*domain.HeatmapCell, error) {
 	client := r.client.Querier(ctx)
 
-	r.log.Debugw("getting place image", "image_id", imageID)
-
-	entImage, err := client.PlaceImage.Query().
-		Where(placeimage.ID(imageID)).
-		Only(ctx)
+	r.log.Debugw("computing place heatmap", "bbox", box, "cell_size", cellSize, "weight", weight)
 
+	rows, err := client.QueryContext(ctx, heatmapQuery,
+		box.MinLng, box.MinLat, box.MaxLng, box.MaxLat,
+		cellSize, weight == types.HeatmapWeightViews,
+		string(types.StatusPublished), time.Now().UTC())
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, ierr.WithError(err).
-				WithHintf("Place image with ID %s was not found", imageID).
-				WithReportableDetails(map[string]any{
-					"image_id": imageID,
-				}).
-				Mark(ierr.ErrNotFound)
-		}
 		return nil, ierr.WithError(err).
-			WithHint("Failed to get place image").
+			WithHint("Failed to compute the place heatmap. Ensure the postgis extension is installed").
 			WithReportableDetails(map[string]any{
-				"image_id": imageID,
+				"cell_size": cellSize,
+				"weight":    weight,
 			}).
 			Mark(ierr.ErrDatabase)
 	}
+	defer rows.Close()
 
-	return domain.FromEntImage(entImage), nil
+	cells := make([]*domain.HeatmapCell, 0)
+	for rows.Next() {
+		cell := &domain.HeatmapCell{}
+		if err := rows.Scan(&cell.Latitude, &cell.Longitude, &cell.Weight); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to read the place heatmap").
+				Mark(ierr.ErrDatabase)
+		}
+		cells = append(cells, cell)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to read the place heatmap").
+			Mark(ierr.ErrDatabase)
+	}
+	return cells, nil
 }
 
-func (r *PlaceRepository) GetImages(ctx context.Context, placeID string) ([]*domain.PlaceImage, error) {
+// nearbySummaryQuery counts the published places within $3 meters of the point ($1 lng, $2 lat)
+// in one pass: a total row, one row per place type and one per published category. The box
+// around the circle lets the geometry index narrow the places before the exact distance test.
+var nearbySummaryQuery = `
+WITH origin AS (
+	SELECT ST_SetSRID(ST_MakePoint($1::float8, $2::float8), 4326)::geography AS geog
+), nearby AS (
+	SELECT p.id, p.place_type
+	FROM places p, origin o
+	WHERE ` + postgres.PlaceGeometry + ` && ST_Envelope(ST_Buffer(o.geog, $3::float8)::geometry)
+		AND ST_DWithin(` + postgres.PlaceGeometry + `::geography, o.geog, $3::float8)
+		AND p.status = $4
+		AND (p.publish_at IS NULL OR p.publish_at <= $5)
+		AND (p.unpublish_at IS NULL OR p.unpublish_at > $5)
+)
+SELECT 'total', '', '', COUNT(*) FROM nearby
+UNION ALL
+SELECT 'type', place_type, '', COUNT(*) FROM nearby GROUP BY place_type
+UNION ALL
+SELECT 'category', c.slug, c.name, COUNT(*)
+FROM nearby n
+JOIN category_places cp ON cp.place_id = n.id
+JOIN categories c ON c.id = cp.category_id AND c.status = $4
+GROUP BY c.slug, c.name
+ORDER BY 1, 4 DESC, 2`
+
+func (r *PlaceRepository) NearbyCategoryCounts(ctx context.Context, center types.Location, radiusKm float64) (*domain.NearbySummary, error) {
 	client := r.client.Querier(ctx)
 
-	r.log.Debugw("getting place images", "place_id", placeID)
-
-	images, err := client.PlaceImage.Query().
-		Where(placeimage.PlaceID(placeID)).
-		Order(ent.Asc(placeimage.FieldPos)).
-		All(ctx)
+	r.log.Debugw("counting nearby places", "center", center, "radius_km", radiusKm)
 
+	rows, err := client.QueryContext(ctx, nearbySummaryQuery,
+		center.Longitude.InexactFloat64(), center.Latitude.InexactFloat64(), radiusKm*1000,
+		string(types.StatusPublished), time.Now().UTC())
 	if err != nil {
 		return nil, ierr.WithError(err).
-			WithHint("Failed to get place images").
+			WithHint("Failed to count the nearby places. Ensure the postgis extension is installed").
 			WithReportableDetails(map[string]any{
-				"place_id": placeID,
+				"radius_km": radiusKm,
 			}).
 			Mark(ierr.ErrDatabase)
 	}
+	defer rows.Close()
 
-	return domain.FromEntImageList(images), nil
-}
-
-func (r *PlaceRepository) UpdateImage(ctx context.Context, image *domain.PlaceImage) error {
-	client := r.client.Querier(ctx)
-
-	r.log.Debugw("updating place image",
-		"image_id", image.ID,
-		"place_id", image.PlaceID,
-	)
-
-	update := client.PlaceImage.UpdateOneID(image.ID).
-		SetURL(image.URL).
-		SetPos(image.Pos).
-		SetStatus(string(image.Status)).
-		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx))
-
-	if image.Alt != "" {
-		update = update.SetAlt(image.Alt)
-	} else {
-		update = update.ClearAlt()
-	}
-	if image.Metadata != nil {
-		update = update.SetMetadata(image.Metadata.ToMap())
+	summary := &domain.NearbySummary{
+		ByType:     make([]*domain.PlaceTypeCount, 0),
+		ByCategory: make([]*domain.CategoryCount, 0),
 	}
-
-	_, err := update.Save(ctx)
-
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return ierr.WithError(err).
-				WithHintf("Place image with ID %s was not found", image.ID).
-				WithReportableDetails(map[string]any{
-					"image_id": image.ID,
-				}).
-				Mark(ierr.ErrNotFound)
+	for rows.Next() {
+		var kind, key, name string
+		var count int64
+		if err := rows.Scan(&kind, &key, &name, &count); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to read the nearby place counts").
+				Mark(ierr.ErrDatabase)
 		}
-		return ierr.WithError(err).
-			WithHint("Failed to update place image").
-			WithReportableDetails(map[string]any{
-				"image_id": image.ID,
-			}).
+		switch kind {
+		case "total":
+			summary.Total = count
+		case "type":
+			summary.ByType = append(summary.ByType, &domain.PlaceTypeCount{PlaceType: types.PlaceType(key), Count: count})
+		case "category":
+			summary.ByCategory = append(summary.ByCategory, &domain.CategoryCount{Slug: key, Name: name, Count: count})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to read the nearby place counts").
 			Mark(ierr.ErrDatabase)
 	}
-
-	return nil
+	return summary, nil
 }
 
-func (r *PlaceRepository) DeleteImage(ctx context.Context, imageID string) error {
+// categoryStatsQuery aggregates the published places of category $1: a total row with the
+// places that have an active image and the review-weighted rating sums, and one row per type
+var categoryStatsQuery = `
+WITH matched AS (
+	SELECT p.place_type, p.rating_avg, p.rating_count,
+		EXISTS (
+			SELECT 1 FROM place_images i
+			WHERE i.place_id = p.id AND i.status NOT IN ($4, $5)
+		) AS has_images
+	FROM places p
+	JOIN category_places cp ON cp.place_id = p.id
+	WHERE cp.category_id = $1
+		AND p.status = $2
+		AND (p.publish_at IS NULL OR p.publish_at <= $3)
+		AND (p.unpublish_at IS NULL OR p.unpublish_at > $3)
+)
+SELECT 'total', '', COUNT(*), COUNT(*) FILTER (WHERE has_images),
+	COALESCE(SUM(rating_avg * rating_count), 0)::float8, COALESCE(SUM(rating_count), 0)::bigint
+FROM matched
+UNION ALL
+SELECT 'type', place_type, COUNT(*), 0, 0, 0 FROM matched GROUP BY place_type
+ORDER BY 3 DESC, 2`
+
+func (r *PlaceRepository) CategoryStats(ctx context.Context, categoryID string) (*domain.CategoryStats, error) {
 	client := r.client.Querier(ctx)
 
-	r.log.Debugw("deleting place image", "image_id", imageID)
-
-	_, err := client.PlaceImage.UpdateOneID(imageID).
-		SetStatus(string(types.StatusArchived)).
-		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx)).
-		Save(ctx)
+	r.log.Debugw("computing category stats", "category_id", categoryID)
 
+	rows, err := client.QueryContext(ctx, categoryStatsQuery,
+		categoryID, string(types.StatusPublished), time.Now().UTC(),
+		string(types.StatusArchived), string(types.StatusDeleted))
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return ierr.WithError(err).
-				WithHintf("Place image with ID %s was not found", imageID).
-				WithReportableDetails(map[string]any{
-					"image_id": imageID,
-				}).
-				Mark(ierr.ErrNotFound)
-		}
-		return ierr.WithError(err).
-			WithHint("Failed to delete place image").
+		return nil, ierr.WithError(err).
+			WithHint("Failed to compute the category stats").
 			WithReportableDetails(map[string]any{
-				"image_id": imageID,
+				"category_id": categoryID,
 			}).
 			Mark(ierr.ErrDatabase)
 	}
+	defer rows.Close()
+
+	stats := &domain.CategoryStats{ByType: make([]*domain.PlaceTypeCount, 0)}
+	for rows.Next() {
+		var kind, key string
+		var count, withImages, ratingCount int64
+		var ratingSum float64
+		if err := rows.Scan(&kind, &key, &count, &withImages, &ratingSum, &ratingCount); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to read the category stats").
+				Mark(ierr.ErrDatabase)
+		}
+		if kind == "total" {
+			stats.PlaceCount = count
+			stats.WithImages = withImages
+			stats.RatingSum = ratingSum
+			stats.RatingCount = ratingCount
+			continue
+		}
+		stats.ByType = append(stats.ByType, &domain.PlaceTypeCount{PlaceType: types.PlaceType(key), Count: count})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to read the category stats").
+			Mark(ierr.ErrDatabase)
+	}
+	return stats, nil
+}
 
-	return nil
+// nearbyDistance is the geodesic distance in meters between a place and the filter's origin
+func nearbyDistance(s *entsql.Selector, filter *types.PlaceFilter) entsql.Querier {
+	return entsql.ExprFunc(func(b *entsql.Builder) {
+		b.WriteString("ST_Distance(ST_SetSRID(ST_MakePoint(").
+			WriteString(s.C(place.FieldLongitude)).WriteString("::float8, ").
+			WriteString(s.C(place.FieldLatitude)).WriteString("::float8), 4326)::geography, ").
+			WriteString("ST_SetSRID(ST_MakePoint(").
+			Arg(filter.Longitude.InexactFloat64()).WriteString("::float8, ").
+			Arg(filter.Latitude.InexactFloat64()).WriteString("::float8), 4326)::geography)")
+	})
 }
 
-// PlaceQuery type alias for better readability
-type PlaceQuery = *ent.PlaceQuery
+// withinRadius keeps places whose exact distance from the origin is within the filter's radius
+func withinRadius(filter *types.PlaceFilter) predicate.Place {
+	return func(s *entsql.Selector) {
+		s.Where(entsql.P(func(b *entsql.Builder) {
+			b.Join(nearbyDistance(s, filter)).
+				WriteString(" <= ").
+				Arg(filter.RadiusM.InexactFloat64())
+		}))
+	}
+}
 
-// PlaceQueryOptions implements query options for place queries
-type PlaceQueryOptions struct{}
+// orderByNearbyScore selects the distance and score of each place and orders by score,
+// best first, with the ID as a stable tie-breaker. The formula is documented on
+// types.NearbyRankWeights.
+func orderByNearbyScore(filter *types.PlaceFilter, weights types.NearbyRankWeights) place.OrderOption {
+	return func(s *entsql.Selector) {
+		distance := nearbyDistance(s, filter)
+		viewCount := s.C(place.FieldViewCount)
+
+		score := entsql.ExprFunc(func(b *entsql.Builder) {
+			b.Arg(weights.DistanceWeight).WriteString("::float8 * (1 - LEAST(").
+				Join(distance).WriteString(" / ").Arg(filter.RadiusM.InexactFloat64()).WriteString("::float8, 1)) + ").
+				WriteString("(1 - ").Arg(weights.DistanceWeight).WriteString("::float8) * (").
+				WriteString(viewCount).WriteString("::float8 / (").
+				WriteString(viewCount).WriteString(" + ").Arg(weights.PopularityHalfViews).WriteString("::float8))")
+		})
 
-// Ensure PlaceQueryOptions implements BaseQueryOptions interface
-var _ BaseQueryOptions[PlaceQuery] = (*PlaceQueryOptions)(nil)
+		s.AppendSelectExprAs(distance, nearbyDistanceColumn).
+			AppendSelectExprAs(score, nearbyScoreColumn).
+			OrderExpr(entsql.Expr(nearbyScoreColumn + " DESC")).
+			OrderBy(s.C(place.FieldID))
+	}
+}
 
-func (o PlaceQueryOptions) ApplyStatusFilter(query PlaceQuery, status string) PlaceQuery {
-	if status == "" {
-		return query.Where(place.StatusNotIn(string(types.StatusDeleted)))
+// selectedFloat reads a float column selected alongside the place; missing values read as 0
+func selectedFloat(p *ent.Place, column string) float64 {
+	v, err := p.Value(column)
+	if err != nil {
+		return 0
 	}
-	return query.Where(place.Status(status))
+	f, _ := v.(float64)
+	return f
 }
 
-func (o PlaceQueryOptions) ApplySortFilter(query PlaceQuery, field string, order string) PlaceQuery {
-	// Validate order
-	if order != types.OrderAsc && order != types.OrderDesc {
-		order = types.OrderDesc
+// titleCollation returns the database collation for sorting titles by c, or "" to sort by byte
+// order. A collation the migrator could not create, e.g. on a server built without ICU, falls
+// back to byte order instead of failing the listing.
+func (r *PlaceRepository) titleCollation(ctx context.Context, c types.Collation) string {
+	name := c.SQLName()
+	if name == "" {
+		return ""
 	}
-	// Default field if empty
-	if field == "" {
-		field = "created_at"
+	if exists, ok := r.collations.Load(name); ok {
+		if exists.(bool) {
+			return name
+		}
+		return ""
 	}
 
-	fieldName := o.GetFieldName(field)
-	if order == types.OrderDesc {
-		return query.Order(ent.Desc(fieldName))
+	exists, err := r.collationExists(ctx, name)
+	if err != nil {
+		r.log.Warnw("failed to look up title collation, sorting titles by byte order",
+			"collation", name,
+			"error", err,
+		)
+		return ""
 	}
-	return query.Order(ent.Asc(fieldName))
+	if !exists {
+		r.log.Warnw("title collation is missing, sorting titles by byte order; run the migrator on a server with ICU support",
+			"collation", name,
+		)
+	}
+	r.collations.Store(name, exists)
+	if exists {
+		return name
+	}
+	return ""
 }
 
-func (o PlaceQueryOptions) ApplyPaginationFilter(query PlaceQuery, limit int, offset int) PlaceQuery {
-	// Validate pagination values
-	if limit <= 0 {
-		limit = 20 // Default limit
+const collationExistsQuery = `SELECT EXISTS (SELECT 1 FROM pg_collation WHERE collname = $1)`
+
+func (r *PlaceRepository) collationExists(ctx context.Context, name string) (bool, error) {
+	rows, err := r.client.Querier(ctx).QueryContext(ctx, collationExistsQuery, name)
+	if err != nil {
+		return false, err
 	}
-	if offset < 0 {
-		offset = 0
+	defer rows.Close()
+
+	var exists bool
+	if rows.Next() {
+		err = rows.Scan(&exists)
 	}
-	if limit > 1000 {
-		limit = 1000
+	if err == nil {
+		err = rows.Err()
 	}
-
-	return query.Offset(offset).Limit(limit)
+	return exists, err
 }
 
-func (o PlaceQueryOptions) GetFieldName(field string) string {
-	switch field {
-	case "created_at":
-		return place.FieldCreatedAt
-	case "updated_at":
-		return place.FieldUpdatedAt
-	case "title":
-		return place.FieldTitle
-	case "slug":
-		return place.FieldSlug
-	case "place_type":
-		return place.FieldPlaceType
-	default:
-		return field
+func (r *PlaceRepository) ListAll(ctx context.Context, filter *types.PlaceFilter) ([]*domain.Place, error) {
+	if filter == nil {
+		filter = types.NewNoLimitPlaceFilter()
 	}
-}
 
-func (o PlaceQueryOptions) ApplyEntityQueryOptions(
-	_ context.Context,
-	f *types.PlaceFilter,
-	query PlaceQuery,
-) PlaceQuery {
-	if f == nil {
-		return query
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
 	}
 
-	// Apply slug filter if specified
-	if len(f.Slug) > 0 {
-		query = query.Where(place.SlugIn(f.Slug...))
+	places, err := r.List(ctx, filter)
+	if err != nil {
+		return nil, err
 	}
+	return places, nil
+}
 
-	// Apply place types filter if specified
-	if len(f.PlaceTypes) > 0 {
-		query = query.Where(place.PlaceTypeIn(f.PlaceTypes...))
-	}
+// Stream walks the matching places with a keyset cursor on id: every batch is a short query
+// starting after the last id seen, so no connection or transaction is held between batches
+// while a slow client reads. Geospatial filters use the exact radius, like ranked listings.
+func (r *PlaceRepository) Stream(ctx context.Context, filter *types.PlaceFilter, batchSize int, fn func(*domain.Place) error) error {
+	r.log.Debugw("streaming places", "batch_size", batchSize)
+
+	base := r.matchingQuery(ctx, filter)
+	withImages := filter != nil && filter.GetExpand().Has("images")
+
+	lastID := ""
+	for {
+		query := base.Clone().
+			Where(place.IDGT(lastID)).
+			Order(ent.Asc(place.FieldID)).
+			Limit(batchSize)
+		if withImages {
+			query = query.WithImages()
+		}
+		query = query.WithCategory(selectCategoryIDs)
 
-	// Apply search query if specified
-	if f.SearchQuery != nil && *f.SearchQuery != "" {
-		query = query.Where(
-			place.Or(
-				place.TitleContainsFold(*f.SearchQuery),
-				place.SlugContainsFold(*f.SearchQuery),
-				place.ShortDescriptionContainsFold(*f.SearchQuery),
-			),
-		)
+		places, err := query.All(ctx)
+		if err != nil {
+			return ierr.WithError(err).
+				WithHint("Failed to stream places").
+				WithReportableDetails(map[string]any{
+					"after_id": lastID,
+				}).
+				Mark(ierr.ErrDatabase)
+		}
+
+		for _, p := range places {
+			if err := fn(domain.FromEnt(p)); err != nil {
+				return err
+			}
+		}
+		if len(places) < batchSize {
+			return nil
+		}
+		lastID = places[len(places)-1].ID
 	}
+}
 
-	// Apply geospatial filters if specified
-	if f.Latitude != nil && f.Longitude != nil && f.RadiusM != nil {
-		lat0 := *f.Latitude
-		lng0 := *f.Longitude
-		radiusM := *f.RadiusM
+// matchingQuery selects every place matching the filter, ignoring pagination and sort. Unlike
+// List, which narrows geospatial filters to the exact radius in memory, it filters the exact
+// radius in SQL.
+func (r *PlaceRepository) matchingQuery(ctx context.Context, filter *types.PlaceFilter) PlaceQuery {
+	query := r.client.Querier(ctx).Place.Query()
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	if filter != nil && filter.Latitude != nil && filter.Longitude != nil && filter.RadiusM != nil {
+		query = query.Where(withinRadius(filter))
+	}
+	return query
+}
 
-		// Step 1: Bounding box prefilter (shrink search space)
-		minLat, maxLat, minLng, maxLng := calculateBoundingBox(lat0, lng0, radiusM)
+// placeExtentColumns are the corners of the ST_Extent of the matching places
+var placeExtentColumns = []string{"min_lng", "min_lat", "max_lng", "max_lat"}
 
-		// Apply bounding box filter (convert decimal to float64 for ent predicates)
-		query = query.Where(
-			place.And(
-				place.LatitudeGTE(minLat),
-				place.LatitudeLTE(maxLat),
-				place.LongitudeGTE(minLng),
-				place.LongitudeLTE(maxLng),
-			),
-		)
+func (r *PlaceRepository) BoundingBox(ctx context.Context, filter *types.PlaceFilter) (types.Point, types.Point, error) {
+	r.log.Debugw("computing place extent")
 
-		// Note: Exact distance filtering and sorting are handled in the List method
-		// after fetching results, since we need to calculate Haversine distances in Go
+	extent := func(s *entsql.Selector) string {
+		return fmt.Sprintf("ST_Extent(ST_MakePoint(%s::float8, %s::float8))",
+			s.C(place.FieldLongitude), s.C(place.FieldLatitude))
 	}
-
-	// Apply time range filters if specified
-	if f.TimeRangeFilter != nil {
-		if f.StartTime != nil {
-			query = query.Where(place.CreatedAtGTE(*f.StartTime))
-		}
-		if f.EndTime != nil {
-			query = query.Where(place.CreatedAtLTE(*f.EndTime))
+	corners := lo.Map([]string{"ST_XMin", "ST_YMin", "ST_XMax", "ST_YMax"}, func(fn string, i int) ent.AggregateFunc {
+		return func(s *entsql.Selector) string {
+			return entsql.As(fn+"("+extent(s)+")", placeExtentColumns[i])
 		}
+	})
+
+	var rows []struct {
+		MinLng *float64 `sql:"min_lng"`
+		MinLat *float64 `sql:"min_lat"`
+		MaxLng *float64 `sql:"max_lng"`
+		MaxLat *float64 `sql:"max_lat"`
+	}
+	err := r.matchingQuery(ctx, filter).
+		Aggregate(corners...).
+		Scan(ctx, &rows)
+	if err != nil {
+		return types.Point{}, types.Point{}, ierr.WithError(err).
+			WithHint("Failed to compute the place extent. Ensure the postgis extension is installed").
+			Mark(ierr.ErrDatabase)
 	}
 
-	// Apply trending filter (last viewed after)
-	if f.LastViewedAfter != nil {
-		query = query.Where(place.LastViewedAtGTE(*f.LastViewedAfter))
+	// The extent of no rows is NULL
+	if len(rows) == 0 || rows[0].MinLng == nil || rows[0].MinLat == nil || rows[0].MaxLng == nil || rows[0].MaxLat == nil {
+		return types.Point{}, types.Point{}, ierr.NewError("no places match the filter").
+			WithHint("No places match the filters, so there is no extent to show").
+			Mark(ierr.ErrNotFound)
 	}
 
-	return query
+	row := rows[0]
+	sw := types.Point{Type: types.GeoJSONTypePoint, Coordinates: [2]float64{*row.MinLng, *row.MinLat}}
+	ne := types.Point{Type: types.GeoJSONTypePoint, Coordinates: [2]float64{*row.MaxLng, *row.MaxLat}}
+	return sw, ne, nil
 }
 
-// Feed-specific methods for engagement tracking and trending queries
-
-// IncrementViewCount increments the view count for a place and updates last_viewed_at
-func (r *PlaceRepository) IncrementViewCount(ctx context.Context, placeID string) error {
+func (r *PlaceRepository) Count(ctx context.Context, filter *types.PlaceFilter) (int, error) {
 	client := r.client.Querier(ctx)
 
-	r.log.Debugw("incrementing view count", "place_id", placeID)
+	r.log.Debugw("counting places")
 
-	now := time.Now().UTC()
-	_, err := client.Place.UpdateOneID(placeID).
-		AddViewCount(1).
-		SetLastViewedAt(now).
-		SetUpdatedAt(now).
-		SetUpdatedBy(types.GetUserID(ctx)).
-		Save(ctx)
+	query := client.Place.Query()
+
+	// Apply base filters (status only, no pagination/sorting)
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+
+	// Apply entity-specific filters
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
 
+	// Ranked nearby listings filter the exact radius in SQL, so the total must too
+	if filter.IsRankedNearby() {
+		query = query.Where(withinRadius(filter))
+	}
+
+	count, err := query.Count(ctx)
 	if err != nil {
-		return ierr.WithError(err).
-			WithHint("Failed to increment view count").
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count places").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
 			Mark(ierr.ErrDatabase)
 	}
 
-	return nil
+	return count, nil
 }
 
-// UpdateRating updates the rating for a place (recalculates average and increments count)
-func (r *PlaceRepository) UpdateRating(ctx context.Context, placeID string, newRating decimal.Decimal) error {
+// ListByIDs returns places by ID, preserving the order of ids. Deleted places are skipped.
+func (r *PlaceRepository) ListByIDs(ctx context.Context, ids []string) ([]*domain.Place, error) {
 	client := r.client.Querier(ctx)
 
-	r.log.Debugw("updating place rating",
-		"place_id", placeID,
-		"new_rating", newRating.String())
+	if len(ids) == 0 {
+		return []*domain.Place{}, nil
+	}
 
-	// Get current place to calculate new average
-	currentPlace, err := client.Place.Get(ctx, placeID)
+	places, err := client.Place.Query().
+		Where(
+			place.IDIn(ids...),
+			place.StatusNotIn(types.SoftDeletedStatuses...),
+		).
+		WithCategory(selectCategoryIDs).
+		All(ctx)
 	if err != nil {
-		return ierr.WithError(err).
-			WithHint("Failed to get place for rating update").
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list places").
+			WithReportableDetails(map[string]any{
+				"place_ids": ids,
+			}).
 			Mark(ierr.ErrDatabase)
 	}
 
-	// Calculate new rating average
-	currentCount := currentPlace.RatingCount
-	currentAvg := currentPlace.RatingAvg
+	byID := lo.KeyBy(places, func(p *ent.Place) string { return p.ID })
+	ordered := lo.FilterMap(ids, func(id string, _ int) (*ent.Place, bool) {
+		p, ok := byID[id]
+		return p, ok
+	})
 
-	newCount := currentCount + 1
-	newAvg := currentAvg.Mul(decimal.NewFromInt(int64(currentCount))).
-		Add(newRating).
-		Div(decimal.NewFromInt(int64(newCount)))
+	return domain.FromEntList(ordered), nil
+}
 
-	now := time.Now().UTC()
-	_, err = client.Place.UpdateOneID(placeID).
-		SetRatingAvg(newAvg).
-		SetRatingCount(newCount).
-		SetUpdatedAt(now).
-		SetUpdatedBy(types.GetUserID(ctx)).
-		Save(ctx)
+// ListScheduleDue returns the places whose publishing schedule is due at now: drafts whose
+// publish_at has passed while their unpublish_at, if any, has not, and published places whose
+// unpublish_at has passed
+func (r *PlaceRepository) ListScheduleDue(ctx context.Context, now time.Time) ([]*domain.Place, error) {
+	client := r.client.Querier(ctx)
 
+	places, err := client.Place.Query().
+		Where(place.Or(
+			place.And(
+				place.Status(types.StatusDraft),
+				place.PublishAtLTE(now),
+				place.Or(place.UnpublishAtIsNil(), place.UnpublishAtGT(now)),
+			),
+			place.And(
+				place.Status(types.StatusPublished),
+				place.UnpublishAtLTE(now),
+			),
+		)).
+		Order(ent.Asc(place.FieldID)).
+		All(ctx)
 	if err != nil {
-		return ierr.WithError(err).
-			WithHint("Failed to update place rating").
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list places with a due publishing schedule").
 			Mark(ierr.ErrDatabase)
 	}
 
-	return nil
+	return domain.FromEntList(places), nil
 }
 
-// UpdatePopularityScore updates the popularity score for a place
-func (r *PlaceRepository) UpdatePopularityScore(ctx context.Context, placeID string, score decimal.Decimal) error {
+// autocompleteQuery matches a lowercased title prefix; it is served by idx_places_title_prefix
+const autocompleteQuery = `SELECT id, title, slug, place_type FROM places
+WHERE lower(title) LIKE $1 ESCAPE '\' AND status NOT IN ($2, $3)
+ORDER BY popularity_score DESC, title, id
+LIMIT $4`
+
+// likeEscaper escapes the LIKE wildcards in user input
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (r *PlaceRepository) Autocomplete(ctx context.Context, prefix string, limit int) ([]*domain.Suggestion, error) {
 	client := r.client.Querier(ctx)
 
-	r.log.Debugw("updating popularity score",
-		"place_id", placeID,
-		"score", score.String())
+	r.log.Debugw("autocompleting places", "prefix", prefix, "limit", limit)
 
-	now := time.Now().UTC()
-	_, err := client.Place.UpdateOneID(placeID).
-		SetPopularityScore(score).
-		SetUpdatedAt(now).
-		SetUpdatedBy(types.GetUserID(ctx)).
-		Save(ctx)
+	pattern := likeEscaper.Replace(strings.ToLower(prefix)) + "%"
+	rows, err := client.QueryContext(ctx, autocompleteQuery,
+		pattern, string(types.StatusArchived), string(types.StatusDeleted), limit)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to search places").
+			WithReportableDetails(map[string]any{
+				"prefix": prefix,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	suggestions := make([]*domain.Suggestion, 0, limit)
+	for rows.Next() {
+		var s domain.Suggestion
+		if err := rows.Scan(&s.ID, &s.Title, &s.Slug, &s.PlaceType); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to read place suggestions").
+				Mark(ierr.ErrDatabase)
+		}
+		suggestions = append(suggestions, &s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to read place suggestions").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return suggestions, nil
+}
+
+// searchPredicate matches the weighted place document against a websearch query ($1)
+var searchPredicate = postgres.PlaceSearchDocument + ` @@ websearch_to_tsquery('simple', $1) AND status NOT IN ($2, $3)`
+
+// searchQuery ranks matching places with the configured weights ($4)
+var searchQuery = `SELECT id FROM places WHERE ` + searchPredicate + `
+ORDER BY ts_rank($4::float4[], ` + postgres.PlaceSearchDocument + `, websearch_to_tsquery('simple', $1)) DESC, id
+LIMIT $5 OFFSET $6`
+
+var searchCountQuery = `SELECT count(*) FROM places WHERE ` + searchPredicate
+
+func (r *PlaceRepository) Search(ctx context.Context, query string, weights []float64, limit, offset int) ([]*domain.Place, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("searching places", "query", query, "limit", limit, "offset", offset)
 
+	rows, err := client.QueryContext(ctx, searchQuery,
+		query, string(types.StatusArchived), string(types.StatusDeleted),
+		pq.Array(weights), limit, offset)
 	if err != nil {
-		return ierr.WithError(err).
-			WithHint("Failed to update popularity score").
+		return nil, ierr.WithError(err).
+			WithHint("Failed to search places").
+			WithReportableDetails(map[string]any{
+				"query": query,
+			}).
 			Mark(ierr.ErrDatabase)
 	}
+	defer rows.Close()
 
-	return nil
+	ids := make([]string, 0, limit)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to read search results").
+				Mark(ierr.ErrDatabase)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to read search results").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return r.ListByIDs(ctx, ids)
 }
 
-// AssignCategories assigns categories to a place by replacing existing category relationships
-func (r *PlaceRepository) AssignCategories(ctx context.Context, placeID string, categoryIDs []string) error {
+func (r *PlaceRepository) CountSearch(ctx context.Context, query string) (int, error) {
 	client := r.client.Querier(ctx)
 
-	r.log.Debugw("assigning categories to place",
-		"place_id", placeID,
-		"category_count", len(categoryIDs),
-	)
+	rows, err := client.QueryContext(ctx, searchCountQuery,
+		query, string(types.StatusArchived), string(types.StatusDeleted))
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count search results").
+			WithReportableDetails(map[string]any{
+				"query": query,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
 
-	now := time.Now().UTC()
+	var count int
+	if rows.Next() {
+		err = rows.Scan(&count)
+	}
+	if err == nil {
+		err = rows.Err()
+	}
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count search results").
+			WithReportableDetails(map[string]any{
+				"query": query,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
 
-	_, err := client.Place.UpdateOneID(placeID).
-		ClearCategory().
-		AddCategoryIDs(categoryIDs...).
-		SetUpdatedAt(now).
-		SetUpdatedBy(types.GetUserID(ctx)).
-		Save(ctx)
+	return count, nil
+}
+
+// suggestTermsQuery ranks the search dictionary (place titles and category names) by
+// pg_trgm word similarity to the term
+const suggestTermsQuery = `SELECT term FROM (
+	SELECT lower(title) AS term FROM places WHERE status NOT IN ($2, $3)
+	UNION
+	SELECT lower(name) FROM categories WHERE status NOT IN ($2, $3)
+) dictionary
+WHERE word_similarity($1, term) >= $4
+ORDER BY word_similarity($1, term) DESC, term
+LIMIT $5`
+
+func (r *PlaceRepository) SuggestTerms(ctx context.Context, term string, minSimilarity float64, limit int) ([]string, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("suggesting search terms", "term", term)
 
+	rows, err := client.QueryContext(ctx, suggestTermsQuery,
+		strings.ToLower(term), string(types.StatusArchived), string(types.StatusDeleted),
+		minSimilarity, limit)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return ierr.WithError(err).
-				WithHintf("Place with ID %s was not found", placeID).
-				WithReportableDetails(map[string]any{
-					"place_id": placeID,
-				}).
-				Mark(ierr.ErrNotFound)
-		}
-		return ierr.WithError(err).
-			WithHint("Failed to assign categories to place").
+		return nil, ierr.WithError(err).
+			WithHint("Failed to suggest search terms. Ensure the pg_trgm extension is installed").
 			WithReportableDetails(map[string]any{
-				"place_id":     placeID,
-				"category_ids": categoryIDs,
+				"term": term,
 			}).
 			Mark(ierr.ErrDatabase)
 	}
+	defer rows.Close()
 
-	return nil
+	terms := make([]string, 0, limit)
+	for rows.Next() {
+		var t string
+		if err := rows.Scan(&t); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to read search term suggestions").
+				Mark(ierr.ErrDatabase)
+		}
+		terms = append(terms, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to read search term suggestions").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return terms, nil
+}
+
+// estimateCountQuery asks the planner for the number of places matching a status predicate
+const estimateCountQuery = `EXPLAIN (FORMAT JSON) SELECT 1 FROM places WHERE status = $1`
+
+// estimateCountExcludingQuery is estimateCountQuery for the default "all but deleted" status filter
+const estimateCountExcludingQuery = `EXPLAIN (FORMAT JSON) SELECT 1 FROM places WHERE status <> ALL($1)`
+
+func (r *PlaceRepository) EstimateCount(ctx context.Context, filter *types.PlaceFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	status := filter.GetStatus()
+	r.log.Debugw("estimating place count", "status", status)
+
+	var rows *sql.Rows
+	var err error
+	if status == "" {
+		rows, err = client.QueryContext(ctx, estimateCountExcludingQuery, softDeletedStatuses)
+	} else {
+		rows, err = client.QueryContext(ctx, estimateCountQuery, status)
+	}
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to estimate place count").
+			Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	var plan []byte
+	if rows.Next() {
+		err = rows.Scan(&plan)
+	}
+	if err == nil {
+		err = rows.Err()
+	}
+
+	var explain []struct {
+		Plan struct {
+			PlanRows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err == nil {
+		err = json.Unmarshal(plan, &explain)
+	}
+	if err == nil && len(explain) == 0 {
+		err = ierr.NewError("empty query plan").Mark(ierr.ErrDatabase)
+	}
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to read place count estimate").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return int(math.Round(explain[0].Plan.PlanRows)), nil
+}
+
+func (r *PlaceRepository) Update(ctx context.Context, p *domain.Place) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating place",
+		"place_id", p.ID,
+		"slug", p.Slug,
+		"title", p.Title,
+	)
+
+	update := client.Place.UpdateOneID(p.ID).
+		SetTitle(p.Title).
+		SetLatitude(p.Location.Latitude).
+		SetLongitude(p.Location.Longitude).
+		SetGeohash(p.Location.Geohash(types.MaxGeohashPrecision)).
+		SetStatus(p.Status).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetActor(ctx))
+
+	if p.Subtitle != nil {
+		update = update.SetSubtitle(*p.Subtitle)
+	} else {
+		update = update.ClearSubtitle()
+	}
+	if p.ShortDescription != nil {
+		update = update.SetShortDescription(*p.ShortDescription)
+	} else {
+		update = update.ClearShortDescription()
+	}
+	if p.LongDescription != nil {
+		update = update.SetLongDescription(*p.LongDescription)
+	} else {
+		update = update.ClearLongDescription()
+	}
+	if len(p.Address) > 0 {
+		update = update.SetAddress(p.Address)
+	} else {
+		update = update.ClearAddress()
+	}
+	if p.PrimaryImageURL != nil {
+		update = update.SetPrimaryImageURL(*p.PrimaryImageURL)
+	} else {
+		update = update.ClearPrimaryImageURL()
+	}
+	if p.ThumbnailURL != nil {
+		update = update.SetThumbnailURL(*p.ThumbnailURL)
+	} else {
+		update = update.ClearThumbnailURL()
+	}
+	if p.PriceInfo != nil {
+		update = update.SetPriceInfo(p.PriceInfo)
+	} else {
+		update = update.ClearPriceInfo()
+	}
+	if !p.Contact.IsEmpty() {
+		update = update.SetContact(p.Contact)
+	} else {
+		update = update.ClearContact()
+	}
+	if p.Accessibility != nil {
+		update = update.SetAccessibility(p.Accessibility)
+	} else {
+		update = update.ClearAccessibility()
+	}
+	if len(p.ExternalRefs) > 0 {
+		update = update.SetExternalRefs(p.ExternalRefs)
+	} else {
+		update = update.ClearExternalRefs()
+	}
+	if p.GeofenceRadiusM != nil {
+		update = update.SetGeofenceRadiusM(*p.GeofenceRadiusM)
+	} else {
+		update = update.ClearGeofenceRadiusM()
+	}
+	if p.PublishAt != nil {
+		update = update.SetPublishAt(*p.PublishAt)
+	} else {
+		update = update.ClearPublishAt()
+	}
+	if p.UnpublishAt != nil {
+		update = update.SetUnpublishAt(*p.UnpublishAt)
+	} else {
+		update = update.ClearUnpublishAt()
+	}
+
+	_, err := update.Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Place with ID %s was not found", p.ID).
+				WithReportableDetails(map[string]any{
+					"place_id": p.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("Place with this slug already exists").
+				WithReportableDetails(map[string]any{
+					"place_id": p.ID,
+					"slug":     p.Slug,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update place").
+			WithReportableDetails(map[string]any{
+				"place_id": p.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *PlaceRepository) Delete(ctx context.Context, p *domain.Place) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting place",
+		"place_id", p.ID,
+	)
+
+	_, err := client.Place.UpdateOneID(p.ID).
+		SetStatus(types.StatusArchived).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetActor(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Place with ID %s was not found", p.ID).
+				WithReportableDetails(map[string]any{
+					"place_id": p.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to delete place").
+			WithReportableDetails(map[string]any{
+				"place_id": p.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *PlaceRepository) AddImage(ctx context.Context, image *domain.PlaceImage) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("adding place image",
+		"image_id", image.ID,
+		"place_id", image.PlaceID,
+	)
+
+	now := time.Now().UTC()
+	create := client.PlaceImage.Create().
+		SetID(image.ID).
+		SetPlaceID(image.PlaceID).
+		SetURL(image.URL).
+		SetPos(image.Pos).
+		SetStatus(image.Status).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		SetCreatedBy(types.GetActor(ctx)).
+		SetUpdatedBy(types.GetActor(ctx))
+
+	if image.Alt != "" {
+		create = create.SetAlt(image.Alt)
+	}
+	if image.Metadata != nil && len(image.Metadata.ToMap()) > 0 {
+		create = create.SetMetadata(image.Metadata.ToMap())
+	}
+
+	_, err := create.Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("Place image with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"image_id": image.ID,
+					"place_id": image.PlaceID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to add place image").
+			WithReportableDetails(map[string]any{
+				"image_id": image.ID,
+				"place_id": image.PlaceID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *PlaceRepository) GetImage(ctx context.Context, imageID string) (*domain.PlaceImage, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting place image", "image_id", imageID)
+
+	entImage, err := client.PlaceImage.Query().
+		Where(placeimage.ID(imageID)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Place image with ID %s was not found", imageID).
+				WithReportableDetails(map[string]any{
+					"image_id": imageID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get place image").
+			WithReportableDetails(map[string]any{
+				"image_id": imageID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domain.FromEntImage(entImage), nil
+}
+
+func (r *PlaceRepository) GetImages(ctx context.Context, placeID string) ([]*domain.PlaceImage, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting place images", "place_id", placeID)
+
+	images, err := client.PlaceImage.Query().
+		Where(placeimage.PlaceID(placeID)).
+		Order(ent.Asc(placeimage.FieldPos)).
+		All(ctx)
+
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get place images").
+			WithReportableDetails(map[string]any{
+				"place_id": placeID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domain.FromEntImageList(images), nil
+}
+
+func (r *PlaceRepository) UpdateImage(ctx context.Context, image *domain.PlaceImage) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating place image",
+		"image_id", image.ID,
+		"place_id", image.PlaceID,
+	)
+
+	update := client.PlaceImage.UpdateOneID(image.ID).
+		SetURL(image.URL).
+		SetPos(image.Pos).
+		SetStatus(image.Status).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetActor(ctx))
+
+	if image.Alt != "" {
+		update = update.SetAlt(image.Alt)
+	} else {
+		update = update.ClearAlt()
+	}
+	if image.Metadata != nil {
+		update = update.SetMetadata(image.Metadata.ToMap())
+	}
+
+	_, err := update.Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Place image with ID %s was not found", image.ID).
+				WithReportableDetails(map[string]any{
+					"image_id": image.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update place image").
+			WithReportableDetails(map[string]any{
+				"image_id": image.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *PlaceRepository) DeleteImage(ctx context.Context, imageID string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting place image", "image_id", imageID)
+
+	_, err := client.PlaceImage.UpdateOneID(imageID).
+		SetStatus(types.StatusArchived).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetActor(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Place image with ID %s was not found", imageID).
+				WithReportableDetails(map[string]any{
+					"image_id": imageID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to delete place image").
+			WithReportableDetails(map[string]any{
+				"image_id": imageID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+// DeleteImages archives the given images of a place in a single statement and returns
+// how many were archived. Images belonging to other places are left untouched.
+func (r *PlaceRepository) DeleteImages(ctx context.Context, placeID string, imageIDs []string) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting place images",
+		"place_id", placeID,
+		"image_count", len(imageIDs),
+	)
+
+	count, err := client.PlaceImage.Update().
+		Where(
+			placeimage.PlaceID(placeID),
+			placeimage.IDIn(imageIDs...),
+		).
+		SetStatus(types.StatusArchived).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetActor(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to delete place images").
+			WithReportableDetails(map[string]any{
+				"place_id":  placeID,
+				"image_ids": imageIDs,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+// RepackImagePositions renumbers the active images of a place as 0..n-1, keeping their
+// current order, so deletions leave no gaps in pos
+func (r *PlaceRepository) RepackImagePositions(ctx context.Context, placeID string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("repacking place image positions", "place_id", placeID)
+
+	images, err := client.PlaceImage.Query().
+		Where(
+			placeimage.PlaceID(placeID),
+			placeimage.StatusNotIn(types.StatusArchived, types.StatusDeleted),
+		).
+		Order(ent.Asc(placeimage.FieldPos), ent.Asc(placeimage.FieldID)).
+		All(ctx)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to get place images").
+			WithReportableDetails(map[string]any{
+				"place_id": placeID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	for pos, image := range images {
+		if image.Pos == pos {
+			continue
+		}
+		if err := client.PlaceImage.UpdateOneID(image.ID).SetPos(pos).Exec(ctx); err != nil {
+			return ierr.WithError(err).
+				WithHint("Failed to reorder place images").
+				WithReportableDetails(map[string]any{
+					"place_id": placeID,
+					"image_id": image.ID,
+				}).
+				Mark(ierr.ErrDatabase)
+		}
+	}
+
+	return nil
+}
+
+// SetPrimaryImageURL points the primary image of a place at url, or clears it when url is nil
+func (r *PlaceRepository) SetPrimaryImageURL(ctx context.Context, placeID string, url *string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("setting place primary image", "place_id", placeID, "url", lo.FromPtr(url))
+
+	update := client.Place.UpdateOneID(placeID).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetActor(ctx))
+	if url != nil {
+		update = update.SetPrimaryImageURL(*url)
+	} else {
+		update = update.ClearPrimaryImageURL()
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Place with ID %s was not found", placeID).
+				WithReportableDetails(map[string]any{
+					"place_id": placeID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update the primary image of the place").
+			WithReportableDetails(map[string]any{
+				"place_id": placeID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+// PlaceQuery type alias for better readability
+type PlaceQuery = *ent.PlaceQuery
+
+// PlaceQueryOptions implements query options for place queries
+type PlaceQueryOptions struct {
+	// TitleCollation is the database collation titles are sorted with; empty sorts by byte order
+	TitleCollation string
+}
+
+// Ensure PlaceQueryOptions implements BaseQueryOptions interface
+var _ BaseQueryOptions[PlaceQuery] = (*PlaceQueryOptions)(nil)
+
+// ApplyStatusFilter filters on status. The publishing window of published places is applied
+// with the entity filters, see publishWindowOpen.
+func (o PlaceQueryOptions) ApplyStatusFilter(query PlaceQuery, status string) PlaceQuery {
+	if status == "" {
+		return query.Where(place.StatusNotIn(types.SoftDeletedStatuses...))
+	}
+	return query.Where(place.Status(types.Status(status)))
+}
+
+// publishWindowOpen matches places whose publishing window is open at now. Listings other
+// than admins' apply it to published places, so a place whose window has not opened or has
+// closed drops out before the scheduler flips its status.
+func publishWindowOpen(now time.Time) predicate.Place {
+	return place.And(
+		place.Or(place.PublishAtIsNil(), place.PublishAtLTE(now)),
+		place.Or(place.UnpublishAtIsNil(), place.UnpublishAtGT(now)),
+	)
+}
+
+func (o PlaceQueryOptions) ApplySortFilter(query PlaceQuery, field string, order string) PlaceQuery {
+	// Validate order
+	if order != types.OrderAsc && order != types.OrderDesc {
+		order = types.OrderDesc
+	}
+	// Default field if empty
+	if field == "" {
+		field = "created_at"
+	}
+
+	fieldName := o.GetFieldName(field)
+	if fieldName == place.FieldTitle && o.TitleCollation != "" {
+		return query.Order(func(s *entsql.Selector) {
+			s.OrderExpr(entsql.Expr(fmt.Sprintf("%s COLLATE %s %s",
+				s.C(place.FieldTitle), pq.QuoteIdentifier(o.TitleCollation), strings.ToUpper(order))))
+		})
+	}
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName))
+	}
+	return query.Order(ent.Asc(fieldName))
+}
+
+func (o PlaceQueryOptions) ApplyPaginationFilter(query PlaceQuery, limit int, offset int) PlaceQuery {
+	// Validate pagination values
+	if limit <= 0 {
+		limit = 20 // Default limit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o PlaceQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return place.FieldCreatedAt
+	case "updated_at":
+		return place.FieldUpdatedAt
+	case "title":
+		return place.FieldTitle
+	case "slug":
+		return place.FieldSlug
+	case "place_type":
+		return place.FieldPlaceType
+	case "view_count":
+		return place.FieldViewCount
+	case "rating_avg":
+		return place.FieldRatingAvg
+	case "rating_count":
+		return place.FieldRatingCount
+	case "popularity_score":
+		return place.FieldPopularityScore
+	case "last_viewed_at":
+		return place.FieldLastViewedAt
+	case "id":
+		return place.FieldID
+	default:
+		return field
+	}
+}
+
+// priceIsFree matches places whose price_info marks them free (or paid when free is false).
+// Places without price_info match neither.
+func priceIsFree(free bool) predicate.Place {
+	return func(s *entsql.Selector) {
+		s.Where(entsql.P(func(b *entsql.Builder) {
+			b.WriteString("(").WriteString(s.C(place.FieldPriceInfo)).WriteString(" ->> 'is_free')::boolean = ").Arg(free)
+		}))
+	}
+}
+
+// priceAtMost matches paid places charging at most amount in currency
+func priceAtMost(amount decimal.Decimal, currency string) predicate.Place {
+	return func(s *entsql.Selector) {
+		s.Where(entsql.P(func(b *entsql.Builder) {
+			column := s.C(place.FieldPriceInfo)
+			b.WriteString(column).WriteString(" ->> 'currency' = ").Arg(currency).
+				WriteString(" AND (").WriteString(column).WriteString(" ->> 'amount')::numeric <= ").Arg(amount.String()).WriteString("::numeric")
+		}))
+	}
+}
+
+// completenessCompare matches places whose completeness score, computed in SQL the way
+// types.CompletenessScore computes it, compares to score by op
+func completenessCompare(op string, score int) predicate.Place {
+	return func(s *entsql.Selector) {
+		s.Where(entsql.P(func(b *entsql.Builder) {
+			writeCompletenessScore(s, b)
+			b.WriteString(" " + op + " ").Arg(score)
+		}))
+	}
+}
+
+// writeCompletenessScore writes the weighted completeness score (0-100, rounded down) of
+// the place rows of s
+func writeCompletenessScore(s *entsql.Selector, b *entsql.Builder) {
+	total := 0
+	b.WriteString("(100 * (0")
+	for _, field := range types.PlaceFields {
+		weight := types.CompletenessWeight(types.PlaceField(field))
+		if weight == 0 {
+			continue
+		}
+		total += weight
+		b.WriteString(" + CASE WHEN ")
+		writeFieldPresent(s, b, types.PlaceField(field))
+		b.WriteString(" THEN ").WriteString(strconv.Itoa(weight)).WriteString(" ELSE 0 END")
+	}
+	b.WriteString(")) / ").WriteString(strconv.Itoa(max(total, 1)))
+}
+
+// writeFieldPresent writes the condition under which the place has field, the negation of
+// missingFieldPredicate
+func writeFieldPresent(s *entsql.Selector, b *entsql.Builder, field types.PlaceField) {
+	switch field {
+	case types.PlaceFieldPrimaryImage:
+		b.WriteString("COALESCE(").WriteString(s.C(place.FieldPrimaryImageURL)).WriteString(", '') <> ''")
+	case types.PlaceFieldLongDescription:
+		b.WriteString("COALESCE(").WriteString(s.C(place.FieldLongDescription)).WriteString(", '') <> ''")
+	case types.PlaceFieldCoordinates:
+		b.WriteString("NOT (").WriteString(s.C(place.FieldLatitude)).WriteString(" = 0 AND ").
+			WriteString(s.C(place.FieldLongitude)).WriteString(" = 0)")
+	case types.PlaceFieldCategories:
+		b.WriteString("EXISTS (SELECT 1 FROM ").WriteString(place.CategoryTable).
+			WriteString(" WHERE ").WriteString(place.CategoryTable + "." + place.CategoryPrimaryKey[1]).
+			WriteString(" = ").WriteString(s.C(place.FieldID)).WriteString(")")
+	case types.PlaceFieldContact:
+		b.WriteString(s.C(place.FieldContact)).WriteString(" IS NOT NULL")
+	default:
+		b.WriteString("FALSE")
+	}
+}
+
+// accessibilityIs matches places that state the accessibility attribute key as value.
+// Places that do not state the attribute match neither true nor false.
+func accessibilityIs(key string, value bool) predicate.Place {
+	return func(s *entsql.Selector) {
+		s.Where(entsql.P(func(b *entsql.Builder) {
+			b.WriteString("(").WriteString(s.C(place.FieldAccessibility)).WriteString(" ->> ").Arg(key).
+				WriteString(")::boolean = ").Arg(value)
+		}))
+	}
+}
+
+// addressPincodeIs matches places whose address pincode is pincode. The key is written into
+// the SQL rather than bound, so the expression matches idx_places_address_pincode.
+func addressPincodeIs(pincode string) predicate.Place {
+	return func(s *entsql.Selector) {
+		s.Where(entsql.P(func(b *entsql.Builder) {
+			b.WriteString("(").WriteString(s.C(place.FieldAddress)).WriteString(" ->> '" + types.AddressKeyPincode + "') = ").Arg(pincode)
+		}))
+	}
+}
+
+func (o PlaceQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.PlaceFilter,
+	query PlaceQuery,
+) PlaceQuery {
+	if f == nil {
+		return query
+	}
+
+	// Hide published places outside their publishing window wherever the listing includes
+	// published places: filtering on published or on no status at all
+	if !f.IncludeScheduled {
+		switch types.Status(f.GetStatus()) {
+		case "":
+			query = query.Where(place.Or(place.StatusNEQ(types.StatusPublished), publishWindowOpen(time.Now().UTC())))
+		case types.StatusPublished:
+			query = query.Where(publishWindowOpen(time.Now().UTC()))
+		}
+	}
+
+	// Apply slug filter if specified
+	if len(f.Slug) > 0 {
+		query = query.Where(place.SlugIn(f.Slug...))
+	}
+
+	// Apply place types filter if specified
+	if len(f.PlaceTypes) > 0 {
+		query = query.Where(place.PlaceTypeIn(f.PlaceTypes...))
+	}
+
+	// Apply category filter if specified
+	if len(f.Categories) > 0 {
+		query = query.Where(place.HasCategoryWith(category.SlugIn(f.Categories...)))
+	}
+
+	// Apply search query if specified
+	if f.SearchQuery != nil && *f.SearchQuery != "" {
+		query = query.Where(
+			place.Or(
+				place.TitleContainsFold(*f.SearchQuery),
+				place.SlugContainsFold(*f.SearchQuery),
+				place.ShortDescriptionContainsFold(*f.SearchQuery),
+			),
+		)
+	}
+
+	// Apply price filters if specified
+	if f.Free != nil {
+		query = query.Where(priceIsFree(*f.Free))
+	}
+	if f.MaxPrice != nil {
+		query = query.Where(place.Or(
+			priceIsFree(true),
+			priceAtMost(*f.MaxPrice, f.GetPriceCurrency()),
+		))
+	}
+
+	// Apply accessibility filters if specified
+	if f.Wheelchair != nil {
+		query = query.Where(accessibilityIs(types.AccessibilityWheelchairAccessible, *f.Wheelchair))
+	}
+
+	if f.Pincode != nil {
+		query = query.Where(addressPincodeIs(*f.Pincode))
+	}
+
+	if f.GeohashPrefix != nil {
+		query = query.Where(place.GeohashHasPrefix(*f.GeohashPrefix))
+	}
+
+	// Apply completeness filters if specified
+	if f.MinCompleteness != nil {
+		query = query.Where(completenessCompare(">=", *f.MinCompleteness))
+	}
+	if f.MaxCompleteness != nil {
+		query = query.Where(completenessCompare("<=", *f.MaxCompleteness))
+	}
+
+	// Apply geospatial filters if specified
+	if f.Latitude != nil && f.Longitude != nil && f.RadiusM != nil {
+		lat0 := *f.Latitude
+		lng0 := *f.Longitude
+		radiusM := *f.RadiusM
+
+		// Step 1: Bounding box prefilter (shrink search space)
+		minLat, maxLat, minLng, maxLng := calculateBoundingBox(lat0, lng0, radiusM)
+
+		// Apply bounding box filter (convert decimal to float64 for ent predicates)
+		query = query.Where(
+			place.And(
+				place.LatitudeGTE(minLat),
+				place.LatitudeLTE(maxLat),
+				place.LongitudeGTE(minLng),
+				place.LongitudeLTE(maxLng),
+			),
+		)
+
+		// Note: Exact distance filtering and sorting are handled in the List method
+		// after fetching results, since we need to calculate Haversine distances in Go
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(place.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(place.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	// Apply trending filter (last viewed after)
+	if f.LastViewedAfter != nil {
+		query = query.Where(place.LastViewedAtGTE(*f.LastViewedAfter))
+	}
+
+	// Apply contributor filters if specified
+	if f.CreatedBy != nil {
+		query = query.Where(place.CreatedBy(*f.CreatedBy))
+	}
+	if f.UpdatedBy != nil {
+		query = query.Where(place.UpdatedBy(*f.UpdatedBy))
+	}
+	if f.UpdatedSince != nil {
+		query = query.Where(place.UpdatedAtGTE(*f.UpdatedSince))
+	}
+
+	return query
+}
+
+// Feed-specific methods for engagement tracking and trending queries
+
+// IncrementViewCount increments the view count for a place and updates last_viewed_at
+func (r *PlaceRepository) IncrementViewCount(ctx context.Context, placeID string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("incrementing view count", "place_id", placeID)
+
+	now := time.Now().UTC()
+	_, err := client.Place.UpdateOneID(placeID).
+		AddViewCount(1).
+		SetLastViewedAt(now).
+		SetUpdatedAt(now).
+		SetUpdatedBy(types.GetActor(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to increment view count").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+// UpdateRating updates the rating for a place (recalculates average and increments count)
+func (r *PlaceRepository) UpdateRating(ctx context.Context, placeID string, newRating decimal.Decimal) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating place rating",
+		"place_id", placeID,
+		"new_rating", newRating.String())
+
+	// Get current place to calculate new average
+	currentPlace, err := client.Place.Get(ctx, placeID)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to get place for rating update").
+			Mark(ierr.ErrDatabase)
+	}
+
+	// Calculate new rating average
+	currentCount := currentPlace.RatingCount
+	currentAvg := currentPlace.RatingAvg
+
+	newCount := currentCount + 1
+	newAvg := currentAvg.Mul(decimal.NewFromInt(int64(currentCount))).
+		Add(newRating).
+		Div(decimal.NewFromInt(int64(newCount)))
+
+	now := time.Now().UTC()
+	_, err = client.Place.UpdateOneID(placeID).
+		SetRatingAvg(newAvg).
+		SetRatingCount(newCount).
+		SetUpdatedAt(now).
+		SetUpdatedBy(types.GetActor(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to update place rating").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+// UpdatePopularityScore updates the popularity score for a place
+func (r *PlaceRepository) UpdatePopularityScore(ctx context.Context, placeID string, score decimal.Decimal) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating popularity score",
+		"place_id", placeID,
+		"score", score.String())
+
+	now := time.Now().UTC()
+	_, err := client.Place.UpdateOneID(placeID).
+		SetPopularityScore(score).
+		SetUpdatedAt(now).
+		SetUpdatedBy(types.GetActor(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to update popularity score").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+// AssignCategories assigns categories to a place by replacing existing category relationships
+func (r *PlaceRepository) AssignCategories(ctx context.Context, placeID string, categoryIDs []string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("assigning categories to place",
+		"place_id", placeID,
+		"category_count", len(categoryIDs),
+	)
+
+	now := time.Now().UTC()
+
+	_, err := client.Place.UpdateOneID(placeID).
+		ClearCategory().
+		AddCategoryIDs(categoryIDs...).
+		SetUpdatedAt(now).
+		SetUpdatedBy(types.GetActor(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Place with ID %s was not found", placeID).
+				WithReportableDetails(map[string]any{
+					"place_id": placeID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to assign categories to place").
+			WithReportableDetails(map[string]any{
+				"place_id":     placeID,
+				"category_ids": categoryIDs,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+// GetCategoryIDs returns the IDs of the categories assigned to a place
+func (r *PlaceRepository) GetCategoryIDs(ctx context.Context, placeID string) ([]string, error) {
+	client := r.client.Querier(ctx)
+
+	ids, err := client.Place.Query().
+		Where(place.ID(placeID)).
+		QueryCategory().
+		IDs(ctx)
+
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get place categories").
+			WithReportableDetails(map[string]any{
+				"place_id": placeID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// CreateRevision stores a new revision of a place. The unique (place_id, revision)
+// index rejects concurrent writers that picked the same revision number.
+func (r *PlaceRepository) CreateRevision(ctx context.Context, rev *domain.Revision) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating place revision",
+		"place_id", rev.PlaceID,
+		"revision", rev.Revision,
+	)
+
+	snapshot, err := rev.Snapshot.Marshal()
+	if err != nil {
+		return err
+	}
+
+	create := client.PlaceRevision.Create().
+		SetID(rev.ID).
+		SetPlaceID(rev.PlaceID).
+		SetRevision(rev.Revision).
+		SetSnapshot(snapshot).
+		SetNillableRevertedFrom(rev.RevertedFrom).
+		SetStatus(rev.Status).
+		SetCreatedAt(rev.CreatedAt).
+		SetUpdatedAt(rev.UpdatedAt).
+		SetCreatedBy(rev.CreatedBy).
+		SetUpdatedBy(rev.UpdatedBy)
+
+	_, err = create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHintf("Revision %d of the place already exists, please retry", rev.Revision).
+				WithReportableDetails(map[string]any{
+					"place_id": rev.PlaceID,
+					"revision": rev.Revision,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create place revision").
+			WithReportableDetails(map[string]any{
+				"place_id": rev.PlaceID,
+				"revision": rev.Revision,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *PlaceRepository) GetRevision(ctx context.Context, placeID string, revision int) (*domain.Revision, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting place revision",
+		"place_id", placeID,
+		"revision", revision,
+	)
+
+	entRev, err := client.PlaceRevision.Query().
+		Where(
+			placerevision.PlaceID(placeID),
+			placerevision.Revision(revision),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Revision %d of place %s was not found", revision, placeID).
+				WithReportableDetails(map[string]any{
+					"place_id": placeID,
+					"revision": revision,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get place revision").
+			WithReportableDetails(map[string]any{
+				"place_id": placeID,
+				"revision": revision,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domain.FromEntRevision(entRev)
+}
+
+// ListRevisions returns the revisions of a place, newest first
+func (r *PlaceRepository) ListRevisions(ctx context.Context, placeID string, filter *types.QueryFilter) ([]*domain.Revision, error) {
+	client := r.client.Querier(ctx)
+
+	if filter == nil {
+		filter = types.NewDefaultQueryFilter()
+	}
+
+	r.log.Debugw("listing place revisions",
+		"place_id", placeID,
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	query := client.PlaceRevision.Query().
+		Where(placerevision.PlaceID(placeID)).
+		Order(ent.Desc(placerevision.FieldRevision)).
+		Offset(filter.GetOffset())
+	if !filter.IsUnlimited() {
+		query = query.Limit(filter.GetLimit())
+	}
+
+	revs, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list place revisions").
+			WithReportableDetails(map[string]any{
+				"place_id": placeID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domain.FromEntRevisionList(revs)
+}
+
+func (r *PlaceRepository) CountRevisions(ctx context.Context, placeID string) (int, error) {
+	client := r.client.Querier(ctx)
+
+	count, err := client.PlaceRevision.Query().
+		Where(placerevision.PlaceID(placeID)).
+		Count(ctx)
+
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count place revisions").
+			WithReportableDetails(map[string]any{
+				"place_id": placeID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *PlaceRepository) LatestRevisionNumber(ctx context.Context, placeID string) (int, error) {
+	client := r.client.Querier(ctx)
+
+	latest, err := client.PlaceRevision.Query().
+		Where(placerevision.PlaceID(placeID)).
+		Order(ent.Desc(placerevision.FieldRevision)).
+		First(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, ierr.WithError(err).
+			WithHint("Failed to get latest place revision").
+			WithReportableDetails(map[string]any{
+				"place_id": placeID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return latest.Revision, nil
+}
+
+func (r *PlaceRepository) PruneRevisions(ctx context.Context, placeID string, keep int) (int, error) {
+	client := r.client.Querier(ctx)
+
+	latest, err := r.LatestRevisionNumber(ctx, placeID)
+	if err != nil {
+		return 0, err
+	}
+
+	// Revision numbers are dense per place, so everything at or below this cutoff is beyond the cap
+	cutoff := latest - keep
+	if cutoff <= 0 {
+		return 0, nil
+	}
+
+	deleted, err := client.PlaceRevision.Delete().
+		Where(
+			placerevision.PlaceID(placeID),
+			placerevision.RevisionLTE(cutoff),
+		).
+		Exec(ctx)
+
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to prune place revisions").
+			WithReportableDetails(map[string]any{
+				"place_id": placeID,
+				"keep":     keep,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	if deleted > 0 {
+		r.log.Debugw("pruned place revisions",
+			"place_id", placeID,
+			"deleted", deleted,
+			"keep", keep,
+		)
+	}
+
+	return deleted, nil
+}
+
+func (r *PlaceRepository) ChangeSlug(ctx context.Context, placeID, oldSlug, newSlug string) (bool, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("changing place slug",
+		"place_id", placeID,
+		"old_slug", oldSlug,
+		"new_slug", newSlug,
+	)
+
+	updated, err := client.Place.Update().
+		Where(
+			place.ID(placeID),
+			place.Slug(oldSlug),
+			place.StatusNEQ(types.StatusDeleted),
+		).
+		SetSlug(newSlug).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetActor(ctx)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return false, ierr.WithError(err).
+				WithHint("Place with this slug already exists").
+				WithReportableDetails(map[string]any{
+					"place_id": placeID,
+					"slug":     newSlug,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return false, ierr.WithError(err).
+			WithHint("Failed to change place slug").
+			WithReportableDetails(map[string]any{
+				"place_id": placeID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return updated > 0, nil
+}
+
+func (r *PlaceRepository) RecordPreviousSlug(ctx context.Context, prev *domain.PreviousSlug) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("recording previous place slug",
+		"place_id", prev.PlaceID,
+		"slug", prev.Slug,
+	)
+
+	// An old slug leads to the place that gave it up last
+	if _, err := client.PlaceSlug.Delete().
+		Where(placeslug.Slug(prev.Slug)).
+		Exec(ctx); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to record the previous place slug").
+			WithReportableDetails(map[string]any{
+				"place_id": prev.PlaceID,
+				"slug":     prev.Slug,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	_, err := client.PlaceSlug.Create().
+		SetID(prev.ID).
+		SetPlaceID(prev.PlaceID).
+		SetSlug(prev.Slug).
+		SetStatus(prev.Status).
+		SetCreatedAt(prev.CreatedAt).
+		SetUpdatedAt(prev.UpdatedAt).
+		SetCreatedBy(prev.CreatedBy).
+		SetUpdatedBy(prev.UpdatedBy).
+		Save(ctx)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to record the previous place slug").
+			WithReportableDetails(map[string]any{
+				"place_id": prev.PlaceID,
+				"slug":     prev.Slug,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *PlaceRepository) GetIDByPreviousSlug(ctx context.Context, slug string) (string, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting place id by previous slug", "slug", slug)
+
+	prev, err := client.PlaceSlug.Query().
+		Where(placeslug.Slug(slug)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", ierr.WithError(err).
+				WithHintf("Place with slug %s was not found", slug).
+				WithReportableDetails(map[string]any{
+					"slug": slug,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return "", ierr.WithError(err).
+			WithHint("Failed to get place by previous slug").
+			WithReportableDetails(map[string]any{
+				"slug": slug,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return prev.PlaceID, nil
+}
+
+// feedListingMetaQuery reads when the listings view was refreshed and the size of one section.
+// All rows share the refresh time, so a section with no rows still reports it.
+var feedListingMetaQuery = fmt.Sprintf(`
+SELECT max(refreshed_at), coalesce(max(section_total) FILTER (WHERE section = $1), 0)
+FROM %s`, postgres.PlaceFeedListingsView)
+
+var feedListingPageQuery = fmt.Sprintf(`
+SELECT place_id
+FROM %s
+WHERE section = $1 AND rank > $2 AND rank <= $3
+ORDER BY rank`, postgres.PlaceFeedListingsView)
+
+func (r *PlaceRepository) GetFeedListingPage(ctx context.Context, section types.FeedSectionType, limit, offset int) (*domain.FeedListingPage, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("reading feed listings",
+		"section", section,
+		"limit", limit,
+		"offset", offset,
+	)
+
+	page := &domain.FeedListingPage{PlaceIDs: make([]string, 0, limit)}
+
+	var refreshedAt sql.NullTime
+	rows, err := client.QueryContext(ctx, feedListingMetaQuery, string(section))
+	if err == nil {
+		if rows.Next() {
+			err = rows.Scan(&refreshedAt, &page.Total)
+		}
+		if err == nil {
+			err = rows.Err()
+		}
+		rows.Close()
+	}
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to read feed listings").
+			WithReportableDetails(map[string]any{
+				"section": section,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+	if !refreshedAt.Valid {
+		return nil, ierr.NewError("feed listings have not been populated").
+			WithHint("Feed listings are not available yet").
+			Mark(ierr.ErrNotFound)
+	}
+	page.RefreshedAt = refreshedAt.Time
+
+	rows, err = client.QueryContext(ctx, feedListingPageQuery, string(section), offset, offset+limit)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to read feed listings").
+			WithReportableDetails(map[string]any{
+				"section": section,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to read feed listings").
+				Mark(ierr.ErrDatabase)
+		}
+		page.PlaceIDs = append(page.PlaceIDs, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to read feed listings").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return page, nil
+}
+
+// RefreshFeedListings rebuilds the feed listings view without blocking readers
+func (r *PlaceRepository) RefreshFeedListings(ctx context.Context) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("refreshing feed listings")
+
+	if _, err := client.ExecContext(ctx, "REFRESH MATERIALIZED VIEW CONCURRENTLY "+postgres.PlaceFeedListingsView); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to refresh feed listings. Ensure migrations have been run").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+// findDuplicatePairsQuery clusters places with PostGIS DBSCAN and then compares
+// titles pairwise within each cluster using pg_trgm similarity. Clustering runs
+// in web mercator, so eps is scaled by the mean latitude of the scanned places
+// to keep it approximately equal to the requested radius in meters.
+const findDuplicatePairsQuery = `
+WITH candidates AS (
+	SELECT id, slug, title, place_type, latitude, longitude, status, view_count, rating_count, created_at,
+		ST_SetSRID(ST_MakePoint(longitude::float8, latitude::float8), 4326) AS geom
+	FROM places
+	WHERE status <> ALL($1)
+		AND NOT (latitude = 0 AND longitude = 0)
+		AND (cardinality($4::text[]) = 0 OR place_type = ANY($4::text[]))
+),
+clustered AS (
+	SELECT c.*,
+		ST_ClusterDBSCAN(
+			ST_Transform(c.geom, 3857),
+			eps := $2::float8 / (SELECT cos(radians(avg(latitude)::float8)) FROM candidates),
+			minpoints := 2
+		) OVER () AS cluster_id
+	FROM candidates c
+)
+SELECT
+	a.id, a.slug, a.title, a.place_type, a.latitude, a.longitude, a.status, a.view_count, a.rating_count, a.created_at,
+	b.id, b.slug, b.title, b.place_type, b.latitude, b.longitude, b.status, b.view_count, b.rating_count, b.created_at,
+	ST_Distance(a.geom::geography, b.geom::geography) AS distance_m,
+	similarity(a.title, b.title) AS title_similarity
+FROM clustered a
+JOIN clustered b ON a.cluster_id = b.cluster_id AND a.id < b.id
+WHERE a.cluster_id IS NOT NULL
+	AND ST_DWithin(a.geom::geography, b.geom::geography, $2::float8)
+	AND similarity(a.title, b.title) >= $3::float8
+ORDER BY a.cluster_id, title_similarity DESC
+`
+
+// FindDuplicatePairs returns pairs of places that are close to each other and have similar titles.
+// Requires the postgis and pg_trgm extensions.
+func (r *PlaceRepository) FindDuplicatePairs(ctx context.Context, filter *types.PlaceDuplicateFilter) ([]*domain.DuplicatePair, error) {
+	client := r.client.Querier(ctx)
+
+	if filter == nil {
+		filter = types.NewPlaceDuplicateFilter()
+	}
+
+	r.log.Debugw("finding duplicate places",
+		"radius_m", filter.GetRadiusM(),
+		"min_similarity", filter.GetMinSimilarity(),
+		"place_types", filter.PlaceTypes,
+	)
+
+	placeTypes := filter.PlaceTypes
+	if placeTypes == nil {
+		placeTypes = []string{}
+	}
+
+	rows, err := client.QueryContext(ctx, findDuplicatePairsQuery,
+		softDeletedStatuses,
+		filter.GetRadiusM(),
+		filter.GetMinSimilarity(),
+		pq.Array(placeTypes),
+	)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to scan places for duplicates. Ensure the postgis and pg_trgm extensions are installed").
+			WithReportableDetails(map[string]any{
+				"radius_m":       filter.GetRadiusM(),
+				"min_similarity": filter.GetMinSimilarity(),
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	pairs := make([]*domain.DuplicatePair, 0)
+	for rows.Next() {
+		left, right := &domain.DuplicateCandidate{}, &domain.DuplicateCandidate{}
+		pair := &domain.DuplicatePair{Left: left, Right: right}
+		if err := rows.Scan(
+			&left.ID, &left.Slug, &left.Title, &left.PlaceType, &left.Location.Latitude, &left.Location.Longitude,
+			&left.Status, &left.ViewCount, &left.RatingCount, &left.CreatedAt,
+			&right.ID, &right.Slug, &right.Title, &right.PlaceType, &right.Location.Latitude, &right.Location.Longitude,
+			&right.Status, &right.ViewCount, &right.RatingCount, &right.CreatedAt,
+			&pair.DistanceM, &pair.Similarity,
+		); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to read duplicate place candidates").
+				Mark(ierr.ErrDatabase)
+		}
+		pairs = append(pairs, pair)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to read duplicate place candidates").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return pairs, nil
+}
+
+// selectCategoryIDs loads only the IDs of the categories of a place, which is all its
+// completeness needs
+func selectCategoryIDs(q *ent.CategoryQuery) {
+	q.Select(category.FieldID)
+}
+
+// missingFieldPredicate returns the predicate matching places that lack the given field
+func missingFieldPredicate(field types.PlaceField) predicate.Place {
+	switch field {
+	case types.PlaceFieldPrimaryImage:
+		return place.Or(place.PrimaryImageURLIsNil(), place.PrimaryImageURL(""))
+	case types.PlaceFieldLongDescription:
+		return place.Or(place.LongDescriptionIsNil(), place.LongDescription(""))
+	case types.PlaceFieldCoordinates:
+		return place.And(place.LatitudeEQ(decimal.Zero), place.LongitudeEQ(decimal.Zero))
+	case types.PlaceFieldCategories:
+		return place.Not(place.HasCategory())
+	case types.PlaceFieldContact:
+		return place.ContactIsNil()
+	default:
+		return nil
+	}
+}
+
+// incompleteQuery builds the single filtered query shared by ListIncomplete and CountIncomplete
+func (r *PlaceRepository) incompleteQuery(ctx context.Context, filter *types.PlaceIncompleteFilter) PlaceQuery {
+	predicates := lo.FilterMap(filter.GetMissing(), func(field types.PlaceField, _ int) (predicate.Place, bool) {
+		p := missingFieldPredicate(field)
+		return p, p != nil
+	})
+
+	query := r.client.Querier(ctx).Place.Query().
+		Where(place.Or(predicates...))
+
+	return ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+}
+
+// ListIncomplete returns places missing any of the requested fields, with the full list of what each is missing
+func (r *PlaceRepository) ListIncomplete(ctx context.Context, filter *types.PlaceIncompleteFilter) ([]*domain.IncompletePlace, error) {
+	if filter == nil {
+		filter = types.NewPlaceIncompleteFilter()
+	}
+
+	r.log.Debugw("listing incomplete places",
+		"missing", filter.GetMissing(),
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	query := r.incompleteQuery(ctx, filter).
+		WithCategory(selectCategoryIDs)
+	query = ApplySorting(query, filter, r.queryOpts)
+	query = ApplyPagination(query, filter, r.queryOpts)
+
+	places, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list incomplete places").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return lo.Map(places, func(p *ent.Place, _ int) *domain.IncompletePlace {
+		dp := domain.FromEnt(p)
+		return &domain.IncompletePlace{
+			ID:        dp.ID,
+			Slug:      dp.Slug,
+			Title:     dp.Title,
+			PlaceType: dp.PlaceType,
+			Status:    dp.Status,
+			UpdatedAt: dp.UpdatedAt,
+			Missing:   dp.MissingFields(len(p.Edges.Category)),
+		}
+	}), nil
+}
+
+// CountIncomplete counts places missing any of the requested fields
+func (r *PlaceRepository) CountIncomplete(ctx context.Context, filter *types.PlaceIncompleteFilter) (int, error) {
+	if filter == nil {
+		filter = types.NewPlaceIncompleteFilter()
+	}
+
+	count, err := r.incompleteQuery(ctx, filter).Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count incomplete places").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
 }
@@ -0,0 +1,63 @@
+package ent
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/omkar273/nashikdarshan/ent"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+)
+
+func TestPlaceQueryOptionsTitleCollation(t *testing.T) {
+	tests := []struct {
+		name      string
+		collation types.Collation
+		sort      string
+		wantOrder string
+	}{
+		{
+			name:      "marathi",
+			collation: types.CollationMarathi,
+			sort:      "title:asc",
+			wantOrder: `ORDER BY "places"."title" COLLATE "title_mr" ASC, "places"."id" ASC`,
+		},
+		{
+			name:      "root, descending",
+			collation: types.CollationRoot,
+			sort:      "title:desc",
+			wantOrder: `ORDER BY "places"."title" COLLATE "title_und" DESC, "places"."id" ASC`,
+		},
+		{
+			name:      "binary",
+			collation: types.CollationBinary,
+			sort:      "title:asc",
+			wantOrder: `ORDER BY "places"."title" ASC, "places"."id" ASC`,
+		},
+		{
+			name:      "other fields ignore the collation",
+			collation: types.CollationMarathi,
+			sort:      "rating_avg:desc,title:asc",
+			wantOrder: `ORDER BY "places"."rating_avg" DESC, "places"."title" COLLATE "title_mr" ASC, "places"."id" ASC`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var query string
+			client := ent.NewClient(ent.Driver(recordingDriver{query: &query}))
+
+			opts := PlaceQueryOptions{TitleCollation: tt.collation.SQLName()}
+			q := ApplySorting(client.Place.Query(), &types.QueryFilter{Sort: lo.ToPtr(tt.sort)}, opts)
+			if _, err := q.IDs(context.Background()); !errors.Is(err, errRecorded) {
+				t.Fatalf("IDs() error = %v, want the recorded statement", err)
+			}
+
+			if !strings.HasSuffix(query, tt.wantOrder) {
+				t.Errorf("query = %s, want it to end with %s", query, tt.wantOrder)
+			}
+		})
+	}
+}
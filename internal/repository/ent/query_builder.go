@@ -3,11 +3,22 @@ package ent
 import (
 	"context"
 
+	"github.com/lib/pq"
 	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
 )
 
+// softDeletedStatuses binds types.SoftDeletedStatuses to raw queries, e.g. status <> ALL($1)
+var softDeletedStatuses = pq.StringArray(lo.Map(types.SoftDeletedStatuses, func(s types.Status, _ int) string {
+	return string(s)
+}))
+
 // ApplyBaseFilters applies common filters like status using the query options
 func ApplyBaseFilters[T any](ctx context.Context, query T, filter types.BaseFilter, opts BaseQueryOptions[T]) T {
+	// Without an explicit status, filters that opted into deleted rows skip the status predicate
+	if f, ok := filter.(types.IncludeDeletedFilter); ok && f.GetIncludeDeleted() && filter.GetStatus() == "" {
+		return query
+	}
 	query = opts.ApplyStatusFilter(query, filter.GetStatus())
 	return query
 }
@@ -24,13 +35,21 @@ func ApplyPagination[T any](query T, filter types.BaseFilter, opts BaseQueryOpti
 	return query
 }
 
-// ApplySorting applies sorting to the query
+// ApplySorting applies each key of a (possibly multi-column) sort in order, followed by id
+// as a tiebreaker so that rows with equal sort values paginate consistently
 func ApplySorting[T any](query T, filter types.BaseFilter, opts BaseQueryOptions[T]) T {
-	if filter == nil {
-		// Apply default sorting if filter is nil
-		return opts.ApplySortFilter(query, "created_at", "desc")
+	fields := []types.SortField{{Field: types.FILTER_DEFAULT_SORT, Order: types.FILTER_DEFAULT_ORDER}}
+	if filter != nil {
+		// Filters validate their sort; a malformed one falls back to the default order
+		if parsed, err := types.ParseSort(filter.GetSort(), filter.GetOrder()); err == nil {
+			fields = parsed
+		}
 	}
-	return opts.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	for _, f := range types.WithIDTiebreaker(fields) {
+		query = opts.ApplySortFilter(query, f.Field, f.Order)
+	}
+	return query
 }
 
 // ApplyQueryOptions applies all common query options (base filters, pagination, sorting)
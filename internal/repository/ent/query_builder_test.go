@@ -0,0 +1,90 @@
+package ent
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/omkar273/nashikdarshan/ent"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+)
+
+// recordingDriver keeps the last statement it was asked to run and fails it
+type recordingDriver struct {
+	query *string
+}
+
+var errRecorded = errors.New("recorded")
+
+func (d recordingDriver) Exec(_ context.Context, query string, _, _ any) error {
+	*d.query = query
+	return errRecorded
+}
+
+func (d recordingDriver) Query(_ context.Context, query string, _, _ any) error {
+	*d.query = query
+	return errRecorded
+}
+
+func (recordingDriver) Tx(context.Context) (dialect.Tx, error) { return nil, errRecorded }
+func (recordingDriver) Close() error                           { return nil }
+func (recordingDriver) Dialect() string                        { return dialect.Postgres }
+
+func TestApplySorting(t *testing.T) {
+	tests := []struct {
+		name      string
+		sort      string
+		order     string
+		wantOrder string
+	}{
+		{
+			// Places with equal titles keep their relative order across pages because id breaks the tie
+			name:      "single key",
+			sort:      "title",
+			order:     "asc",
+			wantOrder: `ORDER BY "places"."title" ASC, "places"."id" ASC LIMIT 2 OFFSET 2`,
+		},
+		{
+			name:      "multiple keys",
+			sort:      "rating_avg:desc,title:asc",
+			wantOrder: `ORDER BY "places"."rating_avg" DESC, "places"."title" ASC, "places"."id" ASC LIMIT 2 OFFSET 2`,
+		},
+		{
+			name:      "explicit id is not repeated",
+			sort:      "title,id:desc",
+			order:     "asc",
+			wantOrder: `ORDER BY "places"."title" ASC, "places"."id" DESC LIMIT 2 OFFSET 2`,
+		},
+		{
+			name:      "malformed sort falls back to the default",
+			sort:      "title:sideways",
+			wantOrder: `ORDER BY "places"."created_at" DESC, "places"."id" ASC LIMIT 2 OFFSET 2`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var query string
+			client := ent.NewClient(ent.Driver(recordingDriver{query: &query}))
+
+			filter := &types.QueryFilter{
+				Limit:  lo.ToPtr(2),
+				Offset: lo.ToPtr(2),
+				Sort:   lo.ToPtr(tt.sort),
+				Order:  lo.ToPtr(tt.order),
+			}
+			q := ApplySorting(client.Place.Query(), filter, PlaceQueryOptions{})
+			q = ApplyPagination(q, filter, PlaceQueryOptions{})
+			if _, err := q.IDs(context.Background()); !errors.Is(err, errRecorded) {
+				t.Fatalf("IDs() error = %v, want the recorded statement", err)
+			}
+
+			if !strings.HasSuffix(query, tt.wantOrder) {
+				t.Errorf("query = %s, want it to end with %s", query, tt.wantOrder)
+			}
+		})
+	}
+}
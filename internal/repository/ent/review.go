@@ -49,11 +49,11 @@ func (r *ReviewRepository) Create(ctx context.Context, rev *reviewDomain.Review)
 		SetEntityID(rev.EntityID).
 		SetUserID(rev.UserID).
 		SetRating(rev.Rating).
-		SetStatus(string(rev.Status)).
+		SetStatus(rev.Status).
 		SetCreatedAt(now).
 		SetUpdatedAt(now).
-		SetCreatedBy(types.GetUserID(ctx)).
-		SetUpdatedBy(types.GetUserID(ctx))
+		SetCreatedBy(types.GetActor(ctx)).
+		SetUpdatedBy(types.GetActor(ctx))
 
 	if rev.Title != nil {
 		create = create.SetTitle(*rev.Title)
@@ -120,7 +120,7 @@ func (r *ReviewRepository) Update(ctx context.Context, id string, rev *reviewDom
 
 	query := client.Review.UpdateOneID(id).
 		Where(review.UserID(userID)). // Ensure only creator can update
-		SetUpdatedBy(userID)
+		SetUpdatedBy(types.GetActor(ctx))
 
 	if rev.Rating.IsPositive() {
 		query = query.SetRating(rev.Rating)
@@ -258,7 +258,7 @@ func (r *ReviewRepository) GetAverageRating(ctx context.Context, entityType type
 		Where(
 			review.EntityType(string(entityType)),
 			review.EntityID(entityID),
-			review.Status(string(types.StatusPublished)),
+			review.Status(types.StatusPublished),
 		).
 		Aggregate(ent.Mean(review.FieldRating)).
 		Scan(ctx, &avgRating)
@@ -295,7 +295,7 @@ func (r *ReviewRepository) GetRatingDistribution(ctx context.Context, entityType
 		Where(
 			review.EntityType(string(entityType)),
 			review.EntityID(entityID),
-			review.Status(string(types.StatusPublished)),
+			review.Status(types.StatusPublished),
 		).
 		GroupBy(review.FieldRating).
 		Aggregate(ent.Count()).
@@ -449,7 +449,7 @@ func (r *ReviewRepository) GetAverageRatingByTimeRange(ctx context.Context, enti
 		Where(
 			review.EntityType(string(entityType)),
 			review.EntityID(entityID),
-			review.Status(string(types.StatusPublished)),
+			review.Status(types.StatusPublished),
 		)
 
 	// Apply time range filter if provided
@@ -489,9 +489,9 @@ func (opts ReviewQueryOptions) ApplyStatusFilter(query *ent.ReviewQuery, status
 	if status == "" {
 		// By default, exclude archived and deleted items from queries
 		// Archived can be restored, deleted is permanent
-		return query.Where(review.StatusNotIn(string(types.StatusArchived), string(types.StatusDeleted)))
+		return query.Where(review.StatusNotIn(types.StatusArchived, types.StatusDeleted))
 	}
-	return query.Where(review.Status(status))
+	return query.Where(review.Status(types.Status(status)))
 }
 
 // ApplySortFilter applies sorting to review queries
@@ -566,6 +566,8 @@ func (opts ReviewQueryOptions) GetFieldName(field string) string {
 		return review.FieldEntityID
 	case "user_id":
 		return review.FieldUserID
+	case "id":
+		return review.FieldID
 	default:
 		return review.FieldCreatedAt
 	}
@@ -43,7 +43,7 @@ func (r *UserRepository) Create(ctx context.Context, userData *domainUser.User)
 		SetPhone(userData.Phone).
 		SetName(userData.Name).
 		SetRole(string(userData.Role)).
-		SetStatus(string(userData.Status)).
+		SetStatus(userData.Status).
 		SetCreatedAt(userData.CreatedAt).
 		SetUpdatedAt(userData.UpdatedAt).
 		SetCreatedBy(userData.CreatedBy).
@@ -109,7 +109,7 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*domainU
 	entUser, err := client.User.Query().
 		Where(
 			user.Email(email),
-			user.StatusNotIn(string(types.StatusArchived), string(types.StatusDeleted)),
+			user.StatusNotIn(types.StatusArchived, types.StatusDeleted),
 		).
 		Only(ctx)
 
@@ -214,9 +214,9 @@ func (r *UserRepository) Update(ctx context.Context, userData *domainUser.User)
 		SetPhone(userData.Phone).
 		SetName(userData.Name).
 		SetRole(string(userData.Role)).
-		SetStatus(string(userData.Status)).
+		SetStatus(userData.Status).
 		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx)).
+		SetUpdatedBy(types.GetActor(ctx)).
 		Save(ctx)
 
 	if err != nil {
@@ -256,9 +256,9 @@ func (r *UserRepository) Delete(ctx context.Context, userData *domainUser.User)
 	)
 
 	_, err := client.User.UpdateOneID(userData.ID).
-		SetStatus(string(types.StatusArchived)).
+		SetStatus(types.StatusArchived).
 		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx)).
+		SetUpdatedBy(types.GetActor(ctx)).
 		Save(ctx)
 
 	if err != nil {
@@ -294,9 +294,9 @@ func (o UserQueryOptions) ApplyStatusFilter(query UserQuery, status string) User
 	if status == "" {
 		// By default, exclude archived and deleted items from queries
 		// Archived can be restored, deleted is permanent
-		return query.Where(user.StatusNotIn(string(types.StatusArchived), string(types.StatusDeleted)))
+		return query.Where(user.StatusNotIn(types.StatusArchived, types.StatusDeleted))
 	}
-	return query.Where(user.Status(status))
+	return query.Where(user.Status(types.Status(status)))
 }
 
 func (o UserQueryOptions) ApplySortFilter(query UserQuery, field string, order string) UserQuery {
@@ -1,16 +1,20 @@
 package repository
 
 import (
+	"github.com/omkar273/nashikdarshan/internal/cache"
 	"github.com/omkar273/nashikdarshan/internal/config"
+	"github.com/omkar273/nashikdarshan/internal/domain/apikey"
 	"github.com/omkar273/nashikdarshan/internal/domain/category"
 	eventdomain "github.com/omkar273/nashikdarshan/internal/domain/event"
 	"github.com/omkar273/nashikdarshan/internal/domain/hotel"
 	itinerarydomain "github.com/omkar273/nashikdarshan/internal/domain/itinerary"
+	"github.com/omkar273/nashikdarshan/internal/domain/outbox"
 	"github.com/omkar273/nashikdarshan/internal/domain/place"
 	"github.com/omkar273/nashikdarshan/internal/domain/review"
 	"github.com/omkar273/nashikdarshan/internal/domain/user"
 	"github.com/omkar273/nashikdarshan/internal/logger"
 	"github.com/omkar273/nashikdarshan/internal/postgres"
+	"github.com/omkar273/nashikdarshan/internal/repository/cached"
 	"github.com/omkar273/nashikdarshan/internal/repository/ent"
 	"go.uber.org/fx"
 )
@@ -39,12 +43,25 @@ func NewUserRepository(params RepositoryParams) user.Repository {
 	return ent.NewUserRepository(params.Client, params.Logger)
 }
 
-func NewCategoryRepository(params RepositoryParams) category.Repository {
-	return ent.NewCategoryRepository(params.Client, params.Logger)
+// NewCacheStore creates the cache shared by the caching repository decorators
+func NewCacheStore(config *config.Configuration) *cache.Store {
+	return cache.NewStore(config.Cache.IsEnabled(), config.Cache.GetTTL(), config.Cache.GetMaxEntries())
 }
 
-func NewPlaceRepository(params RepositoryParams) place.Repository {
-	return ent.NewPlaceRepository(params.Client, params.Logger)
+func NewCategoryRepository(params RepositoryParams, store *cache.Store) category.Repository {
+	repo := ent.NewCategoryRepository(params.Client, params.Logger)
+	if !store.Enabled() {
+		return repo
+	}
+	return cached.NewCategoryRepository(repo, store, params.Logger)
+}
+
+func NewPlaceRepository(params RepositoryParams, store *cache.Store) place.Repository {
+	repo := ent.NewPlaceRepository(params.Client, params.Logger)
+	if !store.Enabled() {
+		return repo
+	}
+	return cached.NewPlaceRepository(repo, store, params.Config.Cache.GetCountTTL(), params.Logger)
 }
 
 func NewReviewRepository(params RepositoryParams) review.Repository {
@@ -62,3 +79,11 @@ func NewEventRepository(params RepositoryParams) eventdomain.Repository {
 func NewItineraryRepository(params RepositoryParams) itinerarydomain.Repository {
 	return ent.NewItineraryRepository(params.Client, params.Logger)
 }
+
+func NewOutboxRepository(params RepositoryParams) outbox.Repository {
+	return ent.NewOutboxRepository(params.Client, params.Logger)
+}
+
+func NewAPIKeyRepository(params RepositoryParams) apikey.Repository {
+	return ent.NewAPIKeyRepository(params.Client, params.Logger)
+}
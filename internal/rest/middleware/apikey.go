@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/logger"
+	"github.com/omkar273/nashikdarshan/internal/service"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// APIKeyAuth authenticates requests carrying an X-API-Key header and sets a synthetic
+// principal (the key ID and label) with the key's scopes. Requests without the header pass through
+// untouched so that JWT authentication can still apply.
+func APIKeyAuth(apiKeyService service.APIKeyService, logger *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rawKey := c.GetHeader(types.HeaderAPIKey)
+		if rawKey == "" {
+			c.Next()
+			return
+		}
+
+		key, err := apiKeyService.Authenticate(c.Request.Context(), rawKey)
+		if err != nil {
+			logger.Warnw("api key authentication failed", "error", err)
+			c.Error(err)
+			c.Abort()
+			return
+		}
+
+		ctx := c.Request.Context()
+		ctx = context.WithValue(ctx, types.CtxAPIKeyID, key.ID)
+		ctx = context.WithValue(ctx, types.CtxAPIKeyLabel, key.Label)
+		ctx = context.WithValue(ctx, types.CtxScopes, key.Scopes)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
@@ -2,50 +2,82 @@ package middleware
 
 import (
 	"context"
-	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/omkar273/nashikdarshan/internal/auth"
 	"github.com/omkar273/nashikdarshan/internal/config"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
 	"github.com/omkar273/nashikdarshan/internal/logger"
+	"github.com/omkar273/nashikdarshan/internal/service"
 	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
 )
 
-// setContextValues sets the user ID and user email in the context
-func setContextValues(c *gin.Context, userID, userEmail string) {
+// setContextValues sets the user ID, user email and granted scopes in the context
+func setContextValues(c *gin.Context, userID, userEmail string, scopes []string) {
 	ctx := c.Request.Context()
 	ctx = context.WithValue(ctx, types.CtxUserID, userID)
 	ctx = context.WithValue(ctx, types.CtxUserEmail, userEmail)
+	ctx = context.WithValue(ctx, types.CtxScopes, scopes)
 
 	c.Request = c.Request.WithContext(ctx)
 }
 
+// abortUnauthenticated stops the request with an ErrUnauthenticated error carrying the reason
+func abortUnauthenticated(c *gin.Context, reason string, hint string) {
+	c.Error(ierr.NewError(reason).
+		WithHint(hint).
+		WithReportableDetails(map[string]any{
+			"reason": reason,
+		}).
+		Mark(ierr.ErrUnauthenticated))
+	c.Abort()
+}
+
 // GuestAuthenticateMiddleware is a middleware that allows requests without authentication
 // For now it sets a default user ID and user email in the request context
 func GuestAuthenticateMiddleware(c *gin.Context) {
 	c.Next()
 }
 
+// OptionalAuthenticateMiddleware runs authenticate only when the request carries credentials,
+// so public routes keep serving anonymous callers while still recognising signed-in principals
+func OptionalAuthenticateMiddleware(authenticate gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetHeader(types.HeaderAuthorization) == "" {
+			c.Next()
+			return
+		}
+		authenticate(c)
+	}
+}
+
 // AuthenticateMiddleware is a middleware that authenticates requests based on either:
-// 1. JWT token in the Authorization header as a Bearer token
-func AuthenticateMiddleware(cfg *config.Configuration, logger *logger.Logger) gin.HandlerFunc {
+// 1. An API key already resolved by APIKeyAuth
+// 2. JWT token in the Authorization header as a Bearer token
+// Scopes granted to a user are the scopes carried by the token plus the defaults of their role.
+func AuthenticateMiddleware(cfg *config.Configuration, logger *logger.Logger, userService service.UserService) gin.HandlerFunc {
 
 	return func(c *gin.Context) {
+		// Requests already authenticated by APIKeyAuth do not need a JWT
+		if types.GetAPIKeyID(c.Request.Context()) != "" {
+			c.Next()
+			return
+		}
+
 		authProvider := auth.NewSupabaseProvider(cfg, logger)
 
 		// If no API key, check for JWT token
 		authHeader := c.GetHeader(types.HeaderAuthorization)
 		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
-			c.Abort()
+			abortUnauthenticated(c, "missing_authorization", "Authorization header is required")
 			return
 		}
 
 		// Check if the authorization header is in the correct format
 		if !strings.HasPrefix(authHeader, "Bearer ") {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization header format"})
-			c.Abort()
+			abortUnauthenticated(c, "invalid_authorization_format", "Invalid authorization header format")
 			return
 		}
 
@@ -53,18 +85,35 @@ func AuthenticateMiddleware(cfg *config.Configuration, logger *logger.Logger) gi
 		claims, err := authProvider.ValidateToken(c.Request.Context(), tokenString)
 		if err != nil {
 			logger.Errorw("failed to validate token", "error", err)
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+			if !ierr.IsUnauthenticated(err) {
+				err = ierr.WithError(err).
+					WithHint("Invalid token").
+					Mark(ierr.ErrUnauthenticated)
+			}
+			c.Error(err)
 			c.Abort()
 			return
 		}
 
 		if claims == nil || claims.UserID == "" || claims.Email == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token claims"})
+			abortUnauthenticated(c, "invalid_token_claims", "Invalid token claims")
+			return
+		}
+
+		role := types.UserRoleUser
+		u, err := userService.Get(c.Request.Context(), claims.UserID)
+		if err != nil && !ierr.IsNotFound(err) {
+			logger.Errorw("failed to resolve user role", "user_id", claims.UserID, "error", err)
+			c.Error(err)
 			c.Abort()
 			return
 		}
+		if u != nil {
+			role = u.Role
+		}
 
-		setContextValues(c, claims.UserID, claims.Email)
+		scopes := lo.Uniq(append(claims.Scopes, types.DefaultScopesForRole(role)...))
+		setContextValues(c, claims.UserID, claims.Email, scopes)
 		c.Next()
 	}
 }
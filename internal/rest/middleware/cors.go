@@ -2,20 +2,141 @@ package middleware
 
 import (
 	"net/http"
+	"slices"
+	"strings"
+	"sync"
 
 	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/types"
 )
 
-// CORSMiddleware handles CORS headers
-func CORSMiddleware(c *gin.Context) {
-	c.Writer.Header().Set("Access-Control-Allow-Origin", "*") // TODO: Set to specific origin
-	c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-	c.Writer.Header().Set("Access-Control-Allow-Headers", "*")
-	c.Writer.Header().Set("Access-Control-Max-Age", "86400")
+// corsMaxAge is how long, in seconds, browsers may cache a preflight response
+const corsMaxAge = "86400"
 
-	if c.Request.Method == "OPTIONS" {
-		c.AbortWithStatus(http.StatusOK)
-		return
+// corsExposedHeaders are the response headers scripts may read besides the CORS-safelisted
+// ones and the request ID header
+var corsExposedHeaders = []string{
+	types.HeaderRetryAfter,
+	types.HeaderRateLimitLimit,
+	types.HeaderRateLimitRemaining,
+	types.HeaderRateLimitReset,
+	"Server-Timing",
+}
+
+// corsMethods are the methods checked against the route table, in the order they are listed
+var corsMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+}
+
+// CORSMiddleware answers cross-origin requests from the allowed origins. A listed Origin is
+// echoed back rather than answered with "*", which browsers reject on credentialed requests.
+//
+// OPTIONS requests are answered here with 204 and the methods registered on router for the
+// request path, so a preflight for PUT /v1/places/:id allows exactly what that route serves.
+// OPTIONS on an unknown path falls through to the not-found handler.
+func CORSMiddleware(router *gin.Engine, allowedOrigins []string, allowCredentials bool, requestIDHeader string) gin.HandlerFunc {
+	routes := &routeTable{router: router}
+	anyOrigin := slices.Contains(allowedOrigins, "*")
+	exposedHeaders := strings.Join(append([]string{requestIDHeader}, corsExposedHeaders...), ", ")
+
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		allowOrigin := origin != "" && (anyOrigin || slices.Contains(allowedOrigins, origin))
+
+		header := c.Writer.Header()
+		if origin != "" {
+			// The response depends on the origin even when it is refused
+			header.Add("Vary", "Origin")
+		}
+		if allowOrigin {
+			header.Set("Access-Control-Allow-Origin", origin)
+			header.Set("Access-Control-Expose-Headers", exposedHeaders)
+			if allowCredentials {
+				header.Set("Access-Control-Allow-Credentials", "true")
+			}
+		}
+
+		if c.Request.Method != http.MethodOptions {
+			c.Next()
+			return
+		}
+
+		methods := routes.methods(c.Request.URL.Path)
+		if len(methods) == 0 {
+			c.Next()
+			return
+		}
+		allowed := strings.Join(append(methods, http.MethodOptions), ", ")
+		header.Set("Allow", allowed)
+
+		// A preflight names the method it is asking about; plain OPTIONS only wants Allow
+		if allowOrigin && c.GetHeader("Access-Control-Request-Method") != "" {
+			header.Add("Vary", "Access-Control-Request-Method")
+			header.Add("Vary", "Access-Control-Request-Headers")
+			header.Set("Access-Control-Allow-Methods", allowed)
+			if requested := c.GetHeader("Access-Control-Request-Headers"); requested != "" {
+				header.Set("Access-Control-Allow-Headers", requested)
+			}
+			header.Set("Access-Control-Max-Age", corsMaxAge)
+		}
+		c.AbortWithStatus(http.StatusNoContent)
 	}
-	c.Next()
+}
+
+// routeTable matches request paths against the routes registered on a router. The routes are
+// read on first use, after the router has been fully set up.
+type routeTable struct {
+	router *gin.Engine
+	once   sync.Once
+	routes map[string][][]string // method -> route paths split into segments
+}
+
+// methods returns the methods with a route matching path, in corsMethods order
+func (t *routeTable) methods(path string) []string {
+	t.once.Do(t.load)
+
+	segments := splitPath(path)
+	methods := make([]string, 0, len(corsMethods))
+	for _, method := range corsMethods {
+		for _, route := range t.routes[method] {
+			if matchRoute(route, segments) {
+				methods = append(methods, method)
+				break
+			}
+		}
+	}
+	return methods
+}
+
+func (t *routeTable) load() {
+	t.routes = make(map[string][][]string)
+	for _, route := range t.router.Routes() {
+		t.routes[route.Method] = append(t.routes[route.Method], splitPath(route.Path))
+	}
+}
+
+// matchRoute reports whether a request path matches a route path in gin syntax, where
+// :name matches one segment and *name the rest of the path
+func matchRoute(route, path []string) bool {
+	for i, segment := range route {
+		if strings.HasPrefix(segment, "*") {
+			return true
+		}
+		if i >= len(path) {
+			return false
+		}
+		if !strings.HasPrefix(segment, ":") && segment != path[i] {
+			return false
+		}
+	}
+	return len(route) == len(path)
+}
+
+func splitPath(path string) []string {
+	return strings.FieldsFunc(path, func(r rune) bool { return r == '/' })
 }
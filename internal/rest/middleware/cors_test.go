@@ -0,0 +1,170 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestCORSMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.HandleMethodNotAllowed = true
+	router.Use(
+		CORSMiddleware(router, []string{"https://nashik.example.com"}, true, "X-Request-ID"),
+		ErrorHandler(),
+	)
+	router.NoRoute(NoRouteHandler)
+	router.NoMethod(NoMethodHandler)
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/v1/places", ok)
+	router.POST("/v1/places", ok)
+	router.GET("/v1/places/:id", ok)
+	router.PUT("/v1/places/:id", ok)
+	router.DELETE("/v1/places/:id", ok)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		header map[string]string
+
+		wantStatus        int
+		wantAllowOrigin   string
+		wantAllowMethods  string
+		wantAllow         string
+		wantVary          []string
+		wantCredentials   bool
+		wantExposeHeaders bool
+	}{
+		{
+			name:              "listed origin",
+			method:            http.MethodGet,
+			path:              "/v1/places",
+			header:            map[string]string{"Origin": "https://nashik.example.com"},
+			wantStatus:        http.StatusOK,
+			wantAllowOrigin:   "https://nashik.example.com",
+			wantVary:          []string{"Origin"},
+			wantCredentials:   true,
+			wantExposeHeaders: true,
+		},
+		{
+			name:       "refused origin",
+			method:     http.MethodGet,
+			path:       "/v1/places",
+			header:     map[string]string{"Origin": "https://evil.example.com"},
+			wantStatus: http.StatusOK,
+			wantVary:   []string{"Origin"},
+		},
+		{
+			name:       "same-origin request",
+			method:     http.MethodGet,
+			path:       "/v1/places",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "preflight on a place",
+			method: http.MethodOptions,
+			path:   "/v1/places/place_01J9Z3K4M5N6P7Q8R9S0T1V2W3",
+			header: map[string]string{
+				"Origin":                         "https://nashik.example.com",
+				"Access-Control-Request-Method":  http.MethodPut,
+				"Access-Control-Request-Headers": "Authorization, Content-Type",
+			},
+			wantStatus:        http.StatusNoContent,
+			wantAllowOrigin:   "https://nashik.example.com",
+			wantAllowMethods:  "GET, PUT, DELETE, OPTIONS",
+			wantAllow:         "GET, PUT, DELETE, OPTIONS",
+			wantVary:          []string{"Origin", "Access-Control-Request-Method", "Access-Control-Request-Headers"},
+			wantCredentials:   true,
+			wantExposeHeaders: true,
+		},
+		{
+			name:   "preflight on the collection",
+			method: http.MethodOptions,
+			path:   "/v1/places",
+			header: map[string]string{
+				"Origin":                        "https://nashik.example.com",
+				"Access-Control-Request-Method": http.MethodPost,
+			},
+			wantStatus:        http.StatusNoContent,
+			wantAllowOrigin:   "https://nashik.example.com",
+			wantAllowMethods:  "GET, POST, OPTIONS",
+			wantAllow:         "GET, POST, OPTIONS",
+			wantVary:          []string{"Origin", "Access-Control-Request-Method", "Access-Control-Request-Headers"},
+			wantCredentials:   true,
+			wantExposeHeaders: true,
+		},
+		{
+			name:   "preflight from a refused origin",
+			method: http.MethodOptions,
+			path:   "/v1/places/place_01J9Z3K4M5N6P7Q8R9S0T1V2W3",
+			header: map[string]string{
+				"Origin":                        "https://evil.example.com",
+				"Access-Control-Request-Method": http.MethodDelete,
+			},
+			wantStatus: http.StatusNoContent,
+			wantAllow:  "GET, PUT, DELETE, OPTIONS",
+			wantVary:   []string{"Origin"},
+		},
+		{
+			name:       "plain OPTIONS",
+			method:     http.MethodOptions,
+			path:       "/v1/places/place_01J9Z3K4M5N6P7Q8R9S0T1V2W3",
+			wantStatus: http.StatusNoContent,
+			wantAllow:  "GET, PUT, DELETE, OPTIONS",
+		},
+		{
+			name:   "OPTIONS on an unknown path",
+			method: http.MethodOptions,
+			path:   "/v1/nowhere",
+			header: map[string]string{
+				"Origin":                        "https://nashik.example.com",
+				"Access-Control-Request-Method": http.MethodGet,
+			},
+			wantStatus:        http.StatusNotFound,
+			wantAllowOrigin:   "https://nashik.example.com",
+			wantVary:          []string{"Origin"},
+			wantCredentials:   true,
+			wantExposeHeaders: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			for k, v := range tt.header {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.wantStatus, w.Body)
+			}
+			header := w.Header()
+			for name, want := range map[string]string{
+				"Access-Control-Allow-Origin":  tt.wantAllowOrigin,
+				"Access-Control-Allow-Methods": tt.wantAllowMethods,
+				"Allow":                        tt.wantAllow,
+			} {
+				if got := header.Get(name); got != want {
+					t.Errorf("%s = %q, want %q", name, got, want)
+				}
+			}
+			if got := header.Values("Vary"); !slices.Equal(got, tt.wantVary) {
+				t.Errorf("Vary = %q, want %q", got, tt.wantVary)
+			}
+			if got := header.Get("Access-Control-Allow-Credentials") == "true"; got != tt.wantCredentials {
+				t.Errorf("credentials allowed = %v, want %v", got, tt.wantCredentials)
+			}
+			if got := header.Get("Access-Control-Expose-Headers") != ""; got != tt.wantExposeHeaders {
+				t.Errorf("exposed headers = %q, want them set %v", header.Get("Access-Control-Expose-Headers"), tt.wantExposeHeaders)
+			}
+		})
+	}
+}
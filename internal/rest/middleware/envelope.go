@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"context"
+	"mime"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// ListEnvelopeMiddleware negotiates the shape of list responses. A supported profile
+// parameter on an Accept media range (e.g. `application/json; profile="data-meta"`)
+// wins; otherwise the configured default applies.
+func ListEnvelopeMiddleware(defaultEnvelope types.ListEnvelope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		envelope := acceptedListEnvelope(c.GetHeader("Accept"))
+		if envelope == "" {
+			envelope = defaultEnvelope
+		}
+
+		ctx := context.WithValue(c.Request.Context(), types.CtxListEnvelope, envelope)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
+
+// acceptedListEnvelope returns the first supported envelope profile in the Accept header.
+// Unknown profiles are ignored rather than rejected, as Accept is only a preference.
+func acceptedListEnvelope(accept string) types.ListEnvelope {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		_, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+		// profile may list several space-separated values
+		for _, profile := range strings.Fields(params["profile"]) {
+			if envelope := types.ListEnvelope(profile); envelope.Validate() == nil {
+				return envelope
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+func TestListEnvelopeMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	list := func(c *gin.Context) {
+		resp := types.NewListResponse([]string{"Ramkund", "Kalaram Temple"}, 12, 2, 0)
+		resp.SetLinks(c.Request.URL)
+		c.JSON(http.StatusOK, resp.Envelope(types.GetListEnvelope(c.Request.Context())))
+	}
+
+	tests := []struct {
+		name            string
+		defaultEnvelope types.ListEnvelope
+		accept          string
+		wantKeys        []string
+		wantItems       string
+		wantMeta        string
+	}{
+		{name: "flat by default", defaultEnvelope: types.ListEnvelopeFlat, accept: "application/json", wantKeys: []string{"items", "links", "pagination"}, wantItems: "items", wantMeta: "pagination"},
+		{name: "data-meta profile", defaultEnvelope: types.ListEnvelopeFlat, accept: `application/json; profile="data-meta"`, wantKeys: []string{"data", "links", "meta"}, wantItems: "data", wantMeta: "meta"},
+		{name: "data-meta among profiles", defaultEnvelope: types.ListEnvelopeFlat, accept: `text/html, application/json; profile="urn:example data-meta"`, wantKeys: []string{"data", "links", "meta"}, wantItems: "data", wantMeta: "meta"},
+		{name: "data-meta configured", defaultEnvelope: types.ListEnvelopeDataMeta, wantKeys: []string{"data", "links", "meta"}, wantItems: "data", wantMeta: "meta"},
+		{name: "flat profile overrides the default", defaultEnvelope: types.ListEnvelopeDataMeta, accept: `application/json; profile=flat`, wantKeys: []string{"items", "links", "pagination"}, wantItems: "items", wantMeta: "pagination"},
+		{name: "unknown profile ignored", defaultEnvelope: types.ListEnvelopeFlat, accept: `application/json; profile="jsonapi"`, wantKeys: []string{"items", "links", "pagination"}, wantItems: "items", wantMeta: "pagination"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(ListEnvelopeMiddleware(tt.defaultEnvelope))
+			router.GET("/v1/places", list)
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/places", nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			var body map[string]json.RawMessage
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode body: %v: %s", err, w.Body)
+			}
+			if keys := slices.Sorted(maps.Keys(body)); !slices.Equal(keys, tt.wantKeys) {
+				t.Errorf("keys = %v, want %v", keys, tt.wantKeys)
+			}
+
+			var items []string
+			if err := json.Unmarshal(body[tt.wantItems], &items); err != nil || len(items) != 2 {
+				t.Errorf("%s = %s, want the two places", tt.wantItems, body[tt.wantItems])
+			}
+			var meta types.PaginationResponse
+			if err := json.Unmarshal(body[tt.wantMeta], &meta); err != nil || meta.Total != 12 || meta.Limit != 2 {
+				t.Errorf("%s = %s, want total 12 and limit 2", tt.wantMeta, body[tt.wantMeta])
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"encoding/json"
+	"mime"
 	"strings"
 
 	"github.com/cockroachdb/errors"
@@ -23,21 +24,45 @@ func ErrorHandler() gin.HandlerFunc {
 			// Get safe details
 			details := getSafeDetails(err)
 
-			response := ierr.ErrorResponse{
-				Success: false,
-				Error: ierr.ErrorDetail{
-					Display:       display,
-					InternalError: err.Error(),
-					Details:       details,
-				},
+			detail := ierr.ErrorDetail{
+				Code:          ierr.CodeFromErr(err),
+				Display:       display,
+				InternalError: err.Error(),
+				Details:       details,
 			}
 
-			status := ierr.HTTPStatusFromErr(err)
-			c.JSON(status, response)
+			writeError(c, ierr.HTTPStatusFromErr(err), detail)
 		}
 	}
 }
 
+// writeError renders detail in the error format negotiated by the Accept header: a JSON:API
+// error document for application/vnd.api+json, the standard ErrorResponse otherwise
+func writeError(c *gin.Context, status int, detail ierr.ErrorDetail) {
+	if acceptsJSONAPI(c.GetHeader("Accept")) {
+		// Set before rendering: gin only fills in the JSON content type when none is set
+		c.Header("Content-Type", ierr.MediaTypeJSONAPI)
+		c.JSON(status, ierr.NewJSONAPIErrorResponse(status, detail))
+		return
+	}
+
+	c.JSON(status, ierr.ErrorResponse{
+		Success: false,
+		Error:   detail,
+	})
+}
+
+// acceptsJSONAPI reports whether any media range of the Accept header is the JSON:API type
+func acceptsJSONAPI(accept string) bool {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err == nil && mediaType == ierr.MediaTypeJSONAPI {
+			return true
+		}
+	}
+	return false
+}
+
 func getDisplayMessage(err error) string {
 	if hints := errors.GetAllHints(err); len(hints) > 0 {
 		// Get the first non-empty hint - GetAllHints is post-order traversal
@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+func TestAcceptsJSONAPI(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   bool
+	}{
+		{accept: "application/vnd.api+json", want: true},
+		{accept: "application/json, application/vnd.api+json;q=0.9", want: true},
+		{accept: "text/html,application/vnd.api+json ; q=0.5,*/*;q=0.1", want: true},
+		{accept: "APPLICATION/VND.API+JSON", want: true},
+		{accept: "application/json"},
+		{accept: "*/*"},
+		{accept: ""},
+		// A malformed range is skipped rather than failing the whole header
+		{accept: "application/vnd.api+json;;;=, application/json"},
+		{accept: "garbage/, application/vnd.api+json", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			if got := acceptsJSONAPI(tt.accept); got != tt.want {
+				t.Errorf("acceptsJSONAPI(%q) = %v, want %v", tt.accept, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(ErrorHandler())
+	router.GET("/v1/places/:id", func(c *gin.Context) {
+		c.Error(ierr.NewErrorf("place %s not found", c.Param("id")).
+			WithHint("The place does not exist").
+			WithReportableDetails(map[string]any{"place_id": c.Param("id")}).
+			Mark(ierr.ErrNotFound))
+	})
+
+	tests := []struct {
+		name            string
+		accept          string
+		wantContentType string
+		wantJSONAPI     bool
+	}{
+		{name: "default", wantContentType: "application/json; charset=utf-8"},
+		{name: "json", accept: "application/json", wantContentType: "application/json; charset=utf-8"},
+		{name: "json api", accept: "application/vnd.api+json", wantContentType: ierr.MediaTypeJSONAPI, wantJSONAPI: true},
+		{name: "json api among others", accept: "text/html, application/vnd.api+json;q=0.8", wantContentType: ierr.MediaTypeJSONAPI, wantJSONAPI: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/places/place_1", nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != http.StatusNotFound {
+				t.Errorf("status = %d, want 404", w.Code)
+			}
+			if got := w.Header().Get("Content-Type"); got != tt.wantContentType {
+				t.Errorf("Content-Type = %q, want %q", got, tt.wantContentType)
+			}
+
+			if !tt.wantJSONAPI {
+				var resp ierr.ErrorResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+					t.Fatalf("decode error response: %v: %s", err, w.Body)
+				}
+				if resp.Success || resp.Error.Code != ierr.CodeFromErr(ierr.ErrNotFound) || resp.Error.Display != "The place does not exist" {
+					t.Errorf("error = %+v", resp.Error)
+				}
+				return
+			}
+
+			// Decode loosely so members the JSON:API document must not have are caught too
+			var doc map[string][]map[string]any
+			if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+				t.Fatalf("decode JSON:API document: %v: %s", err, w.Body)
+			}
+			if len(doc) != 1 || len(doc["errors"]) != 1 {
+				t.Fatalf("document = %s, want a single member errors with one error", w.Body)
+			}
+			got := doc["errors"][0]
+			want := map[string]any{
+				"status": "404",
+				"code":   ierr.CodeFromErr(ierr.ErrNotFound),
+				"title":  ierr.TitleFromCode(ierr.CodeFromErr(ierr.ErrNotFound)),
+				"detail": "The place does not exist",
+			}
+			for k, v := range want {
+				if got[k] != v {
+					t.Errorf("errors[0].%s = %v, want %v", k, got[k], v)
+				}
+			}
+			if meta, _ := got["meta"].(map[string]any); meta["place_id"] != "place_1" {
+				t.Errorf("errors[0].meta = %v, want place_id", got["meta"])
+			}
+		})
+	}
+}
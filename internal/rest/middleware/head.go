@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// DiscardBody lets a GET handler answer HEAD: the handler, and the error handler after it, run
+// unchanged and set the same status and headers, but nothing they write reaches the client.
+// Register it in front of the GET handler on the HEAD route.
+func DiscardBody(c *gin.Context) {
+	c.Writer = &bodylessWriter{ResponseWriter: c.Writer}
+	c.Next()
+}
+
+// bodylessWriter sends the status and headers of a response and drops its body
+type bodylessWriter struct {
+	gin.ResponseWriter
+}
+
+func (w *bodylessWriter) Write(data []byte) (int, error) {
+	w.WriteHeaderNow()
+	return len(data), nil
+}
+
+func (w *bodylessWriter) WriteString(s string) (int, error) {
+	w.WriteHeaderNow()
+	return len(s), nil
+}
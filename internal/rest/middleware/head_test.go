@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+func TestDiscardBody(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	get := func(c *gin.Context) {
+		if c.Param("id") == "missing" {
+			c.Error(ierr.NewError("place not found").WithHint("The place does not exist").Mark(ierr.ErrNotFound))
+			return
+		}
+		c.Header("ETag", `"v1"`)
+		c.String(http.StatusOK, "Ramkund")
+	}
+
+	router := gin.New()
+	router.Use(ErrorHandler())
+	router.GET("/v1/places/:id", get)
+	router.HEAD("/v1/places/:id", DiscardBody, get)
+
+	tests := []struct {
+		name       string
+		path       string
+		accept     string
+		wantStatus int
+	}{
+		{name: "found", path: "/v1/places/place_1", wantStatus: http.StatusOK},
+		{name: "error", path: "/v1/places/missing", wantStatus: http.StatusNotFound},
+		{name: "JSON:API error", path: "/v1/places/missing", accept: ierr.MediaTypeJSONAPI, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serve := func(method string) *httptest.ResponseRecorder {
+				req := httptest.NewRequest(method, tt.path, nil)
+				if tt.accept != "" {
+					req.Header.Set("Accept", tt.accept)
+				}
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+				return w
+			}
+			getResp, headResp := serve(http.MethodGet), serve(http.MethodHead)
+
+			if getResp.Code != tt.wantStatus || headResp.Code != tt.wantStatus {
+				t.Errorf("status = GET %d, HEAD %d, want %d", getResp.Code, headResp.Code, tt.wantStatus)
+			}
+			if getResp.Body.Len() == 0 {
+				t.Error("GET body is empty")
+			}
+			if headResp.Body.Len() != 0 {
+				t.Errorf("HEAD body = %q, want empty", headResp.Body)
+			}
+			if headResp.Header().Get("Content-Type") == "" {
+				t.Error("HEAD Content-Type is empty")
+			}
+			for _, key := range []string{"Content-Type", "ETag"} {
+				if got, want := headResp.Header().Get(key), getResp.Header().Get(key); got != want {
+					t.Errorf("HEAD %s = %q, GET %s = %q", key, got, key, want)
+				}
+			}
+		})
+	}
+}
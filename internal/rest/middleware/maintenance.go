@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// Maintenance answers every request except the health check with 503 and a Retry-After of
+// retryAfter, the expected length of the maintenance window. The health check stays up so
+// orchestrators do not restart an instance that was put in maintenance on purpose.
+func Maintenance(retryAfter time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.FullPath() == "/health" {
+			c.Next()
+			return
+		}
+
+		setRetryAfter(c, retryAfter)
+		c.Error(ierr.NewError("service is in maintenance").
+			WithHintf("The service is undergoing maintenance; please retry in %d seconds", max(ceilSeconds(retryAfter), 1)).
+			WithReportableDetails(map[string]any{
+				"retry_after_seconds": max(ceilSeconds(retryAfter), 1),
+			}).
+			Mark(ierr.ErrUnavailable))
+		c.Abort()
+	}
+}
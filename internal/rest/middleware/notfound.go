@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// NoRouteHandler answers requests for unknown paths with the standard JSON error response
+func NoRouteHandler(c *gin.Context) {
+	c.Error(ierr.NewErrorf("no route for %s %s", c.Request.Method, c.Request.URL.Path).
+		WithHintf("The path %s does not exist", c.Request.URL.Path).
+		WithReportableDetails(map[string]any{
+			"method": c.Request.Method,
+			"path":   c.Request.URL.Path,
+		}).
+		Mark(ierr.ErrNotFound))
+}
+
+// NoMethodHandler answers requests whose path exists under other methods with the standard
+// JSON error response. Gin sets the Allow header listing those methods before it runs.
+func NoMethodHandler(c *gin.Context) {
+	allowed := c.Writer.Header().Get("Allow")
+	c.Error(ierr.NewErrorf("method %s not allowed on %s", c.Request.Method, c.Request.URL.Path).
+		WithHintf("Method %s is not allowed on %s; use one of: %s", c.Request.Method, c.Request.URL.Path, allowed).
+		WithReportableDetails(map[string]any{
+			"method":  c.Request.Method,
+			"path":    c.Request.URL.Path,
+			"allowed": allowed,
+		}).
+		Mark(ierr.ErrMethodNotAllowed))
+}
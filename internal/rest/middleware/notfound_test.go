@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+func TestNoRouteAndNoMethodHandlers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.HandleMethodNotAllowed = true
+	router.Use(ErrorHandler())
+	router.NoRoute(NoRouteHandler)
+	router.NoMethod(NoMethodHandler)
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/v1/places/:id", ok)
+	router.PUT("/v1/places/:id", ok)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		wantStatus int
+		wantCode   string
+		wantAllow  string
+	}{
+		{name: "bogus path", method: http.MethodGet, path: "/v1/plaecs/place_1", wantStatus: http.StatusNotFound, wantCode: ierr.ErrCodeNotFound},
+		{name: "bogus path, write method", method: http.MethodPost, path: "/v2/places", wantStatus: http.StatusNotFound, wantCode: ierr.ErrCodeNotFound},
+		{name: "wrong method", method: http.MethodDelete, path: "/v1/places/place_1", wantStatus: http.StatusMethodNotAllowed, wantCode: ierr.ErrCodeMethodNotAllowed, wantAllow: "GET, PUT"},
+		{name: "existing route", method: http.MethodGet, path: "/v1/places/place_1", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, nil))
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if got := w.Header().Get("Allow"); got != tt.wantAllow {
+				t.Errorf("Allow = %q, want %q", got, tt.wantAllow)
+			}
+			if tt.wantCode == "" {
+				return
+			}
+
+			if got := w.Header().Get("Content-Type"); got != "application/json; charset=utf-8" {
+				t.Errorf("Content-Type = %q, want JSON", got)
+			}
+			var resp ierr.ErrorResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("decode error response: %v: %s", err, w.Body)
+			}
+			if resp.Success || resp.Error.Code != tt.wantCode {
+				t.Errorf("error = %+v, want code %s", resp.Error, tt.wantCode)
+			}
+			if resp.Error.Details["path"] != tt.path {
+				t.Errorf("details = %v, want path %s", resp.Error.Details, tt.path)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// pathIDParams are the path parameters holding entity ids
+var pathIDParams = []string{"id", "image_id", "event_id", "place_id"}
+
+// slugIDRoutes are the routes whose :id holds a slug rather than an id
+var slugIDRoutes = map[string]bool{
+	"/v1/categories/:id/places": true,
+	"/v1/categories/:id/stats":  true,
+}
+
+// ValidatePathIDs rejects requests whose path ids are not in the entity id format with 400,
+// so a malformed id never reaches the database
+func ValidatePathIDs(c *gin.Context) {
+	for _, name := range pathIDParams {
+		value, ok := c.Params.Get(name)
+		if !ok || (name == "id" && slugIDRoutes[c.FullPath()]) {
+			continue
+		}
+		if !types.ValidateID(value) {
+			c.Error(ierr.NewErrorf("invalid %s: %s", name, value).
+				WithHintf("%s must be an id such as place_01J9Z3K4M5N6P7Q8R9S0T1V2W3", name).
+				WithReportableDetails(map[string]any{
+					name: value,
+				}).
+				Mark(ierr.ErrValidation))
+			c.Abort()
+			return
+		}
+	}
+
+	c.Next()
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestValidatePathIDs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(ErrorHandler(), ValidatePathIDs)
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/v1/places/:id", ok)
+	router.DELETE("/v1/places/:id/images/:image_id", ok)
+	router.GET("/v1/categories/:id/places", ok)
+	router.GET("/v1/categories/:id", ok)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		wantStatus int
+	}{
+		{name: "valid id", method: http.MethodGet, path: "/v1/places/place_01J9Z3K4M5N6P7Q8R9S0T1V2W3", wantStatus: http.StatusOK},
+		{name: "malformed id", method: http.MethodGet, path: "/v1/places/not-a-real-id", wantStatus: http.StatusBadRequest},
+		{name: "missing prefix", method: http.MethodGet, path: "/v1/places/01J9Z3K4M5N6P7Q8R9S0T1V2W3", wantStatus: http.StatusBadRequest},
+		{name: "uppercase prefix", method: http.MethodGet, path: "/v1/places/PLACE_01J9Z3K4M5N6P7Q8R9S0T1V2W3", wantStatus: http.StatusBadRequest},
+		{name: "short ulid", method: http.MethodGet, path: "/v1/places/place_01J9Z3", wantStatus: http.StatusBadRequest},
+		{name: "valid nested ids", method: http.MethodDelete, path: "/v1/places/place_01J9Z3K4M5N6P7Q8R9S0T1V2W3/images/img_01J9Z3K4M5N6P7Q8R9S0T1V2W4", wantStatus: http.StatusOK},
+		{name: "malformed nested id", method: http.MethodDelete, path: "/v1/places/place_01J9Z3K4M5N6P7Q8R9S0T1V2W3/images/cover.jpg", wantStatus: http.StatusBadRequest},
+		{name: "slug route", method: http.MethodGet, path: "/v1/categories/temples/places", wantStatus: http.StatusOK},
+		{name: "id route of the same resource", method: http.MethodGet, path: "/v1/categories/temples", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, nil))
+			if w.Code != tt.wantStatus {
+				t.Errorf("%s %s = %d, want %d: %s", tt.method, tt.path, w.Code, tt.wantStatus, w.Body)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"golang.org/x/time/rate"
+)
+
+// RateLimit throttles each client IP to requestsPerMinute, allowing bursts of up to burst
+// requests. Every response carries the client's allowance; a throttled request is answered
+// with 429 and a Retry-After of the seconds until its next request is allowed.
+//
+// The allowance is a token bucket per IP and lives in this process only, so behind a load
+// balancer each instance limits on its own.
+func RateLimit(requestsPerMinute, burst int) gin.HandlerFunc {
+	limiters := &clientLimiters{
+		limit:   rate.Limit(float64(requestsPerMinute) / 60),
+		burst:   burst,
+		clients: make(map[string]*clientLimiter),
+	}
+
+	return func(c *gin.Context) {
+		now := time.Now()
+		limiter := limiters.get(c.ClientIP(), now)
+		allowed := limiter.AllowN(now, 1)
+		tokens := limiter.TokensAt(now)
+
+		header := c.Writer.Header()
+		header.Set(types.HeaderRateLimitLimit, strconv.Itoa(burst))
+		header.Set(types.HeaderRateLimitRemaining, strconv.Itoa(max(int(tokens), 0)))
+		header.Set(types.HeaderRateLimitReset, strconv.Itoa(ceilSeconds(limiters.refillTime(float64(burst)-tokens))))
+
+		if allowed {
+			c.Next()
+			return
+		}
+
+		retryAfter := limiters.refillTime(1 - tokens)
+		setRetryAfter(c, retryAfter)
+		c.Error(ierr.NewErrorf("rate limit of %d requests per minute exceeded", requestsPerMinute).
+			WithHintf("Too many requests; please retry in %d seconds", ceilSeconds(retryAfter)).
+			WithReportableDetails(map[string]any{
+				"retry_after_seconds": ceilSeconds(retryAfter),
+			}).
+			Mark(ierr.ErrRateLimited))
+		c.Abort()
+	}
+}
+
+// setRetryAfter tells the client to wait d before retrying, rounded up to whole seconds and
+// never less than one so clients do not retry immediately
+func setRetryAfter(c *gin.Context, d time.Duration) {
+	c.Header(types.HeaderRetryAfter, strconv.Itoa(max(ceilSeconds(d), 1)))
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// clientLimiterSweepInterval is how often idle client limiters are dropped
+const clientLimiterSweepInterval = time.Minute
+
+type clientLimiter struct {
+	*rate.Limiter
+	lastSeen time.Time
+}
+
+// clientLimiters holds a limiter per client IP. A client idle long enough to have refilled
+// its bucket is indistinguishable from a new one, so its limiter is dropped on the next sweep.
+type clientLimiters struct {
+	limit rate.Limit
+	burst int
+
+	mu        sync.Mutex
+	clients   map[string]*clientLimiter
+	lastSweep time.Time
+}
+
+func (l *clientLimiters) get(ip string, now time.Time) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) >= clientLimiterSweepInterval {
+		idle := l.refillTime(float64(l.burst))
+		for key, client := range l.clients {
+			if now.Sub(client.lastSeen) > idle {
+				delete(l.clients, key)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	client, ok := l.clients[ip]
+	if !ok {
+		client = &clientLimiter{Limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[ip] = client
+	}
+	client.lastSeen = now
+	return client.Limiter
+}
+
+// refillTime is how long the bucket takes to gain the given number of tokens
+func (l *clientLimiters) refillTime(tokens float64) time.Duration {
+	if tokens <= 0 {
+		return 0
+	}
+	return time.Duration(tokens / float64(l.limit) * float64(time.Second))
+}
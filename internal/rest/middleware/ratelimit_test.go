@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"golang.org/x/time/rate"
+)
+
+func TestRateLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name              string
+		requestsPerMinute int
+		burst             int
+		wantRetryAfter    string
+	}{
+		// One token every 10s: the request after the burst waits just under 10s
+		{name: "slow refill", requestsPerMinute: 6, burst: 3, wantRetryAfter: "10"},
+		// One token every 0.1s: the wait rounds up to the one second minimum
+		{name: "fast refill", requestsPerMinute: 600, burst: 2, wantRetryAfter: "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(ErrorHandler(), RateLimit(tt.requestsPerMinute, tt.burst))
+			router.GET("/v1/places", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			get := func(ip string) *httptest.ResponseRecorder {
+				req := httptest.NewRequest(http.MethodGet, "/v1/places", nil)
+				req.RemoteAddr = ip + ":40000"
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+				return w
+			}
+
+			for i := 0; i < tt.burst; i++ {
+				if w := get("203.0.113.7"); w.Code != http.StatusOK {
+					t.Fatalf("request %d = %d, want 200 within the burst", i+1, w.Code)
+				}
+			}
+
+			w := get("203.0.113.7")
+			if w.Code != http.StatusTooManyRequests {
+				t.Fatalf("request %d = %d, want 429", tt.burst+1, w.Code)
+			}
+			if got := w.Header().Get(types.HeaderRetryAfter); got != tt.wantRetryAfter {
+				t.Errorf("Retry-After = %q, want %q", got, tt.wantRetryAfter)
+			}
+			if got := w.Header().Get(types.HeaderRateLimitRemaining); got != "0" {
+				t.Errorf("%s = %q, want 0", types.HeaderRateLimitRemaining, got)
+			}
+
+			if w := get("198.51.100.20"); w.Code != http.StatusOK {
+				t.Errorf("another client = %d, want 200", w.Code)
+			}
+		})
+	}
+}
+
+func TestRefillTime(t *testing.T) {
+	// 30 requests per minute is a token every two seconds
+	limiters := &clientLimiters{limit: rate.Limit(0.5), burst: 5}
+
+	tests := []struct {
+		tokens float64
+		want   time.Duration
+	}{
+		{tokens: 1, want: 2 * time.Second},
+		{tokens: 0.25, want: 500 * time.Millisecond},
+		{tokens: 5, want: 10 * time.Second},
+		{tokens: 0, want: 0},
+		{tokens: -1, want: 0},
+	}
+
+	for _, tt := range tests {
+		if got := limiters.refillTime(tt.tokens); got != tt.want {
+			t.Errorf("refillTime(%v) = %s, want %s", tt.tokens, got, tt.want)
+		}
+	}
+}
+
+func TestSetRetryAfter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		wait time.Duration
+		want string
+	}{
+		{wait: 0, want: "1"},
+		{wait: time.Millisecond, want: "1"},
+		{wait: time.Second, want: "1"},
+		{wait: time.Second + time.Nanosecond, want: "2"},
+		{wait: 9*time.Second + 900*time.Millisecond, want: "10"},
+	}
+
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		setRetryAfter(c, tt.wait)
+		if got := w.Header().Get(types.HeaderRetryAfter); got != tt.want {
+			t.Errorf("setRetryAfter(%s) = %q, want %q", tt.wait, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/gin-gonic/gin"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/logger"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// Recovery turns a panic in any later handler into a JSON 500 and logs it with its stack
+// and request ID. The panic value is logged but never sent to the client. Register it first
+// so it also covers the other middleware.
+func Recovery(log *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			if recovered == http.ErrAbortHandler {
+				// Deliberate abort of the response; let net/http drop the connection
+				panic(recovered)
+			}
+
+			log.Errorw("recovered from panic",
+				"request_id", types.GetRequestID(c.Request.Context()),
+				"method", c.Request.Method,
+				"path", c.Request.URL.Path,
+				"panic", fmt.Sprint(recovered),
+				"stack", string(debug.Stack()),
+			)
+
+			if c.Writer.Written() {
+				// Part of the response is already out; all that is left is to stop
+				c.Abort()
+				return
+			}
+			c.Abort()
+			writeError(c, http.StatusInternalServerError, ierr.ErrorDetail{
+				Code:    ierr.ErrCodeInternalError,
+				Display: "An unexpected error occurred",
+			})
+		}()
+
+		c.Next()
+	}
+}
@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/logger"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRecovery(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const secret = "connect to postgres://admin:hunter2@db failed"
+
+	tests := []struct {
+		name        string
+		handler     gin.HandlerFunc
+		wantStatus  int
+		wantBody    string
+		wantLogged  bool
+		wantRepanic bool
+	}{
+		{
+			name:       "panic before writing",
+			handler:    func(*gin.Context) { panic(secret) },
+			wantStatus: http.StatusInternalServerError,
+			wantLogged: true,
+		},
+		{
+			name: "panic after writing",
+			handler: func(c *gin.Context) {
+				c.String(http.StatusOK, "partial")
+				panic(secret)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "partial",
+			wantLogged: true,
+		},
+		{
+			name:        "aborted response",
+			handler:     func(*gin.Context) { panic(http.ErrAbortHandler) },
+			wantRepanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			core, logs := observer.New(zap.ErrorLevel)
+			router := gin.New()
+			router.Use(Recovery(&logger.Logger{SugaredLogger: zap.New(core).Sugar()}))
+			router.GET("/v1/places", tt.handler)
+
+			w := httptest.NewRecorder()
+			repanicked := func() (recovered any) {
+				defer func() { recovered = recover() }()
+				router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/places", nil))
+				return nil
+			}()
+
+			if tt.wantRepanic {
+				if repanicked != http.ErrAbortHandler {
+					t.Fatalf("recovered %v, want http.ErrAbortHandler re-panicked", repanicked)
+				}
+				if logs.Len() != 0 {
+					t.Errorf("logged %d entries for a deliberate abort", logs.Len())
+				}
+				return
+			}
+			if repanicked != nil {
+				t.Fatalf("panic escaped: %v", repanicked)
+			}
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if strings.Contains(w.Body.String(), "hunter2") {
+				t.Errorf("body leaks the panic value: %s", w.Body)
+			}
+			if tt.wantBody != "" {
+				if w.Body.String() != tt.wantBody {
+					t.Errorf("body = %q, want %q", w.Body, tt.wantBody)
+				}
+			} else {
+				var resp ierr.ErrorResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+					t.Fatalf("body is not a JSON error: %v: %s", err, w.Body)
+				}
+				if resp.Success || resp.Error.Code != ierr.ErrCodeInternalError || resp.Error.InternalError != "" {
+					t.Errorf("error = %+v, want an internal error without details", resp.Error)
+				}
+			}
+
+			if got := logs.FilterField(zap.String("panic", secret)).Len(); (got == 1) != tt.wantLogged {
+				t.Errorf("logged the panic %d times, want logged %v", got, tt.wantLogged)
+			}
+		})
+	}
+}
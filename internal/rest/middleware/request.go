@@ -2,29 +2,31 @@ package middleware
 
 import (
 	"context"
+	"regexp"
 
 	"github.com/gin-gonic/gin"
 	"github.com/omkar273/nashikdarshan/internal/types"
 )
 
-func RequestIDMiddleware(c *gin.Context) {
-	// Create a new context from the request context
-	ctx := c.Request.Context()
-
-	// Add request ID
-	requestID := c.GetHeader("X-Request-ID")
-	if requestID == "" {
-		requestID = types.GenerateUUID()
+// requestIDPattern is what an incoming request ID must look like to be kept. Anything else,
+// such as a value with spaces, quotes or newlines that could forge log lines, is replaced.
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// RequestIDMiddleware tags every request with an ID, stored in the request context and
+// echoed back in the response header. A well-formed ID sent by the client or an upstream
+// proxy in the header is kept so the request can be traced across services; otherwise a
+// new one is generated.
+func RequestIDMiddleware(header string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(header)
+		if !requestIDPattern.MatchString(requestID) {
+			requestID = types.GenerateUUID()
+		}
+
+		ctx := context.WithValue(c.Request.Context(), types.CtxRequestID, requestID)
+		c.Request = c.Request.WithContext(ctx)
+		c.Header(header, requestID)
+
+		c.Next()
 	}
-
-	// Create new context with values
-	ctx = context.WithValue(ctx, types.CtxRequestID, requestID)
-
-	// Replace request context
-	c.Request = c.Request.WithContext(ctx)
-
-	// Add headers for response
-	c.Header(types.HeaderRequestID, requestID)
-
-	c.Next()
 }
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const header = "X-Correlation-ID"
+
+	tests := []struct {
+		name string
+		// inbound is the header sent by the client, unset when empty
+		inbound  string
+		wantKept bool
+	}{
+		{name: "uuid", inbound: "3f2b8c1e-4d5a-4b6c-8e7f-0a1b2c3d4e5f", wantKept: true},
+		{name: "equals sign", inbound: "Root=1-67891233.abcdef012345678912345678"},
+		{name: "dotted and colon separated", inbound: "edge-1:req.42", wantKept: true},
+		{name: "longest kept", inbound: strings.Repeat("a", 128), wantKept: true},
+		{name: "too long", inbound: strings.Repeat("a", 129)},
+		{name: "spaces", inbound: "req 42"},
+		{name: "forged log line", inbound: "req42\" level=error msg=\"forged"},
+		{name: "missing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var seen string
+			router := gin.New()
+			router.Use(RequestIDMiddleware(header))
+			router.GET("/v1/places", func(c *gin.Context) {
+				seen = types.GetRequestID(c.Request.Context())
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/places", nil)
+			if tt.inbound != "" {
+				req.Header.Set(header, tt.inbound)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			echoed := w.Header().Get(header)
+			if echoed != seen {
+				t.Errorf("echoed %q, but the request context holds %q", echoed, seen)
+			}
+			if tt.wantKept {
+				if echoed != tt.inbound {
+					t.Errorf("echoed %q, want the inbound %q", echoed, tt.inbound)
+				}
+				return
+			}
+			if echoed == tt.inbound || !types.ValidateUUID(echoed) {
+				t.Errorf("echoed %q, want a generated id", echoed)
+			}
+		})
+	}
+}
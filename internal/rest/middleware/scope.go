@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// RequireScope rejects requests whose principal was not granted the given scope.
+// It must run after AuthenticateMiddleware (or APIKeyAuth), which populate the scopes.
+func RequireScope(scope types.Scope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !types.HasScope(types.GetScopes(c.Request.Context()), scope) {
+			c.Error(ierr.NewError("missing required scope").
+				WithHintf("Missing required scope: %s", scope).
+				WithReportableDetails(map[string]any{
+					"required_scope": scope,
+				}).
+				Mark(ierr.ErrPermissionDenied))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
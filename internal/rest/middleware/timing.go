@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/timing"
+)
+
+// ServerTiming puts a timing recorder in the request context and reports what it collected,
+// with the total time so far, in a Server-Timing header. The header is added just before the
+// response headers are sent, so streamed responses report the time to their first byte.
+func ServerTiming(c *gin.Context) {
+	ctx, recorder := timing.NewContext(c.Request.Context())
+	c.Request = c.Request.WithContext(ctx)
+
+	w := &timingWriter{ResponseWriter: c.Writer, recorder: recorder, start: time.Now()}
+	c.Writer = w
+	c.Next()
+
+	// Bodiless responses are flushed by gin after the handlers return, past this writer
+	w.setHeader()
+}
+
+// timingWriter adds the Server-Timing header the first time the response is written
+type timingWriter struct {
+	gin.ResponseWriter
+	recorder *timing.Recorder
+	start    time.Time
+	done     bool
+}
+
+func (w *timingWriter) setHeader() {
+	if w.done || w.Written() {
+		return
+	}
+	w.done = true
+	w.Header().Set("Server-Timing", w.recorder.Header(time.Since(w.start)))
+}
+
+func (w *timingWriter) WriteHeaderNow() {
+	w.setHeader()
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *timingWriter) Write(data []byte) (int, error) {
+	w.setHeader()
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *timingWriter) WriteString(s string) (int, error) {
+	w.setHeader()
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *timingWriter) Flush() {
+	w.setHeader()
+	w.ResponseWriter.Flush()
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/nashikdarshan/internal/timing"
+)
+
+func TestServerTiming(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// A read that queries the database twice and the cache once
+	read := func(c *gin.Context) {
+		ctx := c.Request.Context()
+		timing.Since(ctx, timing.MetricCache, time.Now())
+		timing.Since(ctx, timing.MetricDB, time.Now().Add(-3*time.Millisecond))
+		timing.Since(ctx, timing.MetricDB, time.Now().Add(-2*time.Millisecond))
+		c.JSON(http.StatusOK, gin.H{"title": "Ramkund"})
+	}
+
+	tests := []struct {
+		name      string
+		enabled   bool
+		handler   gin.HandlerFunc
+		wantRegex string
+	}{
+		{
+			name:      "read",
+			enabled:   true,
+			handler:   read,
+			wantRegex: `^db;dur=([5-9]|\d{2,})\.\d{2};desc="Database \(2\)", cache;dur=\d+\.\d{2};desc="Cache \(1\)", total;dur=\d+\.\d{2};desc="Total"$`,
+		},
+		{
+			name:      "no content",
+			enabled:   true,
+			handler:   func(c *gin.Context) { c.Status(http.StatusNoContent) },
+			wantRegex: `^total;dur=\d+\.\d{2};desc="Total"$`,
+		},
+		{name: "disabled", handler: read},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			if tt.enabled {
+				router.Use(ServerTiming)
+			}
+			router.GET("/v1/places/:id", tt.handler)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/places/place_1", nil))
+
+			got := w.Header().Get("Server-Timing")
+			if tt.wantRegex == "" {
+				if got != "" {
+					t.Errorf("Server-Timing = %q, want none", got)
+				}
+				return
+			}
+			if !regexp.MustCompile(tt.wantRegex).MatchString(got) {
+				t.Errorf("Server-Timing = %q, want it to match %s", got, tt.wantRegex)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Package sanitize cleans user-supplied rich text before it is stored, so it is safe to render
+// as HTML.
+package sanitize
+
+import (
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// HTML sanitizes markup against an allowlist. Script and style elements, event handler
+// attributes and non-http(s) URLs never survive; what remains depends on the policy.
+// Text is HTML-escaped in the output, so "&" is stored as "&amp;".
+type HTML struct {
+	policy *bluemonday.Policy
+}
+
+// NewHTML returns a sanitizer for the given policy. Unknown policies strip all markup.
+func NewHTML(policy types.HTMLPolicy) *HTML {
+	if policy == types.HTMLPolicyBasic {
+		return &HTML{policy: basicPolicy()}
+	}
+	return &HTML{policy: bluemonday.StrictPolicy()}
+}
+
+// basicPolicy allows formatting that renders the same in every client: paragraphs, emphasis,
+// lists, headings, quotes and links. Links open no referrer and are marked nofollow.
+func basicPolicy() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+	p.AllowElements("p", "br", "strong", "b", "em", "i", "u", "ul", "ol", "li", "blockquote", "h3", "h4")
+	p.AllowStandardURLs()
+	p.AllowAttrs("href").OnElements("a")
+	p.RequireNoFollowOnLinks(true)
+	p.RequireNoReferrerOnLinks(true)
+	return p
+}
+
+// NewRenderedHTML returns a sanitizer for HTML rendered from Markdown. It extends the basic
+// policy with the remaining elements Markdown produces: all heading levels, code blocks,
+// rules and strikethrough. Images are not allowed.
+func NewRenderedHTML() *HTML {
+	p := basicPolicy()
+	p.AllowElements("h1", "h2", "h5", "h6", "pre", "code", "hr", "del")
+	return &HTML{policy: p}
+}
+
+// Sanitize returns s with disallowed markup removed
+func (h *HTML) Sanitize(s string) string {
+	return h.policy.Sanitize(s)
+}
+
+// SanitizePtr sanitizes an optional field, leaving nil as nil
+func (h *HTML) SanitizePtr(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	clean := h.Sanitize(*s)
+	return &clean
+}
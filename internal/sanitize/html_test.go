@@ -0,0 +1,87 @@
+package sanitize
+
+import (
+	"testing"
+
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+func TestHTMLSanitize(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantStrip string
+		wantBasic string
+	}{
+		{
+			name:      "script",
+			input:     `<p>Ghats on the <b>Godavari</b><script>alert(1)</script></p>`,
+			wantStrip: "Ghats on the Godavari",
+			wantBasic: "<p>Ghats on the <b>Godavari</b></p>",
+		},
+		{
+			name:      "onclick",
+			input:     `<p onclick="alert(1)">Ramkund</p>`,
+			wantStrip: "Ramkund",
+			wantBasic: "<p>Ramkund</p>",
+		},
+		{
+			name:      "event handler on a link",
+			input:     `<a href="https://nashik.gov.in" onmouseover="alert(1)">site</a>`,
+			wantStrip: "site",
+			wantBasic: `<a href="https://nashik.gov.in" rel="nofollow noreferrer">site</a>`,
+		},
+		{
+			name:      "javascript URL",
+			input:     `<a href="javascript:alert(1)">Ramkund</a>`,
+			wantStrip: "Ramkund",
+			wantBasic: "Ramkund",
+		},
+		{
+			name:      "image with onerror",
+			input:     `<img src=x onerror=alert(1)>Sula`,
+			wantStrip: "Sula",
+			wantBasic: "Sula",
+		},
+		{
+			name:      "style and iframe",
+			input:     `<style>p{}</style><iframe src="https://example.com"></iframe>Panchavati`,
+			wantStrip: "Panchavati",
+			wantBasic: "Panchavati",
+		},
+		{
+			name:      "text is escaped",
+			input:     "Sita & Ram",
+			wantStrip: "Sita &amp; Ram",
+			wantBasic: "Sita &amp; Ram",
+		},
+	}
+
+	strip, basic := NewHTML(types.HTMLPolicyStrip), NewHTML(types.HTMLPolicyBasic)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := strip.Sanitize(tt.input); got != tt.wantStrip {
+				t.Errorf("strip: Sanitize() = %q, want %q", got, tt.wantStrip)
+			}
+			if got := basic.Sanitize(tt.input); got != tt.wantBasic {
+				t.Errorf("basic: Sanitize() = %q, want %q", got, tt.wantBasic)
+			}
+		})
+	}
+}
+
+func TestHTMLSanitizePtr(t *testing.T) {
+	h := NewHTML(types.HTMLPolicyStrip)
+	if got := h.SanitizePtr(nil); got != nil {
+		t.Errorf("SanitizePtr(nil) = %q, want nil", *got)
+	}
+
+	in := `<script>alert(1)</script>Ramkund`
+	got := h.SanitizePtr(&in)
+	if got == nil || *got != "Ramkund" {
+		t.Errorf("SanitizePtr() = %v, want Ramkund", got)
+	}
+	if in != `<script>alert(1)</script>Ramkund` {
+		t.Errorf("SanitizePtr() changed its input to %q", in)
+	}
+}
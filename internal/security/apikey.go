@@ -0,0 +1,30 @@
+package security
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+const (
+	// APIKeyPrefix marks raw API keys so they are easy to recognise in logs and secret scanners
+	APIKeyPrefix = "ndk_"
+	// apiKeyBytes is the amount of randomness in a generated key
+	apiKeyBytes = 32
+	// apiKeyDisplayLength is the number of leading characters kept to identify a key
+	apiKeyDisplayLength = 12
+)
+
+// GenerateAPIKey returns a new random API key and its display prefix
+func GenerateAPIKey() (key string, displayPrefix string, err error) {
+	buf := make([]byte, apiKeyBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", ierr.WithError(err).
+			WithHint("Failed to generate API key").
+			Mark(ierr.ErrSystem)
+	}
+
+	key = APIKeyPrefix + hex.EncodeToString(buf)
+	return key, key[:apiKeyDisplayLength], nil
+}
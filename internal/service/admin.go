@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+
+	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/postgres"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// AdminService exposes operational tooling that is restricted to admin users
+type AdminService interface {
+	// FindDuplicatePlaces reports groups of places that are likely duplicates of each other
+	FindDuplicatePlaces(ctx context.Context, filter *types.PlaceDuplicateFilter) (*dto.DuplicatePlacesResponse, error)
+
+	// ListIncompletePlaces reports places missing content, with what each one is missing
+	ListIncompletePlaces(ctx context.Context, filter *types.PlaceIncompleteFilter) (*dto.ListIncompletePlacesResponse, error)
+
+	// RefreshViews rebuilds the materialized views, e.g. after bulk edits
+	RefreshViews(ctx context.Context) (*dto.RefreshViewsResponse, error)
+
+	// ReslugPlaces regenerates place slugs under the current slug policy, keeping the old
+	// slugs resolvable
+	ReslugPlaces(ctx context.Context, req *dto.ReslugPlacesRequest) (*dto.ReslugPlacesReport, error)
+}
+
+type adminService struct {
+	ServiceParams
+}
+
+// NewAdminService creates a new admin service
+func NewAdminService(params ServiceParams) AdminService {
+	return &adminService{
+		ServiceParams: params,
+	}
+}
+
+// isAdmin reports whether the authenticated principal holds the admin scope
+func isAdmin(ctx context.Context) bool {
+	return types.HasScope(types.GetScopes(ctx), types.ScopeAdmin)
+}
+
+// scopePlaceFilter narrows a place listing to what the caller may see: only admins get
+// soft-deleted places and published places outside their publishing window
+func scopePlaceFilter(ctx context.Context, filter *types.PlaceFilter) {
+	admin := isAdmin(ctx)
+	if !admin {
+		filter.IncludeDeleted = false
+	}
+	filter.IncludeScheduled = admin
+}
+
+// checkContributorFilter lets admins filter listings on any contributor, and everyone else
+// only on their own user ID
+func checkContributorFilter(ctx context.Context, f types.ContributorFilter) error {
+	if isAdmin(ctx) {
+		return nil
+	}
+
+	userID := types.GetUserID(ctx)
+	for _, actor := range f.Actors() {
+		if userID == "" || actor != userID {
+			return ierr.NewError("contributor filter on another user").
+				WithHint("You can only filter by your own contributions").
+				WithReportableDetails(map[string]any{
+					"actor": actor,
+				}).
+				Mark(ierr.ErrPermissionDenied)
+		}
+	}
+	return nil
+}
+
+// requireAdmin ensures the authenticated principal holds the admin scope.
+// Users with the ADMIN role are granted it by default.
+func requireAdmin(ctx context.Context) error {
+	if !isAdmin(ctx) {
+		return ierr.NewError("admin scope required").
+			WithHintf("Missing required scope: %s", types.ScopeAdmin).
+			WithReportableDetails(map[string]any{
+				"required_scope": types.ScopeAdmin,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	return nil
+}
+
+// FindDuplicatePlaces clusters places by distance and title similarity and returns
+// candidate duplicate groups with a suggested canonical place for each
+func (s *adminService) FindDuplicatePlaces(ctx context.Context, filter *types.PlaceDuplicateFilter) (*dto.DuplicatePlacesResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if filter == nil {
+		filter = types.NewPlaceDuplicateFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	pairs, err := s.PlaceRepo.FindDuplicatePairs(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := place.GroupDuplicatePairs(pairs)
+
+	s.Logger.Infow("duplicate place scan completed",
+		"radius_m", filter.GetRadiusM(),
+		"min_similarity", filter.GetMinSimilarity(),
+		"pairs", len(pairs),
+		"groups", len(groups),
+	)
+
+	return dto.NewDuplicatePlacesResponse(groups, filter), nil
+}
+
+// ListIncompletePlaces returns a paginated list of places missing a primary image,
+// long description, coordinates or categories
+func (s *adminService) ListIncompletePlaces(ctx context.Context, filter *types.PlaceIncompleteFilter) (*dto.ListIncompletePlacesResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if filter == nil {
+		filter = types.NewPlaceIncompleteFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	places, err := s.PlaceRepo.ListIncomplete(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.PlaceRepo.CountIncomplete(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewListIncompletePlacesResponse(places, total, filter.GetLimit(), filter.GetOffset()), nil
+}
+
+// RefreshViews forces a refresh of the precomputed listings instead of waiting for the background job
+func (s *adminService) RefreshViews(ctx context.Context) (*dto.RefreshViewsResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.PlaceRepo.RefreshFeedListings(ctx); err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("refreshed materialized views", "actor", types.GetActor(ctx))
+
+	return &dto.RefreshViewsResponse{
+		Views:       []string{postgres.PlaceFeedListingsView},
+		RefreshedAt: s.now(),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+func TestScopePlaceFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		scopes []string
+		want   bool
+	}{
+		{name: "anonymous", scopes: nil, want: false},
+		{name: "user", scopes: []string{string(types.ScopePlacesWrite), string(types.ScopeImagesWrite)}, want: false},
+		{name: "admin", scopes: []string{string(types.ScopeAdmin)}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.scopes != nil {
+				ctx = context.WithValue(ctx, types.CtxScopes, tt.scopes)
+			}
+
+			filter := types.NewPlaceFilter()
+			filter.IncludeDeleted = true
+			scopePlaceFilter(ctx, filter)
+
+			if filter.IncludeDeleted != tt.want {
+				t.Errorf("IncludeDeleted = %v, want %v", filter.IncludeDeleted, tt.want)
+			}
+			if filter.IncludeScheduled != tt.want {
+				t.Errorf("IncludeScheduled = %v, want %v", filter.IncludeScheduled, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	"github.com/omkar273/nashikdarshan/internal/domain/apikey"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/security"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+)
+
+// lastUsedResolution limits how often last_used_at is written for a busy key
+const lastUsedResolution = time.Minute
+
+type APIKeyService interface {
+	// Admin operations
+	Create(ctx context.Context, req *dto.CreateAPIKeyRequest) (*dto.CreateAPIKeyResponse, error)
+	List(ctx context.Context) (*dto.ListAPIKeysResponse, error)
+	Revoke(ctx context.Context, id string) error
+
+	// Authenticate resolves a raw API key to an active key
+	Authenticate(ctx context.Context, rawKey string) (*apikey.APIKey, error)
+}
+
+type apiKeyService struct {
+	ServiceParams
+}
+
+// NewAPIKeyService creates a new API key service
+func NewAPIKeyService(params ServiceParams) APIKeyService {
+	return &apiKeyService{
+		ServiceParams: params,
+	}
+}
+
+// Create generates a new API key. The raw key is only returned in this response.
+func (s *apiKeyService) Create(ctx context.Context, req *dto.CreateAPIKeyRequest) (*dto.CreateAPIKeyResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	rawKey, displayPrefix, err := security.GenerateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+
+	key := &apikey.APIKey{
+		ID:        s.newID(types.UUID_PREFIX_API_KEY),
+		Label:     req.Label,
+		KeyHash:   s.Encryption.Hash(rawKey),
+		KeyPrefix: displayPrefix,
+		Scopes:    lo.Uniq(req.Scopes),
+		ExpiresAt: req.ExpiresAt,
+		BaseModel: s.newBaseModel(ctx),
+	}
+
+	if err := s.APIKeyRepo.Create(ctx, key); err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("api key created",
+		"api_key_id", key.ID,
+		"label", key.Label,
+		"scopes", key.Scopes,
+	)
+
+	return &dto.CreateAPIKeyResponse{
+		APIKey: key,
+		Key:    rawKey,
+	}, nil
+}
+
+// List returns all API keys that have not been deleted
+func (s *apiKeyService) List(ctx context.Context) (*dto.ListAPIKeysResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	keys, err := s.APIKeyRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewListAPIKeysResponse(keys), nil
+}
+
+// Revoke permanently disables an API key
+func (s *apiKeyService) Revoke(ctx context.Context, id string) error {
+	if err := requireAdmin(ctx); err != nil {
+		return err
+	}
+
+	if err := s.APIKeyRepo.Revoke(ctx, id); err != nil {
+		return err
+	}
+
+	s.Logger.Infow("api key revoked", "api_key_id", id)
+	return nil
+}
+
+// Authenticate validates a raw API key and records its usage
+func (s *apiKeyService) Authenticate(ctx context.Context, rawKey string) (*apikey.APIKey, error) {
+	if rawKey == "" {
+		return nil, apiKeyUnauthenticated("missing_api_key", "API key is required")
+	}
+
+	key, err := s.APIKeyRepo.GetByHash(ctx, s.Encryption.Hash(rawKey))
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			return nil, apiKeyUnauthenticated("invalid_api_key", "Invalid API key")
+		}
+		return nil, err
+	}
+
+	now := s.now()
+	if !key.IsActive(now) {
+		return nil, apiKeyUnauthenticated("api_key_expired", "API key has expired or was revoked")
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= lastUsedResolution {
+		// Usage tracking must not block authentication
+		if err := s.APIKeyRepo.TouchLastUsed(ctx, key.ID, now); err != nil {
+			s.Logger.Warnw("failed to record api key usage", "api_key_id", key.ID, "error", err)
+		}
+	}
+
+	return key, nil
+}
+
+func apiKeyUnauthenticated(reason string, hint string) error {
+	return ierr.NewError(reason).
+		WithHint(hint).
+		WithReportableDetails(map[string]any{
+			"reason": reason,
+		}).
+		Mark(ierr.ErrUnauthenticated)
+}
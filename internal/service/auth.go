@@ -44,6 +44,7 @@ func (s *authService) Signup(ctx context.Context, req *dto.SignupRequest) (*dto.
 
 		userReq := req.ToUser(ctx)
 		userReq.ID = claims.ID
+		s.ServiceParams.stampCreated(&userReq.BaseModel)
 
 		// Get existing user or create if not found (idempotent behavior)
 		existingUser, err := userService.Get(ctx, claims.ID)
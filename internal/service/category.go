@@ -2,8 +2,11 @@ package service
 
 import (
 	"context"
+	"strings"
 
 	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	"github.com/omkar273/nashikdarshan/internal/domain/category"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
 	"github.com/omkar273/nashikdarshan/internal/types"
 )
 
@@ -11,6 +14,8 @@ type CategoryService interface {
 	Create(ctx context.Context, req *dto.CreateCategoryRequest) (*dto.CategoryResponse, error)
 	Get(ctx context.Context, id string) (*dto.CategoryResponse, error)
 	GetBySlug(ctx context.Context, slug string) (*dto.CategoryResponse, error)
+	// GetStats aggregates the published places of the published category with the given slug
+	GetStats(ctx context.Context, slug string) (*dto.CategoryStatsResponse, error)
 	Update(ctx context.Context, id string, req *dto.UpdateCategoryRequest) (*dto.CategoryResponse, error)
 	Delete(ctx context.Context, id string) error
 	List(ctx context.Context, filter *types.CategoryFilter) (*dto.ListCategoriesResponse, error)
@@ -34,8 +39,18 @@ func (s *categoryService) Create(ctx context.Context, req *dto.CreateCategoryReq
 	}
 
 	cat := req.ToCategory(ctx)
-
-	err := s.CategoryRepo.Create(ctx, cat)
+	cat.ID = s.newID(types.UUID_PREFIX_CATEGORY)
+	s.stampCreated(&cat.BaseModel)
+
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.ensureUniqueName(ctx, cat.Name, ""); err != nil {
+			return err
+		}
+		if err := s.CategoryRepo.Create(ctx, cat); err != nil {
+			return err
+		}
+		return s.enqueueEvent(ctx, types.EventCategoryCreated, types.AggregateCategory, cat.ID, cat)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -69,20 +84,54 @@ func (s *categoryService) GetBySlug(ctx context.Context, slug string) (*dto.Cate
 	}, nil
 }
 
-// Update updates an existing category
-func (s *categoryService) Update(ctx context.Context, id string, req *dto.UpdateCategoryRequest) (*dto.CategoryResponse, error) {
-	if err := req.Validate(); err != nil {
+// GetStats aggregates the published places of a published category; a missing or deleted
+// category is not found
+func (s *categoryService) GetStats(ctx context.Context, slug string) (*dto.CategoryStatsResponse, error) {
+	cat, err := s.CategoryRepo.GetBySlug(ctx, slug)
+	if err != nil {
 		return nil, err
 	}
 
-	cat, err := s.CategoryRepo.Get(ctx, id)
+	stats, err := s.PlaceRepo.CategoryStats(ctx, cat.ID)
 	if err != nil {
 		return nil, err
 	}
+	return dto.NewCategoryStatsResponse(cat, stats), nil
+}
 
-	req.ApplyToCategory(ctx, cat)
+// Update updates an existing category
+func (s *categoryService) Update(ctx context.Context, id string, req *dto.UpdateCategoryRequest) (*dto.CategoryResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 
-	err = s.CategoryRepo.Update(ctx, cat)
+	var cat *category.Category
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		// Admins may update an archived category, e.g. to restore it with a live status
+		if isAdmin(ctx) {
+			cat, err = s.CategoryRepo.GetIncludingArchived(ctx, id)
+		} else {
+			cat, err = s.CategoryRepo.Get(ctx, id)
+		}
+		if err != nil {
+			return err
+		}
+
+		previousName := cat.Name
+		req.ApplyToCategory(ctx, cat)
+
+		if !strings.EqualFold(cat.Name, previousName) {
+			if err := s.ensureUniqueName(ctx, cat.Name, cat.ID); err != nil {
+				return err
+			}
+		}
+
+		if err := s.CategoryRepo.Update(ctx, cat); err != nil {
+			return err
+		}
+		return s.enqueueEvent(ctx, types.EventCategoryUpdated, types.AggregateCategory, cat.ID, cat)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -92,14 +141,42 @@ func (s *categoryService) Update(ctx context.Context, id string, req *dto.Update
 	}, nil
 }
 
-// Delete soft deletes a category
-func (s *categoryService) Delete(ctx context.Context, id string) error {
-	cat, err := s.CategoryRepo.Get(ctx, id)
+// ensureUniqueName rejects a name already used by another category when
+// categories.unique_names is enabled. Names are compared case-insensitively.
+func (s *categoryService) ensureUniqueName(ctx context.Context, name string, excludeID string) error {
+	if !s.Config.Categories.UniqueNames {
+		return nil
+	}
+
+	exists, err := s.CategoryRepo.ExistsByName(ctx, name, excludeID)
 	if err != nil {
 		return err
 	}
+	if exists {
+		return ierr.NewErrorf("category name %q already exists", name).
+			WithHintf("A category named %q already exists. Please choose a different name", name).
+			WithReportableDetails(map[string]any{
+				"name": name,
+			}).
+			Mark(ierr.ErrAlreadyExists)
+	}
+	return nil
+}
 
-	return s.CategoryRepo.Delete(ctx, cat)
+// Delete soft deletes a category
+func (s *categoryService) Delete(ctx context.Context, id string) error {
+	return s.DB.WithTx(ctx, func(ctx context.Context) error {
+		cat, err := s.CategoryRepo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.CategoryRepo.Delete(ctx, cat); err != nil {
+			return err
+		}
+		cat.Status = types.StatusArchived
+		return s.enqueueEvent(ctx, types.EventCategoryDeleted, types.AggregateCategory, cat.ID, cat)
+	})
 }
 
 // List retrieves a paginated list of categories
@@ -108,6 +185,14 @@ func (s *categoryService) List(ctx context.Context, filter *types.CategoryFilter
 		filter = types.NewCategoryFilter()
 	}
 
+	// Deleted categories are only ever visible to admins
+	if filter.IncludeDeleted && !isAdmin(ctx) {
+		filter.IncludeDeleted = false
+	}
+	if err := checkContributorFilter(ctx, filter.ContributorFilter); err != nil {
+		return nil, err
+	}
+
 	// Get categories
 	categories, err := s.CategoryRepo.List(ctx, filter)
 	if err != nil {
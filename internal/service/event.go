@@ -2,9 +2,11 @@ package service
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	eventdomain "github.com/omkar273/nashikdarshan/internal/domain/event"
 	ierr "github.com/omkar273/nashikdarshan/internal/errors"
 	"github.com/omkar273/nashikdarshan/internal/types"
 )
@@ -18,6 +20,15 @@ type EventService interface {
 	Delete(ctx context.Context, id string) error
 	List(ctx context.Context, filter *types.EventFilter) (*dto.ListEventsResponse, error)
 
+	// Place-scoped event operations
+	ListByPlace(ctx context.Context, placeID string, filter *types.EventFilter) (*dto.ListEventsResponse, error)
+	CreateForPlace(ctx context.Context, placeID string, req *dto.CreateEventRequest) (*dto.EventResponse, error)
+	UpdateForPlace(ctx context.Context, placeID string, id string, req *dto.UpdateEventRequest) (*dto.EventResponse, error)
+	DeleteForPlace(ctx context.Context, placeID string, id string) error
+
+	// Schedule operations
+	UpcomingEvents(ctx context.Context, within time.Duration) (*dto.UpcomingEventsResponse, error)
+
 	// Occurrence operations
 	CreateOccurrence(ctx context.Context, req *dto.CreateOccurrenceRequest) (*dto.OccurrenceResponse, error)
 	GetOccurrence(ctx context.Context, id string) (*dto.OccurrenceResponse, error)
@@ -37,17 +48,21 @@ type eventService struct {
 
 // NewEventService creates a new event service
 func NewEventService(params ServiceParams) EventService {
+	return &eventService{
+		ServiceParams: params,
+		timezone:      loadEventTimezone(),
+	}
+}
+
+// loadEventTimezone returns the timezone event schedules are expressed in (IST)
+func loadEventTimezone() *time.Location {
 	// Load IST timezone (Asia/Kolkata)
 	ist, err := time.LoadLocation("Asia/Kolkata")
 	if err != nil {
 		// Fallback to UTC+5:30 if timezone database not available
 		ist = time.FixedZone("IST", 5*60*60+30*60)
 	}
-
-	return &eventService{
-		ServiceParams: params,
-		timezone:      ist,
-	}
+	return ist
 }
 
 // Create creates a new event
@@ -60,6 +75,8 @@ func (s *eventService) Create(ctx context.Context, req *dto.CreateEventRequest)
 	if err != nil {
 		return nil, err
 	}
+	event.ID = s.newID(types.UUID_PREFIX_EVENT)
+	s.stampCreated(&event.BaseModel)
 
 	err = s.EventRepo.Create(ctx, event)
 	if err != nil {
@@ -156,6 +173,103 @@ func (s *eventService) List(ctx context.Context, filter *types.EventFilter) (*dt
 	return response, nil
 }
 
+// ListByPlace retrieves a paginated list of events held at a place
+func (s *eventService) ListByPlace(ctx context.Context, placeID string, filter *types.EventFilter) (*dto.ListEventsResponse, error) {
+	// Verify place exists
+	if _, err := s.PlaceRepo.Get(ctx, placeID); err != nil {
+		return nil, err
+	}
+
+	if filter == nil {
+		filter = types.NewEventFilter()
+	}
+	filter.PlaceID = &placeID
+
+	return s.List(ctx, filter)
+}
+
+// CreateForPlace creates a new event attached to a place
+func (s *eventService) CreateForPlace(ctx context.Context, placeID string, req *dto.CreateEventRequest) (*dto.EventResponse, error) {
+	// Verify place exists
+	if _, err := s.PlaceRepo.Get(ctx, placeID); err != nil {
+		return nil, err
+	}
+
+	req.PlaceID = &placeID
+	return s.Create(ctx, req)
+}
+
+// UpdateForPlace updates an event that belongs to a place
+func (s *eventService) UpdateForPlace(ctx context.Context, placeID string, id string, req *dto.UpdateEventRequest) (*dto.EventResponse, error) {
+	if _, err := s.getPlaceEvent(ctx, placeID, id); err != nil {
+		return nil, err
+	}
+
+	if req.PlaceID != nil && *req.PlaceID != placeID {
+		return nil, ierr.NewError("place_id cannot be changed through a place-scoped route").
+			WithHint("Use the event update endpoint to move an event to another place").
+			WithReportableDetails(map[string]any{
+				"place_id": placeID,
+				"event_id": id,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return s.Update(ctx, id, req)
+}
+
+// DeleteForPlace soft deletes an event that belongs to a place
+func (s *eventService) DeleteForPlace(ctx context.Context, placeID string, id string) error {
+	if _, err := s.getPlaceEvent(ctx, placeID, id); err != nil {
+		return err
+	}
+
+	return s.Delete(ctx, id)
+}
+
+// getPlaceEvent fetches an event and verifies it is attached to the given place
+func (s *eventService) getPlaceEvent(ctx context.Context, placeID string, id string) (*dto.EventResponse, error) {
+	event, err := s.EventRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.PlaceID == nil || *event.PlaceID != placeID {
+		return nil, ierr.NewError("event does not belong to place").
+			WithHintf("Event with ID %s was not found for this place", id).
+			WithReportableDetails(map[string]any{
+				"place_id": placeID,
+				"event_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	return dto.NewEventResponse(event), nil
+}
+
+// UpcomingEvents expands the schedules of all published events and returns the
+// concrete instances starting between now and now+within, ordered by start time
+func (s *eventService) UpcomingEvents(ctx context.Context, within time.Duration) (*dto.UpcomingEventsResponse, error) {
+	from := s.now().In(s.timezone)
+	to := from.Add(within)
+
+	events, err := s.EventRepo.ListActiveInWindow(ctx, nil, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	instances := make([]*eventdomain.ExpandedOccurrence, 0)
+	for _, e := range events {
+		instances = append(instances, e.ExpandOccurrences(from, to, s.timezone)...)
+	}
+
+	sort.SliceStable(instances, func(i, j int) bool {
+		return instances[i].StartTime.Before(instances[j].StartTime)
+	})
+
+	return dto.NewUpcomingEventsResponse(instances, from, to), nil
+}
+
 // CreateOccurrence creates a new occurrence for an event
 func (s *eventService) CreateOccurrence(ctx context.Context, req *dto.CreateOccurrenceRequest) (*dto.OccurrenceResponse, error) {
 	if err := req.Validate(); err != nil {
@@ -172,6 +286,8 @@ func (s *eventService) CreateOccurrence(ctx context.Context, req *dto.CreateOccu
 	if err != nil {
 		return nil, err
 	}
+	occurrence.ID = s.newID(types.UUID_PREFIX_OCCURRENCE)
+	s.stampCreated(&occurrence.BaseModel)
 
 	err = s.EventRepo.CreateOccurrence(ctx, occurrence)
 	if err != nil {
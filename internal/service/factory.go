@@ -1,16 +1,25 @@
 package service
 
 import (
+	"context"
+	"time"
+
 	"github.com/omkar273/nashikdarshan/internal/config"
+	"github.com/omkar273/nashikdarshan/internal/domain/apikey"
 	"github.com/omkar273/nashikdarshan/internal/domain/category"
 	eventdomain "github.com/omkar273/nashikdarshan/internal/domain/event"
 	"github.com/omkar273/nashikdarshan/internal/domain/hotel"
 	itinerarydomain "github.com/omkar273/nashikdarshan/internal/domain/itinerary"
+	"github.com/omkar273/nashikdarshan/internal/domain/outbox"
 	"github.com/omkar273/nashikdarshan/internal/domain/place"
 	"github.com/omkar273/nashikdarshan/internal/domain/review"
 	"github.com/omkar273/nashikdarshan/internal/domain/user"
 	"github.com/omkar273/nashikdarshan/internal/logger"
 	"github.com/omkar273/nashikdarshan/internal/postgres"
+	"github.com/omkar273/nashikdarshan/internal/security"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/omkar273/nashikdarshan/internal/vision"
+	"github.com/omkar273/nashikdarshan/internal/weather"
 	"go.uber.org/fx"
 )
 
@@ -22,6 +31,9 @@ type ServiceParams struct {
 	Config *config.Configuration
 	DB     postgres.IClient
 
+	// Security dependencies
+	Encryption security.EncryptionService
+
 	// Repository dependencies
 	UserRepo      user.Repository
 	CategoryRepo  category.Repository
@@ -30,7 +42,45 @@ type ServiceParams struct {
 	HotelRepo     hotel.Repository
 	EventRepo     eventdomain.Repository
 	ItineraryRepo itinerarydomain.Repository
+	APIKeyRepo    apikey.Repository
+	OutboxRepo    outbox.Repository
+
+	// Time and id sources; when not provided the system clock and random ids are used
+	Clock types.Clock       `optional:"true"`
+	IDs   types.IDGenerator `optional:"true"`
 
 	// External service dependencies
-	RoutingClient RoutingClient `optional:"true"` // Optional for services that don't need routing
+	RoutingClient RoutingClient    `optional:"true"` // Optional for services that don't need routing
+	Weather       weather.Provider `optional:"true"`
+	Captioner     vision.Captioner `optional:"true"`
+}
+
+// now returns the current time from the injected Clock
+func (p ServiceParams) now() time.Time {
+	if p.Clock == nil {
+		return types.NowUTC()
+	}
+	return p.Clock.Now()
+}
+
+// newID returns a new id with the given prefix from the injected IDGenerator
+func (p ServiceParams) newID(prefix string) string {
+	if p.IDs == nil {
+		return types.GenerateUUIDWithPrefix(prefix)
+	}
+	return p.IDs.NewID(prefix)
+}
+
+// newBaseModel returns the audit fields of an entity created now by the actor in ctx
+func (p ServiceParams) newBaseModel(ctx context.Context) types.BaseModel {
+	base := types.GetDefaultBaseModel(ctx)
+	p.stampCreated(&base)
+	return base
+}
+
+// stampCreated sets the creation and update times of a new entity from the injected Clock.
+// Request converters fill in the wall-clock time; services stamp what they create.
+func (p ServiceParams) stampCreated(base *types.BaseModel) {
+	base.CreatedAt = p.now()
+	base.UpdatedAt = base.CreatedAt
 }
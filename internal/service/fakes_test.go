@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/ent"
+	"github.com/omkar273/nashikdarshan/internal/config"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/logger"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"go.uber.org/zap"
+)
+
+// testNow is the time of the fixed clock the test services run on
+var testNow = time.Date(2025, 3, 1, 6, 0, 0, 0, time.UTC)
+
+// fakeDB runs transactions inline; the fakes below are not transactional
+type fakeDB struct{}
+
+func (fakeDB) WithTx(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }
+func (fakeDB) TxFromContext(context.Context) *ent.Tx                            { return nil }
+func (fakeDB) Querier(context.Context) *ent.Client                              { return nil }
+
+// fakePlaceRepo keeps places, images, category assignments and revisions in memory. Methods a
+// test needs but the fake does not implement panic through the nil embedded Repository.
+type fakePlaceRepo struct {
+	place.Repository
+
+	places        map[string]*place.Place
+	images        map[string]*place.PlaceImage
+	categories    map[string][]string
+	revisions     []*place.Revision
+	previousSlugs []*place.PreviousSlug
+	// scheduleNow records the time ListScheduleDue was called with
+	scheduleNow time.Time
+}
+
+func newFakePlaceRepo(places ...*place.Place) *fakePlaceRepo {
+	r := &fakePlaceRepo{
+		places:     map[string]*place.Place{},
+		images:     map[string]*place.PlaceImage{},
+		categories: map[string][]string{},
+	}
+	for _, p := range places {
+		r.places[p.ID] = p
+	}
+	return r
+}
+
+func (r *fakePlaceRepo) Create(_ context.Context, p *place.Place) error {
+	copied := *p
+	r.places[p.ID] = &copied
+	return nil
+}
+
+func (r *fakePlaceRepo) get(id string, includeArchived bool) (*place.Place, error) {
+	p, ok := r.places[id]
+	if !ok {
+		return nil, ierr.NewErrorf("place %s not found", id).Mark(ierr.ErrNotFound)
+	}
+	if p.Status.IsSoftDeleted() && !(includeArchived && p.Status == types.StatusArchived) {
+		return nil, ierr.NewErrorf("place %s has been deleted", id).Mark(ierr.ErrGone)
+	}
+	copied := *p
+	return &copied, nil
+}
+
+func (r *fakePlaceRepo) Get(_ context.Context, id string) (*place.Place, error) {
+	return r.get(id, false)
+}
+
+func (r *fakePlaceRepo) GetIncludingArchived(_ context.Context, id string) (*place.Place, error) {
+	return r.get(id, true)
+}
+
+func (r *fakePlaceRepo) Update(ctx context.Context, p *place.Place) error {
+	if _, ok := r.places[p.ID]; !ok {
+		return ierr.NewErrorf("place %s not found", p.ID).Mark(ierr.ErrNotFound)
+	}
+	copied := *p
+	copied.UpdatedBy = types.GetActor(ctx)
+	r.places[p.ID] = &copied
+	return nil
+}
+
+func (r *fakePlaceRepo) ExistsBySlug(_ context.Context, slug string) (bool, error) {
+	for _, p := range r.places {
+		if p.Slug == slug {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Stream hands fn the places that are not soft-deleted, by id; the filter is ignored
+func (r *fakePlaceRepo) Stream(_ context.Context, _ *types.PlaceFilter, _ int, fn func(*place.Place) error) error {
+	ids := slices.Sorted(maps.Keys(r.places))
+	for _, id := range ids {
+		p := r.places[id]
+		if p.Status.IsSoftDeleted() {
+			continue
+		}
+		copied := *p
+		if err := fn(&copied); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *fakePlaceRepo) ChangeSlug(_ context.Context, placeID, oldSlug, newSlug string) (bool, error) {
+	p, ok := r.places[placeID]
+	if !ok || p.Slug != oldSlug || p.Status.IsSoftDeleted() {
+		return false, nil
+	}
+	p.Slug = newSlug
+	return true, nil
+}
+
+func (r *fakePlaceRepo) RecordPreviousSlug(_ context.Context, prev *place.PreviousSlug) error {
+	r.previousSlugs = append(r.previousSlugs, prev)
+	return nil
+}
+
+func (r *fakePlaceRepo) AddImage(_ context.Context, image *place.PlaceImage) error {
+	copied := *image
+	r.images[image.ID] = &copied
+	return nil
+}
+
+func (r *fakePlaceRepo) GetImage(_ context.Context, id string) (*place.PlaceImage, error) {
+	image, ok := r.images[id]
+	if !ok {
+		return nil, ierr.NewErrorf("image %s not found", id).Mark(ierr.ErrNotFound)
+	}
+	copied := *image
+	return &copied, nil
+}
+
+func (r *fakePlaceRepo) UpdateImage(_ context.Context, image *place.PlaceImage) error {
+	if _, ok := r.images[image.ID]; !ok {
+		return ierr.NewErrorf("image %s not found", image.ID).Mark(ierr.ErrNotFound)
+	}
+	copied := *image
+	r.images[image.ID] = &copied
+	return nil
+}
+
+// GetImages returns the images of the place by position, like the ent repository
+func (r *fakePlaceRepo) GetImages(_ context.Context, placeID string) ([]*place.PlaceImage, error) {
+	var images []*place.PlaceImage
+	for _, image := range r.images {
+		if image.PlaceID == placeID {
+			copied := *image
+			images = append(images, &copied)
+		}
+	}
+	slices.SortFunc(images, func(a, b *place.PlaceImage) int { return a.Pos - b.Pos })
+	return images, nil
+}
+
+func (r *fakePlaceRepo) SetPrimaryImageURL(_ context.Context, placeID string, url *string) error {
+	p, ok := r.places[placeID]
+	if !ok {
+		return ierr.NewErrorf("place %s not found", placeID).Mark(ierr.ErrNotFound)
+	}
+	p.PrimaryImageURL = url
+	return nil
+}
+
+func (r *fakePlaceRepo) ListScheduleDue(_ context.Context, now time.Time) ([]*place.Place, error) {
+	r.scheduleNow = now
+	var due []*place.Place
+	for _, p := range r.places {
+		publishDue := p.Status == types.StatusDraft && p.PublishAt != nil && !p.PublishAt.After(now)
+		unpublishDue := p.Status == types.StatusPublished && p.UnpublishAt != nil && !p.UnpublishAt.After(now)
+		if publishDue || unpublishDue {
+			copied := *p
+			due = append(due, &copied)
+		}
+	}
+	return due, nil
+}
+
+func (r *fakePlaceRepo) AssignCategories(_ context.Context, placeID string, categoryIDs []string) error {
+	r.categories[placeID] = slices.Clone(categoryIDs)
+	return nil
+}
+
+func (r *fakePlaceRepo) GetCategoryIDs(_ context.Context, placeID string) ([]string, error) {
+	return r.categories[placeID], nil
+}
+
+func (r *fakePlaceRepo) LatestRevisionNumber(_ context.Context, placeID string) (int, error) {
+	latest := 0
+	for _, rev := range r.revisions {
+		if rev.PlaceID == placeID {
+			latest = max(latest, rev.Revision)
+		}
+	}
+	return latest, nil
+}
+
+func (r *fakePlaceRepo) CreateRevision(_ context.Context, rev *place.Revision) error {
+	r.revisions = append(r.revisions, rev)
+	return nil
+}
+
+func (r *fakePlaceRepo) PruneRevisions(context.Context, string, int) (int, error) {
+	return 0, nil
+}
+
+// newTestParams returns service params on a fixed clock with sequential ids and the default
+// configuration
+func newTestParams(repo place.Repository) ServiceParams {
+	return ServiceParams{
+		Logger:    &logger.Logger{SugaredLogger: zap.NewNop().Sugar()},
+		Config:    config.GetDefaultConfig(),
+		DB:        fakeDB{},
+		PlaceRepo: repo,
+		Clock:     types.FixedClock{T: testNow},
+		IDs:       &types.SequentialIDGenerator{},
+	}
+}
+
+// newTestPlaceService returns a place service over repo, see newTestParams
+func newTestPlaceService(repo place.Repository) *placeService {
+	return NewPlaceService(newTestParams(repo)).(*placeService)
+}
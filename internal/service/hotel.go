@@ -40,6 +40,8 @@ func (s *hotelService) Create(ctx context.Context, req *dto.CreateHotelRequest)
 	if err != nil {
 		return nil, err
 	}
+	h.ID = s.newID(types.UUID_PREFIX_HOTEL)
+	s.stampCreated(&h.BaseModel)
 
 	err = s.HotelRepo.Create(ctx, h)
 	if err != nil {
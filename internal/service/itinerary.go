@@ -3,7 +3,6 @@ package service
 import (
 	"context"
 	"sort"
-	"time"
 
 	"github.com/omkar273/nashikdarshan/ent/visit"
 	"github.com/omkar273/nashikdarshan/internal/api/dto"
@@ -72,7 +71,7 @@ func (s *itineraryService) Create(ctx context.Context, userID string, req *dto.C
 
 	// Create itinerary domain model
 	itin := &itinerary.Itinerary{
-		ID:                    types.GenerateUUIDWithPrefix(types.UUID_PREFIX_ITINERARY),
+		ID:                    s.newID(types.UUID_PREFIX_ITINERARY),
 		UserID:                userID,
 		Title:                 req.Title,
 		Description:           req.Description,
@@ -85,8 +84,8 @@ func (s *itineraryService) Create(ctx context.Context, userID string, req *dto.C
 		IsOptimized:           true,
 		BaseModel: types.BaseModel{
 			Status:    types.StatusPublished,
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
+			CreatedAt: s.now(),
+			UpdatedAt: s.now(),
 			CreatedBy: userID,
 			UpdatedBy: userID,
 		},
@@ -223,7 +222,7 @@ func (s *itineraryService) optimizeRoute(
 
 		// Create visit
 		visit := &itinerary.Visit{
-			ID:                            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_VISIT),
+			ID:                            s.newID(types.UUID_PREFIX_VISIT),
 			PlaceID:                       p.ID,
 			SequenceOrder:                 seq, // seq already accounts for start location
 			PlannedDurationMinutes:        visitDuration,
@@ -232,8 +231,8 @@ func (s *itineraryService) optimizeRoute(
 			TransportMode:                 transportMode,
 			BaseModel: types.BaseModel{
 				Status:    types.StatusPublished,
-				CreatedAt: time.Now(),
-				UpdatedAt: time.Now(),
+				CreatedAt: s.now(),
+				UpdatedAt: s.now(),
 			},
 			Place: p,
 		}
@@ -283,6 +282,9 @@ func (s *itineraryService) fetchPlaces(ctx context.Context, placeIDs []string) (
 					}).
 					Mark(ierr.ErrNotFound)
 			}
+			if ierr.IsGone(err) {
+				return nil, err
+			}
 			return nil, ierr.WithError(err).
 				WithHint("Failed to fetch place details").
 				Mark(ierr.ErrDatabase)
@@ -357,7 +359,7 @@ func (s *itineraryService) Update(ctx context.Context, id string, req *dto.Updat
 		itin.Status = *req.Status
 	}
 
-	itin.UpdatedAt = time.Now()
+	itin.UpdatedAt = s.now()
 
 	// Save updates
 	err = s.ItineraryRepo.Update(ctx, itin)
@@ -3,7 +3,6 @@ package service
 import (
 	"context"
 	"fmt"
-	"math"
 	"time"
 
 	"github.com/omkar273/nashikdarshan/internal/logger"
@@ -29,25 +28,6 @@ type noopRoutingClient struct {
 	log     logger.Logger
 }
 
-// haversineDistanceKm computes great-circle distance between two coordinates in kilometers
-func haversineDistanceKm(a, b types.Location) float64 {
-	// parse decimal strings to float64 via String() -> assume they are valid
-	lat1, _ := a.Latitude.Float64()
-	lng1, _ := a.Longitude.Float64()
-	lat2, _ := b.Latitude.Float64()
-	lng2, _ := b.Longitude.Float64()
-
-	const R = 6371.0 // Earth radius km
-	dLat := (lat2 - lat1) * math.Pi / 180.0
-	dLon := (lng2 - lng1) * math.Pi / 180.0
-	rlat1 := lat1 * math.Pi / 180.0
-	rlat2 := lat2 * math.Pi / 180.0
-
-	aH := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(rlat1)*math.Cos(rlat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
-	c := 2 * math.Atan2(math.Sqrt(aH), math.Sqrt(1-aH))
-	return R * c
-}
-
 // estimateTravelTimeMinutes uses average speeds depending on transport mode
 func estimateTravelTimeMinutes(distanceKm float64, mode types.TransportMode) int {
 	// average speeds (km/h)
@@ -86,7 +66,7 @@ func (n *noopRoutingClient) GetDistanceMatrix(
 
 	for i := 0; i < rows; i++ {
 		for j := 0; j < cols; j++ {
-			d := haversineDistanceKm(origins[i], destinations[j])
+			d := origins[i].DistanceKm(destinations[j])
 			t := estimateTravelTimeMinutes(d, mode)
 			matrix.Set(i, j, RouteInfo{DistanceKm: d, TravelTimeMinutes: t})
 		}
@@ -103,7 +83,7 @@ func (n *noopRoutingClient) GetDirections(
 	mode types.TransportMode,
 ) (string, error) {
 	// not a real directions provider
-	d := haversineDistanceKm(origin, destination)
+	d := origin.DistanceKm(destination)
 	minutes := estimateTravelTimeMinutes(d, mode)
 	return fmt.Sprintf("Estimated %.2f km, ~%d minutes (straight-line)", d, minutes), nil
 }
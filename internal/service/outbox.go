@@ -0,0 +1,153 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/domain/outbox"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// enqueueEvent records a change event in the outbox when it is enabled. Call it inside the
+// transaction of the change so the event is stored if and only if the change commits.
+func (p ServiceParams) enqueueEvent(ctx context.Context, eventType types.ChangeEventType, aggregateType, aggregateID string, data any) error {
+	if !p.Config.Outbox.Enabled {
+		return nil
+	}
+
+	event, err := outbox.NewEvent(p.newID(types.UUID_PREFIX_OUTBOX), p.now(), eventType, aggregateType, aggregateID, data)
+	if err != nil {
+		return err
+	}
+	return p.OutboxRepo.Create(ctx, event)
+}
+
+// OutboxDispatcher delivers the change events recorded in the outbox to the configured webhook
+type OutboxDispatcher interface {
+	// DispatchDue delivers one batch of due events and returns how many were acknowledged
+	DispatchDue(ctx context.Context) (int, error)
+}
+
+type outboxDispatcher struct {
+	ServiceParams
+	httpClient *http.Client
+}
+
+// NewOutboxDispatcher creates a new outbox dispatcher
+func NewOutboxDispatcher(params ServiceParams) OutboxDispatcher {
+	return &outboxDispatcher{
+		ServiceParams: params,
+		httpClient:    &http.Client{Timeout: params.Config.Outbox.GetTimeout()},
+	}
+}
+
+// outboxDelivery is the body POSTed to the webhook for each event
+type outboxDelivery struct {
+	ID            string                `json:"id"`
+	Type          types.ChangeEventType `json:"type"`
+	AggregateType string                `json:"aggregate_type"`
+	AggregateID   string                `json:"aggregate_id"`
+	OccurredAt    time.Time             `json:"occurred_at"`
+	Attempt       int                   `json:"attempt"`
+	Data          json.RawMessage       `json:"data"`
+}
+
+// maxOutboxRetryDelay caps the exponential backoff between delivery attempts
+const maxOutboxRetryDelay = time.Hour
+
+// DispatchDue claims a batch of due events and delivers them one by one. Delivery is
+// at-least-once: an event whose acknowledgement cannot be recorded is delivered again once
+// its lease expires, so consumers deduplicate on the event id.
+func (s *outboxDispatcher) DispatchDue(ctx context.Context) (int, error) {
+	cfg := s.Config.Outbox
+	batchSize := cfg.GetBatchSize()
+
+	// Deliveries run sequentially, so the lease must outlast a batch of timeouts
+	lease := cfg.GetTimeout() * time.Duration(batchSize+1)
+
+	events, err := s.OutboxRepo.ClaimDue(ctx, batchSize, lease)
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	for _, event := range events {
+		if err := s.deliver(ctx, event); err != nil {
+			dead := event.Attempts >= cfg.GetMaxAttempts()
+			retryAt := s.now().Add(outboxRetryDelay(event.Attempts))
+
+			s.Logger.Warnw("failed to deliver outbox event",
+				"event_id", event.ID,
+				"event_type", event.EventType,
+				"attempt", event.Attempts,
+				"dead", dead,
+				"error", err,
+			)
+			if err := s.OutboxRepo.MarkFailed(ctx, event.ID, err.Error(), retryAt, dead); err != nil {
+				s.Logger.Errorw("failed to record outbox delivery failure", "event_id", event.ID, "error", err)
+			}
+			continue
+		}
+
+		if err := s.OutboxRepo.MarkSent(ctx, event.ID); err != nil {
+			s.Logger.Errorw("failed to mark outbox event sent", "event_id", event.ID, "error", err)
+			continue
+		}
+		delivered++
+	}
+
+	return delivered, nil
+}
+
+// deliver POSTs the event to the webhook; any 2xx response acknowledges it
+func (s *outboxDispatcher) deliver(ctx context.Context, event *outbox.Event) error {
+	body, err := json.Marshal(outboxDelivery{
+		ID:            event.ID,
+		Type:          event.EventType,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		OccurredAt:    event.CreatedAt,
+		Attempt:       event.Attempts,
+		Data:          event.Payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.Config.Outbox.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", event.ID)
+	req.Header.Set("X-Event-Type", string(event.EventType))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// outboxRetryDelay backs off exponentially from 10 seconds after the first attempt
+func outboxRetryDelay(attempts int) time.Duration {
+	delay := 10 * time.Second
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxOutboxRetryDelay {
+			return maxOutboxRetryDelay
+		}
+	}
+	return delay
+}
@@ -2,11 +2,18 @@ package service
 
 import (
 	"context"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	"github.com/omkar273/nashikdarshan/internal/diff"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
 	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/postgres"
+	"github.com/omkar273/nashikdarshan/internal/sanitize"
 	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
 )
 
 type PlaceService interface {
@@ -14,50 +21,121 @@ type PlaceService interface {
 	Create(ctx context.Context, req *dto.CreatePlaceRequest) (*dto.PlaceResponse, error)
 	Get(ctx context.Context, id string) (*dto.PlaceResponse, error)
 	GetBySlug(ctx context.Context, slug string) (*dto.PlaceResponse, error)
+	GetDetail(ctx context.Context, id string) (*dto.PlaceDetailResponse, error)
+	Upsert(ctx context.Context, req *dto.UpsertPlaceRequest) (*dto.PlaceResponse, bool, error)
+	Exists(ctx context.Context, req *dto.PlaceExistsRequest) (*dto.PlaceExistsResponse, error)
+	// Heatmap returns the density of the published places over a grid
+	Heatmap(ctx context.Context, req *dto.PlaceHeatmapRequest) (*dto.PlaceHeatmapResponse, error)
+	// NearbySummary counts the published places around a point by place type and category
+	NearbySummary(ctx context.Context, req *dto.NearbySummaryRequest) (*dto.NearbySummaryResponse, error)
+	// AtomFeed returns the Atom feed of recently updated published places
+	AtomFeed(ctx context.Context, feedURL, apiBaseURL string) (*dto.AtomFeed, error)
 	Update(ctx context.Context, id string, req *dto.UpdatePlaceRequest) (*dto.PlaceResponse, error)
+	Patch(ctx context.Context, id string, req *dto.PatchPlaceRequest) (*dto.PlaceResponse, error)
+	PreviewUpdate(ctx context.Context, id string, req *dto.UpdatePlaceRequest) (*dto.PlaceDryRunResponse, error)
+	PreviewPatch(ctx context.Context, id string, req *dto.PatchPlaceRequest) (*dto.PlaceDryRunResponse, error)
 	Delete(ctx context.Context, id string) error
+	Clone(ctx context.Context, id string, req *dto.ClonePlaceRequest) (*dto.PlaceResponse, error)
 
 	// List operations
 	List(ctx context.Context, filter *types.PlaceFilter) (*dto.ListPlacesResponse, error)
+	// Extent returns the box holding every place matching the filter, ignoring pagination
+	Extent(ctx context.Context, filter *types.PlaceFilter) (*dto.PlaceExtentResponse, error)
+	// Stream calls fn with every place matching the filter in id order, ignoring pagination
+	Stream(ctx context.Context, filter *types.PlaceFilter, fn func(*dto.PlaceResponse) error) error
+	// NearbyBatch returns the nearest published places around each origin, grouped by origin
+	NearbyBatch(ctx context.Context, origins []types.Point, radiusKm float64, limitPer int) (*dto.NearbyBatchResponse, error)
+	// CheckGeofences returns the published places whose geofence contains the location
+	CheckGeofences(ctx context.Context, location types.Point) (*dto.GeofenceCheckResponse, error)
+	// Centroid returns the center of the given places
+	Centroid(ctx context.Context, req *dto.PlaceCentroidRequest) (*dto.PlaceCentroidResponse, error)
+	// GetWeather returns a place with the current weather at its location
+	GetWeather(ctx context.Context, id string) (*dto.PlaceWeatherResponse, error)
+	// ListByCategory lists the published places of the category with the given slug
+	ListByCategory(ctx context.Context, categorySlug string, filter *types.PlaceFilter) (*dto.CategoryPlacesResponse, error)
+
+	// Search operations
+	Autocomplete(ctx context.Context, prefix string, limit int) (*dto.AutocompleteResponse, error)
+	Search(ctx context.Context, req *dto.SearchPlacesRequest) (*dto.SearchPlacesResponse, error)
+	Suggest(ctx context.Context, term string) (*string, error)
 
 	// Image operations
 	AddImage(ctx context.Context, placeID string, req *dto.CreatePlaceImageRequest) (*dto.PlaceImageResponse, error)
 	GetImages(ctx context.Context, placeID string) ([]*dto.PlaceImageResponse, error)
+	GetImage(ctx context.Context, imageID string) (*dto.PlaceImageResponse, error)
 	UpdateImage(ctx context.Context, imageID string, req *dto.UpdatePlaceImageRequest) (*dto.PlaceImageResponse, error)
-	DeleteImage(ctx context.Context, imageID string) error
+	// The delete methods refuse to remove the primary image under the reject policy unless force is set
+	DeleteImage(ctx context.Context, imageID string, force bool) error
+	DeleteImages(ctx context.Context, placeID string, imageIDs []string, force bool) error
+	DeleteAllImages(ctx context.Context, placeID string, force bool) error
 
 	// Feed operations
 	GetFeed(ctx context.Context, req *dto.FeedRequest) (*dto.FeedResponse, error)
 	IncrementViewCount(ctx context.Context, placeID string) error
 	UpdatePopularityScores(ctx context.Context) error
+	RefreshFeedListings(ctx context.Context) error
+
+	// ApplyPublishSchedules publishes and unpublishes the places whose schedule is due
+	ApplyPublishSchedules(ctx context.Context) error
 
 	// Category operations
 	AssignCategories(ctx context.Context, placeID string, req *dto.AssignCategoriesRequest) error
+
+	// Revision operations
+	ListRevisions(ctx context.Context, placeID string, filter *types.QueryFilter) (*dto.ListPlaceRevisionsResponse, error)
+	GetRevision(ctx context.Context, placeID string, revision int) (*dto.PlaceRevisionResponse, error)
+	RevertToRevision(ctx context.Context, placeID string, revision int) (*dto.PlaceRevisionResponse, error)
+	DiffRevisions(ctx context.Context, placeID string, req *dto.PlaceRevisionDiffRequest) (*dto.PlaceRevisionDiffResponse, error)
 }
 
+// nextEventLookahead bounds how far ahead the next event on a place is searched for
+const nextEventLookahead = 365 * 24 * time.Hour
+
 type placeService struct {
 	ServiceParams
+	descriptionHTML *sanitize.HTML
 }
 
 // NewPlaceService creates a new place service
 func NewPlaceService(params ServiceParams) PlaceService {
 	return &placeService{
-		ServiceParams: params,
+		ServiceParams:   params,
+		descriptionHTML: sanitize.NewHTML(params.Config.Places.DescriptionHTML),
 	}
 }
 
+// sanitizeDescriptions removes unsafe markup from the descriptions of p before it is stored
+func (s *placeService) sanitizeDescriptions(p *place.Place) {
+	p.ShortDescription = s.descriptionHTML.SanitizePtr(p.ShortDescription)
+	p.LongDescription = s.descriptionHTML.SanitizePtr(p.LongDescription)
+}
+
 // Create creates a new place
 func (s *placeService) Create(ctx context.Context, req *dto.CreatePlaceRequest) (*dto.PlaceResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, err
 	}
+	if err := s.authorizeFields(ctx, req); err != nil {
+		return nil, err
+	}
 
 	p, err := req.ToPlace(ctx)
 	if err != nil {
 		return nil, err
 	}
+	p.ID = s.newID(types.UUID_PREFIX_PLACE)
+	s.stampCreated(&p.BaseModel)
+	s.sanitizeDescriptions(p)
 
-	err = s.PlaceRepo.Create(ctx, p)
+	err = s.createWithSlug(ctx, p, func(ctx context.Context) error {
+		if err := s.PlaceRepo.Create(ctx, p); err != nil {
+			return err
+		}
+		if _, err := s.recordRevision(ctx, p, nil); err != nil {
+			return err
+		}
+		return s.enqueueEvent(ctx, types.EventPlaceCreated, types.AggregatePlace, p.ID, p)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -72,17 +150,73 @@ func (s *placeService) Get(ctx context.Context, id string) (*dto.PlaceResponse,
 		return nil, err
 	}
 
-	return dto.NewPlaceResponse(p), nil
+	resp := dto.NewPlaceResponse(p)
+	s.attachNextEvent(ctx, resp)
+	return resp, nil
 }
 
 // GetBySlug retrieves a place by slug
 func (s *placeService) GetBySlug(ctx context.Context, slug string) (*dto.PlaceResponse, error) {
 	p, err := s.PlaceRepo.GetBySlug(ctx, slug)
+	if ierr.IsNotFound(err) {
+		p, err = s.getByPreviousSlug(ctx, slug, err)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return dto.NewPlaceResponse(p), nil
+	resp := dto.NewPlaceResponse(p)
+	s.attachNextEvent(ctx, resp)
+	return resp, nil
+}
+
+// getByPreviousSlug finds the published place that gave up slug when it was re-slugged, so
+// old links keep working. notFound is returned when there is none.
+func (s *placeService) getByPreviousSlug(ctx context.Context, slug string, notFound error) (*place.Place, error) {
+	id, err := s.PlaceRepo.GetIDByPreviousSlug(ctx, slug)
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			return nil, notFound
+		}
+		return nil, err
+	}
+
+	p, err := s.PlaceRepo.Get(ctx, id)
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			return nil, notFound
+		}
+		return nil, err
+	}
+	if p.Status != types.StatusPublished {
+		return nil, notFound
+	}
+	return p, nil
+}
+
+// attachNextEvent populates the next scheduled event instance at the place.
+// Failures are logged and the response is returned without the event (graceful degradation).
+func (s *placeService) attachNextEvent(ctx context.Context, resp *dto.PlaceResponse) {
+	loc := loadEventTimezone()
+	now := s.now().In(loc)
+
+	events, err := s.EventRepo.ListActiveInWindow(ctx, &resp.ID, now, now.Add(nextEventLookahead))
+	if err != nil {
+		s.Logger.Errorw("failed to load next event for place",
+			"place_id", resp.ID,
+			"error", err)
+		return
+	}
+
+	for _, e := range events {
+		next := e.NextOccurrence(now, nextEventLookahead, loc)
+		if next == nil {
+			continue
+		}
+		if resp.NextEvent == nil || next.StartTime.Before(resp.NextEvent.StartTime) {
+			resp.NextEvent = next
+		}
+	}
 }
 
 // Update updates an existing place
@@ -90,39 +224,145 @@ func (s *placeService) Update(ctx context.Context, id string, req *dto.UpdatePla
 	if err := req.Validate(); err != nil {
 		return nil, err
 	}
+	if err := s.authorizeFields(ctx, req); err != nil {
+		return nil, err
+	}
 
-	p, err := s.PlaceRepo.Get(ctx, id)
-	if err != nil {
+	return s.applyUpdate(ctx, id, req.ApplyToPlace)
+}
+
+// Patch applies a JSON Merge Patch to an existing place
+func (s *placeService) Patch(ctx context.Context, id string, req *dto.PatchPlaceRequest) (*dto.PlaceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.authorizeFields(ctx, req); err != nil {
 		return nil, err
 	}
 
-	err = req.ApplyToPlace(ctx, p)
+	return s.applyUpdate(ctx, id, req.ApplyToPlace)
+}
+
+// getForWrite loads a place that is about to be changed. Admins also get archived places, so
+// an update or revert that sets a live status restores them; everyone else gets ErrGone.
+func (s *placeService) getForWrite(ctx context.Context, id string) (*place.Place, error) {
+	if isAdmin(ctx) {
+		return s.PlaceRepo.GetIncludingArchived(ctx, id)
+	}
+	return s.PlaceRepo.Get(ctx, id)
+}
+
+// applyUpdate loads a place, applies the change, persists it and records a revision in one transaction
+func (s *placeService) applyUpdate(ctx context.Context, id string, apply func(context.Context, *place.Place) error) (*dto.PlaceResponse, error) {
+	var updatedPlace *place.Place
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		p, err := s.getForWrite(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		err = apply(ctx, p)
+		if err != nil {
+			return err
+		}
+		s.sanitizeDescriptions(p)
+
+		err = s.PlaceRepo.Update(ctx, p)
+		if err != nil {
+			return err
+		}
+
+		// Fetch the updated place to get all fields. The update may have archived it, e.g.
+		// through its unpublish schedule, so archived places are read too.
+		updatedPlace, err = s.PlaceRepo.GetIncludingArchived(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if _, err := s.recordRevision(ctx, updatedPlace, nil); err != nil {
+			return err
+		}
+		return s.enqueueEvent(ctx, types.EventPlaceUpdated, types.AggregatePlace, updatedPlace.ID, updatedPlace)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.PlaceRepo.Update(ctx, p)
+	return dto.NewPlaceResponse(updatedPlace), nil
+}
+
+// PreviewUpdate validates an update and returns the changes it would make without persisting it
+func (s *placeService) PreviewUpdate(ctx context.Context, id string, req *dto.UpdatePlaceRequest) (*dto.PlaceDryRunResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.authorizeFields(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return s.previewUpdate(ctx, id, req.ApplyToPlace)
+}
+
+// PreviewPatch validates a merge patch and returns the changes it would make without persisting it
+func (s *placeService) PreviewPatch(ctx context.Context, id string, req *dto.PatchPlaceRequest) (*dto.PlaceDryRunResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.authorizeFields(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return s.previewUpdate(ctx, id, req.ApplyToPlace)
+}
+
+// previewUpdate applies the change to an in-memory copy of the place and diffs it against
+// the stored place. Nothing is written and no revision is recorded; database constraints
+// such as slug uniqueness are only checked when the update is applied for real.
+func (s *placeService) previewUpdate(ctx context.Context, id string, apply func(context.Context, *place.Place) error) (*dto.PlaceDryRunResponse, error) {
+	current, err := s.getForWrite(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	// Fetch the updated place to get all fields
-	updatedPlace, err := s.PlaceRepo.Get(ctx, id)
+	// Apply functions replace fields rather than mutating them, so a shallow copy suffices
+	updated := *current
+	if err := apply(ctx, &updated); err != nil {
+		return nil, err
+	}
+	s.sanitizeDescriptions(&updated)
+
+	changes, err := diff.Compare(current, &updated)
 	if err != nil {
 		return nil, err
 	}
 
-	return dto.NewPlaceResponse(updatedPlace), nil
+	return &dto.PlaceDryRunResponse{
+		PlaceID: id,
+		DryRun:  true,
+		Changes: changes,
+		Place:   dto.NewPlaceResponse(&updated),
+	}, nil
 }
 
 // Delete soft deletes a place
 func (s *placeService) Delete(ctx context.Context, id string) error {
-	p, err := s.PlaceRepo.Get(ctx, id)
-	if err != nil {
-		return err
-	}
+	return s.DB.WithTx(ctx, func(ctx context.Context) error {
+		p, err := s.PlaceRepo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.PlaceRepo.Delete(ctx, p); err != nil {
+			return err
+		}
 
-	return s.PlaceRepo.Delete(ctx, p)
+		// Record the archived state so the deletion can be reverted
+		p.Status = types.StatusArchived
+		if _, err := s.recordRevision(ctx, p, nil); err != nil {
+			return err
+		}
+		return s.enqueueEvent(ctx, types.EventPlaceDeleted, types.AggregatePlace, p.ID, p)
+	})
 }
 
 // List retrieves a paginated list of places
@@ -131,6 +371,19 @@ func (s *placeService) List(ctx context.Context, filter *types.PlaceFilter) (*dt
 		filter = types.NewPlaceFilter()
 	}
 
+	scopePlaceFilter(ctx, filter)
+	if err := checkContributorFilter(ctx, filter.ContributorFilter); err != nil {
+		return nil, err
+	}
+
+	if filter.IsRankedNearby() {
+		return s.listNearbyRanked(ctx, filter)
+	}
+
+	if filter.Collation == "" {
+		filter.Collation = s.Config.Places.TitleCollation
+	}
+
 	// Get places
 	places, err := s.PlaceRepo.List(ctx, filter)
 	if err != nil {
@@ -138,7 +391,7 @@ func (s *placeService) List(ctx context.Context, filter *types.PlaceFilter) (*dt
 	}
 
 	// Get total count
-	total, err := s.PlaceRepo.Count(ctx, filter)
+	total, estimated, err := s.countPlaces(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -147,29 +400,340 @@ func (s *placeService) List(ctx context.Context, filter *types.PlaceFilter) (*dt
 	limit := filter.GetLimit()
 	offset := filter.GetOffset()
 	response := dto.NewListPlacesResponse(places, total, limit, offset)
+	response.Pagination.TotalIsEstimate = estimated
 
 	return response, nil
 }
 
-// AddImage adds an image to a place
-func (s *placeService) AddImage(ctx context.Context, placeID string, req *dto.CreatePlaceImageRequest) (*dto.PlaceImageResponse, error) {
-	if err := req.Validate(); err != nil {
+// Extent computes the bounding box of the matching places in the database
+func (s *placeService) Extent(ctx context.Context, filter *types.PlaceFilter) (*dto.PlaceExtentResponse, error) {
+	if filter == nil {
+		filter = types.NewPlaceFilter()
+	}
+
+	scopePlaceFilter(ctx, filter)
+
+	sw, ne, err := s.PlaceRepo.BoundingBox(ctx, filter)
+	if err != nil {
 		return nil, err
 	}
+	return dto.NewPlaceExtentResponse(sw, ne), nil
+}
 
-	// Verify place exists
-	_, err := s.PlaceRepo.Get(ctx, placeID)
+// placeStreamBatchSize is the number of places read per query while streaming
+const placeStreamBatchSize = 500
+
+// Stream hands every place matching the filter to fn, one batch of places in memory at a time
+func (s *placeService) Stream(ctx context.Context, filter *types.PlaceFilter, fn func(*dto.PlaceResponse) error) error {
+	if filter == nil {
+		filter = types.NewPlaceFilter()
+	}
+
+	scopePlaceFilter(ctx, filter)
+
+	streamed := 0
+	err := s.PlaceRepo.Stream(ctx, filter, placeStreamBatchSize, func(p *place.Place) error {
+		if err := fn(dto.NewPlaceResponse(p)); err != nil {
+			return err
+		}
+		streamed++
+		return nil
+	})
+	switch {
+	case ctx.Err() != nil:
+		s.Logger.Infow("place stream stopped, client went away", "places_streamed", streamed)
+	case err != nil:
+		s.Logger.Errorw("place stream failed", "places_streamed", streamed, "error", err)
+	default:
+		s.Logger.Debugw("streamed places", "places_streamed", streamed)
+	}
+	return err
+}
+
+// ListByCategory lists the published places of a published category. A missing or deleted
+// category is not found; the filter's own sort, pagination and filters otherwise apply.
+func (s *placeService) ListByCategory(ctx context.Context, categorySlug string, filter *types.PlaceFilter) (*dto.CategoryPlacesResponse, error) {
+	cat, err := s.CategoryRepo.GetBySlug(ctx, categorySlug)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter == nil {
+		filter = types.NewPlaceFilter()
+	}
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewDefaultQueryFilter()
+	}
+	filter.Status = lo.ToPtr(types.StatusPublished)
+	filter.IncludeDeleted = false
+	filter.Categories = []string{cat.Slug}
+
+	places, err := s.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.CategoryPlacesResponse{
+		Category:           &dto.CategoryResponse{Category: cat},
+		ListPlacesResponse: places,
+	}, nil
+}
+
+// NearbyBatch finds the nearest places of every origin with one spatial query plus one
+// lookup of the distinct places found, instead of a nearby query per origin
+func (s *placeService) NearbyBatch(ctx context.Context, origins []types.Point, radiusKm float64, limitPer int) (*dto.NearbyBatchResponse, error) {
+	locations := lo.Map(origins, func(p types.Point, _ int) types.Location { return types.FromPoint(p) })
+
+	matches, err := s.PlaceRepo.NearbyBatch(ctx, locations, radiusKm*1000, limitPer)
+	if err != nil {
+		return nil, err
+	}
+
+	placeIDs := lo.Uniq(lo.Map(matches, func(m *place.NearbyMatch, _ int) string { return m.PlaceID }))
+	places, err := s.PlaceRepo.ListByIDs(ctx, placeIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := lo.KeyBy(places, func(p *place.Place) string { return p.ID })
+
+	results := make([]*dto.NearbyOriginResult, len(origins))
+	for i, origin := range origins {
+		results[i] = &dto.NearbyOriginResult{
+			OriginIndex: i,
+			Origin:      origin,
+			Places:      []*dto.PlaceResponse{},
+		}
+	}
+
+	// Matches arrive ordered by origin, then distance
+	for _, m := range matches {
+		p, ok := byID[m.PlaceID]
+		if !ok || m.OriginIndex < 0 || m.OriginIndex >= len(results) {
+			continue
+		}
+		resp := dto.NewPlaceResponse(p)
+		resp.DistanceM = lo.ToPtr(m.DistanceM)
+		results[m.OriginIndex].Places = append(results[m.OriginIndex].Places, resp)
+	}
+
+	return &dto.NearbyBatchResponse{Results: results}, nil
+}
+
+// Centroid computes the center of the given places in the database
+func (s *placeService) Centroid(ctx context.Context, req *dto.PlaceCentroidRequest) (*dto.PlaceCentroidResponse, error) {
+	centroid, err := s.PlaceRepo.Centroid(ctx, req.PlaceIDs)
 	if err != nil {
 		return nil, err
 	}
 
+	return &dto.PlaceCentroidResponse{
+		Centroid: centroid,
+		PlaceIDs: lo.Uniq(req.PlaceIDs),
+	}, nil
+}
+
+// AtomFeed returns the Atom feed of the published places updated within the configured window,
+// most recently updated first. Entries link to the configured site, or under apiBaseURL when
+// none is set.
+func (s *placeService) AtomFeed(ctx context.Context, feedURL, apiBaseURL string) (*dto.AtomFeed, error) {
+	filter := types.NewPlaceFilter()
+	filter.QueryFilter.Status = lo.ToPtr(types.StatusPublished)
+	filter.QueryFilter.Limit = lo.ToPtr(s.Config.Places.AtomFeedSize)
+	filter.QueryFilter.Sort = lo.ToPtr("updated_at")
+	filter.QueryFilter.Order = lo.ToPtr(types.OrderDesc)
+	now := s.now()
+	filter.UpdatedSince = lo.ToPtr(now.Add(-s.Config.Places.GetAtomFeedWindow()))
+
+	places, err := s.PlaceRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	linkFor := func(p *place.Place) string {
+		if site := s.Config.Places.SiteURL; site != "" {
+			return strings.TrimRight(site, "/") + "/places/" + url.PathEscape(p.Slug)
+		}
+		return strings.TrimRight(apiBaseURL, "/") + "/v1/places/slug/" + url.PathEscape(p.Slug)
+	}
+	return dto.NewPlaceAtomFeed(places, feedURL, linkFor, now), nil
+}
+
+// Heatmap returns the density of the published places in the requested box
+func (s *placeService) Heatmap(ctx context.Context, req *dto.PlaceHeatmapRequest) (*dto.PlaceHeatmapResponse, error) {
+	cells, err := s.PlaceRepo.Heatmap(ctx, req.Box(), req.CellSize, req.Weight)
+	if err != nil {
+		return nil, err
+	}
+	return dto.NewPlaceHeatmapResponse(req, cells), nil
+}
+
+// NearbySummary counts the published places within the requested radius by place type and
+// by category
+func (s *placeService) NearbySummary(ctx context.Context, req *dto.NearbySummaryRequest) (*dto.NearbySummaryResponse, error) {
+	summary, err := s.PlaceRepo.NearbyCategoryCounts(ctx, req.Center(), req.RadiusKm)
+	if err != nil {
+		return nil, err
+	}
+	return dto.NewNearbySummaryResponse(req, summary), nil
+}
+
+// Exists reports for each identifier, an ID or a slug, whether a place with it exists, looking
+// all of them up with a single query. Deleted places do not exist and are reported as deleted.
+func (s *placeService) Exists(ctx context.Context, req *dto.PlaceExistsRequest) (*dto.PlaceExistsResponse, error) {
+	places, err := s.PlaceRepo.ListByIdentifiers(ctx, req.Identifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make(map[string]types.Status, 2*len(places))
+	for _, p := range places {
+		statuses[p.ID] = p.Status
+		statuses[p.Slug] = p.Status
+	}
+
+	results := make(map[string]*dto.PlaceExistence, len(req.Identifiers))
+	for _, identifier := range req.Identifiers {
+		status, found := statuses[identifier]
+		results[identifier] = &dto.PlaceExistence{
+			Exists:  found && !status.IsSoftDeleted(),
+			Deleted: found && status.IsSoftDeleted(),
+		}
+	}
+
+	return &dto.PlaceExistsResponse{Results: results}, nil
+}
+
+// CheckGeofences finds the places whose geofence contains location with one spatial query,
+// then loads them in a single batch
+func (s *placeService) CheckGeofences(ctx context.Context, location types.Point) (*dto.GeofenceCheckResponse, error) {
+	matches, err := s.PlaceRepo.InsideGeofences(ctx, types.FromPoint(location))
+	if err != nil {
+		return nil, err
+	}
+
+	placeIDs := lo.Map(matches, func(m *place.GeofenceMatch, _ int) string { return m.PlaceID })
+	places, err := s.PlaceRepo.ListByIDs(ctx, placeIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := lo.KeyBy(places, func(p *place.Place) string { return p.ID })
+
+	resp := &dto.GeofenceCheckResponse{
+		Location: location,
+		Places:   make([]*dto.PlaceResponse, 0, len(matches)),
+	}
+	for _, m := range matches {
+		p, ok := byID[m.PlaceID]
+		if !ok {
+			continue
+		}
+		placeResp := dto.NewPlaceResponse(p)
+		placeResp.DistanceM = lo.ToPtr(m.DistanceM)
+		resp.Places = append(resp.Places, placeResp)
+	}
+
+	return resp, nil
+}
+
+// GetWeather looks up the current weather at the place. Weather is an enrichment: when the
+// provider is disabled, rate limited or failing, the place is returned without it.
+func (s *placeService) GetWeather(ctx context.Context, id string) (*dto.PlaceWeatherResponse, error) {
+	p, err := s.PlaceRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.PlaceWeatherResponse{Place: dto.NewPlaceResponse(p)}
+	if s.Weather == nil {
+		return resp, nil
+	}
+
+	conditions, err := s.Weather.Current(ctx, p.Location)
+	if err != nil {
+		s.Logger.Warnw("weather unavailable, returning place without it",
+			"place_id", id,
+			"error", err,
+		)
+		return resp, nil
+	}
+	resp.Weather = conditions
+
+	return resp, nil
+}
+
+// listNearbyRanked lists places in range ordered by the popularity-weighted nearby score
+func (s *placeService) listNearbyRanked(ctx context.Context, filter *types.PlaceFilter) (*dto.ListPlacesResponse, error) {
+	places, err := s.PlaceRepo.ListNearbyRanked(ctx, filter, s.Config.Places.GetNearbyRankWeights())
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.PlaceRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewRankedPlacesResponse(places, total, filter.GetLimit(), filter.GetOffset()), nil
+}
+
+// countPlaces returns the total for a listing. Full-catalog listings above the configured
+// threshold use the planner estimate to avoid a slow COUNT(*); filtered listings, small
+// catalogs and estimate failures fall back to an exact count.
+func (s *placeService) countPlaces(ctx context.Context, filter *types.PlaceFilter) (int, bool, error) {
+	threshold := s.Config.Places.EstimatedCountThreshold
+	if s.Config.Places.UseEstimatedCounts() && !filter.HasEntityFilters() && !filter.IncludeDeleted {
+		estimate, err := s.PlaceRepo.EstimateCount(ctx, filter)
+		if err != nil {
+			s.Logger.Warnw("failed to estimate place count, counting exactly", "error", err)
+		} else if estimate >= threshold {
+			return estimate, true, nil
+		}
+	}
+
+	total, err := s.PlaceRepo.Count(ctx, filter)
+	if err != nil {
+		return 0, false, err
+	}
+	return total, false, nil
+}
+
+// AddImage adds an image to a place
+func (s *placeService) AddImage(ctx context.Context, placeID string, req *dto.CreatePlaceImageRequest) (*dto.PlaceImageResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	image := req.ToPlaceImage(ctx, placeID)
+	image.ID = s.newID(types.UUID_PREFIX_PLACE_IMAGE)
+	s.stampCreated(&image.BaseModel)
+
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		// Verifies the place exists
+		active, err := s.activeImageIDs(ctx, placeID)
+		if err != nil {
+			return err
+		}
+		if limit := s.Config.Places.MaxImagesPerPlace; limit > 0 && len(active) >= limit {
+			return ierr.NewErrorf("place already has %d images, the limit is %d", len(active), limit).
+				WithHintf("A place can have at most %d images; delete one before adding another", limit).
+				WithReportableDetails(map[string]any{
+					"place_id": placeID,
+					"images":   len(active),
+					"limit":    limit,
+				}).
+				Mark(ierr.ErrValidation)
+		}
 
-	err = s.PlaceRepo.AddImage(ctx, image)
+		return s.PlaceRepo.AddImage(ctx, image)
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	if image.Alt == "" && s.Config.Vision.AltTextEnabled && s.Captioner != nil {
+		go s.generateAltText(context.WithoutCancel(ctx), image.ID, image.URL)
+	}
+
 	// Fetch the created image
 	images, err := s.PlaceRepo.GetImages(ctx, placeID)
 	if err != nil {
@@ -208,6 +772,16 @@ func (s *placeService) GetImages(ctx context.Context, placeID string) ([]*dto.Pl
 	return responses, nil
 }
 
+// GetImage retrieves a single place image
+func (s *placeService) GetImage(ctx context.Context, imageID string) (*dto.PlaceImageResponse, error) {
+	image, err := s.PlaceRepo.GetImage(ctx, imageID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PlaceImageResponse{PlaceImage: image}, nil
+}
+
 // UpdateImage updates an existing place image
 func (s *placeService) UpdateImage(ctx context.Context, imageID string, req *dto.UpdatePlaceImageRequest) (*dto.PlaceImageResponse, error) {
 	if err := req.Validate(); err != nil {
@@ -237,8 +811,149 @@ func (s *placeService) UpdateImage(ctx context.Context, imageID string, req *dto
 }
 
 // DeleteImage deletes a place image
-func (s *placeService) DeleteImage(ctx context.Context, imageID string) error {
-	return s.PlaceRepo.DeleteImage(ctx, imageID)
+func (s *placeService) DeleteImage(ctx context.Context, imageID string, force bool) error {
+	return s.DB.WithTx(ctx, func(ctx context.Context) error {
+		image, err := s.PlaceRepo.GetImage(ctx, imageID)
+		if err != nil {
+			return err
+		}
+
+		return s.withPrimaryImageGuard(ctx, image.PlaceID, []string{imageID}, force, func(ctx context.Context) error {
+			return s.PlaceRepo.DeleteImage(ctx, imageID)
+		})
+	})
+}
+
+// DeleteImages deletes several images of a place in one transaction. Every ID must be an
+// active image of the place; otherwise nothing is deleted. Remaining images are re-packed.
+func (s *placeService) DeleteImages(ctx context.Context, placeID string, imageIDs []string, force bool) error {
+	return s.DB.WithTx(ctx, func(ctx context.Context) error {
+		active, err := s.activeImageIDs(ctx, placeID)
+		if err != nil {
+			return err
+		}
+
+		foreign := lo.Filter(imageIDs, func(id string, _ int) bool {
+			return !lo.Contains(active, id)
+		})
+		if len(foreign) > 0 {
+			return ierr.NewError("images do not belong to the place").
+				WithHint("Every image ID must be an existing image of this place").
+				WithReportableDetails(map[string]any{
+					"place_id":  placeID,
+					"image_ids": foreign,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+
+		return s.withPrimaryImageGuard(ctx, placeID, imageIDs, force, func(ctx context.Context) error {
+			return s.deleteImages(ctx, placeID, imageIDs)
+		})
+	})
+}
+
+// DeleteAllImages clears the gallery of a place in one transaction
+func (s *placeService) DeleteAllImages(ctx context.Context, placeID string, force bool) error {
+	return s.DB.WithTx(ctx, func(ctx context.Context) error {
+		active, err := s.activeImageIDs(ctx, placeID)
+		if err != nil {
+			return err
+		}
+		if len(active) == 0 {
+			return nil
+		}
+
+		return s.withPrimaryImageGuard(ctx, placeID, active, force, func(ctx context.Context) error {
+			return s.deleteImages(ctx, placeID, active)
+		})
+	})
+}
+
+// withPrimaryImageGuard runs del, which deletes the given images of a place, and keeps the
+// place's primary_image_url from pointing at a deleted image as places.primary_image_delete
+// says. It must run inside the transaction of the delete.
+func (s *placeService) withPrimaryImageGuard(ctx context.Context, placeID string, imageIDs []string, force bool, del func(ctx context.Context) error) error {
+	p, err := s.PlaceRepo.Get(ctx, placeID)
+	if err != nil {
+		return err
+	}
+	images, err := s.PlaceRepo.GetImages(ctx, placeID)
+	if err != nil {
+		return err
+	}
+
+	// Images stay in GetImages order, i.e. by position
+	remaining := lo.Filter(images, func(img *place.PlaceImage, _ int) bool {
+		return !lo.Contains(imageIDs, img.ID) &&
+			img.Status != types.StatusArchived && img.Status != types.StatusDeleted
+	})
+
+	// The primary image survives when it is not a gallery image or another image has its URL
+	primary := lo.FromPtr(p.PrimaryImageURL)
+	deletesPrimary := primary != "" &&
+		lo.ContainsBy(images, func(img *place.PlaceImage) bool {
+			return img.URL == primary && lo.Contains(imageIDs, img.ID)
+		}) &&
+		!lo.ContainsBy(remaining, func(img *place.PlaceImage) bool {
+			return img.URL == primary
+		})
+	if !deletesPrimary {
+		return del(ctx)
+	}
+
+	policy := s.Config.Places.PrimaryImageDelete
+	if policy == types.PrimaryImageDeleteReject && !force {
+		return ierr.NewError("cannot delete the primary image of the place").
+			WithHint("Set another primary image first, or retry with force=true to delete it and use the next image").
+			WithReportableDetails(map[string]any{
+				"place_id":          placeID,
+				"primary_image_url": primary,
+			}).
+			Mark(ierr.ErrConflict)
+	}
+
+	if err := del(ctx); err != nil {
+		return err
+	}
+
+	var next *string
+	if policy != types.PrimaryImageDeleteClear && len(remaining) > 0 {
+		next = lo.ToPtr(remaining[0].URL)
+	}
+
+	s.Logger.Infow("replacing deleted primary image of place",
+		"place_id", placeID,
+		"policy", policy,
+		"primary_image_url", lo.FromPtr(next))
+
+	return s.PlaceRepo.SetPrimaryImageURL(ctx, placeID, next)
+}
+
+// activeImageIDs verifies the place exists and returns the IDs of its non-archived images
+func (s *placeService) activeImageIDs(ctx context.Context, placeID string) ([]string, error) {
+	if _, err := s.PlaceRepo.Get(ctx, placeID); err != nil {
+		return nil, err
+	}
+
+	images, err := s.PlaceRepo.GetImages(ctx, placeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.FilterMap(images, func(img *place.PlaceImage, _ int) (string, bool) {
+		return img.ID, img.Status != types.StatusArchived && img.Status != types.StatusDeleted
+	}), nil
+}
+
+func (s *placeService) deleteImages(ctx context.Context, placeID string, imageIDs []string) error {
+	count, err := s.PlaceRepo.DeleteImages(ctx, placeID, imageIDs)
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Infow("deleted place images", "place_id", placeID, "count", count)
+
+	return s.PlaceRepo.RepackImagePositions(ctx, placeID)
 }
 
 // GetFeed retrieves feed data for multiple sections
@@ -271,13 +986,24 @@ func (s *placeService) processSectionRequest(ctx context.Context, sectionReq dto
 	// Create base filter from request
 	filter := sectionReq.ToPlaceFilter(globalReq)
 
+	// Default first pages of the homepage sections are served from the precomputed listings
+	if places, total, ok := s.listFromFeedListings(ctx, sectionReq.Type, filter); ok {
+		return dto.NewFeedSectionResponseFromDomain(
+			sectionReq.Type,
+			places,
+			total,
+			filter.GetLimit(),
+			filter.GetOffset(),
+		), nil
+	}
+
 	// Apply section-specific filter modifications
 	switch sectionReq.Type {
 	case types.SectionTypeLatest:
 		// Latest uses default sorting (by created_at desc)
 	case types.SectionTypeTrending:
 		// Add trending-specific time filter (48 hours lookback for last viewed)
-		cutoffTime := time.Now().UTC().Add(-48 * time.Hour)
+		cutoffTime := s.now().Add(-48 * time.Hour)
 		filter.LastViewedAfter = &cutoffTime
 	case types.SectionTypePopular:
 		// Popular uses default sorting (by popularity_score desc)
@@ -309,6 +1035,71 @@ func (s *placeService) processSectionRequest(ctx context.Context, sectionReq dto
 	), nil
 }
 
+// feedListingSorts is the ordering each precomputed feed section was ranked by
+var feedListingSorts = map[types.FeedSectionType]string{
+	types.SectionTypeLatest:   "created_at",
+	types.SectionTypePopular:  "popularity_score",
+	types.SectionTypeTrending: "popularity_score",
+}
+
+// listFromFeedListings reads a feed section page from the precomputed listings when the
+// request matches how they were built and they are fresh enough. It reports false when
+// the caller should run the live query instead.
+func (s *placeService) listFromFeedListings(ctx context.Context, section types.FeedSectionType, filter *types.PlaceFilter) ([]*place.Place, int, bool) {
+	maxStaleness := s.Config.Places.GetFeedListingsMaxStaleness()
+	sort, ok := feedListingSorts[section]
+	if !ok || maxStaleness == 0 {
+		return nil, 0, false
+	}
+
+	if filter.HasEntityFilters() ||
+		filter.GetStatus() != "" ||
+		filter.GetSort() != sort ||
+		filter.GetOrder() != types.OrderDesc ||
+		filter.GetExpand().Has("images") ||
+		filter.IsUnlimited() ||
+		filter.GetOffset()+filter.GetLimit() > postgres.PlaceFeedListingsDepth {
+		return nil, 0, false
+	}
+
+	page, err := s.PlaceRepo.GetFeedListingPage(ctx, section, filter.GetLimit(), filter.GetOffset())
+	if err != nil {
+		s.Logger.Warnw("failed to read feed listings, using live query",
+			"section_type", section,
+			"error", err)
+		return nil, 0, false
+	}
+
+	if age := time.Since(page.RefreshedAt); age > maxStaleness {
+		s.Logger.Warnw("feed listings are stale, using live query",
+			"section_type", section,
+			"refreshed_at", page.RefreshedAt,
+			"age", age.String())
+		return nil, 0, false
+	}
+
+	places, err := s.PlaceRepo.ListByIDs(ctx, page.PlaceIDs)
+	if err != nil {
+		s.Logger.Warnw("failed to load places from feed listings, using live query",
+			"section_type", section,
+			"error", err)
+		return nil, 0, false
+	}
+
+	return places, page.Total, true
+}
+
+// RefreshFeedListings rebuilds the precomputed feed listings
+func (s *placeService) RefreshFeedListings(ctx context.Context) error {
+	start := time.Now()
+	if err := s.PlaceRepo.RefreshFeedListings(ctx); err != nil {
+		return err
+	}
+
+	s.Logger.Infow("refreshed feed listings", "duration", time.Since(start).String())
+	return nil
+}
+
 // IncrementViewCount increments the view count for a place
 func (s *placeService) IncrementViewCount(ctx context.Context, placeID string) error {
 	// Verify place exists
@@ -352,16 +1143,32 @@ func (s *placeService) AssignCategories(ctx context.Context, placeID string, req
 		return err
 	}
 
-	// Verify place exists
-	_, err := s.PlaceRepo.Get(ctx, placeID)
-	if err != nil {
-		return err
+	if limit := s.Config.Places.MaxCategoriesPerPlace; limit > 0 && len(req.CategoryIDs) > limit {
+		return ierr.NewErrorf("%d categories exceed the limit of %d per place", len(req.CategoryIDs), limit).
+			WithHintf("A place can have at most %d categories", limit).
+			WithReportableDetails(map[string]any{
+				"place_id":   placeID,
+				"categories": len(req.CategoryIDs),
+				"limit":      limit,
+			}).
+			Mark(ierr.ErrValidation)
 	}
 
-	err = s.PlaceRepo.AssignCategories(ctx, placeID, req.CategoryIDs)
-	if err != nil {
-		return err
-	}
+	return s.DB.WithTx(ctx, func(ctx context.Context) error {
+		// Verify place exists
+		p, err := s.PlaceRepo.Get(ctx, placeID)
+		if err != nil {
+			return err
+		}
 
-	return nil
+		err = s.PlaceRepo.AssignCategories(ctx, placeID, req.CategoryIDs)
+		if err != nil {
+			return err
+		}
+
+		if _, err := s.recordRevision(ctx, p, nil); err != nil {
+			return err
+		}
+		return s.enqueueEvent(ctx, types.EventPlaceUpdated, types.AggregatePlace, p.ID, p)
+	})
 }
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// generateAltText captions the image at url and stores the caption as the alt text of the
+// image, marked as machine-generated. It runs after the image was added, so the image is
+// reloaded and left alone if it has gone or an editor has given it alt text in the meantime.
+// Failures are only logged: the image simply keeps its empty alt text.
+func (s *placeService) generateAltText(ctx context.Context, imageID, url string) {
+	ctx, cancel := context.WithTimeout(ctx, s.Config.Vision.GetTimeout())
+	defer cancel()
+
+	caption, err := s.Captioner.Caption(ctx, url)
+	if err != nil {
+		s.Logger.Warnw("failed to caption place image", "image_id", imageID, "error", err)
+		return
+	}
+	caption = strings.TrimSpace(caption)
+	if caption == "" {
+		return
+	}
+
+	image, err := s.PlaceRepo.GetImage(ctx, imageID)
+	if err != nil {
+		s.Logger.Warnw("failed to load captioned place image", "image_id", imageID, "error", err)
+		return
+	}
+	if image.Alt != "" || image.URL != url {
+		return
+	}
+
+	metadata := types.Metadata{}
+	if image.Metadata != nil {
+		metadata = *image.Metadata
+	}
+	metadata[place.ImageMetadataAltSource] = place.AltSourceMachine
+	image.Alt = caption
+	image.Metadata = &metadata
+
+	if err := s.PlaceRepo.UpdateImage(ctx, image); err != nil {
+		s.Logger.Warnw("failed to store generated alt text", "image_id", imageID, "error", err)
+		return
+	}
+	s.Logger.Infow("generated alt text for place image", "image_id", imageID, "place_id", image.PlaceID)
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// fakeCaptioner returns caption, or err when set, and records the URLs it was asked about
+type fakeCaptioner struct {
+	caption string
+	err     error
+	urls    []string
+}
+
+func (c *fakeCaptioner) Caption(_ context.Context, url string) (string, error) {
+	c.urls = append(c.urls, url)
+	return c.caption, c.err
+}
+
+func TestGenerateAltText(t *testing.T) {
+	const url = "https://cdn.example.com/ramkund.jpg"
+
+	tests := []struct {
+		name         string
+		image        *place.PlaceImage
+		captioner    *fakeCaptioner
+		wantAlt      string
+		wantMetadata types.Metadata
+	}{
+		{
+			name:         "stores the caption",
+			image:        &place.PlaceImage{ID: "img_1", URL: url},
+			captioner:    &fakeCaptioner{caption: "  Pilgrims bathing at the Ramkund ghat  "},
+			wantAlt:      "Pilgrims bathing at the Ramkund ghat",
+			wantMetadata: types.Metadata{place.ImageMetadataAltSource: place.AltSourceMachine},
+		},
+		{
+			name:         "keeps other metadata",
+			image:        &place.PlaceImage{ID: "img_1", URL: url, Metadata: &types.Metadata{"credit": "MTDC"}},
+			captioner:    &fakeCaptioner{caption: "Ramkund ghat"},
+			wantAlt:      "Ramkund ghat",
+			wantMetadata: types.Metadata{"credit": "MTDC", place.ImageMetadataAltSource: place.AltSourceMachine},
+		},
+		{
+			name:      "captioner failure leaves the alt text empty",
+			image:     &place.PlaceImage{ID: "img_1", URL: url},
+			captioner: &fakeCaptioner{err: errors.New("captioning unavailable")},
+		},
+		{
+			name:      "blank caption is dropped",
+			image:     &place.PlaceImage{ID: "img_1", URL: url},
+			captioner: &fakeCaptioner{caption: " \n"},
+		},
+		{
+			name:      "alt text written meanwhile is kept",
+			image:     &place.PlaceImage{ID: "img_1", URL: url, Alt: "Ramkund at dawn"},
+			captioner: &fakeCaptioner{caption: "Ramkund ghat"},
+			wantAlt:   "Ramkund at dawn",
+		},
+		{
+			name:      "replaced image is left alone",
+			image:     &place.PlaceImage{ID: "img_1", URL: "https://cdn.example.com/ramkund-2.jpg"},
+			captioner: &fakeCaptioner{caption: "Ramkund ghat"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakePlaceRepo()
+			repo.images[tt.image.ID] = tt.image
+			params := newTestParams(repo)
+			params.Captioner = tt.captioner
+			s := NewPlaceService(params).(*placeService)
+
+			s.generateAltText(context.Background(), tt.image.ID, url)
+
+			if len(tt.captioner.urls) != 1 || tt.captioner.urls[0] != url {
+				t.Errorf("captioned %v, want [%s]", tt.captioner.urls, url)
+			}
+			got := repo.images[tt.image.ID]
+			if got.Alt != tt.wantAlt {
+				t.Errorf("Alt = %q, want %q", got.Alt, tt.wantAlt)
+			}
+			if tt.wantMetadata == nil {
+				if got.Metadata != nil && (*got.Metadata)[place.ImageMetadataAltSource] != "" {
+					t.Errorf("Metadata = %v, want no alt source", *got.Metadata)
+				}
+				return
+			}
+			if got.Metadata == nil || len(*got.Metadata) != len(tt.wantMetadata) {
+				t.Fatalf("Metadata = %v, want %v", got.Metadata, tt.wantMetadata)
+			}
+			for k, v := range tt.wantMetadata {
+				if (*got.Metadata)[k] != v {
+					t.Errorf("Metadata[%s] = %q, want %q", k, (*got.Metadata)[k], v)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+
+	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+)
+
+// Clone copies a place under a new ID and slug, applying the request's overrides. Content,
+// categories and optionally images are copied; engagement (views, ratings) and reviews are not.
+func (s *placeService) Clone(ctx context.Context, id string, req *dto.ClonePlaceRequest) (*dto.PlaceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.authorizeFields(ctx, req); err != nil {
+		return nil, err
+	}
+
+	var clone *place.Place
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		src, err := s.PlaceRepo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		clone = s.newPlaceCopy(ctx, src)
+		if err := req.ApplyToClone(ctx, clone); err != nil {
+			return err
+		}
+		s.sanitizeDescriptions(clone)
+
+		if err := s.PlaceRepo.Create(ctx, clone); err != nil {
+			return err
+		}
+
+		categoryIDs, err := s.PlaceRepo.GetCategoryIDs(ctx, src.ID)
+		if err != nil {
+			return err
+		}
+		if len(categoryIDs) > 0 {
+			if err := s.PlaceRepo.AssignCategories(ctx, clone.ID, categoryIDs); err != nil {
+				return err
+			}
+		}
+
+		if req.IncludeImages {
+			if err := s.cloneImages(ctx, src, clone); err != nil {
+				return err
+			}
+		}
+
+		if _, err := s.recordRevision(ctx, clone, nil); err != nil {
+			return err
+		}
+		return s.enqueueEvent(ctx, types.EventPlaceCreated, types.AggregatePlace, clone.ID, clone)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("cloned place", "source_id", id, "place_id", clone.ID)
+
+	return dto.NewPlaceResponse(clone), nil
+}
+
+// newPlaceCopy copies the content fields of src into a new place with fresh audit fields
+// and zeroed engagement counters
+func (s *placeService) newPlaceCopy(ctx context.Context, src *place.Place) *place.Place {
+	return &place.Place{
+		ID:               s.newID(types.UUID_PREFIX_PLACE),
+		Slug:             src.Slug,
+		Title:            src.Title,
+		Subtitle:         src.Subtitle,
+		ShortDescription: src.ShortDescription,
+		LongDescription:  src.LongDescription,
+		PlaceType:        src.PlaceType,
+		Address:          lo.Assign(src.Address),
+		Location:         src.Location,
+		PrimaryImageURL:  src.PrimaryImageURL,
+		ThumbnailURL:     src.ThumbnailURL,
+		PriceInfo:        src.PriceInfo,
+		Contact:          src.Contact,
+		Accessibility:    src.Accessibility,
+		GeofenceRadiusM:  src.GeofenceRadiusM,
+		BaseModel:        s.newBaseModel(ctx),
+	}
+}
+
+// cloneImages copies the active images of src to clone, preserving their order
+func (s *placeService) cloneImages(ctx context.Context, src, clone *place.Place) error {
+	for _, img := range src.Images {
+		if img.Status == types.StatusArchived || img.Status == types.StatusDeleted {
+			continue
+		}
+
+		copied := &place.PlaceImage{
+			ID:        s.newID(types.UUID_PREFIX_PLACE_IMAGE),
+			PlaceID:   clone.ID,
+			URL:       img.URL,
+			Alt:       img.Alt,
+			Pos:       img.Pos,
+			Metadata:  img.Metadata,
+			BaseModel: s.newBaseModel(ctx),
+		}
+		if err := s.PlaceRepo.AddImage(ctx, copied); err != nil {
+			return err
+		}
+		clone.Images = append(clone.Images, copied)
+	}
+
+	return s.PlaceRepo.RepackImagePositions(ctx, clone.ID)
+}
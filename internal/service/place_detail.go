@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	eventdomain "github.com/omkar273/nashikdarshan/internal/domain/event"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// detailSectionTimeout bounds each related-data fetch of a place detail, so one slow
+	// section is degraded rather than holding up the whole response
+	detailSectionTimeout = 2 * time.Second
+	// detailEventsWindow and detailEventsLimit select the upcoming event instances shown
+	detailEventsWindow = 30 * 24 * time.Hour
+	detailEventsLimit  = 10
+	// detailSimilarLimit and detailSimilarRadiusM select the similar places shown: published
+	// places of the same type, nearest first
+	detailSimilarLimit   = 6
+	detailSimilarRadiusM = 10000
+)
+
+// GetDetail returns the place with its images, review summary, upcoming event instances and
+// similar places. The place itself must load; the related sections are fetched concurrently,
+// each under its own timeout, and a section that fails is logged, returned empty and listed
+// in degraded.
+func (s *placeService) GetDetail(ctx context.Context, id string) (*dto.PlaceDetailResponse, error) {
+	p, err := s.PlaceRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &dto.PlaceDetailResponse{
+		PlaceResponse:  dto.NewPlaceResponse(p),
+		UpcomingEvents: []*dto.ExpandedOccurrenceResponse{},
+		Similar:        []*dto.PlaceStub{},
+	}
+
+	var (
+		mu       sync.Mutex
+		degraded []string
+	)
+	section := func(name string, fetch func(ctx context.Context) error) func() error {
+		return func() error {
+			ctx, cancel := context.WithTimeout(ctx, detailSectionTimeout)
+			defer cancel()
+
+			if err := fetch(ctx); err != nil {
+				s.Logger.Warnw("degraded place detail section",
+					"place_id", p.ID,
+					"section", name,
+					"error", err,
+				)
+				mu.Lock()
+				degraded = append(degraded, name)
+				mu.Unlock()
+			}
+			// Sections degrade independently, so none fails the group
+			return nil
+		}
+	}
+
+	var (
+		summary   *dto.RatingStatsResponse
+		instances []*eventdomain.ExpandedOccurrence
+		similar   []*place.Place
+	)
+	var g errgroup.Group
+	g.Go(section(dto.PlaceDetailSectionReviewSummary, func(ctx context.Context) error {
+		stats, err := s.ReviewRepo.GetRatingStats(ctx, types.EntityTypePlace, p.ID)
+		if err != nil {
+			return err
+		}
+		summary = dto.NewRatingStatsResponse(stats)
+		return nil
+	}))
+	g.Go(section(dto.PlaceDetailSectionUpcomingEvents, func(ctx context.Context) error {
+		upcoming, err := s.upcomingAt(ctx, p.ID)
+		instances = upcoming
+		return err
+	}))
+	g.Go(section(dto.PlaceDetailSectionSimilar, func(ctx context.Context) error {
+		places, err := s.similarPlaces(ctx, p)
+		similar = places
+		return err
+	}))
+	_ = g.Wait()
+
+	detail.ReviewSummary = summary
+	if len(instances) > 0 {
+		detail.NextEvent = instances[0]
+		detail.UpcomingEvents = lo.Map(instances, func(instance *eventdomain.ExpandedOccurrence, _ int) *dto.ExpandedOccurrenceResponse {
+			return dto.NewExpandedOccurrenceResponse(instance)
+		})
+	}
+	detail.Similar = dto.NewPlaceStubs(similar)
+	if len(degraded) > 0 {
+		sort.Strings(degraded)
+		detail.Degraded = degraded
+	}
+
+	return detail, nil
+}
+
+// upcomingAt returns the first event instances at the place within detailEventsWindow,
+// ordered by start time
+func (s *placeService) upcomingAt(ctx context.Context, placeID string) ([]*eventdomain.ExpandedOccurrence, error) {
+	loc := loadEventTimezone()
+	from := s.now().In(loc)
+	to := from.Add(detailEventsWindow)
+
+	events, err := s.EventRepo.ListActiveInWindow(ctx, &placeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	instances := make([]*eventdomain.ExpandedOccurrence, 0)
+	for _, e := range events {
+		instances = append(instances, e.ExpandOccurrences(from, to, loc)...)
+	}
+	sort.SliceStable(instances, func(i, j int) bool {
+		return instances[i].StartTime.Before(instances[j].StartTime)
+	})
+
+	if len(instances) > detailEventsLimit {
+		instances = instances[:detailEventsLimit]
+	}
+	return instances, nil
+}
+
+// similarPlaces returns the nearest published places of the same type as p, excluding p
+func (s *placeService) similarPlaces(ctx context.Context, p *place.Place) ([]*place.Place, error) {
+	filter := types.NewPlaceFilter()
+	filter.Limit = lo.ToPtr(detailSimilarLimit + 1)
+	filter.Status = lo.ToPtr(types.StatusPublished)
+	filter.PlaceTypes = []string{string(p.PlaceType)}
+	filter.Latitude = lo.ToPtr(p.Location.Latitude)
+	filter.Longitude = lo.ToPtr(p.Location.Longitude)
+	filter.RadiusM = lo.ToPtr(decimal.NewFromInt(detailSimilarRadiusM))
+
+	places, err := s.PlaceRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	similar := lo.Filter(places, func(other *place.Place, _ int) bool {
+		return other.ID != p.ID
+	})
+	if len(similar) > detailSimilarLimit {
+		similar = similar[:detailSimilarLimit]
+	}
+	return similar, nil
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// adminFieldsRequest is a place write that may carry admin-only fields (see types.AdminPlaceFields)
+type adminFieldsRequest interface {
+	AdminFields() []string
+	StripAdminFields()
+}
+
+// authorizeFields lets admins set every field of a place write. Anyone else supplying an
+// admin-only field is refused with ErrPermissionDenied naming the fields or, with
+// places.ignore_admin_fields, has them dropped from the request.
+func (s *placeService) authorizeFields(ctx context.Context, req adminFieldsRequest) error {
+	if isAdmin(ctx) {
+		return nil
+	}
+
+	fields := req.AdminFields()
+	if len(fields) == 0 {
+		return nil
+	}
+
+	if s.Config.Places.IgnoreAdminFields {
+		s.Logger.Debugw("dropping admin-only place fields", "fields", fields)
+		req.StripAdminFields()
+		return nil
+	}
+
+	return ierr.NewErrorf("only admins may set %s", strings.Join(fields, ", ")).
+		WithHintf("Only admins may change these fields: %s", strings.Join(fields, ", ")).
+		WithReportableDetails(map[string]any{
+			"fields":         fields,
+			"required_scope": types.ScopeAdmin,
+		}).
+		Mark(ierr.ErrPermissionDenied)
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+)
+
+func TestUpdateAdminFields(t *testing.T) {
+	editor := []string{string(types.ScopePlacesWrite)}
+	admin := []string{string(types.ScopeAdmin)}
+
+	// Places have no featured flag; status and the publishing schedule are the admin-only fields
+	tests := []struct {
+		name       string
+		scopes     []string
+		ignore     bool
+		req        dto.UpdatePlaceRequest
+		wantDenied []string
+		wantTitle  string
+		wantStatus types.Status
+	}{
+		{
+			name:       "editor sets status",
+			scopes:     editor,
+			req:        dto.UpdatePlaceRequest{Status: lo.ToPtr(types.StatusDraft)},
+			wantDenied: []string{types.PlaceRequestFieldStatus},
+		},
+		{
+			name:       "editor sets the schedule",
+			scopes:     editor,
+			req:        dto.UpdatePlaceRequest{Title: lo.ToPtr("Ramkund Ghat"), UnpublishAt: lo.ToPtr(testNow.Add(24 * time.Hour))},
+			wantDenied: []string{types.PlaceRequestFieldUnpublishAt},
+		},
+		{
+			name:       "admin sets status",
+			scopes:     admin,
+			req:        dto.UpdatePlaceRequest{Status: lo.ToPtr(types.StatusDraft)},
+			wantTitle:  "Ramkund",
+			wantStatus: types.StatusDraft,
+		},
+		{
+			name:       "editor changes content",
+			scopes:     editor,
+			req:        dto.UpdatePlaceRequest{Title: lo.ToPtr("Ramkund Ghat")},
+			wantTitle:  "Ramkund Ghat",
+			wantStatus: types.StatusPublished,
+		},
+		{
+			name:       "editor's admin fields ignored",
+			scopes:     editor,
+			ignore:     true,
+			req:        dto.UpdatePlaceRequest{Title: lo.ToPtr("Ramkund Ghat"), Status: lo.ToPtr(types.StatusDraft)},
+			wantTitle:  "Ramkund Ghat",
+			wantStatus: types.StatusPublished,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakePlaceRepo(&place.Place{ID: "plc_1", Slug: "ramkund", Title: "Ramkund", PlaceType: types.PlaceTypeTemple, BaseModel: types.BaseModel{Status: types.StatusPublished}})
+			s := newTestPlaceService(repo)
+			s.Config.Places.IgnoreAdminFields = tt.ignore
+			ctx := context.WithValue(context.Background(), types.CtxScopes, tt.scopes)
+
+			_, err := s.Update(ctx, "plc_1", &tt.req)
+			if tt.wantDenied != nil {
+				if !ierr.IsPermissionDenied(err) {
+					t.Fatalf("Update() error = %v, want permission denied", err)
+				}
+				for _, field := range tt.wantDenied {
+					if !strings.Contains(err.Error(), field) {
+						t.Errorf("Update() error = %v, want it to name %s", err, field)
+					}
+				}
+				if stored := repo.places["plc_1"]; stored.Title != "Ramkund" || stored.Status != types.StatusPublished {
+					t.Errorf("place changed to %q, %s despite the error", stored.Title, stored.Status)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+			stored := repo.places["plc_1"]
+			if stored.Title != tt.wantTitle || stored.Status != tt.wantStatus {
+				t.Errorf("place = %q, %s, want %q, %s", stored.Title, stored.Status, tt.wantTitle, tt.wantStatus)
+			}
+		})
+	}
+}
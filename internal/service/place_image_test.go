@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+)
+
+func TestWithPrimaryImageGuard(t *testing.T) {
+	const (
+		front = "https://cdn.example.com/kalaram-front.jpg"
+		hall  = "https://cdn.example.com/kalaram-hall.jpg"
+		night = "https://cdn.example.com/kalaram-night.jpg"
+	)
+
+	tests := []struct {
+		name    string
+		policy  types.PrimaryImageDeletePolicy
+		primary *string
+		// deleted are the ids of the images being deleted
+		deleted     []string
+		force       bool
+		wantPrimary *string
+		wantDeleted bool
+		wantErr     bool
+	}{
+		{name: "repoint to the next image", policy: types.PrimaryImageDeleteRepoint, primary: lo.ToPtr(front), deleted: []string{"img_front"}, wantPrimary: lo.ToPtr(hall), wantDeleted: true},
+		{name: "repoint skips archived images", policy: types.PrimaryImageDeleteRepoint, primary: lo.ToPtr(front), deleted: []string{"img_front", "img_hall"}, wantPrimary: nil, wantDeleted: true},
+		{name: "clear", policy: types.PrimaryImageDeleteClear, primary: lo.ToPtr(front), deleted: []string{"img_front"}, wantPrimary: nil, wantDeleted: true},
+		{name: "reject", policy: types.PrimaryImageDeleteReject, primary: lo.ToPtr(front), deleted: []string{"img_front"}, wantPrimary: lo.ToPtr(front), wantErr: true},
+		{name: "reject forced repoints", policy: types.PrimaryImageDeleteReject, primary: lo.ToPtr(front), deleted: []string{"img_front"}, force: true, wantPrimary: lo.ToPtr(hall), wantDeleted: true},
+		{name: "other image deleted", policy: types.PrimaryImageDeleteReject, primary: lo.ToPtr(front), deleted: []string{"img_hall"}, wantPrimary: lo.ToPtr(front), wantDeleted: true},
+		{name: "primary not in the gallery", policy: types.PrimaryImageDeleteReject, primary: lo.ToPtr("https://cdn.example.com/cover.jpg"), deleted: []string{"img_front"}, wantPrimary: lo.ToPtr("https://cdn.example.com/cover.jpg"), wantDeleted: true},
+		{name: "no primary image", policy: types.PrimaryImageDeleteReject, deleted: []string{"img_front"}, wantDeleted: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakePlaceRepo(&place.Place{ID: "place_1", PrimaryImageURL: tt.primary, BaseModel: types.BaseModel{Status: types.StatusPublished}})
+			for _, img := range []*place.PlaceImage{
+				{ID: "img_front", PlaceID: "place_1", URL: front, Pos: 0},
+				{ID: "img_hall", PlaceID: "place_1", URL: hall, Pos: 1},
+				{ID: "img_night", PlaceID: "place_1", URL: night, Pos: 2, BaseModel: types.BaseModel{Status: types.StatusArchived}},
+			} {
+				repo.images[img.ID] = img
+			}
+			s := newTestPlaceService(repo)
+			s.Config.Places.PrimaryImageDelete = tt.policy
+
+			deleted := false
+			err := s.withPrimaryImageGuard(context.Background(), "place_1", tt.deleted, tt.force, func(context.Context) error {
+				deleted = true
+				return nil
+			})
+			if tt.wantErr {
+				if !ierr.IsConflict(err) {
+					t.Fatalf("withPrimaryImageGuard() error = %v, want conflict", err)
+				}
+			} else if err != nil {
+				t.Fatalf("withPrimaryImageGuard() error = %v", err)
+			}
+
+			if deleted != tt.wantDeleted {
+				t.Errorf("deleted = %v, want %v", deleted, tt.wantDeleted)
+			}
+			if got := repo.places["place_1"].PrimaryImageURL; lo.FromPtr(got) != lo.FromPtr(tt.wantPrimary) {
+				t.Errorf("primary image = %q, want %q", lo.FromPtr(got), lo.FromPtr(tt.wantPrimary))
+			}
+		})
+	}
+}
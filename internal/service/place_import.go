@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/osm"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// ExternalRefOSM is the external_refs key of places imported from OpenStreetMap
+const ExternalRefOSM = "osm"
+
+// osmCoordinatePlaces is the precision of OpenStreetMap coordinates (about 1 cm)
+const osmCoordinatePlaces = 7
+
+// PlaceImportService bootstraps the catalog from external datasets
+type PlaceImportService interface {
+	// ImportOSM upserts the places selected by an Overpass query, matching them to existing
+	// places by their OpenStreetMap reference
+	ImportOSM(ctx context.Context, req *dto.OSMImportRequest) (*dto.OSMImportReport, error)
+}
+
+type placeImportService struct {
+	*placeService
+	overpass *osm.Client
+
+	// running admits one import at a time; lastStart spaces imports out per the Overpass policy
+	running   sync.Mutex
+	mu        sync.Mutex
+	lastStart time.Time
+}
+
+// NewPlaceImportService creates a new place import service
+func NewPlaceImportService(params ServiceParams) PlaceImportService {
+	cfg := params.Config.OSMImport
+	return &placeImportService{
+		placeService: NewPlaceService(params).(*placeService),
+		overpass:     osm.NewClient(cfg.OverpassURL, cfg.GetTimeout(), cfg.GetMaxRetries(), params.Logger),
+	}
+}
+
+// ImportOSM runs the import. Imported places are created as drafts for editors to review and
+// publish; later runs refresh the title, location and address of places still in the catalog
+// and leave places that editors archived or deleted alone. Each place is written in its own
+// transaction, so a failed run keeps what it wrote and a rerun picks up the rest.
+func (s *placeImportService) ImportOSM(ctx context.Context, req *dto.OSMImportRequest) (*dto.OSMImportReport, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !s.running.TryLock() {
+		return nil, ierr.NewError("an OpenStreetMap import is already running").
+			WithHint("An import is already running, please wait for it to finish").
+			Mark(ierr.ErrInvalidOperation)
+	}
+	defer s.running.Unlock()
+
+	if err := s.claimStart(); err != nil {
+		return nil, err
+	}
+
+	query := req.Query
+	if query == "" {
+		query = s.Config.OSMImport.Query
+	}
+
+	elements, err := s.overpass.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &dto.OSMImportReport{
+		DryRun:  req.DryRun,
+		Fetched: len(elements),
+		Items:   make([]*dto.OSMImportItem, 0, len(elements)),
+	}
+	for _, element := range elements {
+		item, err := s.importElement(ctx, element, req.DryRun)
+		if err != nil {
+			return nil, err
+		}
+		report.Add(item)
+	}
+
+	s.Logger.Infow("imported places from openstreetmap",
+		"dry_run", req.DryRun,
+		"fetched", report.Fetched,
+		"created", report.Created,
+		"updated", report.Updated,
+		"unchanged", report.Unchanged,
+		"skipped", report.Skipped,
+	)
+
+	return report, nil
+}
+
+// claimStart records the start of an import unless the previous one started too recently
+func (s *placeImportService) claimStart() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	minInterval := s.Config.OSMImport.GetMinInterval()
+	if wait := s.lastStart.Add(minInterval).Sub(s.now()); wait > 0 {
+		return ierr.NewErrorf("last import started less than %s ago", minInterval).
+			WithHintf("Imports are limited to one every %s, please retry in %s", minInterval, wait.Round(time.Second)).
+			WithReportableDetails(map[string]any{
+				"retry_after_seconds": int(wait.Seconds()) + 1,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+	s.lastStart = s.now()
+	return nil
+}
+
+func (s *placeImportService) importElement(ctx context.Context, element osm.Element, dryRun bool) (*dto.OSMImportItem, error) {
+	item := &dto.OSMImportItem{Ref: element.Ref(), Title: element.Name()}
+
+	if item.Title == "" {
+		return skipped(item, "element has no name"), nil
+	}
+	position, points, ok := elementPosition(element)
+	if !ok {
+		return skipped(item, "element has no coordinates; query with out geom or out center"), nil
+	}
+	if points != nil {
+		if err := points.Validate(); err != nil {
+			return skipped(item, "element has a member with invalid coordinates"), nil
+		}
+		item.Points = points
+	}
+	location := types.Location{
+		Latitude:  decimal.NewFromFloat(position.Coordinates[1]).Round(osmCoordinatePlaces),
+		Longitude: decimal.NewFromFloat(position.Coordinates[0]).Round(osmCoordinatePlaces),
+	}
+	if err := types.ValidateCoordinates(location.Latitude, location.Longitude); err != nil {
+		return skipped(item, "element has invalid coordinates"), nil
+	}
+
+	existing, err := s.PlaceRepo.GetByExternalRef(ctx, ExternalRefOSM, item.Ref)
+	if err != nil && !ierr.IsNotFound(err) {
+		return nil, err
+	}
+
+	if existing == nil {
+		item.Action = dto.OSMImportCreated
+		if dryRun {
+			return item, nil
+		}
+		p, err := s.createFromOSM(ctx, item, element, location)
+		if err != nil {
+			return nil, err
+		}
+		item.PlaceID = p.ID
+		return item, nil
+	}
+
+	item.PlaceID = existing.ID
+	if existing.Status == types.StatusArchived || existing.Status == types.StatusDeleted {
+		return skipped(item, "place was removed from the catalog"), nil
+	}
+
+	if !applyOSM(existing, item.Title, location, element.Address()) {
+		item.Action = dto.OSMImportUnchanged
+		return item, nil
+	}
+	item.Action = dto.OSMImportUpdated
+	if dryRun {
+		return item, nil
+	}
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.PlaceRepo.Update(ctx, existing); err != nil {
+			return err
+		}
+		if _, err := s.recordRevision(ctx, existing, nil); err != nil {
+			return err
+		}
+		return s.enqueueEvent(ctx, types.EventPlaceUpdated, types.AggregatePlace, existing.ID, existing)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (s *placeImportService) createFromOSM(ctx context.Context, item *dto.OSMImportItem, element osm.Element, location types.Location) (*place.Place, error) {
+	p := &place.Place{
+		ID:           s.newID(types.UUID_PREFIX_PLACE),
+		Title:        item.Title,
+		PlaceType:    types.PlaceType(s.Config.OSMImport.PlaceType),
+		Address:      element.Address(),
+		Location:     location,
+		ExternalRefs: map[string]string{ExternalRefOSM: item.Ref},
+		BaseModel:    s.newBaseModel(ctx),
+	}
+	p.Status = types.StatusDraft
+
+	err := s.createWithSlug(ctx, p, func(ctx context.Context) error {
+		if err := s.PlaceRepo.Create(ctx, p); err != nil {
+			return err
+		}
+		if _, err := s.recordRevision(ctx, p, nil); err != nil {
+			return err
+		}
+		return s.enqueueEvent(ctx, types.EventPlaceCreated, types.AggregatePlace, p.ID, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// elementPosition returns where element is placed. A relation without a position of its
+// own, such as a temple complex mapped as a site of separate shrines, is collapsed to the
+// centroid of its members, which are returned too so the import can report the full set.
+func elementPosition(element osm.Element) (types.Point, *types.MultiPoint, bool) {
+	if position, ok := element.Position(); ok {
+		return types.Point{Type: types.GeoJSONTypePoint, Coordinates: [2]float64{position.Lon, position.Lat}}, nil, true
+	}
+
+	members := element.MemberPositions()
+	if len(members) == 0 {
+		return types.Point{}, nil, false
+	}
+	points := types.NewMultiPoint(lo.Map(members, func(m osm.Point, _ int) types.Point {
+		return types.Point{Type: types.GeoJSONTypePoint, Coordinates: [2]float64{m.Lon, m.Lat}}
+	})...)
+	return points.Centroid(), &points, true
+}
+
+// applyOSM copies the OpenStreetMap fields onto p and reports whether anything changed. An
+// element without address tags leaves the address as editors set it.
+func applyOSM(p *place.Place, title string, location types.Location, address map[string]string) bool {
+	changed := false
+	if p.Title != title {
+		p.Title = title
+		changed = true
+	}
+	if !p.Location.Latitude.Equal(location.Latitude) || !p.Location.Longitude.Equal(location.Longitude) {
+		p.Location = location
+		changed = true
+	}
+	if address != nil && !maps.Equal(p.Address, address) {
+		p.Address = address
+		changed = true
+	}
+	return changed
+}
+
+func skipped(item *dto.OSMImportItem, reason string) *dto.OSMImportItem {
+	item.Action = dto.OSMImportSkipped
+	item.Reason = reason
+	return item
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+func TestAssignCategoriesLimit(t *testing.T) {
+	tests := []struct {
+		name        string
+		categoryIDs []string
+		want        []string
+		wantErr     bool
+	}{
+		{name: "under the limit", categoryIDs: []string{"cat_temple", "cat_ghat"}, want: []string{"cat_temple", "cat_ghat"}},
+		{name: "at the limit", categoryIDs: []string{"cat_temple", "cat_ghat", "cat_heritage"}, want: []string{"cat_temple", "cat_ghat", "cat_heritage"}},
+		{name: "over the limit", categoryIDs: []string{"cat_temple", "cat_ghat", "cat_heritage", "cat_river"}, wantErr: true},
+		// Duplicates collapse before the cap is checked
+		{
+			name:        "duplicates collapsed",
+			categoryIDs: []string{"cat_temple", "cat_ghat", "cat_temple", "cat_heritage", "cat_ghat", "cat_temple"},
+			want:        []string{"cat_temple", "cat_ghat", "cat_heritage"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakePlaceRepo(&place.Place{ID: "plc_1", Title: "Ramkund", BaseModel: types.BaseModel{Status: types.StatusPublished}})
+			s := newTestPlaceService(repo)
+			s.Config.Places.MaxCategoriesPerPlace = 3
+
+			err := s.AssignCategories(context.Background(), "plc_1", &dto.AssignCategoriesRequest{CategoryIDs: tt.categoryIDs})
+			if tt.wantErr {
+				if !ierr.IsValidation(err) {
+					t.Fatalf("AssignCategories() error = %v, want validation error", err)
+				}
+				if hint := errors.FlattenHints(err); !strings.Contains(hint, "at most 3 categories") {
+					t.Errorf("hint = %q, want the limit", hint)
+				}
+				if _, ok := repo.categories["plc_1"]; ok {
+					t.Error("categories were assigned despite the error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AssignCategories() error = %v", err)
+			}
+			if got := repo.categories["plc_1"]; !slices.Equal(got, tt.want) {
+				t.Errorf("assigned %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddImageLimit(t *testing.T) {
+	image := func(id string, status types.Status) *place.PlaceImage {
+		return &place.PlaceImage{ID: id, PlaceID: "plc_1", URL: "https://example.com/" + id + ".jpg", BaseModel: types.BaseModel{Status: status}}
+	}
+
+	tests := []struct {
+		name     string
+		existing []*place.PlaceImage
+		wantErr  bool
+	}{
+		{name: "under the limit", existing: []*place.PlaceImage{image("img_1", types.StatusPublished)}},
+		// Archived images do not count towards the cap
+		{name: "archived images ignored", existing: []*place.PlaceImage{image("img_1", types.StatusPublished), image("img_2", types.StatusArchived)}},
+		{name: "at the limit", existing: []*place.PlaceImage{image("img_1", types.StatusPublished), image("img_2", types.StatusPublished)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakePlaceRepo(&place.Place{ID: "plc_1", Title: "Ramkund", BaseModel: types.BaseModel{Status: types.StatusPublished}})
+			for _, img := range tt.existing {
+				repo.images[img.ID] = img
+			}
+			s := newTestPlaceService(repo)
+			s.Config.Places.MaxImagesPerPlace = 2
+
+			_, err := s.AddImage(context.Background(), "plc_1", &dto.CreatePlaceImageRequest{URL: "https://example.com/ghat.jpg"})
+			if tt.wantErr {
+				if !ierr.IsValidation(err) {
+					t.Fatalf("AddImage() error = %v, want validation error", err)
+				}
+				if hint := errors.FlattenHints(err); !strings.Contains(hint, "at most 2 images") {
+					t.Errorf("hint = %q, want the limit", hint)
+				}
+				if len(repo.images) != len(tt.existing) {
+					t.Errorf("%d images stored, want %d", len(repo.images), len(tt.existing))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AddImage() error = %v", err)
+			}
+			if want := len(tt.existing) + 1; len(repo.images) != want {
+				t.Errorf("%d images stored, want %d", len(repo.images), want)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+
+	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/slug"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+)
+
+// ReslugPlaces regenerates the slug of every place that is not deleted under the current slug
+// policy. The whole catalog is planned first, so two places wanting the same slug get
+// distinct ones per the collision strategy. A place keeps its slug when that slug is one the
+// policy could give it, such as temple-x-2 while temple-x is taken. The changes are then
+// written a batch per transaction, each old slug recorded in the slug history so links to it
+// keep resolving. A place whose slug changed or that was deleted in between is left alone and
+// reported as skipped. A failed run keeps the batches it wrote, and a rerun plans the rest.
+func (s *adminService) ReslugPlaces(ctx context.Context, req *dto.ReslugPlacesRequest) (*dto.ReslugPlacesReport, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	report := &dto.ReslugPlacesReport{DryRun: req.DryRun, Changes: []*dto.ReslugChange{}}
+	// Slugs proposed earlier in this run, taken as far as later places are concerned
+	reserved := make(map[string]bool)
+
+	err := s.PlaceRepo.Stream(ctx, types.NewNoLimitPlaceFilter(), placeStreamBatchSize, func(p *place.Place) error {
+		report.Scanned++
+		proposed, err := s.proposeSlug(ctx, p, reserved)
+		if err != nil {
+			return err
+		}
+		if proposed == p.Slug {
+			report.Unchanged++
+			return nil
+		}
+		reserved[proposed] = true
+		report.Changes = append(report.Changes, &dto.ReslugChange{
+			PlaceID: p.ID,
+			Title:   p.Title,
+			OldSlug: p.Slug,
+			NewSlug: proposed,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.Changed = len(report.Changes)
+
+	if req.DryRun {
+		return report, nil
+	}
+
+	for _, batch := range lo.Chunk(report.Changes, req.GetBatchSize()) {
+		err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+			for _, change := range batch {
+				if err := s.applySlugChange(ctx, change); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	report.Skipped = lo.CountBy(report.Changes, func(change *dto.ReslugChange) bool { return change.Skipped })
+	report.Changed -= report.Skipped
+
+	s.Logger.Infow("re-slugged places",
+		"actor", types.GetActor(ctx),
+		"scanned", report.Scanned,
+		"changed", report.Changed,
+		"skipped", report.Skipped,
+	)
+	return report, nil
+}
+
+// proposeSlug returns the slug the policy gives p. The current slug is kept if the policy
+// reaches it before a free one; slugs held by other places or reserved by this run are
+// passed over per the collision strategy.
+func (s *adminService) proposeSlug(ctx context.Context, p *place.Place, reserved map[string]bool) (string, error) {
+	base := placeSlugBase(s.Config.Places, p)
+
+	for attempt := 0; attempt < maxSlugAttempts; attempt++ {
+		candidate := slug.CandidateFor(s.Config.Places.SlugCollisionStrategy, base, attempt, p.CreatedAt)
+		if candidate == p.Slug {
+			return candidate, nil
+		}
+		if reserved[candidate] {
+			continue
+		}
+		exists, err := s.PlaceRepo.ExistsBySlug(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return candidate, nil
+		}
+	}
+
+	return "", ierr.NewErrorf("no free slug for %s after %d attempts", base, maxSlugAttempts).
+		WithHint("Please give this place a slug by hand and rerun").
+		WithReportableDetails(map[string]any{
+			"place_id":  p.ID,
+			"base_slug": base,
+		}).
+		Mark(ierr.ErrAlreadyExists)
+}
+
+// applySlugChange moves the place to its new slug and records the old one, unless the place
+// was re-slugged by hand or deleted since it was planned
+func (s *adminService) applySlugChange(ctx context.Context, change *dto.ReslugChange) error {
+	changed, err := s.PlaceRepo.ChangeSlug(ctx, change.PlaceID, change.OldSlug, change.NewSlug)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		change.Skipped = true
+		return nil
+	}
+
+	err = s.PlaceRepo.RecordPreviousSlug(ctx, &place.PreviousSlug{
+		ID:        s.newID(types.UUID_PREFIX_PLACE_SLUG),
+		PlaceID:   change.PlaceID,
+		Slug:      change.OldSlug,
+		BaseModel: s.newBaseModel(ctx),
+	})
+	if err != nil {
+		return err
+	}
+
+	if !s.Config.Outbox.Enabled {
+		return nil
+	}
+	p, err := s.PlaceRepo.Get(ctx, change.PlaceID)
+	if err != nil {
+		return err
+	}
+	return s.enqueueEvent(ctx, types.EventPlaceUpdated, types.AggregatePlace, p.ID, p)
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+func TestReslugPlaces(t *testing.T) {
+	newPlaces := func() []*place.Place {
+		temple := func(id, title, slug string) *place.Place {
+			return &place.Place{ID: id, Title: title, Slug: slug, PlaceType: "temple", BaseModel: types.BaseModel{Status: types.StatusPublished}}
+		}
+		return []*place.Place{
+			temple("place_1", "Kalaram Temple", "kalaram-temple"),
+			{ID: "place_2", Title: "Sula Vineyards", Slug: "sula-vineyards", PlaceType: "winery", BaseModel: types.BaseModel{Status: types.StatusPublished}},
+			// Both keep their slugs: the second is what the policy gives it while the first holds the base
+			temple("place_3", "Trimbakeshwar", "temple-trimbakeshwar"),
+			temple("place_4", "Trimbakeshwar", "temple-trimbakeshwar-2"),
+			// Both want temple-panchavati; the one planned later gets the next candidate
+			temple("place_5", "Panchavati", "panchavati"),
+			temple("place_6", "Panchavati", "old-panchavati"),
+			{ID: "place_7", Title: "Old Ghat", Slug: "old-ghat", PlaceType: "temple", BaseModel: types.BaseModel{Status: types.StatusDeleted}},
+		}
+	}
+	wantChanges := map[string][2]string{
+		"place_1": {"kalaram-temple", "temple-kalaram-temple"},
+		"place_5": {"panchavati", "temple-panchavati"},
+		"place_6": {"old-panchavati", "temple-panchavati-2"},
+	}
+
+	tests := []struct {
+		name string
+		req  *dto.ReslugPlacesRequest
+	}{
+		{name: "dry run", req: &dto.ReslugPlacesRequest{DryRun: true}},
+		{name: "default batches", req: &dto.ReslugPlacesRequest{}},
+		{name: "one place per batch", req: &dto.ReslugPlacesRequest{BatchSize: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakePlaceRepo(newPlaces()...)
+			params := newTestParams(repo)
+			params.Config.Places.SlugPrefixes = map[string]string{"temple": "temple"}
+			s := NewAdminService(params).(*adminService)
+
+			ctx := context.WithValue(context.Background(), types.CtxScopes, []string{string(types.ScopeAdmin)})
+			ctx = context.WithValue(ctx, types.CtxUserID, "user_admin")
+			report, err := s.ReslugPlaces(ctx, tt.req)
+			if err != nil {
+				t.Fatalf("ReslugPlaces() error = %v", err)
+			}
+
+			if report.Scanned != 6 || report.Changed != 3 || report.Unchanged != 3 || report.Skipped != 0 {
+				t.Errorf("report = scanned %d, changed %d, unchanged %d, skipped %d, want 6, 3, 3, 0",
+					report.Scanned, report.Changed, report.Unchanged, report.Skipped)
+			}
+			for _, change := range report.Changes {
+				want, ok := wantChanges[change.PlaceID]
+				if !ok || change.OldSlug != want[0] || change.NewSlug != want[1] {
+					t.Errorf("change %s: %s -> %s, want %v", change.PlaceID, change.OldSlug, change.NewSlug, want)
+				}
+			}
+
+			for id, change := range wantChanges {
+				want := change[1]
+				if tt.req.DryRun {
+					want = change[0]
+				}
+				if got := repo.places[id].Slug; got != want {
+					t.Errorf("%s slug = %s after the run, want %s", id, got, want)
+				}
+			}
+			if tt.req.DryRun {
+				if len(repo.previousSlugs) != 0 {
+					t.Errorf("dry run recorded %d previous slugs", len(repo.previousSlugs))
+				}
+				return
+			}
+			if len(repo.previousSlugs) != len(wantChanges) {
+				t.Fatalf("recorded %d previous slugs, want %d", len(repo.previousSlugs), len(wantChanges))
+			}
+			for _, prev := range repo.previousSlugs {
+				if prev.Slug != wantChanges[prev.PlaceID][0] {
+					t.Errorf("previous slug of %s = %s, want %s", prev.PlaceID, prev.Slug, wantChanges[prev.PlaceID][0])
+				}
+				if prev.CreatedBy != "user_admin" || !prev.CreatedAt.Equal(testNow) {
+					t.Errorf("previous slug of %s created by %q at %s, want user_admin at %s", prev.PlaceID, prev.CreatedBy, prev.CreatedAt, testNow)
+				}
+			}
+		})
+	}
+}
+
+func TestReslugPlacesRequiresAdmin(t *testing.T) {
+	s := NewAdminService(newTestParams(newFakePlaceRepo())).(*adminService)
+	ctx := context.WithValue(context.Background(), types.CtxScopes, []string{string(types.ScopePlacesWrite)})
+
+	if _, err := s.ReslugPlaces(ctx, &dto.ReslugPlacesRequest{DryRun: true}); !ierr.IsPermissionDenied(err) {
+		t.Errorf("ReslugPlaces() error = %v, want permission denied", err)
+	}
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	"github.com/omkar273/nashikdarshan/internal/diff"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+)
+
+// recordRevision appends a snapshot of the place's current content to its history
+// and prunes the oldest revisions beyond the configured cap. Callers should run it
+// in the same transaction as the change it records.
+func (s *placeService) recordRevision(ctx context.Context, p *place.Place, revertedFrom *int) (*place.Revision, error) {
+	categoryIDs, err := s.PlaceRepo.GetCategoryIDs(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := s.PlaceRepo.LatestRevisionNumber(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rev := &place.Revision{
+		ID:           s.newID(types.UUID_PREFIX_PLACE_REV),
+		PlaceID:      p.ID,
+		Revision:     latest + 1,
+		Snapshot:     place.NewSnapshot(p, categoryIDs),
+		RevertedFrom: revertedFrom,
+		BaseModel:    s.newBaseModel(ctx),
+	}
+
+	if err := s.PlaceRepo.CreateRevision(ctx, rev); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.PlaceRepo.PruneRevisions(ctx, p.ID, s.Config.Places.GetMaxRevisions()); err != nil {
+		return nil, err
+	}
+
+	return rev, nil
+}
+
+// ListRevisions returns the change history of a place, newest first
+func (s *placeService) ListRevisions(ctx context.Context, placeID string, filter *types.QueryFilter) (*dto.ListPlaceRevisionsResponse, error) {
+	if filter == nil {
+		filter = types.NewDefaultQueryFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Verify place exists; admins also list the revisions of archived places to restore one
+	if _, err := s.getForWrite(ctx, placeID); err != nil {
+		return nil, err
+	}
+
+	revs, err := s.PlaceRepo.ListRevisions(ctx, placeID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.PlaceRepo.CountRevisions(ctx, placeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewListPlaceRevisionsResponse(revs, total, filter.GetLimit(), filter.GetOffset()), nil
+}
+
+// GetRevision returns a single revision of a place
+func (s *placeService) GetRevision(ctx context.Context, placeID string, revision int) (*dto.PlaceRevisionResponse, error) {
+	rev, err := s.PlaceRepo.GetRevision(ctx, placeID, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewPlaceRevisionResponse(rev), nil
+}
+
+// RevertToRevision restores the place content captured by an earlier revision.
+// History is never rewritten: the restored state is recorded as a new revision.
+func (s *placeService) RevertToRevision(ctx context.Context, placeID string, revision int) (*dto.PlaceRevisionResponse, error) {
+	var reverted *place.Revision
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		p, err := s.getForWrite(ctx, placeID)
+		if err != nil {
+			return err
+		}
+
+		target, err := s.PlaceRepo.GetRevision(ctx, placeID, revision)
+		if err != nil {
+			return err
+		}
+
+		snapshot := *target.Snapshot
+		if err := s.authorizeFields(ctx, &revertFields{snapshot: &snapshot, current: p}); err != nil {
+			return err
+		}
+
+		snapshot.ApplyTo(p)
+		if err := s.PlaceRepo.Update(ctx, p); err != nil {
+			return err
+		}
+
+		if err := s.PlaceRepo.AssignCategories(ctx, placeID, target.Snapshot.CategoryIDs); err != nil {
+			return err
+		}
+
+		restored, err := s.getForWrite(ctx, placeID)
+		if err != nil {
+			return err
+		}
+
+		reverted, err = s.recordRevision(ctx, restored, lo.ToPtr(revision))
+		if err != nil {
+			return err
+		}
+		return s.enqueueEvent(ctx, types.EventPlaceUpdated, types.AggregatePlace, restored.ID, restored)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("reverted place to revision",
+		"place_id", placeID,
+		"revision", revision,
+		"new_revision", reverted.Revision,
+	)
+
+	return dto.NewPlaceRevisionResponse(reverted), nil
+}
+
+// revertFields exposes the admin-only fields a revert would change, so non-admins cannot
+// restore a status or publish window through a revision
+type revertFields struct {
+	snapshot *place.Snapshot
+	current  *place.Place
+}
+
+// AdminFields returns the admin-only fields that differ between the snapshot and the place
+func (r *revertFields) AdminFields() []string {
+	fields := make([]string, 0, len(types.AdminPlaceFields))
+	if r.snapshot.Status != r.current.Status {
+		fields = append(fields, types.PlaceRequestFieldStatus)
+	}
+	if !sameTime(r.snapshot.PublishAt, r.current.PublishAt) {
+		fields = append(fields, types.PlaceRequestFieldPublishAt)
+	}
+	if !sameTime(r.snapshot.UnpublishAt, r.current.UnpublishAt) {
+		fields = append(fields, types.PlaceRequestFieldUnpublishAt)
+	}
+	return fields
+}
+
+// StripAdminFields keeps the place's current admin-only fields in the snapshot
+func (r *revertFields) StripAdminFields() {
+	r.snapshot.Status = r.current.Status
+	r.snapshot.PublishAt = r.current.PublishAt
+	r.snapshot.UnpublishAt = r.current.UnpublishAt
+}
+
+// sameTime reports whether two optional instants are both unset or equal
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// DiffRevisions compares the snapshots of two revisions of a place field by field
+func (s *placeService) DiffRevisions(ctx context.Context, placeID string, req *dto.PlaceRevisionDiffRequest) (*dto.PlaceRevisionDiffResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	from, err := s.PlaceRepo.GetRevision(ctx, placeID, req.From)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.PlaceRepo.GetRevision(ctx, placeID, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := diff.Compare(from.Snapshot, to.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PlaceRevisionDiffResponse{
+		PlaceID: placeID,
+		From:    req.From,
+		To:      req.To,
+		Changes: changes,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/samber/lo"
+)
+
+func TestSanitizeDescriptions(t *testing.T) {
+	tests := []struct {
+		policy    types.HTMLPolicy
+		wantShort string
+		wantLong  string
+	}{
+		{policy: types.HTMLPolicyStrip, wantShort: "Holy tank", wantLong: "Ghats on the Godavari"},
+		{policy: types.HTMLPolicyBasic, wantShort: "Holy tank", wantLong: "<p>Ghats on the <b>Godavari</b></p>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			params := newTestParams(newFakePlaceRepo())
+			params.Config.Places.DescriptionHTML = tt.policy
+			s := NewPlaceService(params).(*placeService)
+
+			p := &place.Place{
+				ShortDescription: lo.ToPtr(`Holy tank<script>alert(1)</script>`),
+				LongDescription:  lo.ToPtr(`<p onclick="alert(1)">Ghats on the <b>Godavari</b></p>`),
+			}
+			s.sanitizeDescriptions(p)
+
+			if *p.ShortDescription != tt.wantShort {
+				t.Errorf("ShortDescription = %q, want %q", *p.ShortDescription, tt.wantShort)
+			}
+			if *p.LongDescription != tt.wantLong {
+				t.Errorf("LongDescription = %q, want %q", *p.LongDescription, tt.wantLong)
+			}
+		})
+	}
+}
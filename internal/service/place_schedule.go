@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// errScheduleNotDue aborts a scheduled status change whose place changed since it was listed
+var errScheduleNotDue = errors.New("place schedule is no longer due")
+
+// ApplyPublishSchedules publishes the drafts whose publish_at has passed and archives the
+// published places whose unpublish_at has passed. The timestamp that fired is cleared, so a
+// schedule applies once and a place archived by its schedule is not published again. Each
+// place is changed in its own transaction with a revision and a change event, like any other
+// update; a place that fails is logged and retried on the next run.
+func (s *placeService) ApplyPublishSchedules(ctx context.Context) error {
+	now := s.now()
+	due, err := s.PlaceRepo.ListScheduleDue(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	applied := 0
+	for _, p := range due {
+		_, err := s.applyUpdate(ctx, p.ID, func(ctx context.Context, p *place.Place) error {
+			return applyPublishSchedule(ctx, p, now)
+		})
+		switch {
+		case err == nil:
+			applied++
+		case errors.Is(err, errScheduleNotDue):
+		default:
+			s.Logger.Errorw("failed to apply place publish schedule",
+				"place_id", p.ID,
+				"error", err,
+			)
+		}
+	}
+
+	if applied > 0 {
+		s.Logger.Infow("applied place publish schedules", "count", applied)
+	}
+	return nil
+}
+
+// applyPublishSchedule moves p to the status its schedule calls for at now, re-checking the
+// schedule against the freshly loaded place
+func applyPublishSchedule(ctx context.Context, p *place.Place, now time.Time) error {
+	unpublishDue := p.UnpublishAt != nil && !p.UnpublishAt.After(now)
+
+	switch {
+	case p.Status == types.StatusPublished && unpublishDue:
+		p.Status = types.StatusArchived
+		p.UnpublishAt = nil
+	case p.Status == types.StatusDraft && p.PublishAt != nil && !p.PublishAt.After(now) && !unpublishDue:
+		p.Status = types.StatusPublished
+		p.PublishAt = nil
+	default:
+		return errScheduleNotDue
+	}
+
+	p.UpdatedBy = types.GetActor(ctx)
+	return nil
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+func TestApplyPublishSchedule(t *testing.T) {
+	now := time.Date(2025, 3, 1, 6, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name            string
+		status          types.Status
+		publishAt       *time.Time
+		unpublishAt     *time.Time
+		wantStatus      types.Status
+		wantPublishAt   *time.Time
+		wantUnpublishAt *time.Time
+		wantNotDue      bool
+	}{
+		{
+			name:        "publish when due",
+			status:      types.StatusDraft,
+			publishAt:   &past,
+			unpublishAt: &future,
+			wantStatus:  types.StatusPublished,
+			// The fired publish_at is cleared; the pending unpublish_at stays
+			wantUnpublishAt: &future,
+		},
+		{
+			name:       "publish exactly at publish_at",
+			status:     types.StatusDraft,
+			publishAt:  &now,
+			wantStatus: types.StatusPublished,
+		},
+		{
+			name:        "archive when due",
+			status:      types.StatusPublished,
+			unpublishAt: &past,
+			wantStatus:  types.StatusArchived,
+		},
+		{
+			name:          "unpublish wins when both are due",
+			status:        types.StatusDraft,
+			publishAt:     &past,
+			unpublishAt:   &past,
+			wantStatus:    types.StatusDraft,
+			wantPublishAt: &past,
+			// The window has closed, so the draft is never published
+			wantUnpublishAt: &past,
+			wantNotDue:      true,
+		},
+		{
+			name:          "publish not due",
+			status:        types.StatusDraft,
+			publishAt:     &future,
+			wantStatus:    types.StatusDraft,
+			wantPublishAt: &future,
+			wantNotDue:    true,
+		},
+		{
+			name:            "unpublish not due",
+			status:          types.StatusPublished,
+			unpublishAt:     &future,
+			wantStatus:      types.StatusPublished,
+			wantUnpublishAt: &future,
+			wantNotDue:      true,
+		},
+		{
+			name:          "archived place is not published",
+			status:        types.StatusArchived,
+			publishAt:     &past,
+			wantStatus:    types.StatusArchived,
+			wantPublishAt: &past,
+			wantNotDue:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), types.CtxUserID, "usr_scheduler")
+			p := &place.Place{
+				ID:          "plc_1",
+				PublishAt:   tt.publishAt,
+				UnpublishAt: tt.unpublishAt,
+				BaseModel:   types.BaseModel{Status: tt.status, UpdatedBy: "usr_editor"},
+			}
+
+			err := applyPublishSchedule(ctx, p, now)
+			if tt.wantNotDue {
+				if !errors.Is(err, errScheduleNotDue) {
+					t.Fatalf("applyPublishSchedule() error = %v, want errScheduleNotDue", err)
+				}
+			} else if err != nil {
+				t.Fatalf("applyPublishSchedule() error = %v", err)
+			}
+
+			if p.Status != tt.wantStatus {
+				t.Errorf("Status = %s, want %s", p.Status, tt.wantStatus)
+			}
+			if !sameTime(p.PublishAt, tt.wantPublishAt) {
+				t.Errorf("PublishAt = %v, want %v", p.PublishAt, tt.wantPublishAt)
+			}
+			if !sameTime(p.UnpublishAt, tt.wantUnpublishAt) {
+				t.Errorf("UnpublishAt = %v, want %v", p.UnpublishAt, tt.wantUnpublishAt)
+			}
+
+			wantUpdatedBy := "usr_editor"
+			if !tt.wantNotDue {
+				wantUpdatedBy = "usr_scheduler"
+			}
+			if p.UpdatedBy != wantUpdatedBy {
+				t.Errorf("UpdatedBy = %s, want %s", p.UpdatedBy, wantUpdatedBy)
+			}
+		})
+	}
+}
+
+func TestApplyPublishSchedules(t *testing.T) {
+	past := testNow.Add(-time.Minute)
+	future := testNow.Add(time.Minute)
+
+	repo := newFakePlaceRepo(
+		&place.Place{ID: "plc_due", PublishAt: &past, BaseModel: types.BaseModel{Status: types.StatusDraft}},
+		&place.Place{ID: "plc_later", PublishAt: &future, BaseModel: types.BaseModel{Status: types.StatusDraft}},
+		&place.Place{ID: "plc_closed", UnpublishAt: &past, BaseModel: types.BaseModel{Status: types.StatusPublished}},
+	)
+	s := newTestPlaceService(repo)
+
+	if err := s.ApplyPublishSchedules(context.Background()); err != nil {
+		t.Fatalf("ApplyPublishSchedules() error = %v", err)
+	}
+
+	if !repo.scheduleNow.Equal(testNow) {
+		t.Errorf("schedules were listed at %v, want the service clock %v", repo.scheduleNow, testNow)
+	}
+
+	want := map[string]types.Status{
+		"plc_due":    types.StatusPublished,
+		"plc_later":  types.StatusDraft,
+		"plc_closed": types.StatusArchived,
+	}
+	for id, status := range want {
+		if got := repo.places[id].Status; got != status {
+			t.Errorf("%s status = %s, want %s", id, got, status)
+		}
+	}
+
+	if len(repo.revisions) != 2 {
+		t.Errorf("recorded %d revisions, want one per applied schedule", len(repo.revisions))
+	}
+	for _, rev := range repo.revisions {
+		if !rev.CreatedAt.Equal(testNow) {
+			t.Errorf("revision of %s created at %v, want %v", rev.PlaceID, rev.CreatedAt, testNow)
+		}
+	}
+}
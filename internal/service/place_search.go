@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+)
+
+// Autocomplete returns lightweight suggestions for places whose title starts with prefix.
+// It is a single indexed query so it stays cheap enough to call on every keystroke.
+func (s *placeService) Autocomplete(ctx context.Context, prefix string, limit int) (*dto.AutocompleteResponse, error) {
+	prefix = strings.TrimSpace(prefix)
+	if limit <= 0 || limit > dto.MaxAutocompleteLimit {
+		limit = dto.DefaultAutocompleteLimit
+	}
+
+	if prefix == "" {
+		return &dto.AutocompleteResponse{Suggestions: []*place.Suggestion{}}, nil
+	}
+
+	suggestions, err := s.PlaceRepo.Autocomplete(ctx, prefix, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AutocompleteResponse{Suggestions: suggestions}, nil
+}
+
+// Search runs a full-text search over place titles, subtitles and descriptions. Matches are
+// ranked with the configured per-field weights so title matches outrank description matches.
+func (s *placeService) Search(ctx context.Context, req *dto.SearchPlacesRequest) (*dto.SearchPlacesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	weights := s.Config.Places.GetSearchRankWeights()
+	places, err := s.PlaceRepo.Search(ctx, req.Q, weights, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.PlaceRepo.CountSearch(ctx, req.Q)
+	if err != nil {
+		return nil, err
+	}
+
+	response := dto.NewSearchPlacesResponse(places, total, req.Limit, req.Offset)
+
+	// A failed suggestion must not fail an otherwise valid (empty) search
+	if total == 0 {
+		suggestion, err := s.Suggest(ctx, req.Q)
+		if err != nil {
+			s.Logger.Warnw("failed to suggest search correction", "query", req.Q, "error", err)
+		}
+		response.DidYouMean = suggestion
+	}
+
+	return response, nil
+}
+
+const (
+	// suggestMinSimilarity is the pg_trgm word similarity a dictionary entry needs to be considered
+	suggestMinSimilarity = 0.3
+	// suggestCandidates is the number of dictionary entries checked for the closest spelling
+	suggestCandidates = 10
+)
+
+// Suggest returns the closest spelling of term found in place titles and category names,
+// or nil when nothing is close enough. Candidates come from trigram similarity and are then
+// bounded by edit distance so that garbage input gets no suggestion.
+func (s *placeService) Suggest(ctx context.Context, term string) (*string, error) {
+	term = strings.ToLower(strings.Join(strings.Fields(term), " "))
+	if term == "" {
+		return nil, nil
+	}
+
+	candidates, err := s.PlaceRepo.SuggestTerms(ctx, term, suggestMinSimilarity, suggestCandidates)
+	if err != nil {
+		return nil, err
+	}
+
+	maxEdits := maxSuggestEdits(term)
+	var best string
+	bestEdits := maxEdits + 1
+	for _, candidate := range candidates {
+		phrase, edits := closestPhrase(term, candidate)
+		if edits > 0 && edits < bestEdits {
+			best, bestEdits = phrase, edits
+		}
+	}
+
+	if best == "" {
+		return nil, nil
+	}
+	return &best, nil
+}
+
+// maxSuggestEdits bounds the corrections applied to a term: roughly one edit per four
+// characters, between 1 and 3
+func maxSuggestEdits(term string) int {
+	return min(max(utf8.RuneCountInString(term)/4, 1), 3)
+}
+
+// closestPhrase finds the run of words in candidate, as long as term in words, with the
+// smallest edit distance to term
+func closestPhrase(term, candidate string) (string, int) {
+	words := strings.Fields(candidate)
+	size := min(len(strings.Fields(term)), len(words))
+
+	best, bestEdits := "", -1
+	for i := 0; i+size <= len(words); i++ {
+		phrase := strings.Join(words[i:i+size], " ")
+		if edits := levenshtein(term, phrase); bestEdits < 0 || edits < bestEdits {
+			best, bestEdits = phrase, edits
+		}
+	}
+	return best, bestEdits
+}
+
+// levenshtein returns the edit distance between a and b, counted in runes
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
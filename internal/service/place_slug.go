@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+
+	"github.com/omkar273/nashikdarshan/internal/config"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/postgres"
+	"github.com/omkar273/nashikdarshan/internal/slug"
+)
+
+// maxSlugAttempts bounds the candidates tried before giving up on a generated slug
+const maxSlugAttempts = 50
+
+// maxSlugRaceRetries bounds how often a create is retried after a concurrent create took its
+// generated slug between the existence check and the insert
+const maxSlugRaceRetries = 3
+
+// generateSlug derives an unused slug for p from its title, prefixed per the configured
+// prefix of its place type. Taken slugs get a suffix per the configured collision strategy,
+// e.g. temple-x, temple-x-2, ... for increment.
+func (s *placeService) generateSlug(ctx context.Context, p *place.Place) (string, error) {
+	base := placeSlugBase(s.Config.Places, p)
+	for attempt := 0; attempt < maxSlugAttempts; attempt++ {
+		candidate := slug.CandidateFor(s.Config.Places.SlugCollisionStrategy, base, attempt, p.CreatedAt)
+		exists, err := s.PlaceRepo.ExistsBySlug(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return candidate, nil
+		}
+	}
+
+	return "", ierr.NewErrorf("no free slug for %s after %d attempts", base, maxSlugAttempts).
+		WithHint("Please provide a slug for this place").
+		WithReportableDetails(map[string]any{
+			"base_slug": base,
+		}).
+		Mark(ierr.ErrAlreadyExists)
+}
+
+// placeSlugBase is the slug p gets from its title and the configured prefix of its place
+// type, before any collision suffix
+func placeSlugBase(cfg config.PlacesConfig, p *place.Place) string {
+	base := slug.WithPrefix(cfg.GetSlugPrefix(p.PlaceType), slug.Make(p.Title))
+	if base == "" {
+		// Titles without ASCII letters or digits fall back to the place type
+		base = slug.Make(string(p.PlaceType))
+	}
+	return base
+}
+
+// createWithSlug runs create in a transaction, first generating a slug for p when it has
+// none. Checking a generated slug and inserting it are not atomic, so a concurrent create
+// can take the slug in between; the insert then violates the unique slug index and the
+// whole transaction is retried, which skips the slug that is now taken. A client-supplied
+// slug is never changed: its violation is returned as ErrAlreadyExists. Neither are creates
+// inside a caller's transaction retried, since the failed insert has aborted it.
+func (s *placeService) createWithSlug(ctx context.Context, p *place.Place, create func(ctx context.Context) error) error {
+	generated := p.Slug == ""
+	nested := s.DB.TxFromContext(ctx) != nil
+
+	for retry := 0; ; retry++ {
+		err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+			if generated {
+				candidate, err := s.generateSlug(ctx, p)
+				if err != nil {
+					return err
+				}
+				p.Slug = candidate
+			}
+			return create(ctx)
+		})
+		if err == nil || !generated || nested || !postgres.IsUniqueViolation(err, postgres.PlaceSlugIndex) || retry >= maxSlugRaceRetries {
+			return err
+		}
+
+		s.Logger.Warnw("generated place slug was taken concurrently, retrying",
+			"place_id", p.ID,
+			"slug", p.Slug,
+			"retry", retry+1,
+		)
+	}
+}
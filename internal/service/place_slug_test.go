@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/omkar273/nashikdarshan/internal/domain/place"
+	"github.com/omkar273/nashikdarshan/internal/postgres"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+func TestGenerateSlug(t *testing.T) {
+	existing := func(slugs ...string) []*place.Place {
+		places := make([]*place.Place, len(slugs))
+		for i, s := range slugs {
+			places[i] = &place.Place{ID: "plc_" + s, Slug: s}
+		}
+		return places
+	}
+
+	tests := []struct {
+		name     string
+		prefixes map[string]string
+		title    string
+		existing []*place.Place
+		want     string
+	}{
+		{name: "prefixed", prefixes: map[string]string{"temple": "temple"}, title: "Trimbakeshwar", want: "temple-trimbakeshwar"},
+		{name: "title starts with the prefix", prefixes: map[string]string{"temple": "temple"}, title: "Temple of Kalaram", want: "temple-of-kalaram"},
+		{name: "no prefix configured", title: "Trimbakeshwar", want: "trimbakeshwar"},
+		{
+			name:     "prefixed slug taken",
+			prefixes: map[string]string{"temple": "temple"},
+			title:    "Trimbakeshwar",
+			existing: existing("temple-trimbakeshwar", "temple-trimbakeshwar-2"),
+			want:     "temple-trimbakeshwar-3",
+		},
+		{
+			// An unprefixed slug from before the prefix was configured does not collide
+			name:     "unprefixed slug taken",
+			prefixes: map[string]string{"temple": "temple"},
+			title:    "Trimbakeshwar",
+			existing: existing("trimbakeshwar"),
+			want:     "temple-trimbakeshwar",
+		},
+		{name: "title without ASCII letters", prefixes: map[string]string{"temple": "mandir"}, title: "काळाराम मंदिर", want: "mandir"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestPlaceService(newFakePlaceRepo(tt.existing...))
+			s.Config.Places.SlugPrefixes = tt.prefixes
+
+			got, err := s.generateSlug(context.Background(), &place.Place{Title: tt.title, PlaceType: types.PlaceTypeTemple})
+			if err != nil {
+				t.Fatalf("generateSlug() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("generateSlug() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateWithSlug(t *testing.T) {
+	slugTaken := &pq.Error{Code: "23505", Constraint: postgres.PlaceSlugIndex}
+	errOther := errors.New("connection reset")
+
+	tests := []struct {
+		name      string
+		slug      string
+		races     int   // creates that lose their slug to a concurrent create
+		failWith  error // returned by every create instead, when set
+		wantSlug  string
+		wantCalls int
+		wantErr   error
+	}{
+		{name: "no race", wantSlug: "ramkund", wantCalls: 1},
+		{name: "slug taken concurrently", races: 1, wantSlug: "ramkund-2", wantCalls: 2},
+		{name: "taken twice", races: 2, wantSlug: "ramkund-3", wantCalls: 3},
+		{name: "retries are bounded", races: maxSlugRaceRetries + 1, wantCalls: maxSlugRaceRetries + 1, wantErr: slugTaken},
+		{name: "client slug is not retried", slug: "ramkund", races: 1, wantCalls: 1, wantErr: slugTaken},
+		{name: "other errors are not retried", failWith: errOther, wantCalls: 1, wantErr: errOther},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakePlaceRepo()
+			s := newTestPlaceService(repo)
+			p := &place.Place{ID: "plc_new", Title: "Ramkund", Slug: tt.slug, PlaceType: types.PlaceTypeTemple}
+
+			calls := 0
+			err := s.createWithSlug(context.Background(), p, func(ctx context.Context) error {
+				calls++
+				if tt.failWith != nil {
+					return tt.failWith
+				}
+				if calls <= tt.races {
+					// Another create inserts the slug between the existence check and this insert
+					repo.places["plc_racer_"+p.Slug] = &place.Place{ID: "plc_racer_" + p.Slug, Slug: p.Slug}
+					return slugTaken
+				}
+				return repo.Create(ctx, p)
+			})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("createWithSlug() error = %v, want %v", err, tt.wantErr)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("create called %d times, want %d", calls, tt.wantCalls)
+			}
+			if tt.wantErr == nil && repo.places[p.ID].Slug != tt.wantSlug {
+				t.Errorf("slug = %q, want %q", repo.places[p.ID].Slug, tt.wantSlug)
+			}
+		})
+	}
+}
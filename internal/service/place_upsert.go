@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+
+	"github.com/omkar273/nashikdarshan/internal/api/dto"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// Upsert creates the place with the request slug, or replaces the fields of the place that
+// already has it, and reports whether the place was created. The place is written by a
+// single INSERT ... ON CONFLICT (slug) DO UPDATE, so concurrent upserts of a new slug cannot
+// race: one creates the place and the others update it. The type and status of an existing
+// place are kept. Like any other write it records a revision and a change event in the same
+// transaction; a slug held by a deleted place is gone rather than recreated.
+func (s *placeService) Upsert(ctx context.Context, req *dto.UpsertPlaceRequest) (*dto.PlaceResponse, bool, error) {
+	if err := req.Validate(); err != nil {
+		return nil, false, err
+	}
+	if err := s.authorizeFields(ctx, req); err != nil {
+		return nil, false, err
+	}
+
+	p, err := req.ToPlace(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	p.ID = s.newID(types.UUID_PREFIX_PLACE)
+	s.stampCreated(&p.BaseModel)
+	s.sanitizeDescriptions(p)
+
+	var (
+		resp    *dto.PlaceResponse
+		created bool
+	)
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		id, err := s.PlaceRepo.UpsertBySlug(ctx, p)
+		if err != nil {
+			return err
+		}
+		created = id == p.ID
+
+		stored, err := s.PlaceRepo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if _, err := s.recordRevision(ctx, stored, nil); err != nil {
+			return err
+		}
+
+		eventType := types.EventPlaceUpdated
+		if created {
+			eventType = types.EventPlaceCreated
+		}
+		if err := s.enqueueEvent(ctx, eventType, types.AggregatePlace, stored.ID, stored); err != nil {
+			return err
+		}
+
+		resp = dto.NewPlaceResponse(stored)
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return resp, created, nil
+}
@@ -42,6 +42,8 @@ func (s *reviewService) CreateReview(ctx context.Context, req *dto.CreateReviewR
 	}
 
 	reviewModel := req.ToReview(ctx)
+	reviewModel.ID = s.newID(types.UUID_PREFIX_REVIEW)
+	s.stampCreated(&reviewModel.BaseModel)
 
 	createdReview, err := s.ReviewRepo.Create(ctx, reviewModel)
 	if err != nil {
@@ -0,0 +1,112 @@
+// Package slug derives URL slugs from titles. Generated slugs are kebab-case: lowercase
+// ASCII letters and digits joined by single hyphens.
+package slug
+
+import (
+	"crypto/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// MaxLength is the longest slug generated, matching the limit on client-provided slugs
+const MaxLength = 100
+
+// Make converts title into a slug. Characters other than ASCII letters and digits separate
+// words, so "Shri Kalaram Temple (Panchavati)" becomes "shri-kalaram-temple-panchavati".
+// It returns "" when title has no ASCII letters or digits.
+func Make(title string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(title) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if pendingHyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pendingHyphen = false
+			b.WriteRune(r)
+			continue
+		}
+		pendingHyphen = true
+	}
+	return truncate(b.String(), MaxLength)
+}
+
+// WithPrefix prepends prefix to slug unless slug already starts with it as a whole word,
+// so "temple" + "trimbakeshwar" is "temple-trimbakeshwar" and "temple" + "temple-of-x"
+// stays as is. An empty prefix leaves slug unchanged; an empty slug yields the prefix.
+func WithPrefix(prefix, slug string) string {
+	switch {
+	case prefix == "":
+		return slug
+	case slug == "":
+		return prefix
+	case slug == prefix || strings.HasPrefix(slug, prefix+"-"):
+		return slug
+	}
+	return truncate(prefix+"-"+slug, MaxLength)
+}
+
+// Candidate returns the attempt-th candidate for base: base itself for attempt 0, then
+// base-2, base-3 and so on. base is shortened so the suffix fits within MaxLength.
+func Candidate(base string, attempt int) string {
+	if attempt <= 0 {
+		return base
+	}
+	return withSuffix(base, strconv.Itoa(attempt+1))
+}
+
+// CandidateFor returns the attempt-th candidate for base under strategy. Every strategy tries
+// base itself first; after that:
+//   - increment gives base-2, base-3, ... (see Candidate)
+//   - random gives base followed by a fresh random suffix of RandomSuffixLength characters
+//   - date gives base-YYYYMMDD for the date of createdAt, then base-YYYYMMDD-2, ...
+func CandidateFor(strategy types.SlugCollisionStrategy, base string, attempt int, createdAt time.Time) string {
+	if attempt <= 0 {
+		return base
+	}
+
+	switch strategy {
+	case types.SlugCollisionRandom:
+		return withSuffix(base, randomSuffix())
+	case types.SlugCollisionDate:
+		dated := withSuffix(base, createdAt.UTC().Format("20060102"))
+		return Candidate(dated, attempt-1)
+	default:
+		return Candidate(base, attempt)
+	}
+}
+
+// RandomSuffixLength is the length of the suffix of the random strategy. 36^6 values make a
+// second collision on the same base practically impossible.
+const RandomSuffixLength = 6
+
+const suffixAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+func randomSuffix() string {
+	buf := make([]byte, RandomSuffixLength)
+	if _, err := rand.Read(buf); err != nil {
+		// crypto/rand does not fail on supported platforms; fall back to the clock
+		return strconv.FormatInt(time.Now().UnixNano()%2176782336, 36)
+	}
+	for i, b := range buf {
+		buf[i] = suffixAlphabet[int(b)%len(suffixAlphabet)]
+	}
+	return string(buf)
+}
+
+// withSuffix appends suffix to base, shortening base so the result fits within MaxLength
+func withSuffix(base, suffix string) string {
+	suffix = "-" + suffix
+	return truncate(base, MaxLength-len(suffix)) + suffix
+}
+
+// truncate cuts s to at most n bytes without leaving a trailing hyphen
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return strings.TrimRight(s[:n], "-")
+}
@@ -0,0 +1,111 @@
+package slug
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{title: "Trimbakeshwar", want: "trimbakeshwar"},
+		{title: "Shri Kalaram Temple (Panchavati)", want: "shri-kalaram-temple-panchavati"},
+		{title: "  Ramkund -- Godavari  ", want: "ramkund-godavari"},
+		{title: "Sula Vineyards 2", want: "sula-vineyards-2"},
+		{title: "काळाराम मंदिर", want: ""},
+		{title: strings.Repeat("a", 99) + " b", want: strings.Repeat("a", 99)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			if got := Make(tt.title); got != tt.want {
+				t.Errorf("Make(%q) = %q, want %q", tt.title, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithPrefix(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		slug   string
+		want   string
+	}{
+		{name: "prefixed", prefix: "temple", slug: "trimbakeshwar", want: "temple-trimbakeshwar"},
+		{name: "title starts with the prefix", prefix: "temple", slug: "temple-of-kalaram", want: "temple-of-kalaram"},
+		{name: "slug is the prefix", prefix: "temple", slug: "temple", want: "temple"},
+		// Only a whole word counts as the prefix
+		{name: "prefix is part of a word", prefix: "temple", slug: "templeton-hall", want: "temple-templeton-hall"},
+		{name: "no prefix", prefix: "", slug: "ramkund", want: "ramkund"},
+		{name: "empty slug", prefix: "temple", slug: "", want: "temple"},
+		{name: "too long", prefix: "temple", slug: strings.Repeat("a", MaxLength), want: "temple-" + strings.Repeat("a", MaxLength-len("temple-"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WithPrefix(tt.prefix, tt.slug); got != tt.want {
+				t.Errorf("WithPrefix(%q, %q) = %q, want %q", tt.prefix, tt.slug, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCandidateFor(t *testing.T) {
+	// Created early on 15 January in Nashik, which is still the 14th in UTC
+	createdAt := time.Date(2025, 1, 15, 2, 0, 0, 0, time.FixedZone("IST", 5*60*60+30*60))
+	long := strings.Repeat("a", MaxLength)
+
+	tests := []struct {
+		name     string
+		strategy types.SlugCollisionStrategy
+		base     string
+		attempts []string
+	}{
+		{name: "increment", strategy: types.SlugCollisionIncrement, base: "ramkund", attempts: []string{"ramkund", "ramkund-2", "ramkund-3"}},
+		{name: "unset strategy increments", base: "ramkund", attempts: []string{"ramkund", "ramkund-2"}},
+		{name: "date", strategy: types.SlugCollisionDate, base: "ramkund", attempts: []string{"ramkund", "ramkund-20250114", "ramkund-20250114-2", "ramkund-20250114-3"}},
+		{name: "increment at the length limit", strategy: types.SlugCollisionIncrement, base: long, attempts: []string{long, long[:MaxLength-2] + "-2"}},
+		{name: "date at the length limit", strategy: types.SlugCollisionDate, base: long, attempts: []string{long, long[:MaxLength-9] + "-20250114"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for attempt, want := range tt.attempts {
+				if got := CandidateFor(tt.strategy, tt.base, attempt, createdAt); got != want {
+					t.Errorf("attempt %d = %q, want %q", attempt, got, want)
+				}
+			}
+		})
+	}
+
+	t.Run("random", func(t *testing.T) {
+		if got := CandidateFor(types.SlugCollisionRandom, "ramkund", 0, createdAt); got != "ramkund" {
+			t.Errorf("attempt 0 = %q, want ramkund", got)
+		}
+
+		pattern := regexp.MustCompile(`^ramkund-[a-z0-9]{` + strconv.Itoa(RandomSuffixLength) + `}$`)
+		seen := map[string]bool{}
+		for attempt := 1; attempt <= 5; attempt++ {
+			got := CandidateFor(types.SlugCollisionRandom, "ramkund", attempt, createdAt)
+			if !pattern.MatchString(got) {
+				t.Errorf("attempt %d = %q, want ramkund and a random suffix", attempt, got)
+			}
+			seen[got] = true
+		}
+		if len(seen) < 5 {
+			t.Errorf("random candidates repeat: %v", seen)
+		}
+
+		if got := CandidateFor(types.SlugCollisionRandom, long, 1, createdAt); len(got) != MaxLength {
+			t.Errorf("len = %d, want %d", len(got), MaxLength)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+// Package timing accumulates how long a request spends in each backend, such as the
+// database or the cache, for the Server-Timing response header.
+package timing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// Metric names a backend whose time is recorded. Metric names are Server-Timing tokens.
+type Metric string
+
+const (
+	// MetricDB is time spent running database statements
+	MetricDB Metric = "db"
+	// MetricCache is time spent reading and writing the in-process cache
+	MetricCache Metric = "cache"
+	// MetricTotal is the time from the request arriving to the response headers being sent
+	MetricTotal Metric = "total"
+)
+
+// metricOrder is the order metrics appear in the header
+var metricOrder = []Metric{MetricDB, MetricCache}
+
+// metricDescriptions are shown next to the metrics by browser developer tools
+var metricDescriptions = map[Metric]string{
+	MetricDB:    "Database",
+	MetricCache: "Cache",
+	MetricTotal: "Total",
+}
+
+type entry struct {
+	duration time.Duration
+	count    int
+}
+
+// Recorder accumulates the time spent per metric. It is safe for concurrent use, since a
+// request may query the database from several goroutines.
+type Recorder struct {
+	mu      sync.Mutex
+	entries map[Metric]*entry
+}
+
+// NewContext returns a copy of ctx carrying a new Recorder, which is also returned
+func NewContext(ctx context.Context) (context.Context, *Recorder) {
+	r := &Recorder{entries: make(map[Metric]*entry)}
+	return context.WithValue(ctx, types.CtxServerTiming, r), r
+}
+
+// FromContext returns the Recorder of ctx, or nil when timing is not being recorded
+func FromContext(ctx context.Context) *Recorder {
+	r, _ := ctx.Value(types.CtxServerTiming).(*Recorder)
+	return r
+}
+
+// Since records the time elapsed since start against metric, if ctx carries a Recorder.
+// It is meant to be deferred: defer timing.Since(ctx, timing.MetricDB, time.Now()).
+func Since(ctx context.Context, metric Metric, start time.Time) {
+	if r := FromContext(ctx); r != nil {
+		r.Add(metric, time.Since(start))
+	}
+}
+
+// Add records one operation of duration d against metric
+func (r *Recorder) Add(metric Metric, d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.entries[metric]
+	if !ok {
+		e = &entry{}
+		r.entries[metric] = e
+	}
+	e.duration += d
+	e.count++
+}
+
+// Header formats the recorded metrics and total as a Server-Timing header value, such as
+// `db;dur=12.50;desc="Database (3)", cache;dur=0.02;desc="Cache (1)", total;dur=15.20;desc="Total"`.
+// Durations are in milliseconds; the description carries the number of operations.
+// Metrics without any operation are left out.
+func (r *Recorder) Header(total time.Duration) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	parts := make([]string, 0, len(metricOrder)+1)
+	for _, metric := range metricOrder {
+		e, ok := r.entries[metric]
+		if !ok {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf(`%s;dur=%s;desc="%s (%d)"`,
+			metric, milliseconds(e.duration), metricDescriptions[metric], e.count))
+	}
+	parts = append(parts, fmt.Sprintf(`%s;dur=%s;desc="%s"`,
+		MetricTotal, milliseconds(total), metricDescriptions[MetricTotal]))
+	return strings.Join(parts, ", ")
+}
+
+func milliseconds(d time.Duration) string {
+	return fmt.Sprintf("%.2f", float64(d)/float64(time.Millisecond))
+}
@@ -0,0 +1,65 @@
+package timing
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRecorderHeader(t *testing.T) {
+	tests := []struct {
+		name  string
+		adds  map[Metric][]time.Duration
+		total time.Duration
+		want  string
+	}{
+		{
+			name:  "nothing recorded",
+			total: 1500 * time.Microsecond,
+			want:  `total;dur=1.50;desc="Total"`,
+		},
+		{
+			name: "database and cache",
+			adds: map[Metric][]time.Duration{
+				MetricCache: {10 * time.Microsecond, 10 * time.Microsecond},
+				MetricDB:    {5 * time.Millisecond, 7500 * time.Microsecond, 2 * time.Millisecond},
+			},
+			total: 15200 * time.Microsecond,
+			want:  `db;dur=14.50;desc="Database (3)", cache;dur=0.02;desc="Cache (2)", total;dur=15.20;desc="Total"`,
+		},
+		{
+			name:  "cache only",
+			adds:  map[Metric][]time.Duration{MetricCache: {40 * time.Microsecond}},
+			total: time.Millisecond,
+			want:  `cache;dur=0.04;desc="Cache (1)", total;dur=1.00;desc="Total"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, r := NewContext(context.Background())
+			for metric, durations := range tt.adds {
+				for _, d := range durations {
+					r.Add(metric, d)
+				}
+			}
+			if got := r.Header(tt.total); got != tt.want {
+				t.Errorf("Header() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSince(t *testing.T) {
+	// Without a recorder nothing is recorded and nothing fails
+	Since(context.Background(), MetricDB, time.Now())
+
+	ctx, r := NewContext(context.Background())
+	if FromContext(ctx) != r {
+		t.Fatal("FromContext() did not return the recorder of the context")
+	}
+	Since(ctx, MetricDB, time.Now().Add(-2*time.Millisecond))
+	if e := r.entries[MetricDB]; e == nil || e.count != 1 || e.duration < 2*time.Millisecond {
+		t.Errorf("db entry = %+v, want one operation of at least 2ms", e)
+	}
+}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// Accessibility attribute keys
+const (
+	AccessibilityWheelchairAccessible = "wheelchair_accessible"
+	AccessibilityHasRamp              = "has_ramp"
+	AccessibilityAccessibleRestroom   = "accessible_restroom"
+	AccessibilityBrailleSignage       = "braille_signage"
+)
+
+// AccessibilityAttribute describes one entry of the accessibility vocabulary
+type AccessibilityAttribute struct {
+	Key         string `json:"key"`
+	Description string `json:"description"`
+}
+
+// AccessibilityAttributes is the vocabulary of known accessibility keys
+var AccessibilityAttributes = []AccessibilityAttribute{
+	{Key: AccessibilityWheelchairAccessible, Description: "The place can be visited in a wheelchair"},
+	{Key: AccessibilityHasRamp, Description: "Steps at the entrance have a ramp alternative"},
+	{Key: AccessibilityAccessibleRestroom, Description: "A wheelchair-accessible restroom is available"},
+	{Key: AccessibilityBrailleSignage, Description: "Signage or information boards are available in braille"},
+}
+
+// customAccessibilityKeyRegex restricts custom keys to short snake_case identifiers
+var customAccessibilityKeyRegex = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// allowCustomAccessibilityKeys lets places carry accessibility keys outside the vocabulary
+var allowCustomAccessibilityKeys = false
+
+// SetAllowCustomAccessibilityKeys configures whether unknown accessibility keys are accepted
+func SetAllowCustomAccessibilityKeys(allow bool) {
+	allowCustomAccessibilityKeys = allow
+}
+
+// Accessibility is the structured accessibility information of a place. Unset attributes
+// are unknown rather than false. Keys outside the vocabulary are kept in Custom and are
+// only valid when custom keys are allowed by configuration.
+type Accessibility struct {
+	WheelchairAccessible *bool
+	HasRamp              *bool
+	AccessibleRestroom   *bool
+	BrailleSignage       *bool
+	Custom               map[string]bool
+}
+
+// known maps each vocabulary key to its field
+func (a *Accessibility) known() map[string]**bool {
+	return map[string]**bool{
+		AccessibilityWheelchairAccessible: &a.WheelchairAccessible,
+		AccessibilityHasRamp:              &a.HasRamp,
+		AccessibilityAccessibleRestroom:   &a.AccessibleRestroom,
+		AccessibilityBrailleSignage:       &a.BrailleSignage,
+	}
+}
+
+// MarshalJSON renders known and custom attributes as one flat object
+func (a Accessibility) MarshalJSON() ([]byte, error) {
+	out := make(map[string]bool, len(a.Custom)+4)
+	for key, value := range a.Custom {
+		out[key] = value
+	}
+	for key, field := range a.known() {
+		if *field != nil {
+			out[key] = **field
+		}
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON reads a flat object of booleans, keeping unknown keys in Custom
+func (a *Accessibility) UnmarshalJSON(data []byte) error {
+	var attrs map[string]bool
+	if err := json.Unmarshal(data, &attrs); err != nil {
+		return ierr.WithError(err).
+			WithHint("accessibility must be an object of boolean attributes").
+			Mark(ierr.ErrValidation)
+	}
+
+	*a = Accessibility{}
+	known := a.known()
+	for key, value := range attrs {
+		if field, ok := known[key]; ok {
+			*field = &value
+			continue
+		}
+		if a.Custom == nil {
+			a.Custom = make(map[string]bool)
+		}
+		a.Custom[key] = value
+	}
+	return nil
+}
+
+// Validate rejects custom keys unless they are allowed, and malformed custom keys always
+func (a *Accessibility) Validate() error {
+	if a == nil || len(a.Custom) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(a.Custom))
+	for key := range a.Custom {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if !allowCustomAccessibilityKeys {
+		return ierr.NewErrorf("unknown accessibility attributes: %v", keys).
+			WithHintf("Supported accessibility attributes are: %s, %s, %s, %s",
+				AccessibilityWheelchairAccessible, AccessibilityHasRamp,
+				AccessibilityAccessibleRestroom, AccessibilityBrailleSignage).
+			WithReportableDetails(map[string]any{
+				"unknown": keys,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	for _, key := range keys {
+		if !customAccessibilityKeyRegex.MatchString(key) {
+			return ierr.NewErrorf("invalid accessibility attribute: %s", key).
+				WithHint("Custom accessibility attributes must be snake_case, e.g. audio_guide").
+				WithReportableDetails(map[string]any{
+					"key": key,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+	return nil
+}
+
+// CustomAccessibilityKeysAllowed reports whether keys outside the vocabulary are accepted
+func CustomAccessibilityKeysAllowed() bool {
+	return allowCustomAccessibilityKeys
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// AddressKeyPincode is the address key holding the postal code
+const AddressKeyPincode = "pincode"
+
+// pincodeRegex matches an Indian postal index number: six digits, the first of them not zero
+var pincodeRegex = regexp.MustCompile(`^[1-9][0-9]{5}$`)
+
+// NormalizePincode removes the whitespace from a pincode, so 422 001 becomes 422001
+func NormalizePincode(pincode string) string {
+	return strings.Join(strings.Fields(pincode), "")
+}
+
+// ValidatePincode checks that a pincode is six digits not starting with zero
+func ValidatePincode(pincode string) error {
+	if pincodeRegex.MatchString(pincode) {
+		return nil
+	}
+	return ierr.NewErrorf("invalid pincode: %s", pincode).
+		WithHint("Please provide a 6-digit pincode, e.g. 422001").
+		WithReportableDetails(map[string]any{
+			"pincode": pincode,
+		}).
+		Mark(ierr.ErrValidation)
+}
+
+// NormalizeAddress normalizes the pincode of an address in place, so stored pincodes match the
+// pincode filter
+func NormalizeAddress(address map[string]string) {
+	if pincode, ok := address[AddressKeyPincode]; ok {
+		address[AddressKeyPincode] = NormalizePincode(pincode)
+	}
+}
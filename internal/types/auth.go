@@ -1,8 +1,73 @@
 package types
 
+import (
+	"strings"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/samber/lo"
+)
+
 type AuthProvider string
 
 const (
 	AuthProviderCaygnus  AuthProvider = "caygnus"
 	AuthProviderSupabase AuthProvider = "supabase"
 )
+
+// Scope is a permission that can be granted to a principal (user JWT or API key)
+type Scope string
+
+const (
+	ScopePlacesWrite      Scope = "places:write"
+	ScopeCategoriesWrite  Scope = "categories:write"
+	ScopeImagesWrite      Scope = "images:write"
+	ScopeEventsWrite      Scope = "events:write"
+	ScopeHotelsWrite      Scope = "hotels:write"
+	ScopeReviewsWrite     Scope = "reviews:write"
+	ScopeItinerariesWrite Scope = "itineraries:write"
+	ScopeProfileWrite     Scope = "profile:write"
+	ScopeAdmin            Scope = "admin"
+)
+
+// Scopes contains all valid scopes
+var Scopes = []string{
+	string(ScopePlacesWrite),
+	string(ScopeCategoriesWrite),
+	string(ScopeImagesWrite),
+	string(ScopeEventsWrite),
+	string(ScopeHotelsWrite),
+	string(ScopeReviewsWrite),
+	string(ScopeItinerariesWrite),
+	string(ScopeProfileWrite),
+	string(ScopeAdmin),
+}
+
+// roleScopes maps user roles to the scopes they are granted by default.
+// USER keeps the write access every authenticated user had before scopes existed.
+var roleScopes = map[UserRole][]string{
+	UserRoleUser: lo.Without(Scopes, string(ScopeAdmin)),
+	UserRoleAdmin: {
+		string(ScopeAdmin),
+	},
+}
+
+// DefaultScopesForRole returns the scopes granted to a user role
+func DefaultScopesForRole(role UserRole) []string {
+	return roleScopes[role]
+}
+
+func (s Scope) Validate() error {
+	if !lo.Contains(Scopes, string(s)) {
+		return ierr.NewError("invalid scope").
+			WithHintf("valid scopes are: %s", strings.Join(Scopes, ", ")).
+			WithReportableDetails(map[string]any{"scope": s}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// HasScope reports whether the granted scopes include the required scope.
+// The admin scope implies every other scope.
+func HasScope(granted []string, required Scope) bool {
+	return lo.Contains(granted, string(required)) || lo.Contains(granted, string(ScopeAdmin))
+}
@@ -13,15 +13,19 @@ type BaseModel struct {
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
 	CreatedBy string    `db:"created_by" json:"created_by"`
 	UpdatedBy string    `db:"updated_by" json:"updated_by"`
+	// DeletedAt and DeletedBy record the soft delete of the row. Only deleted rows have them,
+	// and only admins can read deleted rows (include_deleted).
+	DeletedAt *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
+	DeletedBy string     `db:"deleted_by" json:"deleted_by,omitempty"`
 }
 
 func GetDefaultBaseModel(ctx context.Context) BaseModel {
-	now := time.Now().UTC()
+	now := NowUTC()
 	return BaseModel{
 		Status:    StatusPublished,
 		CreatedAt: now,
 		UpdatedAt: now,
-		CreatedBy: GetUserID(ctx),
-		UpdatedBy: GetUserID(ctx),
+		CreatedBy: GetActor(ctx),
+		UpdatedBy: GetActor(ctx),
 	}
 }
@@ -8,6 +8,26 @@ type CategoryFilter struct {
 	Slug   []string `json:"slug,omitempty" form:"slug" validate:"omitempty"`
 	Name   []string `json:"name,omitempty" form:"name" validate:"omitempty"`
 	Status Status   `json:"status,omitempty" form:"status" validate:"omitempty"`
+
+	// Contributor filters; non-admins may only filter on their own user ID
+	ContributorFilter
+
+	// IncludeDeleted also lists soft-deleted (archived and deleted) rows; honoured for admins only
+	IncludeDeleted bool `json:"include_deleted,omitempty" form:"include_deleted"`
+}
+
+// GetIncludeDeleted implements IncludeDeletedFilter
+func (f *CategoryFilter) GetIncludeDeleted() bool {
+	return f != nil && f.IncludeDeleted
+}
+
+// CategorySortFields lists the fields a category list can be sorted by
+var CategorySortFields = []string{
+	"created_at",
+	"updated_at",
+	"name",
+	"slug",
+	SortFieldID,
 }
 
 func (f *CategoryFilter) Validate() error {
@@ -15,6 +35,9 @@ func (f *CategoryFilter) Validate() error {
 		if err := f.QueryFilter.Validate(); err != nil {
 			return err
 		}
+		if err := ValidateSort(f.GetSort(), f.GetOrder(), CategorySortFields); err != nil {
+			return err
+		}
 	}
 
 	if f.TimeRangeFilter != nil {
@@ -23,6 +46,12 @@ func (f *CategoryFilter) Validate() error {
 		}
 	}
 
+	if f.Status != "" {
+		if err := f.Status.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"math"
+	"slices"
+	"strings"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/shopspring/decimal"
+)
+
+// DefaultCityCenter is central Nashik, the reference point when places.city_center_* are unset
+var DefaultCityCenter = Location{
+	Latitude:  decimal.RequireFromString("19.9975"),
+	Longitude: decimal.RequireFromString("73.7898"),
+}
+
+// cityCenter is the configured reference point, see SetCityCenter
+var cityCenter = DefaultCityCenter
+
+// SetCityCenter configures the point distances from the city center are measured from
+func SetCityCenter(center Location) {
+	cityCenter = center
+}
+
+// CityCenter returns the configured city center
+func CityCenter() Location {
+	return cityCenter
+}
+
+// IncludeCenterDistance is the value of the include query parameter that adds each place's
+// distance from the city center to a response
+const IncludeCenterDistance = "center_distance"
+
+// Includes lists the supported values of the include query parameter
+var Includes = []string{IncludeCenterDistance}
+
+// CenterDistancer is implemented by responses carrying places that can report their distance
+// from the city center
+type CenterDistancer interface {
+	SetCenterDistance(center Location)
+}
+
+// SetCenterDistance sets the center distance of every item that supports it
+func (r *ListResponse[T]) SetCenterDistance(center Location) {
+	for _, item := range r.Items {
+		if d, ok := any(item).(CenterDistancer); ok {
+			d.SetCenterDistance(center)
+		}
+	}
+}
+
+// CenterDistanceKm is the great-circle distance from center to l in kilometers, rounded to
+// two decimal places (10 m)
+func CenterDistanceKm(center, l Location) float64 {
+	return math.Round(center.DistanceKm(l)*100) / 100
+}
+
+// ParseInclude splits the comma-separated include query parameter into its values. An empty
+// parameter includes nothing.
+func ParseInclude(include string) ([]string, error) {
+	if include == "" {
+		return nil, nil
+	}
+	values := strings.Split(include, ",")
+	for i, value := range values {
+		values[i] = strings.TrimSpace(value)
+		if !slices.Contains(Includes, values[i]) {
+			return nil, ierr.NewErrorf("invalid include: %s", values[i]).
+				WithHintf("Supported include values are: %s", strings.Join(Includes, ", ")).
+				WithReportableDetails(map[string]any{
+					"include": values[i],
+					"allowed": Includes,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"slices"
+	"testing"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/shopspring/decimal"
+)
+
+func TestCenterDistanceKm(t *testing.T) {
+	tests := []struct {
+		name     string
+		lat, lng string
+		want     float64
+	}{
+		{name: "the center itself", lat: "19.9975", lng: "73.7898", want: 0},
+		{name: "Ramkund", lat: "20.0077", lng: "73.7920", want: 1.16},
+		{name: "Sula Vineyards", lat: "20.0059", lng: "73.6855", want: 10.94},
+		{name: "Trimbakeshwar", lat: "19.9323", lng: "73.5310", want: 28.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			place := *NewLocation(decimal.RequireFromString(tt.lat), decimal.RequireFromString(tt.lng))
+			if got := CenterDistanceKm(DefaultCityCenter, place); got != tt.want {
+				t.Errorf("CenterDistanceKm() = %v, want %v", got, tt.want)
+			}
+			if got := CenterDistanceKm(place, DefaultCityCenter); got != tt.want {
+				t.Errorf("CenterDistanceKm() from the place = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInclude(t *testing.T) {
+	tests := []struct {
+		name    string
+		include string
+		want    []string
+		wantErr bool
+	}{
+		{name: "empty", include: ""},
+		{name: "center distance", include: "center_distance", want: []string{IncludeCenterDistance}},
+		{name: "padded", include: " center_distance ", want: []string{IncludeCenterDistance}},
+		{name: "unknown", include: "weather", wantErr: true},
+		{name: "unknown among known", include: "center_distance,weather", wantErr: true},
+		{name: "trailing comma", include: "center_distance,", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseInclude(tt.include)
+			if tt.wantErr {
+				if !ierr.IsValidation(err) {
+					t.Fatalf("ParseInclude(%q) error = %v, want validation error", tt.include, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseInclude(%q) error = %v", tt.include, err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ParseInclude(%q) = %v, want %v", tt.include, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// Clock tells the current time. Services read time through a Clock so it can be fixed in tests.
+type Clock interface {
+	Now() time.Time
+}
+
+// IDGenerator creates entity ids. Services create ids through an IDGenerator so they can be
+// made deterministic in tests.
+type IDGenerator interface {
+	// NewID returns a new id with the given prefix, in the format of GenerateUUIDWithPrefix
+	NewID(prefix string) string
+}
+
+// SystemClock is the real Clock, reporting the current time in UTC
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return NowUTC()
+}
+
+// ULIDGenerator is the real IDGenerator, creating random k-sortable ids
+type ULIDGenerator struct{}
+
+func (ULIDGenerator) NewID(prefix string) string {
+	return GenerateUUIDWithPrefix(prefix)
+}
+
+// FixedClock is a Clock stopped at T
+type FixedClock struct {
+	T time.Time
+}
+
+func (c FixedClock) Now() time.Time {
+	return c.T
+}
+
+// SequentialIDGenerator is an IDGenerator that numbers ids 1, 2, 3 and so on, across all
+// prefixes. The ids are valid ULIDs with a zero timestamp, so they pass ValidateUUIDWithPrefix
+// and sort in creation order. The zero value is ready to use.
+type SequentialIDGenerator struct {
+	next atomic.Uint64
+}
+
+func (g *SequentialIDGenerator) NewID(prefix string) string {
+	var id ulid.ULID
+	binary.BigEndian.PutUint64(id[8:], g.next.Add(1))
+	if prefix == "" {
+		return id.String()
+	}
+	return fmt.Sprintf("%s_%s", prefix, id.String())
+}
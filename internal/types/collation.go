@@ -0,0 +1,73 @@
+package types
+
+import (
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// Collation selects the language rules used to order place titles when sorting by title
+type Collation string
+
+const (
+	// CollationRoot orders by the language-neutral Unicode (CLDR root) rules, which interleave
+	// accented letters with their base letters and keep each script together
+	CollationRoot Collation = "und"
+	// CollationMarathi orders Devanagari by Marathi rules
+	CollationMarathi Collation = "mr"
+	// CollationHindi orders Devanagari by Hindi rules
+	CollationHindi Collation = "hi"
+	// CollationEnglish orders Latin titles by English rules
+	CollationEnglish Collation = "en"
+	// CollationBinary orders by the raw UTF-8 bytes of the title
+	CollationBinary Collation = "binary"
+)
+
+// Collations lists the supported title collations
+var Collations = []Collation{
+	CollationRoot,
+	CollationMarathi,
+	CollationHindi,
+	CollationEnglish,
+	CollationBinary,
+}
+
+// collationLocales maps the ICU collations to their locale
+var collationLocales = map[Collation]string{
+	CollationRoot:    "und",
+	CollationMarathi: "mr-IN",
+	CollationHindi:   "hi-IN",
+	CollationEnglish: "en-IN",
+}
+
+func (c Collation) String() string {
+	return string(c)
+}
+
+func (c Collation) Validate() error {
+	for _, allowed := range Collations {
+		if c == allowed {
+			return nil
+		}
+	}
+	return ierr.NewErrorf("invalid collation: %s", c).
+		WithHintf("Supported collations are: %s, %s, %s, %s, %s",
+			CollationRoot, CollationMarathi, CollationHindi, CollationEnglish, CollationBinary).
+		WithReportableDetails(map[string]any{
+			"collation": c,
+			"allowed":   Collations,
+		}).
+		Mark(ierr.ErrValidation)
+}
+
+// ICULocale returns the ICU locale of the collation, or "" for the binary collation
+func (c Collation) ICULocale() string {
+	return collationLocales[c]
+}
+
+// SQLName returns the name of the database collation created for c, or "" for the binary
+// collation, which needs none
+func (c Collation) SQLName() string {
+	if c.ICULocale() == "" {
+		return ""
+	}
+	return "title_" + string(c)
+}
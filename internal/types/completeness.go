@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/samber/lo"
+)
+
+// DefaultCompletenessWeights weighs the checked place fields in the completeness score. The
+// weights are relative; they need not add up to 100.
+var DefaultCompletenessWeights = map[string]int{
+	string(PlaceFieldPrimaryImage):    25,
+	string(PlaceFieldLongDescription): 25,
+	string(PlaceFieldCoordinates):     20,
+	string(PlaceFieldCategories):      15,
+	string(PlaceFieldContact):         15,
+}
+
+// completenessWeights are the configured weights, see SetCompletenessWeights
+var completenessWeights = DefaultCompletenessWeights
+
+// SetCompletenessWeights configures the weights of the place completeness score. Fields left
+// out weigh nothing; an empty map keeps the defaults.
+func SetCompletenessWeights(weights map[string]int) {
+	if len(weights) > 0 {
+		completenessWeights = weights
+	}
+}
+
+// CompletenessWeight returns the configured weight of field
+func CompletenessWeight(field PlaceField) int {
+	return completenessWeights[string(field)]
+}
+
+// CompletenessScore returns the weighted share (0-100, rounded down) of the checked fields
+// that are not missing
+func CompletenessScore(missing []PlaceField) int {
+	total, present := 0, 0
+	for _, field := range PlaceFields {
+		weight := completenessWeights[field]
+		total += weight
+		if !lo.Contains(missing, PlaceField(field)) {
+			present += weight
+		}
+	}
+	if total == 0 {
+		return 100
+	}
+	return 100 * present / total
+}
+
+// ValidateCompletenessWeights checks that weights name checked fields only, are not negative
+// and do not all weigh nothing
+func ValidateCompletenessWeights(weights map[string]int) error {
+	if len(weights) == 0 {
+		return nil
+	}
+	total := 0
+	for field, weight := range weights {
+		if err := PlaceField(field).Validate(); err != nil {
+			return fmt.Errorf("unknown field %q", field)
+		}
+		if weight < 0 {
+			return fmt.Errorf("%s must not be negative", field)
+		}
+		total += weight
+	}
+	if total == 0 {
+		return fmt.Errorf("at least one field must weigh more than 0")
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/omkar273/nashikdarshan/internal/validator"
+)
+
+// Contact holds the ways visitors can reach a place
+type Contact struct {
+	// Phone is in E.164 format; bare 10-digit Indian numbers are normalized to +91
+	Phone   *string `json:"phone,omitempty"`
+	Website *string `json:"website,omitempty"`
+	Email   *string `json:"email,omitempty"`
+}
+
+// Normalize trims the fields, drops empty ones and rewrites Indian phone numbers to E.164
+func (c *Contact) Normalize() {
+	if c == nil {
+		return
+	}
+
+	trim := func(v *string) *string {
+		if v == nil {
+			return nil
+		}
+		trimmed := strings.TrimSpace(*v)
+		if trimmed == "" {
+			return nil
+		}
+		return &trimmed
+	}
+
+	c.Phone = trim(c.Phone)
+	c.Website = trim(c.Website)
+	c.Email = trim(c.Email)
+
+	if c.Phone != nil {
+		phone := validator.NormalizeIndianPhone(*c.Phone)
+		c.Phone = &phone
+	}
+}
+
+// Validate checks each field that is set, reporting the first invalid one
+func (c *Contact) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.Phone != nil {
+		if err := validator.ValidatePhoneE164("contact.phone", *c.Phone); err != nil {
+			return err
+		}
+	}
+	if c.Website != nil {
+		if err := validator.ValidateWebsiteURL("contact.website", *c.Website); err != nil {
+			return err
+		}
+	}
+	if c.Email != nil {
+		if err := validator.ValidateEmail("contact.email", *c.Email); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsEmpty reports whether no contact field is set
+func (c *Contact) IsEmpty() bool {
+	return c == nil || (c.Phone == nil && c.Website == nil && c.Email == nil)
+}
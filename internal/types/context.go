@@ -11,11 +11,42 @@ const (
 	CtxJWT           ContextKey = "ctx_jwt"
 	CtxUserEmail     ContextKey = "ctx_user_email"
 	CtxDBTransaction ContextKey = "ctx_db_transaction"
+	CtxAPIKeyID      ContextKey = "ctx_api_key_id"
+	CtxAPIKeyLabel   ContextKey = "ctx_api_key_label"
+	CtxScopes        ContextKey = "ctx_scopes"
+	CtxListEnvelope  ContextKey = "ctx_list_envelope"
+	CtxServerTiming  ContextKey = "ctx_server_timing"
 
 	// Default values
-	DefaultUserID = "00000000-0000-0000-0000-000000000000"
+	DefaultUserID      = "00000000-0000-0000-0000-000000000000"
+	DefaultSystemActor = "system"
+
+	// APIKeyActorPrefix prefixes the label of an API key when it is recorded as an actor
+	APIKeyActorPrefix = "apikey:"
 )
 
+// systemActor is recorded on writes made without an authenticated principal
+var systemActor = DefaultSystemActor
+
+// SetSystemActor configures the actor recorded on writes made without an authenticated principal
+func SetSystemActor(actor string) {
+	if actor != "" {
+		systemActor = actor
+	}
+}
+
+// GetActor returns the principal to record in created_by/updated_by.
+// Precedence: user ID from the JWT, then the API key label, then the configured system actor.
+func GetActor(ctx context.Context) string {
+	if userID := GetUserID(ctx); userID != "" {
+		return userID
+	}
+	if label, ok := ctx.Value(CtxAPIKeyLabel).(string); ok && label != "" {
+		return APIKeyActorPrefix + label
+	}
+	return systemActor
+}
+
 func GetUserID(ctx context.Context) string {
 	if userID, ok := ctx.Value(CtxUserID).(string); ok {
 		return userID
@@ -43,3 +74,19 @@ func GetJWT(ctx context.Context) string {
 	}
 	return ""
 }
+
+// GetAPIKeyID returns the ID of the API key that authenticated the request, if any
+func GetAPIKeyID(ctx context.Context) string {
+	if apiKeyID, ok := ctx.Value(CtxAPIKeyID).(string); ok {
+		return apiKeyID
+	}
+	return ""
+}
+
+// GetScopes returns the scopes granted to the authenticated principal
+func GetScopes(ctx context.Context) []string {
+	if scopes, ok := ctx.Value(CtxScopes).([]string); ok {
+		return scopes
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"context"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// ListEnvelope selects the JSON shape of paginated list responses
+type ListEnvelope string
+
+const (
+	// ListEnvelopeFlat is the default shape: {items, pagination, links}
+	ListEnvelopeFlat ListEnvelope = "flat"
+	// ListEnvelopeDataMeta is the JSON-API style shape: {data, meta, links}
+	ListEnvelopeDataMeta ListEnvelope = "data-meta"
+)
+
+// ListEnvelopes lists the supported list envelope shapes
+var ListEnvelopes = []ListEnvelope{
+	ListEnvelopeFlat,
+	ListEnvelopeDataMeta,
+}
+
+func (e ListEnvelope) String() string {
+	return string(e)
+}
+
+func (e ListEnvelope) Validate() error {
+	for _, allowed := range ListEnvelopes {
+		if e == allowed {
+			return nil
+		}
+	}
+	return ierr.NewErrorf("invalid list envelope: %s", e).
+		WithHintf("Supported list envelopes are: %s, %s", ListEnvelopeFlat, ListEnvelopeDataMeta).
+		WithReportableDetails(map[string]any{
+			"envelope": e,
+			"allowed":  ListEnvelopes,
+		}).
+		Mark(ierr.ErrValidation)
+}
+
+// GetListEnvelope returns the list envelope negotiated for the request, defaulting to flat
+func GetListEnvelope(ctx context.Context) ListEnvelope {
+	if envelope, ok := ctx.Value(CtxListEnvelope).(ListEnvelope); ok && envelope != "" {
+		return envelope
+	}
+	return ListEnvelopeFlat
+}
+
+// Enveloper is implemented by list responses that can be rendered in either envelope shape
+type Enveloper interface {
+	Envelope(envelope ListEnvelope) any
+}
+
+// DataMetaResponse is the data-meta rendering of a ListResponse
+type DataMetaResponse[T any] struct {
+	Data  []T                `json:"data"`
+	Meta  PaginationResponse `json:"meta"`
+	Links *PaginationLinks   `json:"links,omitempty"`
+}
+
+// Envelope returns the response in the requested shape. The flat shape is the response itself.
+func (r *ListResponse[T]) Envelope(envelope ListEnvelope) any {
+	if envelope != ListEnvelopeDataMeta {
+		return r
+	}
+	return &DataMetaResponse[T]{
+		Data:  r.Items,
+		Meta:  r.Pagination,
+		Links: r.Links,
+	}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"time"
 
 	ierr "github.com/omkar273/nashikdarshan/internal/errors"
@@ -43,6 +44,101 @@ func (f Filter) GetExpand() Expand {
 	return NewExpand(f.Expand)
 }
 
+// -------- Sorting ---------
+
+const (
+	// SortFieldID is appended to every sort as the final tiebreaker so pagination is stable
+	SortFieldID = "id"
+	// MaxSortFields caps the number of keys in a multi-column sort
+	MaxSortFields = 5
+)
+
+// SortField is one key of a multi-column sort
+type SortField struct {
+	Field string
+	Order string
+}
+
+// ParseSort parses a comma-separated sort such as "title:asc,created_at:desc". Keys without
+// a direction use defaultOrder, so the single-column sort+order form keeps working.
+func ParseSort(sort, defaultOrder string) ([]SortField, error) {
+	if defaultOrder == "" {
+		defaultOrder = FILTER_DEFAULT_ORDER
+	}
+
+	parts := strings.Split(sort, ",")
+	if len(parts) > MaxSortFields {
+		return nil, ierr.NewErrorf("sort accepts at most %d keys", MaxSortFields).
+			WithHintf("Please sort by at most %d fields", MaxSortFields).
+			WithReportableDetails(map[string]any{"sort": sort}).
+			Mark(ierr.ErrValidation)
+	}
+
+	fields := make([]SortField, 0, len(parts))
+	seen := make(map[string]bool, len(parts))
+	for _, part := range parts {
+		field, order, hasOrder := strings.Cut(strings.TrimSpace(part), ":")
+		field = strings.TrimSpace(field)
+		order = strings.ToLower(strings.TrimSpace(order))
+		if !hasOrder {
+			order = defaultOrder
+		}
+
+		if field == "" {
+			return nil, ierr.NewError("sort contains an empty key").
+				WithHint("Use a comma-separated list such as title:asc,created_at:desc").
+				WithReportableDetails(map[string]any{"sort": sort}).
+				Mark(ierr.ErrValidation)
+		}
+		if order != OrderAsc && order != OrderDesc {
+			return nil, ierr.NewErrorf("invalid sort direction for %s: %s", field, order).
+				WithHint("Sort direction must be either 'asc' or 'desc'").
+				WithReportableDetails(map[string]any{"sort": sort}).
+				Mark(ierr.ErrValidation)
+		}
+		if seen[field] {
+			return nil, ierr.NewErrorf("duplicate sort key: %s", field).
+				WithHint("Each field can appear only once in sort").
+				WithReportableDetails(map[string]any{"sort": sort}).
+				Mark(ierr.ErrValidation)
+		}
+		seen[field] = true
+
+		fields = append(fields, SortField{Field: field, Order: order})
+	}
+
+	return fields, nil
+}
+
+// WithIDTiebreaker appends id ascending unless the sort already includes id
+func WithIDTiebreaker(fields []SortField) []SortField {
+	if lo.ContainsBy(fields, func(f SortField) bool { return f.Field == SortFieldID }) {
+		return fields
+	}
+	return append(fields, SortField{Field: SortFieldID, Order: OrderAsc})
+}
+
+// ValidateSort parses sort and checks every key against the sortable fields of an entity
+func ValidateSort(sort, defaultOrder string, allowed []string) error {
+	fields, err := ParseSort(sort, defaultOrder)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		if !lo.Contains(allowed, f.Field) {
+			return ierr.NewErrorf("cannot sort by %s", f.Field).
+				WithHintf("Sortable fields are: %s", strings.Join(allowed, ", ")).
+				WithReportableDetails(map[string]any{
+					"field":   f.Field,
+					"allowed": allowed,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+	return nil
+}
+
 // -------- New Base filter ---------
 
 // BaseFilter defines common filtering capabilities
@@ -57,7 +153,16 @@ type BaseFilter interface {
 	IsUnlimited() bool
 }
 
-// QueryFilter represents a generic query filter with optional fields
+// IncludeDeletedFilter is implemented by filters that can opt into returning soft-deleted rows
+type IncludeDeletedFilter interface {
+	GetIncludeDeleted() bool
+}
+
+// MaxQueryLimit is the largest page size a list accepts
+const MaxQueryLimit = 1000
+
+// QueryFilter represents a generic query filter with optional fields. A page can be asked for
+// either by limit and offset or by page and per_page, which translate into them.
 type QueryFilter struct {
 	Limit  *int    `json:"limit,omitempty" form:"limit" validate:"omitempty,min=1,max=1000"`
 	Offset *int    `json:"offset,omitempty" form:"offset" validate:"omitempty,min=0"`
@@ -65,6 +170,11 @@ type QueryFilter struct {
 	Sort   *string `json:"sort,omitempty" form:"sort"`
 	Order  *string `json:"order,omitempty" form:"order" validate:"omitempty,oneof=asc desc"`
 	Expand *string `json:"expand,omitempty" form:"expand"`
+
+	// Page is the 1-based page number; per_page, or else limit, is its size
+	Page *int `json:"page,omitempty" form:"page" validate:"omitempty,min=1"`
+	// PerPage is the page size when paging by number, clamped to MaxQueryLimit
+	PerPage *int `json:"per_page,omitempty" form:"per_page" validate:"omitempty,min=1"`
 }
 
 // DefaultQueryFilter defines default values for query filters
@@ -98,9 +208,14 @@ func NewNoLimitQueryFilter() *QueryFilter {
 	}
 }
 
+// IsPaged reports whether the page is asked for by number rather than by offset
+func (f QueryFilter) IsPaged() bool {
+	return f.Page != nil || f.PerPage != nil
+}
+
 // IsUnlimited returns true if this is an unlimited query
 func (f QueryFilter) IsUnlimited() bool {
-	return f.Limit == nil
+	return f.Limit == nil && !f.IsPaged()
 }
 
 // GetLimit returns the limit value or default if not set
@@ -108,14 +223,21 @@ func (f QueryFilter) GetLimit() int {
 	if f.IsUnlimited() {
 		return 0 // No limit for unlimited queries
 	}
+	if f.PerPage != nil {
+		return min(*f.PerPage, MaxQueryLimit)
+	}
 	if f.Limit == nil {
 		return *NewDefaultQueryFilter().Limit
 	}
 	return *f.Limit
 }
 
-// GetOffset returns the offset value or default if not set
+// GetOffset returns the offset value or default if not set. Pages by number start at
+// (page-1) * limit.
 func (f QueryFilter) GetOffset() int {
+	if f.Page != nil {
+		return (max(*f.Page, 1) - 1) * f.GetLimit()
+	}
 	if f.Offset == nil {
 		return *NewDefaultQueryFilter().Offset
 	}
@@ -172,6 +294,41 @@ func (f QueryFilter) Validate() error {
 			},
 		).Mark(ierr.ErrValidation)
 	}
+	if f.Page != nil && *f.Page < 1 {
+		return ierr.NewError("page must be at least 1").WithReportableDetails(
+			map[string]any{
+				"page": "must be at least 1",
+			},
+		).Mark(ierr.ErrValidation)
+	}
+	if f.PerPage != nil && *f.PerPage < 1 {
+		return ierr.NewError("per_page must be at least 1").WithReportableDetails(
+			map[string]any{
+				"per_page": "must be at least 1",
+			},
+		).Mark(ierr.ErrValidation)
+	}
+	if f.IsPaged() && f.Offset != nil {
+		return ierr.NewError("page and per_page cannot be combined with offset").
+			WithHint("Page either by page and per_page or by limit and offset").
+			WithReportableDetails(map[string]any{
+				"offset": "cannot be combined with page or per_page",
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	if f.PerPage != nil && f.Limit != nil {
+		return ierr.NewError("per_page cannot be combined with limit").
+			WithHint("Page either by page and per_page or by limit and offset").
+			WithReportableDetails(map[string]any{
+				"limit": "cannot be combined with per_page",
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	if f.Status != nil {
+		if err := f.Status.Validate(); err != nil {
+			return err
+		}
+	}
 	if f.Order != nil && *f.Order != "asc" && *f.Order != "desc" {
 		return ierr.NewError("order must be either 'asc' or 'desc'").WithReportableDetails(
 			map[string]any{
@@ -187,6 +344,31 @@ type TimeRangeFilter struct {
 	EndTime   *time.Time `json:"end_time,omitempty" form:"end_time" validate:"omitempty,time_rfc3339"`
 }
 
+// ContributorFilter narrows a listing to the rows a user created or last updated, optionally
+// only those updated since a given time
+type ContributorFilter struct {
+	CreatedBy    *string    `json:"created_by,omitempty" form:"created_by" validate:"omitempty"`
+	UpdatedBy    *string    `json:"updated_by,omitempty" form:"updated_by" validate:"omitempty"`
+	UpdatedSince *time.Time `json:"updated_since,omitempty" form:"updated_since" validate:"omitempty"`
+}
+
+// HasContributorFilters reports whether any contributor filter is set
+func (f ContributorFilter) HasContributorFilters() bool {
+	return f.CreatedBy != nil || f.UpdatedBy != nil || f.UpdatedSince != nil
+}
+
+// Actors returns the user IDs the filter narrows to
+func (f ContributorFilter) Actors() []string {
+	actors := make([]string, 0, 2)
+	if f.CreatedBy != nil {
+		actors = append(actors, *f.CreatedBy)
+	}
+	if f.UpdatedBy != nil {
+		actors = append(actors, *f.UpdatedBy)
+	}
+	return actors
+}
+
 // Validate validates the time range filter
 func (f TimeRangeFilter) Validate() error {
 	if f.StartTime != nil && f.EndTime != nil && f.EndTime.Before(*f.StartTime) {
@@ -210,6 +392,23 @@ func (f *QueryFilter) Merge(other QueryFilter) {
 	if other.Offset != nil {
 		f.Offset = other.Offset
 	}
+	if other.Page != nil {
+		f.Page = other.Page
+	}
+	if other.PerPage != nil {
+		f.PerPage = other.PerPage
+	}
+	// The page is asked for one way only, so the way other uses replaces the other one
+	if other.IsPaged() {
+		f.Offset = nil
+	} else if other.Offset != nil {
+		f.Page = nil
+	}
+	if other.PerPage != nil {
+		f.Limit = nil
+	} else if other.Limit != nil {
+		f.PerPage = nil
+	}
 	if other.Status != nil {
 		f.Status = other.Status
 	}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// MaxGeofenceRadiusM caps the radius of a place geofence. Temple complexes and ghats fit
+// comfortably; anything larger would cover neighbouring places too.
+const MaxGeofenceRadiusM = 5000
+
+// ValidateGeofenceRadius checks that a place geofence radius, when set, is a positive
+// number of meters no larger than MaxGeofenceRadiusM
+func ValidateGeofenceRadius(radiusM *int) error {
+	if radiusM == nil {
+		return nil
+	}
+	if *radiusM <= 0 || *radiusM > MaxGeofenceRadiusM {
+		return ierr.NewErrorf("geofence_radius_m must be between 1 and %d", MaxGeofenceRadiusM).
+			WithHintf("Please provide a geofence radius between 1 and %d meters", MaxGeofenceRadiusM).
+			WithReportableDetails(map[string]any{
+				"geofence_radius_m": *radiusM,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+const (
+	// MinGeohashPrecision and MaxGeohashPrecision bound the length of a geohash. At 12
+	// characters a cell is a few centimeters across, finer than stored coordinates.
+	MinGeohashPrecision = 1
+	MaxGeohashPrecision = 12
+	// DefaultGeohashPrecision gives cells of about 150 x 150 m, a city block
+	DefaultGeohashPrecision = 7
+)
+
+// geohashAlphabet is the base32 alphabet of geohashes, which leaves out a, i, l and o
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// geohashPrecision is the configured precision of place geohashes, see SetGeohashPrecision
+var geohashPrecision = DefaultGeohashPrecision
+
+// SetGeohashPrecision configures the length of the geohash in place responses
+func SetGeohashPrecision(precision int) {
+	geohashPrecision = precision
+}
+
+// GeohashPrecision returns the configured geohash precision
+func GeohashPrecision() int {
+	return geohashPrecision
+}
+
+// Geohasher is implemented by responses carrying places whose geohash can be recomputed at
+// another precision
+type Geohasher interface {
+	SetGeohash(precision int)
+}
+
+// SetGeohash recomputes the geohash of every item that supports it
+func (r *ListResponse[T]) SetGeohash(precision int) {
+	for _, item := range r.Items {
+		if g, ok := any(item).(Geohasher); ok {
+			g.SetGeohash(precision)
+		}
+	}
+}
+
+// Geohash encodes the point as a geohash of precision characters, clamped to
+// [MinGeohashPrecision, MaxGeohashPrecision]. Points sharing a prefix lie in the same cell,
+// which suits clustering and cache keys; central Nashik (73.7898, 19.9975) is tes3z0k.
+func (p Point) Geohash(precision int) string {
+	precision = min(max(precision, MinGeohashPrecision), MaxGeohashPrecision)
+
+	lngRange := [2]float64{-180, 180}
+	latRange := [2]float64{-90, 90}
+	lng, lat := p.Coordinates[0], p.Coordinates[1]
+
+	var b strings.Builder
+	b.Grow(precision)
+	// Bits alternate longitude, latitude, starting with longitude; five make a character
+	even := true
+	for b.Len() < precision {
+		index := 0
+		for bit := 0; bit < 5; bit++ {
+			index <<= 1
+			r, v := &latRange, lat
+			if even {
+				r, v = &lngRange, lng
+			}
+			mid := (r[0] + r[1]) / 2
+			if v >= mid {
+				index |= 1
+				r[0] = mid
+			} else {
+				r[1] = mid
+			}
+			even = !even
+		}
+		b.WriteByte(geohashAlphabet[index])
+	}
+	return b.String()
+}
+
+// Geohash encodes the location as a geohash, see Point.Geohash
+func (l Location) Geohash(precision int) string {
+	return l.ToPoint().Geohash(precision)
+}
+
+// DecodeGeohash returns the cell a geohash stands for. Any point in the cell encodes to the
+// hash; the cell's center is the usual single-point decoding. The hash is case-insensitive.
+func DecodeGeohash(hash string) (BBox, error) {
+	if len(hash) < MinGeohashPrecision || len(hash) > MaxGeohashPrecision {
+		return BBox{}, ierr.NewErrorf("invalid geohash: %q", hash).
+			WithHintf("A geohash has %d to %d characters", MinGeohashPrecision, MaxGeohashPrecision).
+			Mark(ierr.ErrValidation)
+	}
+
+	lngRange := [2]float64{-180, 180}
+	latRange := [2]float64{-90, 90}
+	even := true
+	for _, c := range strings.ToLower(hash) {
+		index := strings.IndexRune(geohashAlphabet, c)
+		if index < 0 {
+			return BBox{}, ierr.NewErrorf("invalid geohash: %q", hash).
+				WithHintf("%s is not a geohash character; geohashes use %s", strconv.QuoteRune(c), geohashAlphabet).
+				Mark(ierr.ErrValidation)
+		}
+		for bit := 4; bit >= 0; bit-- {
+			r := &latRange
+			if even {
+				r = &lngRange
+			}
+			mid := (r[0] + r[1]) / 2
+			if index>>bit&1 == 1 {
+				r[0] = mid
+			} else {
+				r[1] = mid
+			}
+			even = !even
+		}
+	}
+
+	return BBox{MinLng: lngRange[0], MinLat: latRange[0], MaxLng: lngRange[1], MaxLat: latRange[1]}, nil
+}
+
+// Center returns the middle of the box
+func (b BBox) Center() Point {
+	return Point{Type: GeoJSONTypePoint, Coordinates: [2]float64{(b.MinLng + b.MaxLng) / 2, (b.MinLat + b.MaxLat) / 2}}
+}
+
+// Contains reports whether the point lies in the box, edges included
+func (b BBox) Contains(p Point) bool {
+	lng, lat := p.Coordinates[0], p.Coordinates[1]
+	return lng >= b.MinLng && lng <= b.MaxLng && lat >= b.MinLat && lat <= b.MaxLat
+}
+
+// ParseGeohashPrecision checks the geohash_precision query parameter. An empty value is
+// the configured precision.
+func ParseGeohashPrecision(value string) (int, error) {
+	if value == "" {
+		return geohashPrecision, nil
+	}
+	precision, err := strconv.Atoi(value)
+	if err != nil || precision < MinGeohashPrecision || precision > MaxGeohashPrecision {
+		return 0, ierr.NewErrorf("invalid geohash precision: %s", value).
+			WithHintf("geohash_precision must be a whole number from %d to %d", MinGeohashPrecision, MaxGeohashPrecision).
+			WithReportableDetails(map[string]any{
+				"geohash_precision": value,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return precision, nil
+}
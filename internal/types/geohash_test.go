@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+func TestGeohash(t *testing.T) {
+	tests := []struct {
+		name      string
+		lng, lat  float64
+		precision int
+		want      string
+	}{
+		{name: "nashik", lng: 73.7898, lat: 19.9975, precision: 7, want: "tes3z0k"},
+		{name: "reference cell", lng: -5.6, lat: 42.6, precision: 5, want: "ezs42"},
+		{name: "reference point", lng: 10.40744, lat: 57.64911, precision: 11, want: "u4pruydqqvj"},
+		{name: "origin", lng: 0, lat: 0, precision: 4, want: "s000"},
+		{name: "south west corner", lng: -180, lat: -90, precision: 3, want: "000"},
+		{name: "precision clamped up", lng: 73.7898, lat: 19.9975, precision: 0, want: "t"},
+		{name: "precision clamped down", lng: 73.7898, lat: 19.9975, precision: 20, want: "tes3z0kjfuny"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Point{Type: GeoJSONTypePoint, Coordinates: [2]float64{tt.lng, tt.lat}}
+			got := p.Geohash(tt.precision)
+			if got != tt.want {
+				t.Fatalf("Geohash(%d) = %s, want %s", tt.precision, got, tt.want)
+			}
+
+			cell, err := DecodeGeohash(got)
+			if err != nil {
+				t.Fatalf("DecodeGeohash(%s) error = %v", got, err)
+			}
+			if !cell.Contains(p) {
+				t.Errorf("DecodeGeohash(%s) = %+v, does not contain %v", got, cell, p.Coordinates)
+			}
+			if center := cell.Center().Geohash(len(got)); center != got {
+				t.Errorf("center of %s encodes to %s", got, center)
+			}
+		})
+	}
+}
+
+func TestDecodeGeohash(t *testing.T) {
+	tests := []struct {
+		name    string
+		hash    string
+		want    BBox
+		wantErr bool
+	}{
+		{name: "first cell", hash: "0", want: BBox{MinLng: -180, MinLat: -90, MaxLng: -135, MaxLat: -45}},
+		{name: "last cell", hash: "z", want: BBox{MinLng: 135, MinLat: 45, MaxLng: 180, MaxLat: 90}},
+		{name: "uppercase", hash: "S", want: BBox{MinLng: 0, MinLat: 0, MaxLng: 45, MaxLat: 45}},
+		{name: "empty", hash: "", wantErr: true},
+		{name: "too long", hash: "tes3z0k12345a", wantErr: true},
+		{name: "excluded letter", hash: "tes3a", wantErr: true},
+		{name: "punctuation", hash: "tes-3", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeGeohash(tt.hash)
+			if tt.wantErr {
+				if !ierr.IsValidation(err) {
+					t.Fatalf("DecodeGeohash(%q) error = %v, want validation error", tt.hash, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DecodeGeohash(%q) error = %v", tt.hash, err)
+			}
+			if got != tt.want {
+				t.Errorf("DecodeGeohash(%q) = %+v, want %+v", tt.hash, got, tt.want)
+			}
+		})
+	}
+}
@@ -4,4 +4,17 @@ const (
 	HeaderEnvironment   = "X-Environment-ID"
 	HeaderRequestID     = "X-Request-ID"
 	HeaderAuthorization = "Authorization"
+	HeaderAPIKey        = "X-API-Key"
+
+	// HeaderRetryAfter is the whole number of seconds a client should wait before retrying
+	// a 429 or 503 response
+	HeaderRetryAfter = "Retry-After"
+	// HeaderRateLimitLimit is the number of requests a client may burst at once
+	HeaderRateLimitLimit = "X-RateLimit-Limit"
+	// HeaderRateLimitRemaining is the number of requests the client may make right now
+	// before being throttled; always 0 on a 429
+	HeaderRateLimitRemaining = "X-RateLimit-Remaining"
+	// HeaderRateLimitReset is the whole number of seconds until the client's allowance is
+	// fully restored, as a delay rather than a timestamp so client clock skew does not matter
+	HeaderRateLimitReset = "X-RateLimit-Reset"
 )
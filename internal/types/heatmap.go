@@ -0,0 +1,83 @@
+package types
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// MaxHeatmapCells caps the grid cells a heatmap may span, so a wide bbox with a fine cell size
+// cannot make the server aggregate and return an unbounded grid
+const MaxHeatmapCells = 10000
+
+// HeatmapWeight selects what each heatmap cell sums
+type HeatmapWeight string
+
+const (
+	// HeatmapWeightCount weighs each cell by its number of places (default)
+	HeatmapWeightCount HeatmapWeight = "count"
+	// HeatmapWeightViews weighs each cell by the summed view counts of its places
+	HeatmapWeightViews HeatmapWeight = "views"
+)
+
+func (w HeatmapWeight) Validate() error {
+	if w != HeatmapWeightCount && w != HeatmapWeightViews {
+		return ierr.NewErrorf("invalid weight: %s", w).
+			WithHintf("Supported weights are: %s, %s", HeatmapWeightCount, HeatmapWeightViews).
+			WithReportableDetails(map[string]any{"weight": w}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// BBox is a box in degrees, in GeoJSON order
+type BBox struct {
+	MinLng float64
+	MinLat float64
+	MaxLng float64
+	MaxLat float64
+}
+
+// ParseBBox parses a bbox query parameter: min_lng,min_lat,max_lng,max_lat
+func ParseBBox(s string) (BBox, error) {
+	parts := strings.Split(s, ",")
+	invalid := func(reason string) error {
+		return ierr.NewErrorf("invalid bbox: %s", reason).
+			WithHint("Please provide bbox as min_lng,min_lat,max_lng,max_lat, e.g. 73.70,19.90,73.90,20.10").
+			WithReportableDetails(map[string]any{"bbox": s}).
+			Mark(ierr.ErrValidation)
+	}
+	if len(parts) != 4 {
+		return BBox{}, invalid("expected four comma-separated numbers")
+	}
+
+	var values [4]float64
+	for i, part := range parts {
+		v, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return BBox{}, invalid("not a number: " + part)
+		}
+		values[i] = v
+	}
+
+	box := BBox{MinLng: values[0], MinLat: values[1], MaxLng: values[2], MaxLat: values[3]}
+	switch {
+	case box.MinLng < -180 || box.MaxLng > 180:
+		return BBox{}, invalid("longitudes must be between -180 and 180")
+	case box.MinLat < -90 || box.MaxLat > 90:
+		return BBox{}, invalid("latitudes must be between -90 and 90")
+	case box.MinLng >= box.MaxLng || box.MinLat >= box.MaxLat:
+		return BBox{}, invalid("the minimum corner must be south-west of the maximum corner")
+	}
+	return box, nil
+}
+
+// GridCells returns how many cells of cellSize degrees the box spans. Points snap to the
+// nearest grid node, so each axis has one node more than it has whole cells.
+func (b BBox) GridCells(cellSize float64) float64 {
+	cols := math.Ceil((b.MaxLng-b.MinLng)/cellSize) + 1
+	rows := math.Ceil((b.MaxLat-b.MinLat)/cellSize) + 1
+	return cols * rows
+}
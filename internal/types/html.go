@@ -0,0 +1,63 @@
+package types
+
+import (
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// HTMLPolicy decides which markup survives in user-supplied rich text
+type HTMLPolicy string
+
+const (
+	// HTMLPolicyStrip removes every tag and keeps only the text
+	HTMLPolicyStrip HTMLPolicy = "strip"
+	// HTMLPolicyBasic keeps basic formatting: paragraphs, emphasis, lists, headings and links
+	HTMLPolicyBasic HTMLPolicy = "basic"
+)
+
+// HTMLPolicies lists the supported HTML policies
+var HTMLPolicies = []HTMLPolicy{
+	HTMLPolicyStrip,
+	HTMLPolicyBasic,
+}
+
+func (p HTMLPolicy) String() string {
+	return string(p)
+}
+
+func (p HTMLPolicy) Validate() error {
+	for _, allowed := range HTMLPolicies {
+		if p == allowed {
+			return nil
+		}
+	}
+	return ierr.NewErrorf("invalid html policy: %s", p).
+		WithHintf("Supported html policies are: %s, %s", HTMLPolicyStrip, HTMLPolicyBasic).
+		WithReportableDetails(map[string]any{
+			"policy":  p,
+			"allowed": HTMLPolicies,
+		}).
+		Mark(ierr.ErrValidation)
+}
+
+// RenderHTML is the value of the render query parameter that adds HTML renderings of
+// Markdown fields to a response
+const RenderHTML = "html"
+
+// HTMLRenderer is implemented by responses with Markdown fields that can be rendered to HTML
+type HTMLRenderer interface {
+	RenderHTML()
+}
+
+// ValidateRender checks the render query parameter. An empty value renders nothing.
+func ValidateRender(render string) error {
+	if render == "" || render == RenderHTML {
+		return nil
+	}
+	return ierr.NewErrorf("invalid render format: %s", render).
+		WithHintf("Supported render formats are: %s", RenderHTML).
+		WithReportableDetails(map[string]any{
+			"render":  render,
+			"allowed": []string{RenderHTML},
+		}).
+		Mark(ierr.ErrValidation)
+}
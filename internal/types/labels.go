@@ -0,0 +1,118 @@
+package types
+
+import (
+	"golang.org/x/text/language"
+)
+
+// Language is a language enum labels are translated into
+type Language string
+
+const (
+	// LanguageEnglish is the fallback language of every label
+	LanguageEnglish Language = "en"
+	// LanguageMarathi is the local language of Nashik
+	LanguageMarathi Language = "mr"
+	// LanguageHindi is offered to visitors from outside Maharashtra
+	LanguageHindi Language = "hi"
+)
+
+// Languages lists the label languages, the fallback first
+var Languages = []Language{
+	LanguageEnglish,
+	LanguageMarathi,
+	LanguageHindi,
+}
+
+// languageMatcher picks the best label language for an Accept-Language header
+var languageMatcher = language.NewMatcher([]language.Tag{
+	language.English,
+	language.Marathi,
+	language.Hindi,
+})
+
+// placeTypeLabels translates the place types; every type has an English label
+var placeTypeLabels = map[PlaceType]map[Language]string{
+	PlaceTypeTemple: {
+		LanguageEnglish: "Temple",
+		LanguageMarathi: "मंदिर",
+		LanguageHindi:   "मंदिर",
+	},
+}
+
+// statusLabels translates the statuses; every status has an English label
+var statusLabels = map[Status]map[Language]string{
+	StatusPublished: {
+		LanguageEnglish: "Published",
+		LanguageMarathi: "प्रकाशित",
+		LanguageHindi:   "प्रकाशित",
+	},
+	StatusDraft: {
+		LanguageEnglish: "Draft",
+		LanguageMarathi: "मसुदा",
+		LanguageHindi:   "मसौदा",
+	},
+	StatusArchived: {
+		LanguageEnglish: "Archived",
+		LanguageMarathi: "संग्रहित",
+		LanguageHindi:   "संग्रहीत",
+	},
+	StatusDeleted: {
+		LanguageEnglish: "Deleted",
+		LanguageMarathi: "हटवले",
+		LanguageHindi:   "हटाया गया",
+	},
+}
+
+// Translator is implemented by responses whose enum values carry labels that can be presented
+// in another language
+type Translator interface {
+	Translate(lang Language)
+}
+
+// MatchLanguage returns the label language that best fits an Accept-Language header, or
+// English when none does or the header is empty or malformed
+func MatchLanguage(acceptLanguage string) Language {
+	if acceptLanguage == "" {
+		return LanguageEnglish
+	}
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return LanguageEnglish
+	}
+	_, index, confidence := languageMatcher.Match(tags...)
+	if confidence == language.No {
+		return LanguageEnglish
+	}
+	return Languages[index]
+}
+
+// Label returns the label of the place type in lang, falling back to English and then to the
+// value itself
+func (pt PlaceType) Label(lang Language) string {
+	return label(placeTypeLabels[pt], lang, string(pt))
+}
+
+// Label returns the label of the status in lang, falling back to English and then to the value
+// itself
+func (s Status) Label(lang Language) string {
+	return label(statusLabels[s], lang, string(s))
+}
+
+func label(labels map[Language]string, lang Language, value string) string {
+	if l, ok := labels[lang]; ok {
+		return l
+	}
+	if l, ok := labels[LanguageEnglish]; ok {
+		return l
+	}
+	return value
+}
+
+// Translate translates the labels of every item that supports it
+func (r *ListResponse[T]) Translate(lang Language) {
+	for _, item := range r.Items {
+		if t, ok := any(item).(Translator); ok {
+			t.Translate(lang)
+		}
+	}
+}
@@ -1,6 +1,12 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strings"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
 	"github.com/shopspring/decimal"
 )
 
@@ -10,6 +16,25 @@ type Location struct {
 	Longitude decimal.Decimal `json:"longitude"`
 }
 
+// ValidateCoordinates validates latitude and longitude values
+func ValidateCoordinates(latitude, longitude decimal.Decimal) error {
+	// Validate latitude range (-90 to 90)
+	if latitude.LessThan(decimal.NewFromInt(-90)) || latitude.GreaterThan(decimal.NewFromInt(90)) {
+		return ierr.NewError("latitude must be between -90 and 90").
+			WithHint("Please provide a valid latitude value").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Validate longitude range (-180 to 180)
+	if longitude.LessThan(decimal.NewFromInt(-180)) || longitude.GreaterThan(decimal.NewFromInt(180)) {
+		return ierr.NewError("longitude must be between -180 and 180").
+			WithHint("Please provide a valid longitude value").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
 // NewLocation creates a new Location
 func NewLocation(lat, lng decimal.Decimal) *Location {
 	return &Location{
@@ -32,3 +57,131 @@ func (l Location) IsValid() bool {
 func (l Location) IsZero() bool {
 	return l.Latitude.IsZero() && l.Longitude.IsZero()
 }
+
+// EarthRadiusKm is the mean Earth radius (WGS84) used for great-circle distances
+const EarthRadiusKm = 6371.0
+
+// DistanceKm returns the great-circle (haversine) distance to other in kilometers.
+// Coordinates are converted to float64 only once each, after which all trigonometry runs
+// in float64. A float64 carries ~15 significant digits, so an 8-decimal coordinate is
+// represented to within ~1e-14 degrees (well under a micrometre); the spherical Earth model,
+// not the conversion, dominates the error (up to ~0.5%).
+func (l Location) DistanceKm(other Location) float64 {
+	lat1 := l.Latitude.InexactFloat64() * math.Pi / 180
+	lat2 := other.Latitude.InexactFloat64() * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (other.Longitude.InexactFloat64() - l.Longitude.InexactFloat64()) * math.Pi / 180
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+
+	return EarthRadiusKm * c
+}
+
+// GeoJSONTypePoint is the GeoJSON geometry type of a Point
+const GeoJSONTypePoint = "Point"
+
+// Point is the GeoJSON form of a Location, with coordinates ordered [longitude, latitude].
+// It is a wire format only; Location stays the canonical representation.
+type Point struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+// ToPoint converts the location to a GeoJSON point. float64 holds 15-17 significant
+// digits, so coordinates stored with up to 8 decimal places convert exactly.
+func (l Location) ToPoint() Point {
+	return Point{
+		Type:        GeoJSONTypePoint,
+		Coordinates: [2]float64{l.Longitude.InexactFloat64(), l.Latitude.InexactFloat64()},
+	}
+}
+
+// FromPoint converts a GeoJSON point to a Location. Each coordinate is taken as the shortest
+// decimal that round-trips the float, so 19.9975 stays 19.9975 rather than gaining float noise.
+func FromPoint(p Point) Location {
+	return Location{
+		Latitude:  decimal.NewFromFloat(p.Coordinates[1]),
+		Longitude: decimal.NewFromFloat(p.Coordinates[0]),
+	}
+}
+
+// Validate validates the point through its Location
+func (p Point) Validate() error {
+	if p.Type != GeoJSONTypePoint {
+		return ierr.NewErrorf("invalid geometry type: %s", p.Type).
+			WithHintf("GeoJSON geometry type must be %s", GeoJSONTypePoint).
+			Mark(ierr.ErrValidation)
+	}
+	return FromPoint(p).Validate()
+}
+
+// IsValid returns true if the point is a GeoJSON Point with valid coordinates
+func (p Point) IsValid() bool {
+	return p.Validate() == nil
+}
+
+// UnmarshalJSON accepts each coordinate as a JSON number (19.9975) or a numeric string
+// ("19.9975"), then validates the range. Missing or null coordinates decode to zero.
+func (l *Location) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Latitude  json.RawMessage `json:"latitude"`
+		Longitude json.RawMessage `json:"longitude"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return ierr.WithError(err).
+			WithHint("location must be an object with latitude and longitude").
+			Mark(ierr.ErrValidation)
+	}
+
+	lat, err := ParseCoordinate("latitude", raw.Latitude)
+	if err != nil {
+		return err
+	}
+	lng, err := ParseCoordinate("longitude", raw.Longitude)
+	if err != nil {
+		return err
+	}
+
+	decoded := Location{Latitude: lat, Longitude: lng}
+	if err := decoded.Validate(); err != nil {
+		return err
+	}
+	*l = decoded
+	return nil
+}
+
+// ParseCoordinate decodes a raw JSON coordinate given either as a number or as a numeric
+// string. Empty and null values decode to zero; anything else non-numeric is ErrValidation.
+func ParseCoordinate(name string, raw json.RawMessage) (decimal.Decimal, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return decimal.Zero, nil
+	}
+
+	value := string(raw)
+	if raw[0] == '"' {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return decimal.Zero, invalidCoordinate(name, value)
+		}
+		value = strings.TrimSpace(s)
+	}
+
+	coordinate, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, invalidCoordinate(name, value)
+	}
+	return coordinate, nil
+}
+
+func invalidCoordinate(name, value string) error {
+	return ierr.NewErrorf("invalid %s: %s", name, value).
+		WithHintf("%s must be a number or a numeric string", name).
+		WithReportableDetails(map[string]any{
+			"field": name,
+			"value": value,
+		}).
+		Mark(ierr.ErrValidation)
+}
@@ -0,0 +1,214 @@
+package types
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"testing"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/shopspring/decimal"
+)
+
+func TestParseCoordinate(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{name: "number", raw: `19.9975`, want: "19.9975"},
+		{name: "negative number", raw: `-73.7898`, want: "-73.7898"},
+		{name: "integer", raw: `20`, want: "20"},
+		{name: "numeric string", raw: `"19.9975"`, want: "19.9975"},
+		{name: "padded numeric string", raw: `" 73.7898 "`, want: "73.7898"},
+		{name: "surrounding whitespace", raw: "  19.5\n", want: "19.5"},
+		{name: "empty", raw: ``, want: "0"},
+		{name: "null", raw: `null`, want: "0"},
+		{name: "non-numeric string", raw: `"north"`, wantErr: true},
+		{name: "empty string", raw: `""`, wantErr: true},
+		{name: "boolean", raw: `true`, wantErr: true},
+		{name: "object", raw: `{"value":1}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCoordinate("latitude", json.RawMessage(tt.raw))
+			if tt.wantErr {
+				if !ierr.IsValidation(err) {
+					t.Fatalf("ParseCoordinate(%s) error = %v, want validation error", tt.raw, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseCoordinate(%s) error = %v", tt.raw, err)
+			}
+			if !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Errorf("ParseCoordinate(%s) = %s, want %s", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocationPointRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		lat, lng string
+	}{
+		{name: "Ramkund, 6 decimals", lat: "20.007712", lng: "73.792034"},
+		{name: "Kalaram Temple, 7 decimals", lat: "20.0069513", lng: "73.7951842"},
+		{name: "8 decimals", lat: "19.99751234", lng: "73.78981234"},
+		{name: "micro-degrees near zero", lat: "-0.000001", lng: "0.000001"},
+		{name: "north pole, antimeridian east", lat: "90", lng: "180"},
+		{name: "south pole, antimeridian west", lat: "-90", lng: "-180"},
+		{name: "limits with 6 decimals", lat: "89.999999", lng: "-179.999999"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := Location{Latitude: decimal.RequireFromString(tt.lat), Longitude: decimal.RequireFromString(tt.lng)}
+
+			p := l.ToPoint()
+			if p.Type != GeoJSONTypePoint {
+				t.Errorf("Type = %s, want %s", p.Type, GeoJSONTypePoint)
+			}
+			// GeoJSON orders coordinates longitude first
+			if want := l.Longitude.InexactFloat64(); p.Coordinates[0] != want {
+				t.Errorf("Coordinates[0] = %v, want longitude %v", p.Coordinates[0], want)
+			}
+			if !p.IsValid() {
+				t.Errorf("%+v is not valid", p)
+			}
+
+			back := FromPoint(p)
+			if !back.Latitude.Equal(l.Latitude) || !back.Longitude.Equal(l.Longitude) {
+				t.Errorf("round trip = (%s, %s), want (%s, %s)", back.Latitude, back.Longitude, l.Latitude, l.Longitude)
+			}
+			// No float noise creeps into the decimal either
+			if back.Latitude.String() != l.Latitude.String() || back.Longitude.String() != l.Longitude.String() {
+				t.Errorf("round trip prints (%s, %s), want (%s, %s)", back.Latitude, back.Longitude, l.Latitude, l.Longitude)
+			}
+		})
+	}
+}
+
+func TestLocationValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		lat, lng string
+		wantErr  bool
+	}{
+		{name: "Nashik", lat: "19.9975", lng: "73.7898"},
+		{name: "limits", lat: "-90", lng: "180"},
+		{name: "latitude over", lat: "90.000001", lng: "0", wantErr: true},
+		{name: "longitude under", lat: "0", lng: "-180.000001", wantErr: true},
+		// float64 rounds this to exactly 90, so only the decimal check can refuse it; that is
+		// why validation runs on the Location and Point delegates to it, not the other way round
+		{name: "latitude over beyond float precision", lat: "90.00000000000000001", lng: "0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := Location{Latitude: decimal.RequireFromString(tt.lat), Longitude: decimal.RequireFromString(tt.lng)}
+			err := l.Validate()
+			if tt.wantErr {
+				if !ierr.IsValidation(err) {
+					t.Fatalf("Validate() error = %v, want validation error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestPointValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		point   Point
+		wantErr bool
+	}{
+		{name: "Ramkund", point: Point{Type: GeoJSONTypePoint, Coordinates: [2]float64{73.7920, 20.0077}}},
+		{name: "limits", point: Point{Type: GeoJSONTypePoint, Coordinates: [2]float64{-180, 90}}},
+		{name: "wrong type", point: Point{Type: "LineString", Coordinates: [2]float64{73.7920, 20.0077}}, wantErr: true},
+		{name: "latitude out of range", point: Point{Type: GeoJSONTypePoint, Coordinates: [2]float64{73.7920, 90.5}}, wantErr: true},
+		// Swapped coordinates put Nashik's longitude into the latitude
+		{name: "latitude first", point: Point{Type: GeoJSONTypePoint, Coordinates: [2]float64{20.0077, 173.7920}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.point.Validate()
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Validate() error = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !ierr.IsValidation(err) {
+				t.Errorf("Validate() error = %v, want validation error", err)
+			}
+			if tt.point.IsValid() != !tt.wantErr {
+				t.Errorf("IsValid() = %v, want %v", tt.point.IsValid(), !tt.wantErr)
+			}
+		})
+	}
+}
+
+// floatHaversineKm is the float64 path DistanceKm replaces: coordinates parsed straight into
+// float64 and run through the same formula
+func floatHaversineKm(t *testing.T, lat1, lng1, lat2, lng2 string) float64 {
+	t.Helper()
+	parse := func(s string) float64 {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			t.Fatalf("parse %s: %v", s, err)
+		}
+		return f * math.Pi / 180
+	}
+	phi1, phi2 := parse(lat1), parse(lat2)
+	dPhi, dLambda := phi2-phi1, parse(lng2)-parse(lng1)
+	a := math.Pow(math.Sin(dPhi/2), 2) + math.Cos(phi1)*math.Cos(phi2)*math.Pow(math.Sin(dLambda/2), 2)
+	return 2 * EarthRadiusKm * math.Asin(math.Sqrt(a))
+}
+
+func TestLocationDistanceKm(t *testing.T) {
+	// floatTolerance bounds the difference from the float path: both convert each coordinate
+	// to float64 once, so they agree to within rounding, far below a millimetre.
+	// approxTolerance bounds the difference from the rounded distance a map would show.
+	const (
+		floatTolerance  = 1e-9
+		approxTolerance = 0.01
+	)
+
+	ramkund := [2]string{"20.0077", "73.7920"}
+	tests := []struct {
+		name     string
+		from, to [2]string
+		approxKm float64
+	}{
+		{name: "Ramkund to Trimbakeshwar", from: ramkund, to: [2]string{"19.9323", "73.5310"}, approxKm: 28.54},
+		{name: "Ramkund to Kalaram Temple", from: ramkund, to: [2]string{"20.0069513", "73.7951842"}, approxKm: 0.34},
+		{name: "Ramkund to Sula Vineyards", from: ramkund, to: [2]string{"20.0059", "73.6855"}, approxKm: 11.13},
+		{name: "Ramkund to Pandavleni Caves", from: ramkund, to: [2]string{"19.9410", "73.7470"}, approxKm: 8.78},
+		{name: "Ramkund to Gateway of India", from: ramkund, to: [2]string{"18.9220", "72.8347"}, approxKm: 156.99},
+		{name: "same point", from: ramkund, to: ramkund, approxKm: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from := Location{Latitude: decimal.RequireFromString(tt.from[0]), Longitude: decimal.RequireFromString(tt.from[1])}
+			to := Location{Latitude: decimal.RequireFromString(tt.to[0]), Longitude: decimal.RequireFromString(tt.to[1])}
+
+			got := from.DistanceKm(to)
+			if want := floatHaversineKm(t, tt.from[0], tt.from[1], tt.to[0], tt.to[1]); math.Abs(got-want) > floatTolerance {
+				t.Errorf("DistanceKm() = %v, float path = %v", got, want)
+			}
+			if math.Abs(got-tt.approxKm) > approxTolerance {
+				t.Errorf("DistanceKm() = %v, want about %v", got, tt.approxKm)
+			}
+			if back := to.DistanceKm(from); math.Abs(back-got) > floatTolerance {
+				t.Errorf("DistanceKm() is not symmetric: %v there, %v back", got, back)
+			}
+		})
+	}
+}
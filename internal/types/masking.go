@@ -0,0 +1,132 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// MaskingRule says how a sensitive response field is shown to callers who may not see it in full
+type MaskingRule string
+
+const (
+	// MaskingNone shows the field as is
+	MaskingNone MaskingRule = "none"
+	// MaskingLast4 keeps the last four characters and replaces the rest with asterisks
+	MaskingLast4 MaskingRule = "last4"
+	// MaskingOmit leaves the field out of the response
+	MaskingOmit MaskingRule = "omit"
+)
+
+// MaskingRules lists the supported masking rules
+var MaskingRules = []MaskingRule{
+	MaskingNone,
+	MaskingLast4,
+	MaskingOmit,
+}
+
+const (
+	// ContactFieldPhone is the phone of a place contact
+	ContactFieldPhone = "phone"
+	// ContactFieldEmail is the email of a place contact
+	ContactFieldEmail = "email"
+	// ContactFieldWebsite is the website of a place contact
+	ContactFieldWebsite = "website"
+)
+
+// ContactFields lists the contact fields that take a masking rule
+var ContactFields = []string{
+	ContactFieldPhone,
+	ContactFieldEmail,
+	ContactFieldWebsite,
+}
+
+// DefaultContactMasking masks the personal contact fields of a place: phones keep their last
+// four digits and emails are left out. Websites are public by nature.
+var DefaultContactMasking = map[string]MaskingRule{
+	ContactFieldPhone:   MaskingLast4,
+	ContactFieldEmail:   MaskingOmit,
+	ContactFieldWebsite: MaskingNone,
+}
+
+// contactMasking are the configured rules, see SetContactMasking
+var contactMasking = DefaultContactMasking
+
+// PIIMasker is implemented by responses carrying personal data that is masked for callers who
+// may not see it in full
+type PIIMasker interface {
+	MaskPII()
+}
+
+// SetContactMasking configures the masking of contact fields. Rules override the default of
+// their field; fields left out keep it.
+func SetContactMasking(rules map[string]MaskingRule) {
+	contactMasking = lo.Assign(DefaultContactMasking, rules)
+}
+
+// ContactMasking returns the configured masking rule of a contact field
+func ContactMasking(field string) MaskingRule {
+	return contactMasking[field]
+}
+
+// ValidateContactMasking checks that rules name contact fields and supported rules only
+func ValidateContactMasking(rules map[string]MaskingRule) error {
+	for field, rule := range rules {
+		if !lo.Contains(ContactFields, field) {
+			return fmt.Errorf("unknown field %q", field)
+		}
+		if !lo.Contains(MaskingRules, rule) {
+			return fmt.Errorf("%s: unknown rule %q, supported rules are %s, %s and %s",
+				field, rule, MaskingNone, MaskingLast4, MaskingOmit)
+		}
+	}
+	return nil
+}
+
+// MaskPII masks the personal data of every item that carries any
+func (r *ListResponse[T]) MaskPII() {
+	for _, item := range r.Items {
+		if m, ok := any(item).(PIIMasker); ok {
+			m.MaskPII()
+		}
+	}
+}
+
+// Mask applies the rule to value, returning nil when the rule omits it
+func (r MaskingRule) Mask(value *string) *string {
+	if value == nil {
+		return nil
+	}
+
+	switch r {
+	case MaskingOmit:
+		return nil
+	case MaskingLast4:
+		runes := []rune(*value)
+		if len(runes) <= 4 {
+			return lo.ToPtr(strings.Repeat("*", len(runes)))
+		}
+		return lo.ToPtr(strings.Repeat("*", len(runes)-4) + string(runes[len(runes)-4:]))
+	default:
+		return value
+	}
+}
+
+// Masked returns a copy of the contact with the configured masking rules applied, or nil when
+// nothing is left of it
+func (c *Contact) Masked() *Contact {
+	if c == nil {
+		return nil
+	}
+
+	masked := &Contact{
+		Phone:   ContactMasking(ContactFieldPhone).Mask(c.Phone),
+		Email:   ContactMasking(ContactFieldEmail).Mask(c.Email),
+		Website: ContactMasking(ContactFieldWebsite).Mask(c.Website),
+	}
+	if masked.IsEmpty() {
+		return nil
+	}
+	return masked
+}
@@ -0,0 +1,151 @@
+package types
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// GeoJSONTypeMultiPoint is the GeoJSON geometry type of a MultiPoint
+const GeoJSONTypeMultiPoint = "MultiPoint"
+
+// MultiPoint is a set of points forming one logical place, such as a temple complex whose
+// shrines a data source lists separately. Like Point it is a wire format, with coordinates
+// ordered [longitude, latitude]; places are stored at its Centroid.
+type MultiPoint struct {
+	Type        string       `json:"type"`
+	Coordinates [][2]float64 `json:"coordinates"`
+}
+
+// NewMultiPoint collects points into a MultiPoint
+func NewMultiPoint(points ...Point) MultiPoint {
+	m := MultiPoint{Type: GeoJSONTypeMultiPoint, Coordinates: make([][2]float64, len(points))}
+	for i, p := range points {
+		m.Coordinates[i] = p.Coordinates
+	}
+	return m
+}
+
+// Points returns the constituent points
+func (m MultiPoint) Points() []Point {
+	points := make([]Point, len(m.Coordinates))
+	for i, c := range m.Coordinates {
+		points[i] = Point{Type: GeoJSONTypePoint, Coordinates: c}
+	}
+	return points
+}
+
+// Validate checks the geometry type and validates every point through its Location
+func (m MultiPoint) Validate() error {
+	if m.Type != GeoJSONTypeMultiPoint {
+		return ierr.NewErrorf("invalid geometry type: %s", m.Type).
+			WithHintf("GeoJSON geometry type must be %s", GeoJSONTypeMultiPoint).
+			Mark(ierr.ErrValidation)
+	}
+	if len(m.Coordinates) == 0 {
+		return ierr.NewError("multipoint has no points").
+			WithHint("A MultiPoint needs at least one point").
+			Mark(ierr.ErrValidation)
+	}
+	for i, p := range m.Points() {
+		if err := FromPoint(p).Validate(); err != nil {
+			return ierr.WithError(err).
+				WithReportableDetails(map[string]any{"point": i}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+	return nil
+}
+
+// Centroid returns the geographic center of the points: the mean of their positions on the
+// unit sphere, projected back to the surface. Unlike averaging degrees it stays correct
+// across the antimeridian. Points spread so evenly that the mean falls at the Earth's center
+// have no meaningful center; the mean of the degrees is returned for them instead.
+func (m MultiPoint) Centroid() Point {
+	var x, y, z float64
+	for _, c := range m.Coordinates {
+		lng, lat := c[0]*math.Pi/180, c[1]*math.Pi/180
+		x += math.Cos(lat) * math.Cos(lng)
+		y += math.Cos(lat) * math.Sin(lng)
+		z += math.Sin(lat)
+	}
+
+	if math.Hypot(math.Hypot(x, y), z) < 1e-9 {
+		var lng, lat float64
+		for _, c := range m.Coordinates {
+			lng += c[0]
+			lat += c[1]
+		}
+		n := float64(len(m.Coordinates))
+		return Point{Type: GeoJSONTypePoint, Coordinates: [2]float64{lng / n, lat / n}}
+	}
+
+	lng := math.Atan2(y, x) * 180 / math.Pi
+	lat := math.Atan2(z, math.Hypot(x, y)) * 180 / math.Pi
+	return Point{Type: GeoJSONTypePoint, Coordinates: [2]float64{lng, lat}}
+}
+
+// WKT returns the points in Well-Known Text, e.g. MULTIPOINT((73.7898 19.9975),(73.53 19.93))
+func (m MultiPoint) WKT() string {
+	var b strings.Builder
+	b.WriteString("MULTIPOINT(")
+	for i, c := range m.Coordinates {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteByte('(')
+		b.WriteString(strconv.FormatFloat(c[0], 'f', -1, 64))
+		b.WriteByte(' ')
+		b.WriteString(strconv.FormatFloat(c[1], 'f', -1, 64))
+		b.WriteByte(')')
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// ParseMultiPointWKT reads a two-dimensional MultiPoint in Well-Known Text. Both the
+// parenthesized MULTIPOINT((x y),(x y)) and the older MULTIPOINT(x y, x y) forms are
+// accepted, in any case. The points are validated.
+func ParseMultiPointWKT(wkt string) (MultiPoint, error) {
+	invalid := func(reason string) (MultiPoint, error) {
+		return MultiPoint{}, ierr.NewErrorf("invalid multipoint WKT: %s", reason).
+			WithHint("Use MULTIPOINT((longitude latitude),(longitude latitude))").
+			Mark(ierr.ErrValidation)
+	}
+
+	text := strings.TrimSpace(wkt)
+	if len(text) < len("MULTIPOINT") || !strings.EqualFold(text[:len("MULTIPOINT")], "MULTIPOINT") {
+		return invalid("must start with MULTIPOINT")
+	}
+	body := strings.TrimSpace(text[len("MULTIPOINT"):])
+	if !strings.HasPrefix(body, "(") || !strings.HasSuffix(body, ")") {
+		return invalid("points must be enclosed in parentheses")
+	}
+	body = body[1 : len(body)-1]
+
+	m := MultiPoint{Type: GeoJSONTypeMultiPoint}
+	for _, part := range strings.Split(body, ",") {
+		part = strings.TrimSpace(part)
+		part = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(part, "("), ")"))
+		fields := strings.Fields(part)
+		if len(fields) != 2 {
+			return invalid("each point must have exactly a longitude and a latitude")
+		}
+		lng, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return invalid("longitude " + strconv.Quote(fields[0]) + " is not a number")
+		}
+		lat, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			return invalid("latitude " + strconv.Quote(fields[1]) + " is not a number")
+		}
+		m.Coordinates = append(m.Coordinates, [2]float64{lng, lat})
+	}
+
+	if err := m.Validate(); err != nil {
+		return MultiPoint{}, err
+	}
+	return m, nil
+}
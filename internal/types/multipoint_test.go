@@ -0,0 +1,76 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+func TestMultiPointWKTRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		points [][2]float64
+		want   string
+	}{
+		{name: "single point", points: [][2]float64{{73.7898, 19.9975}}, want: "MULTIPOINT((73.7898 19.9975))"},
+		{name: "several points", points: [][2]float64{{73.7898, 19.9975}, {73.53, 19.93}}, want: "MULTIPOINT((73.7898 19.9975),(73.53 19.93))"},
+		{name: "negative and integral", points: [][2]float64{{-180, -90}, {180, 90}}, want: "MULTIPOINT((-180 -90),(180 90))"},
+		{name: "full precision", points: [][2]float64{{73.78981234567891, 19.99751234567891}}, want: "MULTIPOINT((73.78981234567891 19.99751234567891))"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := MultiPoint{Type: GeoJSONTypeMultiPoint, Coordinates: tt.points}
+
+			wkt := m.WKT()
+			if wkt != tt.want {
+				t.Errorf("WKT() = %s, want %s", wkt, tt.want)
+			}
+
+			parsed, err := ParseMultiPointWKT(wkt)
+			if err != nil {
+				t.Fatalf("ParseMultiPointWKT(%s) error = %v", wkt, err)
+			}
+			if !reflect.DeepEqual(parsed, m) {
+				t.Errorf("ParseMultiPointWKT(%s) = %v, want %v", wkt, parsed, m)
+			}
+		})
+	}
+}
+
+func TestParseMultiPointWKT(t *testing.T) {
+	tests := []struct {
+		name    string
+		wkt     string
+		want    [][2]float64
+		wantErr bool
+	}{
+		{name: "unparenthesized points", wkt: "MULTIPOINT(73.7898 19.9975, 73.53 19.93)", want: [][2]float64{{73.7898, 19.9975}, {73.53, 19.93}}},
+		{name: "lowercase with spacing", wkt: "  multipoint ( (73.7898 19.9975) , (73.53 19.93) ) ", want: [][2]float64{{73.7898, 19.9975}, {73.53, 19.93}}},
+		{name: "point", wkt: "POINT(73.7898 19.9975)", wantErr: true},
+		{name: "missing parentheses", wkt: "MULTIPOINT 73.7898 19.9975", wantErr: true},
+		{name: "empty", wkt: "MULTIPOINT()", wantErr: true},
+		{name: "three dimensions", wkt: "MULTIPOINT((73.7898 19.9975 600))", wantErr: true},
+		{name: "not a number", wkt: "MULTIPOINT((east 19.9975))", wantErr: true},
+		{name: "out of range", wkt: "MULTIPOINT((73.7898 95))", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMultiPointWKT(tt.wkt)
+			if tt.wantErr {
+				if !ierr.IsValidation(err) {
+					t.Fatalf("ParseMultiPointWKT(%q) error = %v, want validation error", tt.wkt, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseMultiPointWKT(%q) error = %v", tt.wkt, err)
+			}
+			if !reflect.DeepEqual(got.Coordinates, tt.want) {
+				t.Errorf("ParseMultiPointWKT(%q) = %v, want %v", tt.wkt, got.Coordinates, tt.want)
+			}
+		})
+	}
+}
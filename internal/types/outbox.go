@@ -0,0 +1,46 @@
+package types
+
+import (
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/samber/lo"
+)
+
+// OutboxStatus is the delivery state of an outbox event
+type OutboxStatus string
+
+const (
+	// OutboxStatusPending events are waiting for a (re)delivery attempt
+	OutboxStatusPending OutboxStatus = "pending"
+	// OutboxStatusSent events were acknowledged by the consumer
+	OutboxStatusSent OutboxStatus = "sent"
+	// OutboxStatusDead events ran out of attempts and are no longer retried
+	OutboxStatusDead OutboxStatus = "dead"
+)
+
+func (s OutboxStatus) Validate() error {
+	allowed := []OutboxStatus{OutboxStatusPending, OutboxStatusSent, OutboxStatusDead}
+	if !lo.Contains(allowed, s) {
+		return ierr.NewErrorf("invalid outbox status: %s", s).
+			WithHintf("Outbox status must be one of: %v", allowed).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ChangeEventType names a change event delivered through the outbox
+type ChangeEventType string
+
+const (
+	EventPlaceCreated    ChangeEventType = "place.created"
+	EventPlaceUpdated    ChangeEventType = "place.updated"
+	EventPlaceDeleted    ChangeEventType = "place.deleted"
+	EventCategoryCreated ChangeEventType = "category.created"
+	EventCategoryUpdated ChangeEventType = "category.updated"
+	EventCategoryDeleted ChangeEventType = "category.deleted"
+)
+
+// Aggregate types of outbox events
+const (
+	AggregatePlace    = "place"
+	AggregateCategory = "category"
+)
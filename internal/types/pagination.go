@@ -1,16 +1,38 @@
 package types
 
+import (
+	"net/url"
+	"strconv"
+)
+
 // PaginationResponse represents standardized pagination metadata
 type PaginationResponse struct {
 	Total  int `json:"total"`
 	Limit  int `json:"limit"`
 	Offset int `json:"offset"`
+	// TotalIsEstimate is set when Total comes from planner statistics rather than an exact count
+	TotalIsEstimate bool `json:"total_is_estimate,omitempty"`
+	// Page, PerPage and TotalPages describe the same page by number; they are left out of
+	// unlimited lists
+	Page       int `json:"page,omitempty"`
+	PerPage    int `json:"per_page,omitempty"`
+	TotalPages int `json:"total_pages,omitempty"`
+}
+
+// PaginationLinks holds absolute URLs to other pages of a list, preserving the request's filters.
+// Prev and Next are null on the first and last page respectively.
+type PaginationLinks struct {
+	First string  `json:"first"`
+	Prev  *string `json:"prev"`
+	Next  *string `json:"next"`
+	Last  string  `json:"last"`
 }
 
 // ListResponse represents a paginated response with items
 type ListResponse[T any] struct {
 	Items      []T                `json:"items"`
 	Pagination PaginationResponse `json:"pagination"`
+	Links      *PaginationLinks   `json:"links,omitempty"`
 }
 
 // NewPaginationResponse creates a new pagination response
@@ -24,12 +46,87 @@ func NewPaginationResponse(total, limit, offset int) PaginationResponse {
 
 // NewListResponse creates a new list response with pagination
 func NewListResponse[T any](items []T, total, limit, offset int) ListResponse[T] {
+	if items == nil {
+		// An empty page serializes as [], never null
+		items = []T{}
+	}
+	pagination := PaginationResponse{
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}
+	pagination.SetPages()
 	return ListResponse[T]{
-		Items: items,
-		Pagination: PaginationResponse{
-			Total:  total,
-			Limit:  limit,
-			Offset: offset,
-		},
+		Items:      items,
+		Pagination: pagination,
 	}
 }
+
+// SetPages derives the page number fields from the total, limit and offset. An offset that
+// is not a multiple of the limit falls on the page holding its first item.
+func (p *PaginationResponse) SetPages() {
+	if p.Limit <= 0 {
+		return
+	}
+	p.Page = p.Offset/p.Limit + 1
+	p.PerPage = p.Limit
+	p.TotalPages = (p.Total + p.Limit - 1) / p.Limit
+}
+
+// SetLinks populates the pagination links from the absolute URL of the current request
+func (r *ListResponse[T]) SetLinks(requestURL *url.URL) {
+	r.Links = NewPaginationLinks(requestURL, r.Pagination.Total, r.Pagination.Limit, r.Pagination.Offset)
+}
+
+// NewPaginationLinks builds first/prev/next/last links by rewriting the limit and offset
+// query parameters of requestURL, or page and per_page when the request paged by number.
+// Unlimited lists (limit 0) have a single page.
+func NewPaginationLinks(requestURL *url.URL, total, limit, offset int) *PaginationLinks {
+	if requestURL == nil {
+		return nil
+	}
+
+	requestQuery := requestURL.Query()
+	paged := limit > 0 && (requestQuery.Has("page") || requestQuery.Has("per_page"))
+
+	pageURL := func(pageOffset int) string {
+		u := *requestURL
+		query := u.Query()
+		switch {
+		case paged:
+			query.Set("page", strconv.Itoa(pageOffset/limit+1))
+			query.Set("per_page", strconv.Itoa(limit))
+		default:
+			query.Set("offset", strconv.Itoa(pageOffset))
+			if limit > 0 {
+				query.Set("limit", strconv.Itoa(limit))
+			}
+		}
+		u.RawQuery = query.Encode()
+		return u.String()
+	}
+
+	lastOffset := 0
+	if limit > 0 && total > 0 {
+		lastOffset = ((total - 1) / limit) * limit
+	}
+
+	links := &PaginationLinks{
+		First: pageURL(0),
+		Last:  pageURL(lastOffset),
+	}
+	if limit <= 0 {
+		return links
+	}
+
+	if offset > 0 {
+		prev := pageURL(max(offset-limit, 0))
+		links.Prev = &prev
+	}
+	if offset+limit < total {
+		next := pageURL(offset + limit)
+		links.Next = &next
+	}
+
+	return links
+}
@@ -0,0 +1,151 @@
+package types
+
+import (
+	"net/url"
+	"testing"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/samber/lo"
+)
+
+func TestQueryFilterPages(t *testing.T) {
+	tests := []struct {
+		name       string
+		filter     QueryFilter
+		wantLimit  int
+		wantOffset int
+		wantErr    bool
+	}{
+		{name: "first page", filter: QueryFilter{Page: lo.ToPtr(1), PerPage: lo.ToPtr(20)}, wantLimit: 20, wantOffset: 0},
+		{name: "third page", filter: QueryFilter{Page: lo.ToPtr(3), PerPage: lo.ToPtr(20)}, wantLimit: 20, wantOffset: 40},
+		{name: "page sized by limit", filter: QueryFilter{Page: lo.ToPtr(2), Limit: lo.ToPtr(10)}, wantLimit: 10, wantOffset: 10},
+		{name: "page with default size", filter: QueryFilter{Page: lo.ToPtr(2)}, wantLimit: 50, wantOffset: 50},
+		{name: "per_page alone", filter: QueryFilter{PerPage: lo.ToPtr(5)}, wantLimit: 5, wantOffset: 0},
+		{name: "per_page clamped", filter: QueryFilter{Page: lo.ToPtr(2), PerPage: lo.ToPtr(5000)}, wantLimit: MaxQueryLimit, wantOffset: MaxQueryLimit},
+		{name: "limit and offset", filter: QueryFilter{Limit: lo.ToPtr(10), Offset: lo.ToPtr(30)}, wantLimit: 10, wantOffset: 30},
+		{name: "page zero", filter: QueryFilter{Page: lo.ToPtr(0)}, wantErr: true},
+		{name: "negative page", filter: QueryFilter{Page: lo.ToPtr(-1)}, wantErr: true},
+		{name: "per_page zero", filter: QueryFilter{PerPage: lo.ToPtr(0)}, wantErr: true},
+		{name: "page with offset", filter: QueryFilter{Page: lo.ToPtr(2), Offset: lo.ToPtr(0)}, wantErr: true},
+		{name: "per_page with offset", filter: QueryFilter{PerPage: lo.ToPtr(10), Offset: lo.ToPtr(10)}, wantErr: true},
+		{name: "per_page with limit", filter: QueryFilter{PerPage: lo.ToPtr(10), Limit: lo.ToPtr(10)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.wantErr {
+				if !ierr.IsValidation(err) {
+					t.Fatalf("Validate() error = %v, want validation error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+			if got := tt.filter.GetLimit(); got != tt.wantLimit {
+				t.Errorf("GetLimit() = %d, want %d", got, tt.wantLimit)
+			}
+			if got := tt.filter.GetOffset(); got != tt.wantOffset {
+				t.Errorf("GetOffset() = %d, want %d", got, tt.wantOffset)
+			}
+		})
+	}
+}
+
+func TestPaginationResponseSetPages(t *testing.T) {
+	tests := []struct {
+		name                                  string
+		total, limit, offset                  int
+		wantPage, wantPerPage, wantTotalPages int
+	}{
+		{name: "empty list", total: 0, limit: 20, offset: 0, wantPage: 1, wantPerPage: 20, wantTotalPages: 0},
+		{name: "exact pages", total: 40, limit: 20, offset: 20, wantPage: 2, wantPerPage: 20, wantTotalPages: 2},
+		{name: "partial last page", total: 41, limit: 20, offset: 40, wantPage: 3, wantPerPage: 20, wantTotalPages: 3},
+		{name: "offset between pages", total: 100, limit: 20, offset: 30, wantPage: 2, wantPerPage: 20, wantTotalPages: 5},
+		{name: "past the end", total: 10, limit: 20, offset: 100, wantPage: 6, wantPerPage: 20, wantTotalPages: 1},
+		{name: "unlimited", total: 10, limit: 0, offset: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := PaginationResponse{Total: tt.total, Limit: tt.limit, Offset: tt.offset}
+			p.SetPages()
+			if p.Page != tt.wantPage || p.PerPage != tt.wantPerPage || p.TotalPages != tt.wantTotalPages {
+				t.Errorf("SetPages() = page %d, per_page %d, total_pages %d, want %d, %d, %d",
+					p.Page, p.PerPage, p.TotalPages, tt.wantPage, tt.wantPerPage, tt.wantTotalPages)
+			}
+		})
+	}
+}
+
+func TestNewPaginationLinks(t *testing.T) {
+	tests := []struct {
+		name                 string
+		request              string
+		total, limit, offset int
+		wantFirst, wantLast  string
+		wantPrev, wantNext   *string
+	}{
+		{
+			name:      "paged by number",
+			request:   "https://api.example.com/v1/places?page=2&per_page=10&type=temple",
+			total:     35,
+			limit:     10,
+			offset:    10,
+			wantFirst: "https://api.example.com/v1/places?page=1&per_page=10&type=temple",
+			wantPrev:  lo.ToPtr("https://api.example.com/v1/places?page=1&per_page=10&type=temple"),
+			wantNext:  lo.ToPtr("https://api.example.com/v1/places?page=3&per_page=10&type=temple"),
+			wantLast:  "https://api.example.com/v1/places?page=4&per_page=10&type=temple",
+		},
+		{
+			name:      "paged by offset",
+			request:   "https://api.example.com/v1/places?limit=10&offset=30",
+			total:     35,
+			limit:     10,
+			offset:    30,
+			wantFirst: "https://api.example.com/v1/places?limit=10&offset=0",
+			wantPrev:  lo.ToPtr("https://api.example.com/v1/places?limit=10&offset=20"),
+			wantLast:  "https://api.example.com/v1/places?limit=10&offset=30",
+		},
+		{
+			name:      "single page",
+			request:   "https://api.example.com/v1/places?page=1",
+			total:     5,
+			limit:     50,
+			offset:    0,
+			wantFirst: "https://api.example.com/v1/places?page=1&per_page=50",
+			wantLast:  "https://api.example.com/v1/places?page=1&per_page=50",
+		},
+		{
+			name:      "unlimited",
+			request:   "https://api.example.com/v1/places",
+			total:     5,
+			wantFirst: "https://api.example.com/v1/places?offset=0",
+			wantLast:  "https://api.example.com/v1/places?offset=0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(tt.request)
+			if err != nil {
+				t.Fatalf("parse %s: %v", tt.request, err)
+			}
+
+			links := NewPaginationLinks(u, tt.total, tt.limit, tt.offset)
+			if links.First != tt.wantFirst {
+				t.Errorf("First = %s, want %s", links.First, tt.wantFirst)
+			}
+			if links.Last != tt.wantLast {
+				t.Errorf("Last = %s, want %s", links.Last, tt.wantLast)
+			}
+			if lo.FromPtr(links.Prev) != lo.FromPtr(tt.wantPrev) {
+				t.Errorf("Prev = %v, want %v", lo.FromPtr(links.Prev), lo.FromPtr(tt.wantPrev))
+			}
+			if lo.FromPtr(links.Next) != lo.FromPtr(tt.wantNext) {
+				t.Errorf("Next = %v, want %v", lo.FromPtr(links.Next), lo.FromPtr(tt.wantNext))
+			}
+		})
+	}
+}
@@ -1,9 +1,11 @@
 package types
 
 import (
+	"strings"
 	"time"
 
 	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/validator"
 	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 )
@@ -27,25 +29,6 @@ func (pt PlaceType) Validate() error {
 	return nil
 }
 
-// ValidateCoordinates validates latitude and longitude values
-func ValidateCoordinates(latitude, longitude decimal.Decimal) error {
-	// Validate latitude range (-90 to 90)
-	if latitude.LessThan(decimal.NewFromInt(-90)) || latitude.GreaterThan(decimal.NewFromInt(90)) {
-		return ierr.NewError("latitude must be between -90 and 90").
-			WithHint("Please provide a valid latitude value").
-			Mark(ierr.ErrValidation)
-	}
-
-	// Validate longitude range (-180 to 180)
-	if longitude.LessThan(decimal.NewFromInt(-180)) || longitude.GreaterThan(decimal.NewFromInt(180)) {
-		return ierr.NewError("longitude must be between -180 and 180").
-			WithHint("Please provide a valid longitude value").
-			Mark(ierr.ErrValidation)
-	}
-
-	return nil
-}
-
 type PlaceFilter struct {
 	*QueryFilter
 	*TimeRangeFilter
@@ -53,17 +36,145 @@ type PlaceFilter struct {
 	// Custom filters
 	Slug       []string `json:"slug,omitempty" form:"slug" validate:"omitempty"`
 	PlaceTypes []string `json:"place_types,omitempty" form:"place_types" validate:"omitempty"`
+	// Categories restricts places to those in any of the given category slugs
+	Categories []string `json:"categories,omitempty" form:"categories" validate:"omitempty"`
 
 	// Geospatial filters
 	Latitude  *decimal.Decimal `json:"latitude,omitempty" form:"latitude" validate:"omitempty"`
 	Longitude *decimal.Decimal `json:"longitude,omitempty" form:"longitude" validate:"omitempty"`
 	RadiusM   *decimal.Decimal `json:"radius_m,omitempty" form:"radius_m" validate:"omitempty"` // radius in meters (cap: 10-15km for v1)
+	// Rank orders geospatial results; see NearbyRank
+	Rank NearbyRank `json:"rank,omitempty" form:"rank" validate:"omitempty"`
+	// Collation orders titles when sorting by title; empty uses the configured default
+	Collation Collation `json:"collation,omitempty" form:"collation" validate:"omitempty"`
+
+	// Price filters: free selects free (true) or paid (false) places; max_price keeps free places
+	// and paid places whose amount in price_currency (default INR) is at most max_price
+	Free          *bool            `json:"free,omitempty" form:"free" validate:"omitempty"`
+	MaxPrice      *decimal.Decimal `json:"max_price,omitempty" form:"max_price" validate:"omitempty"`
+	PriceCurrency *string          `json:"price_currency,omitempty" form:"price_currency" validate:"omitempty,len=3,uppercase"`
+
+	// Wheelchair selects places stated to be wheelchair accessible (true) or not (false)
+	Wheelchair *bool `json:"wheelchair,omitempty" form:"wheelchair" validate:"omitempty"`
+
+	// Pincode selects places whose address has this postal code; spaces are ignored
+	Pincode *string `json:"pincode,omitempty" form:"pincode" validate:"omitempty"`
+
+	// GeohashPrefix selects places whose location geohash starts with it, i.e. that lie in
+	// the geohash cell; case is ignored
+	GeohashPrefix *string `json:"geohash_prefix,omitempty" form:"geohash_prefix" validate:"omitempty"`
+
+	// Completeness filters bound the weighted completeness score (0-100) of the places
+	MinCompleteness *int `json:"min_completeness,omitempty" form:"min_completeness" validate:"omitempty,min=0,max=100"`
+	MaxCompleteness *int `json:"max_completeness,omitempty" form:"max_completeness" validate:"omitempty,min=0,max=100"`
 
 	// Search
 	SearchQuery *string `json:"search_query,omitempty" form:"search_query" validate:"omitempty"`
 
 	// Trending filter
 	LastViewedAfter *time.Time `json:"last_viewed_after,omitempty" form:"last_viewed_after" validate:"omitempty"`
+
+	// Contributor filters; non-admins may only filter on their own user ID
+	ContributorFilter
+
+	// IncludeDeleted also lists soft-deleted (archived and deleted) rows; honoured for admins only
+	IncludeDeleted bool `json:"include_deleted,omitempty" form:"include_deleted"`
+
+	// IncludeScheduled lists published places outside their publishing window too. It is not
+	// a query parameter: the service sets it for admins.
+	IncludeScheduled bool `json:"-" form:"-"`
+}
+
+// GetIncludeDeleted implements IncludeDeletedFilter
+func (f *PlaceFilter) GetIncludeDeleted() bool {
+	return f != nil && f.IncludeDeleted
+}
+
+// HasEntityFilters reports whether the filter narrows places beyond status,
+// i.e. whether a listing is anything other than the full catalog
+func (f *PlaceFilter) HasEntityFilters() bool {
+	if f == nil {
+		return false
+	}
+	if f.TimeRangeFilter != nil && (f.StartTime != nil || f.EndTime != nil) {
+		return true
+	}
+	return len(f.Slug) > 0 ||
+		len(f.PlaceTypes) > 0 ||
+		len(f.Categories) > 0 ||
+		f.Latitude != nil || f.Longitude != nil || f.RadiusM != nil ||
+		(f.SearchQuery != nil && *f.SearchQuery != "") ||
+		f.LastViewedAfter != nil ||
+		f.Free != nil || f.MaxPrice != nil ||
+		f.Wheelchair != nil ||
+		f.Pincode != nil ||
+		f.GeohashPrefix != nil ||
+		f.MinCompleteness != nil || f.MaxCompleteness != nil ||
+		f.HasContributorFilters()
+}
+
+// GetPriceCurrency returns the currency max_price is expressed in
+func (f *PlaceFilter) GetPriceCurrency() string {
+	if f.PriceCurrency == nil || *f.PriceCurrency == "" {
+		return DefaultPriceCurrency
+	}
+	return *f.PriceCurrency
+}
+
+// IsRankedNearby reports whether geospatial results are ordered by the popularity-weighted score
+func (f *PlaceFilter) IsRankedNearby() bool {
+	return f != nil && f.Rank == NearbyRankBest &&
+		f.Latitude != nil && f.Longitude != nil && f.RadiusM != nil
+}
+
+// NearbyRank selects how geospatial place results are ordered
+type NearbyRank string
+
+const (
+	// NearbyRankDistance orders by distance from the origin, nearest first (default)
+	NearbyRankDistance NearbyRank = "distance"
+	// NearbyRankBest orders by a score blending proximity and popularity; see NearbyRankWeights
+	NearbyRankBest NearbyRank = "best"
+)
+
+func (r NearbyRank) Validate() error {
+	if r != NearbyRankDistance && r != NearbyRankBest {
+		return ierr.NewErrorf("invalid rank: %s", r).
+			WithHintf("Supported ranks are: %s, %s", NearbyRankDistance, NearbyRankBest).
+			WithReportableDetails(map[string]any{"rank": r}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// NearbyRankWeights tunes the "best" nearby score, which lies in [0, 1] (higher is better):
+//
+//	proximity  = 1 - distance_m / radius_m
+//	popularity = view_count / (view_count + PopularityHalfViews)
+//	score      = DistanceWeight * proximity + (1 - DistanceWeight) * popularity
+//
+// Popularity saturates rather than being divided by the maximum in the result set, so a
+// place's score does not depend on which other places happen to be in range.
+type NearbyRankWeights struct {
+	// DistanceWeight (0-1) is the share of the score given to proximity
+	DistanceWeight float64
+	// PopularityHalfViews is the view count at which popularity reaches 0.5
+	PopularityHalfViews float64
+}
+
+// PlaceSortFields lists the fields a place list can be sorted by
+var PlaceSortFields = []string{
+	"created_at",
+	"updated_at",
+	"title",
+	"slug",
+	"place_type",
+	"view_count",
+	"rating_avg",
+	"rating_count",
+	"popularity_score",
+	"last_viewed_at",
+	SortFieldID,
 }
 
 func (f *PlaceFilter) Validate() error {
@@ -71,6 +182,9 @@ func (f *PlaceFilter) Validate() error {
 		if err := f.QueryFilter.Validate(); err != nil {
 			return err
 		}
+		if err := ValidateSort(f.GetSort(), f.GetOrder(), PlaceSortFields); err != nil {
+			return err
+		}
 	}
 
 	if f.TimeRangeFilter != nil {
@@ -115,9 +229,124 @@ func (f *PlaceFilter) Validate() error {
 		}
 	}
 
+	if f.MaxPrice != nil && f.MaxPrice.IsNegative() {
+		return ierr.NewError("max_price cannot be negative").
+			WithHint("Please provide a non-negative max_price").
+			Mark(ierr.ErrValidation)
+	}
+	if f.PriceCurrency != nil {
+		if err := validator.ValidateCurrencyCode(*f.PriceCurrency); err != nil {
+			return err
+		}
+	}
+
+	// The pincode is normalized here so the repository matches it as stored
+	if f.Pincode != nil {
+		pincode := NormalizePincode(*f.Pincode)
+		if err := ValidatePincode(pincode); err != nil {
+			return err
+		}
+		f.Pincode = &pincode
+	}
+
+	// Stored geohashes are lowercase
+	if f.GeohashPrefix != nil {
+		prefix := strings.ToLower(strings.TrimSpace(*f.GeohashPrefix))
+		if _, err := DecodeGeohash(prefix); err != nil {
+			return err
+		}
+		f.GeohashPrefix = &prefix
+	}
+
+	if f.Collation != "" {
+		if err := f.Collation.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.Rank != "" {
+		if err := f.Rank.Validate(); err != nil {
+			return err
+		}
+		if f.Rank == NearbyRankBest && f.RadiusM == nil {
+			return ierr.NewError("rank=best requires a geospatial search").
+				WithHint("Please provide latitude, longitude and radius_m to rank places by popularity-weighted distance").
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	for _, conflict := range placeFilterConflicts {
+		if conflict.applies(f) {
+			return ierr.NewErrorf("conflicting filters: %s", strings.Join(conflict.params, " and ")).
+				WithHint(conflict.hint).
+				WithReportableDetails(map[string]any{
+					"params": conflict.params,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
 	return nil
 }
 
+// placeFilterConflict is a combination of place filter parameters that cannot be honoured
+// together. Such combinations are refused rather than one of the parameters being ignored.
+type placeFilterConflict struct {
+	params  []string
+	applies func(f *PlaceFilter) bool
+	hint    string
+}
+
+// placeFilterConflicts are checked by PlaceFilter.Validate, in order
+var placeFilterConflicts = []placeFilterConflict{
+	{
+		params: []string{"rank=best", "sort"},
+		applies: func(f *PlaceFilter) bool {
+			return f.Rank == NearbyRankBest && f.QueryFilter != nil && f.Sort != nil && *f.Sort != FILTER_DEFAULT_SORT
+		},
+		hint: "rank=best orders places by score; please drop sort, or use rank=distance to sort the places in range",
+	},
+	{
+		params: []string{"collation", "sort"},
+		applies: func(f *PlaceFilter) bool {
+			if f.Collation == "" {
+				return false
+			}
+			fields, err := ParseSort(f.GetSort(), f.GetOrder())
+			return err == nil && !lo.ContainsBy(fields, func(s SortField) bool { return s.Field == "title" })
+		},
+		hint: "collation only orders titles; please sort by title or drop collation",
+	},
+	{
+		params: []string{"free=true", "max_price"},
+		applies: func(f *PlaceFilter) bool {
+			return f.Free != nil && *f.Free && f.MaxPrice != nil
+		},
+		hint: "free=true selects free places only, which max_price cannot narrow; please drop one of them",
+	},
+	{
+		params: []string{"price_currency", "max_price"},
+		applies: func(f *PlaceFilter) bool {
+			return f.PriceCurrency != nil && f.MaxPrice == nil
+		},
+		hint: "price_currency is the currency of max_price; please provide max_price or drop price_currency",
+	},
+	{
+		params: []string{"min_completeness", "max_completeness"},
+		applies: func(f *PlaceFilter) bool {
+			return f.MinCompleteness != nil && f.MaxCompleteness != nil && *f.MinCompleteness > *f.MaxCompleteness
+		},
+		hint: "min_completeness must not exceed max_completeness",
+	},
+	{
+		params: []string{"include_deleted", "status"},
+		applies: func(f *PlaceFilter) bool {
+			return f.IncludeDeleted && f.GetStatus() != ""
+		},
+		hint: "include_deleted lists places of every status; please drop status, or use status=archived for soft-deleted places only",
+	},
+}
+
 func NewPlaceFilter() *PlaceFilter {
 	return &PlaceFilter{
 		QueryFilter:     NewDefaultQueryFilter(),
@@ -187,6 +416,217 @@ func (f *PlaceFilter) IsUnlimited() bool {
 	return f.QueryFilter.IsUnlimited()
 }
 
+const (
+	// DefaultDuplicateRadiusM is the default clustering distance for duplicate detection
+	DefaultDuplicateRadiusM = 50
+	// MaxDuplicateRadiusM caps the clustering distance to keep the scan bounded
+	MaxDuplicateRadiusM = 1000
+	// DefaultDuplicateMinSimilarity is the default trigram similarity threshold for titles
+	DefaultDuplicateMinSimilarity = 0.4
+)
+
+// PlaceDuplicateFilter configures the geographic duplicate detection scan
+type PlaceDuplicateFilter struct {
+	// RadiusM is the maximum distance in meters between two places for them to be considered duplicates
+	RadiusM *decimal.Decimal `json:"radius_m,omitempty" form:"radius_m" validate:"omitempty"`
+	// MinSimilarity is the minimum trigram similarity (0-1) between two titles
+	MinSimilarity *decimal.Decimal `json:"min_similarity,omitempty" form:"min_similarity" validate:"omitempty"`
+	// PlaceTypes restricts the scan to the given place types
+	PlaceTypes []string `json:"place_types,omitempty" form:"place_types" validate:"omitempty"`
+}
+
+func NewPlaceDuplicateFilter() *PlaceDuplicateFilter {
+	return &PlaceDuplicateFilter{}
+}
+
+func (f *PlaceDuplicateFilter) Validate() error {
+	if f.RadiusM != nil {
+		if f.RadiusM.LessThanOrEqual(decimal.Zero) {
+			return ierr.NewError("radius_m must be greater than 0").
+				WithHint("Please provide a positive value for radius_m").
+				Mark(ierr.ErrValidation)
+		}
+		if f.RadiusM.GreaterThan(decimal.NewFromInt(MaxDuplicateRadiusM)) {
+			return ierr.NewError("radius_m cannot exceed 1000 meters").
+				WithHint("Maximum allowed radius for duplicate detection is 1000 meters").
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	if f.MinSimilarity != nil {
+		if f.MinSimilarity.LessThan(decimal.Zero) || f.MinSimilarity.GreaterThan(decimal.NewFromInt(1)) {
+			return ierr.NewError("min_similarity must be between 0 and 1").
+				WithHint("Please provide a similarity threshold between 0 and 1").
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	for _, pt := range f.PlaceTypes {
+		if err := PlaceType(pt).Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetRadiusM returns the clustering distance in meters, falling back to the default
+func (f *PlaceDuplicateFilter) GetRadiusM() float64 {
+	if f.RadiusM == nil {
+		return DefaultDuplicateRadiusM
+	}
+	return f.RadiusM.InexactFloat64()
+}
+
+// GetMinSimilarity returns the title similarity threshold, falling back to the default
+func (f *PlaceDuplicateFilter) GetMinSimilarity() float64 {
+	if f.MinSimilarity == nil {
+		return DefaultDuplicateMinSimilarity
+	}
+	return f.MinSimilarity.InexactFloat64()
+}
+
+// Place request fields only admins may set. They decide whether and when a place is public;
+// editors may change everything else.
+const (
+	PlaceRequestFieldStatus      = "status"
+	PlaceRequestFieldPublishAt   = "publish_at"
+	PlaceRequestFieldUnpublishAt = "unpublish_at"
+)
+
+// AdminPlaceFields lists the place request fields only admins may set
+var AdminPlaceFields = []string{
+	PlaceRequestFieldStatus,
+	PlaceRequestFieldPublishAt,
+	PlaceRequestFieldUnpublishAt,
+}
+
+// PlaceField identifies a piece of place content that the completeness report checks
+type PlaceField string
+
+const (
+	PlaceFieldPrimaryImage    PlaceField = "primary_image"
+	PlaceFieldLongDescription PlaceField = "long_description"
+	PlaceFieldCoordinates     PlaceField = "coordinates"
+	PlaceFieldCategories      PlaceField = "categories"
+	PlaceFieldContact         PlaceField = "contact"
+)
+
+// PlaceFields contains all fields checked by the completeness report
+var PlaceFields = []string{
+	string(PlaceFieldPrimaryImage),
+	string(PlaceFieldLongDescription),
+	string(PlaceFieldCoordinates),
+	string(PlaceFieldCategories),
+	string(PlaceFieldContact),
+}
+
+func (f PlaceField) Validate() error {
+	if !lo.Contains(PlaceFields, string(f)) {
+		return ierr.NewError("invalid place field").
+			WithHint("valid fields are: primary_image, long_description, coordinates, categories, contact").
+			WithReportableDetails(map[string]any{"missing": f}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// PlaceIncompleteFilter filters the report of places that are missing content
+type PlaceIncompleteFilter struct {
+	*QueryFilter
+
+	// Missing restricts the report to places missing any of the given fields (defaults to all)
+	Missing []string `json:"missing,omitempty" form:"missing" validate:"omitempty"`
+}
+
+func NewPlaceIncompleteFilter() *PlaceIncompleteFilter {
+	return &PlaceIncompleteFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+func (f *PlaceIncompleteFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, field := range f.Missing {
+		if err := PlaceField(field).Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetMissing returns the fields to check, defaulting to every field
+func (f *PlaceIncompleteFilter) GetMissing() []PlaceField {
+	fields := f.Missing
+	if len(fields) == 0 {
+		fields = PlaceFields
+	}
+	return lo.Map(lo.Uniq(fields), func(field string, _ int) PlaceField {
+		return PlaceField(field)
+	})
+}
+
+// GetLimit implements BaseFilter interface
+func (f *PlaceIncompleteFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *PlaceIncompleteFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *PlaceIncompleteFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *PlaceIncompleteFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *PlaceIncompleteFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *PlaceIncompleteFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *PlaceIncompleteFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
 // FeedSectionType represents the type of feed section
 type FeedSectionType string
 
@@ -0,0 +1,197 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+func TestPlaceFilterValidatePincode(t *testing.T) {
+	tests := []struct {
+		name    string
+		pincode string
+		want    string
+		wantErr bool
+	}{
+		{name: "six digits", pincode: "422001", want: "422001"},
+		{name: "spaced", pincode: "422 001", want: "422001"},
+		{name: "padded", pincode: " 422003\t", want: "422003"},
+		{name: "leading zero", pincode: "022001", wantErr: true},
+		{name: "five digits", pincode: "42200", wantErr: true},
+		{name: "seven digits", pincode: "4220011", wantErr: true},
+		{name: "letters", pincode: "42200A", wantErr: true},
+		{name: "empty", pincode: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewPlaceFilter()
+			f.Pincode = lo.ToPtr(tt.pincode)
+
+			err := f.Validate()
+			if tt.wantErr {
+				if !ierr.IsValidation(err) {
+					t.Fatalf("Validate() error = %v, want validation error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+			if *f.Pincode != tt.want {
+				t.Errorf("Pincode = %q, want %q", *f.Pincode, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlaceFilterValidateConflicts(t *testing.T) {
+	tests := []struct {
+		name       string
+		setup      func(f *PlaceFilter)
+		wantParams []string
+	}{
+		{
+			name: "rank best with sort",
+			setup: func(f *PlaceFilter) {
+				f.Latitude, f.Longitude, f.RadiusM = lo.ToPtr(decimal.RequireFromString("20.0077")), lo.ToPtr(decimal.RequireFromString("73.7920")), lo.ToPtr(decimal.NewFromInt(5000))
+				f.Rank = NearbyRankBest
+				f.Sort = lo.ToPtr("title")
+			},
+			wantParams: []string{"rank=best", "sort"},
+		},
+		{
+			name: "rank best with default sort",
+			setup: func(f *PlaceFilter) {
+				f.Latitude, f.Longitude, f.RadiusM = lo.ToPtr(decimal.RequireFromString("20.0077")), lo.ToPtr(decimal.RequireFromString("73.7920")), lo.ToPtr(decimal.NewFromInt(5000))
+				f.Rank = NearbyRankBest
+			},
+		},
+		{
+			name: "collation without title sort",
+			setup: func(f *PlaceFilter) {
+				f.Collation = CollationMarathi
+			},
+			wantParams: []string{"collation", "sort"},
+		},
+		{
+			name: "collation with title sort",
+			setup: func(f *PlaceFilter) {
+				f.Collation = CollationMarathi
+				f.Sort = lo.ToPtr("title")
+			},
+		},
+		{
+			name: "free with max price",
+			setup: func(f *PlaceFilter) {
+				f.Free = lo.ToPtr(true)
+				f.MaxPrice = lo.ToPtr(decimal.NewFromInt(100))
+			},
+			wantParams: []string{"free=true", "max_price"},
+		},
+		{
+			name: "not free with max price",
+			setup: func(f *PlaceFilter) {
+				f.Free = lo.ToPtr(false)
+				f.MaxPrice = lo.ToPtr(decimal.NewFromInt(100))
+			},
+		},
+		{
+			name: "price currency without max price",
+			setup: func(f *PlaceFilter) {
+				f.PriceCurrency = lo.ToPtr("INR")
+			},
+			wantParams: []string{"price_currency", "max_price"},
+		},
+		{
+			name: "inverted completeness bounds",
+			setup: func(f *PlaceFilter) {
+				f.MinCompleteness, f.MaxCompleteness = lo.ToPtr(80), lo.ToPtr(20)
+			},
+			wantParams: []string{"min_completeness", "max_completeness"},
+		},
+		{
+			name: "equal completeness bounds",
+			setup: func(f *PlaceFilter) {
+				f.MinCompleteness, f.MaxCompleteness = lo.ToPtr(50), lo.ToPtr(50)
+			},
+		},
+		{
+			name: "include deleted with status",
+			setup: func(f *PlaceFilter) {
+				f.IncludeDeleted = true
+				f.Status = lo.ToPtr(StatusPublished)
+			},
+			wantParams: []string{"include_deleted", "status"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewPlaceFilter()
+			tt.setup(f)
+
+			err := f.Validate()
+			if tt.wantParams == nil {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if !ierr.IsValidation(err) {
+				t.Fatalf("Validate() error = %v, want validation error", err)
+			}
+			if want := "conflicting filters: " + strings.Join(tt.wantParams, " and "); !strings.Contains(err.Error(), want) {
+				t.Errorf("Validate() error = %v, want %q", err, want)
+			}
+		})
+	}
+}
+
+func TestPlaceFilterValidateGeohashPrefix(t *testing.T) {
+	ramkund := NewLocation(decimal.RequireFromString("20.0077"), decimal.RequireFromString("73.7920"))
+	stored := ramkund.Geohash(MaxGeohashPrecision)
+
+	tests := []struct {
+		name    string
+		prefix  string
+		want    string
+		wantErr bool
+	}{
+		{name: "lowercase", prefix: stored[:5], want: stored[:5]},
+		{name: "uppercase", prefix: strings.ToUpper(stored[:6]), want: stored[:6]},
+		{name: "padded", prefix: " " + stored[:4] + " ", want: stored[:4]},
+		{name: "full precision", prefix: stored, want: stored},
+		{name: "too long", prefix: stored + "0", wantErr: true},
+		{name: "empty", prefix: "", wantErr: true},
+		{name: "not in the alphabet", prefix: "tek1a", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewPlaceFilter()
+			f.GeohashPrefix = lo.ToPtr(tt.prefix)
+
+			err := f.Validate()
+			if tt.wantErr {
+				if !ierr.IsValidation(err) {
+					t.Fatalf("Validate() error = %v, want validation error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+			if *f.GeohashPrefix != tt.want {
+				t.Errorf("GeohashPrefix = %q, want %q", *f.GeohashPrefix, tt.want)
+			}
+			// The repository matches the prefix against the stored full-precision geohash
+			if !strings.HasPrefix(stored, *f.GeohashPrefix) {
+				t.Errorf("stored geohash %s does not start with %s", stored, *f.GeohashPrefix)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package types
+
+// placeholderImageURL and placeholderThumbnailURL stand in for the images of places that have
+// none, see SetPlaceholderImage
+var (
+	placeholderImageURL     string
+	placeholderThumbnailURL string
+)
+
+// SetPlaceholderImage configures the image shown for places without a primary image or
+// thumbnail. An empty thumbnail URL uses the image URL; an empty image URL disables the
+// placeholder.
+func SetPlaceholderImage(imageURL, thumbnailURL string) {
+	placeholderImageURL = imageURL
+	placeholderThumbnailURL = thumbnailURL
+	if placeholderThumbnailURL == "" {
+		placeholderThumbnailURL = imageURL
+	}
+}
+
+// PlaceholderImageURL returns the configured placeholder image, or "" when there is none
+func PlaceholderImageURL() string {
+	return placeholderImageURL
+}
+
+// PlaceholderThumbnailURL returns the configured placeholder thumbnail, or "" when there is none
+func PlaceholderThumbnailURL() string {
+	return placeholderThumbnailURL
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"time"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// CheckUnmodifiedSince enforces an If-Unmodified-Since precondition against the resource's
+// last modification time. HTTP dates have whole-second precision while updated_at keeps
+// microseconds, so lastModified is truncated to the second before comparing: a client echoing
+// the Last-Modified header it was sent passes, as long as no update landed in a later second.
+// A nil since always passes.
+func CheckUnmodifiedSince(lastModified time.Time, since *time.Time) error {
+	if since == nil {
+		return nil
+	}
+	if !lastModified.Truncate(time.Second).After(*since) {
+		return nil
+	}
+	return ierr.NewErrorf("resource modified at %s, after %s", lastModified.UTC().Format(time.RFC3339Nano), since.UTC().Format(time.RFC3339)).
+		WithHint("The resource has changed since you last read it. Fetch it again and reapply your changes").
+		WithReportableDetails(map[string]any{
+			"last_modified":    lastModified.UTC().Truncate(time.Second),
+			"unmodified_since": since.UTC(),
+		}).
+		Mark(ierr.ErrPreconditionFailed)
+}
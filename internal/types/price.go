@@ -0,0 +1,58 @@
+package types
+
+import (
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/validator"
+	"github.com/shopspring/decimal"
+)
+
+// DefaultPriceCurrency is the currency max_price is compared in when none is given
+const DefaultPriceCurrency = "INR"
+
+// PriceInfo describes the entry fee of a place
+type PriceInfo struct {
+	// IsFree is set for places without an entry fee; Amount must then be absent or zero
+	IsFree bool `json:"is_free"`
+	// Amount is the entry fee in Currency; required for paid places
+	Amount *decimal.Decimal `json:"amount,omitempty"`
+	// Currency is an ISO 4217 code such as INR; required for paid places
+	Currency string `json:"currency,omitempty" binding:"omitempty,len=3,uppercase" validate:"omitempty,len=3,uppercase"`
+	// Notes carries free-form details, e.g. concessions or camera fees
+	Notes *string `json:"notes,omitempty" binding:"omitempty,max=500" validate:"omitempty,max=500"`
+}
+
+// Validate checks that the amount and currency are consistent with IsFree
+func (p *PriceInfo) Validate() error {
+	if p == nil {
+		return nil
+	}
+
+	if p.Amount != nil && p.Amount.IsNegative() {
+		return ierr.NewError("price amount cannot be negative").
+			WithHint("Please provide a non-negative price amount").
+			WithReportableDetails(map[string]any{"amount": p.Amount}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if p.IsFree {
+		if p.Amount != nil && !p.Amount.IsZero() {
+			return ierr.NewError("a free place cannot have a price amount").
+				WithHint("Remove the amount or set is_free to false").
+				WithReportableDetails(map[string]any{"amount": p.Amount}).
+				Mark(ierr.ErrValidation)
+		}
+	} else {
+		if p.Amount == nil {
+			return ierr.NewError("price amount is required for paid places").
+				WithHint("Please provide the entry fee amount, or set is_free to true").
+				Mark(ierr.ErrValidation)
+		}
+		if p.Currency == "" {
+			return ierr.NewError("price currency is required for paid places").
+				WithHint("Please provide an ISO 4217 currency code such as INR").
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	return validator.ValidateCurrencyCode(p.Currency)
+}
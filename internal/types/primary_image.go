@@ -0,0 +1,46 @@
+package types
+
+import (
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// PrimaryImageDeletePolicy decides what happens to a place's primary_image_url when the
+// gallery image it points at is deleted
+type PrimaryImageDeletePolicy string
+
+const (
+	// PrimaryImageDeleteRepoint points primary_image_url at the first remaining image by
+	// position, or clears it when none is left
+	PrimaryImageDeleteRepoint PrimaryImageDeletePolicy = "repoint"
+	// PrimaryImageDeleteClear clears primary_image_url
+	PrimaryImageDeleteClear PrimaryImageDeletePolicy = "clear"
+	// PrimaryImageDeleteReject refuses the delete unless it is forced, which then repoints
+	PrimaryImageDeleteReject PrimaryImageDeletePolicy = "reject"
+)
+
+// PrimaryImageDeletePolicies lists the supported primary image delete policies
+var PrimaryImageDeletePolicies = []PrimaryImageDeletePolicy{
+	PrimaryImageDeleteRepoint,
+	PrimaryImageDeleteClear,
+	PrimaryImageDeleteReject,
+}
+
+func (p PrimaryImageDeletePolicy) String() string {
+	return string(p)
+}
+
+func (p PrimaryImageDeletePolicy) Validate() error {
+	for _, allowed := range PrimaryImageDeletePolicies {
+		if p == allowed {
+			return nil
+		}
+	}
+	return ierr.NewErrorf("invalid primary image delete policy: %s", p).
+		WithHintf("Supported primary image delete policies are: %s, %s, %s",
+			PrimaryImageDeleteRepoint, PrimaryImageDeleteClear, PrimaryImageDeleteReject).
+		WithReportableDetails(map[string]any{
+			"policy":  p,
+			"allowed": PrimaryImageDeletePolicies,
+		}).
+		Mark(ierr.ErrValidation)
+}
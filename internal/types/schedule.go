@@ -0,0 +1,22 @@
+package types
+
+import (
+	"time"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// ValidatePublishSchedule checks that a scheduled unpublish, when both times are set, comes
+// after the scheduled publish
+func ValidatePublishSchedule(publishAt, unpublishAt *time.Time) error {
+	if publishAt == nil || unpublishAt == nil || unpublishAt.After(*publishAt) {
+		return nil
+	}
+	return ierr.NewError("unpublish_at must be after publish_at").
+		WithHint("Please schedule the unpublish after the publish").
+		WithReportableDetails(map[string]any{
+			"publish_at":   *publishAt,
+			"unpublish_at": *unpublishAt,
+		}).
+		Mark(ierr.ErrValidation)
+}
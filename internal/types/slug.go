@@ -0,0 +1,45 @@
+package types
+
+import (
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// SlugCollisionStrategy decides the suffix given to a generated slug that is already taken
+type SlugCollisionStrategy string
+
+const (
+	// SlugCollisionIncrement numbers taken slugs: x, x-2, x-3, ...
+	SlugCollisionIncrement SlugCollisionStrategy = "increment"
+	// SlugCollisionRandom appends a short random suffix: x, x-k3f9qa, ...
+	SlugCollisionRandom SlugCollisionStrategy = "random"
+	// SlugCollisionDate appends the creation date, numbered when that is taken too:
+	// x, x-20250114, x-20250114-2, ...
+	SlugCollisionDate SlugCollisionStrategy = "date"
+)
+
+// SlugCollisionStrategies lists the supported slug collision strategies
+var SlugCollisionStrategies = []SlugCollisionStrategy{
+	SlugCollisionIncrement,
+	SlugCollisionRandom,
+	SlugCollisionDate,
+}
+
+func (s SlugCollisionStrategy) String() string {
+	return string(s)
+}
+
+func (s SlugCollisionStrategy) Validate() error {
+	for _, allowed := range SlugCollisionStrategies {
+		if s == allowed {
+			return nil
+		}
+	}
+	return ierr.NewErrorf("invalid slug collision strategy: %s", s).
+		WithHintf("Supported slug collision strategies are: %s, %s, %s",
+			SlugCollisionIncrement, SlugCollisionRandom, SlugCollisionDate).
+		WithReportableDetails(map[string]any{
+			"strategy": s,
+			"allowed":  SlugCollisionStrategies,
+		}).
+		Mark(ierr.ErrValidation)
+}
@@ -1,5 +1,14 @@
 package types
 
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/samber/lo"
+)
+
 // Status is a type for the status of a resource (e.g. place, event, hotel, etc.) in the Database
 // This is used to track the lifecycle of a resource and to determine if it should be included in queries
 // Any changes to this type should be reflected in the database schema by running migrations
@@ -27,3 +36,110 @@ const (
 	// Unlike archived, deleted status is irreversible - use with caution
 	StatusDeleted Status = "deleted"
 )
+
+// Statuses contains all valid statuses
+var Statuses = []Status{
+	StatusPublished,
+	StatusDraft,
+	StatusArchived,
+	StatusDeleted,
+}
+
+// SoftDeletedStatuses are the statuses of soft-deleted rows. Delete archives a row, which can
+// be restored; deleted marks a row that never comes back. Neither is listed by default.
+var SoftDeletedStatuses = []Status{
+	StatusArchived,
+	StatusDeleted,
+}
+
+// ParseStatus converts a string into a Status, rejecting unknown values
+func ParseStatus(s string) (Status, error) {
+	status := Status(s)
+	if err := status.Validate(); err != nil {
+		return "", err
+	}
+	return status, nil
+}
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// IsSoftDeleted reports whether s is the status of a soft-deleted row
+func (s Status) IsSoftDeleted() bool {
+	return lo.Contains(SoftDeletedStatuses, s)
+}
+
+// IsValid reports whether s is one of the defined statuses
+func (s Status) IsValid() bool {
+	return lo.Contains(Statuses, s)
+}
+
+func (s Status) Validate() error {
+	if !s.IsValid() {
+		return ierr.NewErrorf("invalid status %q", string(s)).
+			WithHintf("Status must be one of: %s", strings.Join(lo.Map(Statuses, func(s Status, _ int) string { return string(s) }), ", ")).
+			WithReportableDetails(map[string]any{
+				"status":         string(s),
+				"allowed_values": Statuses,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the status as a string. The zero value encodes as an empty string
+// so unset statuses in partially populated models still serialize.
+func (s Status) MarshalJSON() ([]byte, error) {
+	if s != "" && !s.IsValid() {
+		return nil, s.Validate()
+	}
+	return json.Marshal(string(s))
+}
+
+// UnmarshalJSON decodes a status, rejecting unknown values
+func (s *Status) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return ierr.WithError(err).
+			WithHint("Status must be a string").
+			Mark(ierr.ErrValidation)
+	}
+	return s.UnmarshalText([]byte(raw))
+}
+
+// UnmarshalText decodes a status from text, rejecting unknown values
+func (s *Status) UnmarshalText(text []byte) error {
+	status, err := ParseStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*s = status
+	return nil
+}
+
+// UnmarshalParam decodes a status from a query or form parameter (gin binding)
+func (s *Status) UnmarshalParam(param string) error {
+	return s.UnmarshalText([]byte(param))
+}
+
+// Value implements driver.Valuer
+func (s Status) Value() (driver.Value, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return string(s), nil
+}
+
+// Scan implements sql.Scanner, rejecting unknown values stored in the database
+func (s *Status) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		return s.UnmarshalText([]byte(v))
+	case []byte:
+		return s.UnmarshalText(v)
+	default:
+		return ierr.NewErrorf("cannot scan %T into Status", src).
+			Mark(ierr.ErrDatabase)
+	}
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+func TestParseStatus(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Status
+		wantErr bool
+	}{
+		{input: "published", want: StatusPublished},
+		{input: "draft", want: StatusDraft},
+		{input: "archived", want: StatusArchived},
+		{input: "deleted", want: StatusDeleted},
+		{input: "", wantErr: true},
+		{input: "Published", wantErr: true},
+		{input: " published", wantErr: true},
+		{input: "active", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseStatus(tt.input)
+			if tt.wantErr {
+				if !ierr.IsValidation(err) {
+					t.Fatalf("ParseStatus(%q) error = %v, want validation error", tt.input, err)
+				}
+				if got != "" {
+					t.Errorf("ParseStatus(%q) = %q, want empty status", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseStatus(%q) error = %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseStatus(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"time"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+const (
+	// TimezoneUTC is the zone every timestamp is stored and serialized in by default
+	TimezoneUTC = "UTC"
+	// TimezoneIST is the local zone for Nashik, available for presentation only
+	TimezoneIST = "Asia/Kolkata"
+)
+
+// DisplayTimezones lists the zones accepted by the tz query parameter
+var DisplayTimezones = []string{
+	TimezoneUTC,
+	TimezoneIST,
+}
+
+// Localizer is implemented by responses whose timestamps can be presented in another zone
+type Localizer interface {
+	Localize(loc *time.Location)
+}
+
+// NowUTC returns the current time in UTC
+func NowUTC() time.Time {
+	return time.Now().UTC()
+}
+
+// ParseDisplayTimezone resolves the tz query parameter. An empty value means UTC.
+func ParseDisplayTimezone(tz string) (*time.Location, error) {
+	switch tz {
+	case "", TimezoneUTC:
+		return time.UTC, nil
+	case TimezoneIST:
+		loc, err := time.LoadLocation(TimezoneIST)
+		if err != nil {
+			// Fall back to a fixed offset when the host has no tzdata; IST has no DST
+			return time.FixedZone("IST", 5*60*60+30*60), nil
+		}
+		return loc, nil
+	}
+
+	return nil, ierr.NewErrorf("invalid timezone: %s", tz).
+		WithHintf("Supported timezones are: %s, %s", TimezoneUTC, TimezoneIST).
+		WithReportableDetails(map[string]any{
+			"tz":      tz,
+			"allowed": DisplayTimezones,
+		}).
+		Mark(ierr.ErrValidation)
+}
+
+// UTCPtr returns t in UTC, or nil when t is nil
+func UTCPtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+// Localize converts the timestamps to loc for presentation. The instant is unchanged.
+func (b *BaseModel) Localize(loc *time.Location) {
+	if b == nil || loc == nil {
+		return
+	}
+	b.CreatedAt = b.CreatedAt.In(loc)
+	b.UpdatedAt = b.UpdatedAt.In(loc)
+	if b.DeletedAt != nil {
+		deletedAt := b.DeletedAt.In(loc)
+		b.DeletedAt = &deletedAt
+	}
+}
+
+// Localize converts the timestamps of every item that supports it
+func (r *ListResponse[T]) Localize(loc *time.Location) {
+	for _, item := range r.Items {
+		if l, ok := any(item).(Localizer); ok {
+			l.Localize(loc)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+// setLocal runs the test as if the server were in loc
+func setLocal(t *testing.T, loc *time.Location) {
+	t.Helper()
+	saved := time.Local
+	time.Local = loc
+	t.Cleanup(func() { time.Local = saved })
+}
+
+func TestBaseModelUTCRoundTrip(t *testing.T) {
+	zones := []*time.Location{
+		time.UTC,
+		time.FixedZone("IST", 5*60*60+30*60),
+		time.FixedZone("PDT", -7*60*60),
+	}
+
+	for _, zone := range zones {
+		t.Run(zone.String(), func(t *testing.T) {
+			setLocal(t, zone)
+
+			base := GetDefaultBaseModel(context.Background())
+			if base.CreatedAt.Location() != time.UTC || base.UpdatedAt.Location() != time.UTC {
+				t.Fatalf("timestamps in %s and %s, want UTC", base.CreatedAt.Location(), base.UpdatedAt.Location())
+			}
+
+			data, err := json.Marshal(base)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			var fields map[string]string
+			if err := json.Unmarshal(data, &fields); err != nil {
+				t.Fatalf("unmarshal fields: %v", err)
+			}
+			for _, name := range []string{"created_at", "updated_at"} {
+				if !strings.HasSuffix(fields[name], "Z") {
+					t.Errorf("%s = %s, want a UTC timestamp", name, fields[name])
+				}
+			}
+
+			var back BaseModel
+			if err := json.Unmarshal(data, &back); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if !back.CreatedAt.Equal(base.CreatedAt) || back.CreatedAt.Location() != time.UTC {
+				t.Errorf("round trip = %v, want %v", back.CreatedAt, base.CreatedAt)
+			}
+		})
+	}
+}
+
+func TestBaseModelLocalize(t *testing.T) {
+	// Stored at 06:00 UTC, presented at 11:30 in Nashik
+	stored := time.Date(2025, 3, 1, 6, 0, 0, 0, time.UTC)
+	base := BaseModel{CreatedAt: stored, UpdatedAt: stored}
+
+	loc, err := ParseDisplayTimezone(TimezoneIST)
+	if err != nil {
+		t.Fatalf("ParseDisplayTimezone() error = %v", err)
+	}
+	base.Localize(loc)
+
+	if !base.CreatedAt.Equal(stored) {
+		t.Errorf("Localize() moved the instant to %v", base.CreatedAt)
+	}
+	if got := base.CreatedAt.Format(time.RFC3339); got != "2025-03-01T11:30:00+05:30" {
+		t.Errorf("CreatedAt = %s, want 2025-03-01T11:30:00+05:30", got)
+	}
+}
+
+func TestParseDisplayTimezone(t *testing.T) {
+	tests := []struct {
+		tz         string
+		wantOffset int
+		wantErr    bool
+	}{
+		{tz: "", wantOffset: 0},
+		{tz: TimezoneUTC, wantOffset: 0},
+		{tz: TimezoneIST, wantOffset: 5*60*60 + 30*60},
+		{tz: "America/New_York", wantErr: true},
+		{tz: "utc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tz, func(t *testing.T) {
+			loc, err := ParseDisplayTimezone(tt.tz)
+			if tt.wantErr {
+				if !ierr.IsValidation(err) {
+					t.Fatalf("ParseDisplayTimezone(%q) error = %v, want validation error", tt.tz, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDisplayTimezone(%q) error = %v", tt.tz, err)
+			}
+			if _, offset := time.Date(2025, 3, 1, 0, 0, 0, 0, loc).Zone(); offset != tt.wantOffset {
+				t.Errorf("offset = %d, want %d", offset, tt.wantOffset)
+			}
+		})
+	}
+}
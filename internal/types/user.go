@@ -48,6 +48,12 @@ func (f *UserFilter) Validate() error {
 		}
 	}
 
+	if f.Status != "" {
+		if err := f.Status.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
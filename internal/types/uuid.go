@@ -35,16 +35,30 @@ func ValidateUUIDWithPrefix(uuid string, prefix string) bool {
 	return ValidateUUID(ulidPart)
 }
 
+// ValidateID reports whether id has the format of every entity id, a lowercase prefix and a
+// ULID joined by an underscore, without checking which prefix it is
+func ValidateID(id string) bool {
+	prefix, ulidPart, ok := strings.Cut(id, "_")
+	if !ok || prefix == "" || strings.ToLower(prefix) != prefix {
+		return false
+	}
+	return ValidateUUID(ulidPart)
+}
+
 const (
 	// Prefixes for all domains and entities
 	UUID_PREFIX_USER        = "user"
 	UUID_PREFIX_CATEGORY    = "category"
 	UUID_PREFIX_PLACE       = "place"
 	UUID_PREFIX_PLACE_IMAGE = "plcimg"
+	UUID_PREFIX_PLACE_REV   = "plcrev"
+	UUID_PREFIX_PLACE_SLUG  = "plcslug"
 	UUID_PREFIX_REVIEW      = "review"
 	UUID_PREFIX_HOTEL       = "hotel"
 	UUID_PREFIX_EVENT       = "evt"
 	UUID_PREFIX_OCCURRENCE  = "occ"
 	UUID_PREFIX_ITINERARY   = "itin"
 	UUID_PREFIX_VISIT       = "visit"
+	UUID_PREFIX_API_KEY     = "apikey"
+	UUID_PREFIX_OUTBOX      = "obx"
 )
@@ -3,6 +3,8 @@ package validator
 import (
 	"errors"
 	"fmt"
+	"net/mail"
+	"net/url"
 	"regexp"
 	"strings"
 	"sync"
@@ -66,40 +68,43 @@ func ValidateRequest(req interface{}) error {
 	return nil
 }
 
+// slugExample is quoted in slug validation hints
+const slugExample = "kalaram-temple"
+
+// IsValidSlug reports whether slug is URL-safe: lowercase letters and digits, with single
+// hyphens only between them (no spaces, leading/trailing or consecutive hyphens)
+func IsValidSlug(slug string) bool {
+	return slugRegex.MatchString(slug)
+}
+
 // ValidateSlugFormat validates that a slug follows kebab-case format
 // (lowercase alphanumeric characters with hyphens, no leading/trailing hyphens)
 func ValidateSlugFormat(slug string) error {
-	// Trim whitespace
-	slug = strings.TrimSpace(slug)
-
-	if slug == "" {
-		return ierr.NewError("slug cannot be empty").
-			WithHint("Please provide a valid slug").
-			Mark(ierr.ErrValidation)
-	}
-
-	// Check for leading or trailing hyphens
-	if strings.HasPrefix(slug, "-") || strings.HasSuffix(slug, "-") {
-		return ierr.NewError("slug cannot start or end with a hyphen").
-			WithHint("Please use kebab-case format (e.g., 'my-hotel-name')").
-			Mark(ierr.ErrValidation)
+	if IsValidSlug(slug) {
+		return nil
 	}
 
-	// Validate format using regex
-	if !slugRegex.MatchString(slug) {
-		return ierr.NewError("slug must be in kebab-case format").
-			WithHint("Use only lowercase letters, numbers, and hyphens (e.g., 'nashik-grand-hotel')").
-			Mark(ierr.ErrValidation)
+	var reason string
+	switch {
+	case strings.TrimSpace(slug) == "":
+		reason = "slug cannot be empty"
+	case strings.ContainsAny(slug, " \t\r\n"):
+		reason = "slug cannot contain whitespace"
+	case strings.HasPrefix(slug, "-") || strings.HasSuffix(slug, "-"):
+		reason = "slug cannot start or end with a hyphen"
+	case strings.Contains(slug, "--"):
+		reason = "slug cannot contain consecutive hyphens"
+	default:
+		reason = "slug must be in kebab-case format"
 	}
 
-	// Check for consecutive hyphens
-	if strings.Contains(slug, "--") {
-		return ierr.NewError("slug cannot contain consecutive hyphens").
-			WithHint("Please use single hyphens to separate words").
-			Mark(ierr.ErrValidation)
-	}
-
-	return nil
+	return ierr.NewError(reason).
+		WithHintf("Use only lowercase letters, numbers and single hyphens (e.g., '%s')", slugExample).
+		WithReportableDetails(map[string]any{
+			"slug":    slug,
+			"example": slugExample,
+		}).
+		Mark(ierr.ErrValidation)
 }
 
 // ValidateCurrencyCode validates that a currency code is a valid ISO 4217 code
@@ -127,3 +132,78 @@ func ValidateCurrencyCode(currency string) error {
 
 	return nil
 }
+
+// e164Regex matches an E.164 phone number: a plus sign and up to 15 digits without a leading zero
+var e164Regex = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+
+// NormalizeIndianPhone rewrites a bare Indian number to E.164. Spaces, hyphens, dots and
+// parentheses are stripped; a 10-digit number, optionally with a leading 0 or 91, gets the
+// +91 country code. Other input is returned with only the separators stripped.
+func NormalizeIndianPhone(phone string) string {
+	phone = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(phone))
+
+	digits := phone
+	switch {
+	case strings.HasPrefix(phone, "+"):
+		return phone
+	case len(phone) == 11 && strings.HasPrefix(phone, "0"):
+		digits = phone[1:]
+	case len(phone) == 12 && strings.HasPrefix(phone, "91"):
+		digits = phone[2:]
+	}
+
+	if len(digits) == 10 && strings.Trim(digits, "0123456789") == "" {
+		return "+91" + digits
+	}
+	return phone
+}
+
+// ValidatePhoneE164 validates that a phone number is in E.164 format (e.g. +919876543210)
+func ValidatePhoneE164(field, phone string) error {
+	if e164Regex.MatchString(phone) {
+		return nil
+	}
+	return ierr.NewErrorf("invalid %s: %s", field, phone).
+		WithHint("Please provide the phone number in E.164 format, e.g. +919876543210").
+		WithReportableDetails(map[string]any{
+			"field": field,
+			"value": phone,
+		}).
+		Mark(ierr.ErrValidation)
+}
+
+// ValidateWebsiteURL validates that a URL is absolute and uses http or https
+func ValidateWebsiteURL(field, website string) error {
+	u, err := url.Parse(website)
+	if err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" {
+		return nil
+	}
+	return ierr.NewErrorf("invalid %s: %s", field, website).
+		WithHint("Please provide an absolute http or https URL, e.g. https://example.com").
+		WithReportableDetails(map[string]any{
+			"field": field,
+			"value": website,
+		}).
+		Mark(ierr.ErrValidation)
+}
+
+// ValidateEmail validates that an email is a bare address without a display name
+func ValidateEmail(field, email string) error {
+	addr, err := mail.ParseAddress(email)
+	if err == nil && addr.Address == email {
+		return nil
+	}
+	return ierr.NewErrorf("invalid %s: %s", field, email).
+		WithHint("Please provide a valid email address, e.g. info@example.com").
+		WithReportableDetails(map[string]any{
+			"field": field,
+			"value": email,
+		}).
+		Mark(ierr.ErrValidation)
+}
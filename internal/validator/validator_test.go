@@ -0,0 +1,52 @@
+package validator
+
+import (
+	"strings"
+	"testing"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+)
+
+func TestValidateSlugFormat(t *testing.T) {
+	tests := []struct {
+		name       string
+		slug       string
+		wantReason string
+	}{
+		{name: "single word", slug: "ramkund"},
+		{name: "kebab case", slug: "kalaram-temple"},
+		{name: "digits", slug: "sula-vineyards-2"},
+		{name: "empty", slug: "", wantReason: "slug cannot be empty"},
+		{name: "blank", slug: "   ", wantReason: "slug cannot be empty"},
+		{name: "untrimmed", slug: " kalaram-temple ", wantReason: "slug cannot contain whitespace"},
+		{name: "inner space", slug: "kalaram temple", wantReason: "slug cannot contain whitespace"},
+		{name: "leading hyphen", slug: "-kalaram", wantReason: "slug cannot start or end with a hyphen"},
+		{name: "trailing hyphen", slug: "kalaram-", wantReason: "slug cannot start or end with a hyphen"},
+		{name: "consecutive hyphens", slug: "kalaram--temple", wantReason: "slug cannot contain consecutive hyphens"},
+		{name: "uppercase", slug: "Kalaram-Temple", wantReason: "slug must be in kebab-case format"},
+		{name: "underscore", slug: "kalaram_temple", wantReason: "slug must be in kebab-case format"},
+		{name: "non-ascii", slug: "काळाराम", wantReason: "slug must be in kebab-case format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidSlug(tt.slug); got != (tt.wantReason == "") {
+				t.Errorf("IsValidSlug(%q) = %v, want %v", tt.slug, got, tt.wantReason == "")
+			}
+
+			err := ValidateSlugFormat(tt.slug)
+			if tt.wantReason == "" {
+				if err != nil {
+					t.Errorf("ValidateSlugFormat(%q) error = %v, want nil", tt.slug, err)
+				}
+				return
+			}
+			if !ierr.IsValidation(err) {
+				t.Fatalf("ValidateSlugFormat(%q) error = %v, want validation error", tt.slug, err)
+			}
+			if !strings.Contains(err.Error(), tt.wantReason) {
+				t.Errorf("ValidateSlugFormat(%q) error = %v, want %q", tt.slug, err, tt.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+// Package vision describes images for accessibility. A Captioner writes a short caption for
+// an image, used as alt text for place images added without one. No captioning service is
+// built in: deployments plug one in by providing their own Captioner.
+package vision
+
+import (
+	"context"
+
+	"github.com/omkar273/nashikdarshan/internal/config"
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/logger"
+)
+
+// Captioner describes the image at a URL in a sentence fit for alt text
+type Captioner interface {
+	Caption(ctx context.Context, imageURL string) (string, error)
+}
+
+// ErrUnavailable is returned when no captioner is configured; callers should leave the alt
+// text empty
+var ErrUnavailable = ierr.NewError("image captioning is unavailable").
+	WithHint("Image captioning is currently unavailable").
+	Mark(ierr.ErrIntegration)
+
+// NewCaptioner returns the default Captioner, which captions nothing. Deployments with a
+// captioning service replace it with their own implementation.
+func NewCaptioner(cfg *config.Configuration, log *logger.Logger) Captioner {
+	if cfg.Vision.AltTextEnabled {
+		log.Warnw("alt text generation is enabled but no captioner is provided - images will keep empty alt text")
+	}
+	return noop{}
+}
+
+type noop struct{}
+
+// Caption always fails with ErrUnavailable
+func (noop) Caption(context.Context, string) (string, error) {
+	return "", ErrUnavailable
+}
@@ -0,0 +1,79 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/omkar273/nashikdarshan/internal/cache"
+	"github.com/omkar273/nashikdarshan/internal/logger"
+	"github.com/omkar273/nashikdarshan/internal/types"
+	"github.com/shopspring/decimal"
+	"golang.org/x/time/rate"
+)
+
+const cacheNamespace = "weather"
+
+// Cached serves conditions per grid cell: a location is snapped to the centre of its cell
+// and every location in the cell shares one cached observation until it expires. Upstream
+// requests are rate limited; when the limit is reached, uncached lookups fail with
+// ErrUnavailable rather than wait.
+type Cached struct {
+	provider    Provider
+	store       *cache.Store
+	limiter     *rate.Limiter
+	gridDegrees float64
+	log         logger.Logger
+}
+
+// NewCached wraps provider with a cache of ttl per cell of gridDegrees, allowing at most
+// requestsPerMinute upstream requests
+func NewCached(provider Provider, ttl time.Duration, gridDegrees float64, requestsPerMinute int, maxCells int, log *logger.Logger) *Cached {
+	return &Cached{
+		provider:    provider,
+		store:       cache.NewStore(true, ttl, maxCells),
+		limiter:     rate.NewLimiter(rate.Limit(float64(requestsPerMinute)/60), max(requestsPerMinute/10, 1)),
+		gridDegrees: gridDegrees,
+		log:         *log,
+	}
+}
+
+func (c *Cached) Current(ctx context.Context, loc types.Location) (*Conditions, error) {
+	cell := c.cell(loc)
+	key := fmt.Sprintf("%s:%s,%s", cacheNamespace, cell.Latitude, cell.Longitude)
+
+	if v, ok := c.store.Get(key); ok {
+		c.store.RecordLookup(cacheNamespace, true)
+		conditions := *v.(*Conditions)
+		return &conditions, nil
+	}
+	c.store.RecordLookup(cacheNamespace, false)
+
+	if !c.limiter.Allow() {
+		c.log.Warnw("weather rate limit reached, skipping lookup", "cell", key)
+		return nil, ErrUnavailable
+	}
+
+	conditions, err := c.provider.Current(ctx, cell)
+	if err != nil {
+		return nil, err
+	}
+
+	stored := *conditions
+	c.store.Set(key, &stored)
+	return conditions, nil
+}
+
+// cell returns the centre of the grid cell containing loc
+func (c *Cached) cell(loc types.Location) types.Location {
+	snap := func(d decimal.Decimal) decimal.Decimal {
+		v := d.InexactFloat64()
+		centre := (math.Floor(v/c.gridDegrees) + 0.5) * c.gridDegrees
+		return decimal.NewFromFloat(centre).Round(4)
+	}
+	return types.Location{
+		Latitude:  snap(loc.Latitude),
+		Longitude: snap(loc.Longitude),
+	}
+}
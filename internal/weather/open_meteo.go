@@ -0,0 +1,146 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/logger"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// ProviderOpenMeteo is the name of the Open-Meteo provider, which needs no API key
+const ProviderOpenMeteo = "open_meteo"
+
+// DefaultOpenMeteoURL is the Open-Meteo forecast endpoint
+const DefaultOpenMeteoURL = "https://api.open-meteo.com/v1/forecast"
+
+type openMeteo struct {
+	baseURL    string
+	httpClient *http.Client
+	log        logger.Logger
+}
+
+// NewOpenMeteo returns a provider backed by the Open-Meteo forecast API at baseURL
+func NewOpenMeteo(baseURL string, timeout time.Duration, log *logger.Logger) Provider {
+	if baseURL == "" {
+		baseURL = DefaultOpenMeteoURL
+	}
+	return &openMeteo{
+		baseURL:    baseURL,
+		httpClient: &http.Client{Timeout: timeout},
+		log:        *log,
+	}
+}
+
+type openMeteoResponse struct {
+	Current struct {
+		Time                string  `json:"time"`
+		Temperature         float64 `json:"temperature_2m"`
+		ApparentTemperature float64 `json:"apparent_temperature"`
+		RelativeHumidity    float64 `json:"relative_humidity_2m"`
+		Precipitation       float64 `json:"precipitation"`
+		WindSpeed           float64 `json:"wind_speed_10m"`
+		WeatherCode         int     `json:"weather_code"`
+	} `json:"current"`
+}
+
+func (o *openMeteo) Current(ctx context.Context, loc types.Location) (*Conditions, error) {
+	query := url.Values{}
+	query.Set("latitude", loc.Latitude.String())
+	query.Set("longitude", loc.Longitude.String())
+	query.Set("current", "temperature_2m,apparent_temperature,relative_humidity_2m,precipitation,wind_speed_10m,weather_code")
+	query.Set("wind_speed_unit", "kmh")
+	query.Set("timezone", "GMT")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.baseURL+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to build the weather request").
+			Mark(ierr.ErrIntegration)
+	}
+
+	o.log.Debugw("fetching current weather",
+		"provider", ProviderOpenMeteo,
+		"latitude", loc.Latitude,
+		"longitude", loc.Longitude,
+	)
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Weather provider is unreachable").
+			Mark(ierr.ErrIntegration)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ierr.NewErrorf("weather provider responded with status %d", resp.StatusCode).
+			WithHint("Weather provider is unavailable").
+			WithReportableDetails(map[string]any{
+				"provider": ProviderOpenMeteo,
+				"status":   resp.StatusCode,
+			}).
+			Mark(ierr.ErrIntegration)
+	}
+
+	var body openMeteoResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Weather provider returned an unreadable response").
+			Mark(ierr.ErrIntegration)
+	}
+
+	observedAt, err := time.Parse("2006-01-02T15:04", body.Current.Time)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Weather provider returned an unreadable response").
+			WithReportableDetails(map[string]any{
+				"time": body.Current.Time,
+			}).
+			Mark(ierr.ErrIntegration)
+	}
+
+	return &Conditions{
+		TemperatureC:    body.Current.Temperature,
+		FeelsLikeC:      body.Current.ApparentTemperature,
+		HumidityPct:     body.Current.RelativeHumidity,
+		PrecipitationMm: body.Current.Precipitation,
+		WindSpeedKmh:    body.Current.WindSpeed,
+		Code:            body.Current.WeatherCode,
+		Summary:         describeCode(body.Current.WeatherCode),
+		ObservedAt:      observedAt.UTC(),
+		Provider:        ProviderOpenMeteo,
+	}, nil
+}
+
+// describeCode names a WMO weather interpretation code
+func describeCode(code int) string {
+	switch {
+	case code == 0:
+		return "Clear sky"
+	case code <= 2:
+		return "Partly cloudy"
+	case code == 3:
+		return "Overcast"
+	case code == 45 || code == 48:
+		return "Fog"
+	case code >= 51 && code <= 57:
+		return "Drizzle"
+	case code >= 61 && code <= 67:
+		return "Rain"
+	case code >= 71 && code <= 77:
+		return "Snow"
+	case code >= 80 && code <= 82:
+		return "Rain showers"
+	case code == 85 || code == 86:
+		return "Snow showers"
+	case code >= 95:
+		return "Thunderstorm"
+	}
+	return fmt.Sprintf("Weather code %d", code)
+}
@@ -0,0 +1,32 @@
+package weather
+
+import (
+	"strings"
+
+	"github.com/omkar273/nashikdarshan/internal/config"
+	"github.com/omkar273/nashikdarshan/internal/logger"
+)
+
+// NewProvider creates the weather provider selected in configuration, wrapped in the cell
+// cache and rate limit. Without a provider every lookup fails with ErrUnavailable.
+func NewProvider(cfg *config.Configuration, log *logger.Logger) Provider {
+	wc := cfg.Weather
+	provider := strings.ToLower(strings.TrimSpace(wc.Provider))
+	switch provider {
+	case ProviderOpenMeteo:
+		return NewCached(
+			NewOpenMeteo(wc.BaseURL, wc.GetTimeout(), log),
+			wc.GetCacheTTL(),
+			wc.GetGridDegrees(),
+			wc.GetRequestsPerMinute(),
+			wc.GetMaxCells(),
+			log,
+		)
+	case "", "none":
+		log.Infow("weather disabled - place weather lookups will be skipped")
+		return disabled{}
+	default:
+		log.Warnw("unknown weather provider, disabling weather", "provider", wc.Provider)
+		return disabled{}
+	}
+}
@@ -0,0 +1,52 @@
+// Package weather looks up current conditions at a location from an external provider.
+// Lookups are cached per grid cell and rate limited (see Cached) so that places close to each
+// other share one upstream request.
+package weather
+
+import (
+	"context"
+	"time"
+
+	ierr "github.com/omkar273/nashikdarshan/internal/errors"
+	"github.com/omkar273/nashikdarshan/internal/types"
+)
+
+// Conditions are the current weather at a location
+type Conditions struct {
+	TemperatureC    float64 `json:"temperature_c"`
+	FeelsLikeC      float64 `json:"feels_like_c"`
+	HumidityPct     float64 `json:"humidity_pct"`
+	PrecipitationMm float64 `json:"precipitation_mm"`
+	WindSpeedKmh    float64 `json:"wind_speed_kmh"`
+	// Code is the WMO weather interpretation code and Summary its English description
+	Code       int       `json:"code"`
+	Summary    string    `json:"summary"`
+	ObservedAt time.Time `json:"observed_at"`
+	Provider   string    `json:"provider"`
+}
+
+// Localize converts the observation time to loc for presentation
+func (c *Conditions) Localize(loc *time.Location) {
+	if c == nil || loc == nil {
+		return
+	}
+	c.ObservedAt = c.ObservedAt.In(loc)
+}
+
+// Provider returns the current conditions at a location
+type Provider interface {
+	Current(ctx context.Context, loc types.Location) (*Conditions, error)
+}
+
+// ErrUnavailable is returned when no provider is configured or the provider cannot be
+// used right now; callers should carry on without weather
+var ErrUnavailable = ierr.NewError("weather is unavailable").
+	WithHint("Weather information is currently unavailable").
+	Mark(ierr.ErrIntegration)
+
+type disabled struct{}
+
+// Current always fails with ErrUnavailable
+func (disabled) Current(context.Context, types.Location) (*Conditions, error) {
+	return nil, ErrUnavailable
+}
@@ -8,7 +8,7 @@ import (
 
 // GenerateEnt runs the Ent code generator.
 func GenerateEnt() error {
-	cmd := exec.Command("go", "run", "-mod=mod", "entgo.io/ent/cmd/ent", "generate", "./ent/schema")
+	cmd := exec.Command("go", "run", "-mod=mod", "entgo.io/ent/cmd/ent", "generate", "--feature", "sql/execquery,sql/upsert", "./ent/schema")
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 